@@ -158,14 +158,14 @@ func (suite *EIP712TestSuite) SetupTest() {
 	evmGs := evmtypes.NewGenesisState(
 		evmtypes.NewParams(
 			"afury",                       // evmDenom
-			true,                         // allowedUnprotectedTxs
+			false,                         // allowedUnprotectedTxs
 			true,                          // enableCreate
 			true,                          // enableCall
 			evmtypes.DefaultChainConfig(), // ChainConfig
-			true,                           // extraEIPs
-			true,                           // eip712AllowedMsgs
+			nil,                           // extraEIPs
+			nil,                           // eip712AllowedMsgs
 		),
-		true,
+		nil,
 	)
 
 	feemarketGenesis := feemarkettypes.DefaultGenesisState()
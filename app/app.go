@@ -225,28 +225,29 @@ var (
 	// If these are changed, the permissions stored in accounts
 	// must also be migrated during a chain upgrade.
 	mAccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		furydisttypes.FuryDistMacc:      {authtypes.Minter},
-		auctiontypes.ModuleName:         nil,
-		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
-		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
-		swaptypes.ModuleName:            nil,
-		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		jinxtypes.ModuleAccountName:     {authtypes.Minter},
-		savingstypes.ModuleAccountName:  nil,
-		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
-		furydisttypes.FundModuleAccount: nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		communitytypes.ModuleName:       nil,
+		authtypes.FeeCollectorName:          nil,
+		distrtypes.ModuleName:               nil,
+		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                 {authtypes.Burner},
+		ibctransfertypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmutiltypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
+		furydisttypes.FuryDistMacc:          {authtypes.Minter},
+		auctiontypes.ModuleName:             nil,
+		issuancetypes.ModuleAccountName:     {authtypes.Minter, authtypes.Burner},
+		bep3types.ModuleName:                {authtypes.Burner, authtypes.Minter},
+		swaptypes.ModuleName:                nil,
+		cdptypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		cdptypes.LiquidatorMacc:             {authtypes.Minter, authtypes.Burner},
+		jinxtypes.ModuleAccountName:         {authtypes.Minter},
+		jinxtypes.IsolatedModuleAccountName: nil,
+		savingstypes.ModuleAccountName:      nil,
+		liquidtypes.ModuleAccountName:       {authtypes.Minter, authtypes.Burner},
+		earntypes.ModuleAccountName:         nil,
+		furydisttypes.FundModuleAccount:     nil,
+		minttypes.ModuleName:                {authtypes.Minter},
+		communitytypes.ModuleName:           nil,
 	}
 )
 
@@ -577,10 +578,12 @@ func NewApp(
 		bep3Subspace,
 		app.ModuleAccountAddrs(),
 	)
-	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
+	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
-		keys[pricefeedtypes.StoreKey],
-		pricefeedSubspace,
+		app.accountKeeper,
+		app.bankKeeper,
+		&app.stakingKeeper,
+		&app.distrKeeper,
 	)
 	swapKeeper := swapkeeper.NewKeeper(
 		appCodec,
@@ -589,6 +592,13 @@ func NewApp(
 		app.accountKeeper,
 		app.bankKeeper,
 	)
+	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
+		appCodec,
+		keys[pricefeedtypes.StoreKey],
+		pricefeedSubspace,
+		app.liquidKeeper,
+		swapKeeper,
+	)
 	cdpKeeper := cdpkeeper.NewKeeper(
 		appCodec,
 		keys[cdptypes.StoreKey],
@@ -607,13 +617,7 @@ func NewApp(
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
-	)
-	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
-		appCodec,
-		app.accountKeeper,
-		app.bankKeeper,
-		&app.stakingKeeper,
-		&app.distrKeeper,
+		app.MsgServiceRouter(),
 	)
 	savingsKeeper := savingskeeper.NewKeeper(
 		appCodec,
@@ -717,6 +721,7 @@ func NewApp(
 	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
 	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
 	app.jinxKeeper = *jinxKeeper.SetHooks(jinxtypes.NewMultiJINXHooks(app.incentiveKeeper.Hooks()))
+	app.auctionKeeper = *app.auctionKeeper.SetHooks(auctiontypes.NewMultiAuctionHooks(app.jinxKeeper.AuctionHooks()))
 	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
 
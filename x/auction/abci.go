@@ -11,8 +11,8 @@ import (
 	"github.com/percosis-labs/fury/x/auction/types"
 )
 
-// BeginBlocker closes all expired auctions at the end of each block. It panics if
-// there's an error other than ErrAuctionNotFound.
+// BeginBlocker closes all expired auctions at the end of each block, then prunes the bid history
+// of auctions closed longer than the retention period. It panics if there's an error other than ErrAuctionNotFound.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -20,4 +20,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err != nil && !errors.Is(err, types.ErrAuctionNotFound) {
 		panic(err)
 	}
+
+	k.PruneBids(ctx)
 }
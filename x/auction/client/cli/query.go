@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAuction(),
 		GetCmdQueryAuctions(),
+		GetCmdQueryAuctionBids(),
+		GetCmdQueryBidderAuctions(),
+		GetCmdQueryAuctionStats(),
 	}
 
 	for _, cmd := range cmds {
@@ -96,12 +100,85 @@ func GetCmdQueryAuction() *cobra.Command {
 	}
 }
 
+// GetCmdQueryAuctionBids queries the bid history of an auction
+func GetCmdQueryAuctionBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bids [auction-id]",
+		Short: "get the bid history of an open auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AuctionBids(context.Background(), &types.QueryAuctionBidsRequest{
+				AuctionId:  auctionID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bids")
+
+	return cmd
+}
+
+// GetCmdQueryBidderAuctions queries the open auctions an address has bid on
+func GetCmdQueryBidderAuctions() *cobra.Command {
+	return &cobra.Command{
+		Use:     "bidder-auctions [bidder-addr]",
+		Short:   "get the open auctions an address holds the top bid on or has been outbid on",
+		Example: fmt.Sprintf("  $ %s q %s bidder-auctions fury1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BidderAuctions(context.Background(), &types.QueryBidderAuctionsRequest{
+				Bidder: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // Query auction flags
 const (
 	flagType  = "type"
 	flagDenom = "denom"
 	flagPhase = "phase"
 	flagOwner = "owner"
+
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
 )
 
 // GetCmdQueryAuctions queries the auctions in the store
@@ -145,13 +222,15 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 				if auctionType != types.CollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
-					auctionType != types.DebtAuctionType {
+					auctionType != types.DebtAuctionType &&
+					auctionType != types.DutchAuctionType &&
+					auctionType != types.BatchAuctionType {
 					return fmt.Errorf("invalid auction type %s", auctionType)
 				}
 			}
 
 			if len(owner) != 0 {
-				if auctionType != types.CollateralAuctionType {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType && auctionType != types.BatchAuctionType {
 					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
 				}
 				_, err := sdk.AccAddressFromBech32(owner)
@@ -170,10 +249,11 @@ func GetCmdQueryAuctions() *cobra.Command {
 			if len(phase) != 0 {
 				phase = strings.ToLower(phase)
 
-				if len(auctionType) > 0 && auctionType != types.CollateralAuctionType {
+				if len(auctionType) > 0 && auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType && auctionType != types.BatchAuctionType {
 					return fmt.Errorf("cannot apply phase flag to non-collateral auction type")
 				}
-				if phase != types.ForwardAuctionPhase && phase != types.ReverseAuctionPhase {
+				if phase != types.ForwardAuctionPhase && phase != types.ReverseAuctionPhase &&
+					phase != types.DescendingAuctionPhase && phase != types.UniformPriceAuctionPhase {
 					return fmt.Errorf("invalid auction phase %s", phase)
 				}
 			}
@@ -203,10 +283,80 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, "auctions")
 
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch, batch")
 	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
-	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
+	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse/descending/uniform")
+
+	return cmd
+}
+
+// GetCmdQueryAuctionStats queries the auction stats over daily windows
+func GetCmdQueryAuctionStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-stats",
+		Short: "query auction stats over daily windows",
+		Long:  "Query the auctions started and closed, lots sold and bids raised of each auction type and lot denom over daily windows, along with their totals over the queried range. Times are in RFC3339 format.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  $ %s q %s auction-stats --type=collateral --denom=bnb", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auction-stats --start-time=2022-03-01T00:00:00Z --end-time=2022-04-01T00:00:00Z", version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auctionType, err := cmd.Flags().GetString(flagType)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+			startTime, err := getTimeFlag(cmd, flagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := getTimeFlag(cmd, flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AuctionStats(context.Background(), &types.QueryAuctionStatsRequest{
+				Type:      strings.ToLower(auctionType),
+				LotDenom:  denom,
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch, batch")
+	cmd.Flags().String(flagDenom, "", "(optional) filter by lot denom")
+	cmd.Flags().String(flagStartTime, "", "(optional) exclude windows starting before this time")
+	cmd.Flags().String(flagEndTime, "", "(optional) exclude windows starting at or after this time")
 
 	return cmd
 }
+
+// getTimeFlag parses an optional RFC3339 time flag, returning the zero time if it is unset
+func getTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s: %w", flag, str, err)
+	}
+	return t, nil
+}
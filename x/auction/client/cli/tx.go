@@ -24,6 +24,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPlaceBid(),
+		GetCmdPlaceBatchBid(),
 	}
 
 	for _, cmd := range cmds {
@@ -40,7 +41,7 @@ func GetCmdPlaceBid() *cobra.Command {
 	return &cobra.Command{
 		Use:     "bid [auction-id] [amount]",
 		Short:   "place a bid on an auction",
-		Long:    "Place a bid on any type of auction, updating the latest bid amount to [amount]. Collateral auctions must be bid up to their maxbid before entering reverse phase.",
+		Long:    "Place a bid on any type of auction, updating the latest bid amount to [amount]. Collateral auctions must be bid up to their maxbid before entering reverse phase. Bids on dutch auctions buy [amount] of the lot at the current price.",
 		Example: fmt.Sprintf("  $ %s tx %s bid 34 1000usdf --from myKeyName", version.AppName, types.ModuleName),
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -68,3 +69,42 @@ func GetCmdPlaceBid() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPlaceBatchBid cli command for bidding for part of the lot of batch auctions
+func GetCmdPlaceBatchBid() *cobra.Command {
+	return &cobra.Command{
+		Use:     "batch-bid [auction-id] [lot] [bid]",
+		Short:   "bid for part of the lot of a batch auction",
+		Long:    "Bid to buy [lot] of a batch auction's lot for at most [bid]. The bid is held until the auction is settled at a single clearing price, when any of it not paid is refunded. A new bid replaces the bidder's previous bid on the auction.",
+		Example: fmt.Sprintf("  $ %s tx %s batch-bid 34 100000btc 5000usdf --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			lot, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			bid, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBatchBid(id, clientCtx.GetFromAddress().String(), lot, bid)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -25,11 +25,30 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 	if err != nil {
 		panic(fmt.Sprintf("failed to unpack genesis auctions: %s", err))
 	}
+	for _, stats := range gs.AuctionStats {
+		keeper.SetAuctionStats(ctx, stats)
+	}
+
 	for _, a := range auctions {
 		keeper.SetAuction(ctx, a)
 		// find the total coins that should be present in the module account
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
+	for _, bid := range gs.Bids {
+		auction, found := keeper.GetAuction(ctx, bid.AuctionID)
+		if !found {
+			// The auction has closed, its bid history is retained for the retention period from genesis.
+			// Its type is no longer known, so it is not restored to the bidder index.
+			keeper.AppendBidHistory(ctx, bid)
+			keeper.ScheduleBidPruning(ctx, bid.AuctionID, ctx.BlockTime().Add(gs.Params.BidHistoryRetention))
+			continue
+		}
+		keeper.AppendBid(ctx, auction.GetType(), bid)
+	}
+	for _, bid := range gs.BatchBids {
+		keeper.AppendBatchBid(ctx, bid)
+		totalAuctionCoins = totalAuctionCoins.Add(bid.Bid)
+	}
 
 	// check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
@@ -69,6 +88,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	gs.AuctionStats = keeper.GetAllAuctionStats(ctx)
+	gs.Bids = keeper.GetAllBids(ctx)
+	gs.BatchBids = keeper.GetAllBatchBids(ctx)
 
 	return gs
 }
@@ -156,4 +156,56 @@ func TestExportGenesis(t *testing.T) {
 		expectedGenesisState.Auctions = append(expectedGenesisState.Auctions, packedGenesisAuctions...)
 		require.Equal(t, expectedGenesisState, gs)
 	})
+	t.Run("bid history", func(t *testing.T) {
+		// setup state
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+		tApp.InitializeFromGenesisStates()
+		keeper := tApp.GetAuctionKeeper()
+		keeper.SetNextAuctionID(ctx, 10)
+		keeper.SetAuction(ctx, testAuction)
+		bid := types.NewBidRecord(testAuction.GetID(), testAddrs[0], c("biddenom", 10), c("lotdenom", 10), testTime, 1)
+		keeper.AppendBid(ctx, testAuction.GetType(), bid)
+
+		// export
+		gs := auction.ExportGenesis(ctx, keeper)
+		require.Equal(t, types.BidRecords{bid}, gs.Bids)
+
+		// the bidder index is rebuilt on import
+		tApp = app.NewTestApp()
+		ctx = tApp.NewContext(true, tmproto.Header{Height: 1})
+		tApp.InitializeFromGenesisStates()
+		keeper = tApp.GetAuctionKeeper()
+		require.NoError(t, tApp.FundModuleAccount(ctx, types.ModuleName, testAuction.GetModuleAccountCoins()))
+		auction.InitGenesis(ctx, keeper, tApp.GetBankKeeper(), tApp.GetAccountKeeper(), gs)
+
+		var auctionIDs []uint64
+		keeper.IterateBidderAuctions(ctx, testAddrs[0], func(id uint64) bool {
+			auctionIDs = append(auctionIDs, id)
+			return false
+		})
+		require.Equal(t, []uint64{testAuction.GetID()}, auctionIDs)
+	})
+	t.Run("closed auction bid history", func(t *testing.T) {
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: testTime})
+		tApp.InitializeFromGenesisStates()
+		keeper := tApp.GetAuctionKeeper()
+
+		gs := types.DefaultGenesisState()
+		gs.NextAuctionId = 10
+		bid := types.NewBidRecord(3, testAddrs[0], c("biddenom", 10), c("lotdenom", 10), testTime, 1)
+		gs.Bids = types.BidRecords{bid}
+		auction.InitGenesis(ctx, keeper, tApp.GetBankKeeper(), tApp.GetAccountKeeper(), gs)
+
+		// the bid history of a closed auction is kept for the retention period from genesis
+		require.Equal(t, types.BidRecords{bid}, keeper.GetAuctionBids(ctx, 3))
+		// but it is not added to the bidder index, as dutch auction purchases are never indexed
+		keeper.IterateBidderAuctions(ctx, testAddrs[0], func(id uint64) bool {
+			t.Fatalf("closed auction %d indexed for bidder", id)
+			return true
+		})
+		keeper.PruneBids(ctx.WithBlockTime(testTime.Add(gs.Params.BidHistoryRetention)))
+		require.Empty(t, keeper.GetAuctionBids(ctx, 3))
+	})
 }
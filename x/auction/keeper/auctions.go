@@ -32,6 +32,7 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 	if err != nil {
 		return 0, err
 	}
+	k.recordAuctionStart(ctx, &auction, nil)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -71,6 +72,7 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 	if err != nil {
 		return 0, err
 	}
+	k.recordAuctionStart(ctx, &auction, sdk.NewCoins(debt))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -116,6 +118,7 @@ func (k Keeper) StartCollateralAuction(
 	if err != nil {
 		return 0, err
 	}
+	k.recordAuctionStart(ctx, &auction, sdk.NewCoins(debt))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -130,6 +133,65 @@ func (k Keeper) StartCollateralAuction(
 	return auctionID, nil
 }
 
+// StartDutchAuction starts a new dutch (descending price) auction.
+// The lot's price starts at marketPrice, the price of one unit of lot in units of the bid denom, plus a premium,
+// and decays no lower than the reserve ratio of the market price.
+func (k Keeper) StartDutchAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin, marketPrice sdk.Dec,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin,
+) (uint64, error) {
+	if marketPrice.IsNil() || !marketPrice.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidStartPrice, "%s", marketPrice)
+	}
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewDutchAuction(
+		seller,
+		lot,
+		maxBid,
+		weightedAddresses,
+		debt,
+		marketPrice.Mul(sdk.OneDec().Add(params.DutchStartPremium)),
+		marketPrice.Mul(params.DutchReserveRatio),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.DutchAuctionDuration),
+		params.DutchDecayCurve,
+		params.DutchExponentialDecay,
+	)
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, &auction)
+	if err != nil {
+		return 0, err
+	}
+	k.recordAuctionStart(ctx, &auction, sdk.NewCoins(debt))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -142,6 +204,9 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
 	}
 
+	// bids update the auction in place, so note the top bid before placing the new one
+	previous := getTopBid(auction)
+
 	// move coins and return updated auction
 	var (
 		err            error
@@ -158,6 +223,10 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case *types.DutchAuction:
+		updatedAuction, err = k.PlaceBidDutch(ctx, auctionType, bidder, newAmount)
+	case *types.BatchAuction:
+		err = errorsmod.Wrapf(types.ErrInvalidBidType, "batch auction %d only accepts batch bids", auctionID)
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -167,6 +236,7 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	}
 
 	k.SetAuction(ctx, updatedAuction)
+	k.recordBid(ctx, previous, updatedAuction, bidder)
 
 	return nil
 }
@@ -463,6 +533,84 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	return auction, nil
 }
 
+// PlaceBidDutch buys some amount of lot from a dutch auction at its current price, moving coins and returning the updated auction.
+// If the purchase would raise more than the auction's max bid, the amount bought is reduced to only raise the remainder.
+func (k Keeper) PlaceBidDutch(ctx sdk.Context, auction *types.DutchAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DutchAuction, error) {
+	// Validate new bid
+	if lot.Denom != auction.Lot.Denom {
+		return auction, errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if auction.IsSoldOut() {
+		return auction, errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d is sold out", auction.ID)
+	}
+	if !lot.IsPositive() {
+		return auction, errorsmod.Wrapf(types.ErrLotTooSmall, "%s ≤ %s%s", lot, sdk.ZeroInt(), auction.Lot.Denom)
+	}
+	if lot.Amount.GT(auction.Lot.Amount) {
+		return auction, errorsmod.Wrapf(types.ErrLotTooLarge, "%s > %s", lot, auction.Lot)
+	}
+	price := auction.CurrentPrice(ctx.BlockTime())
+	if !price.IsPositive() {
+		return auction, errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d has reached a price of zero", auction.ID)
+	}
+
+	// Cap the payment at the amount left to raise, reducing the lot bought to match
+	remaining := auction.MaxBid.Sub(auction.Bid)
+	payment := sdk.NewCoin(auction.Bid.Denom, price.MulInt(lot.Amount).Ceil().TruncateInt())
+	if payment.Amount.GT(remaining.Amount) {
+		payment = remaining
+		lot = sdk.NewCoin(lot.Denom, sdk.MinInt(lot.Amount, sdk.NewDecFromInt(remaining.Amount).Quo(price).Ceil().TruncateInt()))
+	}
+
+	// Payment is sent to auction initiator
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(payment))
+	if err != nil {
+		return auction, err
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to payment (or whatever is left if < payment).
+	if auction.CorrespondingDebt.IsPositive() {
+		debtAmountToReturn := sdk.MinInt(payment.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Lot is sent straight to the bidder
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return auction, err
+	}
+
+	// Update Auction
+	auction.Bidder = bidder
+	auction.Bid = auction.Bid.Add(payment)
+	auction.Lot = auction.Lot.Sub(lot)
+	auction.HasReceivedBids = true
+	k.recordAuctionSale(ctx, auction, lot, payment)
+	if auction.IsSoldOut() {
+		// close the auction at the next block, returning any unsold lot
+		auction.EndTime = ctx.BlockTime()
+		auction.MaxEndTime = ctx.BlockTime()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
 // CloseAuction closes an auction and distributes funds to the highest bidder.
 func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -483,6 +631,10 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		err = k.PayoutDebtAuction(ctx, auc)
 	case *types.CollateralAuction:
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case *types.DutchAuction:
+		err = k.PayoutDutchAuction(ctx, auc)
+	case *types.BatchAuction:
+		err = k.PayoutBatchAuction(ctx, auc)
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -490,6 +642,8 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	if err != nil {
 		return err
 	}
+	k.recordAuctionClose(ctx, auction)
+	k.AfterAuctionClosed(ctx, auction)
 
 	k.DeleteAuction(ctx, auctionID)
 
@@ -545,6 +699,35 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction *types.Collater
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// PayoutDutchAuction returns any unsold lot and remaining debt once a dutch auction has closed.
+// The lot has already been paid out to buyers as it was sold.
+func (k Keeper) PayoutDutchAuction(ctx sdk.Context, auction *types.DutchAuction) error {
+	// Unsold lot is sent to weighted addresses (normally the CDP depositors)
+	if auction.Lot.IsPositive() {
+		lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			// if the payout amount is 0, don't send 0 coins
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
 // paying out to the highest bidder.
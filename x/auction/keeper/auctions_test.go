@@ -194,6 +194,87 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func (suite *auctionTestSuite) TestDutchAuctionBasic() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction, with a starting price of 2.4 token2 per token1 after the premium is applied
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), sdk.MustNewDecFromStr("2.0"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+	// Check seller's coins have decreased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Buy half the lot a quarter of the way through the auction, at three quarters of the starting price
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration / 4))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	// Check bidder's coins have been exchanged
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 110), c("token2", 82)))
+	// Check seller's coins have increased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 118), c("debt", 78)))
+
+	// Buying more than the remaining lot fails
+	suite.ErrorIs(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 11)), types.ErrLotTooLarge)
+
+	// Buy the rest of the lot later on, when the price has decayed to the reserve price of 1.6
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration * 5 / 6))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 120), c("token2", 66)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 134), c("debt", 94)))
+
+	// The sold out auction can't be bid on
+	suite.ErrorIs(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 1)), types.ErrAuctionHasExpired)
+
+	// Close auction, returning the remaining debt
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 134), c("debt", 100)))
+	// Check return addresses have not received coins
+	for _, ra := range suite.Addrs[1:] {
+		suite.CheckAccountBalanceEqual(ra, cs(c("token1", 100), c("token2", 100)))
+	}
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionMaxBidReached() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 23), c("token2", 12), sdk.MustNewDecFromStr("2.0"), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Buying lot worth more than the max bid only buys enough to raise the max bid
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 10)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 105), c("token2", 88)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 77), c("token2", 112), c("debt", 72)))
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	suite.Equal(suite.Ctx.BlockTime(), auction.GetEndTime())
+
+	// Close auction, returning the unsold lot and remaining debt
+	suite.NoError(suite.Keeper.CloseAuction(suite.Ctx, auctionID))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 77), c("token2", 112), c("debt", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 109), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 106), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[3], cs(c("token1", 103), c("token2", 100)))
+}
+
+func (suite *auctionTestSuite) TestStartDutchAuctionInvalidPrice() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("debt", 100)))
+
+	_, err := suite.Keeper.StartDutchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), sdk.ZeroDec(), suite.Addrs[1:], is(30, 20, 10), c("debt", 40))
+	suite.ErrorIs(err, types.ErrInvalidStartPrice)
+}
+
 func (suite *auctionTestSuite) TestStartSurplusAuction() {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
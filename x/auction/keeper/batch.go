@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+// StartBatchAuction starts a new batch (uniform price) auction.
+// Bids for parts of the lot are collected for the batch auction duration, then settled together at a single price.
+// Bids are not accepted below the reserve ratio of marketPrice, the price of one unit of lot in units of the bid denom.
+func (k Keeper) StartBatchAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin, marketPrice sdk.Dec,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin,
+) (uint64, error) {
+	if marketPrice.IsNil() || !marketPrice.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidMarketPrice, "%s", marketPrice)
+	}
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewBatchAuction(
+		seller,
+		lot,
+		ctx.BlockTime().Add(params.BatchAuctionDuration),
+		maxBid,
+		marketPrice.Mul(params.BatchReserveRatio),
+		weightedAddresses,
+		debt,
+	)
+
+	// NOTE: for the duration of the auction the auction module account holds the debt, the lot and the bids
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, &auction)
+	if err != nil {
+		return 0, err
+	}
+	k.recordAuctionStart(ctx, &auction, sdk.NewCoins(debt))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.ReservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+	return auctionID, nil
+}
+
+// PlaceBatchBid bids to buy some amount of a batch auction's lot, paying at most bid.
+// The bid is held by the auction module account until the auction is settled. A new bid replaces the bidder's previous bid.
+// Bids must be for at least the min lot fraction of the lot and priced at or above the reserve price.
+// Once an auction holds the max number of bids, a new bidder must outprice the lowest bid, which is refunded and removed.
+func (k Keeper) PlaceBatchBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, lot, bid sdk.Coin) error {
+	a, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	if ctx.BlockTime().After(a.GetEndTime()) {
+		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+	auction, ok := a.(*types.BatchAuction)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidBidType, "%s auction %d does not accept batch bids", a.GetType(), auctionID)
+	}
+
+	newBid := types.BatchBid{Bidder: bidder, Lot: lot, Bid: bid, AuctionID: auctionID}
+	if err := newBid.Validate(auction.Lot, auction.MaxBid.Denom); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidBatchBid, err.Error())
+	}
+	params := k.GetParams(ctx)
+	minLot := sdk.NewDecFromInt(auction.Lot.Amount).Mul(params.BatchMinLotFraction).Ceil().TruncateInt()
+	if lot.Amount.LT(minLot) {
+		return errorsmod.Wrapf(types.ErrLotTooSmall, "%s < %s%s", lot, minLot, lot.Denom)
+	}
+	if newBid.PriceLT(auction.ReservePrice) {
+		return errorsmod.Wrapf(types.ErrBidTooSmall, "price %s is below the reserve price %s", newBid.Price(), auction.ReservePrice)
+	}
+
+	// Only the difference from the bidder's previous bid is moved in or out of escrow
+	escrowed := sdk.NewCoin(bid.Denom, sdk.ZeroInt())
+	previous, replaced := k.GetBatchBid(ctx, auctionID, bidder)
+	var evicted types.BatchBid
+	evict := !replaced && auction.BidCount >= params.BatchMaxBids
+	if replaced {
+		escrowed = previous.Bid
+	} else if evict {
+		evicted = k.lowestBatchBid(ctx, auctionID)
+		if !newBid.PriceGT(evicted) {
+			return errorsmod.Wrapf(types.ErrBidTooSmall, "auction %d holds %d bids, price %s must be greater than the lowest price %s", auctionID, auction.BidCount, newBid.Price(), evicted.Price())
+		}
+	}
+	var err error
+	switch {
+	case bid.Amount.GT(escrowed.Amount):
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(bid.Sub(escrowed)))
+	case bid.Amount.LT(escrowed.Amount):
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(escrowed.Sub(bid)))
+	}
+	if err != nil {
+		return err
+	}
+	if evict {
+		if err := k.evictBatchBid(ctx, evicted, newBid); err != nil {
+			return err
+		}
+	}
+
+	// Update Auction, replaced bids lose their place among bids at the same price
+	if replaced {
+		k.deleteBatchBid(ctx, auctionID, bidder)
+	} else if !evict {
+		auction.BidCount++
+	}
+	k.AppendBatchBid(ctx, newBid)
+	auction.Bidder = bidder
+	auction.HasReceivedBids = true
+	k.SetAuction(ctx, auction)
+	k.AppendBid(ctx, auction.GetType(), types.NewBidRecord(auctionID, bidder, bid, lot, ctx.BlockTime(), ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, newBid.Price().String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+	return nil
+}
+
+// evictBatchBid refunds and removes the lowest bid of a full batch auction to make room for a new bid.
+func (k Keeper) evictBatchBid(ctx sdk.Context, evicted, newBid types.BatchBid) error {
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, evicted.Bidder, sdk.NewCoins(evicted.Bid))
+	if err != nil {
+		return err
+	}
+	k.deleteBatchBid(ctx, evicted.AuctionID, evicted.Bidder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionOutbid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", evicted.AuctionID)),
+			sdk.NewAttribute(types.AttributeKeyOutbidBidder, evicted.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, evicted.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, newBid.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, newBid.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, newBid.Lot.String()),
+		),
+	)
+	return nil
+}
+
+// lowestBatchBid returns the bid of a batch auction that would be filled last, the latest bid at the lowest price.
+func (k Keeper) lowestBatchBid(ctx sdk.Context, auctionID uint64) (lowest types.BatchBid) {
+	first := true
+	k.IterateBatchBids(ctx, auctionID, func(bid types.BatchBid) bool {
+		if first || !bid.PriceGT(lowest) {
+			lowest = bid
+			first = false
+		}
+		return false
+	})
+	return lowest
+}
+
+// AppendBatchBid stores a bid on a batch auction after the auction's other bids, and indexes it by bidder.
+func (k Keeper) AppendBatchBid(ctx sdk.Context, bid types.BatchBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchBidKeyPrefix)
+	var sequence uint64
+	iterator := prefix.NewStore(store, types.Uint64ToBytes(bid.AuctionID)).ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		sequence = types.Uint64FromBytes(iterator.Key()) + 1
+	}
+	iterator.Close()
+	store.Set(types.GetBatchBidKey(bid.AuctionID, sequence), k.cdc.MustMarshal(&bid))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchBidderKeyPrefix)
+	indexStore.Set(types.GetBatchBidderKey(bid.AuctionID, bid.Bidder), types.Uint64ToBytes(sequence))
+}
+
+// GetBatchBid returns a bidder's bid on a batch auction, or false if they have not bid on it.
+func (k Keeper) GetBatchBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) (types.BatchBid, bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchBidderKeyPrefix)
+	bz := indexStore.Get(types.GetBatchBidderKey(auctionID, bidder))
+	if bz == nil {
+		return types.BatchBid{}, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchBidKeyPrefix)
+	var bid types.BatchBid
+	k.cdc.MustUnmarshal(store.Get(types.GetBatchBidKey(auctionID, types.Uint64FromBytes(bz))), &bid)
+	return bid, true
+}
+
+// deleteBatchBid removes a bidder's bid on a batch auction and its index entry.
+func (k Keeper) deleteBatchBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchBidderKeyPrefix)
+	indexKey := types.GetBatchBidderKey(auctionID, bidder)
+	bz := indexStore.Get(indexKey)
+	if bz == nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchBidKeyPrefix)
+	store.Delete(types.GetBatchBidKey(auctionID, types.Uint64FromBytes(bz)))
+	indexStore.Delete(indexKey)
+}
+
+// deleteBatchBids removes all bids held by a batch auction and their index entries.
+func (k Keeper) deleteBatchBids(ctx sdk.Context, auctionID uint64) {
+	for _, bid := range k.GetBatchBids(ctx, auctionID) {
+		k.deleteBatchBid(ctx, auctionID, bid.Bidder)
+	}
+}
+
+// IterateBatchBids provides an iterator over the bids held by a batch auction, in the order they were placed.
+// For each bid, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateBatchBids(ctx sdk.Context, auctionID uint64, cb func(bid types.BatchBid) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.BatchBidKeyPrefix, types.Uint64ToBytes(auctionID)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.BatchBid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// GetBatchBids returns the bids held by a batch auction, in the order they were placed
+func (k Keeper) GetBatchBids(ctx sdk.Context, auctionID uint64) (bids types.BatchBids) {
+	k.IterateBatchBids(ctx, auctionID, func(bid types.BatchBid) bool {
+		bids = append(bids, bid)
+		return false
+	})
+	return
+}
+
+// GetAllBatchBids returns the bids held by all batch auctions
+func (k Keeper) GetAllBatchBids(ctx sdk.Context) (bids types.BatchBids) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BatchBidKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.BatchBid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// PayoutBatchAuction settles a batch auction at its clearing price once it has closed.
+// Filled bids receive their lot and are refunded what they bid over the clearing price, other bids are refunded in full.
+// The amount raised and all debt are sent to the initiator, and unsold lot is returned to the weighted addresses.
+// The auction is updated to the lot sold, the bid raised and the debt left uncovered, for recording its close.
+func (k Keeper) PayoutBatchAuction(ctx sdk.Context, auction *types.BatchAuction) error {
+	bids := k.GetBatchBids(ctx, auction.ID)
+	fills, price := auction.Settle(bids)
+
+	sold := sdk.NewCoin(auction.Lot.Denom, sdk.ZeroInt())
+	raised := sdk.NewCoin(auction.MaxBid.Denom, sdk.ZeroInt())
+	for i, bid := range bids {
+		fill := fills[i]
+		if fill.Lot.IsPositive() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Bidder, sdk.NewCoins(fill.Lot))
+			if err != nil {
+				return err
+			}
+		}
+		refund := bid.Bid.Sub(fill.Payment)
+		if refund.IsPositive() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Bidder, sdk.NewCoins(refund))
+			if err != nil {
+				return err
+			}
+		}
+		sold = sold.Add(fill.Lot)
+		raised = raised.Add(fill.Payment)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBatchFill,
+				sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+				sdk.NewAttribute(types.AttributeKeyBidder, bid.Bidder.String()),
+				sdk.NewAttribute(types.AttributeKeyLot, fill.Lot.String()),
+				sdk.NewAttribute(types.AttributeKeyBid, fill.Payment.String()),
+				sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			),
+		)
+	}
+
+	// Amount raised is sent to auction initiator, along with all the debt, as it would be over the course of a collateral auction
+	if raised.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(raised))
+		if err != nil {
+			return err
+		}
+	}
+	if auction.CorrespondingDebt.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Unsold lot is sent to weighted addresses (normally the CDP depositors)
+	unsold := auction.Lot.Sub(sold)
+	if unsold.IsPositive() {
+		lotPayouts, err := splitCoinIntoWeightedBuckets(unsold, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			// if the payout amount is 0, don't send 0 coins
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	auction.Lot = sold
+	auction.Bid = raised
+	auction.CorrespondingDebt = auction.CorrespondingDebt.SubAmount(sdk.MinInt(raised.Amount, auction.CorrespondingDebt.Amount))
+	return nil
+}
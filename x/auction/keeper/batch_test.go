@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/auction/keeper"
+	"github.com/percosis-labs/fury/x/auction/testutil"
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+type batchTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *batchTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+}
+
+func TestBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(batchTestSuite))
+}
+
+func (suite *batchTestSuite) checkModuleAccountInvariant() {
+	msg, broken := keeper.ModuleAccountInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(broken, msg)
+}
+
+func (suite *batchTestSuite) TestSettleBatchAuction() {
+	auctionID, err := suite.Keeper.StartBatchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 100), c("token2", 120), sdk.MustNewDecFromStr("1.25"), suite.Addrs[2:], is(1, 1), c("debt", 100))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 60), c("token2", 60)))
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[1], c("token1", 60), c("token2", 90)))
+	// replacing a bid only escrows the difference, and moves it behind other bids at the same price
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 50), c("token2", 75)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 100), c("token2", 25)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 100), c("token2", 10)))
+	suite.checkModuleAccountInvariant()
+
+	res, err := keeper.NewQueryServerImpl(suite.Keeper).BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: suite.Addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Len(res.ActiveAuctions, 1)
+	suite.Empty(res.OutbidAuctions)
+
+	// 80 of the lot is enough to raise the max bid at the clearing price of 1.5
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultBatchAuctionDuration))
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+
+	_, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.False(found)
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 120), c("token2", 70)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 160), c("token2", 10)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 110), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[3], cs(c("token1", 110), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.ModAcc.GetAddress(), cs(c("token2", 220), c("debt", 100)))
+	suite.checkModuleAccountInvariant()
+
+	stats, found := suite.Keeper.GetAuctionStats(ctx, types.BatchAuctionType, "token1", types.StatsWindowStart(ctx.BlockTime()))
+	suite.Require().True(found)
+	suite.Equal(uint64(1), stats.AuctionsClosed)
+	suite.Equal(cs(c("token1", 80)), stats.LotSold)
+	suite.Equal(cs(c("token2", 120)), stats.BidRaised)
+	suite.Empty(stats.DebtUnrecovered)
+}
+
+func (suite *batchTestSuite) TestSettleBatchAuctionWithoutBids() {
+	auctionID, err := suite.Keeper.StartBatchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), sdk.MustNewDecFromStr("2"), suite.Addrs[1:], is(30, 20, 10), c("debt", 40))
+	suite.Require().NoError(err)
+
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultBatchAuctionDuration))
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+
+	// the whole lot is returned by weight, with the remainder going to the largest weight
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 110), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[2], cs(c("token1", 107), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[3], cs(c("token1", 103), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.ModAcc.GetAddress(), cs(c("token1", 80), c("token2", 100), c("debt", 100)))
+}
+
+func (suite *batchTestSuite) TestPlaceBatchBidErrors() {
+	batchID, err := suite.Keeper.StartBatchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), sdk.MustNewDecFromStr("1"), suite.Addrs[2:], is(1, 1), c("debt", 40))
+	suite.Require().NoError(err)
+	collateralID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[2:], is(1, 1), c("debt", 40))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.PlaceBid(suite.Ctx, batchID, suite.Addrs[0], c("token2", 10))
+	suite.ErrorIs(err, types.ErrInvalidBidType)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, collateralID, suite.Addrs[0], c("token1", 10), c("token2", 10))
+	suite.ErrorIs(err, types.ErrInvalidBidType)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, 100, suite.Addrs[0], c("token1", 10), c("token2", 10))
+	suite.ErrorIs(err, types.ErrAuctionNotFound)
+	_, err = suite.Keeper.StartBatchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), sdk.MustNewDecFromStr("0"), suite.Addrs[2:], is(1, 1), c("debt", 40))
+	suite.ErrorIs(err, types.ErrInvalidMarketPrice)
+
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, batchID, suite.Addrs[0], c("token1", 21), c("token2", 10))
+	suite.ErrorIs(err, types.ErrInvalidBatchBid)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, batchID, suite.Addrs[0], c("token2", 10), c("token2", 10))
+	suite.ErrorIs(err, types.ErrInvalidBatchBid)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, batchID, suite.Addrs[0], c("token1", 10), c("token1", 10))
+	suite.ErrorIs(err, types.ErrInvalidBatchBid)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, batchID, suite.Addrs[0], c("token1", 10), c("token2", 101))
+	suite.Error(err)
+
+	expired := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultBatchAuctionDuration + 1))
+	err = suite.Keeper.PlaceBatchBid(expired, batchID, suite.Addrs[0], c("token1", 10), c("token2", 10))
+	suite.ErrorIs(err, types.ErrAuctionHasExpired)
+
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 100), c("token2", 100)))
+}
+
+func (suite *batchTestSuite) TestBatchBidLimits() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.BatchMinLotFraction = sdk.MustNewDecFromStr("0.1")
+	params.BatchMaxBids = 2
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	// bids are not accepted below the reserve price of 0.8
+	auctionID, err := suite.Keeper.StartBatchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 100), c("token2", 100), sdk.MustNewDecFromStr("1"), suite.Addrs[3:], is(1), c("debt", 100))
+	suite.Require().NoError(err)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 9), c("token2", 9))
+	suite.ErrorIs(err, types.ErrLotTooSmall)
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 10), c("token2", 7))
+	suite.ErrorIs(err, types.ErrBidTooSmall)
+
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 10), c("token2", 10)))
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[1], c("token1", 10), c("token2", 12)))
+
+	// once full, a new bid must outprice the lowest bid, which is refunded
+	err = suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[2], c("token1", 10), c("token2", 10))
+	suite.ErrorIs(err, types.ErrBidTooSmall)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[2], c("token1", 10), c("token2", 11)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 100), c("token2", 100)))
+	_, found := suite.Keeper.GetBatchBid(suite.Ctx, auctionID, suite.Addrs[0])
+	suite.False(found)
+	var outbid []sdk.Event
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAuctionOutbid {
+			outbid = append(outbid, event)
+		}
+	}
+	suite.Len(outbid, 1)
+
+	res, err := keeper.NewQueryServerImpl(suite.Keeper).BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: suite.Addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Empty(res.ActiveAuctions)
+	suite.Len(res.OutbidAuctions, 1)
+
+	// bidders can still replace their own bid on a full auction
+	suite.Require().NoError(suite.Keeper.PlaceBatchBid(suite.Ctx, auctionID, suite.Addrs[1], c("token1", 20), c("token2", 24)))
+	bids := suite.Keeper.GetBatchBids(suite.Ctx, auctionID)
+	suite.Require().Len(bids, 2)
+	suite.Equal(suite.Addrs[2], bids[0].Bidder)
+	suite.Equal(suite.Addrs[1], bids[1].Bidder)
+	a, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(uint64(2), a.(*types.BatchAuction).BidCount)
+	suite.checkModuleAccountInvariant()
+
+	// settling the auction removes its bids
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultBatchAuctionDuration))
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	suite.Empty(suite.Keeper.GetBatchBids(suite.Ctx, auctionID))
+	_, found = suite.Keeper.GetBatchBid(suite.Ctx, auctionID, suite.Addrs[1])
+	suite.False(found)
+}
@@ -462,6 +462,16 @@ func TestAuctionBidding(t *testing.T) {
 				types.DefaultIncrement,
 				types.DefaultIncrement,
 				types.DefaultIncrement,
+				types.DefaultDutchStartPremium,
+				types.DefaultDutchAuctionDuration,
+				types.DefaultDutchDecayCurve,
+				types.DefaultDutchExponentialDecay,
+				types.DefaultBatchAuctionDuration,
+				types.DefaultDutchReserveRatio,
+				types.DefaultBidHistoryRetention,
+				types.DefaultBatchReserveRatio,
+				types.DefaultBatchMinLotFraction,
+				types.DefaultBatchMaxBids,
 			)
 
 			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
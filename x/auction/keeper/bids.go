@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+// topBid is the top bid of an auction before a new bid is placed on it
+type topBid struct {
+	bidder          sdk.AccAddress
+	bid             sdk.Coin
+	lot             sdk.Coin
+	hasReceivedBids bool
+}
+
+// getTopBid returns the current top bid of an auction.
+// It must be called before a bid is placed, as bids update auctions in place.
+func getTopBid(auction types.Auction) topBid {
+	return topBid{
+		bidder:          auction.GetBidder(),
+		bid:             auction.GetBid(),
+		lot:             auction.GetLot(),
+		hasReceivedBids: hasReceivedBids(auction),
+	}
+}
+
+// AppendBid adds a bid to the end of an auction's bid history, and adds the auction to the bidder's index.
+// Dutch auction purchases are settled immediately, so they are not added to the bidder index.
+func (k Keeper) AppendBid(ctx sdk.Context, auctionType string, bid types.BidRecord) {
+	k.AppendBidHistory(ctx, bid)
+
+	if auctionType != types.DutchAuctionType {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderIndexKeyPrefix)
+		indexStore.Set(types.GetBidderIndexKey(bid.Bidder, bid.AuctionID), types.Uint64ToBytes(bid.AuctionID))
+	}
+}
+
+// AppendBidHistory adds a bid to the end of an auction's bid history without adding it to the bidder's index.
+func (k Keeper) AppendBidHistory(ctx sdk.Context, bid types.BidRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidKeyPrefix)
+	var sequence uint64
+	if last, found := k.getLastBid(ctx, bid.AuctionID); found {
+		sequence = last + 1
+	}
+	store.Set(types.GetBidKey(bid.AuctionID, sequence), k.cdc.MustMarshal(&bid))
+}
+
+// getLastBid returns the sequence of the most recent bid on an auction
+func (k Keeper) getLastBid(ctx sdk.Context, auctionID uint64) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.BidKeyPrefix, types.Uint64ToBytes(auctionID)...))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return types.Uint64FromBytes(iterator.Key()), true
+}
+
+// IterateAuctionBids provides an iterator over the bid history of an auction, oldest first.
+// For each bid, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAuctionBids(ctx sdk.Context, auctionID uint64, cb func(bid types.BidRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.BidKeyPrefix, types.Uint64ToBytes(auctionID)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.BidRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// GetAuctionBids returns the bid history of an auction, oldest first
+func (k Keeper) GetAuctionBids(ctx sdk.Context, auctionID uint64) (bids types.BidRecords) {
+	k.IterateAuctionBids(ctx, auctionID, func(bid types.BidRecord) bool {
+		bids = append(bids, bid)
+		return false
+	})
+	return
+}
+
+// GetAllBids returns the bid history of all auctions
+func (k Keeper) GetAllBids(ctx sdk.Context) (bids types.BidRecords) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BidKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.BidRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// IterateBidderAuctions provides an iterator over the IDs of the auctions a bidder has bid on.
+// Closed auctions are included until their bid history is pruned.
+// For each auction, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateBidderAuctions(ctx sdk.Context, bidder sdk.AccAddress, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.BidderIndexKeyPrefix, address.MustLengthPrefix(bidder)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.Uint64FromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// deleteAuctionBids removes the bid history of an auction, and removes the auction from its bidders' indexes.
+func (k Keeper) deleteAuctionBids(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.BidKeyPrefix, types.Uint64ToBytes(auctionID)...))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderIndexKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.BidRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		indexStore.Delete(types.GetBidderIndexKey(bid.Bidder, auctionID))
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ScheduleBidPruning queues the bid history of a closed auction to be removed at the prune time.
+func (k Keeper) ScheduleBidPruning(ctx sdk.Context, auctionID uint64, pruneTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidPruneKeyPrefix)
+	store.Set(types.GetAuctionByTimeKey(pruneTime, auctionID), types.Uint64ToBytes(auctionID))
+}
+
+// PruneBids removes the bid history and bidder index entries of closed auctions whose prune time has been reached.
+func (k Keeper) PruneBids(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidPruneKeyPrefix)
+	iterator := store.Iterator(
+		nil,
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())), // include any keys with times equal to the block time
+	)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		k.deleteAuctionBids(ctx, types.Uint64FromBytes(iterator.Value()))
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordBid adds a bid placed on an auction to its bid history.
+// If the bid replaced another bidder's bid, the refund paid to them is recorded and an outbid event is emitted.
+func (k Keeper) recordBid(ctx sdk.Context, previous topBid, auction types.Auction, bidder sdk.AccAddress) {
+	if auction.GetType() == types.DutchAuctionType {
+		// record the purchase rather than the auction's totals
+		payment := auction.GetBid().Sub(previous.bid)
+		lot := previous.lot.Sub(auction.GetLot())
+		k.AppendBid(ctx, auction.GetType(), types.NewBidRecord(auction.GetID(), bidder, payment, lot, ctx.BlockTime(), ctx.BlockHeight()))
+		return
+	}
+
+	if previous.hasReceivedBids && !bidder.Equals(previous.bidder) {
+		k.recordRefund(ctx, auction.GetID(), previous.bidder, previous.bid)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionOutbid,
+				sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
+				sdk.NewAttribute(types.AttributeKeyOutbidBidder, previous.bidder.String()),
+				sdk.NewAttribute(types.AttributeKeyRefund, previous.bid.String()),
+				sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+				sdk.NewAttribute(types.AttributeKeyBid, auction.GetBid().String()),
+				sdk.NewAttribute(types.AttributeKeyLot, auction.GetLot().String()),
+			),
+		)
+	}
+
+	k.AppendBid(ctx, auction.GetType(), types.NewBidRecord(auction.GetID(), bidder, auction.GetBid(), auction.GetLot(), ctx.BlockTime(), ctx.BlockHeight()))
+}
+
+// recordRefund sets the refund on the most recent bid of an auction, if it was placed by the refunded bidder
+func (k Keeper) recordRefund(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, refund sdk.Coin) {
+	sequence, found := k.getLastBid(ctx, auctionID)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidKeyPrefix)
+	key := types.GetBidKey(auctionID, sequence)
+
+	var bid types.BidRecord
+	k.cdc.MustUnmarshal(store.Get(key), &bid)
+	if !bid.Bidder.Equals(bidder) {
+		return
+	}
+	bid.Refund = refund
+	store.Set(key, k.cdc.MustMarshal(&bid))
+}
+
+// isActiveBidder returns true if a bidder's bid on an auction has not been outbid
+func (k Keeper) isActiveBidder(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress) bool {
+	if auction.GetType() == types.BatchAuctionType {
+		// batch bids are only outbid when a full auction removes its lowest bid
+		_, found := k.GetBatchBid(ctx, auction.GetID(), bidder)
+		return found
+	}
+	return auction.GetBidder().Equals(bidder)
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/percosis-labs/fury/x/auction/keeper"
+	"github.com/percosis-labs/fury/x/auction/testutil"
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+type bidsTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *bidsTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+}
+
+func TestBidsTestSuite(t *testing.T) {
+	suite.Run(t, new(bidsTestSuite))
+}
+
+func (suite *bidsTestSuite) outbidEvents() []sdk.Event {
+	var events []sdk.Event
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAuctionOutbid {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (suite *bidsTestSuite) TestBidHistory() {
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[2:], is(1, 1), c("debt", 50))
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 10)))
+	suite.Empty(suite.outbidEvents())
+
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token2", 20)))
+	events := suite.outbidEvents()
+	suite.Require().Len(events, 1)
+	suite.Contains(events[0].Attributes, attr(types.AttributeKeyOutbidBidder, suite.Addrs[0].String()))
+	suite.Contains(events[0].Attributes, attr(types.AttributeKeyRefund, "10token2"))
+	suite.Contains(events[0].Attributes, attr(types.AttributeKeyBidder, suite.Addrs[1].String()))
+
+	// raising your own bid does not refund or outbid anyone
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token2", 30)))
+	suite.Len(suite.outbidEvents(), 1)
+
+	bids := suite.Keeper.GetAuctionBids(suite.Ctx, auctionID)
+	suite.Require().Len(bids, 3)
+	suite.Equal(suite.Addrs[0], bids[0].Bidder)
+	suite.Equal(c("token2", 10), bids[0].Bid)
+	suite.Equal(c("token1", 20), bids[0].Lot)
+	suite.Equal(c("token2", 10), bids[0].Refund)
+	suite.Equal(suite.Ctx.BlockTime(), bids[0].Time)
+	suite.Equal(suite.Addrs[1], bids[1].Bidder)
+	suite.Equal(c("token2", 0), bids[1].Refund)
+	suite.Equal(c("token2", 30), bids[2].Bid)
+	suite.Equal(c("token2", 0), bids[2].Refund)
+
+	// closing the auction keeps its bid history and bidder index entries for the retention period
+	closeTime := suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration)
+	suite.Require().NoError(suite.Keeper.CloseAuction(suite.Ctx.WithBlockTime(closeTime), auctionID))
+	suite.Len(suite.Keeper.GetAuctionBids(suite.Ctx, auctionID), 3)
+
+	res, err := keeper.NewQueryServerImpl(suite.Keeper).BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: suite.Addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Empty(res.ActiveAuctions)
+	suite.Empty(res.OutbidAuctions)
+	suite.Equal([]uint64{auctionID}, res.ClosedAuctionIds)
+
+	suite.Keeper.PruneBids(suite.Ctx.WithBlockTime(closeTime.Add(types.DefaultBidHistoryRetention - time.Second)))
+	suite.Len(suite.Keeper.GetAuctionBids(suite.Ctx, auctionID), 3)
+
+	// once the retention period has passed the bid history and bidder index entries are removed
+	suite.Keeper.PruneBids(suite.Ctx.WithBlockTime(closeTime.Add(types.DefaultBidHistoryRetention)))
+	suite.Empty(suite.Keeper.GetAuctionBids(suite.Ctx, auctionID))
+	for _, addr := range suite.Addrs[:2] {
+		suite.Keeper.IterateBidderAuctions(suite.Ctx, addr, func(id uint64) bool {
+			suite.Failf("bidder index not removed", "auction %d", id)
+			return false
+		})
+	}
+}
+
+func (suite *bidsTestSuite) TestDebtAuctionFirstBidIsNotOutbid() {
+	auctionID, err := suite.Keeper.StartDebtAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 100), c("debt", 20))
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 90)))
+	suite.Empty(suite.outbidEvents())
+
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token2", 80)))
+	suite.Len(suite.outbidEvents(), 1)
+
+	bids := suite.Keeper.GetAuctionBids(suite.Ctx, auctionID)
+	suite.Require().Len(bids, 2)
+	suite.Equal(c("token1", 20), bids[0].Refund)
+	suite.Equal(c("token2", 80), bids[1].Lot)
+}
+
+func (suite *bidsTestSuite) TestDutchPurchaseHistory() {
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), sdk.MustNewDecFromStr("2.0"), suite.Addrs[2:], is(1, 1), c("debt", 40))
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 5)))
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token1", 5)))
+	suite.Empty(suite.outbidEvents())
+
+	// purchases are recorded individually, at the starting price of 2.4
+	bids := suite.Keeper.GetAuctionBids(suite.Ctx, auctionID)
+	suite.Require().Len(bids, 2)
+	for i, bid := range bids {
+		suite.Equal(suite.Addrs[i], bid.Bidder)
+		suite.Equal(c("token2", 12), bid.Bid)
+		suite.Equal(c("token1", 5), bid.Lot)
+		suite.Equal(c("token2", 0), bid.Refund)
+	}
+
+	// purchases are settled immediately, so buyers are not indexed
+	suite.Keeper.IterateBidderAuctions(suite.Ctx, suite.Addrs[0], func(id uint64) bool {
+		suite.Failf("dutch purchase indexed", "auction %d", id)
+		return false
+	})
+}
+
+func (suite *bidsTestSuite) TestQueryBids() {
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	firstID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[2:], is(1, 1), c("debt", 50))
+	suite.Require().NoError(err)
+	secondID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, firstID, suite.Addrs[0], c("token2", 10)))
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, firstID, suite.Addrs[1], c("token2", 20)))
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, secondID, suite.Addrs[0], c("token2", 10)))
+
+	res, err := queryServer.AuctionBids(sdk.WrapSDKContext(suite.Ctx), &types.QueryAuctionBidsRequest{AuctionId: firstID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Bids, 2)
+	suite.Equal(suite.Addrs[0], res.Bids[0].Bidder)
+	suite.Equal(suite.Addrs[1], res.Bids[1].Bidder)
+
+	bidderRes, err := queryServer.BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: suite.Addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(bidderRes.ActiveAuctions, 1)
+	suite.Equal(secondID, bidderRes.ActiveAuctions[0].GetCachedValue().(types.Auction).GetID())
+	suite.Require().Len(bidderRes.OutbidAuctions, 1)
+	suite.Equal(firstID, bidderRes.OutbidAuctions[0].GetCachedValue().(types.Auction).GetID())
+
+	bidderRes, err = queryServer.BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: suite.Addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Len(bidderRes.ActiveAuctions, 1)
+	suite.Empty(bidderRes.OutbidAuctions)
+
+	_, err = queryServer.BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: "invalid"})
+	suite.Require().Error(err)
+}
+
+func attr(key, value string) abci.EventAttribute {
+	return abci.EventAttribute{Key: []byte(key), Value: []byte(value)}
+}
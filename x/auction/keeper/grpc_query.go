@@ -86,7 +86,7 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 		// True if empty owner, otherwise check if auction contains owner
 		ownerIsMatch := req.Owner == ""
 		if req.Owner != "" {
-			if cAuc, ok := result.(*types.CollateralAuction); ok {
+			if cAuc, ok := result.(types.LotReturnsAuction); ok {
 				for _, addr := range cAuc.GetLotReturns().Addresses {
 					if addr.String() == req.Owner {
 						ownerIsMatch = true
@@ -129,6 +129,116 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 	}, nil
 }
 
+// AuctionBids implements the Query/AuctionBids gRPC method
+func (s queryServer) AuctionBids(c context.Context, req *types.QueryAuctionBidsRequest) (*types.QueryAuctionBidsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bids := types.BidRecords{}
+	bidStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), append(types.BidKeyPrefix, types.Uint64ToBytes(req.AuctionId)...))
+
+	pageRes, err := query.Paginate(bidStore, req.Pagination, func(key []byte, value []byte) error {
+		var bid types.BidRecord
+		if err := s.keeper.cdc.Unmarshal(value, &bid); err != nil {
+			return err
+		}
+		bids = append(bids, bid)
+		return nil
+	})
+	if err != nil {
+		return &types.QueryAuctionBidsResponse{}, err
+	}
+
+	return &types.QueryAuctionBidsResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BidderAuctions implements the Query/BidderAuctions gRPC method
+func (s queryServer) BidderAuctions(c context.Context, req *types.QueryBidderAuctionsRequest) (*types.QueryBidderAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bidder: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var active, outbid []*codectypes.Any
+	var closed []uint64
+	s.keeper.IterateBidderAuctions(ctx, bidder, func(auctionID uint64) bool {
+		auction, found := s.keeper.GetAuction(ctx, auctionID)
+		if !found {
+			closed = append(closed, auctionID)
+			return false
+		}
+		var auctionAny *codectypes.Any
+		auctionAny, err = codectypes.NewAnyWithValue(auction)
+		if err != nil {
+			return true
+		}
+		if s.keeper.isActiveBidder(ctx, auction, bidder) {
+			active = append(active, auctionAny)
+		} else {
+			outbid = append(outbid, auctionAny)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBidderAuctionsResponse{
+		ActiveAuctions:   active,
+		OutbidAuctions:   outbid,
+		ClosedAuctionIds: closed,
+	}, nil
+}
+
+// AuctionStats implements the Query/AuctionStats gRPC method
+func (s queryServer) AuctionStats(c context.Context, req *types.QueryAuctionStatsRequest) (*types.QueryAuctionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if !req.EndTime.IsZero() && req.EndTime.Before(req.StartTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time is before start time")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats := types.AuctionStatsList{}
+	totals := types.AuctionStatsList{}
+	totalIndexes := make(map[string]int)
+	s.keeper.IterateAuctionStats(ctx, req.StartTime, req.EndTime, func(as types.AuctionStats) bool {
+		if req.Type != "" && as.AuctionType != req.Type {
+			return false
+		}
+		if req.LotDenom != "" && as.LotDenom != req.LotDenom {
+			return false
+		}
+		stats = append(stats, as)
+
+		// totals take the window start of the earliest window included
+		key := as.AuctionType + "/" + as.LotDenom
+		i, found := totalIndexes[key]
+		if !found {
+			i = len(totals)
+			totalIndexes[key] = i
+			totals = append(totals, types.NewAuctionStats(as.AuctionType, as.LotDenom, as.WindowStart))
+		}
+		totals[i] = totals[i].Add(as)
+		return false
+	})
+
+	return &types.QueryAuctionStatsResponse{
+		Stats:  stats,
+		Totals: totals,
+	}, nil
+}
+
 // NextAuctionID implements the gRPC service handler for querying x/auction next auction ID.
 func (s queryServer) NextAuctionID(ctx context.Context, req *types.QueryNextAuctionIDRequest) (*types.QueryNextAuctionIDResponse, error) {
 	if req == nil {
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+// Implements AuctionHooks interface
+var _ types.AuctionHooks = Keeper{}
+
+// AfterAuctionClosed - call hook if registered
+func (k Keeper) AfterAuctionClosed(ctx sdk.Context, auction types.Auction) {
+	if k.hooks != nil {
+		k.hooks.AfterAuctionClosed(ctx, auction)
+	}
+}
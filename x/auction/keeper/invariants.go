@@ -32,6 +32,10 @@ func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 			totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 			return false
 		})
+		// batch bids are held in escrow until their auction is settled
+		for _, bid := range k.GetAllBatchBids(ctx) {
+			totalAuctionCoins = totalAuctionCoins.Add(bid.Bid)
+		}
 
 		moduleAccCoins := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
 		broken := !moduleAccCoins.IsEqual(totalAuctionCoins)
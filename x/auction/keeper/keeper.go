@@ -21,6 +21,7 @@ type Keeper struct {
 	paramSubspace paramtypes.Subspace
 	bankKeeper    types.BankKeeper
 	accountKeeper types.AccountKeeper
+	hooks         types.AuctionHooks
 }
 
 // NewKeeper returns a new auction keeper.
@@ -37,9 +38,19 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, paramstore paramty
 		paramSubspace: paramstore,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		hooks:         nil,
 	}
 }
 
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.AuctionHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set auction hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 // MustUnmarshalAuction attempts to decode and return an Auction object from
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustUnmarshalAuction(bz []byte) types.Auction {
@@ -149,12 +160,15 @@ func (k Keeper) GetAuction(ctx sdk.Context, auctionID uint64) (types.Auction, bo
 	return k.MustUnmarshalAuction(bz), true
 }
 
-// DeleteAuction removes an auction from the store, and any indexes.
+// DeleteAuction removes an auction from the store, any indexes, and any bids held by it.
+// The auction's bid history is kept until the BidHistoryRetention param has passed.
 func (k Keeper) DeleteAuction(ctx sdk.Context, auctionID uint64) {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if found {
 		k.removeFromByTimeIndex(ctx, auction.GetEndTime(), auctionID)
 	}
+	k.ScheduleBidPruning(ctx, auctionID, ctx.BlockTime().Add(k.GetParams(ctx).BidHistoryRetention))
+	k.deleteBatchBids(ctx, auctionID)
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 	store.Delete(types.GetAuctionKey(auctionID))
@@ -41,3 +41,25 @@ func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*typ
 	)
 	return &types.MsgPlaceBidResponse{}, nil
 }
+
+func (k msgServer) PlaceBatchBid(goCtx context.Context, msg *types.MsgPlaceBatchBid) (*types.MsgPlaceBatchBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PlaceBatchBid(ctx, msg.AuctionId, bidder, msg.Lot, msg.Bid)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgPlaceBatchBidResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+// GetAuctionStats gets the stats of an auction type and lot denom for the window starting at windowStart from the store.
+func (k Keeper) GetAuctionStats(ctx sdk.Context, auctionType, lotDenom string, windowStart time.Time) (types.AuctionStats, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionStatsKeyPrefix)
+	bz := store.Get(types.GetAuctionStatsKey(windowStart, auctionType, lotDenom))
+	if bz == nil {
+		return types.AuctionStats{}, false
+	}
+	var stats types.AuctionStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetAuctionStats puts the stats of an auction type and lot denom for a window into the store.
+func (k Keeper) SetAuctionStats(ctx sdk.Context, stats types.AuctionStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionStatsKeyPrefix)
+	store.Set(types.GetAuctionStatsKey(stats.WindowStart, stats.AuctionType, stats.LotDenom), k.cdc.MustMarshal(&stats))
+}
+
+// IterateAuctionStats provides an iterator over the stats of windows starting in [start, end), ordered by window start.
+// A zero start or end leaves the range unbounded on that side. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAuctionStats(ctx sdk.Context, start, end time.Time, cb func(stats types.AuctionStats) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionStatsKeyPrefix)
+	var startBz, endBz []byte
+	if !start.IsZero() {
+		startBz = sdk.FormatTimeBytes(start)
+	}
+	if !end.IsZero() {
+		endBz = sdk.FormatTimeBytes(end)
+	}
+	iterator := store.Iterator(startBz, endBz)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.AuctionStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllAuctionStats returns the stats of all auction types, lot denoms and windows from the store.
+func (k Keeper) GetAllAuctionStats(ctx sdk.Context) (stats types.AuctionStatsList) {
+	k.IterateAuctionStats(ctx, time.Time{}, time.Time{}, func(as types.AuctionStats) bool {
+		stats = append(stats, as)
+		return false
+	})
+	return
+}
+
+// addAuctionStats adds the input stats to the stats of its auction type and lot denom for the current window.
+func (k Keeper) addAuctionStats(ctx sdk.Context, added types.AuctionStats) {
+	windowStart := types.StatsWindowStart(ctx.BlockTime())
+	stats, found := k.GetAuctionStats(ctx, added.AuctionType, added.LotDenom, windowStart)
+	if !found {
+		stats = types.NewAuctionStats(added.AuctionType, added.LotDenom, windowStart)
+	}
+	k.SetAuctionStats(ctx, stats.Add(added))
+}
+
+// recordAuctionStart records a new auction's lot and the debt it is to cover.
+func (k Keeper) recordAuctionStart(ctx sdk.Context, auction types.Auction, debt sdk.Coins) {
+	stats := types.NewAuctionStats(auction.GetType(), auction.GetLot().Denom, time.Time{})
+	stats.AuctionsStarted = 1
+	stats.LotAuctioned = sdk.NewCoins(auction.GetLot())
+	stats.DebtAuctioned = debt
+	k.addAuctionStats(ctx, stats)
+}
+
+// recordAuctionSale records lot sold to bidders and the bids raised for it.
+func (k Keeper) recordAuctionSale(ctx sdk.Context, auction types.Auction, lot, bid sdk.Coin) {
+	stats := types.NewAuctionStats(auction.GetType(), lot.Denom, time.Time{})
+	stats.LotSold = sdk.NewCoins(lot)
+	stats.BidRaised = sdk.NewCoins(bid)
+	k.addAuctionStats(ctx, stats)
+}
+
+// recordAuctionClose records a closed auction and the debt it left uncovered.
+// Auctions other than dutch auctions pay out their lot at close, so their final lot and bid are recorded as sold.
+func (k Keeper) recordAuctionClose(ctx sdk.Context, auction types.Auction) {
+	stats := types.NewAuctionStats(auction.GetType(), auction.GetLot().Denom, time.Time{})
+	stats.AuctionsClosed = 1
+
+	switch auc := auction.(type) {
+	case *types.DebtAuction:
+		stats.DebtUnrecovered = sdk.NewCoins(auc.CorrespondingDebt)
+	case *types.CollateralAuction:
+		stats.DebtUnrecovered = sdk.NewCoins(auc.CorrespondingDebt)
+	case *types.DutchAuction:
+		stats.DebtUnrecovered = sdk.NewCoins(auc.CorrespondingDebt)
+	case *types.BatchAuction:
+		// batch auctions are updated to their settled lot and bid when paid out
+		stats.DebtUnrecovered = sdk.NewCoins(auc.CorrespondingDebt)
+	}
+	// dutch auction sales are recorded as they are made
+	if _, isDutch := auction.(*types.DutchAuction); !isDutch && hasReceivedBids(auction) {
+		stats.LotSold = sdk.NewCoins(auction.GetLot())
+		stats.BidRaised = sdk.NewCoins(auction.GetBid())
+	}
+	k.addAuctionStats(ctx, stats)
+}
+
+// hasReceivedBids returns true if any bids have been placed on an auction
+func hasReceivedBids(auction types.Auction) bool {
+	switch auc := auction.(type) {
+	case *types.SurplusAuction:
+		return auc.HasReceivedBids
+	case *types.DebtAuction:
+		return auc.HasReceivedBids
+	case *types.CollateralAuction:
+		return auc.HasReceivedBids
+	case *types.DutchAuction:
+		return auc.HasReceivedBids
+	case *types.BatchAuction:
+		return auc.HasReceivedBids
+	default:
+		return false
+	}
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/auction/keeper"
+	"github.com/percosis-labs/fury/x/auction/testutil"
+	"github.com/percosis-labs/fury/x/auction/types"
+)
+
+type statsTestSuite struct {
+	testutil.Suite
+
+	windowStart time.Time
+}
+
+func (suite *statsTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+	suite.windowStart = time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.windowStart.Add(time.Hour))
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+}
+
+func TestStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(statsTestSuite))
+}
+
+func (suite *statsTestSuite) TestCollateralAuctionStats() {
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[1:], is(30, 20, 10), c("debt", 40))
+	suite.Require().NoError(err)
+
+	stats, found := suite.Keeper.GetAuctionStats(suite.Ctx, types.CollateralAuctionType, "token1", suite.windowStart)
+	suite.Require().True(found)
+	suite.Equal(uint64(1), stats.AuctionsStarted)
+	suite.Equal(uint64(0), stats.AuctionsClosed)
+	suite.Equal(cs(c("token1", 20)), stats.LotAuctioned)
+	suite.Equal(cs(c("debt", 40)), stats.DebtAuctioned)
+	suite.Empty(stats.LotSold)
+
+	// the auction closes in the next window with part of its debt uncovered
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 10)))
+	suite.Require().NoError(suite.Keeper.CloseAuction(suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration)), auctionID))
+
+	stats, found = suite.Keeper.GetAuctionStats(suite.Ctx, types.CollateralAuctionType, "token1", suite.windowStart.Add(types.StatsWindowDuration))
+	suite.Require().True(found)
+	suite.Equal(uint64(0), stats.AuctionsStarted)
+	suite.Equal(uint64(1), stats.AuctionsClosed)
+	suite.Equal(cs(c("token1", 20)), stats.LotSold)
+	suite.Equal(cs(c("token2", 10)), stats.BidRaised)
+	suite.Equal(cs(c("debt", 30)), stats.DebtUnrecovered)
+}
+
+func (suite *statsTestSuite) TestDutchAuctionStats() {
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), sdk.MustNewDecFromStr("2.0"), suite.Addrs[1:], is(30, 20, 10), c("debt", 40))
+	suite.Require().NoError(err)
+
+	// sales are recorded as they are made, at the reserve price of 1.6
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration / 2))
+	suite.Require().NoError(suite.Keeper.PlaceBid(ctx, auctionID, suite.Addrs[0], c("token1", 10)))
+
+	stats, found := suite.Keeper.GetAuctionStats(suite.Ctx, types.DutchAuctionType, "token1", suite.windowStart)
+	suite.Require().True(found)
+	suite.Equal(cs(c("token1", 10)), stats.LotSold)
+	suite.Equal(cs(c("token2", 16)), stats.BidRaised)
+
+	// closing the auction returns the unsold lot, which is not recorded as sold
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration))
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+
+	stats, found = suite.Keeper.GetAuctionStats(suite.Ctx, types.DutchAuctionType, "token1", suite.windowStart)
+	suite.Require().True(found)
+	suite.Equal(uint64(1), stats.AuctionsClosed)
+	suite.Equal(cs(c("token1", 10)), stats.LotSold)
+	suite.Equal(cs(c("debt", 24)), stats.DebtUnrecovered)
+}
+
+func (suite *statsTestSuite) TestQueryAuctionStats() {
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	_, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[1:], is(30, 20, 10), c("debt", 40))
+	suite.Require().NoError(err)
+
+	// start another collateral auction in the next window
+	nextWindow := suite.windowStart.Add(types.StatsWindowDuration)
+	suite.Ctx = suite.Ctx.WithBlockTime(nextWindow)
+	_, err = suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 10), c("token2", 50), suite.Addrs[1:], is(30, 20, 10), c("debt", 20))
+	suite.Require().NoError(err)
+
+	res, err := queryServer.AuctionStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryAuctionStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.Stats, 3)
+	suite.Len(res.Totals, 2)
+
+	res, err = queryServer.AuctionStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryAuctionStatsRequest{
+		Type: types.CollateralAuctionType,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 2)
+	suite.Equal(suite.windowStart, res.Stats[0].WindowStart)
+	suite.Equal(nextWindow, res.Stats[1].WindowStart)
+	suite.Require().Len(res.Totals, 1)
+	suite.Equal(suite.windowStart, res.Totals[0].WindowStart)
+	suite.Equal(uint64(2), res.Totals[0].AuctionsStarted)
+	suite.Equal(cs(c("token1", 30)), res.Totals[0].LotAuctioned)
+	suite.Equal(cs(c("debt", 60)), res.Totals[0].DebtAuctioned)
+
+	res, err = queryServer.AuctionStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryAuctionStatsRequest{
+		Type:      types.CollateralAuctionType,
+		LotDenom:  "token1",
+		StartTime: nextWindow,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Equal(cs(c("debt", 20)), res.Stats[0].DebtAuctioned)
+
+	res, err = queryServer.AuctionStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryAuctionStatsRequest{
+		EndTime: nextWindow,
+	})
+	suite.Require().NoError(err)
+	suite.Len(res.Stats, 2)
+
+	_, err = queryServer.AuctionStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryAuctionStatsRequest{
+		StartTime: nextWindow,
+		EndTime:   suite.windowStart,
+	})
+	suite.Require().Error(err)
+}
@@ -61,6 +61,16 @@ func (suite *Suite) SetupTest(numAddrs int) {
 		types.DefaultIncrement,
 		types.DefaultIncrement,
 		types.DefaultIncrement,
+		types.DefaultDutchStartPremium,
+		types.DefaultDutchAuctionDuration,
+		types.DefaultDutchDecayCurve,
+		types.DefaultDutchExponentialDecay,
+		types.DefaultBatchAuctionDuration,
+		types.DefaultDutchReserveRatio,
+		types.DefaultBidHistoryRetention,
+		types.DefaultBatchReserveRatio,
+		types.DefaultBatchMinLotFraction,
+		types.DefaultBatchMaxBids,
 	)
 
 	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
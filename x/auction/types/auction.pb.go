@@ -30,6 +30,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DecayCurve is the curve a dutch auction's price follows as it decreases over time.
+type DecayCurve int32
+
+const (
+	// DECAY_CURVE_UNSPECIFIED represents an unspecified or invalid decay curve.
+	DECAY_CURVE_UNSPECIFIED DecayCurve = 0
+	// DECAY_CURVE_LINEAR decreases the price linearly, reaching zero at the auction's end time.
+	DECAY_CURVE_LINEAR DecayCurve = 1
+	// DECAY_CURVE_EXPONENTIAL decreases the price by a fixed fraction every second.
+	DECAY_CURVE_EXPONENTIAL DecayCurve = 2
+)
+
+var DecayCurve_name = map[int32]string{
+	0: "DECAY_CURVE_UNSPECIFIED",
+	1: "DECAY_CURVE_LINEAR",
+	2: "DECAY_CURVE_EXPONENTIAL",
+}
+
+var DecayCurve_value = map[string]int32{
+	"DECAY_CURVE_UNSPECIFIED": 0,
+	"DECAY_CURVE_LINEAR":      1,
+	"DECAY_CURVE_EXPONENTIAL": 2,
+}
+
+func (x DecayCurve) String() string {
+	return proto.EnumName(DecayCurve_name, int32(x))
+}
+
+func (DecayCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a5874b5da241bea6, []int{0}
+}
+
 // BaseAuction defines common attributes of all auctions
 type BaseAuction struct {
 	ID              uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -200,6 +232,197 @@ func (m *CollateralAuction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CollateralAuction proto.InternalMessageInfo
 
+// DutchAuction is a descending price auction.
+// The price of the lot starts at a premium to the market price and decays over time, following the auction's decay curve.
+// Any account can buy all or part of the remaining lot at the current price until MaxBid has been raised.
+// Unsold Lot is sent to LotReturns, being divided among the addresses by weight.
+type DutchAuction struct {
+	BaseAuction       `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction"`
+	CorrespondingDebt types.Coin        `protobuf:"bytes,2,opt,name=corresponding_debt,json=correspondingDebt,proto3" json:"corresponding_debt"`
+	MaxBid            types.Coin        `protobuf:"bytes,3,opt,name=max_bid,json=maxBid,proto3" json:"max_bid"`
+	LotReturns        WeightedAddresses `protobuf:"bytes,4,opt,name=lot_returns,json=lotReturns,proto3" json:"lot_returns"`
+	// start_price is the price of one unit of lot, denominated in the bid denom, at start_time
+	StartPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=start_price,json=startPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_price"`
+	StartTime  time.Time                              `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	DecayCurve DecayCurve                             `protobuf:"varint,7,opt,name=decay_curve,json=decayCurve,proto3,enum=fury.auction.v1beta1.DecayCurve" json:"decay_curve,omitempty"`
+	// exponential_decay is the fraction of the price lost each second when using an exponential decay curve
+	ExponentialDecay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=exponential_decay,json=exponentialDecay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exponential_decay"`
+	// reserve_price is the lowest price of one unit of lot, the price does not decay below it
+	ReservePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=reserve_price,json=reservePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_price"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5874b5da241bea6, []int{4}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
+// BatchAuction is a uniform price auction of a single large lot.
+// Bidders bid for a fraction of the lot, escrowing the most they will pay for it, until the auction ends.
+// At settlement the highest priced bids are filled until MaxBid is raised or the lot runs out, and every filled bid
+// pays the same clearing price per unit of lot.
+// Unsold Lot is sent to LotReturns, being divided among the addresses by weight.
+type BatchAuction struct {
+	BaseAuction       `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction"`
+	CorrespondingDebt types.Coin        `protobuf:"bytes,2,opt,name=corresponding_debt,json=correspondingDebt,proto3" json:"corresponding_debt"`
+	MaxBid            types.Coin        `protobuf:"bytes,3,opt,name=max_bid,json=maxBid,proto3" json:"max_bid"`
+	LotReturns        WeightedAddresses `protobuf:"bytes,4,opt,name=lot_returns,json=lotReturns,proto3" json:"lot_returns"`
+	// bid_count is the number of bids held by the auction, the bids are stored separately
+	BidCount uint64 `protobuf:"varint,6,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	// reserve_price is the lowest price of one unit of lot that bids can be placed and filled at
+	ReservePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=reserve_price,json=reservePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_price"`
+}
+
+func (m *BatchAuction) Reset()         { *m = BatchAuction{} }
+func (m *BatchAuction) String() string { return proto.CompactTextString(m) }
+func (*BatchAuction) ProtoMessage()    {}
+func (*BatchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5874b5da241bea6, []int{5}
+}
+func (m *BatchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAuction.Merge(m, src)
+}
+func (m *BatchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAuction proto.InternalMessageInfo
+
+// BatchBid is a bid for part of the lot of a batch auction.
+type BatchBid struct {
+	Bidder github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=bidder,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bidder,omitempty"`
+	// lot is the amount of lot wanted
+	Lot types.Coin `protobuf:"bytes,2,opt,name=lot,proto3" json:"lot"`
+	// bid is the most the bidder will pay for the lot, which is held in escrow until settlement
+	Bid       types.Coin `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid"`
+	AuctionID uint64     `protobuf:"varint,4,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *BatchBid) Reset()         { *m = BatchBid{} }
+func (m *BatchBid) String() string { return proto.CompactTextString(m) }
+func (*BatchBid) ProtoMessage()    {}
+func (*BatchBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5874b5da241bea6, []int{6}
+}
+func (m *BatchBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchBid.Merge(m, src)
+}
+func (m *BatchBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchBid proto.InternalMessageInfo
+
+// BidRecord records a bid placed on an auction.
+type BidRecord struct {
+	AuctionID uint64                                        `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=bidder,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bidder,omitempty"`
+	// bid is the auction's bid after the bid was placed, or the payment made for a dutch auction purchase
+	Bid types.Coin `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid"`
+	// lot is the auction's lot after the bid was placed, or the lot bought by a dutch auction purchase
+	Lot    types.Coin `protobuf:"bytes,4,opt,name=lot,proto3" json:"lot"`
+	Time   time.Time  `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	Height int64      `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// refund is the bid returned to the bidder when another bidder replaced their bid, zero otherwise
+	Refund types.Coin `protobuf:"bytes,7,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *BidRecord) Reset()         { *m = BidRecord{} }
+func (m *BidRecord) String() string { return proto.CompactTextString(m) }
+func (*BidRecord) ProtoMessage()    {}
+func (*BidRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5874b5da241bea6, []int{7}
+}
+func (m *BidRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidRecord.Merge(m, src)
+}
+func (m *BidRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidRecord proto.InternalMessageInfo
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,rep,name=addresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"addresses,omitempty"`
@@ -210,7 +433,7 @@ func (m *WeightedAddresses) Reset()         { *m = WeightedAddresses{} }
 func (m *WeightedAddresses) String() string { return proto.CompactTextString(m) }
 func (*WeightedAddresses) ProtoMessage()    {}
 func (*WeightedAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5874b5da241bea6, []int{4}
+	return fileDescriptor_a5874b5da241bea6, []int{8}
 }
 func (m *WeightedAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,10 +463,15 @@ func (m *WeightedAddresses) XXX_DiscardUnknown() {
 var xxx_messageInfo_WeightedAddresses proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("fury.auction.v1beta1.DecayCurve", DecayCurve_name, DecayCurve_value)
 	proto.RegisterType((*BaseAuction)(nil), "fury.auction.v1beta1.BaseAuction")
 	proto.RegisterType((*SurplusAuction)(nil), "fury.auction.v1beta1.SurplusAuction")
 	proto.RegisterType((*DebtAuction)(nil), "fury.auction.v1beta1.DebtAuction")
 	proto.RegisterType((*CollateralAuction)(nil), "fury.auction.v1beta1.CollateralAuction")
+	proto.RegisterType((*DutchAuction)(nil), "fury.auction.v1beta1.DutchAuction")
+	proto.RegisterType((*BatchAuction)(nil), "fury.auction.v1beta1.BatchAuction")
+	proto.RegisterType((*BatchBid)(nil), "fury.auction.v1beta1.BatchBid")
+	proto.RegisterType((*BidRecord)(nil), "fury.auction.v1beta1.BidRecord")
 	proto.RegisterType((*WeightedAddresses)(nil), "fury.auction.v1beta1.WeightedAddresses")
 }
 
@@ -252,49 +480,72 @@ func init() {
 }
 
 var fileDescriptor_a5874b5da241bea6 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x24, 0x35, 0x49, 0x67, 0x8b, 0x92, 0xb1, 0xc8, 0xb6, 0xc8, 0x26, 0xf6, 0xa0, 0x41,
-	0xc8, 0x2e, 0xad, 0x17, 0xf1, 0x22, 0xdd, 0x56, 0x69, 0x11, 0x7a, 0x58, 0x05, 0xc1, 0xcb, 0x3a,
-	0xbb, 0x33, 0xdd, 0x0c, 0xee, 0xee, 0x84, 0x9d, 0xd9, 0x9a, 0xfe, 0x8b, 0xfe, 0x98, 0x9e, 0xbc,
-	0x0b, 0xa5, 0x20, 0x14, 0x4f, 0xe2, 0x21, 0x6a, 0xfa, 0x2f, 0x3c, 0xc9, 0xec, 0xce, 0xb6, 0x0d,
-	0xf6, 0x10, 0x41, 0x0f, 0x9e, 0x92, 0xf7, 0xed, 0xbc, 0xef, 0xfb, 0xde, 0x9b, 0xf7, 0x06, 0xae,
-	0xed, 0xe7, 0xd9, 0xa1, 0x83, 0xf3, 0x50, 0x32, 0x9e, 0x3a, 0x07, 0xeb, 0x01, 0x95, 0x78, 0xbd,
-	0x8a, 0xed, 0x51, 0xc6, 0x25, 0x47, 0xcb, 0xea, 0x8c, 0x5d, 0x61, 0xfa, 0xcc, 0xaa, 0x15, 0x72,
-	0x91, 0x70, 0xe1, 0x04, 0x58, 0xd0, 0x8b, 0xc4, 0x90, 0x33, 0x9d, 0xb5, 0xba, 0x52, 0x7e, 0xf7,
-	0x8b, 0xc8, 0x29, 0x03, 0xfd, 0x69, 0x39, 0xe2, 0x11, 0x2f, 0x71, 0xf5, 0x4f, 0xa3, 0xdd, 0x88,
-	0xf3, 0x28, 0xa6, 0x4e, 0x11, 0x05, 0xf9, 0xbe, 0x23, 0x59, 0x42, 0x85, 0xc4, 0xc9, 0xa8, 0x3c,
-	0xb0, 0xf6, 0xa9, 0x01, 0x0d, 0x17, 0x0b, 0xba, 0x59, 0x3a, 0x41, 0x77, 0x60, 0x9d, 0x11, 0x13,
-	0xf4, 0x40, 0x7f, 0xc1, 0x6d, 0x4e, 0x27, 0xdd, 0xfa, 0xee, 0xb6, 0x57, 0x67, 0x04, 0xdd, 0x85,
-	0x8b, 0x2c, 0x65, 0x92, 0x61, 0xc9, 0x33, 0xb3, 0xde, 0x03, 0xfd, 0x45, 0xef, 0x12, 0x40, 0xeb,
-	0xb0, 0x11, 0x73, 0x69, 0x36, 0x7a, 0xa0, 0x6f, 0x6c, 0xac, 0xd8, 0xda, 0x98, 0xaa, 0xa2, 0x2a,
-	0xcd, 0xde, 0xe2, 0x2c, 0x75, 0x17, 0x4e, 0x26, 0xdd, 0x9a, 0xa7, 0xce, 0xa2, 0xb7, 0xb0, 0x19,
-	0x30, 0x42, 0x68, 0x66, 0x2e, 0xf4, 0x40, 0x7f, 0xc9, 0xdd, 0xf9, 0x39, 0xe9, 0x0e, 0x22, 0x26,
-	0x87, 0x79, 0x60, 0x87, 0x3c, 0xd1, 0xc5, 0xe9, 0x9f, 0x81, 0x20, 0xef, 0x1c, 0x79, 0x38, 0xa2,
-	0xc2, 0xde, 0x0c, 0xc3, 0x4d, 0x42, 0x32, 0x2a, 0xc4, 0xe7, 0xe3, 0xc1, 0x6d, 0xad, 0xa4, 0x11,
-	0xf7, 0x50, 0x52, 0xe1, 0x69, 0x5e, 0x65, 0x2a, 0x60, 0xc4, 0xbc, 0x31, 0xa7, 0xa9, 0x80, 0x11,
-	0xf4, 0x10, 0x76, 0x86, 0x58, 0xf8, 0x19, 0x0d, 0x29, 0x3b, 0xa0, 0xc4, 0x0f, 0x18, 0x11, 0x66,
-	0xb3, 0x07, 0xfa, 0x6d, 0xef, 0xd6, 0x10, 0x0b, 0x4f, 0xe3, 0x2e, 0x23, 0x02, 0x3d, 0x85, 0x6d,
-	0x9a, 0x12, 0x5f, 0x35, 0xd4, 0x6c, 0x15, 0x1a, 0xab, 0x76, 0xd9, 0x6d, 0xbb, 0xea, 0xb6, 0xfd,
-	0xaa, 0xea, 0xb6, 0xdb, 0x56, 0x22, 0x47, 0xdf, 0xba, 0xc0, 0x6b, 0xd1, 0x94, 0x28, 0x1c, 0x3d,
-	0x87, 0x4b, 0x09, 0x1e, 0xfb, 0x17, 0x24, 0xed, 0x3f, 0x20, 0x81, 0x09, 0x1e, 0x3f, 0x2b, 0x79,
-	0x9e, 0x18, 0xa7, 0xc7, 0x83, 0x96, 0xbe, 0xbf, 0xb5, 0x04, 0xde, 0x7c, 0x99, 0x67, 0xa3, 0x38,
-	0x17, 0xd5, 0x8d, 0xee, 0xc1, 0x25, 0x55, 0xb3, 0xaf, 0x67, 0xad, 0xb8, 0x5b, 0x63, 0xe3, 0x9e,
-	0x7d, 0xdd, 0x00, 0xda, 0x57, 0x46, 0xa1, 0x54, 0x3b, 0x9b, 0x74, 0x81, 0x67, 0x04, 0x97, 0xf0,
-	0xac, 0xdc, 0x07, 0x00, 0x8d, 0x6d, 0x1a, 0xc8, 0x7f, 0x24, 0x86, 0xf6, 0x20, 0x0a, 0x79, 0x96,
-	0x51, 0x31, 0xe2, 0x29, 0x61, 0x69, 0xe4, 0x13, 0x1a, 0xc8, 0x62, 0xfe, 0xe6, 0xb8, 0xd2, 0xce,
-	0x4c, 0xaa, 0xb2, 0x39, 0x6b, 0xfe, 0xb4, 0x0e, 0x3b, 0x5b, 0x3c, 0x8e, 0xb1, 0xa4, 0x19, 0x8e,
-	0xff, 0x93, 0x12, 0xd0, 0x63, 0xd8, 0x52, 0x63, 0xa3, 0x46, 0x7b, 0xce, 0x7d, 0x6b, 0x26, 0x78,
-	0xec, 0x32, 0x82, 0xf6, 0xa0, 0x11, 0x73, 0xe9, 0x67, 0x54, 0xe6, 0x59, 0x2a, 0x8a, 0xbd, 0x33,
-	0x36, 0x1e, 0x5c, 0x5f, 0xd8, 0x6b, 0xca, 0xa2, 0xa1, 0xa4, 0x44, 0x6f, 0x16, 0x15, 0x9a, 0x0b,
-	0xc6, 0x5c, 0x7a, 0x25, 0xc1, 0x6c, 0x33, 0x3f, 0x02, 0xd8, 0xf9, 0x2d, 0x09, 0xed, 0xc3, 0x45,
-	0x5c, 0x05, 0x26, 0xe8, 0x35, 0xfe, 0xea, 0xa2, 0x5f, 0x52, 0xa3, 0x1d, 0xd8, 0x7a, 0x5f, 0x88,
-	0x0b, 0xb3, 0x5e, 0xa8, 0xd8, 0xca, 0xed, 0xd7, 0x49, 0xf7, 0xfe, 0x1c, 0x4a, 0xbb, 0xa9, 0xf4,
-	0xaa, 0x74, 0xf7, 0xc5, 0xc9, 0x0f, 0xab, 0x76, 0x32, 0xb5, 0xc0, 0xd9, 0xd4, 0x02, 0xdf, 0xa7,
-	0x16, 0x38, 0x3a, 0xb7, 0x6a, 0x67, 0xe7, 0x56, 0xed, 0xcb, 0xb9, 0x55, 0x7b, 0x73, 0xd5, 0xf8,
-	0x88, 0x66, 0x21, 0x17, 0x4c, 0x0c, 0x62, 0x1c, 0x08, 0xa7, 0x78, 0xf3, 0xc7, 0x17, 0xaf, 0x7e,
-	0xc1, 0x1c, 0x34, 0x8b, 0x25, 0x7e, 0xf4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x45, 0x95, 0x2c,
-	0x12, 0x06, 0x00, 0x00,
+	// 1033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x3d, 0x6f, 0x1b, 0x47,
+	0x13, 0xe6, 0x91, 0x14, 0x3f, 0x86, 0xb4, 0x5f, 0x69, 0x5f, 0x43, 0x39, 0xcb, 0x01, 0xc9, 0xb0,
+	0x48, 0x04, 0x23, 0x3a, 0x42, 0x4a, 0x11, 0x23, 0x4d, 0xc0, 0x23, 0x69, 0x98, 0x89, 0x41, 0x0b,
+	0x27, 0x3b, 0x9f, 0xc5, 0xe5, 0xee, 0x76, 0x45, 0x2e, 0x72, 0xbc, 0x25, 0x6e, 0xf7, 0x14, 0xa9,
+	0x4b, 0x99, 0x22, 0x85, 0xff, 0x43, 0xfe, 0x82, 0xab, 0xb4, 0x41, 0x00, 0xc1, 0x40, 0x00, 0x21,
+	0x55, 0x90, 0x82, 0x49, 0xa4, 0x7f, 0x91, 0x2a, 0xd8, 0xbd, 0xa5, 0x3e, 0x6c, 0x21, 0x20, 0x05,
+	0xab, 0x08, 0xe0, 0x4a, 0x9c, 0xd9, 0x99, 0x67, 0x66, 0x9e, 0x99, 0x1d, 0xed, 0x41, 0x73, 0x37,
+	0x89, 0x0f, 0x5a, 0x5e, 0x12, 0x08, 0xca, 0xa2, 0xd6, 0xde, 0xa6, 0x4f, 0x84, 0xb7, 0x39, 0x93,
+	0xad, 0x49, 0xcc, 0x04, 0x43, 0xb7, 0xa4, 0x8d, 0x35, 0xd3, 0x69, 0x9b, 0xb5, 0x5a, 0xc0, 0xf8,
+	0x98, 0xf1, 0x96, 0xef, 0x71, 0x72, 0xea, 0x18, 0x30, 0xaa, 0xbd, 0xd6, 0x6e, 0xa7, 0xe7, 0xae,
+	0x92, 0x5a, 0xa9, 0xa0, 0x8f, 0x6e, 0x0d, 0xd9, 0x90, 0xa5, 0x7a, 0xf9, 0x4b, 0x6b, 0xeb, 0x43,
+	0xc6, 0x86, 0x21, 0x69, 0x29, 0xc9, 0x4f, 0x76, 0x5b, 0x82, 0x8e, 0x09, 0x17, 0xde, 0x78, 0x92,
+	0x1a, 0x34, 0x7f, 0xc9, 0x41, 0xc5, 0xf6, 0x38, 0x69, 0xa7, 0x99, 0xa0, 0x55, 0xc8, 0x52, 0x6c,
+	0x1a, 0x0d, 0x63, 0x3d, 0x6f, 0x17, 0x8e, 0xa7, 0xf5, 0x6c, 0xbf, 0xeb, 0x64, 0x29, 0x46, 0x6f,
+	0x42, 0x99, 0x46, 0x54, 0x50, 0x4f, 0xb0, 0xd8, 0xcc, 0x36, 0x8c, 0xf5, 0xb2, 0x73, 0xa6, 0x40,
+	0x9b, 0x90, 0x0b, 0x99, 0x30, 0x73, 0x0d, 0x63, 0xbd, 0xb2, 0x75, 0xdb, 0xd2, 0x89, 0xc9, 0x2a,
+	0x66, 0xa5, 0x59, 0x1d, 0x46, 0x23, 0x3b, 0x7f, 0x38, 0xad, 0x67, 0x1c, 0x69, 0x8b, 0xbe, 0x82,
+	0x82, 0x4f, 0x31, 0x26, 0xb1, 0x99, 0x6f, 0x18, 0xeb, 0x55, 0xfb, 0xc1, 0xdf, 0xd3, 0xfa, 0xc6,
+	0x90, 0x8a, 0x51, 0xe2, 0x5b, 0x01, 0x1b, 0xeb, 0xe2, 0xf4, 0x9f, 0x0d, 0x8e, 0xbf, 0x6e, 0x89,
+	0x83, 0x09, 0xe1, 0x56, 0x3b, 0x08, 0xda, 0x18, 0xc7, 0x84, 0xf3, 0x5f, 0x9f, 0x6d, 0xfc, 0x5f,
+	0x47, 0xd2, 0x1a, 0xfb, 0x40, 0x10, 0xee, 0x68, 0x5c, 0x99, 0x94, 0x4f, 0xb1, 0xb9, 0x34, 0x67,
+	0x52, 0x3e, 0xc5, 0xe8, 0x2e, 0xac, 0x8c, 0x3c, 0xee, 0xc6, 0x24, 0x20, 0x74, 0x8f, 0x60, 0xd7,
+	0xa7, 0x98, 0x9b, 0x85, 0x86, 0xb1, 0x5e, 0x72, 0xfe, 0x37, 0xf2, 0xb8, 0xa3, 0xf5, 0x36, 0xc5,
+	0x1c, 0x7d, 0x08, 0x25, 0x12, 0x61, 0x57, 0x12, 0x6a, 0x16, 0x55, 0x8c, 0x35, 0x2b, 0x65, 0xdb,
+	0x9a, 0xb1, 0x6d, 0x3d, 0x9e, 0xb1, 0x6d, 0x97, 0x64, 0x90, 0xa7, 0x7f, 0xd4, 0x0d, 0xa7, 0x48,
+	0x22, 0x2c, 0xf5, 0xe8, 0x3e, 0x54, 0xc7, 0xde, 0xbe, 0x7b, 0x0a, 0x52, 0x5a, 0x00, 0x04, 0xc6,
+	0xde, 0x7e, 0x2f, 0xc5, 0xf9, 0xa0, 0xf2, 0xfc, 0xd9, 0x46, 0x51, 0xf7, 0xaf, 0x39, 0x86, 0x9b,
+	0x3b, 0x49, 0x3c, 0x09, 0x13, 0x3e, 0xeb, 0xe8, 0x00, 0xaa, 0xb2, 0x66, 0x57, 0xcf, 0x9a, 0xea,
+	0x6d, 0x65, 0xeb, 0x2d, 0xeb, 0xb2, 0x01, 0xb4, 0xce, 0x8d, 0x42, 0x1a, 0xed, 0x68, 0x5a, 0x37,
+	0x9c, 0x8a, 0x7f, 0xa6, 0xbe, 0x18, 0xee, 0x47, 0x03, 0x2a, 0x5d, 0xe2, 0x8b, 0x6b, 0x0a, 0x86,
+	0x06, 0x80, 0x02, 0x16, 0xc7, 0x84, 0x4f, 0x58, 0x84, 0x69, 0x34, 0x74, 0x31, 0xf1, 0x85, 0x99,
+	0x9d, 0xaf, 0xa5, 0x2b, 0x17, 0x5c, 0x65, 0x9a, 0x17, 0x93, 0x7f, 0x9e, 0x85, 0x95, 0x0e, 0x0b,
+	0x43, 0x4f, 0x90, 0xd8, 0x0b, 0xff, 0x23, 0x25, 0xa0, 0x7b, 0x50, 0x94, 0x63, 0x23, 0x47, 0x7b,
+	0xce, 0xfb, 0x56, 0x18, 0x7b, 0xfb, 0x36, 0xc5, 0x68, 0x00, 0x95, 0x90, 0x09, 0x37, 0x26, 0x22,
+	0x89, 0x23, 0xae, 0xee, 0x5d, 0x65, 0xeb, 0x9d, 0xcb, 0x0b, 0xfb, 0x94, 0xd0, 0xe1, 0x48, 0x10,
+	0xac, 0x6f, 0x16, 0xe1, 0x1a, 0x0b, 0x42, 0x26, 0x9c, 0x14, 0xe0, 0x22, 0x99, 0x47, 0x4b, 0x50,
+	0xed, 0x26, 0x22, 0x18, 0xbd, 0xe6, 0x71, 0x41, 0x1e, 0xd1, 0x23, 0xa8, 0x70, 0xe1, 0xc5, 0xc2,
+	0x9d, 0xc4, 0x34, 0x20, 0x6a, 0x61, 0x55, 0x6d, 0x4b, 0x9a, 0xfd, 0x3e, 0xad, 0xbf, 0x3d, 0xc7,
+	0x4e, 0xec, 0x92, 0xc0, 0x01, 0x05, 0xb1, 0x2d, 0x11, 0x50, 0x07, 0x52, 0x29, 0xdd, 0x2b, 0x85,
+	0x05, 0xf6, 0x4a, 0x59, 0xf9, 0xc9, 0x13, 0xd4, 0x86, 0x0a, 0x26, 0x81, 0x77, 0xe0, 0x06, 0x49,
+	0xbc, 0x97, 0xae, 0xb8, 0x9b, 0x5b, 0x8d, 0xcb, 0xab, 0xec, 0x4a, 0xc3, 0x8e, 0xb4, 0x73, 0x00,
+	0x9f, 0xfe, 0x46, 0x5f, 0xc2, 0x0a, 0xd9, 0x9f, 0xb0, 0x88, 0x44, 0x82, 0x7a, 0xa1, 0xab, 0x4e,
+	0xcc, 0xd2, 0x95, 0xca, 0x5b, 0x3e, 0x07, 0xa4, 0xa2, 0xa1, 0x1d, 0xb8, 0x11, 0x13, 0x4e, 0xe2,
+	0x3d, 0xa2, 0x79, 0x2b, 0x5f, 0x09, 0xb8, 0xaa, 0x41, 0x14, 0x73, 0x17, 0x47, 0xfa, 0xa7, 0x1c,
+	0x54, 0x6d, 0xef, 0xf5, 0x48, 0x5f, 0x61, 0xa4, 0xef, 0x40, 0xd9, 0xa7, 0xd8, 0x0d, 0x58, 0x12,
+	0x09, 0x35, 0x80, 0x79, 0xa7, 0xe4, 0x53, 0xdc, 0x91, 0xf2, 0xcb, 0x9d, 0x2b, 0xbe, 0xe2, 0xce,
+	0x7d, 0x94, 0x2f, 0x2d, 0x2d, 0x17, 0x9c, 0xbc, 0xfc, 0xf7, 0xdd, 0xfc, 0x36, 0x0b, 0x25, 0xd5,
+	0x45, 0x59, 0xe7, 0xd9, 0xab, 0xc3, 0xb8, 0xbe, 0x57, 0x47, 0xc8, 0xe6, 0x6e, 0xa2, 0xb4, 0x9d,
+	0x3d, 0x54, 0x72, 0x0b, 0x3c, 0x54, 0xde, 0x05, 0xd0, 0x6d, 0x71, 0x29, 0x56, 0xed, 0xca, 0xdb,
+	0x37, 0x8e, 0xa7, 0xf5, 0xb2, 0x66, 0xa0, 0xdf, 0x75, 0xca, 0xda, 0xa0, 0x8f, 0x9b, 0xdf, 0xe7,
+	0xa0, 0x6c, 0x53, 0xec, 0x90, 0x80, 0xc5, 0x2f, 0xfa, 0x1a, 0xff, 0xee, 0x7b, 0x8e, 0xb1, 0xec,
+	0xf5, 0xbe, 0xd3, 0x16, 0x29, 0x5f, 0x93, 0x9c, 0x5f, 0x80, 0xe4, 0x7b, 0x90, 0x57, 0xdb, 0x70,
+	0x69, 0x81, 0x6d, 0xa8, 0x3c, 0xd0, 0x2a, 0x14, 0x46, 0x6a, 0xe4, 0xd5, 0x20, 0xe7, 0x1c, 0x2d,
+	0xa1, 0xf7, 0xa1, 0x10, 0x93, 0xdd, 0x24, 0xc2, 0x66, 0x71, 0xbe, 0x3c, 0xb4, 0x79, 0xf3, 0x67,
+	0x03, 0x56, 0x5e, 0xba, 0x44, 0x68, 0x17, 0xca, 0xde, 0x4c, 0x30, 0x8d, 0x46, 0xee, 0x95, 0x72,
+	0x7d, 0x06, 0x8d, 0x1e, 0x40, 0xf1, 0x1b, 0x15, 0x9c, 0x9b, 0xd9, 0x46, 0x6e, 0xc1, 0x7b, 0xd7,
+	0x8f, 0x84, 0x33, 0x73, 0xbf, 0x8b, 0x01, 0xce, 0x16, 0x3f, 0xba, 0x03, 0x6f, 0x74, 0x7b, 0x9d,
+	0xf6, 0xe7, 0x6e, 0xe7, 0x89, 0xf3, 0x49, 0xcf, 0x7d, 0x32, 0xd8, 0xd9, 0xee, 0x75, 0xfa, 0xf7,
+	0xfb, 0xbd, 0xee, 0x72, 0x06, 0xad, 0x02, 0x3a, 0x7f, 0xf8, 0xb0, 0x3f, 0xe8, 0xb5, 0x9d, 0x65,
+	0xe3, 0x45, 0xa7, 0xde, 0x67, 0xdb, 0x8f, 0x06, 0xbd, 0xc1, 0xe3, 0x7e, 0xfb, 0xe1, 0x72, 0x76,
+	0x2d, 0xff, 0xdd, 0x0f, 0xb5, 0x8c, 0xfd, 0xf1, 0xe1, 0x5f, 0xb5, 0xcc, 0xe1, 0x71, 0xcd, 0x38,
+	0x3a, 0xae, 0x19, 0x7f, 0x1e, 0xd7, 0x8c, 0xa7, 0x27, 0xb5, 0xcc, 0xd1, 0x49, 0x2d, 0xf3, 0xdb,
+	0x49, 0x2d, 0xf3, 0xc5, 0x79, 0x7a, 0x26, 0x24, 0x0e, 0x18, 0xa7, 0x7c, 0x23, 0xf4, 0x7c, 0xde,
+	0x52, 0x1f, 0x61, 0xfb, 0xa7, 0x9f, 0x61, 0x2a, 0x7f, 0xbf, 0xa0, 0xfa, 0xfd, 0xde, 0x3f, 0x03,
+	0x00, 0x23, 0xff, 0x50, 0x8a, 0xa3, 0x0d, 0x00, 0x00,
 }
 
 func (m *BaseAuction) Marshal() (dAtA []byte, err error) {
@@ -524,7 +775,7 @@ func (m *CollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WeightedAddresses) Marshal() (dAtA []byte, err error) {
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -534,126 +785,540 @@ func (m *WeightedAddresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WeightedAddresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WeightedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Weights) > 0 {
-		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Weights[iNdEx].Size()
-				i -= size
-				if _, err := m.Weights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintAuction(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.ReservePrice.Size()
+		i -= size
+		if _, err := m.ReservePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintAuction(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ExponentialDecay.Size()
+		i -= size
+		if _, err := m.ExponentialDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	i--
+	dAtA[i] = 0x42
+	if m.DecayCurve != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.DecayCurve))
+		i--
+		dAtA[i] = 0x38
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BaseAuction) Size() (n int) {
-	if m == nil {
-		return 0
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAuction(uint64(m.ID))
+	i -= n12
+	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LotReturns.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = m.Lot.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MaxBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = m.Bid.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	if m.HasReceivedBids {
-		n += 2
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CorrespondingDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovAuction(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.MaxEndTime)
-	n += 1 + l + sovAuction(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SurplusAuction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BaseAuction.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DebtAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BaseAuction.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	l = m.CorrespondingDebt.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	return n
+func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CollateralAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BaseAuction.Size()
+	{
+		size := m.ReservePrice.Size()
+		i -= size
+		if _, err := m.ReservePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BidCount != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.BidCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.LotReturns.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MaxBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CorrespondingDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionID != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionID))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintAuction(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionID != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Weights[iNdEx].Size()
+				i -= size
+				if _, err := m.Weights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAuction(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAuction(uint64(m.ID))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Lot.Size()
 	n += 1 + l + sovAuction(uint64(l))
-	l = m.CorrespondingDebt.Size()
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Bid.Size()
 	n += 1 + l + sovAuction(uint64(l))
-	l = m.MaxBid.Size()
+	if m.HasReceivedBids {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
 	n += 1 + l + sovAuction(uint64(l))
-	l = m.LotReturns.Size()
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.MaxEndTime)
 	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
-func (m *WeightedAddresses) Size() (n int) {
+func (m *SurplusAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *DebtAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CorrespondingDebt.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *CollateralAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CorrespondingDebt.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.MaxBid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.LotReturns.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CorrespondingDebt.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.MaxBid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.LotReturns.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.DecayCurve != 0 {
+		n += 1 + sovAuction(uint64(m.DecayCurve))
+	}
+	l = m.ExponentialDecay.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *BatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CorrespondingDebt.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.MaxBid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.LotReturns.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.BidCount != 0 {
+		n += 1 + sovAuction(uint64(m.BidCount))
+	}
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *BatchBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Lot.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Bid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.AuctionID != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionID))
+	}
+	return n
+}
+
+func (m *BidRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionID != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionID))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Lot.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAuction(uint64(m.Height))
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *WeightedAddresses) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -671,16 +1336,1017 @@ func (m *WeightedAddresses) Size() (n int) {
 			n += 1 + l + sovAuction(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
+	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReceivedBids", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasReceivedBids = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.MaxEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SurplusAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SurplusAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayCurve", wireType)
+			}
+			m.DecayCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayCurve |= DecayCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentialDecay", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExponentialDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovAuction(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuction(x uint64) (n int) {
-	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BaseAuction) Unmarshal(dAtA []byte) error {
+func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,36 +2369,17 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -742,27 +2389,28 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -789,15 +2437,15 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -807,29 +2455,28 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bidder == nil {
-				m.Bidder = []byte{}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -856,15 +2503,15 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReceivedBids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCount", wireType)
 			}
-			var v int
+			m.BidCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -874,50 +2521,16 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BidCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasReceivedBids = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -927,22 +2540,22 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.MaxEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -967,7 +2580,7 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
+func (m *BatchBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -990,17 +2603,17 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SurplusAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SurplusAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1010,78 +2623,29 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DebtAuction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1108,13 +2672,13 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1141,10 +2705,29 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionID", wireType)
+			}
+			m.AuctionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1166,7 +2749,7 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+func (m *BidRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,15 +2772,68 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionID", wireType)
+			}
+			m.AuctionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1224,13 +2860,13 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1257,13 +2893,13 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +2926,32 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +2978,7 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,8 +17,14 @@ const (
 	CollateralAuctionType = "collateral"
 	SurplusAuctionType    = "surplus"
 	DebtAuctionType       = "debt"
+	DutchAuctionType      = "dutch"
+	BatchAuctionType      = "batch"
 	ForwardAuctionPhase   = "forward"
 	ReverseAuctionPhase   = "reverse"
+	// DescendingAuctionPhase is the only phase of a dutch auction, where the price decreases until the lot is sold
+	DescendingAuctionPhase = "descending"
+	// UniformPriceAuctionPhase is the only phase of a batch auction, where bids are collected and settled at a single price
+	UniformPriceAuctionPhase = "uniform"
 )
 
 // DistantFuture is a very large time value to use as initial the ending time for auctions.
@@ -32,6 +39,10 @@ var (
 	_ GenesisAuction = &DebtAuction{}
 	_ Auction        = &CollateralAuction{}
 	_ GenesisAuction = &CollateralAuction{}
+	_ Auction        = &DutchAuction{}
+	_ GenesisAuction = &DutchAuction{}
+	_ Auction        = &BatchAuction{}
+	_ GenesisAuction = &BatchAuction{}
 )
 
 // --------------- Shared auction functionality ---------------
@@ -54,6 +65,12 @@ type Auction interface {
 	GetPhase() string
 }
 
+// LotReturnsAuction is implemented by auctions that return unsold lot to a set of weighted addresses.
+type LotReturnsAuction interface {
+	Auction
+	GetLotReturns() WeightedAddresses
+}
+
 // --------------- BaseAuction ---------------
 
 func (a BaseAuction) GetID() uint64 { return a.ID }
@@ -250,6 +267,331 @@ func (a CollateralAuction) Validate() error {
 	return ValidateAuction(&a)
 }
 
+// --------------- DutchAuction ---------------
+
+// NewDutchAuction returns a new dutch auction.
+func NewDutchAuction(
+	seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin,
+	startPrice, reservePrice sdk.Dec, startTime, endTime time.Time, decayCurve DecayCurve, exponentialDecay sdk.Dec,
+) DutchAuction {
+	auction := DutchAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartPrice:        startPrice,
+		StartTime:         startTime,
+		DecayCurve:        decayCurve,
+		ExponentialDecay:  exponentialDecay,
+		ReservePrice:      reservePrice,
+	}
+	return auction
+}
+
+func (a DutchAuction) WithID(id uint64) Auction {
+	a.ID = id
+	return Auction(&a)
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchAuction) GetType() string { return DutchAuctionType }
+
+// GetPhase returns the direction of a dutch auction, which never changes.
+func (a DutchAuction) GetPhase() string { return DescendingAuctionPhase }
+
+// GetLotReturns returns the auction's lot returns as weighted addresses
+func (a DutchAuction) GetLotReturns() WeightedAddresses { return a.LotReturns }
+
+// IsSoldOut returns whether the whole lot has been sold or the max bid has been raised.
+func (a DutchAuction) IsSoldOut() bool {
+	return a.Lot.IsZero() || a.Bid.IsGTE(a.MaxBid)
+}
+
+// CurrentPrice returns the price of one unit of lot at the given time, denominated in the bid denom.
+// The price decays from the start price but never falls below the reserve price.
+func (a DutchAuction) CurrentPrice(blockTime time.Time) sdk.Dec {
+	return sdk.MaxDec(a.decayedPrice(blockTime), a.ReservePrice)
+}
+
+// decayedPrice returns the start price decayed along the auction's decay curve.
+// Linearly decaying prices reach zero at the auction's end time.
+func (a DutchAuction) decayedPrice(blockTime time.Time) sdk.Dec {
+	elapsed := int64(blockTime.Sub(a.StartTime).Seconds())
+	if elapsed <= 0 {
+		return a.StartPrice
+	}
+
+	switch a.DecayCurve {
+	case DECAY_CURVE_LINEAR:
+		duration := int64(a.EndTime.Sub(a.StartTime).Seconds())
+		if elapsed >= duration {
+			return sdk.ZeroDec()
+		}
+		return a.StartPrice.MulInt64(duration - elapsed).QuoInt64(duration)
+	case DECAY_CURVE_EXPONENTIAL:
+		return a.StartPrice.Mul(sdk.OneDec().Sub(a.ExponentialDecay).Power(uint64(elapsed)))
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// Validate validates the DutchAuction fields values.
+func (a DutchAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if a.MaxBid.Denom != a.Bid.Denom {
+		return fmt.Errorf("max bid denom %s does not match bid denom %s", a.MaxBid.Denom, a.Bid.Denom)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive: %s", a.StartPrice)
+	}
+	if a.ReservePrice.IsNil() || a.ReservePrice.IsNegative() || a.ReservePrice.GT(a.StartPrice) {
+		return fmt.Errorf("reserve price must be between zero and the start price: %s", a.ReservePrice)
+	}
+	if a.StartTime.Unix() <= 0 {
+		return errors.New("start time cannot be zero")
+	}
+	if a.StartTime.After(a.EndTime) {
+		return fmt.Errorf("EndTime < StartTime (%s < %s)", a.EndTime, a.StartTime)
+	}
+	if err := a.DecayCurve.Validate(); err != nil {
+		return err
+	}
+	if a.ExponentialDecay.IsNil() || a.ExponentialDecay.IsNegative() || a.ExponentialDecay.GTE(sdk.OneDec()) {
+		return fmt.Errorf("exponential decay must be between 0 and 1 (exclusive): %s", a.ExponentialDecay)
+	}
+	return ValidateAuction(&a)
+}
+
+// --------------- BatchAuction ---------------
+
+// NewBatchAuction returns a new batch auction.
+func NewBatchAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid sdk.Coin, reservePrice sdk.Dec, lotReturns WeightedAddresses, debt sdk.Coin) BatchAuction {
+	auction := BatchAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		BidCount:          0,
+		ReservePrice:      reservePrice,
+	}
+	return auction
+}
+
+func (a BatchAuction) WithID(id uint64) Auction {
+	a.ID = id
+	return Auction(&a)
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a BatchAuction) GetType() string { return BatchAuctionType }
+
+// GetPhase returns the direction of a batch auction, which never changes.
+func (a BatchAuction) GetPhase() string { return UniformPriceAuctionPhase }
+
+// GetLotReturns returns the auction's lot returns as weighted addresses
+func (a BatchAuction) GetLotReturns() WeightedAddresses { return a.LotReturns }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+// Bids held in escrow are stored separately from the auction, so they are not included.
+func (a BatchAuction) GetModuleAccountCoins() sdk.Coins {
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// Validate validates the BatchAuction fields values.
+func (a BatchAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if a.MaxBid.Denom != a.Bid.Denom {
+		return fmt.Errorf("max bid denom %s does not match bid denom %s", a.MaxBid.Denom, a.Bid.Denom)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if a.ReservePrice.IsNil() || a.ReservePrice.IsNegative() {
+		return fmt.Errorf("reserve price must be non-negative: %s", a.ReservePrice)
+	}
+	return ValidateAuction(&a)
+}
+
+// BatchFill is the part of the lot a batch bid receives at settlement, and the amount it pays for it.
+type BatchFill struct {
+	Lot     sdk.Coin
+	Payment sdk.Coin
+}
+
+// Settle clears the auction's bids at a single price. Bids are filled from the highest limit price down,
+// with earlier bids filled first at equal prices, until the lot is sold or enough is sold to raise the max bid.
+// Bids priced below the reserve price are not filled.
+// Every filled bid pays the limit price of the last bid needed, so no bidder pays more than they bid.
+// The bids must be in the order they were placed. It returns the fill of each bid in the same order, and the clearing price.
+func (a BatchAuction) Settle(bids BatchBids) ([]BatchFill, sdk.Dec) {
+	fills := make([]BatchFill, len(bids))
+	for i := range bids {
+		fills[i] = BatchFill{
+			Lot:     sdk.NewCoin(a.Lot.Denom, sdk.ZeroInt()),
+			Payment: sdk.NewCoin(a.MaxBid.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	order := make([]int, len(bids))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bids[order[i]].PriceGT(bids[order[j]])
+	})
+
+	// prices are compared by cross multiplying amounts to avoid rounding
+	var clearing BatchBid
+	sold := sdk.ZeroInt()
+	demand := sdk.ZeroInt()
+	for _, i := range order {
+		if bids[i].PriceLT(a.ReservePrice) {
+			break
+		}
+		clearing = bids[i]
+		demand = demand.Add(clearing.Lot.Amount)
+		sold = sdk.MinInt(demand, a.Lot.Amount)
+
+		if clearing.Bid.Amount.Mul(sold).GTE(a.MaxBid.Amount.Mul(clearing.Lot.Amount)) {
+			// sell only as much lot as is needed to cover the max bid, rounding up
+			needed := a.MaxBid.Amount.Mul(clearing.Lot.Amount).Add(clearing.Bid.Amount).Sub(sdk.OneInt()).Quo(clearing.Bid.Amount)
+			sold = sdk.MinInt(sold, needed)
+			break
+		}
+		if sold.Equal(a.Lot.Amount) {
+			break
+		}
+	}
+	if sold.IsZero() {
+		return fills, sdk.ZeroDec()
+	}
+
+	raised := sdk.MinInt(a.MaxBid.Amount, clearing.Bid.Amount.Mul(sold).Quo(clearing.Lot.Amount))
+	var filled []int
+	unpaid := raised
+	remaining := sold
+	for _, i := range order {
+		if remaining.IsZero() {
+			break
+		}
+		lot := sdk.MinInt(bids[i].Lot.Amount, remaining)
+		remaining = remaining.Sub(lot)
+
+		// the amount raised is shared in proportion to lot filled, rounding down
+		payment := sdk.MinInt(raised.Mul(lot).Quo(sold), bids[i].Bid.Amount)
+		unpaid = unpaid.Sub(payment)
+		fills[i] = BatchFill{
+			Lot:     sdk.NewCoin(a.Lot.Denom, lot),
+			Payment: sdk.NewCoin(a.MaxBid.Denom, payment),
+		}
+		filled = append(filled, i)
+	}
+	// the remainder lost to rounding is paid by the filled bids in fill order, up to what each bid offered
+	for _, i := range filled {
+		if !unpaid.IsPositive() {
+			break
+		}
+		extra := sdk.MinInt(unpaid, bids[i].Bid.Amount.Sub(fills[i].Payment.Amount))
+		fills[i].Payment = fills[i].Payment.AddAmount(extra)
+		unpaid = unpaid.Sub(extra)
+	}
+	return fills, clearing.Price()
+}
+
+// BatchBids is a slice of BatchBid
+type BatchBids []BatchBid
+
+// Price returns the most the bid will pay for one unit of lot, denominated in the bid denom.
+func (b BatchBid) Price() sdk.Dec {
+	return sdk.NewDecFromInt(b.Bid.Amount).QuoInt(b.Lot.Amount)
+}
+
+// PriceGT returns true if the bid's limit price is greater than the other bid's.
+func (b BatchBid) PriceGT(other BatchBid) bool {
+	return b.Bid.Amount.Mul(other.Lot.Amount).GT(other.Bid.Amount.Mul(b.Lot.Amount))
+}
+
+// PriceLT returns true if the bid's limit price is less than the price.
+func (b BatchBid) PriceLT(price sdk.Dec) bool {
+	return sdk.NewDecFromInt(b.Bid.Amount).LT(price.MulInt(b.Lot.Amount))
+}
+
+// Validate returns an error if the bid is not for part of the given lot, or not denominated in the bid denom.
+func (b BatchBid) Validate(lot sdk.Coin, bidDenom string) error {
+	if b.Bidder.Empty() {
+		return errors.New("batch bid bidder cannot be empty")
+	}
+	if !b.Lot.IsValid() || !b.Lot.IsPositive() {
+		return fmt.Errorf("batch bid lot must be positive: %s", b.Lot)
+	}
+	if !b.Bid.IsValid() || !b.Bid.IsPositive() {
+		return fmt.Errorf("batch bid must be positive: %s", b.Bid)
+	}
+	if b.Lot.Denom != lot.Denom {
+		return fmt.Errorf("batch bid lot denom %s does not match lot denom %s", b.Lot.Denom, lot.Denom)
+	}
+	if b.Bid.Denom != bidDenom {
+		return fmt.Errorf("batch bid denom %s does not match bid denom %s", b.Bid.Denom, bidDenom)
+	}
+	if b.Lot.Amount.GT(lot.Amount) {
+		return fmt.Errorf("batch bid lot %s is larger than auction lot %s", b.Lot, lot)
+	}
+	return nil
+}
+
+// IsValid returns true if the DecayCurve is valid and false otherwise.
+func (c DecayCurve) IsValid() bool {
+	return c == DECAY_CURVE_LINEAR || c == DECAY_CURVE_EXPONENTIAL
+}
+
+// Validate returns an error if the DecayCurve is invalid.
+func (c DecayCurve) Validate() error {
+	if !c.IsValid() {
+		return fmt.Errorf("invalid decay curve %s", c)
+	}
+
+	return nil
+}
+
 // NewWeightedAddresses returns a new list addresses with weights.
 func NewWeightedAddresses(addrs []sdk.AccAddress, weights []sdkmath.Int) (WeightedAddresses, error) {
 	wa := WeightedAddresses{
@@ -354,3 +354,336 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestNewDutchAuction(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress([]byte(testAccAddress1)),
+	}
+	weightedAddresses, _ := NewWeightedAddresses(addresses, is(1))
+
+	startTime := time.Now()
+	endTime := startTime.Add(TestExtraEndTime)
+
+	dutchAuction := NewDutchAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		c(TestBidDenom, TestBidAmount),
+		weightedAddresses,
+		c(TestDebtDenom, TestDebtAmount2),
+		d("1.5"),
+		d("1.0"),
+		startTime,
+		endTime,
+		DECAY_CURVE_LINEAR,
+		d("0.001"),
+	)
+
+	require.Equal(t, dutchAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, dutchAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, dutchAuction.Bid, c(TestBidDenom, 0))
+	require.Equal(t, dutchAuction.EndTime, endTime)
+	require.Equal(t, dutchAuction.MaxEndTime, endTime)
+	require.Equal(t, dutchAuction.MaxBid, c(TestBidDenom, TestBidAmount))
+	require.Equal(t, dutchAuction.LotReturns, weightedAddresses)
+	require.Equal(t, dutchAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
+	require.Equal(t, dutchAuction.StartPrice, d("1.5"))
+	require.Equal(t, dutchAuction.ReservePrice, d("1.0"))
+	require.Equal(t, dutchAuction.StartTime, startTime)
+	require.NoError(t, dutchAuction.Validate())
+}
+
+func TestDutchAuctionCurrentPrice(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Second)
+
+	tests := []struct {
+		name         string
+		curve        DecayCurve
+		reservePrice sdk.Dec
+		elapsed      time.Duration
+		expPrice     sdk.Dec
+	}{
+		{"linear at start", DECAY_CURVE_LINEAR, d("0"), 0, d("2.0")},
+		{"linear before start", DECAY_CURVE_LINEAR, d("0"), -10 * time.Second, d("2.0")},
+		{"linear quarter way", DECAY_CURVE_LINEAR, d("0"), 25 * time.Second, d("1.5")},
+		{"linear at end", DECAY_CURVE_LINEAR, d("0"), 100 * time.Second, d("0")},
+		{"linear after end", DECAY_CURVE_LINEAR, d("0"), 200 * time.Second, d("0")},
+		{"linear above reserve", DECAY_CURVE_LINEAR, d("1.6"), 10 * time.Second, d("1.8")},
+		{"linear at reserve", DECAY_CURVE_LINEAR, d("1.6"), 20 * time.Second, d("1.6")},
+		{"linear clamped to reserve", DECAY_CURVE_LINEAR, d("1.6"), 25 * time.Second, d("1.6")},
+		{"linear at end clamped to reserve", DECAY_CURVE_LINEAR, d("1.6"), 100 * time.Second, d("1.6")},
+		{"exponential at start", DECAY_CURVE_EXPONENTIAL, d("0"), 0, d("2.0")},
+		{"exponential after one second", DECAY_CURVE_EXPONENTIAL, d("0"), time.Second, d("1.8")},
+		{"exponential after two seconds", DECAY_CURVE_EXPONENTIAL, d("0"), 2 * time.Second, d("1.62")},
+		{"exponential clamped to reserve", DECAY_CURVE_EXPONENTIAL, d("1.7"), 2 * time.Second, d("1.7")},
+		{"unspecified curve", DECAY_CURVE_UNSPECIFIED, d("0"), time.Second, d("0")},
+		{"unspecified curve clamped to reserve", DECAY_CURVE_UNSPECIFIED, d("1.2"), time.Second, d("1.2")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			auction := DutchAuction{
+				BaseAuction:      BaseAuction{EndTime: endTime, MaxEndTime: endTime},
+				StartPrice:       d("2.0"),
+				StartTime:        startTime,
+				DecayCurve:       tc.curve,
+				ExponentialDecay: d("0.1"),
+				ReservePrice:     tc.reservePrice,
+			}
+			require.Equal(t, tc.expPrice, auction.CurrentPrice(startTime.Add(tc.elapsed)))
+		})
+	}
+}
+
+func TestDutchAuctionValidate(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte(testAccAddress1))
+
+	now := time.Now()
+	validAuction := func() DutchAuction {
+		return DutchAuction{
+			BaseAuction: BaseAuction{
+				ID:              1,
+				Initiator:       testAccAddress1,
+				Lot:             c("fury", 1),
+				Bidder:          addr1,
+				Bid:             c("usdf", 1),
+				EndTime:         now,
+				MaxEndTime:      now,
+				HasReceivedBids: true,
+			},
+			CorrespondingDebt: c("debt", 1),
+			MaxBid:            c("usdf", 2),
+			LotReturns: WeightedAddresses{
+				Addresses: []sdk.AccAddress{addr1},
+				Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+			},
+			StartPrice:       d("1.2"),
+			StartTime:        now.Add(-time.Hour),
+			DecayCurve:       DECAY_CURVE_EXPONENTIAL,
+			ExponentialDecay: d("0.001"),
+			ReservePrice:     d("0.8"),
+		}
+	}
+
+	tests := []struct {
+		msg      string
+		malleate func(*DutchAuction)
+		expPass  bool
+	}{
+		{"valid auction", func(*DutchAuction) {}, true},
+		{"invalid max bid denom", func(a *DutchAuction) { a.MaxBid = c("fury", 2) }, false},
+		{"invalid lot returns", func(a *DutchAuction) { a.LotReturns = WeightedAddresses{} }, false},
+		{"zero start price", func(a *DutchAuction) { a.StartPrice = sdk.ZeroDec() }, false},
+		{"zero reserve price", func(a *DutchAuction) { a.ReservePrice = sdk.ZeroDec() }, true},
+		{"nil reserve price", func(a *DutchAuction) { a.ReservePrice = sdk.Dec{} }, false},
+		{"negative reserve price", func(a *DutchAuction) { a.ReservePrice = d("-0.1") }, false},
+		{"reserve price above start price", func(a *DutchAuction) { a.ReservePrice = d("1.3") }, false},
+		{"start after end", func(a *DutchAuction) { a.StartTime = now.Add(time.Hour) }, false},
+		{"unspecified decay curve", func(a *DutchAuction) { a.DecayCurve = DECAY_CURVE_UNSPECIFIED }, false},
+		{"exponential decay of one", func(a *DutchAuction) { a.ExponentialDecay = sdk.OneDec() }, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			auction := validAuction()
+			tc.malleate(&auction)
+			err := auction.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchAuctionValidate(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte(testAccAddress1))
+	addr2 := sdk.AccAddress([]byte(testAccAddress2))
+
+	now := time.Now()
+	validAuction := func() BatchAuction {
+		return BatchAuction{
+			BaseAuction: BaseAuction{
+				ID:              1,
+				Initiator:       testAccAddress1,
+				Lot:             c("fury", 100),
+				Bidder:          addr2,
+				Bid:             c("usdf", 0),
+				EndTime:         now,
+				MaxEndTime:      now,
+				HasReceivedBids: true,
+			},
+			CorrespondingDebt: c("debt", 100),
+			MaxBid:            c("usdf", 110),
+			LotReturns: WeightedAddresses{
+				Addresses: []sdk.AccAddress{addr1},
+				Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+			},
+			BidCount:     2,
+			ReservePrice: d("0.5"),
+		}
+	}
+
+	tests := []struct {
+		msg      string
+		malleate func(*BatchAuction)
+		expPass  bool
+	}{
+		{"valid auction", func(*BatchAuction) {}, true},
+		{"no bids", func(a *BatchAuction) { a.BidCount = 0 }, true},
+		{"zero reserve price", func(a *BatchAuction) { a.ReservePrice = d("0") }, true},
+		{"nil reserve price", func(a *BatchAuction) { a.ReservePrice = sdk.Dec{} }, false},
+		{"negative reserve price", func(a *BatchAuction) { a.ReservePrice = d("-0.5") }, false},
+		{"invalid max bid denom", func(a *BatchAuction) { a.MaxBid = c("fury", 2) }, false},
+		{"invalid lot returns", func(a *BatchAuction) { a.LotReturns = WeightedAddresses{} }, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			auction := validAuction()
+			tc.malleate(&auction)
+			err := auction.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchBidValidate(t *testing.T) {
+	validBid := func() BatchBid {
+		return BatchBid{Bidder: sdk.AccAddress(testAccAddress1), Lot: c("fury", 50), Bid: c("usdf", 60), AuctionID: 1}
+	}
+
+	tests := []struct {
+		msg      string
+		malleate func(*BatchBid)
+		expPass  bool
+	}{
+		{"valid bid", func(*BatchBid) {}, true},
+		{"whole lot", func(b *BatchBid) { b.Lot = c("fury", 100) }, true},
+		{"empty bidder", func(b *BatchBid) { b.Bidder = nil }, false},
+		{"zero bid lot", func(b *BatchBid) { b.Lot = c("fury", 0) }, false},
+		{"zero bid", func(b *BatchBid) { b.Bid = c("usdf", 0) }, false},
+		{"invalid bid lot denom", func(b *BatchBid) { b.Lot = c("btc", 50) }, false},
+		{"invalid bid denom", func(b *BatchBid) { b.Bid = c("btc", 60) }, false},
+		{"bid lot larger than lot", func(b *BatchBid) { b.Lot = c("fury", 101) }, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			bid := validBid()
+			tc.malleate(&bid)
+			err := bid.Validate(c("fury", 100), "usdf")
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchAuctionSettle(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("batchBidder1"),
+		sdk.AccAddress("batchBidder2"),
+		sdk.AccAddress("batchBidder3"),
+	}
+	bid := func(i int, lot, bid int64) BatchBid {
+		return BatchBid{Bidder: addrs[i], Lot: c("fury", lot), Bid: c("usdf", bid)}
+	}
+	fill := func(lot, payment int64) BatchFill {
+		return BatchFill{Lot: c("fury", lot), Payment: c("usdf", payment)}
+	}
+
+	tests := []struct {
+		name     string
+		maxBid   int64
+		reserve  sdk.Dec
+		bids     BatchBids
+		expFills []BatchFill
+		expPrice sdk.Dec
+	}{
+		{
+			"no bids",
+			500,
+			d("0"),
+			nil,
+			[]BatchFill{},
+			d("0"),
+		},
+		{
+			"undersubscribed clears at the lowest bid",
+			500,
+			d("0"),
+			BatchBids{bid(0, 30, 150), bid(1, 20, 60)},
+			[]BatchFill{fill(30, 90), fill(20, 60)},
+			d("3"),
+		},
+		{
+			"oversubscribed clears at the last bid filled",
+			1000,
+			d("0"),
+			BatchBids{bid(0, 60, 180), bid(1, 50, 100), bid(2, 60, 240)},
+			[]BatchFill{fill(40, 120), fill(0, 0), fill(60, 180)},
+			d("3"),
+		},
+		{
+			"sells only enough to raise the max bid",
+			500,
+			d("0"),
+			BatchBids{bid(0, 60, 600), bid(1, 60, 480)},
+			[]BatchFill{fill(50, 500), fill(0, 0)},
+			d("10"),
+		},
+		{
+			"earlier bids are filled first at equal prices",
+			1000,
+			d("0"),
+			BatchBids{bid(0, 80, 400), bid(1, 80, 400)},
+			[]BatchFill{fill(80, 400), fill(20, 100)},
+			d("5"),
+		},
+		{
+			"bids below the reserve price are not filled",
+			500,
+			d("2"),
+			BatchBids{bid(0, 30, 150), bid(1, 20, 60), bid(2, 40, 40)},
+			[]BatchFill{fill(30, 90), fill(20, 60), fill(0, 0)},
+			d("3"),
+		},
+		{
+			"no bids at the reserve price",
+			500,
+			d("2"),
+			BatchBids{bid(0, 40, 40)},
+			[]BatchFill{fill(0, 0)},
+			d("0"),
+		},
+		{
+			"rounding remainder is paid by the first bid filled",
+			100,
+			d("0"),
+			BatchBids{bid(0, 3, 1), bid(1, 2, 1)},
+			[]BatchFill{fill(3, 0), fill(2, 1)},
+			d("0.333333333333333333"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			auction := NewBatchAuction(testAccAddress1, c("fury", 100), time.Now(), c("usdf", tc.maxBid), tc.reserve, WeightedAddresses{}, c("debt", tc.maxBid))
+
+			fills, price := auction.Settle(tc.bids)
+			require.Len(t, fills, len(tc.expFills))
+			raised := sdk.ZeroInt()
+			for i, f := range fills {
+				require.Equal(t, tc.expFills[i].Lot.String(), f.Lot.String())
+				require.Equal(t, tc.expFills[i].Payment.String(), f.Payment.String())
+				require.True(t, f.Payment.IsLTE(tc.bids[i].Bid))
+				raised = raised.Add(f.Payment.Amount)
+			}
+			require.Equal(t, tc.expPrice, price)
+			require.True(t, raised.LTE(sdkmath.NewInt(tc.maxBid)))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBidRecord returns a record of a bid placed on an auction, with a zero refund
+func NewBidRecord(auctionID uint64, bidder sdk.AccAddress, bid, lot sdk.Coin, time time.Time, height int64) BidRecord {
+	return BidRecord{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Bid:       bid,
+		Lot:       lot,
+		Time:      time,
+		Height:    height,
+		Refund:    sdk.NewCoin(bid.Denom, sdk.ZeroInt()),
+	}
+}
+
+// Validate performs a basic validation of a bid record
+func (br BidRecord) Validate() error {
+	if br.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if br.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if !br.Bid.IsValid() {
+		return fmt.Errorf("invalid bid: %s", br.Bid)
+	}
+	if !br.Lot.IsValid() {
+		return fmt.Errorf("invalid lot: %s", br.Lot)
+	}
+	if !br.Refund.IsValid() {
+		return fmt.Errorf("invalid refund: %s", br.Refund)
+	}
+	return nil
+}
+
+// BidRecords is a slice of BidRecord
+type BidRecords []BidRecord
+
+// Validate validates each bid record
+func (brs BidRecords) Validate() error {
+	for _, br := range brs {
+		if err := br.Validate(); err != nil {
+			return fmt.Errorf("invalid bid on auction %d: %w", br.AuctionID, err)
+		}
+	}
+	return nil
+}
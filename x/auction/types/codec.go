@@ -13,17 +13,21 @@ import (
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(&MsgPlaceBatchBid{}, "auction/MsgPlaceBatchBid", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
 	cdc.RegisterConcrete(&SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(&DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(&CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(&DutchAuction{}, "auction/DutchAuction", nil)
+	cdc.RegisterConcrete(&BatchAuction{}, "auction/BatchAuction", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPlaceBid{},
+		&MsgPlaceBatchBid{},
 	)
 
 	registry.RegisterInterface(
@@ -32,6 +36,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchAuction{},
+		&BatchAuction{},
 	)
 
 	registry.RegisterInterface(
@@ -40,6 +46,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchAuction{},
+		&BatchAuction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -27,4 +27,12 @@ var (
 	ErrLotTooSmall = errorsmod.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = errorsmod.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrInvalidStartPrice error for when a dutch auction is started without a positive price
+	ErrInvalidStartPrice = errorsmod.Register(ModuleName, 13, "dutch auction start price must be positive")
+	// ErrInvalidBidType error for when a bid is placed with a message the auction type does not accept
+	ErrInvalidBidType = errorsmod.Register(ModuleName, 14, "bid type is not accepted by auction")
+	// ErrInvalidBatchBid error for when a batch bid is not for part of an auction's lot
+	ErrInvalidBatchBid = errorsmod.Register(ModuleName, 15, "invalid batch bid")
+	// ErrInvalidMarketPrice error for when a batch auction is started without a positive market price
+	ErrInvalidMarketPrice = errorsmod.Register(ModuleName, 16, "batch auction market price must be positive")
 )
@@ -2,17 +2,22 @@ package types
 
 // Events for the module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart  = "auction_start"
+	EventTypeAuctionBid    = "auction_bid"
+	EventTypeAuctionClose  = "auction_close"
+	EventTypeAuctionOutbid = "auction_outbid"
+	EventTypeBatchFill     = "auction_batch_fill"
 
-	AttributeValueCategory  = ModuleName
-	AttributeKeyAuctionID   = "auction_id"
-	AttributeKeyAuctionType = "auction_type"
-	AttributeKeyBidder      = "bidder"
-	AttributeKeyLot         = "lot"
-	AttributeKeyMaxBid      = "max_bid"
-	AttributeKeyBid         = "bid"
-	AttributeKeyEndTime     = "end_time"
-	AttributeKeyCloseBlock  = "close_block"
+	AttributeValueCategory   = ModuleName
+	AttributeKeyAuctionID    = "auction_id"
+	AttributeKeyAuctionType  = "auction_type"
+	AttributeKeyBidder       = "bidder"
+	AttributeKeyLot          = "lot"
+	AttributeKeyMaxBid       = "max_bid"
+	AttributeKeyBid          = "bid"
+	AttributeKeyPrice        = "price"
+	AttributeKeyEndTime      = "end_time"
+	AttributeKeyCloseBlock   = "close_block"
+	AttributeKeyOutbidBidder = "outbid_bidder"
+	AttributeKeyRefund       = "refund"
 )
@@ -20,3 +20,8 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// AuctionHooks event hooks for other keepers to run code in response to auctions closing
+type AuctionHooks interface {
+	AfterAuctionClosed(ctx sdk.Context, auction Auction)
+}
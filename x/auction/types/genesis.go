@@ -96,7 +96,11 @@ func (gs GenesisState) Validate() error {
 	}
 
 	ids := map[uint64]bool{}
+	batchAuctions := map[uint64]*BatchAuction{}
 	for _, a := range auctions {
+		if batch, ok := a.(*BatchAuction); ok {
+			batchAuctions[batch.ID] = batch
+		}
 
 		if err := a.Validate(); err != nil {
 			return fmt.Errorf("found invalid auction: %w", err)
@@ -111,6 +115,48 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionId)
 		}
 	}
+	if err := gs.AuctionStats.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.Bids.Validate(); err != nil {
+		return err
+	}
+	for _, bid := range gs.Bids {
+		// bids on closed auctions are kept until their bid history is pruned
+		if !ids[bid.AuctionID] && bid.AuctionID >= gs.NextAuctionId {
+			return fmt.Errorf("found bid on auction %d that is not in genesis", bid.AuctionID)
+		}
+	}
+
+	return validateBatchBids(gs.BatchBids, batchAuctions)
+}
+
+// validateBatchBids returns an error if a batch bid is not valid for a batch auction in genesis,
+// or the bids do not match the bid count of their auction.
+func validateBatchBids(bids BatchBids, auctions map[uint64]*BatchAuction) error {
+	counts := map[uint64]uint64{}
+	bidders := map[string]bool{}
+	for _, bid := range bids {
+		auction, found := auctions[bid.AuctionID]
+		if !found {
+			return fmt.Errorf("found batch bid on auction %d that is not a batch auction in genesis", bid.AuctionID)
+		}
+		if err := bid.Validate(auction.Lot, auction.MaxBid.Denom); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s", bid.AuctionID, bid.Bidder)
+		if bidders[key] {
+			return fmt.Errorf("found duplicate batch bid from %s on auction %d", bid.Bidder, bid.AuctionID)
+		}
+		bidders[key] = true
+		counts[bid.AuctionID]++
+	}
+	for id, auction := range auctions {
+		if counts[id] != auction.BidCount {
+			return fmt.Errorf("batch auction %d has %d bids, expected %d", id, counts[id], auction.BidCount)
+		}
+	}
 	return nil
 }
 
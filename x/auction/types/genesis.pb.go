@@ -8,10 +8,12 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -36,6 +38,12 @@ type GenesisState struct {
 	Params        Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Genesis auctions
 	Auctions []*types.Any `protobuf:"bytes,3,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// Auction outcome totals
+	AuctionStats AuctionStatsList `protobuf:"bytes,4,rep,name=auction_stats,json=auctionStats,proto3,castrepeated=AuctionStatsList" json:"auction_stats"`
+	// Bid history of the genesis auctions
+	Bids BidRecords `protobuf:"bytes,5,rep,name=bids,proto3,castrepeated=BidRecords" json:"bids"`
+	// Bids held by the genesis batch auctions, in the order they were placed
+	BatchBids BatchBids `protobuf:"bytes,6,rep,name=batch_bids,json=batchBids,proto3,castrepeated=BatchBids" json:"batch_bids"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +87,25 @@ type Params struct {
 	IncrementSurplus    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=increment_surplus,json=incrementSurplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_surplus"`
 	IncrementDebt       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=increment_debt,json=incrementDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_debt"`
 	IncrementCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=increment_collateral,json=incrementCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_collateral"`
+	// dutch_start_premium is the premium over the market price that dutch auctions start at
+	DutchStartPremium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=dutch_start_premium,json=dutchStartPremium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_start_premium"`
+	// dutch_auction_duration is how long a dutch auction runs for before any unsold lot is returned
+	DutchAuctionDuration time.Duration `protobuf:"bytes,9,opt,name=dutch_auction_duration,json=dutchAuctionDuration,proto3,stdduration" json:"dutch_auction_duration"`
+	DutchDecayCurve      DecayCurve    `protobuf:"varint,10,opt,name=dutch_decay_curve,json=dutchDecayCurve,proto3,enum=fury.auction.v1beta1.DecayCurve" json:"dutch_decay_curve,omitempty"`
+	// dutch_exponential_decay is the fraction of the price lost each second by exponentially decaying dutch auctions
+	DutchExponentialDecay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=dutch_exponential_decay,json=dutchExponentialDecay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_exponential_decay"`
+	// batch_auction_duration is how long a batch auction takes bids for before it is settled
+	BatchAuctionDuration time.Duration `protobuf:"bytes,12,opt,name=batch_auction_duration,json=batchAuctionDuration,proto3,stdduration" json:"batch_auction_duration"`
+	// dutch_reserve_ratio is the fraction of the market price below which dutch auction prices do not decay
+	DutchReserveRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=dutch_reserve_ratio,json=dutchReserveRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_reserve_ratio"`
+	// bid_history_retention is how long the bid history of an auction is kept after it closes
+	BidHistoryRetention time.Duration `protobuf:"bytes,14,opt,name=bid_history_retention,json=bidHistoryRetention,proto3,stdduration" json:"bid_history_retention"`
+	// batch_reserve_ratio is the fraction of the market price below which batch auction bids are not accepted
+	BatchReserveRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=batch_reserve_ratio,json=batchReserveRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"batch_reserve_ratio"`
+	// batch_min_lot_fraction is the smallest fraction of a batch auction's lot that a bid can be for
+	BatchMinLotFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=batch_min_lot_fraction,json=batchMinLotFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"batch_min_lot_fraction"`
+	// batch_max_bids is the most bids a batch auction holds, once full a new bid must outprice the lowest bid to replace it
+	BatchMaxBids uint64 `protobuf:"varint,17,opt,name=batch_max_bids,json=batchMaxBids,proto3" json:"batch_max_bids,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -114,9 +141,63 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// AuctionStats defines the outcome totals of auctions of a type and lot denom over a time window.
+type AuctionStats struct {
+	AuctionType string `protobuf:"bytes,1,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	LotDenom    string `protobuf:"bytes,2,opt,name=lot_denom,json=lotDenom,proto3" json:"lot_denom,omitempty"`
+	// window_start is the start of the time window the totals were recorded in
+	WindowStart     time.Time `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	AuctionsStarted uint64    `protobuf:"varint,4,opt,name=auctions_started,json=auctionsStarted,proto3" json:"auctions_started,omitempty"`
+	AuctionsClosed  uint64    `protobuf:"varint,5,opt,name=auctions_closed,json=auctionsClosed,proto3" json:"auctions_closed,omitempty"`
+	// lot_auctioned is the lot of the auctions started
+	LotAuctioned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=lot_auctioned,json=lotAuctioned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lot_auctioned"`
+	// debt_auctioned is the debt the auctions started were to cover
+	DebtAuctioned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=debt_auctioned,json=debtAuctioned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"debt_auctioned"`
+	// lot_sold is the lot paid out to winning bidders
+	LotSold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=lot_sold,json=lotSold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lot_sold"`
+	// bid_raised is the winning bids paid for the lot sold
+	BidRaised github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=bid_raised,json=bidRaised,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_raised"`
+	// debt_unrecovered is the debt left uncovered when auctions closed, which is returned to the initiator
+	DebtUnrecovered github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=debt_unrecovered,json=debtUnrecovered,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"debt_unrecovered"`
+}
+
+func (m *AuctionStats) Reset()         { *m = AuctionStats{} }
+func (m *AuctionStats) String() string { return proto.CompactTextString(m) }
+func (*AuctionStats) ProtoMessage()    {}
+func (*AuctionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5304523b3c6348d5, []int{2}
+}
+func (m *AuctionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionStats.Merge(m, src)
+}
+func (m *AuctionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionStats proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.auction.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "fury.auction.v1beta1.Params")
+	proto.RegisterType((*AuctionStats)(nil), "fury.auction.v1beta1.AuctionStats")
 }
 
 func init() {
@@ -124,39 +205,75 @@ func init() {
 }
 
 var fileDescriptor_5304523b3c6348d5 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0x6e, 0xdb, 0x3c,
-	0x14, 0xc5, 0xa5, 0x58, 0x9f, 0x3f, 0x83, 0x76, 0xd2, 0x94, 0xd5, 0x20, 0x07, 0x85, 0x6c, 0x78,
-	0x08, 0xbc, 0x98, 0x42, 0xdc, 0xad, 0x5b, 0x54, 0x03, 0x45, 0xdb, 0xa5, 0x50, 0x90, 0xa5, 0x1d,
-	0x04, 0x4a, 0xa2, 0x55, 0xa1, 0x92, 0x28, 0x90, 0x54, 0x6a, 0xbf, 0x45, 0xc7, 0x3e, 0x48, 0x87,
-	0x3c, 0x82, 0xd1, 0x29, 0x63, 0xd1, 0x21, 0x6d, 0xed, 0x17, 0x29, 0x44, 0xd1, 0xb2, 0xfb, 0x67,
-	0x49, 0x26, 0x89, 0xf7, 0x9e, 0xfb, 0x3b, 0x87, 0x94, 0x08, 0x46, 0xf3, 0x92, 0x2d, 0x1d, 0x5c,
-	0x86, 0x22, 0xa1, 0xb9, 0x73, 0x75, 0x16, 0x10, 0x81, 0xcf, 0x9c, 0x98, 0xe4, 0x84, 0x27, 0x1c,
-	0x15, 0x8c, 0x0a, 0x0a, 0xcd, 0x4a, 0x83, 0x94, 0x06, 0x29, 0xcd, 0x49, 0x3f, 0xa4, 0x3c, 0xa3,
-	0xdc, 0x97, 0x1a, 0xa7, 0x5e, 0xd4, 0x03, 0x27, 0x66, 0x4c, 0x63, 0x5a, 0xd7, 0xab, 0x37, 0x55,
-	0xed, 0xc7, 0x94, 0xc6, 0x29, 0x71, 0xe4, 0x2a, 0x28, 0xe7, 0x0e, 0xce, 0x97, 0xaa, 0x65, 0xff,
-	0xd9, 0x8a, 0x4a, 0x86, 0xa5, 0x9b, 0xac, 0x8c, 0xae, 0x75, 0xd0, 0x7b, 0x5e, 0x67, 0xba, 0x10,
-	0x58, 0x10, 0x78, 0x0a, 0x1e, 0xe4, 0x64, 0x21, 0x7c, 0x15, 0xca, 0x4f, 0x22, 0x4b, 0x1f, 0xea,
-	0x63, 0xc3, 0x3b, 0xac, 0xca, 0xe7, 0x75, 0xf5, 0x45, 0x04, 0x9f, 0x82, 0x76, 0x81, 0x19, 0xce,
-	0xb8, 0x75, 0x30, 0xd4, 0xc7, 0xdd, 0xe9, 0x63, 0xf4, 0xaf, 0xbd, 0xa0, 0xd7, 0x52, 0xe3, 0x1a,
-	0xab, 0xdb, 0x81, 0xe6, 0xa9, 0x09, 0x38, 0x03, 0x1d, 0xa5, 0xe3, 0x56, 0x6b, 0xd8, 0x1a, 0x77,
-	0xa7, 0x26, 0xaa, 0x73, 0xa2, 0x6d, 0x4e, 0x74, 0x9e, 0x2f, 0x5d, 0xf8, 0xe5, 0xf3, 0xe4, 0x48,
-	0xa5, 0x53, 0xce, 0x5e, 0x33, 0x39, 0xba, 0x36, 0x40, 0xbb, 0xc6, 0xc3, 0x4b, 0x60, 0x66, 0x78,
-	0xd1, 0x64, 0xde, 0xee, 0x51, 0x26, 0xef, 0x4e, 0xfb, 0x7f, 0xc1, 0x67, 0x4a, 0xe0, 0x76, 0xaa,
-	0x5c, 0x9f, 0xbe, 0x0f, 0x74, 0x0f, 0x66, 0x78, 0xa1, 0x3c, 0xb6, 0xdd, 0x0a, 0x3b, 0xa7, 0xec,
-	0x03, 0x66, 0x91, 0x1f, 0x24, 0xd1, 0x0e, 0xdb, 0xbe, 0x03, 0x56, 0x01, 0xdc, 0x24, 0xda, 0xc7,
-	0x32, 0x72, 0x45, 0x18, 0x27, 0xbf, 0x63, 0xff, 0xbf, 0x03, 0x56, 0x01, 0xf6, 0xb1, 0x6f, 0xc1,
-	0xc3, 0x24, 0x0f, 0x19, 0xc9, 0x48, 0x2e, 0x7c, 0x5e, 0xb2, 0x22, 0x2d, 0xab, 0xe3, 0xd5, 0xc7,
-	0x3d, 0x17, 0x55, 0x83, 0xdf, 0x6e, 0x07, 0xa7, 0x71, 0x22, 0xde, 0x95, 0x01, 0x0a, 0x69, 0xa6,
-	0xfe, 0x2b, 0xf5, 0x98, 0xf0, 0xe8, 0xbd, 0x23, 0x96, 0x05, 0xe1, 0x68, 0x46, 0x42, 0xef, 0xb8,
-	0x01, 0x5d, 0xd4, 0x1c, 0x78, 0x09, 0x8e, 0x76, 0xf0, 0x88, 0x04, 0xc2, 0x32, 0xee, 0x45, 0x3e,
-	0x6c, 0x28, 0x33, 0x12, 0x08, 0x88, 0x81, 0xb9, 0xc3, 0x86, 0x34, 0x4d, 0xb1, 0x20, 0x0c, 0xa7,
-	0xd6, 0x7f, 0xf7, 0x82, 0x3f, 0x6a, 0x58, 0xcf, 0x1a, 0xd4, 0x4b, 0xa3, 0x73, 0x70, 0xdc, 0xf2,
-	0x7a, 0xfb, 0x27, 0xed, 0xbe, 0x5a, 0xfd, 0xb4, 0xb5, 0xd5, 0xda, 0xd6, 0x6f, 0xd6, 0xb6, 0xfe,
-	0x63, 0x6d, 0xeb, 0x1f, 0x37, 0xb6, 0x76, 0xb3, 0xb1, 0xb5, 0xaf, 0x1b, 0x5b, 0x7b, 0x33, 0xd9,
-	0xb3, 0x2b, 0x08, 0x0b, 0x29, 0x4f, 0xf8, 0x24, 0xc5, 0x01, 0x77, 0xe4, 0x95, 0x5e, 0x34, 0x97,
-	0x5a, 0x3a, 0x07, 0x6d, 0xf9, 0xa1, 0x9e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x72, 0x05, 0x0d,
-	0x1e, 0xf1, 0x03, 0x00, 0x00,
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0xc7, 0xe3, 0x8d, 0xd7, 0x6b, 0xb7, 0x1f, 0x71, 0x7a, 0xbd, 0x30, 0x1b, 0x90, 0x1d, 0x22,
+	0xb4, 0x98, 0x43, 0xc6, 0x6c, 0xb8, 0x71, 0xdb, 0x89, 0x21, 0x3c, 0x82, 0x14, 0x4d, 0x36, 0x42,
+	0x80, 0xc4, 0xa8, 0x67, 0xba, 0xed, 0x34, 0xcc, 0x4c, 0x5b, 0xdd, 0x6d, 0xc7, 0xfe, 0x16, 0x7b,
+	0xe4, 0xc2, 0x1d, 0x71, 0x46, 0x7c, 0x86, 0x88, 0xd3, 0x1e, 0x11, 0x87, 0x5d, 0x48, 0xbe, 0x08,
+	0xea, 0xc7, 0x8c, 0x4d, 0x62, 0xa4, 0xc4, 0xe4, 0x64, 0x4f, 0x75, 0xd5, 0xef, 0x5f, 0x5d, 0xd5,
+	0x2f, 0xb0, 0x33, 0x18, 0xf3, 0x59, 0x0f, 0x8d, 0x23, 0x49, 0x59, 0xda, 0x9b, 0x3c, 0x0d, 0x89,
+	0x44, 0x4f, 0x7b, 0x43, 0x92, 0x12, 0x41, 0x85, 0x3b, 0xe2, 0x4c, 0x32, 0xd8, 0x52, 0x3e, 0xae,
+	0xf5, 0x71, 0xad, 0xcf, 0x56, 0x3b, 0x62, 0x22, 0x61, 0xa2, 0x17, 0x22, 0x41, 0xf2, 0xc0, 0x88,
+	0xd1, 0xd4, 0x44, 0x6d, 0x3d, 0x36, 0xe3, 0x81, 0xfe, 0xea, 0x99, 0x0f, 0x3b, 0xd4, 0x1a, 0xb2,
+	0x21, 0x33, 0x76, 0xf5, 0xcf, 0x5a, 0x97, 0xa7, 0x92, 0xc9, 0x5a, 0xe8, 0x90, 0xb1, 0x61, 0x4c,
+	0x7a, 0xfa, 0x2b, 0x1c, 0x0f, 0x7a, 0x28, 0x9d, 0xd9, 0xa1, 0xf6, 0xd5, 0x21, 0x3c, 0xe6, 0x68,
+	0x21, 0xb4, 0x73, 0x75, 0x5c, 0xd2, 0x84, 0x08, 0x89, 0x92, 0x91, 0x71, 0xd8, 0xf9, 0x6d, 0x1d,
+	0xd4, 0x0e, 0xcc, 0xc4, 0x8f, 0x25, 0x92, 0x04, 0x3e, 0x01, 0x1b, 0x29, 0x99, 0xca, 0xc0, 0xa6,
+	0x10, 0x50, 0xec, 0x14, 0xb6, 0x0b, 0xdd, 0xa2, 0x5f, 0x57, 0xe6, 0x67, 0xc6, 0xfa, 0x19, 0x86,
+	0x1f, 0x81, 0xd2, 0x08, 0x71, 0x94, 0x08, 0xe7, 0xde, 0x76, 0xa1, 0x5b, 0xdd, 0x7b, 0xdb, 0x5d,
+	0x56, 0x30, 0xf7, 0x48, 0xfb, 0x78, 0xc5, 0xf3, 0x57, 0x9d, 0x35, 0xdf, 0x46, 0xc0, 0x3e, 0x28,
+	0x5b, 0x3f, 0xe1, 0xac, 0x6f, 0xaf, 0x77, 0xab, 0x7b, 0x2d, 0xd7, 0x24, 0xea, 0x66, 0x89, 0xba,
+	0xcf, 0xd2, 0x99, 0x07, 0x7f, 0xff, 0x75, 0xb7, 0x61, 0xb3, 0xb3, 0xca, 0x7e, 0x1e, 0x09, 0x11,
+	0xa8, 0x67, 0x49, 0x0a, 0x89, 0xa4, 0x70, 0x8a, 0x1a, 0xb5, 0xb3, 0x3c, 0x11, 0x1b, 0xaf, 0x26,
+	0x29, 0x3c, 0x47, 0xa5, 0xf3, 0xcb, 0xeb, 0x4e, 0x73, 0xd1, 0x7a, 0x48, 0x85, 0xf4, 0x6b, 0x68,
+	0xc1, 0x02, 0x0f, 0x40, 0x31, 0xa4, 0x58, 0x38, 0xf7, 0x35, 0xb9, 0xb3, 0x9c, 0xec, 0x51, 0xec,
+	0x93, 0x88, 0x71, 0xec, 0x41, 0x8b, 0x05, 0xb9, 0x49, 0xf8, 0x1a, 0x00, 0x8f, 0x00, 0x08, 0x91,
+	0x8c, 0x4e, 0x03, 0x8d, 0x2b, 0x69, 0x5c, 0xfb, 0x3f, 0x70, 0xca, 0xcf, 0xa3, 0xd8, 0xdb, 0xb4,
+	0xb4, 0x4a, 0x66, 0x11, 0x7e, 0x25, 0xcc, 0xfe, 0xee, 0xfc, 0x5c, 0x05, 0x25, 0x53, 0x5c, 0x78,
+	0x02, 0x5a, 0x09, 0x9a, 0xe6, 0x1d, 0xcb, 0x96, 0x80, 0xee, 0x5b, 0x75, 0xef, 0xf1, 0xb5, 0xd2,
+	0xf6, 0xad, 0x83, 0x57, 0x56, 0x0a, 0x3f, 0xbe, 0xee, 0x14, 0x7c, 0x98, 0xa0, 0xa9, 0xad, 0x45,
+	0x36, 0xaa, 0xb0, 0x03, 0xc6, 0xcf, 0x10, 0xc7, 0x2a, 0xeb, 0x39, 0xb6, 0x74, 0x0b, 0xac, 0x05,
+	0x78, 0x14, 0x2f, 0x62, 0x39, 0x99, 0x10, 0x2e, 0xc8, 0xbf, 0xb1, 0x0f, 0x6e, 0x81, 0xb5, 0x80,
+	0x45, 0xec, 0xb7, 0x60, 0x93, 0xa6, 0x11, 0x27, 0x09, 0x49, 0x65, 0x20, 0xc6, 0x7c, 0x14, 0x8f,
+	0xd5, 0xe2, 0x2a, 0x74, 0x6b, 0x9e, 0xab, 0x02, 0xff, 0x7c, 0xd5, 0x79, 0x32, 0xa4, 0xf2, 0x74,
+	0x1c, 0xba, 0x11, 0x4b, 0xec, 0xd6, 0xb4, 0x3f, 0xbb, 0x02, 0xff, 0xd0, 0x93, 0xb3, 0x11, 0x11,
+	0x6e, 0x9f, 0x44, 0x7e, 0x33, 0x07, 0x1d, 0x1b, 0x0e, 0x3c, 0x01, 0x8d, 0x39, 0x1c, 0x93, 0x50,
+	0x3a, 0xc5, 0x95, 0xc8, 0xf5, 0x9c, 0xd2, 0x27, 0xa1, 0x84, 0x08, 0xb4, 0xe6, 0xd8, 0x88, 0xc5,
+	0x31, 0x92, 0x84, 0xa3, 0xd8, 0xb9, 0xbf, 0x12, 0xfc, 0x61, 0xce, 0xda, 0xcf, 0x51, 0xf0, 0x3b,
+	0xf0, 0x10, 0x8f, 0xd5, 0xc2, 0x13, 0x12, 0x71, 0x19, 0x8c, 0x38, 0x49, 0xe8, 0x38, 0x71, 0xca,
+	0x2b, 0x29, 0x6c, 0x6a, 0xd4, 0xb1, 0x22, 0x1d, 0x19, 0x10, 0xfc, 0x1a, 0xbc, 0x61, 0xf8, 0xd7,
+	0x56, 0x5f, 0xe5, 0xe6, 0xfd, 0x6c, 0x69, 0xc4, 0xd5, 0xf5, 0x77, 0x08, 0x8c, 0x5e, 0x80, 0x49,
+	0x84, 0x66, 0x41, 0x34, 0xe6, 0x13, 0xe2, 0x80, 0xed, 0x42, 0xb7, 0xb1, 0xb7, 0xbd, 0x7c, 0xeb,
+	0xf4, 0x95, 0xe3, 0xbe, 0xf2, 0xf3, 0x37, 0x74, 0xe8, 0xdc, 0x00, 0x07, 0xe0, 0x4d, 0x43, 0x23,
+	0xd3, 0x11, 0x4b, 0x49, 0x2a, 0x29, 0x8a, 0x0d, 0xd9, 0xa9, 0xae, 0x54, 0x8c, 0x47, 0x1a, 0xf7,
+	0xf1, 0x9c, 0xa6, 0xc5, 0x54, 0x41, 0xcc, 0x4e, 0xbf, 0x56, 0x90, 0xda, 0x2d, 0x0a, 0x12, 0xa2,
+	0x25, 0x05, 0xc9, 0x7b, 0xc9, 0x89, 0x20, 0x7c, 0x42, 0x02, 0x6d, 0x77, 0xea, 0xff, 0xa3, 0x97,
+	0xbe, 0x21, 0xf9, 0x0a, 0x04, 0xbf, 0x02, 0x8f, 0xd4, 0x8e, 0x3c, 0xa5, 0x42, 0x32, 0x3e, 0x0b,
+	0x38, 0x91, 0x6a, 0x62, 0x2c, 0x75, 0x1a, 0x37, 0xcf, 0xfc, 0x61, 0x48, 0xf1, 0xa7, 0x06, 0xe0,
+	0x67, 0xf1, 0x2a, 0xf1, 0x10, 0x5d, 0x4f, 0x7c, 0x63, 0xb5, 0xc4, 0x43, 0x74, 0x35, 0xf1, 0x28,
+	0xab, 0x79, 0x42, 0xd3, 0x20, 0x66, 0x32, 0x18, 0x70, 0xa4, 0x4b, 0xe7, 0x34, 0x57, 0xdb, 0x49,
+	0x9a, 0xf6, 0x25, 0x4d, 0x0f, 0x99, 0xfc, 0xc4, 0xa2, 0xe0, 0xbb, 0xa0, 0x61, 0x45, 0xd0, 0xd4,
+	0x1c, 0xe3, 0x9b, 0xfa, 0x5e, 0xac, 0x19, 0x67, 0x34, 0x55, 0xc7, 0xf2, 0xe7, 0xc5, 0xf2, 0xbd,
+	0xe6, 0xba, 0x5f, 0x5b, 0x3c, 0xd9, 0x76, 0x7e, 0x2a, 0x81, 0xda, 0xe2, 0x45, 0x03, 0xdf, 0x01,
+	0xd9, 0x35, 0x13, 0x28, 0x51, 0x7d, 0x50, 0x57, 0xfc, 0xaa, 0xb5, 0x3d, 0x9f, 0x8d, 0x08, 0x7c,
+	0x0b, 0x54, 0xd4, 0x44, 0x30, 0x49, 0x59, 0xa2, 0x6f, 0xd8, 0x8a, 0x5f, 0x8e, 0x99, 0xec, 0xab,
+	0x6f, 0x78, 0x00, 0x6a, 0x67, 0x34, 0xc5, 0xec, 0xcc, 0xec, 0x6a, 0x7d, 0xcc, 0x55, 0xf7, 0xb6,
+	0xae, 0xf5, 0xe7, 0x79, 0x76, 0xd9, 0x9b, 0x06, 0xbd, 0x50, 0x0d, 0xaa, 0x9a, 0x48, 0xbd, 0x89,
+	0xe1, 0xfb, 0xa0, 0x69, 0x45, 0x85, 0x41, 0x11, 0xac, 0x4f, 0xb6, 0xa2, 0xbf, 0x91, 0xd9, 0x8f,
+	0x8d, 0x19, 0xbe, 0x07, 0x72, 0x53, 0x10, 0xc5, 0x4c, 0x10, 0xac, 0x8f, 0xa9, 0xa2, 0xdf, 0xc8,
+	0xcc, 0xfb, 0xda, 0x0a, 0x47, 0xa0, 0xae, 0x32, 0xb7, 0x56, 0x82, 0xed, 0x6d, 0xf7, 0xd8, 0xb5,
+	0xaf, 0x21, 0xf5, 0x74, 0xca, 0x77, 0xec, 0x3e, 0xa3, 0xa9, 0xf7, 0x81, 0xbd, 0xe8, 0xba, 0x37,
+	0x68, 0x8f, 0x0a, 0x10, 0x7e, 0x2d, 0x66, 0xd9, 0x53, 0x84, 0x60, 0xc8, 0x41, 0x43, 0x9d, 0xc9,
+	0x0b, 0x92, 0x0f, 0xee, 0x5e, 0xb2, 0xae, 0x24, 0xe6, 0x9a, 0x03, 0xa0, 0xda, 0x11, 0x08, 0x16,
+	0x63, 0xa7, 0x7c, 0xf7, 0x6a, 0x0f, 0x62, 0x26, 0x8f, 0x59, 0x8c, 0xe1, 0xf7, 0x00, 0xa8, 0xb5,
+	0xc4, 0x11, 0x55, 0x15, 0xaf, 0xdc, 0xbd, 0x52, 0x25, 0xa4, 0xd8, 0xd7, 0x74, 0x38, 0x01, 0x4d,
+	0x5d, 0xc7, 0x71, 0xca, 0x49, 0xc4, 0x26, 0x84, 0x13, 0xec, 0x80, 0xbb, 0x57, 0xdc, 0x50, 0x22,
+	0x27, 0x73, 0x0d, 0xef, 0x8b, 0xf3, 0xbf, 0xdb, 0x6b, 0xe7, 0x17, 0xed, 0xc2, 0xcb, 0x8b, 0x76,
+	0xe1, 0xaf, 0x8b, 0x76, 0xe1, 0xc5, 0x65, 0x7b, 0xed, 0xe5, 0x65, 0x7b, 0xed, 0x8f, 0xcb, 0xf6,
+	0xda, 0x37, 0xbb, 0x0b, 0xe0, 0x11, 0xe1, 0x11, 0x13, 0x54, 0xec, 0xc6, 0x28, 0x14, 0x3d, 0xfd,
+	0x74, 0x9e, 0xe6, 0x8f, 0x67, 0xad, 0x11, 0x96, 0xf4, 0xea, 0xff, 0xf0, 0x9f, 0x01, 0x00, 0xce,
+	0x25, 0xe8, 0x20, 0xe4, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -179,6 +296,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchBids) > 0 {
+		for iNdEx := len(m.BatchBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AuctionStats) > 0 {
+		for iNdEx := len(m.AuctionStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Auctions) > 0 {
 		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,21 +390,109 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if m.BatchMaxBids != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxBids))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.BatchMinLotFraction.Size()
+		i -= size
+		if _, err := m.BatchMinLotFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.BatchReserveRatio.Size()
+		i -= size
+		if _, err := m.BatchReserveRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BidHistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BidHistoryRetention):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x3a
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	dAtA[i] = 0x72
+	{
+		size := m.DutchReserveRatio.Size()
+		i -= size
+		if _, err := m.DutchReserveRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BatchAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BatchAuctionDuration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x62
+	{
+		size := m.DutchExponentialDecay.Size()
+		i -= size
+		if _, err := m.DutchExponentialDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.DutchDecayCurve != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DutchDecayCurve))
+		i--
+		dAtA[i] = 0x50
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DutchAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DutchAuctionDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DutchStartPremium.Size()
+		i -= size
+		if _, err := m.DutchStartPremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.IncrementCollateral.Size()
@@ -277,17 +524,142 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAuctionDuration):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAuctionDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *AuctionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DebtUnrecovered) > 0 {
+		for iNdEx := len(m.DebtUnrecovered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DebtUnrecovered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BidRaised) > 0 {
+		for iNdEx := len(m.BidRaised) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidRaised[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LotSold) > 0 {
+		for iNdEx := len(m.LotSold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LotSold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DebtAuctioned) > 0 {
+		for iNdEx := len(m.DebtAuctioned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DebtAuctioned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LotAuctioned) > 0 {
+		for iNdEx := len(m.LotAuctioned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LotAuctioned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AuctionsClosed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionsClosed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuctionsStarted != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionsStarted))
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LotDenom) > 0 {
+		i -= len(m.LotDenom)
+		copy(dAtA[i:], m.LotDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LotDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -316,6 +688,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AuctionStats) > 0 {
+		for _, e := range m.AuctionStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchBids) > 0 {
+		for _, e := range m.BatchBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +727,83 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchStartPremium.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DutchAuctionDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DutchDecayCurve != 0 {
+		n += 1 + sovGenesis(uint64(m.DutchDecayCurve))
+	}
+	l = m.DutchExponentialDecay.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BatchAuctionDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchReserveRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BidHistoryRetention)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BatchReserveRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BatchMinLotFraction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.BatchMaxBids != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchMaxBids))
+	}
+	return n
+}
+
+func (m *AuctionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.LotDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.AuctionsStarted != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionsStarted))
+	}
+	if m.AuctionsClosed != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionsClosed))
+	}
+	if len(m.LotAuctioned) > 0 {
+		for _, e := range m.LotAuctioned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DebtAuctioned) > 0 {
+		for _, e := range m.DebtAuctioned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LotSold) > 0 {
+		for _, e := range m.LotSold {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidRaised) > 0 {
+		for _, e := range m.BidRaised {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DebtUnrecovered) > 0 {
+		for _, e := range m.DebtUnrecovered {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -461,17 +928,119 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionStats = append(m.AuctionStats, AuctionStats{})
+			if err := m.AuctionStats[len(m.AuctionStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, BidRecord{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchBids = append(m.BatchBids, BatchBid{})
+			if err := m.BatchBids[len(m.BatchBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -709,6 +1278,663 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchStartPremium", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchStartPremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DutchAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchDecayCurve", wireType)
+			}
+			m.DutchDecayCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DutchDecayCurve |= DecayCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchExponentialDecay", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchExponentialDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BatchAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchReserveRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchReserveRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BidHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchReserveRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchReserveRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMinLotFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchMinLotFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxBids", wireType)
+			}
+			m.BatchMaxBids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxBids |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionsStarted", wireType)
+			}
+			m.AuctionsStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionsStarted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionsClosed", wireType)
+			}
+			m.AuctionsClosed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionsClosed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotAuctioned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotAuctioned = append(m.LotAuctioned, types1.Coin{})
+			if err := m.LotAuctioned[len(m.LotAuctioned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctioned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtAuctioned = append(m.DebtAuctioned, types1.Coin{})
+			if err := m.DebtAuctioned[len(m.DebtAuctioned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotSold = append(m.LotSold, types1.Coin{})
+			if err := m.LotSold[len(m.LotSold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidRaised", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidRaised = append(m.BidRaised, types1.Coin{})
+			if err := m.BidRaised[len(m.BidRaised)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtUnrecovered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtUnrecovered = append(m.DebtUnrecovered, types1.Coin{})
+			if err := m.DebtUnrecovered[len(m.DebtUnrecovered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,42 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	}
 
+	batchAuction := &BatchAuction{
+		BaseAuction: BaseAuction{
+			ID:              11,
+			Initiator:       "seller mod account",
+			Lot:             sdk.NewInt64Coin("btc", 1e8),
+			Bidder:          sdk.AccAddress("test bidder"),
+			Bid:             sdk.NewInt64Coin("usdf", 0),
+			HasReceivedBids: true,
+			EndTime:         arbitraryTime,
+			MaxEndTime:      arbitraryTime,
+		},
+		CorrespondingDebt: sdk.NewInt64Coin("debt", 1e9),
+		MaxBid:            sdk.NewInt64Coin("usdf", 5e4),
+		LotReturns:        validAuction.LotReturns,
+		BidCount:          1,
+		ReservePrice:      sdk.ZeroDec(),
+	}
+	batchBid := BatchBid{
+		Bidder:    sdk.AccAddress("test bidder"),
+		Lot:       sdk.NewInt64Coin("btc", 1e7),
+		Bid:       sdk.NewInt64Coin("usdf", 5e3),
+		AuctionID: batchAuction.ID,
+	}
+	batchGenesis := func(bids BatchBids) *GenesisState {
+		return &GenesisState{
+			NextAuctionId: batchAuction.ID + 1,
+			Params:        DefaultParams(),
+			Auctions:      mustPackGenesisAuctions([]GenesisAuction{validAuction, batchAuction}),
+			BatchBids:     bids,
+		}
+	}
+	bidOnCollateralAuction := batchBid
+	bidOnCollateralAuction.AuctionID = validAuction.ID
+	duplicateBidder := batchBid
+	duplicateBidder.Lot = sdk.NewInt64Coin("btc", 2e7)
+
 	testCases := []struct {
 		name       string
 		genesis    *GenesisState
@@ -61,6 +97,9 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				nil,
+				nil,
+				nil,
 			},
 			false,
 		},
@@ -75,9 +114,37 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				nil,
+				nil,
+				nil,
 			},
 			false,
 		},
+		{
+			"valid batch bids",
+			batchGenesis(BatchBids{batchBid}),
+			true,
+		},
+		{
+			"batch bids do not match bid count",
+			batchGenesis(nil),
+			false,
+		},
+		{
+			"batch bid on collateral auction",
+			batchGenesis(BatchBids{batchBid, bidOnCollateralAuction}),
+			false,
+		},
+		{
+			"duplicate batch bidder",
+			batchGenesis(BatchBids{batchBid, duplicateBidder}),
+			false,
+		},
+		{
+			"invalid batch bid",
+			batchGenesis(BatchBids{{Bidder: batchBid.Bidder, Lot: sdk.NewInt64Coin("btc", 2e8), Bid: batchBid.Bid, AuctionID: batchAuction.ID}}),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
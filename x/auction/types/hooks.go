@@ -0,0 +1,18 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiAuctionHooks combine multiple auction hooks, all hook functions are run in array sequence
+type MultiAuctionHooks []AuctionHooks
+
+// NewMultiAuctionHooks returns a new MultiAuctionHooks
+func NewMultiAuctionHooks(hooks ...AuctionHooks) MultiAuctionHooks {
+	return hooks
+}
+
+// AfterAuctionClosed runs after an auction has been paid out and before it is deleted
+func (h MultiAuctionHooks) AfterAuctionClosed(ctx sdk.Context, auction Auction) {
+	for i := range h {
+		h[i].AfterAuctionClosed(ctx, auction)
+	}
+}
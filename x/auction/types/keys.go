@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -30,6 +31,13 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	AuctionStatsKeyPrefix = []byte{0x03} // prefix for keys that store auction stats
+	BidKeyPrefix          = []byte{0x04} // prefix for keys that store the bid history of auctions
+	BidderIndexKeyPrefix  = []byte{0x05} // prefix for keys that are part of the auctionsByBidder index
+	BidPruneKeyPrefix     = []byte{0x06} // prefix for keys that are part of the queue of closed auctions whose bid history is pruned by time
+	BatchBidKeyPrefix     = []byte{0x07} // prefix for keys that store the bids held by batch auctions
+	BatchBidderKeyPrefix  = []byte{0x08} // prefix for keys that are part of the batch bids by bidder index
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -37,11 +45,39 @@ func GetAuctionKey(auctionID uint64) []byte {
 	return Uint64ToBytes(auctionID)
 }
 
-// GetAuctionByTimeKey returns the key for iterating auctions by time
+// GetAuctionByTimeKey returns the key for iterating auctions by time.
+// It is also used to key the bid history pruning queue.
 func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetAuctionStatsKey returns the key of the stats of an auction type and lot denom for the window starting at windowStart
+func GetAuctionStatsKey(windowStart time.Time, auctionType, lotDenom string) []byte {
+	key := append(sdk.FormatTimeBytes(windowStart), []byte(auctionType)...)
+	key = append(key, 0x00)
+	return append(key, []byte(lotDenom)...)
+}
+
+// GetBidKey returns the key of a bid in the bid history of an auction
+func GetBidKey(auctionID, sequence uint64) []byte {
+	return append(Uint64ToBytes(auctionID), Uint64ToBytes(sequence)...)
+}
+
+// GetBidderIndexKey returns the key for iterating the auctions a bidder has bid on
+func GetBidderIndexKey(bidder sdk.AccAddress, auctionID uint64) []byte {
+	return append(address.MustLengthPrefix(bidder), Uint64ToBytes(auctionID)...)
+}
+
+// GetBatchBidKey returns the key of a bid held by a batch auction, ordered by when it was placed
+func GetBatchBidKey(auctionID, sequence uint64) []byte {
+	return append(Uint64ToBytes(auctionID), Uint64ToBytes(sequence)...)
+}
+
+// GetBatchBidderKey returns the key for finding a bidder's bid on a batch auction
+func GetBatchBidderKey(auctionID uint64, bidder sdk.AccAddress) []byte {
+	return append(Uint64ToBytes(auctionID), address.MustLengthPrefix(bidder)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
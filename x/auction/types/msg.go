@@ -9,7 +9,10 @@ import (
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgPlaceBatchBid{}
+)
 
 // NewMsgPlaceBid returns a new MsgPlaceBid.
 func NewMsgPlaceBid(auctionID uint64, bidder string, amt sdk.Coin) MsgPlaceBid {
@@ -55,3 +58,52 @@ func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{bidder}
 }
+
+// NewMsgPlaceBatchBid returns a new MsgPlaceBatchBid.
+func NewMsgPlaceBatchBid(auctionID uint64, bidder string, lot, bid sdk.Coin) MsgPlaceBatchBid {
+	return MsgPlaceBatchBid{
+		AuctionId: auctionID,
+		Bidder:    bidder,
+		Lot:       lot,
+		Bid:       bid,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceBatchBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceBatchBid) Type() string { return "place_batch_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceBatchBid) ValidateBasic() error {
+	if msg.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if !msg.Lot.IsValid() || !msg.Lot.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "lot amount %s", msg.Lot)
+	}
+	if !msg.Bid.IsValid() || !msg.Bid.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", msg.Bid)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceBatchBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceBatchBid) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
@@ -50,3 +50,51 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlaceBatchBid_ValidateBasic(t *testing.T) {
+	bidder := sdk.AccAddress("batchBidder").String()
+	tests := []struct {
+		name       string
+		msg        MsgPlaceBatchBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceBatchBid(1, bidder, c("lot", 10), c("token", 10)),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgPlaceBatchBid(0, bidder, c("lot", 10), c("token", 10)),
+			false,
+		},
+		{
+			"empty address",
+			NewMsgPlaceBatchBid(1, "", c("lot", 10), c("token", 10)),
+			false,
+		},
+		{
+			"zero lot",
+			NewMsgPlaceBatchBid(1, bidder, c("lot", 0), c("token", 10)),
+			false,
+		},
+		{
+			"zero amount",
+			NewMsgPlaceBatchBid(1, bidder, c("lot", 10), c("token", 0)),
+			false,
+		},
+		{
+			"negative amount",
+			NewMsgPlaceBatchBid(1, bidder, c("lot", 10), sdk.Coin{Denom: "token", Amount: sdkmath.NewInt(-10)}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
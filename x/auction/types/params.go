@@ -19,18 +19,48 @@ const (
 	DefaultForwardBidDuration time.Duration = 24 * time.Hour
 	// DefaultReverseBidDuration how long an auction gets extended when someone bids for a reverse auction
 	DefaultReverseBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchAuctionDuration how long a dutch auction runs for
+	DefaultDutchAuctionDuration time.Duration = 6 * time.Hour
+	// DefaultDutchDecayCurve the curve dutch auction prices follow
+	DefaultDutchDecayCurve = DECAY_CURVE_LINEAR
+	// DefaultBatchAuctionDuration how long a batch auction collects bids before it is settled
+	DefaultBatchAuctionDuration time.Duration = 6 * time.Hour
+	// DefaultBidHistoryRetention how long the bid history of an auction is kept after it closes
+	DefaultBidHistoryRetention time.Duration = 7 * 24 * time.Hour
+	// DefaultBatchMaxBids the most bids a batch auction holds
+	DefaultBatchMaxBids uint64 = 100
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchStartPremium is the premium over the market price that dutch auctions start at
+	DefaultDutchStartPremium sdk.Dec = sdk.MustNewDecFromStr("0.2")
+	// DefaultDutchExponentialDecay is the fraction of the price exponentially decaying dutch auctions lose each second
+	DefaultDutchExponentialDecay sdk.Dec = sdk.MustNewDecFromStr("0.0001")
+	// DefaultDutchReserveRatio is the fraction of the market price below which dutch auction prices do not decay
+	DefaultDutchReserveRatio sdk.Dec = sdk.MustNewDecFromStr("0.8")
+	// DefaultBatchReserveRatio is the fraction of the market price below which batch auction bids are not accepted
+	DefaultBatchReserveRatio sdk.Dec = sdk.MustNewDecFromStr("0.8")
+	// DefaultBatchMinLotFraction is the smallest fraction of a batch auction's lot that a bid can be for
+	DefaultBatchMinLotFraction sdk.Dec = sdk.MustNewDecFromStr("0.001")
 	// ParamStoreKeyParams Param store key for auction params
-	KeyForwardBidDuration  = []byte("ForwardBidDuration")
-	KeyReverseBidDuration  = []byte("ReverseBidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyForwardBidDuration    = []byte("ForwardBidDuration")
+	KeyReverseBidDuration    = []byte("ReverseBidDuration")
+	KeyMaxAuctionDuration    = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus      = []byte("IncrementSurplus")
+	KeyIncrementDebt         = []byte("IncrementDebt")
+	KeyIncrementCollateral   = []byte("IncrementCollateral")
+	KeyDutchStartPremium     = []byte("DutchStartPremium")
+	KeyDutchAuctionDuration  = []byte("DutchAuctionDuration")
+	KeyDutchDecayCurve       = []byte("DutchDecayCurve")
+	KeyDutchExponentialDecay = []byte("DutchExponentialDecay")
+	KeyBatchAuctionDuration  = []byte("BatchAuctionDuration")
+	KeyDutchReserveRatio     = []byte("DutchReserveRatio")
+	KeyBidHistoryRetention   = []byte("BidHistoryRetention")
+	KeyBatchReserveRatio     = []byte("BatchReserveRatio")
+	KeyBatchMinLotFraction   = []byte("BatchMinLotFraction")
+	KeyBatchMaxBids          = []byte("BatchMaxBids")
 )
 
 // NewParams returns a new Params object.
@@ -39,14 +69,34 @@ func NewParams(
 	incrementSurplus,
 	incrementDebt,
 	incrementCollateral sdk.Dec,
+	dutchStartPremium sdk.Dec,
+	dutchAuctionDuration time.Duration,
+	dutchDecayCurve DecayCurve,
+	dutchExponentialDecay sdk.Dec,
+	batchAuctionDuration time.Duration,
+	dutchReserveRatio sdk.Dec,
+	bidHistoryRetention time.Duration,
+	batchReserveRatio sdk.Dec,
+	batchMinLotFraction sdk.Dec,
+	batchMaxBids uint64,
 ) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		ForwardBidDuration:  forwardBidDuration,
-		ReverseBidDuration:  reverseBidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDuration:    maxAuctionDuration,
+		ForwardBidDuration:    forwardBidDuration,
+		ReverseBidDuration:    reverseBidDuration,
+		IncrementSurplus:      incrementSurplus,
+		IncrementDebt:         incrementDebt,
+		IncrementCollateral:   incrementCollateral,
+		DutchStartPremium:     dutchStartPremium,
+		DutchAuctionDuration:  dutchAuctionDuration,
+		DutchDecayCurve:       dutchDecayCurve,
+		DutchExponentialDecay: dutchExponentialDecay,
+		BatchAuctionDuration:  batchAuctionDuration,
+		DutchReserveRatio:     dutchReserveRatio,
+		BidHistoryRetention:   bidHistoryRetention,
+		BatchReserveRatio:     batchReserveRatio,
+		BatchMinLotFraction:   batchMinLotFraction,
+		BatchMaxBids:          batchMaxBids,
 	}
 }
 
@@ -59,6 +109,16 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchStartPremium,
+		DefaultDutchAuctionDuration,
+		DefaultDutchDecayCurve,
+		DefaultDutchExponentialDecay,
+		DefaultBatchAuctionDuration,
+		DefaultDutchReserveRatio,
+		DefaultBidHistoryRetention,
+		DefaultBatchReserveRatio,
+		DefaultBatchMinLotFraction,
+		DefaultBatchMaxBids,
 	)
 }
 
@@ -76,6 +136,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		paramtypes.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		paramtypes.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		paramtypes.NewParamSetPair(KeyDutchStartPremium, &p.DutchStartPremium, validateDutchStartPremiumParam),
+		paramtypes.NewParamSetPair(KeyDutchAuctionDuration, &p.DutchAuctionDuration, validateDutchAuctionDurationParam),
+		paramtypes.NewParamSetPair(KeyDutchDecayCurve, &p.DutchDecayCurve, validateDutchDecayCurveParam),
+		paramtypes.NewParamSetPair(KeyDutchExponentialDecay, &p.DutchExponentialDecay, validateDutchExponentialDecayParam),
+		paramtypes.NewParamSetPair(KeyBatchAuctionDuration, &p.BatchAuctionDuration, validateBatchAuctionDurationParam),
+		paramtypes.NewParamSetPair(KeyDutchReserveRatio, &p.DutchReserveRatio, validateDutchReserveRatioParam),
+		paramtypes.NewParamSetPair(KeyBidHistoryRetention, &p.BidHistoryRetention, validateBidHistoryRetentionParam),
+		paramtypes.NewParamSetPair(KeyBatchReserveRatio, &p.BatchReserveRatio, validateBatchReserveRatioParam),
+		paramtypes.NewParamSetPair(KeyBatchMinLotFraction, &p.BatchMinLotFraction, validateBatchMinLotFractionParam),
+		paramtypes.NewParamSetPair(KeyBatchMaxBids, &p.BatchMaxBids, validateBatchMaxBidsParam),
 	}
 }
 
@@ -109,7 +179,59 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchStartPremiumParam(p.DutchStartPremium); err != nil {
+		return err
+	}
+
+	if err := validateDutchAuctionDurationParam(p.DutchAuctionDuration); err != nil {
+		return err
+	}
+
+	if p.DutchAuctionDuration > p.MaxAuctionDuration {
+		return errors.New("dutch auction duration param cannot be larger than max auction duration")
+	}
+
+	if err := validateDutchDecayCurveParam(p.DutchDecayCurve); err != nil {
+		return err
+	}
+
+	if err := validateDutchExponentialDecayParam(p.DutchExponentialDecay); err != nil {
+		return err
+	}
+
+	if err := validateBatchAuctionDurationParam(p.BatchAuctionDuration); err != nil {
+		return err
+	}
+
+	if p.BatchAuctionDuration > p.MaxAuctionDuration {
+		return errors.New("batch auction duration param cannot be larger than max auction duration")
+	}
+
+	if err := validateDutchReserveRatioParam(p.DutchReserveRatio); err != nil {
+		return err
+	}
+
+	if err := validateBidHistoryRetentionParam(p.BidHistoryRetention); err != nil {
+		return err
+	}
+
+	if err := validateBatchReserveRatioParam(p.BatchReserveRatio); err != nil {
+		return err
+	}
+
+	if err := validateBatchMinLotFractionParam(p.BatchMinLotFraction); err != nil {
+		return err
+	}
+
+	if err := validateBatchMaxBidsParam(p.BatchMaxBids); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -188,3 +310,149 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchStartPremiumParam(i interface{}) error {
+	premium, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if premium == emptyDec || premium.IsNil() {
+		return errors.New("dutch auction start premium cannot be nil or empty")
+	}
+
+	if premium.IsNegative() {
+		return fmt.Errorf("dutch auction start premium cannot be less than zero %s", premium)
+	}
+
+	return nil
+}
+
+func validateDutchAuctionDurationParam(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("dutch auction duration must be positive %d", duration)
+	}
+
+	return nil
+}
+
+func validateDutchDecayCurveParam(i interface{}) error {
+	curve, ok := i.(DecayCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return curve.Validate()
+}
+
+func validateDutchExponentialDecayParam(i interface{}) error {
+	decay, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if decay == emptyDec || decay.IsNil() {
+		return errors.New("dutch auction exponential decay cannot be nil or empty")
+	}
+
+	if decay.IsNegative() || decay.GTE(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction exponential decay must be between 0 and 1 (exclusive), is %s", decay)
+	}
+
+	return nil
+}
+
+func validateBatchAuctionDurationParam(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("batch auction duration must be positive %d", duration)
+	}
+
+	return nil
+}
+
+func validateDutchReserveRatioParam(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if ratio == emptyDec || ratio.IsNil() {
+		return errors.New("dutch auction reserve ratio cannot be nil or empty")
+	}
+
+	if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction reserve ratio must be between 0 and 1, is %s", ratio)
+	}
+
+	return nil
+}
+
+func validateBidHistoryRetentionParam(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention < 0 {
+		return fmt.Errorf("bid history retention cannot be negative %d", retention)
+	}
+
+	return nil
+}
+
+func validateBatchReserveRatioParam(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if ratio == emptyDec || ratio.IsNil() {
+		return errors.New("batch auction reserve ratio cannot be nil or empty")
+	}
+
+	if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("batch auction reserve ratio must be between 0 and 1, is %s", ratio)
+	}
+
+	return nil
+}
+
+func validateBatchMinLotFractionParam(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fraction == emptyDec || fraction.IsNil() {
+		return errors.New("batch auction min lot fraction cannot be nil or empty")
+	}
+
+	if !fraction.IsPositive() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("batch auction min lot fraction must be between 0 (exclusive) and 1, is %s", fraction)
+	}
+
+	return nil
+}
+
+func validateBatchMaxBidsParam(i interface{}) error {
+	maxBids, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxBids == 0 {
+		return errors.New("batch auction max bids must be positive")
+	}
+
+	return nil
+}
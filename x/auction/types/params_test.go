@@ -114,6 +114,291 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"negative dutch start premium",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("-0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+			},
+			true,
+		},
+		{
+			"dutch duration>auction",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  48 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+			},
+			true,
+		},
+		{
+			"unspecified dutch decay curve",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_UNSPECIFIED,
+				DutchExponentialDecay: d("0.0001"),
+			},
+			true,
+		},
+		{
+			"dutch exponential decay of one",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_EXPONENTIAL,
+				DutchExponentialDecay: d("1"),
+			},
+			true,
+		},
+		{
+			"batch duration>auction",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  48 * time.Hour,
+			},
+			true,
+		},
+		{
+			"negative dutch reserve ratio",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("-0.1"),
+			},
+			true,
+		},
+		{
+			"dutch reserve ratio above one",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("1.1"),
+			},
+			true,
+		},
+		{
+			"nil dutch reserve ratio",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+			},
+			true,
+		},
+		{
+			"negative bid history retention",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   -1 * time.Hour,
+			},
+			true,
+		},
+		{
+			"zero bid history retention",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   0,
+				BatchReserveRatio:     d("0.8"),
+				BatchMinLotFraction:   d("0.001"),
+				BatchMaxBids:          100,
+			},
+			false,
+		},
+		{
+			"negative batch reserve ratio",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   time.Hour,
+				BatchReserveRatio:     d("-0.1"),
+				BatchMinLotFraction:   d("0.001"),
+				BatchMaxBids:          100,
+			},
+			true,
+		},
+		{
+			"batch reserve ratio above one",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   time.Hour,
+				BatchReserveRatio:     d("1.1"),
+				BatchMinLotFraction:   d("0.001"),
+				BatchMaxBids:          100,
+			},
+			true,
+		},
+		{
+			"zero batch min lot fraction",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   time.Hour,
+				BatchReserveRatio:     d("0.8"),
+				BatchMinLotFraction:   d("0"),
+				BatchMaxBids:          100,
+			},
+			true,
+		},
+		{
+			"batch min lot fraction above one",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   time.Hour,
+				BatchReserveRatio:     d("0.8"),
+				BatchMinLotFraction:   d("1.1"),
+				BatchMaxBids:          100,
+			},
+			true,
+		},
+		{
+			"zero batch max bids",
+			Params{
+				MaxAuctionDuration:    24 * time.Hour,
+				ForwardBidDuration:    1 * time.Hour,
+				ReverseBidDuration:    1 * time.Hour,
+				IncrementSurplus:      d("0.05"),
+				IncrementDebt:         d("0.05"),
+				IncrementCollateral:   d("0.05"),
+				DutchStartPremium:     d("0.2"),
+				DutchAuctionDuration:  6 * time.Hour,
+				DutchDecayCurve:       DECAY_CURVE_LINEAR,
+				DutchExponentialDecay: d("0.0001"),
+				BatchAuctionDuration:  6 * time.Hour,
+				DutchReserveRatio:     d("0.8"),
+				BidHistoryRetention:   time.Hour,
+				BatchReserveRatio:     d("0.8"),
+				BatchMinLotFraction:   d("0.001"),
+				BatchMaxBids:          0,
+			},
+			true,
+		},
 		{
 			"zero value",
 			Params{},
@@ -6,24 +6,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -293,6 +298,352 @@ func (m *QueryAuctionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAuctionBidsRequest is the request type for the Query/AuctionBids RPC method.
+type QueryAuctionBidsRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidsRequest) Reset()         { *m = QueryAuctionBidsRequest{} }
+func (m *QueryAuctionBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsRequest) ProtoMessage()    {}
+func (*QueryAuctionBidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa9ebc446bec28, []int{6}
+}
+func (m *QueryAuctionBidsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionBidsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsRequest.Merge(m, src)
+}
+func (m *QueryAuctionBidsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionBidsRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *QueryAuctionBidsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionBidsResponse is the response type for the Query/AuctionBids RPC method.
+type QueryAuctionBidsResponse struct {
+	// bids are the bids placed on the auction, oldest first
+	Bids BidRecords `protobuf:"bytes,1,rep,name=bids,proto3,castrepeated=BidRecords" json:"bids"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidsResponse) Reset()         { *m = QueryAuctionBidsResponse{} }
+func (m *QueryAuctionBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsResponse) ProtoMessage()    {}
+func (*QueryAuctionBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa9ebc446bec28, []int{7}
+}
+func (m *QueryAuctionBidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionBidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsResponse.Merge(m, src)
+}
+func (m *QueryAuctionBidsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionBidsResponse) GetBids() BidRecords {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryAuctionBidsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBidderAuctionsRequest is the request type for the Query/BidderAuctions RPC method.
+type QueryBidderAuctionsRequest struct {
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (m *QueryBidderAuctionsRequest) Reset()         { *m = QueryBidderAuctionsRequest{} }
+func (m *QueryBidderAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAuctionsRequest) ProtoMessage()    {}
+func (*QueryBidderAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa9ebc446bec28, []int{8}
+}
+func (m *QueryBidderAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidderAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAuctionsRequest.Merge(m, src)
+}
+func (m *QueryBidderAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidderAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryBidderAuctionsRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+// QueryBidderAuctionsResponse is the response type for the Query/BidderAuctions RPC method.
+type QueryBidderAuctionsResponse struct {
+	// active_auctions are the auctions where the bidder holds the top bid
+	ActiveAuctions []*types.Any `protobuf:"bytes,1,rep,name=active_auctions,json=activeAuctions,proto3" json:"active_auctions,omitempty"`
+	// outbid_auctions are the auctions where the bidder's bid has been replaced by another bidder
+	OutbidAuctions []*types.Any `protobuf:"bytes,2,rep,name=outbid_auctions,json=outbidAuctions,proto3" json:"outbid_auctions,omitempty"`
+	// closed_auction_ids are the IDs of closed auctions the bidder bid on whose bid history is still retained
+	ClosedAuctionIds []uint64 `protobuf:"varint,3,rep,packed,name=closed_auction_ids,json=closedAuctionIds,proto3" json:"closed_auction_ids,omitempty"`
+}
+
+func (m *QueryBidderAuctionsResponse) Reset()         { *m = QueryBidderAuctionsResponse{} }
+func (m *QueryBidderAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAuctionsResponse) ProtoMessage()    {}
+func (*QueryBidderAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa9ebc446bec28, []int{9}
+}
+func (m *QueryBidderAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidderAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAuctionsResponse.Merge(m, src)
+}
+func (m *QueryBidderAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidderAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryBidderAuctionsResponse) GetActiveAuctions() []*types.Any {
+	if m != nil {
+		return m.ActiveAuctions
+	}
+	return nil
+}
+
+func (m *QueryBidderAuctionsResponse) GetOutbidAuctions() []*types.Any {
+	if m != nil {
+		return m.OutbidAuctions
+	}
+	return nil
+}
+
+func (m *QueryBidderAuctionsResponse) GetClosedAuctionIds() []uint64 {
+	if m != nil {
+		return m.ClosedAuctionIds
+	}
+	return nil
+}
+
+// QueryAuctionStatsRequest is the request type for the Query/AuctionStats RPC method.
+type QueryAuctionStatsRequest struct {
+	// type filters the stats by auction type, all types are returned if empty
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// lot_denom filters the stats by lot denom, all denoms are returned if empty
+	LotDenom string `protobuf:"bytes,2,opt,name=lot_denom,json=lotDenom,proto3" json:"lot_denom,omitempty"`
+	// start_time excludes windows starting before it, if set
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time excludes windows starting at or after it, if set
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryAuctionStatsRequest) Reset()         { *m = QueryAuctionStatsRequest{} }
+func (m *QueryAuctionStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionStatsRequest) ProtoMessage()    {}
+func (*QueryAuctionStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa9ebc446bec28, []int{10}
+}
+func (m *QueryAuctionStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionStatsRequest.Merge(m, src)
+}
+func (m *QueryAuctionStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionStatsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QueryAuctionStatsRequest) GetLotDenom() string {
+	if m != nil {
+		return m.LotDenom
+	}
+	return ""
+}
+
+func (m *QueryAuctionStatsRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryAuctionStatsRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// QueryAuctionStatsResponse is the response type for the Query/AuctionStats RPC method.
+type QueryAuctionStatsResponse struct {
+	// stats are the totals of each auction type and lot denom for each time window
+	Stats AuctionStatsList `protobuf:"bytes,1,rep,name=stats,proto3,castrepeated=AuctionStatsList" json:"stats"`
+	// totals are the totals of each auction type and lot denom summed over all returned windows
+	Totals AuctionStatsList `protobuf:"bytes,2,rep,name=totals,proto3,castrepeated=AuctionStatsList" json:"totals"`
+}
+
+func (m *QueryAuctionStatsResponse) Reset()         { *m = QueryAuctionStatsResponse{} }
+func (m *QueryAuctionStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionStatsResponse) ProtoMessage()    {}
+func (*QueryAuctionStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa9ebc446bec28, []int{11}
+}
+func (m *QueryAuctionStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionStatsResponse.Merge(m, src)
+}
+func (m *QueryAuctionStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionStatsResponse) GetStats() AuctionStatsList {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryAuctionStatsResponse) GetTotals() AuctionStatsList {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
 // QueryNextAuctionIDRequest defines the request type for querying x/auction next auction ID.
 type QueryNextAuctionIDRequest struct {
 }
@@ -301,7 +652,7 @@ func (m *QueryNextAuctionIDRequest) Reset()         { *m = QueryNextAuctionIDReq
 func (m *QueryNextAuctionIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextAuctionIDRequest) ProtoMessage()    {}
 func (*QueryNextAuctionIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54fa9ebc446bec28, []int{6}
+	return fileDescriptor_54fa9ebc446bec28, []int{12}
 }
 func (m *QueryNextAuctionIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +690,7 @@ func (m *QueryNextAuctionIDResponse) Reset()         { *m = QueryNextAuctionIDRe
 func (m *QueryNextAuctionIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextAuctionIDResponse) ProtoMessage()    {}
 func (*QueryNextAuctionIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54fa9ebc446bec28, []int{7}
+	return fileDescriptor_54fa9ebc446bec28, []int{13}
 }
 func (m *QueryNextAuctionIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,6 +733,12 @@ func init() {
 	proto.RegisterType((*QueryAuctionResponse)(nil), "fury.auction.v1beta1.QueryAuctionResponse")
 	proto.RegisterType((*QueryAuctionsRequest)(nil), "fury.auction.v1beta1.QueryAuctionsRequest")
 	proto.RegisterType((*QueryAuctionsResponse)(nil), "fury.auction.v1beta1.QueryAuctionsResponse")
+	proto.RegisterType((*QueryAuctionBidsRequest)(nil), "fury.auction.v1beta1.QueryAuctionBidsRequest")
+	proto.RegisterType((*QueryAuctionBidsResponse)(nil), "fury.auction.v1beta1.QueryAuctionBidsResponse")
+	proto.RegisterType((*QueryBidderAuctionsRequest)(nil), "fury.auction.v1beta1.QueryBidderAuctionsRequest")
+	proto.RegisterType((*QueryBidderAuctionsResponse)(nil), "fury.auction.v1beta1.QueryBidderAuctionsResponse")
+	proto.RegisterType((*QueryAuctionStatsRequest)(nil), "fury.auction.v1beta1.QueryAuctionStatsRequest")
+	proto.RegisterType((*QueryAuctionStatsResponse)(nil), "fury.auction.v1beta1.QueryAuctionStatsResponse")
 	proto.RegisterType((*QueryNextAuctionIDRequest)(nil), "fury.auction.v1beta1.QueryNextAuctionIDRequest")
 	proto.RegisterType((*QueryNextAuctionIDResponse)(nil), "fury.auction.v1beta1.QueryNextAuctionIDResponse")
 }
@@ -389,47 +746,73 @@ func init() {
 func init() { proto.RegisterFile("fury/auction/v1beta1/query.proto", fileDescriptor_54fa9ebc446bec28) }
 
 var fileDescriptor_54fa9ebc446bec28 = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3d, 0x6f, 0xd4, 0x4c,
-	0x10, 0xc7, 0xed, 0xcb, 0xe5, 0x6d, 0x1f, 0x3d, 0x14, 0x8b, 0x91, 0x2e, 0x26, 0x38, 0x91, 0x05,
-	0x49, 0x48, 0xf0, 0x6e, 0x5e, 0xba, 0x14, 0x48, 0x89, 0x50, 0xa2, 0x34, 0x88, 0xb8, 0xa4, 0x41,
-	0xeb, 0xbb, 0x8d, 0x63, 0x29, 0xe7, 0x75, 0xbc, 0x36, 0xe4, 0x84, 0x68, 0xa0, 0x41, 0xa2, 0x41,
-	0x20, 0xfa, 0xf0, 0x45, 0xa8, 0x53, 0x46, 0xa2, 0x80, 0x0a, 0xa1, 0x84, 0x82, 0x8f, 0x81, 0xbc,
-	0x3b, 0xbe, 0x9c, 0xc1, 0x1c, 0xd7, 0xed, 0x8e, 0xff, 0x33, 0xf3, 0x9b, 0xdd, 0xff, 0x1a, 0xcd,
-	0x1f, 0xe4, 0x69, 0x8f, 0xb2, 0xbc, 0x9d, 0x45, 0x22, 0xa6, 0x4f, 0xd7, 0x02, 0x9e, 0xb1, 0x35,
-	0x7a, 0x9c, 0xf3, 0xb4, 0x47, 0x92, 0x54, 0x64, 0x02, 0x5b, 0x85, 0x82, 0x80, 0x82, 0x80, 0xc2,
-	0x5e, 0x6e, 0x0b, 0xd9, 0x15, 0x92, 0x06, 0x4c, 0x72, 0x2d, 0xef, 0x27, 0x27, 0x2c, 0x8c, 0x62,
-	0xa6, 0xd4, 0xaa, 0x82, 0xed, 0xd6, 0xf6, 0x08, 0x79, 0xcc, 0x65, 0x24, 0x41, 0x63, 0x85, 0x22,
-	0x14, 0x6a, 0x49, 0x8b, 0x15, 0x44, 0x67, 0x43, 0x21, 0xc2, 0x23, 0x4e, 0x59, 0x12, 0x51, 0x16,
-	0xc7, 0x22, 0x53, 0x65, 0xcb, 0x9c, 0x19, 0xf8, 0xaa, 0x76, 0x41, 0x7e, 0x40, 0x59, 0x0c, 0xd0,
-	0xae, 0x85, 0xf0, 0x7e, 0x01, 0xf5, 0x88, 0xa5, 0xac, 0x2b, 0x7d, 0x7e, 0x9c, 0x73, 0x99, 0xb9,
-	0xfb, 0xe8, 0x7a, 0x25, 0x2a, 0x13, 0x11, 0x4b, 0x8e, 0x37, 0xd1, 0x44, 0xa2, 0x22, 0x2d, 0x73,
-	0xde, 0x5c, 0xfa, 0x6f, 0x7d, 0x96, 0xd4, 0x8d, 0x4c, 0x74, 0xd6, 0x76, 0xf3, 0xec, 0xdb, 0x9c,
-	0xe1, 0x43, 0x86, 0x7b, 0x1f, 0x4a, 0x6e, 0x69, 0x31, 0x74, 0xc2, 0xb7, 0x10, 0x82, 0xf4, 0x27,
-	0x51, 0x47, 0x95, 0x6d, 0xfa, 0xd3, 0x10, 0xd9, 0xeb, 0x6c, 0x4e, 0xbd, 0x3e, 0x9d, 0x33, 0x7e,
-	0x9e, 0xce, 0x19, 0xee, 0x0e, 0xb2, 0xaa, 0xf9, 0xc0, 0x44, 0xd0, 0x24, 0xc8, 0x01, 0xca, 0x22,
-	0x7a, 0x5a, 0x52, 0x4e, 0x4b, 0xb6, 0xe2, 0x9e, 0x5f, 0x8a, 0xdc, 0x4f, 0x66, 0xb5, 0x50, 0x39,
-	0x33, 0xc6, 0xa8, 0x99, 0xf5, 0x12, 0xae, 0xaa, 0x4c, 0xfb, 0x6a, 0x8d, 0x2d, 0x34, 0x2e, 0x9e,
-	0xc5, 0x3c, 0x6d, 0x35, 0x54, 0x50, 0x6f, 0x8a, 0x68, 0x87, 0xc7, 0xa2, 0xdb, 0x1a, 0xd3, 0x51,
-	0xb5, 0x29, 0xa2, 0xc9, 0x21, 0x93, 0xbc, 0xd5, 0xd4, 0x51, 0xb5, 0xc1, 0x3b, 0x08, 0x5d, 0x5d,
-	0x73, 0x6b, 0x5c, 0x11, 0x2e, 0x10, 0xed, 0x09, 0x52, 0x78, 0x82, 0x68, 0x0b, 0x5d, 0x9d, 0x5d,
-	0xc8, 0x81, 0xc8, 0x1f, 0xc8, 0x1c, 0x38, 0x88, 0x77, 0x26, 0xba, 0xf1, 0xdb, 0x00, 0x70, 0x14,
-	0xab, 0x68, 0x0a, 0xa6, 0x2c, 0x2e, 0x68, 0xec, 0xaf, 0x67, 0xd1, 0x57, 0xe1, 0xdd, 0x0a, 0x5d,
-	0x43, 0xd1, 0x2d, 0xfe, 0x93, 0x4e, 0xb7, 0x1b, 0xc4, 0x73, 0x6f, 0xa2, 0x19, 0xc5, 0xf4, 0x90,
-	0x9f, 0x64, 0xc0, 0xb5, 0xf7, 0xa0, 0x74, 0xd3, 0x3d, 0x64, 0xd7, 0x7d, 0x04, 0xea, 0x6b, 0xa8,
-	0xd1, 0xbf, 0xf9, 0x46, 0xd4, 0x59, 0xff, 0xd2, 0x44, 0xe3, 0x4a, 0x8e, 0x5f, 0x99, 0x68, 0x42,
-	0x7b, 0x09, 0x2f, 0xd5, 0x3b, 0xed, 0x4f, 0xeb, 0xda, 0x77, 0x47, 0x50, 0xea, 0xce, 0xee, 0xed,
-	0x97, 0x9f, 0x7f, 0xbc, 0x6f, 0x38, 0x78, 0x96, 0xd6, 0xbe, 0x3b, 0x6d, 0x5c, 0xfc, 0xc1, 0x44,
-	0x93, 0x40, 0x8d, 0x87, 0x15, 0xaf, 0x1a, 0xdb, 0x5e, 0x1e, 0x45, 0x0a, 0x20, 0x1b, 0x0a, 0xc4,
-	0xc3, 0x2b, 0xf5, 0x20, 0xe5, 0x75, 0xd1, 0xe7, 0x57, 0x4f, 0xe5, 0x05, 0x7e, 0x63, 0xa2, 0xa9,
-	0xd2, 0x02, 0x78, 0x84, 0x6e, 0xfd, 0x13, 0x5a, 0x19, 0x49, 0x0b, 0x68, 0x0b, 0x0a, 0x6d, 0x1e,
-	0x3b, 0xc3, 0xd1, 0xf0, 0x47, 0x13, 0xfd, 0x5f, 0xb9, 0x5f, 0x4c, 0x87, 0xb4, 0xa9, 0xb3, 0x89,
-	0xbd, 0x3a, 0x7a, 0x02, 0xc0, 0x79, 0x0a, 0x6e, 0x11, 0xdf, 0xa9, 0x87, 0x8b, 0xf9, 0x49, 0xe6,
-	0x41, 0xd0, 0x8b, 0x3a, 0xdb, 0xbb, 0x67, 0x17, 0x8e, 0x79, 0x7e, 0xe1, 0x98, 0xdf, 0x2f, 0x1c,
-	0xf3, 0xed, 0xa5, 0x63, 0x9c, 0x5f, 0x3a, 0xc6, 0xd7, 0x4b, 0xc7, 0x78, 0xec, 0x85, 0x51, 0x76,
-	0x98, 0x07, 0xa4, 0x2d, 0xba, 0x34, 0xe1, 0x69, 0x5b, 0xc8, 0x48, 0x7a, 0x47, 0x2c, 0x90, 0xba,
-	0xf0, 0x49, 0xbf, 0x74, 0xf1, 0x57, 0x90, 0xc1, 0x84, 0x7a, 0x4e, 0x1b, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x30, 0x9d, 0x90, 0x68, 0x14, 0x06, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x8e, 0xe3, 0xbc, 0x40, 0xa8, 0x06, 0x23, 0x9c, 0x4d, 0xb0, 0xa3, 0x85, 0xb6,
+	0xa1, 0xa9, 0x77, 0x9d, 0xf4, 0x00, 0xaa, 0x04, 0x28, 0xa6, 0x4a, 0x84, 0x84, 0x2a, 0xba, 0xed,
+	0x89, 0x8b, 0xb5, 0xf6, 0x4e, 0xdd, 0x91, 0xec, 0x9d, 0xed, 0xce, 0xb8, 0x24, 0xaa, 0x2a, 0x21,
+	0xb8, 0x20, 0x71, 0xa9, 0x40, 0xdc, 0x38, 0x94, 0x0b, 0x07, 0x84, 0x38, 0x71, 0xe6, 0x86, 0x54,
+	0x6e, 0x01, 0x2e, 0x9c, 0x28, 0x4a, 0x38, 0xf0, 0x33, 0xd0, 0xce, 0xcc, 0xae, 0xd7, 0xc9, 0xb2,
+	0xdd, 0x00, 0xb7, 0x9d, 0x37, 0xdf, 0xfb, 0xde, 0xf7, 0xde, 0xbc, 0x79, 0xb3, 0xb0, 0x7e, 0x7b,
+	0x12, 0x1e, 0xd8, 0xee, 0x64, 0x20, 0x28, 0xf3, 0xed, 0x7b, 0x5b, 0x7d, 0x22, 0xdc, 0x2d, 0xfb,
+	0xee, 0x84, 0x84, 0x07, 0x56, 0x10, 0x32, 0xc1, 0x70, 0x3d, 0x42, 0x58, 0x1a, 0x61, 0x69, 0x84,
+	0x71, 0x69, 0xc0, 0xf8, 0x98, 0x71, 0xbb, 0xef, 0x72, 0xa2, 0xe0, 0x89, 0x73, 0xe0, 0x0e, 0xa9,
+	0xef, 0x4a, 0xb4, 0x64, 0x30, 0x56, 0x14, 0xb6, 0x27, 0x57, 0xb6, 0x5a, 0xe8, 0x2d, 0x33, 0x33,
+	0x7c, 0x1c, 0x2c, 0x0f, 0x33, 0x24, 0x3e, 0xe1, 0x34, 0xe6, 0xa9, 0x0f, 0xd9, 0x90, 0x29, 0xfe,
+	0xe8, 0x4b, 0x5b, 0xd7, 0x86, 0x8c, 0x0d, 0x47, 0xc4, 0x76, 0x03, 0x6a, 0xbb, 0xbe, 0xcf, 0x84,
+	0x54, 0x15, 0xfb, 0xac, 0xe8, 0x5d, 0xb9, 0xea, 0x4f, 0x6e, 0xdb, 0xae, 0xaf, 0x73, 0x36, 0x5a,
+	0x27, 0xb7, 0x04, 0x1d, 0x13, 0x2e, 0xdc, 0x71, 0xa0, 0x00, 0x66, 0x1d, 0xf0, 0x8d, 0x28, 0xe9,
+	0xf7, 0xdc, 0xd0, 0x1d, 0x73, 0x87, 0xdc, 0x9d, 0x10, 0x2e, 0xcc, 0x1b, 0xf0, 0xfc, 0x8c, 0x95,
+	0x07, 0xcc, 0xe7, 0x04, 0x5f, 0x85, 0x6a, 0x20, 0x2d, 0x0d, 0xb4, 0x8e, 0x36, 0x96, 0xb6, 0xd7,
+	0xac, 0xac, 0x92, 0x5a, 0xca, 0xab, 0x5b, 0x79, 0xfc, 0x7b, 0xab, 0xe4, 0x68, 0x0f, 0xf3, 0x4d,
+	0x4d, 0xb9, 0xa3, 0xc0, 0x3a, 0x12, 0x7e, 0x09, 0x40, 0xbb, 0xf7, 0xa8, 0x27, 0x69, 0x2b, 0xce,
+	0xa2, 0xb6, 0xbc, 0xe3, 0x5d, 0xad, 0x7d, 0xf2, 0xa8, 0x55, 0xfa, 0xeb, 0x51, 0xab, 0x64, 0xee,
+	0x42, 0x7d, 0xd6, 0x5f, 0x6b, 0xb2, 0x60, 0x41, 0xc3, 0xb5, 0xa8, 0xba, 0xa5, 0x72, 0xb6, 0xe2,
+	0x9c, 0xad, 0x1d, 0xff, 0xc0, 0x89, 0x41, 0xe6, 0x0f, 0x68, 0x96, 0x28, 0xce, 0x19, 0x63, 0xa8,
+	0x88, 0x83, 0x80, 0x48, 0x96, 0x45, 0x47, 0x7e, 0xe3, 0x3a, 0xcc, 0xb3, 0x0f, 0x7c, 0x12, 0x36,
+	0xca, 0xd2, 0xa8, 0x16, 0x91, 0xd5, 0x23, 0x3e, 0x1b, 0x37, 0xe6, 0x94, 0x55, 0x2e, 0x22, 0x6b,
+	0x70, 0xc7, 0xe5, 0xa4, 0x51, 0x51, 0x56, 0xb9, 0xc0, 0xbb, 0x00, 0xd3, 0x36, 0x6a, 0xcc, 0x4b,
+	0x85, 0x17, 0x2c, 0xdd, 0x3a, 0x51, 0xcf, 0x59, 0xaa, 0x45, 0xa7, 0xb5, 0x1b, 0x12, 0xad, 0xc8,
+	0x49, 0x79, 0xa6, 0x0a, 0xf1, 0x19, 0x82, 0x17, 0x4e, 0x24, 0xa0, 0x4b, 0xd1, 0x81, 0x9a, 0xce,
+	0x32, 0x3a, 0xa0, 0xb9, 0x7f, 0xac, 0x45, 0x82, 0xc2, 0x7b, 0x33, 0xea, 0xca, 0x52, 0xdd, 0xc5,
+	0xa7, 0xaa, 0x53, 0xe1, 0xd2, 0xf2, 0xcc, 0x0f, 0x11, 0xbc, 0x98, 0x16, 0xd5, 0xa5, 0x1e, 0x2f,
+	0x76, 0xc4, 0x78, 0x37, 0x43, 0xc3, 0xbf, 0xa8, 0x90, 0xf9, 0x2d, 0x82, 0xc6, 0x69, 0x09, 0xba,
+	0x34, 0x7b, 0x50, 0xe9, 0x53, 0x2f, 0x2e, 0x4b, 0x2b, 0xbb, 0x6f, 0xbb, 0xd4, 0x73, 0xc8, 0x80,
+	0x85, 0x5e, 0x17, 0x47, 0xad, 0xfb, 0xcd, 0x93, 0x16, 0x24, 0x26, 0xee, 0x48, 0x82, 0xff, 0xaf,
+	0x62, 0xd7, 0xc1, 0x90, 0x6a, 0xbb, 0xd4, 0xf3, 0x48, 0x78, 0xb2, 0x19, 0x3b, 0x50, 0xed, 0xcb,
+	0x0d, 0xd5, 0x8e, 0xdd, 0xc6, 0x2f, 0xdf, 0xb7, 0xeb, 0x3a, 0xca, 0x8e, 0xe7, 0x85, 0x84, 0xf3,
+	0x9b, 0x22, 0xa4, 0xfe, 0xd0, 0xd1, 0x38, 0xf3, 0x27, 0x04, 0xab, 0x99, 0x84, 0xba, 0x02, 0x6f,
+	0xc0, 0x73, 0xee, 0x40, 0xd0, 0x7b, 0xa4, 0x57, 0xa8, 0x47, 0x96, 0x15, 0x38, 0xa6, 0x89, 0xdc,
+	0xd9, 0x44, 0xf4, 0xa9, 0x37, 0x75, 0x2f, 0xe7, 0xb9, 0x2b, 0x70, 0xe2, 0x7e, 0x19, 0xf0, 0x60,
+	0xc4, 0x38, 0x49, 0xdc, 0x7b, 0xd1, 0x69, 0xcc, 0xad, 0xcf, 0x6d, 0x54, 0x9c, 0x73, 0x6a, 0x67,
+	0x27, 0xee, 0x08, 0x6e, 0xfe, 0x7c, 0xe2, 0x28, 0x6f, 0x0a, 0x57, 0xe4, 0xde, 0xd3, 0x55, 0x58,
+	0x1c, 0x31, 0xd1, 0x53, 0xb7, 0x52, 0xdd, 0xd5, 0xda, 0x88, 0x89, 0x6b, 0xf2, 0x62, 0xbe, 0x0d,
+	0xc0, 0x85, 0x1b, 0x8a, 0x5e, 0x34, 0xfb, 0xe4, 0x9d, 0x5d, 0xda, 0x36, 0x4e, 0xa9, 0xbe, 0x15,
+	0x0f, 0xc6, 0x6e, 0x2d, 0x3a, 0xfc, 0x87, 0x4f, 0x5a, 0xc8, 0x59, 0x94, 0x7e, 0xd1, 0x0e, 0x7e,
+	0x0b, 0x6a, 0xc4, 0xf7, 0x14, 0x45, 0xe5, 0x0c, 0x14, 0x0b, 0xc4, 0xf7, 0x22, 0xbb, 0xf9, 0x23,
+	0x82, 0x95, 0x8c, 0x9c, 0xf4, 0xe9, 0x38, 0x30, 0xcf, 0x23, 0x83, 0x3e, 0x13, 0x33, 0xbb, 0x41,
+	0xd3, 0xae, 0xdd, 0x86, 0xee, 0xd1, 0x73, 0x69, 0xeb, 0xbb, 0x94, 0x0b, 0x47, 0x51, 0xe1, 0x5b,
+	0x50, 0x15, 0x4c, 0xb8, 0xa3, 0xf8, 0xa4, 0xfe, 0x1b, 0xa9, 0xe6, 0x32, 0x57, 0x75, 0x1a, 0xd7,
+	0xc9, 0xbe, 0x88, 0x8f, 0xec, 0x5a, 0xfc, 0x6e, 0x5c, 0x06, 0x23, 0x6b, 0x53, 0x27, 0xb9, 0x0c,
+	0xe5, 0x64, 0x00, 0x94, 0xa9, 0xb7, 0x7d, 0x58, 0x83, 0x79, 0x09, 0xc7, 0x1f, 0x23, 0xa8, 0xaa,
+	0x57, 0x03, 0x6f, 0x64, 0xab, 0x3c, 0xfd, 0x48, 0x19, 0xaf, 0x16, 0x40, 0xaa, 0xc8, 0xe6, 0x2b,
+	0x1f, 0xfd, 0xfa, 0xe7, 0xe7, 0xe5, 0x26, 0x5e, 0xb3, 0x33, 0x9f, 0x60, 0xf5, 0x44, 0xe1, 0x2f,
+	0x10, 0x2c, 0x68, 0xd5, 0x38, 0x8f, 0x7c, 0xf6, 0x09, 0x33, 0x2e, 0x15, 0x81, 0x6a, 0x21, 0x57,
+	0xa4, 0x90, 0x36, 0xde, 0xb4, 0xf3, 0xfe, 0x17, 0xb8, 0x7d, 0x7f, 0x7a, 0x4d, 0x1e, 0xe0, 0x4f,
+	0x11, 0xd4, 0x92, 0x9b, 0x54, 0x20, 0x5a, 0x52, 0xa1, 0xcd, 0x42, 0x58, 0x2d, 0xed, 0x82, 0x94,
+	0xb6, 0x8e, 0x9b, 0xf9, 0xd2, 0xf0, 0xd7, 0x08, 0x96, 0x52, 0x23, 0x16, 0xb7, 0x9f, 0x1e, 0x24,
+	0xf5, 0x1a, 0x18, 0x56, 0x51, 0xb8, 0x96, 0xf5, 0xba, 0x94, 0xb5, 0x8d, 0x3b, 0x67, 0xa8, 0x98,
+	0x2d, 0x47, 0xf5, 0x77, 0x08, 0x96, 0x67, 0x87, 0x21, 0xee, 0xe4, 0x04, 0xcf, 0x1c, 0xc4, 0xc6,
+	0xd6, 0x19, 0x3c, 0xb4, 0xe2, 0xd7, 0xa4, 0xe2, 0x2d, 0x6c, 0x67, 0x2b, 0x56, 0xf3, 0x9a, 0xdb,
+	0xf7, 0xd5, 0xc7, 0x83, 0x69, 0x65, 0xbf, 0x44, 0xf0, 0x4c, 0xfa, 0xde, 0xe1, 0x02, 0xb5, 0x4a,
+	0x8f, 0x46, 0xc3, 0x2e, 0x8c, 0xd7, 0x52, 0x37, 0xa5, 0xd4, 0xf3, 0xf8, 0xe5, 0xdc, 0xe2, 0xb6,
+	0xd5, 0x3c, 0xf9, 0x0a, 0xc1, 0xb3, 0x33, 0x17, 0x1b, 0xe7, 0xc5, 0xcb, 0x9a, 0x0f, 0x46, 0xa7,
+	0xb8, 0x83, 0x56, 0xd8, 0x96, 0x0a, 0x2f, 0xe2, 0xf3, 0xd9, 0x0a, 0x7d, 0xb2, 0x2f, 0xda, 0xb1,
+	0x4c, 0xea, 0x75, 0xf7, 0x1e, 0x1f, 0x35, 0xd1, 0xe1, 0x51, 0x13, 0xfd, 0x71, 0xd4, 0x44, 0x0f,
+	0x8f, 0x9b, 0xa5, 0xc3, 0xe3, 0x66, 0xe9, 0xb7, 0xe3, 0x66, 0xe9, 0xfd, 0xf6, 0x90, 0x8a, 0x3b,
+	0x93, 0xbe, 0x35, 0x60, 0x63, 0x3b, 0x20, 0xe1, 0x80, 0x71, 0xca, 0xdb, 0x23, 0xb7, 0xcf, 0x15,
+	0xf1, 0x7e, 0x42, 0x1d, 0x3d, 0x28, 0xbc, 0x5f, 0x95, 0x53, 0xfd, 0xca, 0xdf, 0x03, 0x00, 0xee,
+	0xa5, 0xe6, 0xdd, 0x57, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -450,6 +833,12 @@ type QueryClient interface {
 	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
 	// Auctions queries auctions filtered by asset denom, owner address, phase, and auction type
 	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	// AuctionBids queries the bid history of an open auction
+	AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error)
+	// BidderAuctions queries the auctions an address has bid on, split by whether it holds the top bid or the auction has closed
+	BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error)
+	// AuctionStats queries the outcome totals of auctions over time windows
+	AuctionStats(ctx context.Context, in *QueryAuctionStatsRequest, opts ...grpc.CallOption) (*QueryAuctionStatsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(ctx context.Context, in *QueryNextAuctionIDRequest, opts ...grpc.CallOption) (*QueryNextAuctionIDResponse, error)
 }
@@ -489,6 +878,33 @@ func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error) {
+	out := new(QueryAuctionBidsResponse)
+	err := c.cc.Invoke(ctx, "/fury.auction.v1beta1.Query/AuctionBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error) {
+	out := new(QueryBidderAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fury.auction.v1beta1.Query/BidderAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionStats(ctx context.Context, in *QueryAuctionStatsRequest, opts ...grpc.CallOption) (*QueryAuctionStatsResponse, error) {
+	out := new(QueryAuctionStatsResponse)
+	err := c.cc.Invoke(ctx, "/fury.auction.v1beta1.Query/AuctionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NextAuctionID(ctx context.Context, in *QueryNextAuctionIDRequest, opts ...grpc.CallOption) (*QueryNextAuctionIDResponse, error) {
 	out := new(QueryNextAuctionIDResponse)
 	err := c.cc.Invoke(ctx, "/fury.auction.v1beta1.Query/NextAuctionID", in, out, opts...)
@@ -506,6 +922,12 @@ type QueryServer interface {
 	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
 	// Auctions queries auctions filtered by asset denom, owner address, phase, and auction type
 	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// AuctionBids queries the bid history of an open auction
+	AuctionBids(context.Context, *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error)
+	// BidderAuctions queries the auctions an address has bid on, split by whether it holds the top bid or the auction has closed
+	BidderAuctions(context.Context, *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error)
+	// AuctionStats queries the outcome totals of auctions over time windows
+	AuctionStats(context.Context, *QueryAuctionStatsRequest) (*QueryAuctionStatsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(context.Context, *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error)
 }
@@ -523,6 +945,15 @@ func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionR
 func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
 }
+func (*UnimplementedQueryServer) AuctionBids(ctx context.Context, req *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBids not implemented")
+}
+func (*UnimplementedQueryServer) BidderAuctions(ctx context.Context, req *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAuctions not implemented")
+}
+func (*UnimplementedQueryServer) AuctionStats(ctx context.Context, req *QueryAuctionStatsRequest) (*QueryAuctionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionStats not implemented")
+}
 func (*UnimplementedQueryServer) NextAuctionID(ctx context.Context, req *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextAuctionID not implemented")
 }
@@ -585,53 +1016,119 @@ func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_NextAuctionID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextAuctionIDRequest)
+func _Query_AuctionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionBidsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).NextAuctionID(ctx, in)
+		return srv.(QueryServer).AuctionBids(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fury.auction.v1beta1.Query/NextAuctionID",
+		FullMethod: "/fury.auction.v1beta1.Query/AuctionBids",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NextAuctionID(ctx, req.(*QueryNextAuctionIDRequest))
+		return srv.(QueryServer).AuctionBids(ctx, req.(*QueryAuctionBidsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.auction.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Auction",
-			Handler:    _Query_Auction_Handler,
-		},
-		{
-			MethodName: "Auctions",
-			Handler:    _Query_Auctions_Handler,
-		},
-		{
-			MethodName: "NextAuctionID",
-			Handler:    _Query_NextAuctionID_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/auction/v1beta1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BidderAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.auction.v1beta1.Query/BidderAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAuctions(ctx, req.(*QueryBidderAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.auction.v1beta1.Query/AuctionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionStats(ctx, req.(*QueryAuctionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextAuctionID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextAuctionIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextAuctionID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.auction.v1beta1.Query/NextAuctionID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextAuctionID(ctx, req.(*QueryNextAuctionIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.auction.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "Auctions",
+			Handler:    _Query_Auctions_Handler,
+		},
+		{
+			MethodName: "AuctionBids",
+			Handler:    _Query_AuctionBids_Handler,
+		},
+		{
+			MethodName: "BidderAuctions",
+			Handler:    _Query_BidderAuctions_Handler,
+		},
+		{
+			MethodName: "AuctionStats",
+			Handler:    _Query_AuctionStats_Handler,
+		},
+		{
+			MethodName: "NextAuctionID",
+			Handler:    _Query_NextAuctionID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/auction/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -859,7 +1356,7 @@ func (m *QueryAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextAuctionIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAuctionBidsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -869,20 +1366,37 @@ func (m *QueryNextAuctionIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNextAuctionIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAuctionBidsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNextAuctionIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAuctionBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextAuctionIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAuctionBidsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -892,112 +1406,387 @@ func (m *QueryNextAuctionIDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNextAuctionIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAuctionBidsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNextAuctionIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAuctionBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidderAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBidderAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBidderAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Auction != nil {
-		l = m.Auction.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBidderAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBidderAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClosedAuctionIds) > 0 {
+		dAtA8 := make([]byte, len(m.ClosedAuctionIds)*10)
+		var j7 int
+		for _, num := range m.ClosedAuctionIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OutbidAuctions) > 0 {
+		for iNdEx := len(m.OutbidAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutbidAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ActiveAuctions) > 0 {
+		for iNdEx := len(m.ActiveAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LotDenom) > 0 {
+		i -= len(m.LotDenom)
+		copy(dAtA[i:], m.LotDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LotDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextAuctionIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextAuctionIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextAuctionIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextAuctionIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextAuctionIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextAuctionIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1014,34 +1803,774 @@ func (m *QueryAuctionsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryNextAuctionIDRequest) Size() (n int) {
+func (m *QueryAuctionBidsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryNextAuctionIDResponse) Size() (n int) {
+func (m *QueryAuctionBidsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveAuctions) > 0 {
+		for _, e := range m.ActiveAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.OutbidAuctions) > 0 {
+		for _, e := range m.OutbidAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClosedAuctionIds) > 0 {
+		l = 0
+		for _, e := range m.ClosedAuctionIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryAuctionStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LotDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextAuctionIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextAuctionIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &types.Any{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionBidsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,12 +2593,67 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionBidsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1091,7 +2675,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionBidsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,15 +2698,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionBidsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1149,7 +2733,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, BidRecord{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1174,7 +2795,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidderAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1197,17 +2818,17 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidderAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidderAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			m.AuctionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1217,11 +2838,24 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1243,7 +2877,7 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidderAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1266,15 +2900,15 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidderAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidderAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveAuctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1301,13 +2935,121 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auction == nil {
-				m.Auction = &types.Any{}
+			m.ActiveAuctions = append(m.ActiveAuctions, &types.Any{})
+			if err := m.ActiveAuctions[len(m.ActiveAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutbidAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutbidAuctions = append(m.OutbidAuctions, &types.Any{})
+			if err := m.OutbidAuctions[len(m.OutbidAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClosedAuctionIds = append(m.ClosedAuctionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClosedAuctionIds) == 0 {
+					m.ClosedAuctionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClosedAuctionIds = append(m.ClosedAuctionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAuctionIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1329,7 +3071,7 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1352,10 +3094,10 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1392,7 +3134,7 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1420,13 +3162,13 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.LotDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1436,59 +3178,28 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1515,10 +3226,7 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1543,7 +3251,7 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,15 +3274,15 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1601,14 +3309,14 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, &types.Any{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, AuctionStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1635,10 +3343,8 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Totals = append(m.Totals, AuctionStats{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StatsWindowDuration is the length of the time windows auction stats are recorded in
+const StatsWindowDuration = 24 * time.Hour
+
+// StatsWindowStart returns the start of the stats window containing the input time
+func StatsWindowStart(t time.Time) time.Time {
+	return t.UTC().Truncate(StatsWindowDuration)
+}
+
+// NewAuctionStats returns empty auction stats for an auction type, lot denom and window
+func NewAuctionStats(auctionType, lotDenom string, windowStart time.Time) AuctionStats {
+	return AuctionStats{
+		AuctionType:     auctionType,
+		LotDenom:        lotDenom,
+		WindowStart:     windowStart,
+		LotAuctioned:    sdk.NewCoins(),
+		DebtAuctioned:   sdk.NewCoins(),
+		LotSold:         sdk.NewCoins(),
+		BidRaised:       sdk.NewCoins(),
+		DebtUnrecovered: sdk.NewCoins(),
+	}
+}
+
+// Add returns the sum of the counts and amounts of the input stats, keeping the type, lot denom and window of the receiver
+func (as AuctionStats) Add(other AuctionStats) AuctionStats {
+	as.AuctionsStarted += other.AuctionsStarted
+	as.AuctionsClosed += other.AuctionsClosed
+	as.LotAuctioned = as.LotAuctioned.Add(other.LotAuctioned...)
+	as.DebtAuctioned = as.DebtAuctioned.Add(other.DebtAuctioned...)
+	as.LotSold = as.LotSold.Add(other.LotSold...)
+	as.BidRaised = as.BidRaised.Add(other.BidRaised...)
+	as.DebtUnrecovered = as.DebtUnrecovered.Add(other.DebtUnrecovered...)
+	return as
+}
+
+// Validate performs a basic validation of auction stats
+func (as AuctionStats) Validate() error {
+	if strings.TrimSpace(as.AuctionType) == "" {
+		return fmt.Errorf("auction type cannot be empty")
+	}
+	if err := sdk.ValidateDenom(as.LotDenom); err != nil {
+		return fmt.Errorf("invalid lot denom: %w", err)
+	}
+	if !as.WindowStart.Equal(StatsWindowStart(as.WindowStart)) {
+		return fmt.Errorf("window start %s is not the start of a stats window", as.WindowStart)
+	}
+	for _, coins := range []sdk.Coins{as.LotAuctioned, as.DebtAuctioned, as.LotSold, as.BidRaised, as.DebtUnrecovered} {
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid %s auction stats for %s: %w", as.AuctionType, as.LotDenom, err)
+		}
+	}
+	return nil
+}
+
+// AuctionStatsList is a slice of AuctionStats
+type AuctionStatsList []AuctionStats
+
+// Validate validates each stats and checks there is at most one per auction type, lot denom and window
+func (asl AuctionStatsList) Validate() error {
+	seen := make(map[string]bool)
+	for _, as := range asl {
+		if err := as.Validate(); err != nil {
+			return err
+		}
+		key := string(GetAuctionStatsKey(as.WindowStart, as.AuctionType, as.LotDenom))
+		if seen[key] {
+			return fmt.Errorf("duplicate %s auction stats for %s at %s", as.AuctionType, as.LotDenom, as.WindowStart)
+		}
+		seen[key] = true
+	}
+	return nil
+}
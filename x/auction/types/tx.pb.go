@@ -106,35 +106,122 @@ func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
 
+// MsgPlaceBatchBid represents a message used by bidders to bid for part of the lot of a batch auction.
+// A new bid replaces the bidder's previous bid on the auction.
+type MsgPlaceBatchBid struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// lot is the amount of lot wanted
+	Lot types.Coin `protobuf:"bytes,3,opt,name=lot,proto3" json:"lot"`
+	// bid is the most the bidder will pay for the lot
+	Bid types.Coin `protobuf:"bytes,4,opt,name=bid,proto3" json:"bid"`
+}
+
+func (m *MsgPlaceBatchBid) Reset()         { *m = MsgPlaceBatchBid{} }
+func (m *MsgPlaceBatchBid) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBatchBid) ProtoMessage()    {}
+func (*MsgPlaceBatchBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771ae901d63fd52f, []int{2}
+}
+func (m *MsgPlaceBatchBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBatchBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBatchBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBatchBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBatchBid.Merge(m, src)
+}
+func (m *MsgPlaceBatchBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBatchBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBatchBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBatchBid proto.InternalMessageInfo
+
+// MsgPlaceBatchBidResponse defines the Msg/PlaceBatchBid response type.
+type MsgPlaceBatchBidResponse struct {
+}
+
+func (m *MsgPlaceBatchBidResponse) Reset()         { *m = MsgPlaceBatchBidResponse{} }
+func (m *MsgPlaceBatchBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBatchBidResponse) ProtoMessage()    {}
+func (*MsgPlaceBatchBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771ae901d63fd52f, []int{3}
+}
+func (m *MsgPlaceBatchBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBatchBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBatchBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBatchBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBatchBidResponse.Merge(m, src)
+}
+func (m *MsgPlaceBatchBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBatchBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBatchBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBatchBidResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPlaceBid)(nil), "fury.auction.v1beta1.MsgPlaceBid")
 	proto.RegisterType((*MsgPlaceBidResponse)(nil), "fury.auction.v1beta1.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgPlaceBatchBid)(nil), "fury.auction.v1beta1.MsgPlaceBatchBid")
+	proto.RegisterType((*MsgPlaceBatchBidResponse)(nil), "fury.auction.v1beta1.MsgPlaceBatchBidResponse")
 }
 
 func init() { proto.RegisterFile("fury/auction/v1beta1/tx.proto", fileDescriptor_771ae901d63fd52f) }
 
 var fileDescriptor_771ae901d63fd52f = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2b, 0x2d, 0xaa,
-	0xd4, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01, 0x49, 0xeb, 0x41, 0xa5,
-	0xf5, 0xa0, 0xd2, 0x52, 0x72, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5, 0xa9,
-	0x70, 0x3d, 0xc9, 0xf9, 0x99, 0x79, 0x10, 0x5d, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6,
-	0x3e, 0x88, 0x05, 0x11, 0x55, 0x6a, 0x67, 0xe4, 0xe2, 0xf6, 0x2d, 0x4e, 0x0f, 0xc8, 0x49, 0x4c,
-	0x4e, 0x75, 0xca, 0x4c, 0x11, 0x92, 0xe5, 0xe2, 0x82, 0x1a, 0x1c, 0x9f, 0x99, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x09, 0x15, 0xf1, 0x4c, 0x11, 0x12, 0xe3, 0x62, 0x4b, 0xca, 0x4c,
-	0x49, 0x49, 0x2d, 0x92, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0xf2, 0x84, 0xcc, 0xb9, 0xd8,
-	0x12, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x20,
-	0xae, 0xd1, 0x03, 0xb9, 0x06, 0xe6, 0x44, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0x96, 0x13, 0xf7,
-	0xe4, 0x19, 0x82, 0xa0, 0xca, 0xad, 0x38, 0x3a, 0x16, 0xc8, 0x33, 0xbc, 0x58, 0x20, 0xcf, 0xa0,
-	0x24, 0xca, 0x25, 0x8c, 0xe4, 0x90, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3, 0x78,
-	0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x08, 0x2e, 0x0e, 0xb8, 0x1b, 0x15, 0xf5, 0xb0, 0x05, 0x80,
-	0x1e, 0x92, 0x6e, 0x29, 0x4d, 0x82, 0x4a, 0x60, 0x16, 0x38, 0xb9, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x7e, 0x41, 0x6a, 0x51, 0x72, 0x7e, 0x71, 0x66, 0xb1, 0x6e, 0x4e, 0x62, 0x52, 0xb1, 0x3e,
-	0x38, 0x7e, 0x2a, 0xe0, 0x31, 0x54, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x51, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x95, 0xc0, 0x00, 0xbe, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x6b, 0xea, 0x40,
+	0x10, 0xc7, 0xb3, 0x4f, 0x11, 0x5d, 0x79, 0xf0, 0xc8, 0xf3, 0x3d, 0xd2, 0x80, 0xd1, 0x7a, 0x28,
+	0xf6, 0xe0, 0x06, 0xed, 0xa1, 0xd0, 0xa3, 0x3d, 0x94, 0x1e, 0x84, 0x92, 0x53, 0xe9, 0xa5, 0x64,
+	0x93, 0x6d, 0x5c, 0xd0, 0x4c, 0xc8, 0x6e, 0x8a, 0x7e, 0x82, 0xf6, 0xd8, 0x8f, 0xe0, 0xd7, 0xe8,
+	0x37, 0xf0, 0xe8, 0xb1, 0xa7, 0x52, 0xf4, 0xd2, 0x8f, 0x51, 0xa2, 0x9b, 0x60, 0x4b, 0x41, 0xa1,
+	0xb7, 0xc9, 0xcc, 0xff, 0x9f, 0xf9, 0xcd, 0xec, 0xe0, 0xfa, 0x5d, 0x12, 0x4f, 0x6d, 0x37, 0xf1,
+	0x24, 0x87, 0xd0, 0xbe, 0xef, 0x52, 0x26, 0xdd, 0xae, 0x2d, 0x27, 0x24, 0x8a, 0x41, 0x82, 0x5e,
+	0x4b, 0xcb, 0x44, 0x95, 0x89, 0x2a, 0x9b, 0x96, 0x07, 0x62, 0x0c, 0xc2, 0xa6, 0xae, 0x60, 0xb9,
+	0xc7, 0x03, 0x1e, 0x6e, 0x5c, 0x66, 0x2d, 0x80, 0x00, 0xd6, 0xa1, 0x9d, 0x46, 0x9b, 0x6c, 0xeb,
+	0x01, 0xe1, 0xea, 0x40, 0x04, 0x57, 0x23, 0xd7, 0x63, 0x7d, 0xee, 0xeb, 0x75, 0x8c, 0xd5, 0x8f,
+	0x6f, 0xb9, 0x6f, 0xa0, 0x26, 0x6a, 0x17, 0x9d, 0x8a, 0xca, 0x5c, 0xfa, 0xfa, 0x7f, 0x5c, 0xa2,
+	0xdc, 0xf7, 0x59, 0x6c, 0xfc, 0x6a, 0xa2, 0x76, 0xc5, 0x51, 0x5f, 0xfa, 0x29, 0x2e, 0xb9, 0x63,
+	0x48, 0x42, 0x69, 0x14, 0x9a, 0xa8, 0x5d, 0xed, 0x1d, 0x90, 0x0d, 0x0d, 0x49, 0x69, 0x32, 0x44,
+	0x72, 0x0e, 0x3c, 0xec, 0x17, 0xe7, 0xaf, 0x0d, 0xcd, 0x51, 0xf2, 0xb3, 0xf2, 0xe3, 0xac, 0xa1,
+	0xbd, 0xcf, 0x1a, 0x5a, 0xeb, 0x1f, 0xfe, 0xbb, 0x05, 0xe2, 0x30, 0x11, 0x41, 0x28, 0x58, 0xeb,
+	0x19, 0xe1, 0x3f, 0x79, 0xde, 0x95, 0xde, 0xf0, 0x07, 0x94, 0x5d, 0x5c, 0x18, 0xc1, 0xde, 0x88,
+	0xa9, 0x36, 0xb5, 0x50, 0xee, 0x1b, 0xc5, 0x3d, 0x2d, 0x94, 0xfb, 0x5b, 0x23, 0x99, 0xd8, 0xf8,
+	0x8a, 0x9e, 0xcd, 0xd5, 0x9b, 0x23, 0x5c, 0x18, 0x88, 0x40, 0xbf, 0xc6, 0xe5, 0x7c, 0xf9, 0x87,
+	0xe4, 0xbb, 0x97, 0x25, 0x5b, 0x6b, 0x31, 0x8f, 0x77, 0x4a, 0xb2, 0x0e, 0x7a, 0x80, 0x7f, 0x7f,
+	0xde, 0xda, 0xd1, 0x0e, 0xaf, 0xd2, 0x99, 0x64, 0x3f, 0x5d, 0xd6, 0xa8, 0x7f, 0x31, 0x5f, 0x5a,
+	0x68, 0xb1, 0xb4, 0xd0, 0xdb, 0xd2, 0x42, 0x4f, 0x2b, 0x4b, 0x5b, 0xac, 0x2c, 0xed, 0x65, 0x65,
+	0x69, 0x37, 0x9d, 0x80, 0xcb, 0x61, 0x42, 0x89, 0x07, 0x63, 0x3b, 0x62, 0xb1, 0x07, 0x82, 0x8b,
+	0xce, 0xc8, 0xa5, 0xc2, 0x5e, 0x5f, 0xf8, 0x24, 0xbf, 0x71, 0x39, 0x8d, 0x98, 0xa0, 0xa5, 0xf5,
+	0x4d, 0x9e, 0x7c, 0x0c, 0x00, 0x9f, 0x8f, 0x86, 0x8c, 0x00, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -151,6 +238,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// PlaceBid message type used by bidders to place bids on auctions
 	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// PlaceBatchBid message type used by bidders to bid for part of the lot of batch auctions
+	PlaceBatchBid(ctx context.Context, in *MsgPlaceBatchBid, opts ...grpc.CallOption) (*MsgPlaceBatchBidResponse, error)
 }
 
 type msgClient struct {
@@ -170,10 +259,21 @@ func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) PlaceBatchBid(ctx context.Context, in *MsgPlaceBatchBid, opts ...grpc.CallOption) (*MsgPlaceBatchBidResponse, error) {
+	out := new(MsgPlaceBatchBidResponse)
+	err := c.cc.Invoke(ctx, "/fury.auction.v1beta1.Msg/PlaceBatchBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PlaceBid message type used by bidders to place bids on auctions
 	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// PlaceBatchBid message type used by bidders to bid for part of the lot of batch auctions
+	PlaceBatchBid(context.Context, *MsgPlaceBatchBid) (*MsgPlaceBatchBidResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -183,6 +283,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
 }
+func (*UnimplementedMsgServer) PlaceBatchBid(ctx context.Context, req *MsgPlaceBatchBid) (*MsgPlaceBatchBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBatchBid not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -206,6 +309,24 @@ func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceBatchBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBatchBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBatchBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.auction.v1beta1.Msg/PlaceBatchBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBatchBid(ctx, req.(*MsgPlaceBatchBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.auction.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -214,6 +335,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceBid",
 			Handler:    _Msg_PlaceBid_Handler,
 		},
+		{
+			MethodName: "PlaceBatchBid",
+			Handler:    _Msg_PlaceBatchBid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/auction/v1beta1/tx.proto",
@@ -287,6 +412,84 @@ func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPlaceBatchBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBatchBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBatchBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBatchBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBatchBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBatchBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -325,6 +528,35 @@ func (m *MsgPlaceBidResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPlaceBatchBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Lot.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Bid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBatchBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -515,6 +747,223 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPlaceBatchBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBatchBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBatchBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBatchBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBatchBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBatchBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
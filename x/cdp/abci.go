@@ -47,6 +47,8 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		}
 	}
 
+	k.LiquidateMultiCdps(ctx)
+
 	err := k.RunSurplusAndDebtAuctions(ctx)
 	if err != nil {
 		panic(err)
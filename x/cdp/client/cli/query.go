@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +23,8 @@ const (
 	flagOwner          = "owner"
 	flagID             = "id"
 	flagRatio          = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagStartTime      = "start-time"
+	flagEndTime        = "end-time"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -34,10 +37,17 @@ func GetQueryCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		QueryCdpCmd(),
+		QueryAdjustCdpCmd(),
 		QueryGetCdpsCmd(),
 		QueryCdpDepositsCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryLiquidationStatsCmd(),
+		QueryMultiCdpCmd(),
+		QueryMultiCdpsCmd(),
+		QueryCdpHealthCmd(),
+		QueryMultiCdpHealthCmd(),
+		QueryCdpsAtRiskCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -87,6 +97,55 @@ $ %s query %s cdp fury15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 	}
 }
 
+// QueryAdjustCdpCmd returns the command handler for simulating an adjustment to a cdp
+func QueryAdjustCdpCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "adjust [owner-addr] [collateral-type] [collateral-delta] [principal-delta]",
+		Short: "preview the result of adjusting a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a CDP as it would be after depositing or withdrawing collateral and drawing or repaying debt.
+Negative deltas must follow a "--" separator.
+
+Example:
+$ %s query %s adjust -- fury15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a -10000000 -1000000
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			collateralDelta, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid collateral delta %s", args[2])
+			}
+			principalDelta, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid principal delta %s", args[3])
+			}
+
+			res, err := queryClient.AdjustCdp(context.Background(), &types.QueryAdjustCdpRequest{
+				Owner:           args[0],
+				CollateralType:  args[1],
+				CollateralDelta: collateralDelta,
+				PrincipalDelta:  principalDelta,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // QueryGetCdpsCmd queries the cdps in the store
 func QueryGetCdpsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -270,3 +329,266 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryLiquidationStatsCmd returns the command handler for querying liquidation stats
+func QueryLiquidationStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-stats",
+		Short: "get liquidation stats over daily windows",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the liquidations, collateral seized, debt liquidated, penalties and keeper rewards
+of each collateral type over daily windows, along with their totals over the queried range.
+Times are in RFC3339 format.
+
+Example:
+$ %s query %s liquidation-stats --collateral-type=btc-a --start-time=2022-03-01T00:00:00Z --end-time=2022-04-01T00:00:00Z
+`, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+			startTime, err := getTimeFlag(cmd, flagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := getTimeFlag(cmd, flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidationStats(context.Background(), &types.QueryLiquidationStatsRequest{
+				CollateralType: collateralType,
+				StartTime:      startTime,
+				EndTime:        endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+	cmd.Flags().String(flagStartTime, "", "(optional) exclude windows starting before this time")
+	cmd.Flags().String(flagEndTime, "", "(optional) exclude windows starting at or after this time")
+
+	return cmd
+}
+
+// getTimeFlag parses an optional RFC3339 time flag, returning the zero time if it is unset
+func getTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s: %w", flag, str, err)
+	}
+	return t, nil
+}
+
+// QueryMultiCdpCmd returns the command handler for querying the multi-collateral cdp of an owner
+func QueryMultiCdpCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "multi-cdp [owner-addr]",
+		Short: "get info about a multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a multi-collateral CDP by the owner address.
+
+Example:
+$ %s query %s multi-cdp fury15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MultiCdp(context.Background(), &types.QueryMultiCdpRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryMultiCdpsCmd queries the multi-collateral cdps in the store
+func QueryMultiCdpsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-cdps",
+		Short: "query all multi-collateral cdps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List all multi-collateral CDPs.
+
+Example:
+$ %s query %s multi-cdps --page=2 --limit=100
+`, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MultiCdps(context.Background(), &types.QueryMultiCdpsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "multi-cdps")
+
+	return cmd
+}
+
+// QueryCdpHealthCmd returns the command handler for querying how close a cdp is to liquidation
+func QueryCdpHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cdp-health [owner-addr] [collateral-type]",
+		Short: "get how close a cdp is to liquidation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the health factor and liquidation price of a CDP, along with the principal it can still draw and the collateral it can withdraw.
+
+Example:
+$ %s query %s cdp-health fury15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CdpHealth(context.Background(), &types.QueryCdpHealthRequest{
+				Owner:          args[0],
+				CollateralType: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryMultiCdpHealthCmd returns the command handler for querying how close a multi-collateral cdp is to liquidation
+func QueryMultiCdpHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "multi-cdp-health [owner-addr]",
+		Short: "get how close a multi-collateral cdp is to liquidation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the health factor and liquidation prices of a multi-collateral CDP, along with the principal it can still draw and the collateral it can withdraw.
+
+Example:
+$ %s query %s multi-cdp-health fury15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MultiCdpHealth(context.Background(), &types.QueryMultiCdpHealthRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryCdpsAtRiskCmd returns the command handler for querying the cdps of a collateral type closest to liquidation
+func QueryCdpsAtRiskCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cdps-at-risk [collateral-type]",
+		Short: "query the cdps of a collateral type closest to liquidation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the health of the CDPs of a collateral type, ordered from the lowest collateral to debt ratio.
+
+Example:
+$ %s query %s cdps-at-risk atom-a --limit=10
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CdpsAtRisk(context.Background(), &types.QueryCdpsAtRiskRequest{
+				CollateralType: args[0],
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "cdps at risk")
+
+	return cmd
+}
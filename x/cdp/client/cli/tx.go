@@ -29,6 +29,14 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDraw(),
 		GetCmdRepay(),
 		GetCmdLiquidate(),
+		GetCmdCreateMultiCdp(),
+		GetCmdDepositMultiCdp(),
+		GetCmdWithdrawMultiCdp(),
+		GetCmdDrawMultiCdp(),
+		GetCmdRepayMultiCdp(),
+		GetCmdLiquidateMultiCdp(),
+		GetCmdRedeem(),
+		GetCmdAdjust(),
 	}
 
 	for _, cmd := range cmds {
@@ -212,6 +220,76 @@ $ %s tx %s repay atom-a 1000usdf --from myKeyName
 	}
 }
 
+// GetCmdRedeem cli command for redeeming debt for collateral.
+func GetCmdRedeem() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [collateral-type] [debt]",
+		Short: "redeem debt for collateral",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn debt in exchange for an equal value of collateral, taken from the lowest collateralized cdps of a collateral type.
+
+Example:
+$ %s tx %s redeem atom-a 1000usdf --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemDebt(clientCtx.GetFromAddress(), args[0], amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdAdjust cli command for adjusting the collateral and debt of a cdp in one step.
+func GetCmdAdjust() *cobra.Command {
+	return &cobra.Command{
+		Use:   "adjust [collateral-type] [collateral-delta] [principal-delta]",
+		Short: "adjust the collateral and debt of an existing cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit or withdraw collateral and draw or repay debt in a single step, only checking the
+collateralization ratio once both changes are applied. Positive deltas deposit collateral and draw debt,
+negative deltas withdraw collateral and repay debt. Negative deltas must follow a "--" separator.
+
+Example:
+$ %s tx %s adjust atom-a --from myKeyName -- -10000000 -1000000
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateralDelta, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid collateral delta %s", args[1])
+			}
+			principalDelta, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid principal delta %s", args[2])
+			}
+			msg := types.NewMsgAdjustCDP(clientCtx.GetFromAddress(), args[0], collateralDelta, principalDelta)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
 // GetCmdLiquidate cli command for liquidating a cdp.
 func GetCmdLiquidate() *cobra.Command {
 	return &cobra.Command{
@@ -243,3 +321,217 @@ $ %s tx %s liquidate fury1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm  btcb-a --from
 		},
 	}
 }
+
+// GetCmdCreateMultiCdp returns the command handler for creating a multi-collateral cdp
+func GetCmdCreateMultiCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-multi [collateral-type:collateral,...] [debt]",
+		Short: "create a new multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new cdp backed by several collateral types, depositing the collateral and drawing some debt.
+The first collateral type determines the stability fee and debt limit of the cdp.
+
+Example:
+$ %s tx %s create-multi atom-a:10000000uatom,bnb-a:100000000bnb 1000usdf --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := parseTypedCollaterals(args[0])
+			if err != nil {
+				return err
+			}
+			debt, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateMultiCDP(clientCtx.GetFromAddress(), collateral, debt)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdDepositMultiCdp cli command for depositing to a multi-collateral cdp.
+func GetCmdDepositMultiCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-multi [collateral] [collateral-type]",
+		Short: "deposit collateral to an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add collateral of any supported collateral type to your multi-collateral cdp.
+
+Example:
+$ %s tx %s deposit-multi 10000000uatom atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositMultiCDP(clientCtx.GetFromAddress(), collateral, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdWithdrawMultiCdp cli command for withdrawing from a multi-collateral cdp.
+func GetCmdWithdrawMultiCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-multi [collateral] [collateral-type]",
+		Short: "withdraw collateral from an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove collateral from your multi-collateral cdp if it does not leave the cdp undercollateralized.
+
+Example:
+$ %s tx %s withdraw-multi 10000000uatom atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawMultiCDP(clientCtx.GetFromAddress(), collateral, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdDrawMultiCdp cli command for depositing to a multi-collateral cdp.
+func GetCmdDrawMultiCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "draw-multi [debt]",
+		Short: "draw debt off an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create debt in your multi-collateral cdp.
+
+Example:
+$ %s tx %s draw-multi 1000usdf --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			debt, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDrawMultiCDPDebt(clientCtx.GetFromAddress(), debt)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdRepayMultiCdp cli command for repaying debt on a multi-collateral cdp.
+func GetCmdRepayMultiCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "repay-multi [payment]",
+		Short: "repay debt to an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel out debt in your multi-collateral cdp. Once all debt is repaid the collateral is returned.
+
+Example:
+$ %s tx %s repay-multi 1000usdf --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payment, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRepayMultiCDPDebt(clientCtx.GetFromAddress(), payment)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdLiquidateMultiCdp cli command for liquidating a multi-collateral cdp.
+func GetCmdLiquidateMultiCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate-multi [cdp-owner-address]",
+		Short: "liquidate a multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquidate a multi-collateral cdp if its collateral no longer covers its debt.
+
+Example:
+$ %s tx %s liquidate-multi fury1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLiquidateMultiCDP(clientCtx.GetFromAddress(), borrower)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// parseTypedCollaterals parses a comma separated list of collateral-type:coin pairs
+func parseTypedCollaterals(arg string) (types.TypedCollaterals, error) {
+	var collateral types.TypedCollaterals
+	for _, pair := range strings.Split(arg, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid collateral %s, expected collateral-type:amount", pair)
+		}
+		coin, err := sdk.ParseCoinNormalized(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		collateral = append(collateral, types.NewTypedCollateral(parts[0], coin))
+	}
+	return collateral, nil
+}
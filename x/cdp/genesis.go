@@ -77,6 +77,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 		k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
 	}
 
+	for _, cdp := range gs.MultiCDPs {
+		if cdp.ID == gs.StartingCdpID {
+			panic(fmt.Sprintf("starting cdp id is assigned to an existing cdp: %v", cdp))
+		}
+		k.SetMultiCDP(ctx, cdp)
+		k.IndexMultiCdpByOwner(ctx, cdp)
+		k.IncrementMultiCdpPrincipal(ctx, cdp.Type, cdp.GetTotalPrincipal())
+	}
+
 	k.SetNextCdpID(ctx, gs.StartingCdpID)
 	k.SetDebtDenom(ctx, gs.DebtDenom)
 	k.SetGovDenom(ctx, gs.GovDenom)
@@ -84,6 +93,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	for _, d := range gs.Deposits {
 		k.SetDeposit(ctx, d)
 	}
+
+	for _, stats := range gs.LiquidationStats {
+		k.SetLiquidationStats(ctx, stats)
+	}
 }
 
 // ExportGenesis export genesis state for cdp module
@@ -102,6 +115,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	var multiCdps types.MultiCDPs
+	k.IterateMultiCdps(ctx, func(cdp types.MultiCDP) (stop bool) {
+		multiCdps = append(multiCdps, k.SynchronizeMultiCdpInterest(ctx, cdp))
+		return false
+	})
+
 	cdpID := k.GetNextCdpID(ctx)
 	debtDenom := k.GetDebtDenom(ctx)
 	govDenom := k.GetGovDenom(ctx)
@@ -129,5 +148,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	gs := types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	gs.MultiCDPs = multiCdps
+	gs.LiquidationStats = k.GetAllLiquidationStats(ctx)
+	return gs
 }
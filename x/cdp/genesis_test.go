@@ -206,10 +206,12 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 	cdpGenesis := types.GenesisState{
 		Params: types.Params{
 			GlobalDebtLimit:         sdk.NewInt64Coin("usdf", 1000000000000),
+			MultiCdpDebtLimit:       sdk.NewInt64Coin("usdf", 500000000000),
 			SurplusAuctionThreshold: types.DefaultSurplusThreshold,
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			PegController:           types.DefaultPegController,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
@@ -224,6 +226,9 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 					KeeperRewardPercentage:           d("0.01"),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
+					CloseFactor:                      sdk.ZeroDec(),
+					LiquidationTargetRatio:           sdk.ZeroDec(),
+					RedemptionFee:                    sdk.ZeroDec(),
 				},
 				{
 					Denom:                            "btc",
@@ -238,6 +243,9 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 					KeeperRewardPercentage:           d("0.01"),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
+					CloseFactor:                      sdk.ZeroDec(),
+					LiquidationTargetRatio:           sdk.ZeroDec(),
+					RedemptionFee:                    sdk.ZeroDec(),
 				},
 			},
 			DebtParam: types.DebtParam{
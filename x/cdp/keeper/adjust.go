@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+// AdjustCDP applies collateral and principal deltas to the owner's cdp in a single step.
+// A positive collateral delta is deposited by the owner, and a negative one is withdrawn from the owner's deposit.
+// A positive principal delta is drawn, and a negative one is repaid, fees first.
+// The collateralization ratio and debt floor are only checked on the final state of the cdp.
+// If all debt is repaid, the collateral is returned to depositors and the cdp is removed from the store.
+func (k Keeper) AdjustCDP(ctx sdk.Context, owner sdk.AccAddress, collateralType string, collateralDelta, principalDelta sdkmath.Int) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	collateral := sdk.NewCoin(cdp.Collateral.Denom, collateralDelta.Abs())
+	principal := sdk.NewCoin(cdp.Principal.Denom, principalDelta.Abs())
+
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	if collateralDelta.IsPositive() {
+		err = k.ValidateBalance(ctx, collateral, owner)
+		if err != nil {
+			return err
+		}
+	}
+	deposit, found := k.GetDeposit(ctx, cdp.ID, owner)
+	if !found {
+		deposit = types.NewDeposit(cdp.ID, owner, sdk.NewCoin(cdp.Collateral.Denom, sdk.ZeroInt()))
+	}
+	if collateralDelta.IsNegative() && collateral.Amount.GT(deposit.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, deposit %s", collateral, deposit.Amount)
+	}
+	if principalDelta.IsPositive() {
+		err = k.ValidatePrincipalDraw(ctx, principal, cdp.Principal.Denom)
+		if err != nil {
+			return err
+		}
+		err = k.ValidateDebtLimit(ctx, cdp.Type, principal)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	// calculate fee and principal payment
+	feePayment := sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.ZeroInt())
+	principalPayment := sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt())
+	if principalDelta.IsNegative() {
+		feePayment, principalPayment = k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, principal)
+		err = k.ValidateBalance(ctx, feePayment.Add(principalPayment), owner)
+		if err != nil {
+			return err
+		}
+	}
+
+	// validate the final state of the cdp
+	updated := cdp
+	if collateralDelta.IsPositive() {
+		updated.Collateral = updated.Collateral.Add(collateral)
+	} else {
+		updated.Collateral = updated.Collateral.Sub(collateral)
+	}
+	if principalDelta.IsPositive() {
+		updated.Principal = updated.Principal.Add(principal)
+	} else {
+		updated.Principal = updated.Principal.Sub(principalPayment)
+	}
+	updated.AccumulatedFees = updated.AccumulatedFees.Sub(feePayment)
+
+	closed := updated.Principal.IsZero() && updated.AccumulatedFees.IsZero()
+	if !closed {
+		dp, _ := k.GetDebtParam(ctx, updated.Principal.Denom)
+		if updated.Principal.Amount.LT(dp.DebtFloor) {
+			return errorsmod.Wrapf(types.ErrBelowDebtFloor, "proposed %s < minimum %s", updated.Principal, dp.DebtFloor)
+		}
+		err = k.ValidateCollateralizationRatio(ctx, updated.Collateral, updated.Type, updated.Principal, updated.AccumulatedFees)
+		if err != nil {
+			return err
+		}
+	}
+
+	// move coins into the module account before paying any out
+	if collateralDelta.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpDeposit,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+	}
+	if principalDelta.IsNegative() {
+		payment := feePayment.Add(principalPayment)
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(payment))
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(payment))
+		if err != nil {
+			panic(err)
+		}
+		debtDenom := k.GetDebtDenom(ctx)
+		err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, sdk.NewCoin(debtDenom, sdk.MinInt(payment.Amount, k.getModAccountDebt(ctx, types.ModuleName))))
+		if err != nil {
+			panic(err)
+		}
+		k.DecrementTotalPrincipal(ctx, cdp.Type, payment)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpRepay,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+	}
+	if collateralDelta.IsNegative() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(collateral))
+		if err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpWithdrawal,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+	}
+	if principalDelta.IsPositive() {
+		// mint the principal and send it to the cdp owner, along with the corresponding debt coins
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(principal))
+		if err != nil {
+			panic(err)
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(principal))
+		if err != nil {
+			panic(err)
+		}
+		err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+		if err != nil {
+			panic(err)
+		}
+		k.IncrementTotalPrincipal(ctx, cdp.Type, principal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpDraw,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, principal.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+	}
+
+	// update the owner's deposit
+	if collateralDelta.IsPositive() {
+		deposit.Amount = deposit.Amount.Add(collateral)
+	} else {
+		deposit.Amount = deposit.Amount.Sub(collateral)
+	}
+	if deposit.Amount.IsZero() {
+		k.DeleteDeposit(ctx, deposit.CdpID, deposit.Depositor)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+
+	if closed {
+		k.ReturnCollateral(ctx, updated)
+		k.RemoveCdpOwnerIndex(ctx, updated)
+		err := k.DeleteCdpAndCollateralRatioIndex(ctx, updated)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpClose,
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+		return nil
+	}
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, updated.Collateral, updated.Type, updated.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, updated, collateralToDebtRatio)
+}
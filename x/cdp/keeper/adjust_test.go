@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/cdp/keeper"
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+type AdjustTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *AdjustTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := []sdk.Coins{
+		cs(c("btc", 300000000)),
+		cs(c("btc", 100000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	keeper := tApp.GetCDPKeeper()
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// collateral ratio of 2
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("btc", 100000000), c("usdf", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *AdjustTestSuite) TestAdjustWithdrawAndRepay() {
+	// withdrawing half the collateral on its own leaves the cdp below the liquidation ratio
+	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 50000000), "btc-a")
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateralRatio)
+
+	err = suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(-50000000), i(-2000000000))
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("btc", 50000000), cdp.Collateral)
+	suite.Equal(c("usdf", 2000000000), cdp.Principal)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("btc", 50000000), deposit.Amount)
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 250000000), c("usdf", 2000000000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+	suite.Equal(i(2000000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+	suite.Equal(i(2000000000), bk.GetSupply(suite.ctx, "debt").Amount)
+}
+
+func (suite *AdjustTestSuite) TestAdjustDepositAndDraw() {
+	// drawing on its own leaves the cdp below the liquidation ratio
+	err := suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "btc-a", c("usdf", 6000000000))
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateralRatio)
+
+	err = suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(100000000), i(6000000000))
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("btc", 200000000), cdp.Collateral)
+	suite.Equal(c("usdf", 10000000000), cdp.Principal)
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 100000000), c("usdf", 10000000000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+	suite.Equal(i(10000000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+}
+
+func (suite *AdjustTestSuite) TestAdjustRepayAllClosesCdp() {
+	err := suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(-50000000), i(-4000000000))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.False(found)
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 300000000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+}
+
+func (suite *AdjustTestSuite) TestAdjustInvalid() {
+	err := suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(-50000000), i(0))
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateralRatio)
+
+	err = suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(0), i(-3995000000))
+	suite.Require().ErrorIs(err, types.ErrBelowDebtFloor)
+
+	err = suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(-200000000), i(-4000000000))
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawAmount)
+
+	err = suite.keeper.AdjustCDP(suite.ctx, suite.addrs[0], "btc-a", i(300000000), i(0))
+	suite.Require().ErrorIs(err, types.ErrInsufficientBalance)
+
+	err = suite.keeper.AdjustCDP(suite.ctx, suite.addrs[1], "btc-a", i(100000000), i(0))
+	suite.Require().ErrorIs(err, types.ErrCdpNotFound)
+
+	// a failed adjustment leaves the cdp unchanged
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("btc", 100000000), cdp.Collateral)
+	suite.Equal(c("usdf", 4000000000), cdp.Principal)
+}
+
+func TestAdjustTestSuite(t *testing.T) {
+	suite.Run(t, new(AdjustTestSuite))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -14,6 +15,16 @@ const (
 
 // AuctionCollateral creates auctions from the input deposits which attempt to raise the corresponding amount of debt
 func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, collateralType string, debt sdkmath.Int, bidDenom string) error {
+	k.recordLiquidation(ctx, collateralType)
+
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	if cp.BatchAuctionEnabled() {
+		return k.startBatchAuction(ctx, deposits, collateralType, debt, bidDenom)
+	}
+
 	auctionSize := k.getAuctionSize(ctx, collateralType)
 	totalCollateral := deposits.SumCollateral()
 	for _, deposit := range deposits {
@@ -69,10 +80,9 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
-			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
-			[]sdkmath.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
+		err := k.startAuction(
+			ctx, collateralType, sdk.NewCoin(collateral.Denom, auctionSize),
+			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), returnAddr, sdk.NewCoin(debtDenom, debtAmount),
 		)
 		if err != nil {
 			return err
@@ -94,12 +104,87 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
-		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
-		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
-		[]sdkmath.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
+	return k.startAuction(
+		ctx, collateralType, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
+		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), returnAddr, sdk.NewCoin(debtDenom, lastAuctionDebt),
 	)
+}
+
+// startAuction starts an auction of the lot using the auction type of the collateral type, returning unsold lot to returnAddr
+func (k Keeper) startAuction(ctx sdk.Context, collateralType string, lot, maxBid sdk.Coin, returnAddr sdk.AccAddress, debt sdk.Coin) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+
+	k.recordCollateralAuction(ctx, collateralType, lot, sdk.NewCoin(maxBid.Denom, debt.Amount), maxBid.SubAmount(debt.Amount))
+
+	if !cp.DutchAuctionEnabled() {
+		_, err := k.auctionKeeper.StartCollateralAuction(
+			ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdkmath.Int{lot.Amount}, debt,
+		)
+		return err
+	}
 
+	// dutch auctions start from the liquidation price
+	marketPrice, err := k.auctionMarketPrice(ctx, cp, maxBid.Denom)
+	if err != nil {
+		return err
+	}
+	_, err = k.auctionKeeper.StartDutchAuction(
+		ctx, types.LiquidatorMacc, lot, maxBid, marketPrice, []sdk.AccAddress{returnAddr}, []sdkmath.Int{lot.Amount}, debt,
+	)
+	return err
+}
+
+// auctionMarketPrice returns the liquidation price of a collateral type, converted to units of principal per unit of collateral
+func (k Keeper) auctionMarketPrice(ctx sdk.Context, cp types.CollateralParam, principalDenom string) (sdk.Dec, error) {
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	dp, found := k.GetDebtParam(ctx, principalDenom)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrDebtNotSupported, principalDenom)
+	}
+	return price.Price.
+		Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())).
+		Quo(sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())), nil
+}
+
+// startBatchAuction starts a single batch auction for all the input deposits, returning unsold lot to the depositors
+// in proportion to their deposits, rather than splitting the deposits into auctions of the auction size
+func (k Keeper) startBatchAuction(ctx sdk.Context, deposits types.Deposits, collateralType string, debt sdkmath.Int, principalDenom string) error {
+	totalCollateral := deposits.SumCollateral()
+	if !totalCollateral.IsPositive() {
+		return nil
+	}
+
+	returnAddrs := make([]sdk.AccAddress, len(deposits))
+	returnWeights := make([]sdkmath.Int, len(deposits))
+	for i, deposit := range deposits {
+		returnAddrs[i] = deposit.Depositor
+		returnWeights[i] = deposit.Amount.Amount
+	}
+
+	// bids below the reserve ratio of the liquidation price are not accepted
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	marketPrice, err := k.auctionMarketPrice(ctx, cp, principalDenom)
+	if err != nil {
+		return err
+	}
+
+	lot := sdk.NewCoin(deposits[0].Amount.Denom, totalCollateral)
+	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debt)
+	k.recordCollateralAuction(ctx, collateralType, lot, sdk.NewCoin(principalDenom, debt), sdk.NewCoin(principalDenom, penalty))
+
+	_, err = k.auctionKeeper.StartBatchAuction(
+		ctx, types.LiquidatorMacc, lot, sdk.NewCoin(principalDenom, debt.Add(penalty)), marketPrice, returnAddrs, returnWeights,
+		sdk.NewCoin(k.GetDebtDenom(ctx), debt),
+	)
 	return err
 }
 
@@ -71,6 +71,68 @@ func (suite *AuctionTestSuite) TestCollateralAuction() {
 	suite.Require().NoError(err)
 }
 
+func (suite *AuctionTestSuite) TestDutchCollateralAuction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Type == "btc-a" {
+			params.CollateralParams[i].AuctionType = auctiontypes.DutchAuctionType
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	bk := suite.app.GetBankKeeper()
+	err := bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 600000000), c("btc", 15000000)))
+	suite.Require().NoError(err)
+	testDeposit := types.NewDeposit(1, suite.addrs[0], c("btc", 15000000))
+	err = suite.keeper.AuctionCollateral(suite.ctx, types.Deposits{testDeposit}, "btc-a", i(600000000), "usdf")
+	suite.Require().NoError(err)
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 2)
+	for _, auction := range auctions {
+		dutchAuction, ok := auction.(*auctiontypes.DutchAuction)
+		suite.Require().True(ok)
+		// $8000 per btc is 80 usdf base units per satoshi, plus the default 20% premium
+		suite.Equal(d("96"), dutchAuction.StartPrice)
+		suite.Equal([]sdk.AccAddress{suite.addrs[0]}, dutchAuction.LotReturns.Addresses)
+	}
+	suite.Equal(c("btc", 10000000), auctions[0].GetLot())
+	suite.Equal(c("btc", 5000000), auctions[1].GetLot())
+}
+
+func (suite *AuctionTestSuite) TestBatchCollateralAuction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Type == "btc-a" {
+			params.CollateralParams[i].AuctionType = auctiontypes.BatchAuctionType
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	bk := suite.app.GetBankKeeper()
+	err := bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 600000000), c("btc", 25000000)))
+	suite.Require().NoError(err)
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("FuryTestUser2")))
+	deposits := types.Deposits{
+		types.NewDeposit(1, suite.addrs[0], c("btc", 15000000)),
+		types.NewDeposit(1, depositor, c("btc", 10000000)),
+	}
+	err = suite.keeper.AuctionCollateral(suite.ctx, deposits, "btc-a", i(600000000), "usdf")
+	suite.Require().NoError(err)
+
+	// all the collateral is sold in one auction, rather than auctions of the auction size
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 1)
+	batchAuction, ok := auctions[0].(*auctiontypes.BatchAuction)
+	suite.Require().True(ok)
+	suite.Equal(c("btc", 25000000), batchAuction.Lot)
+	suite.Equal(c("debt", 600000000), batchAuction.CorrespondingDebt)
+	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "btc-a", i(600000000))
+	suite.Equal(c("usdf", 600000000).AddAmount(penalty), batchAuction.MaxBid)
+	suite.Equal([]sdk.AccAddress{suite.addrs[0], depositor}, batchAuction.LotReturns.Addresses)
+	suite.Equal([]sdkmath.Int{i(15000000), i(10000000)}, batchAuction.LotReturns.Weights)
+}
+
 func (suite *AuctionTestSuite) TestSurplusAuction() {
 	bk := suite.app.GetBankKeeper()
 	ak := suite.app.GetAccountKeeper()
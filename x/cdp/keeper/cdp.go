@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -564,21 +565,23 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 		return sdk.ZeroDec(), nil
 	}
 	var marketID string
+	var twapWindow time.Duration
 	switch pfType {
 	case spot:
 		marketID = k.getSpotMarketID(ctx, collateralType)
 	case liquidation:
 		marketID = k.getliquidationMarketID(ctx, collateralType)
+		twapWindow = k.getLiquidationTwapWindow(ctx, collateralType)
 	default:
 		return sdk.Dec{}, pfType.IsValid()
 	}
 
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getMarketPrice(ctx, marketID, twapWindow)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
 	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral, collateralType)
-	collateralValue := collateralBaseUnits.Mul(price.Price)
+	collateralValue := collateralBaseUnits.Mul(price)
 
 	prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, principal)
 	principalTotal := prinicpalBaseUnits
@@ -593,24 +596,40 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context, collateralType string, absoluteRatio sdk.Dec, pfType pricefeedType) (sdk.Dec, error) {
 	// get price of collateral
 	var marketID string
+	var twapWindow time.Duration
 	switch pfType {
 	case spot:
 		marketID = k.getSpotMarketID(ctx, collateralType)
 	case liquidation:
 		marketID = k.getliquidationMarketID(ctx, collateralType)
+		twapWindow = k.getLiquidationTwapWindow(ctx, collateralType)
 	default:
 		return sdk.Dec{}, pfType.IsValid()
 	}
 
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getMarketPrice(ctx, marketID, twapWindow)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
 	// convert absolute ratio to collateralization ratio
-	respectiveCollateralRatio := absoluteRatio.Quo(price.Price)
+	respectiveCollateralRatio := absoluteRatio.Quo(price)
 	return respectiveCollateralRatio, nil
 }
 
+// getMarketPrice returns the current price of a market, or its time-weighted average price over the twap window if the
+// window is positive. The market must have a valid current price either way, so a twap is not used once the market's
+// prices have expired or been flagged.
+func (k Keeper) getMarketPrice(ctx sdk.Context, marketID string, twapWindow time.Duration) (sdk.Dec, error) {
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if twapWindow > 0 {
+		return k.pricefeedKeeper.GetTWAP(ctx, marketID, twapWindow)
+	}
+	return price.Price, nil
+}
+
 // SetMarketStatus sets the status of the input market, true means the market is up and running, false means it is down
 func (k Keeper) SetMarketStatus(ctx sdk.Context, marketID string, up bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PricefeedStatusKeyPrefix)
@@ -5,6 +5,8 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -111,6 +113,19 @@ func (s QueryServer) TotalCollateral(c context.Context, req *types.QueryTotalCol
 	cdpAccount := s.keeper.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	totalCdpCollateral := s.keeper.bankKeeper.GetAllBalances(ctx, cdpAccount.GetAddress())
 
+	// multi-collateral cdps hold collateral of several types in the same module account
+	multiCdpCollateral := make(map[string]sdkmath.Int)
+	s.keeper.IterateMultiCdps(ctx, func(cdp types.MultiCDP) bool {
+		for _, tc := range cdp.Collateral {
+			amount, found := multiCdpCollateral[tc.CollateralType]
+			if !found {
+				amount = sdk.ZeroInt()
+			}
+			multiCdpCollateral[tc.CollateralType] = amount.Add(tc.Amount.Amount)
+		}
+		return false
+	})
+
 	var totalCollaterals types.TotalCollaterals
 
 	for denom, collateralTypes := range denomCollateralTypes {
@@ -135,6 +150,9 @@ func (s QueryServer) TotalCollateral(c context.Context, req *types.QueryTotalCol
 			cdps := s.keeper.GetAllCdpsByCollateralType(ctx, collateralTypes[i])
 
 			collateral := sdk.ZeroInt()
+			if amount, found := multiCdpCollateral[collateralTypes[i]]; found {
+				collateral = amount
+			}
 
 			for _, cdp := range cdps {
 				collateral = collateral.Add(cdp.Collateral.Amount)
@@ -212,6 +230,151 @@ func (s QueryServer) Cdp(c context.Context, req *types.QueryCdpRequest) (*types.
 	}, nil
 }
 
+// AdjustCdp simulates adjusting the cdp owned by the input address, returning the cdp as it would be after the adjustment.
+func (s QueryServer) AdjustCdp(c context.Context, req *types.QueryAdjustCdpRequest) (*types.QueryAdjustCdpResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	collateralDelta, principalDelta := req.CollateralDelta, req.PrincipalDelta
+	if collateralDelta.IsNil() {
+		collateralDelta = sdk.ZeroInt()
+	}
+	if principalDelta.IsNil() {
+		principalDelta = sdk.ZeroInt()
+	}
+
+	// apply the adjustment to a cached context that is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	err = s.keeper.AdjustCDP(cacheCtx, owner, req.CollateralType, collateralDelta, principalDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(cacheCtx, owner, req.CollateralType)
+	if !found {
+		return &types.QueryAdjustCdpResponse{
+			Closed: true,
+		}, nil
+	}
+
+	return &types.QueryAdjustCdpResponse{
+		Cdp: s.keeper.LoadCDPResponse(cacheCtx, cdp),
+	}, nil
+}
+
+// LiquidationStats queries the liquidation totals of collateral types over time windows.
+func (s QueryServer) LiquidationStats(c context.Context, req *types.QueryLiquidationStatsRequest) (*types.QueryLiquidationStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.CollateralType != "" {
+		_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+		if !valid {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+		}
+	}
+	if !req.EndTime.IsZero() && req.EndTime.Before(req.StartTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time is before start time")
+	}
+
+	stats := types.LiquidationStatsList{}
+	totalsByType := make(map[string]types.LiquidationStats)
+	var collateralTypes []string
+	s.keeper.IterateLiquidationStats(ctx, req.StartTime, req.EndTime, func(ls types.LiquidationStats) bool {
+		if req.CollateralType != "" && ls.CollateralType != req.CollateralType {
+			return false
+		}
+		stats = append(stats, ls)
+
+		total, found := totalsByType[ls.CollateralType]
+		if !found {
+			// totals take the window start of the earliest window included
+			total = types.NewLiquidationStats(ls.CollateralType, ls.WindowStart)
+			collateralTypes = append(collateralTypes, ls.CollateralType)
+		}
+		totalsByType[ls.CollateralType] = total.Add(ls)
+		return false
+	})
+
+	totals := types.LiquidationStatsList{}
+	for _, collateralType := range collateralTypes {
+		totals = append(totals, totalsByType[collateralType])
+	}
+
+	return &types.QueryLiquidationStatsResponse{
+		Stats:  stats,
+		Totals: totals,
+	}, nil
+}
+
+// MultiCdp queries the multi-collateral CDP owned by the input address.
+func (s QueryServer) MultiCdp(c context.Context, req *types.QueryMultiCdpRequest) (*types.QueryMultiCdpResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	cdp, found := s.keeper.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", req.Owner)
+	}
+
+	return &types.QueryMultiCdpResponse{
+		Cdp: s.keeper.LoadMultiCDPResponse(ctx, cdp),
+	}, nil
+}
+
+// MultiCdps queries all active multi-collateral CDPs.
+func (s QueryServer) MultiCdps(c context.Context, req *types.QueryMultiCdpsRequest) (*types.QueryMultiCdpsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var cdps types.MultiCDPResponses
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.MultiCdpKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var cdp types.MultiCDP
+		if err := s.keeper.cdc.Unmarshal(value, &cdp); err != nil {
+			return err
+		}
+
+		cdps = append(cdps, s.keeper.LoadMultiCDPResponse(ctx, cdp))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMultiCdpsResponse{
+		Cdps:       cdps,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Deposits queries deposits associated with the CDP owned by an address for a collateral type.
 func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -295,3 +458,105 @@ func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (type
 
 	return cdpResponses, nil
 }
+
+// CdpHealth queries how close the CDP owned by an address for a collateral type is to liquidation.
+func (s QueryServer) CdpHealth(c context.Context, req *types.QueryCdpHealthRequest) (*types.QueryCdpHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	}
+
+	health, err := s.keeper.LoadCDPHealth(ctx, cdp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCdpHealthResponse{
+		Health: health,
+	}, nil
+}
+
+// MultiCdpHealth queries how close the multi-collateral CDP owned by an address is to liquidation.
+func (s QueryServer) MultiCdpHealth(c context.Context, req *types.QueryMultiCdpHealthRequest) (*types.QueryMultiCdpHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	cdp, found := s.keeper.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", req.Owner)
+	}
+
+	health, err := s.keeper.LoadMultiCDPHealth(ctx, cdp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMultiCdpHealthResponse{
+		Health: health,
+	}, nil
+}
+
+// CdpsAtRisk queries the CDPs of a collateral type in the order of the collateral ratio index, lowest first. The index
+// doesn't include interest accrued since a CDP was last synced, so the order is approximate.
+func (s QueryServer) CdpsAtRisk(c context.Context, req *types.QueryCdpsAtRiskRequest) (*types.QueryCdpsAtRiskResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	var cdps types.CDPHealthResponses
+	indexStore := prefix.NewStore(ctx.KVStore(s.keeper.key), types.CollateralRatioIndexPrefix)
+	store := prefix.NewStore(indexStore, types.CollateralRatioTypePrefix(req.CollateralType))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		id := types.GetCdpIDFromBytes(key[len(key)-8:])
+		cdp, found := s.keeper.GetCDP(ctx, req.CollateralType, id)
+		if !found {
+			return errorsmod.Wrapf(types.ErrCdpNotFound, "id %d", id)
+		}
+
+		health, err := s.keeper.LoadCDPHealth(ctx, cdp)
+		if err != nil {
+			return err
+		}
+		cdps = append(cdps, health)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCdpsAtRiskResponse{
+		Cdps:       cdps,
+		Pagination: pageRes,
+	}, nil
+}
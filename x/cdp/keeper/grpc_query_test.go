@@ -219,6 +219,49 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryCdp() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryAdjustCdp() {
+	suite.addCdp()
+
+	res, err := suite.queryServer.AdjustCdp(sdk.WrapSDKContext(suite.ctx), &types.QueryAdjustCdpRequest{
+		CollateralType:  "xrp-a",
+		Owner:           suite.addrs[0].String(),
+		CollateralDelta: i(100000000),
+		PrincipalDelta:  i(10000000),
+	})
+	suite.Require().NoError(err)
+	suite.False(res.Closed)
+	suite.Equal(c("xrp", 200000000), res.Cdp.Collateral)
+	suite.Equal(c("usdf", 20000000), res.Cdp.Principal)
+
+	// the adjustment is not applied to the store
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 100000000), cdp.Collateral)
+	suite.Equal(c("usdf", 10000000), cdp.Principal)
+
+	// repaying all debt closes the cdp
+	res, err = suite.queryServer.AdjustCdp(sdk.WrapSDKContext(suite.ctx), &types.QueryAdjustCdpRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+		PrincipalDelta: i(-10000000),
+	})
+	suite.Require().NoError(err)
+	suite.True(res.Closed)
+
+	_, err = suite.queryServer.AdjustCdp(sdk.WrapSDKContext(suite.ctx), &types.QueryAdjustCdpRequest{
+		CollateralType:  "xrp-a",
+		Owner:           suite.addrs[0].String(),
+		CollateralDelta: i(-100000000),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateralRatio)
+
+	_, err = suite.queryServer.AdjustCdp(sdk.WrapSDKContext(suite.ctx), &types.QueryAdjustCdpRequest{
+		CollateralType: "xrp-a",
+		Owner:          "invalid addr",
+	})
+	suite.Require().EqualError(err, "rpc error: code = InvalidArgument desc = invalid address")
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryDeposits() {
 	suite.addCdp()
 
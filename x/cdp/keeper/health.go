@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+// LoadCDPHealth returns how close a cdp is to liquidation, including any unsynced interest
+func (k Keeper) LoadCDPHealth(ctx sdk.Context, cdp types.CDP) (types.CDPHealthResponse, error) {
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(k.CalculateNewInterest(ctx, cdp))
+	collateral := types.TypedCollaterals{types.NewTypedCollateral(cdp.Type, cdp.Collateral)}
+	return k.calculateHealth(ctx, cdp.ID, cdp.Owner, cdp.Type, collateral, cdp.GetTotalPrincipal())
+}
+
+// LoadMultiCDPHealth returns how close a multi-collateral cdp is to liquidation, including any unsynced interest
+func (k Keeper) LoadMultiCDPHealth(ctx sdk.Context, cdp types.MultiCDP) (types.CDPHealthResponse, error) {
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(k.CalculateNewMultiCdpInterest(ctx, cdp))
+	return k.calculateHealth(ctx, cdp.ID, cdp.Owner, cdp.Type, cdp.Collateral, cdp.GetTotalPrincipal())
+}
+
+// calculateHealth compares the debt the collateral supports, the sum of each collateral's value divided by the
+// liquidation ratio of its type, to the total debt. Liquidation prices and the health factor use liquidation market
+// prices, while the maximum draw and withdraws use spot prices as the draw and withdraw messages do.
+func (k Keeper) calculateHealth(ctx sdk.Context, id uint64, owner sdk.AccAddress, collateralType string,
+	collateral types.TypedCollaterals, debt sdk.Coin,
+) (types.CDPHealthResponse, error) {
+	_, liquidationCapacity, err := k.CalculateMultiCdpCollateralValue(ctx, collateral, liquidation)
+	if err != nil {
+		return types.CDPHealthResponse{}, err
+	}
+	_, spotCapacity, err := k.CalculateMultiCdpCollateralValue(ctx, collateral, spot)
+	if err != nil {
+		return types.CDPHealthResponse{}, err
+	}
+	debtBaseUnits := k.convertDebtToBaseUnits(ctx, debt)
+
+	var healthFactor sdk.Dec
+	if debtBaseUnits.IsPositive() {
+		healthFactor = liquidationCapacity.Quo(debtBaseUnits)
+	}
+
+	// The price of a collateral type at which the debt its collateral supports covers the rest of the debt
+	liquidationPrices := types.LiquidationPrices{}
+	if liquidationCapacity.GTE(debtBaseUnits) {
+		for _, tc := range collateral {
+			value, err := k.calculateCollateralValue(ctx, tc, liquidation)
+			if err != nil {
+				return types.CDPHealthResponse{}, err
+			}
+			liquidationRatio := k.getLiquidationRatio(ctx, tc.CollateralType)
+			uncovered := debtBaseUnits.Sub(liquidationCapacity.Sub(value.Quo(liquidationRatio)))
+			units := k.convertCollateralToBaseUnits(ctx, tc.Amount, tc.CollateralType)
+			if uncovered.IsPositive() && units.IsPositive() {
+				liquidationPrices = append(liquidationPrices, types.NewLiquidationPrice(tc.CollateralType, uncovered.Mul(liquidationRatio).Quo(units)))
+			}
+		}
+	}
+
+	excess := spotCapacity.Sub(debtBaseUnits)
+	maxDraw := sdk.NewCoin(debt.Denom, sdk.ZeroInt())
+	maxWithdraw := types.TypedCollaterals{}
+	if excess.IsPositive() {
+		maxDraw = sdk.NewCoin(debt.Denom, k.convertBaseUnitsToDebt(ctx, debt.Denom, excess).TruncateInt())
+
+		// Withdrawing collateral worth the excess multiplied by its liquidation ratio leaves the debt exactly covered
+		for _, tc := range collateral {
+			value, err := k.calculateCollateralValue(ctx, tc, spot)
+			if err != nil {
+				return types.CDPHealthResponse{}, err
+			}
+			withdrawable := tc.Amount.Amount
+			withdrawValue := excess.Mul(k.getLiquidationRatio(ctx, tc.CollateralType))
+			if withdrawValue.LT(value) {
+				withdrawable = withdrawValue.Quo(value).MulInt(tc.Amount.Amount).TruncateInt()
+			}
+			if withdrawable.IsPositive() {
+				maxWithdraw = append(maxWithdraw, types.NewTypedCollateral(tc.CollateralType, sdk.NewCoin(tc.Amount.Denom, withdrawable)))
+			}
+		}
+	}
+
+	return types.NewCDPHealthResponse(id, owner, collateralType, healthFactor, liquidationPrices, maxDraw, maxWithdraw), nil
+}
+
+// converts the input amount of debt in base units to the smallest unit of the debt asset
+func (k Keeper) convertBaseUnitsToDebt(ctx sdk.Context, denom string, baseUnits sdk.Dec) sdk.Dec {
+	dp, _ := k.GetDebtParam(ctx, denom)
+	return baseUnits.Quo(sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64()))
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/percosis-labs/fury/x/cdp/keeper"
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+func (suite *MultiCdpTestSuite) TestCDPHealth() {
+	// $250 of xrp (liquidation ratio 2.0) supports 125 usdf of debt
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 1000000000), c("usdf", 100000000), "xrp-a")
+	suite.Require().NoError(err)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+
+	health, err := suite.keeper.LoadCDPHealth(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewCDPHealthResponse(
+		cdp.ID, suite.addrs[0], "xrp-a",
+		d("1.25"),
+		types.LiquidationPrices{types.NewLiquidationPrice("xrp-a", d("0.2"))},
+		c("usdf", 25000000),
+		types.TypedCollaterals{types.NewTypedCollateral("xrp-a", c("xrp", 200000000))},
+	), health)
+
+	// drawing the maximum principal leaves the cdp at the liquidation ratio
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(suite.keeper.AddPrincipal(cacheCtx, suite.addrs[0], "xrp-a", health.MaxDraw))
+	cacheCtx, _ = suite.ctx.CacheContext()
+	suite.Require().NoError(suite.keeper.WithdrawCollateral(cacheCtx, suite.addrs[0], suite.addrs[0], health.MaxWithdraw[0].Amount, "xrp-a"))
+
+	suite.setPrice(d("0.19"), "xrp:usd")
+	suite.setPrice(d("0.19"), "xrp:usd:30")
+
+	health, err = suite.keeper.LoadCDPHealth(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	suite.Require().Equal("0.950000000000000000", health.HealthFactor)
+	suite.Require().Empty(health.LiquidationPrices)
+	suite.Require().True(health.MaxDraw.IsZero())
+	suite.Require().Empty(health.MaxWithdraw)
+}
+
+func (suite *MultiCdpTestSuite) TestMultiCDPHealth() {
+	suite.createMultiCdp(suite.addrs[0])
+	cdp, found := suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+
+	health, err := suite.keeper.LoadMultiCDPHealth(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	capacity := d("125").Add(d("80").Quo(d("1.5")))
+	suite.Require().Equal(types.NewCDPHealthResponse(
+		cdp.ID, suite.addrs[0], cdp.Type,
+		capacity.Quo(d("150")),
+		types.LiquidationPrices{
+			types.NewLiquidationPrice("xrp-a", d("150").Sub(d("80").Quo(d("1.5"))).Mul(d("2")).Quo(d("1000"))),
+			types.NewLiquidationPrice("btc-a", d("3750")),
+		},
+		c("usdf", 28333333),
+		types.TypedCollaterals{
+			types.NewTypedCollateral("xrp-a", c("xrp", 226666666)),
+			types.NewTypedCollateral("btc-a", c("btc", 531250)),
+		},
+	), health)
+
+	for _, tc := range health.MaxWithdraw {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		suite.Require().NoError(suite.keeper.WithdrawMultiCdpCollateral(cacheCtx, suite.addrs[0], tc.Amount, tc.CollateralType))
+	}
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(suite.keeper.AddMultiCdpPrincipal(cacheCtx, suite.addrs[0], health.MaxDraw))
+}
+
+func (suite *MultiCdpTestSuite) TestGrpcQueryHealth() {
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 1000000000), c("usdf", 100000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 1000000000), c("usdf", 120000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 1000000000), c("usdf", 50000000), "xrp-a"))
+	suite.createMultiCdp(suite.addrs[0])
+
+	res, err := queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpHealthRequest{
+		Owner:          suite.addrs[0].String(),
+		CollateralType: "xrp-a",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal("1.250000000000000000", res.Health.HealthFactor)
+
+	_, err = queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpHealthRequest{
+		Owner:          suite.addrs[0].String(),
+		CollateralType: "btc-a",
+	})
+	suite.Require().ErrorIs(err, types.ErrCdpNotFound)
+
+	multiRes, err := queryServer.MultiCdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryMultiCdpHealthRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(multiRes.Health.HealthFactor)
+
+	// cdps are ordered from the lowest collateral to debt ratio
+	atRiskRes, err := queryServer.CdpsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpsAtRiskRequest{
+		CollateralType: "xrp-a",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(atRiskRes.Cdps, 3)
+	suite.Require().Equal(suite.addrs[1].String(), atRiskRes.Cdps[0].Owner)
+	suite.Require().Equal(suite.addrs[0].String(), atRiskRes.Cdps[1].Owner)
+	suite.Require().Equal(suite.addrs[2].String(), atRiskRes.Cdps[2].Owner)
+
+	atRiskRes, err = queryServer.CdpsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpsAtRiskRequest{
+		CollateralType: "xrp-a",
+		Pagination:     &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(atRiskRes.Cdps, 1)
+	suite.Require().Equal(suite.addrs[1].String(), atRiskRes.Cdps[0].Owner)
+
+	atRiskRes, err = queryServer.CdpsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpsAtRiskRequest{
+		CollateralType: "btc-a",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(atRiskRes.Cdps)
+
+	_, err = queryServer.CdpsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpsAtRiskRequest{
+		CollateralType: "invalid",
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
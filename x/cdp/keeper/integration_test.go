@@ -156,6 +156,7 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 	cdpGenesis := types.GenesisState{
 		Params: types.Params{
 			GlobalDebtLimit:         sdk.NewInt64Coin("usdf", 2000000000000),
+			MultiCdpDebtLimit:       sdk.NewInt64Coin("usdf", 1000000000000),
 			SurplusAuctionThreshold: types.DefaultSurplusThreshold,
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
@@ -261,6 +261,119 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 	}
 }
 
+// TestAccumulateInterestPegController tests that interest accumulates at the stability fee adjusted for the debt asset's peg
+func (suite *InterestTestSuite) TestAccumulateInterestPegController() {
+	type args struct {
+		pegController types.PegController
+		price         sdk.Dec
+		expectedFee   sdk.Dec
+	}
+
+	type test struct {
+		name string
+		args args
+	}
+	oneYearInSeconds := 31536000
+	stabilityFee := d("1.000000001547125958") // bnb-a stability fee
+	sensitivity := d("0.00000005")
+	minFee := d("1.000000000315522921")
+	maxFee := d("1.000000003593629036")
+
+	testCases := []test{
+		{
+			"disabled",
+			args{
+				pegController: types.DefaultPegController,
+				price:         d("0.98"),
+				expectedFee:   stabilityFee,
+			},
+		},
+		{
+			"at peg",
+			args{
+				pegController: types.NewPegController("busd:usd", sdk.OneDec(), sensitivity, minFee, maxFee),
+				price:         d("1.00"),
+				expectedFee:   stabilityFee,
+			},
+		},
+		{
+			"below peg",
+			args{
+				pegController: types.NewPegController("busd:usd", sdk.OneDec(), sensitivity, minFee, maxFee),
+				price:         d("0.98"),
+				expectedFee:   stabilityFee.Add(d("0.000000001")),
+			},
+		},
+		{
+			"above peg",
+			args{
+				pegController: types.NewPegController("busd:usd", sdk.OneDec(), sensitivity, minFee, maxFee),
+				price:         d("1.02"),
+				expectedFee:   stabilityFee.Sub(d("0.000000001")),
+			},
+		},
+		{
+			"far below peg - capped at max fee",
+			args{
+				pegController: types.NewPegController("busd:usd", sdk.OneDec(), sensitivity, minFee, maxFee),
+				price:         d("0.5"),
+				expectedFee:   maxFee,
+			},
+		},
+		{
+			"far above peg - capped at min fee",
+			args{
+				pegController: types.NewPegController("busd:usd", sdk.OneDec(), sensitivity, minFee, maxFee),
+				price:         d("1.5"),
+				expectedFee:   minFee,
+			},
+		},
+		{
+			"no price - unadjusted fee",
+			args{
+				pegController: types.NewPegController("usdf:usd", sdk.OneDec(), sensitivity, minFee, maxFee),
+				price:         d("0.98"),
+				expectedFee:   stabilityFee,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+			totalPrincipal := sdkmath.NewInt(100000000000000)
+
+			params := suite.keeper.GetParams(suite.ctx)
+			params.PegController = tc.args.pegController
+			suite.keeper.SetParams(suite.ctx, params)
+
+			suite.ctx = suite.ctx.WithBlockTime(initialTime)
+			suite.keeper.SetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom, totalPrincipal)
+			suite.keeper.SetPreviousAccrualTime(suite.ctx, "bnb-a", suite.ctx.BlockTime())
+			suite.keeper.SetInterestFactor(suite.ctx, "bnb-a", sdk.OneDec())
+
+			updatedBlockTime := initialTime.Add(time.Duration(int(time.Second) * oneYearInSeconds))
+			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
+
+			pk := suite.app.GetPriceFeedKeeper()
+			_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "busd:usd", tc.args.price, updatedBlockTime.Add(time.Hour))
+			suite.Require().NoError(err)
+			suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "busd:usd"))
+
+			err = suite.keeper.AccumulateInterest(suite.ctx, "bnb-a")
+			suite.Require().NoError(err)
+
+			interestFactor := keeper.CalculateInterestFactor(tc.args.expectedFee, sdkmath.NewInt(int64(oneYearInSeconds)))
+			expectedTotalPrincipal := interestFactor.MulInt(totalPrincipal).RoundInt()
+			actualTotalPrincipal := suite.keeper.GetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom)
+			suite.Require().Equal(expectedTotalPrincipal, actualTotalPrincipal)
+
+			actualInterestFactor, _ := suite.keeper.GetInterestFactor(suite.ctx, "bnb-a")
+			suite.Require().Equal(interestFactor, actualInterestFactor)
+		})
+	}
+}
+
 // TestSynchronizeInterest tests the functionality of synchronizing the accumulated interest for CDPs
 func (suite *InterestTestSuite) TestSynchronizeInterest() {
 	type args struct {
@@ -173,3 +173,197 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) CreateMultiCDP(goCtx context.Context, msg *types.MsgCreateMultiCDP) (*types.MsgCreateMultiCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.AddMultiCdp(ctx, sender, msg.Collateral, msg.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	cdp, _ := k.keeper.GetMultiCdpByOwner(ctx, sender)
+	return &types.MsgCreateMultiCDPResponse{CdpID: cdp.ID}, nil
+}
+
+func (k msgServer) DepositMultiCDP(goCtx context.Context, msg *types.MsgDepositMultiCDP) (*types.MsgDepositMultiCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DepositMultiCdpCollateral(ctx, sender, msg.Collateral, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgDepositMultiCDPResponse{}, nil
+}
+
+func (k msgServer) WithdrawMultiCDP(goCtx context.Context, msg *types.MsgWithdrawMultiCDP) (*types.MsgWithdrawMultiCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.WithdrawMultiCdpCollateral(ctx, sender, msg.Collateral, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgWithdrawMultiCDPResponse{}, nil
+}
+
+func (k msgServer) DrawMultiCDPDebt(goCtx context.Context, msg *types.MsgDrawMultiCDPDebt) (*types.MsgDrawMultiCDPDebtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.AddMultiCdpPrincipal(ctx, sender, msg.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgDrawMultiCDPDebtResponse{}, nil
+}
+
+func (k msgServer) RepayMultiCDPDebt(goCtx context.Context, msg *types.MsgRepayMultiCDPDebt) (*types.MsgRepayMultiCDPDebtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RepayMultiCdpPrincipal(ctx, sender, msg.Payment)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRepayMultiCDPDebtResponse{}, nil
+}
+
+func (k msgServer) LiquidateMultiCDP(goCtx context.Context, msg *types.MsgLiquidateMultiCDP) (*types.MsgLiquidateMultiCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.AttemptKeeperMultiCdpLiquidation(ctx, keeper, borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Keeper),
+		),
+	)
+	return &types.MsgLiquidateMultiCDPResponse{}, nil
+}
+
+func (k msgServer) AdjustCDP(goCtx context.Context, msg *types.MsgAdjustCDP) (*types.MsgAdjustCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.AdjustCDP(ctx, sender, msg.CollateralType, msg.CollateralDelta, msg.PrincipalDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgAdjustCDPResponse{}, nil
+}
+
+func (k msgServer) RedeemDebt(goCtx context.Context, msg *types.MsgRedeemDebt) (*types.MsgRedeemDebtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	redeemed, collateral, err := k.keeper.RedeemDebt(ctx, sender, msg.CollateralType, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRedeemDebtResponse{
+		Redeemed:   redeemed,
+		Collateral: collateral,
+	}, nil
+}
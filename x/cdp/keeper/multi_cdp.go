@@ -0,0 +1,845 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+// Multi-collateral cdps hold collateral of several collateral types against a single debt position.
+// The debt accrues interest and counts towards the debt limit of the cdp's Type, which is the
+// collateral type of the first collateral used to open the cdp. As the debt limits of the other
+// collateral types never see this debt, the total debt of all multi-collateral cdps is also capped
+// by the MultiCdpDebtLimit param. The position is solvent while the sum over each collateral of
+// value / liquidation ratio covers the total debt.
+//
+// Note: cdp hooks are not called for multi-collateral cdps, so they do not earn usdf minting rewards.
+
+// AddMultiCdp opens a multi-collateral cdp for the owner, backed by the input collateral.
+// The collateral type of the first collateral entry determines the stability fee and debt limit of the cdp.
+func (k Keeper) AddMultiCdp(ctx sdk.Context, owner sdk.AccAddress, collateral types.TypedCollaterals, principal sdk.Coin) error {
+	if len(collateral) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidCollateral, "collateral cannot be empty")
+	}
+	if err := collateral.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCollateral, err.Error())
+	}
+	for _, tc := range collateral {
+		if err := k.ValidateCollateral(ctx, tc.Amount, tc.CollateralType); err != nil {
+			return err
+		}
+		if err := k.ValidateBalance(ctx, tc.Amount, owner); err != nil {
+			return err
+		}
+	}
+	if _, found := k.GetMultiCdpByOwner(ctx, owner); found {
+		return errorsmod.Wrapf(types.ErrMultiCdpAlreadyExists, "owner %s", owner)
+	}
+	if err := k.ValidatePrincipalAdd(ctx, principal); err != nil {
+		return err
+	}
+
+	collateralType := collateral[0].CollateralType
+	if err := k.ValidateMultiCdpDebtLimit(ctx, collateralType, principal); err != nil {
+		return err
+	}
+	if err := k.ValidateMultiCdpCollateralizationRatio(ctx, collateral, principal, sdk.NewCoin(principal.Denom, sdk.ZeroInt())); err != nil {
+		return err
+	}
+
+	id := k.GetNextCdpID(ctx)
+	interestFactor, found := k.GetInterestFactor(ctx, collateralType)
+	if !found {
+		interestFactor = sdk.OneDec()
+		k.SetInterestFactor(ctx, collateralType, interestFactor)
+	}
+	cdp := types.NewMultiCDP(id, owner, collateral, collateralType, principal, ctx.BlockHeader().Time, interestFactor)
+
+	// send collateral from the owner's account to the cdp module
+	for _, tc := range collateral {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(tc.Amount)); err != nil {
+			return err
+		}
+	}
+
+	// mint the principal and send to the owners account
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+
+	// mint the corresponding amount of debt coins
+	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	if err != nil {
+		panic(err)
+	}
+
+	k.IncrementTotalPrincipal(ctx, collateralType, principal)
+	k.IncrementMultiCdpPrincipal(ctx, collateralType, principal)
+
+	k.SetMultiCDP(ctx, cdp)
+	k.IndexMultiCdpByOwner(ctx, cdp)
+	k.SetNextCdpID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCdp,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	for _, tc := range collateral {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpDeposit,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tc.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDraw,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, principal.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	return nil
+}
+
+// DepositMultiCdpCollateral adds collateral of the input collateral type to the owner's multi-collateral cdp
+func (k Keeper) DepositMultiCdpCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	cdp, found := k.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", owner)
+	}
+	err = k.ValidateBalance(ctx, collateral, owner)
+	if err != nil {
+		return err
+	}
+	cdp = k.SynchronizeMultiCdpInterest(ctx, cdp)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
+
+	cdp.Collateral = cdp.Collateral.Add(collateralType, collateral)
+	k.SetMultiCDP(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	return nil
+}
+
+// WithdrawMultiCdpCollateral removes collateral of the input collateral type from the owner's multi-collateral cdp
+// if it does not leave the cdp undercollateralized
+func (k Keeper) WithdrawMultiCdpCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	cdp, found := k.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", owner)
+	}
+	held, found := cdp.Collateral.AmountOf(collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "owner %s, collateral %s %s", owner, collateral.Denom, collateralType)
+	}
+	if collateral.Amount.GT(held.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, deposit %s", collateral, held)
+	}
+	cdp = k.SynchronizeMultiCdpInterest(ctx, cdp)
+
+	updatedCollateral := cdp.Collateral.Sub(collateralType, collateral)
+	err = k.ValidateMultiCdpCollateralizationRatio(ctx, updatedCollateral, cdp.Principal, cdp.AccumulatedFees)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(collateral))
+	if err != nil {
+		panic(err)
+	}
+
+	cdp.Collateral = updatedCollateral
+	k.SetMultiCDP(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	return nil
+}
+
+// AddMultiCdpPrincipal draws additional debt from the owner's multi-collateral cdp
+func (k Keeper) AddMultiCdpPrincipal(ctx sdk.Context, owner sdk.AccAddress, principal sdk.Coin) error {
+	cdp, found := k.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", owner)
+	}
+	err := k.ValidatePrincipalDraw(ctx, principal, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateMultiCdpDebtLimit(ctx, cdp.Type, principal)
+	if err != nil {
+		return err
+	}
+	cdp = k.SynchronizeMultiCdpInterest(ctx, cdp)
+
+	err = k.ValidateMultiCdpCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal.Add(principal), cdp.AccumulatedFees)
+	if err != nil {
+		return err
+	}
+
+	// mint the principal and send it to the cdp owner
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+
+	// mint the corresponding amount of debt coins in the cdp module account
+	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDraw,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, principal.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	cdp.Principal = cdp.Principal.Add(principal)
+	k.IncrementTotalPrincipal(ctx, cdp.Type, principal)
+	k.IncrementMultiCdpPrincipal(ctx, cdp.Type, principal)
+	k.SetMultiCDP(ctx, cdp)
+	return nil
+}
+
+// RepayMultiCdpPrincipal removes debt from the owner's multi-collateral cdp.
+// If all debt is repaid, the collateral is returned to the owner and the cdp is removed from the store
+func (k Keeper) RepayMultiCdpPrincipal(ctx sdk.Context, owner sdk.AccAddress, payment sdk.Coin) error {
+	cdp, found := k.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", owner)
+	}
+	debt := cdp.GetTotalPrincipal()
+	if payment.Denom != debt.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidPayment, "cdp %d: expected %s, got %s", cdp.ID, debt.Denom, payment.Denom)
+	}
+	dp, found := k.GetDebtParam(ctx, payment.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidPayment, "payment denom %s not found", payment.Denom)
+	}
+	err := k.ValidateBalance(ctx, payment, owner)
+	if err != nil {
+		return err
+	}
+	cdp = k.SynchronizeMultiCdpInterest(ctx, cdp)
+
+	feePayment, principalPayment := k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, payment)
+
+	proposedBalance := cdp.Principal.Amount.Sub(principalPayment.Amount)
+	if proposedBalance.IsPositive() && proposedBalance.LT(dp.DebtFloor) {
+		return errorsmod.Wrapf(types.ErrBelowDebtFloor, "proposed %s < minimum %s", sdk.NewCoin(payment.Denom, proposedBalance), dp.DebtFloor)
+	}
+
+	totalPayment := feePayment.Add(principalPayment)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(totalPayment))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(totalPayment))
+	if err != nil {
+		panic(err)
+	}
+
+	// burn the corresponding amount of debt coins
+	debtDenom := k.GetDebtDenom(ctx)
+	coinsToBurn := sdk.NewCoin(debtDenom, sdk.MinInt(totalPayment.Amount, k.getModAccountDebt(ctx, types.ModuleName)))
+	err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, coinsToBurn)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpRepay,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalPayment.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	cdp.Principal = cdp.Principal.Sub(principalPayment)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	k.DecrementTotalPrincipal(ctx, cdp.Type, totalPayment)
+	k.DecrementMultiCdpPrincipal(ctx, cdp.Type, totalPayment)
+
+	if cdp.Principal.IsZero() && cdp.AccumulatedFees.IsZero() {
+		for _, tc := range cdp.Collateral {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cdp.Owner, sdk.NewCoins(tc.Amount)); err != nil {
+				panic(err)
+			}
+		}
+		k.RemoveMultiCdpOwnerIndex(ctx, cdp)
+		k.DeleteMultiCDP(ctx, cdp)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpClose,
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+		return nil
+	}
+
+	k.SetMultiCDP(ctx, cdp)
+	return nil
+}
+
+// AttemptKeeperMultiCdpLiquidation liquidates the owner's multi-collateral cdp if its collateral no longer covers its debt.
+// The keeper that sent the transaction is rewarded a percentage of each collateral according to that collateral types'
+// keeper reward percentage.
+func (k Keeper) AttemptKeeperMultiCdpLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress) error {
+	cdp, found := k.GetMultiCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s", owner)
+	}
+	cdp = k.SynchronizeMultiCdpInterest(ctx, cdp)
+
+	err := k.ValidateMultiCdpLiquidation(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	cdp, err = k.payoutMultiCdpKeeperLiquidationReward(ctx, keeper, cdp)
+	if err != nil {
+		return err
+	}
+	return k.SeizeMultiCdpCollateral(ctx, cdp)
+}
+
+// SeizeMultiCdpCollateral liquidates the collateral in the input multi-collateral cdp.
+// The debt is split between the collateral types in proportion to the value of each collateral,
+// and each collateral is auctioned to raise its share of the debt.
+func (k Keeper) SeizeMultiCdpCollateral(ctx sdk.Context, cdp types.MultiCDP) error {
+	// Move debt coins from cdp to liquidator account
+	debt := sdk.MinInt(cdp.GetTotalPrincipal().Amount, k.getModAccountDebt(ctx, types.ModuleName))
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	if err != nil {
+		return err
+	}
+
+	values := make([]sdk.Dec, len(cdp.Collateral))
+	totalValue := sdk.ZeroDec()
+	for i, tc := range cdp.Collateral {
+		value, err := k.calculateCollateralValue(ctx, tc, liquidation)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+		totalValue = totalValue.Add(value)
+	}
+
+	unallocatedDebt := debt
+	for i, tc := range cdp.Collateral {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(tc.Amount)); err != nil {
+			return err
+		}
+
+		// the last collateral covers any debt left over from rounding
+		debtCovered := unallocatedDebt
+		if i < len(cdp.Collateral)-1 && totalValue.IsPositive() {
+			debtCovered = sdk.NewDecFromInt(debt).Mul(values[i]).Quo(totalValue).TruncateInt()
+		}
+		unallocatedDebt = unallocatedDebt.Sub(debtCovered)
+
+		deposits := types.Deposits{types.NewDeposit(cdp.ID, cdp.Owner, tc.Amount)}
+		if err := k.AuctionCollateral(ctx, deposits, tc.CollateralType, debtCovered, cdp.Principal.Denom); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyCollateral, fmt.Sprintf("%s:%s", tc.CollateralType, tc.Amount)),
+			),
+		)
+	}
+
+	k.DecrementTotalPrincipal(ctx, cdp.Type, cdp.GetTotalPrincipal())
+	k.DecrementMultiCdpPrincipal(ctx, cdp.Type, cdp.GetTotalPrincipal())
+
+	k.RemoveMultiCdpOwnerIndex(ctx, cdp)
+	k.DeleteMultiCDP(ctx, cdp)
+	return nil
+}
+
+// LiquidateMultiCdps seizes collateral from multi-collateral cdps whose collateral no longer covers their debt.
+// A cdp can only be undercollateralized if each of its collaterals on its own supports less than the debt, so
+// for each collateral type the candidates are the cdps that index that collateral below the ratio implied by its
+// price and liquidation ratio, up to the collateral type's CheckCollateralizationIndexCount.
+// Cdps holding collateral with a market that is down are skipped. Errors are logged and the cdp is skipped, so
+// one cdp cannot halt the liquidation of the others.
+func (k Keeper) LiquidateMultiCdps(ctx sdk.Context) {
+	checked := make(map[uint64]bool)
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		if !k.GetMarketStatus(ctx, cp.SpotMarketID) || !k.GetMarketStatus(ctx, cp.LiquidationMarketID) {
+			continue
+		}
+
+		// sync the interest of the riskiest cdps so that their index entries reflect their current debt
+		for _, cdp := range k.GetSliceOfMultiCdpsByRatioAndType(ctx, cp.CheckCollateralizationIndexCount, types.MaxSortableDec, cp.Type) {
+			k.SynchronizeMultiCdpInterest(ctx, cdp)
+		}
+
+		price, err := k.getMarketPrice(ctx, cp.LiquidationMarketID, k.getLiquidationTwapWindow(ctx, cp.Type))
+		if err != nil {
+			k.Logger(ctx).Error("skipping multi cdp liquidations", "collateral_type", cp.Type, "error", err)
+			continue
+		}
+		priceDivLiqRatio := price.Quo(cp.LiquidationRatio)
+		if priceDivLiqRatio.IsZero() {
+			priceDivLiqRatio = sdk.SmallestDec()
+		}
+		normalizedRatio := sdk.OneDec().Quo(priceDivLiqRatio)
+
+		for _, cdp := range k.GetSliceOfMultiCdpsByRatioAndType(ctx, cp.CheckCollateralizationIndexCount, normalizedRatio, cp.Type) {
+			if checked[cdp.ID] {
+				continue
+			}
+			checked[cdp.ID] = true
+			if !k.multiCdpMarketsUp(ctx, cdp) {
+				continue
+			}
+
+			err := k.ValidateMultiCdpLiquidation(ctx, cdp)
+			if errors.Is(err, types.ErrNotLiquidatable) {
+				continue
+			}
+			if err != nil {
+				k.Logger(ctx).Error("failed to validate multi cdp liquidation", "cdp_id", cdp.ID, "error", err)
+				continue
+			}
+
+			// seize in a cached context so that a failed liquidation does not leave partial state changes
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.SeizeMultiCdpCollateral(cacheCtx, cdp); err != nil {
+				k.Logger(ctx).Error("failed to liquidate multi cdp", "cdp_id", cdp.ID, "error", err)
+				continue
+			}
+			write()
+		}
+	}
+}
+
+// ValidateMultiCdpDebtLimit validates that the input debt amount does not exceed the multi-collateral cdp debt limit,
+// nor the global debt limit or the debt limit of the input collateral type
+func (k Keeper) ValidateMultiCdpDebtLimit(ctx sdk.Context, collateralType string, principal sdk.Coin) error {
+	if err := k.ValidateDebtLimit(ctx, collateralType, principal); err != nil {
+		return err
+	}
+	limit := k.GetParams(ctx).MultiCdpDebtLimit
+	if limit.Denom == "" {
+		limit = sdk.NewCoin(principal.Denom, sdk.ZeroInt())
+	}
+	totalPrincipal := k.GetMultiCdpTotalPrincipal(ctx).Add(principal.Amount)
+	if totalPrincipal.GT(limit.Amount) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > multi cdp debt limit %s", sdk.NewCoin(principal.Denom, totalPrincipal), limit)
+	}
+	return nil
+}
+
+// ValidateMultiCdpCollateralizationRatio validates that the input collateral covers the input principal and fees at spot prices
+func (k Keeper) ValidateMultiCdpCollateralizationRatio(ctx sdk.Context, collateral types.TypedCollaterals, principal sdk.Coin, fees sdk.Coin) error {
+	collateralValue, capacity, err := k.CalculateMultiCdpCollateralValue(ctx, collateral, spot)
+	if err != nil {
+		return err
+	}
+	debt := k.convertDebtToBaseUnits(ctx, principal.Add(fees))
+	if debt.GT(capacity) {
+		return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "collateral value %s, debt %s, borrow capacity %s", collateralValue, debt, capacity)
+	}
+	return nil
+}
+
+// ValidateMultiCdpLiquidation validates that the cdp's collateral no longer covers its debt at liquidation prices
+func (k Keeper) ValidateMultiCdpLiquidation(ctx sdk.Context, cdp types.MultiCDP) error {
+	collateralValue, capacity, err := k.CalculateMultiCdpCollateralValue(ctx, cdp.Collateral, liquidation)
+	if err != nil {
+		return err
+	}
+	debt := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	if debt.LTE(capacity) {
+		return errorsmod.Wrapf(types.ErrNotLiquidatable, "collateral value %s, debt %s, borrow capacity %s", collateralValue, debt, capacity)
+	}
+	return nil
+}
+
+// CalculateMultiCdpCollateralValue returns the total value of the input collateral and the maximum debt it can support,
+// which is the sum over each collateral of its value divided by the liquidation ratio of its collateral type
+func (k Keeper) CalculateMultiCdpCollateralValue(ctx sdk.Context, collateral types.TypedCollaterals, pfType pricefeedType) (sdk.Dec, sdk.Dec, error) {
+	totalValue := sdk.ZeroDec()
+	capacity := sdk.ZeroDec()
+	for _, tc := range collateral {
+		value, err := k.calculateCollateralValue(ctx, tc, pfType)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		totalValue = totalValue.Add(value)
+		capacity = capacity.Add(value.Quo(k.getLiquidationRatio(ctx, tc.CollateralType)))
+	}
+	return totalValue, capacity, nil
+}
+
+// SynchronizeMultiCdpInterest updates the input multi-collateral cdp to reflect the current accumulated interest,
+// updates the cdp state in the store, and returns the updated cdp object
+func (k Keeper) SynchronizeMultiCdpInterest(ctx sdk.Context, cdp types.MultiCDP) types.MultiCDP {
+	globalInterestFactor, found := k.GetInterestFactor(ctx, cdp.Type)
+	if !found {
+		k.SetInterestFactor(ctx, cdp.Type, sdk.OneDec())
+		cdp.InterestFactor = sdk.OneDec()
+		cdp.FeesUpdated = ctx.BlockTime()
+		k.SetMultiCDP(ctx, cdp)
+		return cdp
+	}
+	prevAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.Type)
+	if !found {
+		return cdp
+	}
+
+	newInterest := k.CalculateNewMultiCdpInterest(ctx, cdp)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(newInterest)
+	cdp.FeesUpdated = prevAccrualTime
+	cdp.InterestFactor = globalInterestFactor
+	k.IncrementMultiCdpPrincipal(ctx, cdp.Type, newInterest)
+	k.SetMultiCDP(ctx, cdp)
+	return cdp
+}
+
+// CalculateNewMultiCdpInterest returns the amount of interest that has accrued to the multi-collateral cdp since its interest was last synchronized
+func (k Keeper) CalculateNewMultiCdpInterest(ctx sdk.Context, cdp types.MultiCDP) sdk.Coin {
+	globalInterestFactor, found := k.GetInterestFactor(ctx, cdp.Type)
+	if !found {
+		return sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.ZeroInt())
+	}
+	cdpInterestFactor := globalInterestFactor.Quo(cdp.InterestFactor)
+	if cdpInterestFactor.Equal(sdk.OneDec()) {
+		return sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.ZeroInt())
+	}
+	totalPrincipal := cdp.GetTotalPrincipal().Amount
+	accumulatedInterest := sdk.NewDecFromInt(totalPrincipal).Mul(cdpInterestFactor).RoundInt().Sub(totalPrincipal)
+	return sdk.NewCoin(cdp.AccumulatedFees.Denom, accumulatedInterest)
+}
+
+// LoadMultiCDPResponse creates a new MultiCDPResponse from an existing multi-collateral cdp
+func (k Keeper) LoadMultiCDPResponse(ctx sdk.Context, cdp types.MultiCDP) types.MultiCDPResponse {
+	// sync the latest interest of the cdp
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(k.CalculateNewMultiCdpInterest(ctx, cdp))
+	prevAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.Type)
+	if found {
+		cdp.FeesUpdated = prevAccrualTime
+	}
+	globalInterestFactor, found := k.GetInterestFactor(ctx, cdp.Type)
+	if found {
+		cdp.InterestFactor = globalInterestFactor
+	}
+
+	collateralValue, capacity, err := k.CalculateMultiCdpCollateralValue(ctx, cdp.Collateral, liquidation)
+	if err != nil || !capacity.IsPositive() {
+		return types.NewMultiCDPResponse(cdp, sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt()), sdk.ZeroDec(), sdk.ZeroDec())
+	}
+	liquidationRatio := collateralValue.Quo(capacity)
+
+	debt := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	if !debt.IsPositive() {
+		return types.NewMultiCDPResponse(cdp, sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt()), sdk.ZeroDec(), liquidationRatio)
+	}
+	collateralizationRatio := collateralValue.Quo(debt)
+
+	// convert collateral value to debt coin
+	collateralValueInDebtDenom := sdk.NewDecFromInt(cdp.GetTotalPrincipal().Amount).Mul(collateralizationRatio)
+	collateralValueInDebt := sdk.NewCoin(cdp.Principal.Denom, collateralValueInDebtDenom.RoundInt())
+	return types.NewMultiCDPResponse(cdp, collateralValueInDebt, collateralizationRatio, liquidationRatio)
+}
+
+// GetMultiCDP returns the multi-collateral cdp with the input id
+func (k Keeper) GetMultiCDP(ctx sdk.Context, id uint64) (types.MultiCDP, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	bz := store.Get(types.GetCdpIDBytes(id))
+	if bz == nil {
+		return types.MultiCDP{}, false
+	}
+	var cdp types.MultiCDP
+	k.cdc.MustUnmarshal(bz, &cdp)
+	return cdp, true
+}
+
+// SetMultiCDP sets a multi-collateral cdp in the store and replaces its entries in the collateral ratio index
+func (k Keeper) SetMultiCDP(ctx sdk.Context, cdp types.MultiCDP) {
+	k.removeOldMultiCdpCollateralRatioIndex(ctx, cdp.ID)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	bz := k.cdc.MustMarshal(&cdp)
+	store.Set(types.GetCdpIDBytes(cdp.ID), bz)
+	k.IndexMultiCdpByCollateralRatio(ctx, cdp)
+}
+
+// DeleteMultiCDP deletes a multi-collateral cdp and its collateral ratio index entries from the store
+func (k Keeper) DeleteMultiCDP(ctx sdk.Context, cdp types.MultiCDP) {
+	k.removeOldMultiCdpCollateralRatioIndex(ctx, cdp.ID)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	store.Delete(types.GetCdpIDBytes(cdp.ID))
+}
+
+// IndexMultiCdpByCollateralRatio indexes the multi-collateral cdp id by each of its collateral types and the ratio of that collateral to the cdp's debt
+func (k Keeper) IndexMultiCdpByCollateralRatio(ctx sdk.Context, cdp types.MultiCDP) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpRatioIndexPrefix)
+	for _, tc := range cdp.Collateral {
+		ratio := k.CalculateCollateralToDebtRatio(ctx, tc.Amount, tc.CollateralType, cdp.GetTotalPrincipal())
+		store.Set(types.CollateralRatioKey(tc.CollateralType, cdp.ID, ratio), types.GetCdpIDBytes(cdp.ID))
+	}
+}
+
+// RemoveMultiCdpCollateralRatioIndex deletes the multi-collateral cdp id from the store's index of cdps by collateral type and collateral to debt ratio
+func (k Keeper) RemoveMultiCdpCollateralRatioIndex(ctx sdk.Context, cdp types.MultiCDP) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpRatioIndexPrefix)
+	for _, tc := range cdp.Collateral {
+		ratio := k.CalculateCollateralToDebtRatio(ctx, tc.Amount, tc.CollateralType, cdp.GetTotalPrincipal())
+		store.Delete(types.CollateralRatioKey(tc.CollateralType, cdp.ID, ratio))
+	}
+}
+
+func (k Keeper) removeOldMultiCdpCollateralRatioIndex(ctx sdk.Context, id uint64) {
+	storedCdp, found := k.GetMultiCDP(ctx, id)
+	if !found {
+		return
+	}
+	k.RemoveMultiCdpCollateralRatioIndex(ctx, storedCdp)
+}
+
+// IterateMultiCdpsByCollateralRatio iterates over multi-collateral cdps holding collateral of the input collateral type
+// with a collateral:debt ratio LESS THAN targetRatio and performs a callback function
+func (k Keeper) IterateMultiCdpsByCollateralRatio(ctx sdk.Context, collateralType string, targetRatio sdk.Dec, cb func(cdp types.MultiCDP) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpRatioIndexPrefix)
+	iterator := store.Iterator(types.CollateralRatioIterKey(collateralType, sdk.ZeroDec()), types.CollateralRatioIterKey(collateralType, targetRatio))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, id, _ := types.SplitCollateralRatioKey(iterator.Key())
+		cdp, found := k.GetMultiCDP(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("multi cdp %d does not exist", id))
+		}
+		if cb(cdp) {
+			break
+		}
+	}
+}
+
+// GetSliceOfMultiCdpsByRatioAndType returns up to cutoffCount multi-collateral cdps holding collateral of the input
+// collateral type below the target ratio, in ascending order of that collateral's collateral:debt ratio
+func (k Keeper) GetSliceOfMultiCdpsByRatioAndType(ctx sdk.Context, cutoffCount sdkmath.Int, targetRatio sdk.Dec, collateralType string) (cdps types.MultiCDPs) {
+	count := sdk.ZeroInt()
+	k.IterateMultiCdpsByCollateralRatio(ctx, collateralType, targetRatio, func(cdp types.MultiCDP) bool {
+		cdps = append(cdps, cdp)
+		count = count.Add(sdk.OneInt())
+		return count.GTE(cutoffCount)
+	})
+	return cdps
+}
+
+// IncrementMultiCdpPrincipal increments the total debt of the multi-collateral cdps charged to a collateral type
+func (k Keeper) IncrementMultiCdpPrincipal(ctx sdk.Context, collateralType string, principal sdk.Coin) {
+	k.SetMultiCdpPrincipal(ctx, collateralType, k.GetMultiCdpPrincipal(ctx, collateralType).Add(principal.Amount))
+}
+
+// DecrementMultiCdpPrincipal decrements the total debt of the multi-collateral cdps charged to a collateral type
+func (k Keeper) DecrementMultiCdpPrincipal(ctx sdk.Context, collateralType string, principal sdk.Coin) {
+	total := k.GetMultiCdpPrincipal(ctx, collateralType)
+	total = sdk.MaxInt(total.Sub(principal.Amount), sdk.ZeroInt())
+	k.SetMultiCdpPrincipal(ctx, collateralType, total)
+}
+
+// GetMultiCdpPrincipal returns the total debt of the multi-collateral cdps charged to a collateral type, including fees
+// as of each cdp's last interest sync
+func (k Keeper) GetMultiCdpPrincipal(ctx sdk.Context, collateralType string) (total sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpPrincipalPrefix)
+	bz := store.Get(types.MultiCdpPrincipalKey(collateralType))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+// SetMultiCdpPrincipal sets the total debt of the multi-collateral cdps charged to a collateral type
+func (k Keeper) SetMultiCdpPrincipal(ctx sdk.Context, collateralType string, total sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpPrincipalPrefix)
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.MultiCdpPrincipalKey(collateralType), bz)
+}
+
+// GetMultiCdpTotalPrincipal returns the total debt of all multi-collateral cdps, including fees as of each cdp's last interest sync
+func (k Keeper) GetMultiCdpTotalPrincipal(ctx sdk.Context) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpPrincipalPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	total := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var principal sdkmath.Int
+		if err := principal.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		total = total.Add(principal)
+	}
+	return total
+}
+
+// GetMultiCdpByOwner returns the multi-collateral cdp controlled by the input owner
+func (k Keeper) GetMultiCdpByOwner(ctx sdk.Context, owner sdk.AccAddress) (types.MultiCDP, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpOwnerKeyPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return types.MultiCDP{}, false
+	}
+	return k.GetMultiCDP(ctx, types.GetCdpIDFromBytes(bz))
+}
+
+// IndexMultiCdpByOwner sets the multi-collateral cdp id in the store, indexed by the owner
+func (k Keeper) IndexMultiCdpByOwner(ctx sdk.Context, cdp types.MultiCDP) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpOwnerKeyPrefix)
+	store.Set(cdp.Owner, types.GetCdpIDBytes(cdp.ID))
+}
+
+// RemoveMultiCdpOwnerIndex deletes the multi-collateral cdp id from the store's index of cdps by owner
+func (k Keeper) RemoveMultiCdpOwnerIndex(ctx sdk.Context, cdp types.MultiCDP) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpOwnerKeyPrefix)
+	store.Delete(cdp.Owner)
+}
+
+// IterateMultiCdps iterates over all multi-collateral cdps and performs a callback function
+func (k Keeper) IterateMultiCdps(ctx sdk.Context, cb func(cdp types.MultiCDP) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var cdp types.MultiCDP
+		k.cdc.MustUnmarshal(iterator.Value(), &cdp)
+		if cb(cdp) {
+			break
+		}
+	}
+}
+
+// GetAllMultiCdps returns all multi-collateral cdps from the store
+func (k Keeper) GetAllMultiCdps(ctx sdk.Context) (cdps types.MultiCDPs) {
+	k.IterateMultiCdps(ctx, func(cdp types.MultiCDP) bool {
+		cdps = append(cdps, cdp)
+		return false
+	})
+	return
+}
+
+func (k Keeper) payoutMultiCdpKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAddress, cdp types.MultiCDP) (types.MultiCDP, error) {
+	rewards := sdk.NewCoins()
+	var typedRewards types.TypedCollaterals
+	for _, tc := range cdp.Collateral {
+		collateralParam, found := k.GetCollateral(ctx, tc.CollateralType)
+		if !found {
+			return types.MultiCDP{}, errorsmod.Wrapf(types.ErrInvalidCollateral, "%s", tc.CollateralType)
+		}
+		reward := sdk.NewDecFromInt(tc.Amount.Amount).Mul(collateralParam.KeeperRewardPercentage).RoundInt()
+		if !reward.IsPositive() || reward.GTE(tc.Amount.Amount) {
+			continue
+		}
+		rewardCoin := sdk.NewCoin(tc.Amount.Denom, reward)
+		cdp.Collateral = cdp.Collateral.Sub(tc.CollateralType, rewardCoin)
+		rewards = rewards.Add(rewardCoin)
+		typedRewards = append(typedRewards, types.NewTypedCollateral(tc.CollateralType, rewardCoin))
+	}
+	if rewards.IsZero() {
+		return cdp, nil
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper, rewards)
+	if err != nil {
+		return types.MultiCDP{}, err
+	}
+	for _, reward := range typedRewards {
+		k.recordKeeperReward(ctx, reward.CollateralType, reward.Amount)
+	}
+	k.SetMultiCDP(ctx, cdp)
+	return cdp, nil
+}
+
+// calculateCollateralValue returns the value of the input collateral in base units of the debt asset
+func (k Keeper) calculateCollateralValue(ctx sdk.Context, collateral types.TypedCollateral, pfType pricefeedType) (sdk.Dec, error) {
+	var marketID string
+	var twapWindow time.Duration
+	switch pfType {
+	case spot:
+		marketID = k.getSpotMarketID(ctx, collateral.CollateralType)
+	case liquidation:
+		marketID = k.getliquidationMarketID(ctx, collateral.CollateralType)
+		twapWindow = k.getLiquidationTwapWindow(ctx, collateral.CollateralType)
+	default:
+		return sdk.Dec{}, pfType.IsValid()
+	}
+
+	price, err := k.getMarketPrice(ctx, marketID, twapWindow)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.convertCollateralToBaseUnits(ctx, collateral.Amount, collateral.CollateralType).Mul(price), nil
+}
+
+func (k Keeper) multiCdpMarketsUp(ctx sdk.Context, cdp types.MultiCDP) bool {
+	for _, tc := range cdp.Collateral {
+		cp, found := k.GetCollateral(ctx, tc.CollateralType)
+		if !found {
+			return false
+		}
+		if !k.GetMarketStatus(ctx, cp.SpotMarketID) || !k.GetMarketStatus(ctx, cp.LiquidationMarketID) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/percosis-labs/fury/app"
+	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
+	"github.com/percosis-labs/fury/x/cdp/keeper"
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+type MultiCdpTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *MultiCdpTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	coins := cs(c("btc", 100000000), c("xrp", 10000000000), c("usdf", 10000000000))
+
+	authGS := app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(tApp.AppCodec()),
+		NewCDPGenStateMulti(tApp.AppCodec()),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+// createMultiCdp opens a cdp backed by $250 of xrp (liquidation ratio 2.0) and $80 of btc (liquidation ratio 1.5),
+// which supports up to ~178.33 usdf of debt
+func (suite *MultiCdpTestSuite) createMultiCdp(owner sdk.AccAddress) {
+	collateral := types.TypedCollaterals{
+		types.NewTypedCollateral("xrp-a", c("xrp", 1000000000)),
+		types.NewTypedCollateral("btc-a", c("btc", 1000000)),
+	}
+	err := suite.keeper.AddMultiCdp(suite.ctx, owner, collateral, c("usdf", 150000000))
+	suite.Require().NoError(err)
+}
+
+func (suite *MultiCdpTestSuite) setPrice(price sdk.Dec, market string) {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.Require().NoError(err)
+	err = pfKeeper.SetCurrentPrices(suite.ctx, market)
+	suite.Require().NoError(err)
+}
+
+func (suite *MultiCdpTestSuite) TestAddMultiCdp() {
+	// xrp alone only supports 125 usdf of debt
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 1000000000), c("usdf", 150000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+
+	suite.createMultiCdp(suite.addrs[0])
+
+	cdp, found := suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(uint64(types.DefaultCdpStartingID), cdp.ID)
+	suite.Equal("xrp-a", cdp.Type)
+	suite.Equal(c("usdf", 150000000), cdp.Principal)
+	suite.Equal(i(150000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdf"))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(cs(c("btc", 1000000), c("xrp", 1000000000), c("debt", 150000000)), bk.GetAllBalances(suite.ctx, acc.GetAddress()))
+
+	err = suite.keeper.AddMultiCdp(suite.ctx, suite.addrs[0], types.TypedCollaterals{types.NewTypedCollateral("xrp-a", c("xrp", 1000000000))}, c("usdf", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrMultiCdpAlreadyExists))
+
+	collateral := types.TypedCollaterals{
+		types.NewTypedCollateral("xrp-a", c("xrp", 1000000000)),
+		types.NewTypedCollateral("btc-a", c("btc", 1000000)),
+	}
+	err = suite.keeper.AddMultiCdp(suite.ctx, suite.addrs[1], collateral, c("usdf", 180000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+
+	collateral = types.TypedCollaterals{types.NewTypedCollateral("btc-a", c("xrp", 1000000))}
+	err = suite.keeper.AddMultiCdp(suite.ctx, suite.addrs[1], collateral, c("usdf", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateral))
+}
+
+func (suite *MultiCdpTestSuite) TestDepositWithdrawMultiCdp() {
+	suite.createMultiCdp(suite.addrs[0])
+
+	err := suite.keeper.WithdrawMultiCdpCollateral(suite.ctx, suite.addrs[0], c("btc", 1000000), "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+	err = suite.keeper.WithdrawMultiCdpCollateral(suite.ctx, suite.addrs[0], c("btc", 2000000), "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+	err = suite.keeper.WithdrawMultiCdpCollateral(suite.ctx, suite.addrs[0], c("bnb", 1000000), "bnb-a")
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+
+	err = suite.keeper.DepositMultiCdpCollateral(suite.ctx, suite.addrs[0], c("xrp", 400000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.WithdrawMultiCdpCollateral(suite.ctx, suite.addrs[0], c("btc", 1000000), "btc-a")
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(types.TypedCollaterals{types.NewTypedCollateral("xrp-a", c("xrp", 1400000000))}, cdp.Collateral)
+
+	err = suite.keeper.DepositMultiCdpCollateral(suite.ctx, suite.addrs[1], c("xrp", 400000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+}
+
+func (suite *MultiCdpTestSuite) TestDrawRepayMultiCdp() {
+	suite.createMultiCdp(suite.addrs[0])
+
+	err := suite.keeper.AddMultiCdpPrincipal(suite.ctx, suite.addrs[0], c("usdf", 20000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.AddMultiCdpPrincipal(suite.ctx, suite.addrs[0], c("usdf", 20000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+	suite.Equal(i(170000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdf"))
+
+	err = suite.keeper.RepayMultiCdpPrincipal(suite.ctx, suite.addrs[0], c("usdf", 165000000))
+	suite.Require().True(errors.Is(err, types.ErrBelowDebtFloor))
+	err = suite.keeper.RepayMultiCdpPrincipal(suite.ctx, suite.addrs[0], c("usdf", 170000000))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdf"))
+
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(sdk.Coins{}, bk.GetAllBalances(suite.ctx, acc.GetAddress()))
+	suite.Equal(cs(c("btc", 100000000), c("xrp", 10000000000), c("usdf", 10000000000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+}
+
+func (suite *MultiCdpTestSuite) TestKeeperMultiCdpLiquidation() {
+	suite.createMultiCdp(suite.addrs[0])
+
+	err := suite.keeper.AttemptKeeperMultiCdpLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0])
+	suite.Require().True(errors.Is(err, types.ErrNotLiquidatable))
+
+	// btc now supports ~13.33 usdf, leaving the cdp undercollateralized
+	suite.setPrice(d("2000.00"), "btc:usd:30")
+
+	bk := suite.app.GetBankKeeper()
+	keeperBalance := bk.GetAllBalances(suite.ctx, suite.addrs[1])
+	err = suite.keeper.AttemptKeeperMultiCdpLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0])
+	suite.Require().NoError(err)
+
+	reward := cs(c("btc", 10000), c("xrp", 10000000))
+	suite.Equal(keeperBalance.Add(reward...), bk.GetAllBalances(suite.ctx, suite.addrs[1]))
+
+	_, found := suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdf"))
+
+	ak := suite.app.GetAccountKeeper()
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("btc", 990000), c("xrp", 990000000), c("debt", 150000000)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Len(auctions, 2)
+}
+
+func (suite *MultiCdpTestSuite) TestMultiCdpDebtLimit() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MultiCdpDebtLimit = c("usdf", 200000000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.createMultiCdp(suite.addrs[0])
+	err := suite.keeper.AddMultiCdpPrincipal(suite.ctx, suite.addrs[0], c("usdf", 20000000))
+	suite.Require().NoError(err)
+	suite.Equal(i(170000000), suite.keeper.GetMultiCdpTotalPrincipal(suite.ctx))
+
+	// listing btc first charges the debt to btc-a, but the multi cdp debt limit still applies
+	collateral := types.TypedCollaterals{
+		types.NewTypedCollateral("btc-a", c("btc", 1000000)),
+		types.NewTypedCollateral("xrp-a", c("xrp", 1000000000)),
+	}
+	err = suite.keeper.AddMultiCdp(suite.ctx, suite.addrs[1], collateral, c("usdf", 50000000))
+	suite.Require().True(errors.Is(err, types.ErrExceedsDebtLimit))
+
+	err = suite.keeper.RepayMultiCdpPrincipal(suite.ctx, suite.addrs[0], c("usdf", 170000000))
+	suite.Require().NoError(err)
+	suite.Equal(i(0), suite.keeper.GetMultiCdpTotalPrincipal(suite.ctx))
+	err = suite.keeper.AddMultiCdp(suite.ctx, suite.addrs[1], collateral, c("usdf", 50000000))
+	suite.Require().NoError(err)
+	suite.Equal(i(50000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+	suite.Equal(i(50000000), suite.keeper.GetMultiCdpPrincipal(suite.ctx, "btc-a"))
+	suite.Equal(i(0), suite.keeper.GetMultiCdpPrincipal(suite.ctx, "xrp-a"))
+	suite.Equal(i(50000000), suite.keeper.GetMultiCdpTotalPrincipal(suite.ctx))
+
+	// an unset limit disables multi-collateral cdps
+	params.MultiCdpDebtLimit = sdk.Coin{}
+	suite.keeper.SetParams(suite.ctx, params)
+	err = suite.keeper.AddMultiCdpPrincipal(suite.ctx, suite.addrs[1], c("usdf", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrExceedsDebtLimit))
+}
+
+func (suite *MultiCdpTestSuite) TestLiquidateMultiCdps() {
+	suite.createMultiCdp(suite.addrs[0])
+	suite.createMultiCdp(suite.addrs[1])
+
+	suite.keeper.LiquidateMultiCdps(suite.ctx)
+	suite.Len(suite.keeper.GetAllMultiCdps(suite.ctx), 2)
+
+	// each collateral of a cdp is indexed under its own collateral type
+	suite.Len(suite.keeper.GetSliceOfMultiCdpsByRatioAndType(suite.ctx, i(10), types.MaxSortableDec, "xrp-a"), 2)
+	suite.Len(suite.keeper.GetSliceOfMultiCdpsByRatioAndType(suite.ctx, i(10), types.MaxSortableDec, "btc-a"), 2)
+
+	suite.setPrice(d("0.15"), "xrp:usd:30")
+	suite.keeper.LiquidateMultiCdps(suite.ctx)
+	suite.Len(suite.keeper.GetAllMultiCdps(suite.ctx), 0)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdf"))
+	suite.Equal(i(0), suite.keeper.GetMultiCdpTotalPrincipal(suite.ctx))
+	suite.Len(suite.keeper.GetSliceOfMultiCdpsByRatioAndType(suite.ctx, i(10), types.MaxSortableDec, "xrp-a"), 0)
+	suite.Len(suite.keeper.GetSliceOfMultiCdpsByRatioAndType(suite.ctx, i(10), types.MaxSortableDec, "btc-a"), 0)
+}
+
+func (suite *MultiCdpTestSuite) TestLiquidateMultiCdps_SecondCollateral() {
+	suite.createMultiCdp(suite.addrs[0])
+
+	// $500 of xrp supports 250 usdf on its own
+	collateral := types.TypedCollaterals{
+		types.NewTypedCollateral("xrp-a", c("xrp", 2000000000)),
+		types.NewTypedCollateral("btc-a", c("btc", 1000000)),
+	}
+	err := suite.keeper.AddMultiCdp(suite.ctx, suite.addrs[1], collateral, c("usdf", 150000000))
+	suite.Require().NoError(err)
+
+	// only the cdp relying on its btc is undercollateralized
+	suite.setPrice(d("2000.00"), "btc:usd:30")
+	suite.keeper.LiquidateMultiCdps(suite.ctx)
+
+	_, found := suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	_, found = suite.keeper.GetMultiCdpByOwner(suite.ctx, suite.addrs[1])
+	suite.True(found)
+	suite.Equal(i(150000000), suite.keeper.GetMultiCdpTotalPrincipal(suite.ctx))
+}
+
+func TestMultiCdpTestSuite(t *testing.T) {
+	suite.Run(t, new(MultiCdpTestSuite))
+}
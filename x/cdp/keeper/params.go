@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -67,6 +68,14 @@ func (k Keeper) getliquidationMarketID(ctx sdk.Context, collateralType string) s
 	return cp.LiquidationMarketID
 }
 
+func (k Keeper) getLiquidationTwapWindow(ctx sdk.Context, collateralType string) time.Duration {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", collateralType))
+	}
+	return cp.LiquidationTwapWindow
+}
+
 func (k Keeper) getLiquidationRatio(ctx sdk.Context, collateralType string) sdk.Dec {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
@@ -97,5 +106,19 @@ func (k Keeper) getFeeRate(ctx sdk.Context, collateralType string) (fee sdk.Dec)
 	if !found {
 		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralType))
 	}
-	return collalateralParam.StabilityFee
+	return k.adjustFeeRateForPeg(ctx, collalateralParam.StabilityFee)
+}
+
+// adjustFeeRateForPeg returns the fee rate adjusted by the peg controller for the current price of the debt asset.
+// The fee rate is returned unchanged if the controller is disabled or the debt asset has no valid price.
+func (k Keeper) adjustFeeRateForPeg(ctx sdk.Context, fee sdk.Dec) sdk.Dec {
+	pc := k.GetParams(ctx).PegController
+	if !pc.Enabled() {
+		return fee
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, pc.MarketID)
+	if err != nil {
+		return fee
+	}
+	return pc.AdjustStabilityFee(fee, price.Price)
 }
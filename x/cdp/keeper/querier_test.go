@@ -259,7 +259,7 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 
 	cdpGS := NewCDPGenStateHighDebtLimit(suite.app.AppCodec())
 	gs := types.GenesisState{}
-	err = suite.legacyAmino.UnmarshalJSON(cdpGS["cdp"], &gs)
+	err = suite.app.AppCodec().UnmarshalJSON(cdpGS["cdp"], &gs)
 	suite.NoError(err)
 
 	suite.Equal(gs.Params, p)
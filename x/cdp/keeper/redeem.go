@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+// RedeemDebt burns stable asset from the redeemer in exchange for an equal value of collateral at the spot price.
+// The collateral is taken from the lowest collateralized cdps of the input collateral type, skipping cdps below the
+// liquidation ratio. Each redeemed cdp's fees and then principal are reduced by the redeemed amount, and the cdp keeps
+// the redemption fee share of the redeemed collateral. A cdp whose debt is fully redeemed is closed, returning its
+// remaining collateral to depositors.
+// Returns the stable asset redeemed, which is less than amount if there is not enough debt to redeem against,
+// and the collateral sent to the redeemer.
+func (k Keeper) RedeemDebt(ctx sdk.Context, redeemer sdk.AccAddress, collateralType string, amount sdk.Coin) (sdk.Coin, sdk.Coin, error) {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrCollateralNotSupported, "%s", collateralType)
+	}
+	dp, found := k.GetDebtParam(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrDebtNotSupported, "%s", amount.Denom)
+	}
+	if !k.GetMarketStatus(ctx, cp.SpotMarketID) {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrPricefeedDown, cp.Denom)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrPricefeedDown, cp.Denom)
+	}
+	err = k.ValidateBalance(ctx, amount, redeemer)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// collect the lowest collateralized cdps until they hold enough redeemable debt to cover the amount,
+	// cdps are updated after iterating as the index can't be modified while it is iterated over
+	var cdps types.CDPs
+	redeemable := sdk.ZeroInt()
+	k.IterateCdpsByCollateralRatio(ctx, collateralType, sdk.MaxSortableDec, func(cdp types.CDP) bool {
+		if cdp.Principal.Denom != amount.Denom {
+			return false
+		}
+		ratio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, spot)
+		if err != nil || ratio.LT(cp.LiquidationRatio) {
+			return false
+		}
+		cdps = append(cdps, cdp)
+		redeemable = redeemable.Add(calculateRedeemableDebt(cdp, amount.Amount.Sub(redeemable), dp.DebtFloor))
+		return redeemable.GTE(amount.Amount)
+	})
+
+	debtConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())
+	collateralConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())
+	redemptionFee := cp.GetRedemptionFee()
+
+	redeemed := sdk.ZeroInt()
+	collateralOut := sdk.NewCoin(cp.Denom, sdk.ZeroInt())
+	for _, cdp := range cdps {
+		remaining := amount.Amount.Sub(redeemed)
+		if !remaining.IsPositive() {
+			break
+		}
+		k.hooks.BeforeCDPModified(ctx, cdp)
+		cdp = k.SynchronizeInterest(ctx, cdp)
+
+		debt := calculateRedeemableDebt(cdp, remaining, dp.DebtFloor)
+		collateralAmount := sdk.NewDecFromInt(debt).Mul(debtConversion).Quo(price.Price).Quo(collateralConversion).TruncateInt()
+		if !debt.IsPositive() || !collateralAmount.IsPositive() || collateralAmount.GT(cdp.Collateral.Amount) {
+			continue
+		}
+		fee := sdk.NewDecFromInt(collateralAmount).Mul(redemptionFee).Ceil().TruncateInt()
+		collateral := sdk.NewCoin(cdp.Collateral.Denom, collateralAmount.Sub(fee))
+
+		k.takeCollateralFromDeposits(ctx, cdp, collateral)
+
+		// fees are repaid before principal
+		repaid := sdk.NewCoin(cdp.Principal.Denom, debt)
+		feePayment := sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.MinInt(debt, cdp.AccumulatedFees.Amount))
+		cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+		cdp.Principal = cdp.Principal.Sub(repaid.Sub(feePayment))
+		cdp.Collateral = cdp.Collateral.Sub(collateral)
+
+		k.DecrementTotalPrincipal(ctx, cdp.Type, repaid)
+		redeemed = redeemed.Add(debt)
+		collateralOut = collateralOut.Add(collateral)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpRedemption,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(sdk.AttributeKeySender, redeemer.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, repaid.String()),
+				sdk.NewAttribute(types.AttributeKeyCollateral, collateral.String()),
+				sdk.NewAttribute(types.AttributeKeyFee, sdk.NewCoin(cdp.Collateral.Denom, fee).String()),
+			),
+		)
+
+		if cdp.Principal.IsZero() && cdp.AccumulatedFees.IsZero() {
+			k.ReturnCollateral(ctx, cdp)
+			k.RemoveCdpOwnerIndex(ctx, cdp)
+			if err := k.DeleteCdpAndCollateralRatioIndex(ctx, cdp); err != nil {
+				return sdk.Coin{}, sdk.Coin{}, err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCdpClose,
+					sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				),
+			)
+			continue
+		}
+
+		collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+		if err := k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	if redeemed.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrNoRedeemableDebt, "collateral type %s", collateralType)
+	}
+
+	// burn the redeemed stable asset and the corresponding debt coins
+	redeemedCoin := sdk.NewCoin(amount.Denom, redeemed)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(redeemedCoin))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(redeemedCoin))
+	if err != nil {
+		panic(err)
+	}
+	debtDenom := k.GetDebtDenom(ctx)
+	err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, sdk.NewCoin(debtDenom, sdk.MinInt(redeemed, k.getModAccountDebt(ctx, types.ModuleName))))
+	if err != nil {
+		panic(err)
+	}
+
+	if collateralOut.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, sdk.NewCoins(collateralOut))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	return redeemedCoin, collateralOut, nil
+}
+
+// calculateRedeemableDebt returns the debt of the cdp that can be redeemed, up to the input amount.
+// Fees are redeemed before principal, and a partial redemption can't leave the cdp's principal below the debt floor.
+func calculateRedeemableDebt(cdp types.CDP, amount, debtFloor sdkmath.Int) sdkmath.Int {
+	totalDebt := cdp.GetTotalPrincipal().Amount
+	if amount.GTE(totalDebt) {
+		return totalDebt
+	}
+	maxPartial := sdkmath.MaxInt(cdp.AccumulatedFees.Amount, totalDebt.Sub(debtFloor))
+	return sdkmath.MaxInt(sdkmath.MinInt(amount, maxPartial), sdk.ZeroInt())
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/cdp/keeper"
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+type RedeemTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *RedeemTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	coins := []sdk.Coins{
+		cs(c("btc", 100000000)),
+		cs(c("btc", 100000000)),
+		cs(c("usdf", 10000000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	keeper := tApp.GetCDPKeeper()
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "btc-a" {
+			params.CollateralParams[i].RedemptionFee = d("0.005")
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// collateral ratio of 4
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("btc", 100000000), c("usdf", 2000000000), "btc-a")
+	suite.Require().NoError(err)
+	// collateral ratio of 2
+	err = suite.keeper.AddCdp(suite.ctx, addrs[1], c("btc", 100000000), c("usdf", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *RedeemTestSuite) TestRedeemDebt() {
+	redeemed, collateral, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "btc-a", c("usdf", 1000000000))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdf", 1000000000), redeemed)
+	// 1000 usdf buys 0.125 btc, less the 0.5% redemption fee
+	suite.Equal(c("btc", 12437500), collateral)
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 12437500), c("usdf", 9000000000)), bk.GetAllBalances(suite.ctx, suite.addrs[2]))
+
+	// the lowest collateralized cdp is redeemed
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 3000000000), cdp.Principal)
+	suite.Equal(c("btc", 87562500), cdp.Collateral)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Equal(c("btc", 87562500), deposit.Amount)
+
+	cdp, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 2000000000), cdp.Principal)
+	suite.Equal(c("btc", 100000000), cdp.Collateral)
+
+	suite.Equal(i(5000000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+	suite.Equal(i(5000000000), bk.GetSupply(suite.ctx, "debt").Amount)
+}
+
+func (suite *RedeemTestSuite) TestRedeemDebtMultipleCdps() {
+	redeemed, collateral, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "btc-a", c("usdf", 5000000000))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdf", 5000000000), redeemed)
+	suite.Equal(c("btc", 62187500), collateral)
+
+	// the fully redeemed cdp is closed and its remaining collateral returned
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "btc-a")
+	suite.False(found)
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 50250000), c("usdf", 4000000000)), bk.GetAllBalances(suite.ctx, suite.addrs[1]))
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 1000000000), cdp.Principal)
+	suite.Equal(c("btc", 87562500), cdp.Collateral)
+
+	suite.Equal(i(1000000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+}
+
+func (suite *RedeemTestSuite) TestRedeemDebtDebtFloor() {
+	redeemed, _, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "btc-a", c("usdf", 3995000000))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdf", 3995000000), redeemed)
+
+	// the partially redeemed cdp is left at the debt floor
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 10000000), cdp.Principal)
+
+	cdp, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 1995000000), cdp.Principal)
+}
+
+func (suite *RedeemTestSuite) TestRedeemDebtSkipsLiquidatableCdps() {
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", d("3000"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "btc:usd"))
+
+	_, _, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "btc-a", c("usdf", 1000000000))
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 4000000000), cdp.Principal)
+
+	cdp, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdf", 1000000000), cdp.Principal)
+}
+
+func (suite *RedeemTestSuite) TestRedeemDebtInvalid() {
+	_, _, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "xrp-a", c("usdf", 1000000000))
+	suite.Require().True(errors.Is(err, types.ErrNoRedeemableDebt))
+
+	_, _, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "lol-a", c("usdf", 1000000000))
+	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
+
+	_, _, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[2], "btc-a", c("usdf", 20000000000))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientBalance))
+}
+
+func TestRedeemTestSuite(t *testing.T) {
+	suite.Run(t, new(RedeemTestSuite))
+}
@@ -25,6 +25,13 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 	if err != nil {
 		return err
 	}
+	debt, collateral, ok, err := k.CalculatePartialLiquidation(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return k.SeizePartialCollateral(ctx, cdp, keeper, debt, collateral)
+	}
 	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp)
 	if err != nil {
 		return err
@@ -89,11 +96,11 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec, count sdkmath.Int) error {
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getMarketPrice(ctx, marketID, k.getLiquidationTwapWindow(ctx, collateralType))
 	if err != nil {
 		return err
 	}
-	priceDivLiqRatio := price.Price.Quo(liquidationRatio)
+	priceDivLiqRatio := price.Quo(liquidationRatio)
 	if priceDivLiqRatio.IsZero() {
 		priceDivLiqRatio = sdk.SmallestDec()
 	}
@@ -104,7 +111,15 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	cdpsToLiquidate := k.GetSliceOfCDPsByRatioAndType(ctx, count, normalizedRatio, collateralType)
 	for _, c := range cdpsToLiquidate {
 		k.hooks.BeforeCDPModified(ctx, c)
-		err := k.SeizeCollateral(ctx, c)
+		debt, collateral, ok, err := k.CalculatePartialLiquidation(ctx, c)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = k.SeizePartialCollateral(ctx, c, nil, debt, collateral)
+		} else {
+			err = k.SeizeCollateral(ctx, c)
+		}
 		if err != nil {
 			return err
 		}
@@ -112,6 +127,164 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	return nil
 }
 
+// CalculatePartialLiquidation returns the debt to repay and the collateral to seize in order to restore the cdp to the
+// liquidation target ratio of its collateral type, with the debt capped by the close factor.
+// The seized collateral is worth the repaid debt plus the liquidation penalty at the liquidation price.
+// ok is false if partial liquidation is disabled for the collateral type, or if the cdp should be liquidated in full
+// because the partial liquidation would consume all of its collateral or leave its debt below the debt floor.
+func (k Keeper) CalculatePartialLiquidation(ctx sdk.Context, cdp types.CDP) (debt sdkmath.Int, collateral sdk.Coin, ok bool, err error) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return sdkmath.Int{}, sdk.Coin{}, false, errorsmod.Wrapf(types.ErrCollateralNotSupported, "%s", cdp.Type)
+	}
+	if !cp.PartialLiquidationEnabled() {
+		return sdkmath.Int{}, sdk.Coin{}, false, nil
+	}
+	// a penalised repayment reduces the ratio when ratio <= 1 + penalty, so the cdp cannot be restored
+	denominator := cp.LiquidationTargetRatio.Sub(sdk.OneDec()).Sub(cp.LiquidationPenalty)
+	if !denominator.IsPositive() {
+		return sdkmath.Int{}, sdk.Coin{}, false, nil
+	}
+
+	price, err := k.getMarketPrice(ctx, cp.LiquidationMarketID, k.getLiquidationTwapWindow(ctx, cdp.Type))
+	if err != nil {
+		return sdkmath.Int{}, sdk.Coin{}, false, err
+	}
+	dp, _ := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	debtConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())
+	collateralConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())
+
+	totalDebt := cdp.GetTotalPrincipal()
+	collateralValue := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, cdp.Type).Mul(price)
+	debtValue := k.convertDebtToBaseUnits(ctx, totalDebt)
+
+	// (collateralValue - repaid * (1 + penalty)) / (debtValue - repaid) = targetRatio
+	repayValue := cp.LiquidationTargetRatio.Mul(debtValue).Sub(collateralValue).Quo(denominator)
+	repayValue = sdk.MinDec(repayValue, cp.CloseFactor.Mul(debtValue))
+	if !repayValue.IsPositive() {
+		return sdkmath.Int{}, sdk.Coin{}, false, nil
+	}
+	debt = repayValue.Quo(debtConversion).Ceil().TruncateInt()
+
+	seizeValue := sdk.NewDecFromInt(debt).Mul(debtConversion).Mul(sdk.OneDec().Add(cp.LiquidationPenalty))
+	collateralAmount := seizeValue.Quo(price).Quo(collateralConversion).Ceil().TruncateInt()
+
+	if debt.GTE(totalDebt.Amount) || collateralAmount.GTE(cdp.Collateral.Amount) {
+		return sdkmath.Int{}, sdk.Coin{}, false, nil
+	}
+	// fees are repaid before principal
+	remainingPrincipal := cdp.Principal.Amount.Sub(sdkmath.MaxInt(debt.Sub(cdp.AccumulatedFees.Amount), sdk.ZeroInt()))
+	if remainingPrincipal.LT(dp.DebtFloor) {
+		return sdkmath.Int{}, sdk.Coin{}, false, nil
+	}
+	return debt, sdk.NewCoin(cdp.Collateral.Denom, collateralAmount), true, nil
+}
+
+// SeizePartialCollateral liquidates part of the input cdp, leaving the remainder open under the same cdp id.
+// the following operations are performed:
+// 1. The seized collateral is taken from the cdp's deposits in proportion to their size
+// 2. If a keeper is provided, it is paid the keeper reward percentage of the seized collateral
+// 3. The remaining seized collateral and the repaid debt coins are sent to the liquidator module account and auctioned
+// 4. The cdp's fees and then principal are reduced by the repaid debt, as is the total principal for that collateral type
+func (k Keeper) SeizePartialCollateral(ctx sdk.Context, cdp types.CDP, keeper sdk.AccAddress, debt sdkmath.Int, collateral sdk.Coin) error {
+	collateralParam, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
+	}
+
+	seized := k.takeCollateralFromDeposits(ctx, cdp, collateral)
+
+	// pay the keeper reward out of the seized collateral
+	if !keeper.Empty() {
+		rewards := sdk.NewCoins()
+		for i, dep := range seized {
+			reward := sdk.NewDecFromInt(dep.Amount.Amount).Mul(collateralParam.KeeperRewardPercentage).RoundInt()
+			if !reward.IsPositive() || reward.GTE(dep.Amount.Amount) {
+				continue
+			}
+			rewardCoin := sdk.NewCoin(dep.Amount.Denom, reward)
+			seized[i].Amount = dep.Amount.Sub(rewardCoin)
+			rewards = rewards.Add(rewardCoin)
+		}
+		if !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper, rewards); err != nil {
+				return err
+			}
+			k.recordKeeperReward(ctx, cdp.Type, sdk.NewCoin(cdp.Collateral.Denom, rewards.AmountOf(cdp.Collateral.Denom)))
+		}
+	}
+
+	// Move debt coins from cdp to liquidator account
+	debtToMove := sdk.MinInt(debt, k.getModAccountDebt(ctx, types.ModuleName))
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debtToMove)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	if err != nil {
+		return err
+	}
+
+	for _, dep := range seized {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(dep.Amount)); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, dep.String()),
+			),
+		)
+	}
+
+	err = k.AuctionCollateral(ctx, seized, cdp.Type, debtToMove, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+
+	// fees are repaid before principal
+	repaid := sdk.NewCoin(cdp.Principal.Denom, debt)
+	feePayment := sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.MinInt(debt, cdp.AccumulatedFees.Amount))
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	cdp.Principal = cdp.Principal.Sub(repaid.Sub(feePayment))
+	cdp.Collateral = cdp.Collateral.Sub(collateral)
+
+	k.DecrementTotalPrincipal(ctx, cdp.Type, repaid)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// takeCollateralFromDeposits removes the input collateral from the cdp's deposits in proportion to their size, with the
+// last deposit covering any remainder, and returns the amounts taken from each depositor.
+// The collateral stays in the cdp module account, and the cdp itself is not updated.
+func (k Keeper) takeCollateralFromDeposits(ctx sdk.Context, cdp types.CDP, collateral sdk.Coin) types.Deposits {
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	var taken types.Deposits
+	unallocated := collateral.Amount
+	for i, dep := range deposits {
+		amount := sdk.NewDecFromInt(dep.Amount.Amount).Quo(sdk.NewDecFromInt(cdp.Collateral.Amount)).MulInt(collateral.Amount).TruncateInt()
+		if i == len(deposits)-1 {
+			amount = unallocated
+		}
+		amount = sdk.MinInt(amount, dep.Amount.Amount)
+		unallocated = unallocated.Sub(amount)
+		if amount.IsZero() {
+			continue
+		}
+		takenCoin := sdk.NewCoin(dep.Amount.Denom, amount)
+
+		dep.Amount = dep.Amount.Sub(takenCoin)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+		taken = append(taken, types.NewDeposit(cdp.ID, dep.Depositor, takenCoin))
+	}
+	return taken
+}
+
 // ApplyLiquidationPenalty multiplies the input debt amount by the liquidation penalty
 func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, collateralType string, debt sdkmath.Int) sdkmath.Int {
 	penalty := k.getLiquidationPenalty(ctx, collateralType)
@@ -160,6 +333,7 @@ func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAdd
 	if err != nil {
 		return types.CDP{}, err
 	}
+	k.recordKeeperReward(ctx, cdp.Type, rewardCoin)
 	cdp.Collateral = cdp.Collateral.Sub(rewardCoin)
 	ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 	err = k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, ratio)
@@ -22,6 +22,7 @@ import (
 	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
 	"github.com/percosis-labs/fury/x/cdp/keeper"
 	"github.com/percosis-labs/fury/x/cdp/types"
+	pricefeedtypes "github.com/percosis-labs/fury/x/pricefeed/types"
 )
 
 type SeizeTestSuite struct {
@@ -571,6 +572,170 @@ func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 	}
 }
 
+func (suite *SeizeTestSuite) TestKeeperPartialLiquidation() {
+	type args struct {
+		closeFactor         sdk.Dec
+		targetRatio         sdk.Dec
+		finalTwapPrice      sdk.Dec
+		principal           sdk.Coin
+		expectedPrincipal   sdk.Coin // zero if the cdp is liquidated in full
+		expectedCollateral  sdk.Coin
+		expectedKeeperCoins sdk.Coins
+	}
+	type test struct {
+		name string
+		args args
+	}
+
+	testCases := []test{
+		{
+			"partial liquidation capped by close factor",
+			args{
+				closeFactor:         d("0.5"),
+				targetRatio:         d("2.0"),
+				finalTwapPrice:      d("7000.00"),
+				principal:           c("usdf", 5000000000),
+				expectedPrincipal:   c("usdf", 2500000000),
+				expectedCollateral:  c("btc", 63392857),
+				expectedKeeperCoins: cs(c("btc", 366071)),
+			},
+		},
+		{
+			"partial liquidation restores target ratio",
+			args{
+				closeFactor:         d("1.0"),
+				targetRatio:         d("2.0"),
+				finalTwapPrice:      d("7000.00"),
+				principal:           c("usdf", 5000000000),
+				expectedPrincipal:   c("usdf", 1923076923),
+				expectedCollateral:  c("btc", 54945054),
+				expectedKeeperCoins: cs(c("btc", 450549)),
+			},
+		},
+		{
+			"full liquidation when remaining debt is below debt floor",
+			args{
+				closeFactor:         d("1.0"),
+				targetRatio:         d("2.0"),
+				finalTwapPrice:      d("620.00"),
+				principal:           c("usdf", 600000000),
+				expectedPrincipal:   c("usdf", 0),
+				expectedKeeperCoins: cs(c("btc", 1000000)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.keeper.GetParams(suite.ctx)
+			for i := range params.CollateralParams {
+				if params.CollateralParams[i].Type == "btc-a" {
+					params.CollateralParams[i].CloseFactor = tc.args.closeFactor
+					params.CollateralParams[i].LiquidationTargetRatio = tc.args.targetRatio
+				}
+			}
+			suite.keeper.SetParams(suite.ctx, params)
+
+			pk := suite.app.GetPriceFeedKeeper()
+			_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", d("8000.00"), suite.ctx.BlockTime().Add(time.Hour*24))
+			suite.Require().NoError(err)
+			err = pk.SetCurrentPrices(suite.ctx, "btc:usd")
+			suite.Require().NoError(err)
+
+			err = suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), tc.args.principal, "btc-a")
+			suite.Require().NoError(err)
+
+			_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd:30", tc.args.finalTwapPrice, suite.ctx.BlockTime().Add(time.Hour*24))
+			suite.Require().NoError(err)
+			err = pk.SetCurrentPrices(suite.ctx, "btc:usd:30")
+			suite.Require().NoError(err)
+
+			bk := suite.app.GetBankKeeper()
+			keeperBalance := bk.GetAllBalances(suite.ctx, suite.addrs[1])
+
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "btc-a")
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(keeperBalance.Add(tc.args.expectedKeeperCoins...), bk.GetAllBalances(suite.ctx, suite.addrs[1]))
+
+			cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+			if tc.args.expectedPrincipal.IsZero() {
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(1), cdp.ID)
+			suite.Require().Equal(tc.args.expectedPrincipal, cdp.Principal)
+			suite.Require().Equal(tc.args.expectedCollateral, cdp.Collateral)
+			suite.Require().Equal(tc.args.expectedPrincipal.Amount, suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdf"))
+
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.expectedCollateral, deposit.Amount)
+
+			ratio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, "liquidation")
+			suite.Require().NoError(err)
+			suite.Require().True(ratio.GTE(d("1.5")))
+
+			auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+			suite.Require().NotEmpty(auctions)
+		})
+	}
+}
+
+func (suite *SeizeTestSuite) TestTwapLiquidation() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Type == "btc-a" {
+			params.CollateralParams[i].LiquidationTwapWindow = time.Hour
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	for i := range pfParams.Markets {
+		if pfParams.Markets[i].MarketID == "btc:usd:30" {
+			pfParams.Markets[i].PriceHistoryLength = 100
+		}
+	}
+	pk.SetParams(suite.ctx, pfParams)
+
+	// cdps cannot be liquidated before the liquidation market has a price history
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdf", 5000000000), "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "btc-a")
+	suite.Require().Error(err)
+
+	start := suite.ctx.BlockTime()
+	suite.setPrice(d("8000.00"), "btc:usd:30")
+
+	// a brief drop in the spot price below the liquidation price does not move the twap enough to liquidate
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(start.Add(59 * time.Minute))
+	suite.setPrice(d("7000.00"), "btc:usd:30")
+	suite.ctx = suite.ctx.WithBlockHeight(3).WithBlockTime(start.Add(time.Hour))
+	ratio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, c("btc", 100000000), "btc-a", c("usdf", 5000000000), c("usdf", 0), "liquidation")
+	suite.Require().NoError(err)
+	suite.Require().True(ratio.GT(d("1.5")))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "btc-a")
+	suite.Require().ErrorIs(err, types.ErrNotLiquidatable)
+
+	// the twap is not used once the market has no valid current price, even though its history still has prices
+	staleCtx, _ := suite.ctx.WithBlockHeight(4).WithBlockTime(start.Add(4 * time.Hour)).CacheContext()
+	suite.Require().Error(pk.SetCurrentPrices(staleCtx, "btc:usd:30"))
+	err = suite.keeper.AttemptKeeperLiquidation(staleCtx, suite.addrs[1], suite.addrs[0], "btc-a")
+	suite.Require().ErrorIs(err, pricefeedtypes.ErrNoValidPrice)
+
+	// a sustained drop does
+	suite.ctx = suite.ctx.WithBlockHeight(4).WithBlockTime(start.Add(2 * time.Hour))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "btc-a")
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.Require().False(found)
+}
+
 func TestSeizeTestSuite(t *testing.T) {
 	suite.Run(t, new(SeizeTestSuite))
 }
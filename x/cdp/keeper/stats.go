@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+// GetLiquidationStats returns the liquidation stats of a collateral type for the window starting at windowStart
+func (k Keeper) GetLiquidationStats(ctx sdk.Context, collateralType string, windowStart time.Time) (types.LiquidationStats, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationStatsKeyPrefix)
+	bz := store.Get(types.LiquidationStatsKey(windowStart, collateralType))
+	if bz == nil {
+		return types.LiquidationStats{}, false
+	}
+	var stats types.LiquidationStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetLiquidationStats sets the liquidation stats of a collateral type for a window in the store
+func (k Keeper) SetLiquidationStats(ctx sdk.Context, stats types.LiquidationStats) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationStatsKeyPrefix)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.LiquidationStatsKey(stats.WindowStart, stats.CollateralType), bz)
+}
+
+// IterateLiquidationStats iterates over the liquidation stats of windows starting in [start, end), in order of window
+// start and then collateral type. A zero start or end leaves the range unbounded on that side.
+func (k Keeper) IterateLiquidationStats(ctx sdk.Context, start, end time.Time, cb func(stats types.LiquidationStats) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationStatsKeyPrefix)
+	var startBz, endBz []byte
+	if !start.IsZero() {
+		startBz = sdk.FormatTimeBytes(start)
+	}
+	if !end.IsZero() {
+		endBz = sdk.FormatTimeBytes(end)
+	}
+	iterator := store.Iterator(startBz, endBz)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.LiquidationStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllLiquidationStats returns the liquidation stats of all collateral types and windows from the store
+func (k Keeper) GetAllLiquidationStats(ctx sdk.Context) (stats types.LiquidationStatsList) {
+	k.IterateLiquidationStats(ctx, time.Time{}, time.Time{}, func(ls types.LiquidationStats) bool {
+		stats = append(stats, ls)
+		return false
+	})
+	return
+}
+
+// addLiquidationStats adds the input stats to the stats of the collateral type for the current window
+func (k Keeper) addLiquidationStats(ctx sdk.Context, added types.LiquidationStats) {
+	windowStart := types.StatsWindowStart(ctx.BlockTime())
+	stats, found := k.GetLiquidationStats(ctx, added.CollateralType, windowStart)
+	if !found {
+		stats = types.NewLiquidationStats(added.CollateralType, windowStart)
+	}
+	k.SetLiquidationStats(ctx, stats.Add(added))
+}
+
+// recordLiquidation records a liquidation of the collateral type in the current stats window
+func (k Keeper) recordLiquidation(ctx sdk.Context, collateralType string) {
+	stats := types.NewLiquidationStats(collateralType, time.Time{})
+	stats.Liquidations = 1
+	k.addLiquidationStats(ctx, stats)
+}
+
+// recordCollateralAuction records collateral seized to auction, the debt it covers, and the penalty on that debt in the
+// current stats window
+func (k Keeper) recordCollateralAuction(ctx sdk.Context, collateralType string, lot, debt, penalty sdk.Coin) {
+	stats := types.NewLiquidationStats(collateralType, time.Time{})
+	stats.CollateralSeized = sdk.NewCoins(lot)
+	stats.DebtLiquidated = sdk.NewCoins(debt)
+	stats.LiquidationPenalty = sdk.NewCoins(penalty)
+	k.addLiquidationStats(ctx, stats)
+}
+
+// recordKeeperReward records collateral paid to a keeper for liquidating a cdp in the current stats window
+func (k Keeper) recordKeeperReward(ctx sdk.Context, collateralType string, reward sdk.Coin) {
+	stats := types.NewLiquidationStats(collateralType, time.Time{})
+	stats.KeeperRewards = sdk.NewCoins(reward)
+	k.addLiquidationStats(ctx, stats)
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
+	"github.com/percosis-labs/fury/x/cdp/keeper"
+	"github.com/percosis-labs/fury/x/cdp/types"
+)
+
+type StatsTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *StatsTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	coins := []sdk.Coins{
+		cs(c("btc", 100000000)),
+		cs(c("btc", 100000000)),
+		cs(),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// collateral ratio of 1.6
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("btc", 100000000), c("usdf", 5000000000), "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[1], c("btc", 100000000), c("usdf", 5000000000), "btc-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *StatsTestSuite) setPrice(price sdk.Dec) {
+	pk := suite.app.GetPriceFeedKeeper()
+	for _, market := range []string{"btc:usd", "btc:usd:30"} {
+		_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, market))
+	}
+}
+
+// auctionedPenalty returns the sum of the penalties added to the max bids of the open collateral auctions
+func (suite *StatsTestSuite) auctionedPenalty() sdk.Coins {
+	penalty := sdk.NewCoins()
+	for _, a := range suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx) {
+		auction, ok := a.(*auctiontypes.CollateralAuction)
+		suite.Require().True(ok)
+		penalty = penalty.Add(auction.MaxBid.SubAmount(auction.CorrespondingDebt.Amount))
+	}
+	return penalty
+}
+
+func (suite *StatsTestSuite) TestRecordLiquidation() {
+	suite.setPrice(d("7000"))
+	err := suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "btc-a")
+	suite.Require().NoError(err)
+
+	windowStart := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	stats, found := suite.keeper.GetLiquidationStats(suite.ctx, "btc-a", windowStart)
+	suite.Require().True(found)
+	suite.Equal(uint64(1), stats.Liquidations)
+	suite.Equal(cs(c("btc", 99000000)), stats.CollateralSeized)
+	suite.Equal(cs(c("usdf", 5000000000)), stats.DebtLiquidated)
+	suite.Equal(suite.auctionedPenalty(), stats.LiquidationPenalty)
+	suite.Equal(cs(c("btc", 1000000)), stats.KeeperRewards)
+
+	// liquidations in the same window are added to the same stats
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[1], "btc-a")
+	suite.Require().NoError(err)
+
+	stats, found = suite.keeper.GetLiquidationStats(suite.ctx, "btc-a", windowStart)
+	suite.Require().True(found)
+	suite.Equal(uint64(2), stats.Liquidations)
+	suite.Equal(cs(c("btc", 198000000)), stats.CollateralSeized)
+	suite.Equal(cs(c("usdf", 10000000000)), stats.DebtLiquidated)
+	suite.Equal(cs(c("btc", 2000000)), stats.KeeperRewards)
+}
+
+func (suite *StatsTestSuite) TestQueryLiquidationStats() {
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	suite.setPrice(d("7000"))
+	err := suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "btc-a")
+	suite.Require().NoError(err)
+
+	// liquidate the second cdp in the next window
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	suite.setPrice(d("7000"))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[1], "btc-a")
+	suite.Require().NoError(err)
+
+	firstWindow := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	secondWindow := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	res, err := queryServer.LiquidationStats(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 2)
+	suite.Equal(firstWindow, res.Stats[0].WindowStart)
+	suite.Equal(secondWindow, res.Stats[1].WindowStart)
+	suite.Require().Len(res.Totals, 1)
+	suite.Equal(firstWindow, res.Totals[0].WindowStart)
+	suite.Equal(uint64(2), res.Totals[0].Liquidations)
+	suite.Equal(cs(c("usdf", 10000000000)), res.Totals[0].DebtLiquidated)
+	suite.Equal(cs(c("btc", 2000000)), res.Totals[0].KeeperRewards)
+
+	res, err = queryServer.LiquidationStats(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationStatsRequest{
+		CollateralType: "btc-a",
+		StartTime:      secondWindow,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Equal(secondWindow, res.Stats[0].WindowStart)
+	suite.Equal(uint64(1), res.Totals[0].Liquidations)
+
+	res, err = queryServer.LiquidationStats(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationStatsRequest{
+		EndTime: secondWindow,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Equal(firstWindow, res.Stats[0].WindowStart)
+
+	res, err = queryServer.LiquidationStats(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationStatsRequest{
+		CollateralType: "xrp-a",
+	})
+	suite.Require().NoError(err)
+	suite.Empty(res.Stats)
+	suite.Empty(res.Totals)
+
+	_, err = queryServer.LiquidationStats(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationStatsRequest{
+		CollateralType: "lol-a",
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
+
+func TestStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatsTestSuite))
+}
@@ -231,57 +231,148 @@ func (m *OwnerCDPIndex) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OwnerCDPIndex proto.InternalMessageInfo
 
+// TypedCollateral defines an amount of collateral of a single collateral type
+type TypedCollateral struct {
+	CollateralType string     `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Amount         types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *TypedCollateral) Reset()         { *m = TypedCollateral{} }
+func (m *TypedCollateral) String() string { return proto.CompactTextString(m) }
+func (*TypedCollateral) ProtoMessage()    {}
+func (*TypedCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ace3339a6b997db3, []int{5}
+}
+func (m *TypedCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypedCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypedCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypedCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedCollateral.Merge(m, src)
+}
+func (m *TypedCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypedCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedCollateral proto.InternalMessageInfo
+
+// MultiCDP defines the state of a collateralized debt position backed by several collateral types.
+type MultiCDP struct {
+	ID    uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// type is the collateral type whose stability fee and debt limit apply to the debt of the position
+	Type            string                                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Collateral      TypedCollaterals                       `protobuf:"bytes,4,rep,name=collateral,proto3,castrepeated=TypedCollaterals" json:"collateral"`
+	Principal       types.Coin                             `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
+	AccumulatedFees types.Coin                             `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	FeesUpdated     time.Time                              `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
+	InterestFactor  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_factor"`
+}
+
+func (m *MultiCDP) Reset()         { *m = MultiCDP{} }
+func (m *MultiCDP) String() string { return proto.CompactTextString(m) }
+func (*MultiCDP) ProtoMessage()    {}
+func (*MultiCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ace3339a6b997db3, []int{6}
+}
+func (m *MultiCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCDP.Merge(m, src)
+}
+func (m *MultiCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCDP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCDP proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CDP)(nil), "fury.cdp.v1beta1.CDP")
 	proto.RegisterType((*Deposit)(nil), "fury.cdp.v1beta1.Deposit")
 	proto.RegisterType((*TotalPrincipal)(nil), "fury.cdp.v1beta1.TotalPrincipal")
 	proto.RegisterType((*TotalCollateral)(nil), "fury.cdp.v1beta1.TotalCollateral")
 	proto.RegisterType((*OwnerCDPIndex)(nil), "fury.cdp.v1beta1.OwnerCDPIndex")
+	proto.RegisterType((*TypedCollateral)(nil), "fury.cdp.v1beta1.TypedCollateral")
+	proto.RegisterType((*MultiCDP)(nil), "fury.cdp.v1beta1.MultiCDP")
 }
 
 func init() { proto.RegisterFile("fury/cdp/v1beta1/cdp.proto", fileDescriptor_ace3339a6b997db3) }
 
 var fileDescriptor_ace3339a6b997db3 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xce, 0xa6, 0x89, 0xdb, 0x6c, 0xfb, 0x37, 0xd5, 0xfe, 0x08, 0xb9, 0x39, 0xd8, 0x51, 0x91,
-	0x20, 0x1c, 0x62, 0xab, 0x80, 0xc4, 0x05, 0x84, 0xea, 0x58, 0x2d, 0xe1, 0x42, 0x65, 0x95, 0x0b,
-	0x07, 0x22, 0x67, 0x77, 0x13, 0x2c, 0x6c, 0xaf, 0xe5, 0x5d, 0x43, 0xfb, 0x10, 0x48, 0x7d, 0x98,
-	0x3e, 0x44, 0x0f, 0x1c, 0xaa, 0x9e, 0x10, 0x07, 0x03, 0xee, 0x5b, 0x70, 0x42, 0xbb, 0x76, 0xea,
-	0x1c, 0x83, 0x04, 0xa7, 0xec, 0xcc, 0xec, 0xf7, 0xcd, 0x64, 0xbe, 0xcf, 0x0b, 0x7b, 0xb3, 0x2c,
-	0x3d, 0xb3, 0x31, 0x49, 0xec, 0x8f, 0xfb, 0x53, 0x2a, 0xfc, 0x7d, 0x79, 0xb6, 0x92, 0x94, 0x09,
-	0x86, 0x76, 0x64, 0xcd, 0x92, 0x71, 0x55, 0xeb, 0x19, 0x98, 0xf1, 0x88, 0x71, 0x7b, 0xea, 0x73,
-	0x5a, 0x03, 0x58, 0x10, 0x97, 0x88, 0xde, 0x6e, 0x59, 0x9f, 0xa8, 0xc8, 0x2e, 0x83, 0xaa, 0x74,
-	0x67, 0xce, 0xe6, 0xac, 0xcc, 0xcb, 0x53, 0x95, 0x35, 0xe7, 0x8c, 0xcd, 0x43, 0x6a, 0xab, 0x68,
-	0x9a, 0xcd, 0x6c, 0x11, 0x44, 0x94, 0x0b, 0x3f, 0xaa, 0x66, 0xd8, 0xfb, 0xdc, 0x82, 0x6b, 0x23,
-	0xf7, 0x18, 0xdd, 0x85, 0xcd, 0x80, 0xe8, 0xa0, 0x0f, 0x06, 0x2d, 0x47, 0x2b, 0x72, 0xb3, 0x39,
-	0x76, 0xbd, 0x66, 0x40, 0xd0, 0x3b, 0xd8, 0x66, 0x9f, 0x62, 0x9a, 0xea, 0xcd, 0x3e, 0x18, 0x6c,
-	0x39, 0x2f, 0x7f, 0xe5, 0xe6, 0x70, 0x1e, 0x88, 0xf7, 0xd9, 0xd4, 0xc2, 0x2c, 0xaa, 0x46, 0xa8,
-	0x7e, 0x86, 0x9c, 0x7c, 0xb0, 0xc5, 0x59, 0x42, 0xb9, 0x75, 0x80, 0xf1, 0x01, 0x21, 0x29, 0xe5,
-	0xfc, 0xfa, 0x62, 0xf8, 0x7f, 0x35, 0x68, 0x95, 0x71, 0xce, 0x04, 0xe5, 0x5e, 0x49, 0x8b, 0x10,
-	0x6c, 0x49, 0x84, 0xbe, 0xd6, 0x07, 0x83, 0x8e, 0xa7, 0xce, 0xe8, 0x05, 0x84, 0x98, 0x85, 0xa1,
-	0x2f, 0x68, 0xea, 0x87, 0x7a, 0xab, 0x0f, 0x06, 0x9b, 0x8f, 0x76, 0xad, 0x8a, 0x44, 0xae, 0x66,
-	0xb1, 0x2f, 0x6b, 0xc4, 0x82, 0xd8, 0x69, 0x5d, 0xe6, 0x66, 0xc3, 0x5b, 0x82, 0xa0, 0xe7, 0xb0,
-	0x93, 0xa4, 0x41, 0x8c, 0x83, 0xc4, 0x0f, 0xf5, 0xf6, 0x6a, 0xf8, 0x1a, 0x81, 0x5e, 0xc1, 0x1d,
-	0x1f, 0xe3, 0x2c, 0xca, 0x24, 0x1f, 0x99, 0xcc, 0x28, 0xe5, 0xba, 0xb6, 0x1a, 0x4b, 0x77, 0x09,
-	0x78, 0x48, 0x29, 0x47, 0x47, 0x70, 0x4b, 0xe2, 0x27, 0x59, 0x42, 0x64, 0x4e, 0x5f, 0x57, 0x3c,
-	0x3d, 0xab, 0xd4, 0xc5, 0x5a, 0xe8, 0x62, 0x9d, 0x2c, 0x74, 0x71, 0x36, 0x24, 0xd1, 0xf9, 0x77,
-	0x13, 0x78, 0x9b, 0x12, 0xf9, 0xa6, 0x04, 0x22, 0x0a, 0xbb, 0x41, 0x2c, 0x68, 0x4a, 0xb9, 0x98,
-	0xcc, 0x7c, 0x2c, 0x58, 0xaa, 0x6f, 0xc8, 0x9d, 0x39, 0xcf, 0xe4, 0xfd, 0x6f, 0xb9, 0x79, 0x7f,
-	0x05, 0x59, 0x5c, 0x8a, 0xaf, 0x2f, 0x86, 0xb0, 0xfa, 0x13, 0x2e, 0xc5, 0xde, 0xf6, 0x82, 0xf4,
-	0x50, 0x71, 0xee, 0x7d, 0x01, 0x70, 0xdd, 0xa5, 0x09, 0xe3, 0x81, 0x40, 0x7d, 0xa8, 0x61, 0x92,
-	0x4c, 0x6e, 0x7d, 0xd1, 0x29, 0x72, 0xb3, 0x3d, 0x22, 0xc9, 0xd8, 0xf5, 0xda, 0x98, 0x24, 0x63,
-	0x82, 0x66, 0xb0, 0x43, 0xca, 0xcb, 0xac, 0x74, 0x48, 0xe7, 0x2f, 0x3a, 0xa4, 0xa6, 0x46, 0x4f,
-	0xa1, 0xe6, 0x47, 0x2c, 0x8b, 0x85, 0xf2, 0xc9, 0x0a, 0x3a, 0x54, 0xd7, 0xf7, 0x52, 0xb8, 0x7d,
-	0xc2, 0x84, 0x1f, 0x1e, 0xdf, 0x8a, 0xfb, 0x00, 0x76, 0x6b, 0xa7, 0x4c, 0x94, 0xf7, 0x80, 0xf2,
-	0xde, 0x76, 0x9d, 0x3e, 0x91, 0x2e, 0xac, 0x7b, 0x36, 0xff, 0xac, 0x27, 0x87, 0x5d, 0xd5, 0x73,
-	0x54, 0x1b, 0xf2, 0xdf, 0x37, 0x7d, 0x02, 0xff, 0x7b, 0x2d, 0x3f, 0xa8, 0x91, 0x7b, 0x3c, 0x8e,
-	0x09, 0x3d, 0x45, 0xf7, 0xe0, 0x7a, 0x29, 0x1e, 0xd7, 0x41, 0x7f, 0x6d, 0xd0, 0x72, 0x60, 0x91,
-	0x9b, 0x9a, 0x52, 0x8f, 0x7b, 0x9a, 0x92, 0x8f, 0x3b, 0x47, 0x97, 0x3f, 0x8d, 0xc6, 0x65, 0x61,
-	0x80, 0xab, 0xc2, 0x00, 0x3f, 0x0a, 0x03, 0x9c, 0xdf, 0x18, 0x8d, 0xab, 0x1b, 0xa3, 0xf1, 0xf5,
-	0xc6, 0x68, 0xbc, 0x7d, 0xb8, 0x24, 0x63, 0x42, 0x53, 0xcc, 0x78, 0xc0, 0x87, 0xa1, 0x3f, 0xe5,
-	0xb6, 0x7a, 0xd4, 0x4e, 0xd5, 0xb3, 0xa6, 0xd4, 0x9c, 0x6a, 0xca, 0xc8, 0x8f, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xc2, 0x18, 0xfe, 0x67, 0xef, 0x04, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8d, 0x13, 0xc7, 0x6d, 0xa6, 0x7d, 0x4d, 0x35, 0xef, 0xe9, 0xc9, 0xcd, 0xc2, 0x0e, 0x45,
+	0x82, 0xb0, 0x88, 0xad, 0x16, 0x24, 0x36, 0x20, 0x54, 0xc7, 0x6a, 0x09, 0x12, 0xa2, 0xb2, 0xca,
+	0x06, 0x24, 0x22, 0x67, 0x66, 0x12, 0x2c, 0x1c, 0x8f, 0xe5, 0x19, 0x43, 0xf3, 0x11, 0x48, 0xfd,
+	0x0e, 0x16, 0xac, 0xfa, 0x11, 0x5d, 0xb0, 0xa8, 0xba, 0x42, 0x2c, 0x52, 0x48, 0xff, 0x82, 0x15,
+	0x9a, 0xb1, 0x53, 0x57, 0x59, 0x05, 0xa9, 0x20, 0x16, 0xac, 0x32, 0x73, 0xef, 0x9c, 0x73, 0xef,
+	0xcc, 0x39, 0xb9, 0x06, 0x8d, 0x41, 0x9a, 0x8c, 0x6d, 0x84, 0x63, 0xfb, 0xed, 0x56, 0x9f, 0x70,
+	0x7f, 0x4b, 0xac, 0xad, 0x38, 0xa1, 0x9c, 0xc2, 0x75, 0x91, 0xb3, 0xc4, 0x3e, 0xcf, 0x35, 0x0c,
+	0x44, 0xd9, 0x88, 0x32, 0xbb, 0xef, 0x33, 0x52, 0x00, 0x68, 0x10, 0x65, 0x88, 0xc6, 0x46, 0x96,
+	0xef, 0xc9, 0x9d, 0x9d, 0x6d, 0xf2, 0xd4, 0x7f, 0x43, 0x3a, 0xa4, 0x59, 0x5c, 0xac, 0xf2, 0xa8,
+	0x39, 0xa4, 0x74, 0x18, 0x12, 0x5b, 0xee, 0xfa, 0xe9, 0xc0, 0xe6, 0xc1, 0x88, 0x30, 0xee, 0x8f,
+	0xf2, 0x1e, 0x36, 0xdf, 0xab, 0xa0, 0xd2, 0x71, 0xf7, 0xe1, 0xff, 0xa0, 0x1c, 0x60, 0x5d, 0x69,
+	0x2a, 0x2d, 0xd5, 0xd1, 0xa6, 0x13, 0xb3, 0xdc, 0x75, 0xbd, 0x72, 0x80, 0xe1, 0x2b, 0x50, 0xa5,
+	0xef, 0x22, 0x92, 0xe8, 0xe5, 0xa6, 0xd2, 0x5a, 0x75, 0x1e, 0x7f, 0x9f, 0x98, 0xed, 0x61, 0xc0,
+	0x5f, 0xa7, 0x7d, 0x0b, 0xd1, 0x51, 0xde, 0x42, 0xfe, 0xd3, 0x66, 0xf8, 0x8d, 0xcd, 0xc7, 0x31,
+	0x61, 0xd6, 0x0e, 0x42, 0x3b, 0x18, 0x27, 0x84, 0xb1, 0xb3, 0xe3, 0xf6, 0xbf, 0x79, 0xa3, 0x79,
+	0xc4, 0x19, 0x73, 0xc2, 0xbc, 0x8c, 0x16, 0x42, 0xa0, 0x0a, 0x84, 0x5e, 0x69, 0x2a, 0xad, 0x9a,
+	0x27, 0xd7, 0xf0, 0x11, 0x00, 0x88, 0x86, 0xa1, 0xcf, 0x49, 0xe2, 0x87, 0xba, 0xda, 0x54, 0x5a,
+	0x2b, 0xdb, 0x1b, 0x56, 0x4e, 0x22, 0x9e, 0x66, 0xf6, 0x5e, 0x56, 0x87, 0x06, 0x91, 0xa3, 0x9e,
+	0x4c, 0xcc, 0x92, 0x77, 0x05, 0x02, 0x1f, 0x82, 0x5a, 0x9c, 0x04, 0x11, 0x0a, 0x62, 0x3f, 0xd4,
+	0xab, 0x8b, 0xe1, 0x0b, 0x04, 0x7c, 0x02, 0xd6, 0x7d, 0x84, 0xd2, 0x51, 0x2a, 0xf8, 0x70, 0x6f,
+	0x40, 0x08, 0xd3, 0xb5, 0xc5, 0x58, 0xea, 0x57, 0x80, 0xbb, 0x84, 0x30, 0xb8, 0x07, 0x56, 0x05,
+	0xbe, 0x97, 0xc6, 0x58, 0xc4, 0xf4, 0x25, 0xc9, 0xd3, 0xb0, 0x32, 0x5d, 0xac, 0x99, 0x2e, 0xd6,
+	0xc1, 0x4c, 0x17, 0x67, 0x59, 0x10, 0x1d, 0x9d, 0x9b, 0x8a, 0xb7, 0x22, 0x90, 0xcf, 0x33, 0x20,
+	0x24, 0xa0, 0x1e, 0x44, 0x9c, 0x24, 0x84, 0xf1, 0xde, 0xc0, 0x47, 0x9c, 0x26, 0xfa, 0xb2, 0x78,
+	0x33, 0xe7, 0x81, 0x38, 0xff, 0x65, 0x62, 0xde, 0x5a, 0x40, 0x16, 0x97, 0xa0, 0xb3, 0xe3, 0x36,
+	0xc8, 0x2f, 0xe1, 0x12, 0xe4, 0xad, 0xcd, 0x48, 0x77, 0x25, 0xe7, 0xe6, 0x27, 0x05, 0x2c, 0xb9,
+	0x24, 0xa6, 0x2c, 0xe0, 0xb0, 0x09, 0x34, 0x84, 0xe3, 0xde, 0xa5, 0x2f, 0x6a, 0xd3, 0x89, 0x59,
+	0xed, 0xe0, 0xb8, 0xeb, 0x7a, 0x55, 0x84, 0xe3, 0x2e, 0x86, 0x03, 0x50, 0xc3, 0xd9, 0x61, 0x9a,
+	0x39, 0xa4, 0x76, 0x8d, 0x0e, 0x29, 0xa8, 0xe1, 0x7d, 0xa0, 0xf9, 0x23, 0x9a, 0x46, 0x5c, 0xaf,
+	0x2c, 0xa6, 0x43, 0x7e, 0x7c, 0x33, 0x01, 0x6b, 0x07, 0x94, 0xfb, 0xe1, 0xfe, 0xa5, 0xb8, 0xb7,
+	0x41, 0xbd, 0x70, 0x4a, 0x4f, 0x7a, 0x4f, 0x91, 0xde, 0x5b, 0x2b, 0xc2, 0x07, 0xc2, 0x85, 0x45,
+	0xcd, 0xf2, 0xcf, 0xd5, 0x64, 0xa0, 0x2e, 0x6b, 0x76, 0x0a, 0x43, 0xfe, 0xfa, 0xa2, 0xf7, 0xc0,
+	0x3f, 0xcf, 0xc4, 0x1f, 0xaa, 0xe3, 0xee, 0x77, 0x23, 0x4c, 0x0e, 0xe1, 0x4d, 0xb0, 0x94, 0x89,
+	0xc7, 0x74, 0xa5, 0x59, 0x69, 0xa9, 0x0e, 0x98, 0x4e, 0x4c, 0x4d, 0xaa, 0xc7, 0x3c, 0x4d, 0xca,
+	0xc7, 0x64, 0xab, 0xe3, 0x98, 0xe0, 0xdf, 0xda, 0xea, 0x47, 0x15, 0x2c, 0x3f, 0x4d, 0x43, 0x1e,
+	0xfc, 0x69, 0x73, 0xe7, 0xe5, 0xdc, 0xdc, 0xa9, 0xb4, 0x56, 0xb6, 0x6f, 0x58, 0xf3, 0x43, 0xda,
+	0x9a, 0x7b, 0x31, 0x47, 0x17, 0xb7, 0xfb, 0x70, 0x6e, 0xae, 0xcf, 0x25, 0xd8, 0xdf, 0x99, 0x74,
+	0x2d, 0x33, 0xc9, 0xd9, 0x3b, 0xf9, 0x66, 0x94, 0x4e, 0xa6, 0x86, 0x72, 0x3a, 0x35, 0x94, 0xaf,
+	0x53, 0x43, 0x39, 0xba, 0x30, 0x4a, 0xa7, 0x17, 0x46, 0xe9, 0xf3, 0x85, 0x51, 0x7a, 0x71, 0xe7,
+	0x4a, 0x8d, 0x98, 0x24, 0x88, 0xb2, 0x80, 0xb5, 0x43, 0xbf, 0xcf, 0x6c, 0xf9, 0xe9, 0x3d, 0x94,
+	0x1f, 0x5f, 0x59, 0xaa, 0xaf, 0xc9, 0xab, 0xdd, 0xfd, 0x31, 0x00, 0xc7, 0xde, 0xdd, 0x03, 0x95,
+	0x07, 0x00, 0x00,
 }
 
 func (m *CDP) Marshal() (dAtA []byte, err error) {
@@ -540,6 +631,140 @@ func (m *OwnerCDPIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TypedCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypedCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypedCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InterestFactor.Size()
+		i -= size
+		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintCdp(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCdp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCdp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCdp(v)
 	base := offset
@@ -645,6 +870,55 @@ func (m *OwnerCDPIndex) Size() (n int) {
 	return n
 }
 
+func (m *TypedCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *MultiCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovCdp(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.InterestFactor.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
 func sovCdp(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1442,6 +1716,423 @@ func (m *OwnerCDPIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TypedCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypedCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypedCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, TypedCollateral{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCdp(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
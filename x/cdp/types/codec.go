@@ -18,6 +18,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(&MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgCreateMultiCDP{}, "cdp/MsgCreateMultiCDP", nil)
+	cdc.RegisterConcrete(&MsgDepositMultiCDP{}, "cdp/MsgDepositMultiCDP", nil)
+	cdc.RegisterConcrete(&MsgWithdrawMultiCDP{}, "cdp/MsgWithdrawMultiCDP", nil)
+	cdc.RegisterConcrete(&MsgDrawMultiCDPDebt{}, "cdp/MsgDrawMultiCDPDebt", nil)
+	cdc.RegisterConcrete(&MsgRepayMultiCDPDebt{}, "cdp/MsgRepayMultiCDPDebt", nil)
+	cdc.RegisterConcrete(&MsgLiquidateMultiCDP{}, "cdp/MsgLiquidateMultiCDP", nil)
+	cdc.RegisterConcrete(&MsgRedeemDebt{}, "cdp/MsgRedeemDebt", nil)
+	cdc.RegisterConcrete(&MsgAdjustCDP{}, "cdp/MsgAdjustCDP", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +36,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDrawDebt{},
 		&MsgRepayDebt{},
 		&MsgLiquidate{},
+		&MsgCreateMultiCDP{},
+		&MsgDepositMultiCDP{},
+		&MsgWithdrawMultiCDP{},
+		&MsgDrawMultiCDPDebt{},
+		&MsgRepayMultiCDPDebt{},
+		&MsgLiquidateMultiCDP{},
+		&MsgRedeemDebt{},
+		&MsgAdjustCDP{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -49,4 +49,8 @@ var (
 	ErrInsufficientBalance = errorsmod.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = errorsmod.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrMultiCdpAlreadyExists error for an owner creating a second multi-collateral cdp
+	ErrMultiCdpAlreadyExists = errorsmod.Register(ModuleName, 24, "multi-collateral cdp already exists")
+	// ErrNoRedeemableDebt error for a redemption when no cdp has debt that can be redeemed
+	ErrNoRedeemableDebt = errorsmod.Register(ModuleName, 25, "no redeemable debt")
 )
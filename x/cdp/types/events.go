@@ -9,10 +9,13 @@ const (
 	EventTypeCdpClose          = "cdp_close"
 	EventTypeCdpWithdrawal     = "cdp_withdrawal"
 	EventTypeCdpLiquidation    = "cdp_liquidation"
+	EventTypeCdpRedemption     = "cdp_redemption"
 	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
 
 	AttributeKeyCdpID      = "cdp_id"
 	AttributeKeyDeposit    = "deposit"
+	AttributeKeyCollateral = "collateral"
+	AttributeKeyFee        = "fee"
 	AttributeValueCategory = "cdp"
 	AttributeKeyError      = "error_message"
 )
@@ -51,6 +51,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.MultiCDPs.Validate(); err != nil {
+		return err
+	}
+
 	if err := gs.Deposits.Validate(); err != nil {
 		return err
 	}
@@ -63,6 +67,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.LiquidationStats.Validate(); err != nil {
+		return err
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
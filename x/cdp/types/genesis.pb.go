@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -41,6 +42,8 @@ type GenesisState struct {
 	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	MultiCDPs                 MultiCDPs                `protobuf:"bytes,9,rep,name=multi_cdps,json=multiCdps,proto3,castrepeated=MultiCDPs" json:"multi_cdps"`
+	LiquidationStats          LiquidationStatsList     `protobuf:"bytes,10,rep,name=liquidation_stats,json=liquidationStats,proto3,castrepeated=LiquidationStatsList" json:"liquidation_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +135,20 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetMultiCDPs() MultiCDPs {
+	if m != nil {
+		return m.MultiCDPs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationStats() LiquidationStatsList {
+	if m != nil {
+		return m.LiquidationStats
+	}
+	return nil
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams        CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -142,6 +159,10 @@ type Params struct {
 	DebtAuctionThreshold    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=debt_auction_threshold,json=debtAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_threshold"`
 	DebtAuctionLot          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker          bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// multi_cdp_debt_limit caps the total debt of all multi-collateral cdps. An unset limit disables multi-collateral cdps.
+	MultiCdpDebtLimit types.Coin `protobuf:"bytes,9,opt,name=multi_cdp_debt_limit,json=multiCdpDebtLimit,proto3" json:"multi_cdp_debt_limit"`
+	// peg_controller adjusts the stability fee of each collateral type based on the debt asset's deviation from its peg.
+	PegController PegController `protobuf:"bytes,10,opt,name=peg_controller,json=pegController,proto3" json:"peg_controller"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -205,6 +226,76 @@ func (m *Params) GetCircuitBreaker() bool {
 	return false
 }
 
+func (m *Params) GetMultiCdpDebtLimit() types.Coin {
+	if m != nil {
+		return m.MultiCdpDebtLimit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetPegController() PegController {
+	if m != nil {
+		return m.PegController
+	}
+	return PegController{}
+}
+
+// PegController defines governance params for adjusting stability fees according to the market price of the debt asset.
+// The effective stability fee of a collateral type is
+// clamp(stability_fee + sensitivity * (target_price - price) / target_price, min_stability_fee, max_stability_fee).
+type PegController struct {
+	// market_id is the pricefeed market of the debt asset. An empty market id disables the controller.
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// target_price is the peg price of the debt asset.
+	TargetPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=target_price,json=targetPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_price"`
+	// sensitivity is the change in per second stability fee for each unit of relative deviation from the peg.
+	Sensitivity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=sensitivity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sensitivity"`
+	// min_stability_fee is the lowest per second stability fee the controller can set.
+	MinStabilityFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_stability_fee,json=minStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_fee"`
+	// max_stability_fee is the highest per second stability fee the controller can set.
+	MaxStabilityFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_stability_fee,json=maxStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_stability_fee"`
+}
+
+func (m *PegController) Reset()         { *m = PegController{} }
+func (m *PegController) String() string { return proto.CompactTextString(m) }
+func (*PegController) ProtoMessage()    {}
+func (*PegController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca565c97afff7e5, []int{2}
+}
+func (m *PegController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegController.Merge(m, src)
+}
+func (m *PegController) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegController) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegController proto.InternalMessageInfo
+
+func (m *PegController) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -217,7 +308,7 @@ func (m *DebtParam) Reset()         { *m = DebtParam{} }
 func (m *DebtParam) String() string { return proto.CompactTextString(m) }
 func (*DebtParam) ProtoMessage()    {}
 func (*DebtParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ca565c97afff7e5, []int{2}
+	return fileDescriptor_3ca565c97afff7e5, []int{3}
 }
 func (m *DebtParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,13 +365,26 @@ type CollateralParam struct {
 	KeeperRewardPercentage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=check_collateralization_index_count,json=checkCollateralizationIndexCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"check_collateralization_index_count"`
 	ConversionFactor                 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// close_factor is the maximum fraction of a cdp's debt that can be repaid in a single liquidation.
+	// Zero disables partial liquidation, and undercollateralized cdps are liquidated in full.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
+	// liquidation_target_ratio is the collateralization ratio a partially liquidated cdp is restored to.
+	LiquidationTargetRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=liquidation_target_ratio,json=liquidationTargetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_target_ratio"`
+	// auction_type is the type of auction liquidated collateral is sold in, either "collateral" (the default when
+	// empty), "dutch" or "batch". Batch auctions sell all of a liquidated cdp's collateral in a single auction.
+	AuctionType string `protobuf:"bytes,15,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// redemption_fee is the fraction of redeemed collateral that is kept by the redeemed cdp as a fee.
+	RedemptionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=redemption_fee,json=redemptionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_fee"`
+	// liquidation_twap_window is the window the liquidation market price is averaged over for liquidation checks, using
+	// the market's price history. Zero uses the current price of the liquidation market.
+	LiquidationTwapWindow time.Duration `protobuf:"bytes,17,opt,name=liquidation_twap_window,json=liquidationTwapWindow,proto3,stdduration" json:"liquidation_twap_window"`
 }
 
 func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
 func (m *CollateralParam) String() string { return proto.CompactTextString(m) }
 func (*CollateralParam) ProtoMessage()    {}
 func (*CollateralParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ca565c97afff7e5, []int{3}
+	return fileDescriptor_3ca565c97afff7e5, []int{4}
 }
 func (m *CollateralParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +448,20 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+func (m *CollateralParam) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
+func (m *CollateralParam) GetLiquidationTwapWindow() time.Duration {
+	if m != nil {
+		return m.LiquidationTwapWindow
+	}
+	return 0
+}
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -355,7 +473,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ca565c97afff7e5, []int{4}
+	return fileDescriptor_3ca565c97afff7e5, []int{5}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +526,7 @@ func (m *GenesisTotalPrincipal) Reset()         { *m = GenesisTotalPrincipal{} }
 func (m *GenesisTotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*GenesisTotalPrincipal) ProtoMessage()    {}
 func (*GenesisTotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ca565c97afff7e5, []int{5}
+	return fileDescriptor_3ca565c97afff7e5, []int{6}
 }
 func (m *GenesisTotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,93 +562,221 @@ func (m *GenesisTotalPrincipal) GetCollateralType() string {
 	return ""
 }
 
+// LiquidationStats defines the liquidation totals of a collateral type over a time window.
+type LiquidationStats struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// window_start is the start of the time window the totals were recorded in
+	WindowStart time.Time `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// liquidations is the number of times collateral of the type was seized
+	Liquidations uint64 `protobuf:"varint,3,opt,name=liquidations,proto3" json:"liquidations,omitempty"`
+	// collateral_seized is the collateral sent to auction, excluding keeper rewards
+	CollateralSeized github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=collateral_seized,json=collateralSeized,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral_seized"`
+	// debt_liquidated is the principal and fees the seized collateral was auctioned to cover
+	DebtLiquidated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=debt_liquidated,json=debtLiquidated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"debt_liquidated"`
+	// liquidation_penalty is the penalty added to the debt raised by auctions
+	LiquidationPenalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=liquidation_penalty,json=liquidationPenalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidation_penalty"`
+	// keeper_rewards is the collateral paid to keepers for liquidating cdps
+	KeeperRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=keeper_rewards,json=keeperRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"keeper_rewards"`
+}
+
+func (m *LiquidationStats) Reset()         { *m = LiquidationStats{} }
+func (m *LiquidationStats) String() string { return proto.CompactTextString(m) }
+func (*LiquidationStats) ProtoMessage()    {}
+func (*LiquidationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca565c97afff7e5, []int{7}
+}
+func (m *LiquidationStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationStats.Merge(m, src)
+}
+func (m *LiquidationStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationStats proto.InternalMessageInfo
+
+func (m *LiquidationStats) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *LiquidationStats) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *LiquidationStats) GetLiquidations() uint64 {
+	if m != nil {
+		return m.Liquidations
+	}
+	return 0
+}
+
+func (m *LiquidationStats) GetCollateralSeized() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollateralSeized
+	}
+	return nil
+}
+
+func (m *LiquidationStats) GetDebtLiquidated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DebtLiquidated
+	}
+	return nil
+}
+
+func (m *LiquidationStats) GetLiquidationPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LiquidationPenalty
+	}
+	return nil
+}
+
+func (m *LiquidationStats) GetKeeperRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.KeeperRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.cdp.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "fury.cdp.v1beta1.Params")
+	proto.RegisterType((*PegController)(nil), "fury.cdp.v1beta1.PegController")
 	proto.RegisterType((*DebtParam)(nil), "fury.cdp.v1beta1.DebtParam")
 	proto.RegisterType((*CollateralParam)(nil), "fury.cdp.v1beta1.CollateralParam")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "fury.cdp.v1beta1.GenesisAccumulationTime")
 	proto.RegisterType((*GenesisTotalPrincipal)(nil), "fury.cdp.v1beta1.GenesisTotalPrincipal")
+	proto.RegisterType((*LiquidationStats)(nil), "fury.cdp.v1beta1.LiquidationStats")
 }
 
 func init() { proto.RegisterFile("fury/cdp/v1beta1/genesis.proto", fileDescriptor_3ca565c97afff7e5) }
 
 var fileDescriptor_3ca565c97afff7e5 = []byte{
-	// 1182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x4e, 0x5b, 0xc7,
-	0x13, 0xe7, 0x10, 0x87, 0xd8, 0x8b, 0x63, 0x9b, 0x0d, 0x49, 0x0e, 0xa0, 0xbf, 0xed, 0x3f, 0x95,
-	0x1a, 0x72, 0x81, 0xad, 0xa4, 0x52, 0xa4, 0x4a, 0x55, 0x5b, 0x6c, 0x8b, 0x08, 0x85, 0x4a, 0xd6,
-	0x81, 0xab, 0xf6, 0xe2, 0xe8, 0x7c, 0x8c, 0xcd, 0x8a, 0xe3, 0xb3, 0xa7, 0xbb, 0x6b, 0x17, 0x78,
-	0x85, 0xaa, 0x6a, 0xd4, 0x97, 0xa8, 0x94, 0xeb, 0x3e, 0x44, 0x7a, 0x17, 0xf5, 0xaa, 0xea, 0x85,
-	0xa9, 0xcc, 0x7d, 0x9f, 0xa1, 0xda, 0x0f, 0x7f, 0x60, 0x63, 0x29, 0x02, 0xf7, 0x86, 0xc3, 0xce,
-	0xec, 0xfc, 0x7e, 0x3b, 0xb3, 0x33, 0xb3, 0x63, 0x54, 0x6c, 0x75, 0xd9, 0x79, 0x35, 0x08, 0x93,
-	0x6a, 0xef, 0x85, 0x0f, 0xc2, 0x7b, 0x51, 0x6d, 0x43, 0x0c, 0x9c, 0xf0, 0x4a, 0xc2, 0xa8, 0xa0,
-	0xb8, 0x20, 0xf5, 0x95, 0x20, 0x4c, 0x2a, 0x46, 0xbf, 0x59, 0x0c, 0x28, 0xef, 0x50, 0x5e, 0xf5,
-	0x3d, 0x0e, 0x23, 0xa3, 0x80, 0x92, 0x58, 0x5b, 0x6c, 0x6e, 0x68, 0xbd, 0xab, 0x56, 0x55, 0xbd,
-	0x30, 0xaa, 0xcd, 0x19, 0x32, 0x09, 0xac, 0x75, 0xeb, 0x6d, 0xda, 0xa6, 0xda, 0x46, 0xfe, 0x67,
-	0xa4, 0xa5, 0x36, 0xa5, 0xed, 0x08, 0xaa, 0x6a, 0xe5, 0x77, 0x5b, 0x55, 0x41, 0x3a, 0xc0, 0x85,
-	0xd7, 0x31, 0x66, 0xdb, 0xbf, 0xa7, 0x50, 0xf6, 0xb5, 0x3e, 0xf1, 0x91, 0xf0, 0x04, 0xe0, 0x57,
-	0x68, 0x25, 0xf1, 0x98, 0xd7, 0xe1, 0xb6, 0x55, 0xb6, 0x76, 0x56, 0x5f, 0xda, 0x95, 0x69, 0x0f,
-	0x2a, 0x4d, 0xa5, 0xaf, 0xa5, 0xde, 0xf7, 0x4b, 0x4b, 0x8e, 0xd9, 0x8d, 0xbf, 0x42, 0xa9, 0x20,
-	0x4c, 0xb8, 0xbd, 0x5c, 0xbe, 0xb7, 0xb3, 0xfa, 0xf2, 0xf1, 0xac, 0x55, 0xbd, 0xd1, 0xac, 0xad,
-	0x4b, 0x93, 0x41, 0xbf, 0x94, 0xaa, 0x37, 0x9a, 0xfc, 0xdd, 0xa5, 0xfe, 0x3a, 0xca, 0x10, 0xbf,
-	0x46, 0xe9, 0x10, 0x12, 0xca, 0x89, 0xe0, 0xf6, 0x3d, 0x05, 0xb2, 0x31, 0x0b, 0xd2, 0xd0, 0x3b,
-	0x6a, 0x05, 0x09, 0xf4, 0xee, 0xb2, 0x94, 0x36, 0x02, 0xee, 0x8c, 0x8c, 0xf1, 0xe7, 0x28, 0xcf,
-	0x85, 0xc7, 0x04, 0x89, 0xdb, 0x6e, 0x10, 0x26, 0x2e, 0x09, 0xed, 0x54, 0xd9, 0xda, 0x49, 0xd5,
-	0xd6, 0x06, 0xfd, 0xd2, 0xc3, 0x23, 0xa3, 0xaa, 0x87, 0xc9, 0x41, 0xc3, 0x79, 0xc8, 0x27, 0x96,
-	0x21, 0xfe, 0x1f, 0x42, 0x21, 0xf8, 0xc2, 0x0d, 0x21, 0xa6, 0x1d, 0xfb, 0x7e, 0xd9, 0xda, 0xc9,
-	0x38, 0x19, 0x29, 0x69, 0x48, 0x01, 0xde, 0x42, 0x99, 0x36, 0xed, 0x19, 0xed, 0x8a, 0xd2, 0xa6,
-	0xdb, 0xb4, 0xa7, 0x95, 0x3f, 0x5a, 0x68, 0x2b, 0x61, 0xd0, 0x23, 0xb4, 0xcb, 0x5d, 0x2f, 0x08,
-	0xba, 0x9d, 0x6e, 0xe4, 0x09, 0x42, 0x63, 0x57, 0xc5, 0xdc, 0x7e, 0xa0, 0x7c, 0x7a, 0x3e, 0xeb,
-	0x93, 0x09, 0xff, 0xde, 0x84, 0xc9, 0x31, 0xe9, 0x40, 0xad, 0x6c, 0x7c, 0xb4, 0xe7, 0x6c, 0xe0,
-	0xce, 0xc6, 0x90, 0x6f, 0x46, 0x85, 0x19, 0x2a, 0x08, 0x2a, 0xbc, 0xc8, 0x4d, 0x18, 0x89, 0x03,
-	0x92, 0x78, 0x11, 0xb7, 0xd3, 0xea, 0x04, 0xcf, 0xe6, 0x9e, 0xe0, 0x58, 0x1a, 0x34, 0x87, 0xfb,
-	0x6b, 0x45, 0xc3, 0xff, 0xe4, 0x46, 0x35, 0x77, 0xf2, 0xe2, 0xba, 0x60, 0xfb, 0x9f, 0xfb, 0x68,
-	0x45, 0xe7, 0x06, 0x3e, 0x41, 0x6b, 0x01, 0x8d, 0x22, 0x4f, 0x00, 0x93, 0x67, 0x18, 0x26, 0x94,
-	0xe4, 0xff, 0xff, 0x0d, 0xa9, 0x31, 0xda, 0xaa, 0xcc, 0x6b, 0xb6, 0x61, 0x2e, 0x4c, 0x29, 0xb8,
-	0x53, 0x08, 0xa6, 0x24, 0xf8, 0x6b, 0x73, 0x65, 0x8a, 0xc3, 0x5e, 0x56, 0x39, 0xbb, 0x75, 0x53,
-	0xe2, 0xf8, 0x42, 0x83, 0xeb, 0xb4, 0x55, 0xb7, 0xaa, 0x04, 0xf8, 0x0d, 0x5a, 0x6b, 0x47, 0xd4,
-	0xf7, 0x22, 0x57, 0x01, 0x45, 0xa4, 0x43, 0x84, 0x7d, 0x4f, 0x01, 0x6d, 0x54, 0x4c, 0xfd, 0xc9,
-	0x62, 0x9d, 0x38, 0x2e, 0x89, 0x0d, 0x4c, 0x5e, 0x5b, 0x4a, 0xf4, 0x43, 0x69, 0x87, 0xcf, 0xd0,
-	0x06, 0xef, 0xb2, 0x24, 0x92, 0x39, 0xd0, 0x0d, 0xf4, 0xf5, 0x9f, 0x30, 0xe0, 0x27, 0x34, 0xd2,
-	0x69, 0x98, 0xa9, 0x7d, 0x21, 0x2d, 0xff, 0xea, 0x97, 0x3e, 0x6d, 0x13, 0x71, 0xd2, 0xf5, 0x2b,
-	0x01, 0xed, 0x98, 0x32, 0x37, 0x9f, 0x5d, 0x1e, 0x9e, 0x56, 0xc5, 0x79, 0x02, 0xbc, 0x72, 0x10,
-	0x8b, 0x3f, 0x7e, 0xdb, 0x45, 0xe6, 0x14, 0x07, 0xb1, 0x70, 0x9e, 0x1a, 0xf8, 0x3d, 0x8d, 0x7e,
-	0x3c, 0x04, 0xc7, 0x11, 0x7a, 0x34, 0xcd, 0x1c, 0x51, 0xa1, 0x93, 0xf8, 0x8e, 0x9c, 0x6b, 0xd7,
-	0x39, 0x0f, 0xa9, 0xc0, 0x0c, 0x3d, 0x51, 0xd1, 0x9a, 0x75, 0x72, 0x65, 0x01, 0x84, 0xeb, 0x12,
-	0x7b, 0xc6, 0xc3, 0x16, 0x2a, 0x5c, 0xe3, 0x94, 0xee, 0x3d, 0x58, 0x00, 0x5b, 0x6e, 0x82, 0x4d,
-	0xfa, 0xf6, 0x0c, 0xe5, 0x03, 0xc2, 0x82, 0x2e, 0x11, 0xae, 0xcf, 0xc0, 0x3b, 0x05, 0x66, 0xa7,
-	0xcb, 0xd6, 0x4e, 0xda, 0xc9, 0x19, 0x71, 0x4d, 0x4b, 0xb7, 0x7f, 0x59, 0x46, 0x99, 0x51, 0x62,
-	0xe1, 0x75, 0x74, 0x5f, 0x77, 0x06, 0x4b, 0x75, 0x06, 0xbd, 0x90, 0x60, 0x0c, 0x5a, 0xc0, 0x20,
-	0x0e, 0xc0, 0xf5, 0x38, 0x07, 0xa1, 0x92, 0x34, 0xe3, 0xe4, 0x46, 0xe2, 0x3d, 0x29, 0xc5, 0x44,
-	0x96, 0x4c, 0xdc, 0x03, 0xc6, 0xa5, 0x6f, 0x2d, 0x2f, 0x10, 0x94, 0xa9, 0x34, 0xbc, 0xab, 0x7b,
-	0x85, 0x31, 0xec, 0xbe, 0x42, 0xc5, 0xdf, 0x99, 0x9a, 0x69, 0x45, 0x94, 0xb2, 0x85, 0x64, 0xa5,
-	0x2a, 0xa7, 0x7d, 0x09, 0xb7, 0xfd, 0x73, 0x1a, 0xe5, 0xa7, 0xea, 0x76, 0x4e, 0x68, 0x30, 0x4a,
-	0x49, 0x3c, 0x13, 0x0f, 0xf5, 0xbf, 0x8c, 0x42, 0x44, 0xbe, 0xef, 0x92, 0x50, 0xb7, 0x4e, 0x26,
-	0x3f, 0xb7, 0x88, 0x42, 0x03, 0x82, 0x89, 0x13, 0x36, 0x20, 0x70, 0x0a, 0x13, 0xb0, 0x8e, 0xfc,
-	0x8b, 0xbf, 0x34, 0x51, 0xd0, 0x05, 0x9f, 0xfa, 0xb8, 0x82, 0x57, 0x8e, 0xea, 0x52, 0xf7, 0x90,
-	0x7c, 0x3d, 0x7c, 0x12, 0x11, 0x71, 0xee, 0xb6, 0x00, 0x6e, 0x51, 0x6a, 0xb3, 0xc7, 0xcc, 0x8e,
-	0x20, 0xf7, 0x01, 0xb0, 0x8b, 0xb2, 0xc3, 0x64, 0xe7, 0xe4, 0x02, 0x16, 0x52, 0x5b, 0xab, 0x06,
-	0xf1, 0x88, 0x5c, 0x00, 0xee, 0xa0, 0x47, 0x93, 0xe1, 0x4e, 0x20, 0xf6, 0x22, 0x71, 0x7e, 0x8b,
-	0xaa, 0x9a, 0xf5, 0x04, 0x4f, 0x00, 0x37, 0x35, 0x2e, 0x7e, 0x85, 0x72, 0x3c, 0xa1, 0xc2, 0xed,
-	0x78, 0xec, 0x14, 0x84, 0x7c, 0x99, 0xd3, 0x8a, 0xa9, 0x30, 0xe8, 0x97, 0xb2, 0x47, 0x09, 0x15,
-	0xdf, 0x28, 0xc5, 0x41, 0xc3, 0xc9, 0xf2, 0xf1, 0x2a, 0xc4, 0x6f, 0xd0, 0xe3, 0xc9, 0x63, 0x8e,
-	0xcd, 0x33, 0xca, 0xfc, 0xe9, 0xa0, 0x5f, 0x7a, 0x74, 0x38, 0xde, 0x30, 0x42, 0x99, 0x74, 0x6e,
-	0x04, 0xd6, 0x43, 0xf6, 0x29, 0x40, 0x02, 0xcc, 0x65, 0xf0, 0x83, 0xc7, 0x42, 0x37, 0x01, 0x16,
-	0x40, 0x2c, 0xbc, 0x36, 0xd8, 0x68, 0x01, 0x8e, 0x3f, 0xd1, 0xe8, 0x8e, 0x02, 0x6f, 0x8e, 0xb0,
-	0xe5, 0x80, 0xf0, 0x49, 0x70, 0x02, 0xc1, 0xa9, 0x3b, 0x7e, 0xc4, 0xc8, 0x85, 0xf6, 0x88, 0xc4,
-	0x21, 0x9c, 0xb9, 0x01, 0xed, 0xc6, 0xc2, 0x5e, 0x5d, 0xc0, 0x25, 0x97, 0x15, 0x51, 0x7d, 0x9a,
-	0xe7, 0x40, 0xd2, 0xd4, 0x25, 0xcb, 0xcd, 0xed, 0x26, 0xfb, 0x5f, 0xb4, 0x9b, 0xed, 0x9f, 0x96,
-	0xd1, 0xd3, 0x39, 0x33, 0x8c, 0xea, 0xb5, 0xe3, 0x41, 0x41, 0xb5, 0x03, 0xdd, 0x23, 0x72, 0x63,
-	0xf1, 0xb1, 0x6c, 0x0c, 0x3e, 0xda, 0x9c, 0x3f, 0x5d, 0x99, 0x77, 0x7f, 0xb3, 0xa2, 0xc7, 0xdd,
-	0xca, 0x70, 0xdc, 0xad, 0x1c, 0x0f, 0xc7, 0xdd, 0x5a, 0x5a, 0x3a, 0xf5, 0xf6, 0xb2, 0x64, 0x39,
-	0xf6, 0xbc, 0xa9, 0x09, 0x03, 0xca, 0x93, 0x58, 0x00, 0x03, 0x2e, 0x6e, 0xdf, 0x80, 0x67, 0x13,
-	0x22, 0x37, 0x04, 0x35, 0xf1, 0xf8, 0xd5, 0x42, 0x8f, 0x6f, 0x9c, 0xa9, 0x3e, 0x3e, 0x1a, 0x80,
-	0xf2, 0x53, 0xe3, 0x9d, 0xee, 0xa2, 0x77, 0x7d, 0x09, 0xaf, 0x8f, 0x74, 0xb5, 0xfa, 0xfb, 0x41,
-	0xd1, 0xfa, 0x30, 0x28, 0x5a, 0x7f, 0x0f, 0x8a, 0xd6, 0xdb, 0xab, 0xe2, 0xd2, 0x87, 0xab, 0xe2,
-	0xd2, 0x9f, 0x57, 0xc5, 0xa5, 0x6f, 0x9f, 0x4f, 0xe0, 0xcb, 0xfa, 0xa1, 0x9c, 0xf0, 0xdd, 0xc8,
-	0xf3, 0x79, 0x55, 0xfd, 0x46, 0x39, 0x53, 0xbf, 0x52, 0x14, 0x8d, 0xbf, 0xa2, 0x6e, 0xe3, 0xb3,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x03, 0xf9, 0x54, 0x2b, 0x0d, 0x00, 0x00,
+	// 1613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5d, 0x6b, 0x1b, 0x47,
+	0x17, 0xb6, 0x6c, 0xd9, 0x96, 0x46, 0x9f, 0x1e, 0x3b, 0xf1, 0xda, 0x79, 0x5f, 0xc9, 0xd1, 0x0b,
+	0x6f, 0x9c, 0x8b, 0x48, 0x4d, 0x0a, 0x81, 0x42, 0x69, 0x1b, 0x49, 0x24, 0x98, 0x38, 0x20, 0xd6,
+	0x86, 0xd2, 0x06, 0xba, 0xac, 0x76, 0xc7, 0xf2, 0xe0, 0xdd, 0x9d, 0xed, 0xcc, 0x48, 0xfe, 0xa0,
+	0xbf, 0xa0, 0xa5, 0x25, 0xb4, 0x37, 0xfd, 0x07, 0x85, 0x5c, 0xf7, 0x47, 0xe4, 0xa6, 0x34, 0xf4,
+	0xaa, 0xf4, 0xc2, 0x29, 0xca, 0x1f, 0x29, 0xf3, 0xb1, 0xd2, 0xea, 0xc3, 0xc5, 0x29, 0xdb, 0x1b,
+	0x5b, 0x7b, 0xce, 0x9c, 0xe7, 0x99, 0x39, 0x7b, 0xe6, 0x99, 0x39, 0x0b, 0x2a, 0x47, 0x7d, 0x7a,
+	0xde, 0x70, 0xdc, 0xb0, 0x31, 0xb8, 0xdf, 0x45, 0xdc, 0xbe, 0xdf, 0xe8, 0xa1, 0x00, 0x31, 0xcc,
+	0xea, 0x21, 0x25, 0x9c, 0xc0, 0xb2, 0xf0, 0xd7, 0x1d, 0x37, 0xac, 0x6b, 0xff, 0x76, 0xc5, 0x21,
+	0xcc, 0x27, 0xac, 0xd1, 0xb5, 0x19, 0x1a, 0x05, 0x39, 0x04, 0x07, 0x2a, 0x62, 0x7b, 0x4b, 0xf9,
+	0x2d, 0xf9, 0xd4, 0x50, 0x0f, 0xda, 0xb5, 0x3d, 0x43, 0x26, 0x80, 0x95, 0x6f, 0xa3, 0x47, 0x7a,
+	0x44, 0xc5, 0x88, 0x5f, 0xda, 0x5a, 0xe9, 0x11, 0xd2, 0xf3, 0x50, 0x43, 0x3e, 0x75, 0xfb, 0x47,
+	0x0d, 0xb7, 0x4f, 0x6d, 0x8e, 0x49, 0x44, 0x56, 0x9d, 0xf6, 0x73, 0xec, 0x23, 0xc6, 0x6d, 0x5f,
+	0xc3, 0xd6, 0x7e, 0x58, 0x01, 0xf9, 0x27, 0x6a, 0x45, 0x07, 0xdc, 0xe6, 0x08, 0x3e, 0x04, 0x2b,
+	0xa1, 0x4d, 0x6d, 0x9f, 0x19, 0xa9, 0x9d, 0xd4, 0x6e, 0xee, 0x81, 0x51, 0x9f, 0x5e, 0x61, 0xbd,
+	0x23, 0xfd, 0xcd, 0xf4, 0xab, 0xcb, 0xea, 0x82, 0xa9, 0x47, 0xc3, 0x8f, 0x41, 0xda, 0x71, 0x43,
+	0x66, 0x2c, 0xee, 0x2c, 0xed, 0xe6, 0x1e, 0xdc, 0x98, 0x8d, 0x6a, 0xb5, 0x3b, 0xcd, 0x0d, 0x11,
+	0x32, 0xbc, 0xac, 0xa6, 0x5b, 0xed, 0x0e, 0x7b, 0xf9, 0x46, 0xfd, 0x37, 0x65, 0x20, 0x7c, 0x02,
+	0x32, 0x2e, 0x0a, 0x09, 0xc3, 0x9c, 0x19, 0x4b, 0x12, 0x64, 0x6b, 0x16, 0xa4, 0xad, 0x46, 0x34,
+	0xcb, 0x02, 0xe8, 0xe5, 0x9b, 0x6a, 0x46, 0x1b, 0x98, 0x39, 0x0a, 0x86, 0x1f, 0x80, 0x12, 0xe3,
+	0x36, 0xe5, 0x38, 0xe8, 0x59, 0x8e, 0x1b, 0x5a, 0xd8, 0x35, 0xd2, 0x3b, 0xa9, 0xdd, 0x74, 0x73,
+	0x6d, 0x78, 0x59, 0x2d, 0x1c, 0x68, 0x57, 0xcb, 0x0d, 0xf7, 0xda, 0x66, 0x81, 0xc5, 0x1e, 0x5d,
+	0xf8, 0x5f, 0x00, 0x5c, 0xd4, 0xe5, 0x96, 0x8b, 0x02, 0xe2, 0x1b, 0xcb, 0x3b, 0xa9, 0xdd, 0xac,
+	0x99, 0x15, 0x96, 0xb6, 0x30, 0xc0, 0x5b, 0x20, 0xdb, 0x23, 0x03, 0xed, 0x5d, 0x91, 0xde, 0x4c,
+	0x8f, 0x0c, 0x94, 0xf3, 0x9b, 0x14, 0xb8, 0x15, 0x52, 0x34, 0xc0, 0xa4, 0xcf, 0x2c, 0xdb, 0x71,
+	0xfa, 0x7e, 0xdf, 0x93, 0xaf, 0xc2, 0x92, 0x39, 0x37, 0x56, 0xe5, 0x9a, 0xee, 0xce, 0xae, 0x49,
+	0xa7, 0xff, 0x51, 0x2c, 0xe4, 0x10, 0xfb, 0xa8, 0xb9, 0xa3, 0xd7, 0x68, 0x5c, 0x31, 0x80, 0x99,
+	0x5b, 0x11, 0xdf, 0x8c, 0x0b, 0x52, 0x50, 0xe6, 0x84, 0xdb, 0x9e, 0x15, 0x52, 0x1c, 0x38, 0x38,
+	0xb4, 0x3d, 0x66, 0x64, 0xe4, 0x0c, 0xee, 0x5c, 0x39, 0x83, 0x43, 0x11, 0xd0, 0x89, 0xc6, 0x37,
+	0x2b, 0x9a, 0xff, 0xe6, 0x5c, 0x37, 0x33, 0x4b, 0x7c, 0xd2, 0x00, 0x3f, 0x03, 0xc0, 0xef, 0x7b,
+	0x1c, 0x5b, 0xb2, 0x10, 0xb2, 0x92, 0x6d, 0x7b, 0x96, 0xed, 0x99, 0x18, 0x23, 0xaa, 0xa1, 0xa2,
+	0xab, 0x21, 0x1b, 0x59, 0x44, 0x49, 0x8c, 0x1f, 0xcc, 0xac, 0x44, 0x6b, 0x89, 0xe2, 0xf0, 0xc1,
+	0x9a, 0x87, 0xbf, 0xec, 0x63, 0x57, 0x65, 0x94, 0x71, 0x9b, 0x33, 0x03, 0x48, 0x86, 0xda, 0x2c,
+	0xc3, 0xfe, 0x78, 0xa8, 0x28, 0x6a, 0xd6, 0xfc, 0x8f, 0x5e, 0xca, 0xc6, 0xb4, 0x67, 0x1f, 0x33,
+	0x6e, 0x96, 0xbd, 0x29, 0x6b, 0xed, 0xbb, 0x55, 0xb0, 0xa2, 0xaa, 0x1c, 0x1e, 0x83, 0x35, 0x87,
+	0x78, 0x9e, 0xcd, 0x11, 0x15, 0xd9, 0x8c, 0xb6, 0x86, 0x60, 0xbe, 0x3d, 0xa7, 0xc8, 0x47, 0x43,
+	0x65, 0x78, 0xd3, 0xd0, 0xc4, 0xe5, 0x29, 0x07, 0x33, 0xcb, 0xce, 0x94, 0x05, 0x7e, 0xa2, 0x8b,
+	0x4f, 0x72, 0x18, 0x8b, 0x72, 0xf7, 0xdd, 0x9a, 0xb7, 0x05, 0xba, 0x5c, 0x81, 0xab, 0x0d, 0x98,
+	0x75, 0x23, 0x03, 0x7c, 0x0a, 0xd6, 0x7a, 0x1e, 0xe9, 0xda, 0x9e, 0x25, 0x81, 0x3c, 0xec, 0x63,
+	0x6e, 0x2c, 0x49, 0xa0, 0xad, 0xba, 0x56, 0x1a, 0x21, 0x4b, 0xb1, 0xe9, 0xe2, 0x40, 0xc3, 0x94,
+	0x54, 0xa4, 0x40, 0xdf, 0x17, 0x71, 0xf0, 0x0c, 0x6c, 0xb1, 0x3e, 0x0d, 0x3d, 0x51, 0xcd, 0x7d,
+	0x47, 0x15, 0xf2, 0x31, 0x45, 0xec, 0x98, 0x78, 0x6a, 0x43, 0x65, 0x9b, 0x1f, 0x8a, 0xc8, 0x3f,
+	0x2e, 0xab, 0xff, 0xef, 0x61, 0x7e, 0xdc, 0xef, 0xd6, 0x1d, 0xe2, 0x6b, 0x41, 0xd3, 0xff, 0xee,
+	0x31, 0xf7, 0xa4, 0xc1, 0xcf, 0x43, 0xc4, 0xea, 0x7b, 0x01, 0xff, 0xed, 0xe7, 0x7b, 0x40, 0xcf,
+	0x62, 0x2f, 0xe0, 0xe6, 0xa6, 0x86, 0x7f, 0xa4, 0xd0, 0x0f, 0x23, 0x70, 0xe8, 0x81, 0xf5, 0x69,
+	0x66, 0x8f, 0x70, 0x63, 0x39, 0x01, 0xce, 0xb5, 0x49, 0xce, 0x7d, 0xc2, 0x21, 0x05, 0x37, 0x65,
+	0xb6, 0x66, 0x17, 0xb9, 0x92, 0x00, 0xe1, 0x86, 0xc0, 0x9e, 0x59, 0xe1, 0x11, 0x28, 0x4f, 0x70,
+	0x8a, 0xe5, 0xad, 0x26, 0xc0, 0x56, 0x8c, 0xb1, 0x89, 0xb5, 0xdd, 0x01, 0x25, 0x07, 0x53, 0xa7,
+	0x8f, 0xb9, 0xd5, 0xa5, 0xc8, 0x3e, 0x41, 0xd4, 0xc8, 0xec, 0xa4, 0x76, 0x33, 0x66, 0x51, 0x9b,
+	0x9b, 0xca, 0x0a, 0x3b, 0x60, 0x63, 0xb4, 0x75, 0xe3, 0xc5, 0x93, 0xbd, 0x5e, 0xf1, 0xac, 0x45,
+	0x3b, 0x75, 0x5c, 0x3e, 0xfb, 0xa0, 0x18, 0xa2, 0x9e, 0xe5, 0x90, 0x80, 0x53, 0xe2, 0x79, 0x88,
+	0x1a, 0x40, 0x62, 0x55, 0xe7, 0x9c, 0x27, 0xa8, 0xd7, 0x1a, 0x0d, 0xd3, 0x88, 0x85, 0x30, 0x6e,
+	0xac, 0xfd, 0xba, 0x04, 0x0a, 0x13, 0xc3, 0xe0, 0x5d, 0x90, 0xf5, 0x6d, 0x7a, 0x82, 0xb8, 0xd0,
+	0xf7, 0x94, 0xcc, 0x5d, 0x7e, 0x78, 0x59, 0xcd, 0x3c, 0x93, 0xc6, 0xbd, 0xb6, 0x99, 0x51, 0xee,
+	0x3d, 0x17, 0x5a, 0x20, 0xcf, 0x6d, 0xda, 0x43, 0x5c, 0x88, 0xa1, 0x83, 0x8c, 0xc5, 0x77, 0xce,
+	0x74, 0x1b, 0x39, 0xb1, 0x4c, 0xb7, 0x91, 0x63, 0xe6, 0x14, 0x62, 0x47, 0x00, 0xc2, 0x2f, 0x40,
+	0x8e, 0xa1, 0x80, 0x61, 0x8e, 0x07, 0x98, 0x9f, 0x1b, 0x4b, 0x49, 0xe0, 0xc7, 0x00, 0x85, 0x06,
+	0xf9, 0x58, 0xaa, 0x5e, 0x17, 0x7b, 0x98, 0x9f, 0x5b, 0x47, 0x08, 0x19, 0xe9, 0x04, 0x58, 0x4a,
+	0x3e, 0x0e, 0x0e, 0x22, 0xd4, 0xc7, 0x08, 0x49, 0x26, 0xfb, 0x6c, 0x8a, 0x69, 0x39, 0x11, 0x26,
+	0xfb, 0x2c, 0xce, 0x54, 0xfb, 0x7e, 0x11, 0x64, 0x47, 0x52, 0x06, 0x37, 0xc0, 0xb2, 0x3a, 0x55,
+	0xe5, 0x9b, 0x34, 0xd5, 0x83, 0x28, 0x5f, 0x8a, 0x8e, 0x10, 0x45, 0x81, 0x83, 0x2c, 0x9b, 0x31,
+	0xc4, 0xd5, 0xbb, 0x33, 0x8b, 0x23, 0xf3, 0x23, 0x61, 0x85, 0x58, 0x88, 0x74, 0x30, 0x40, 0x94,
+	0x89, 0xdd, 0x74, 0x64, 0x3b, 0x9c, 0x50, 0x63, 0x29, 0x81, 0x0d, 0x55, 0x1e, 0xc3, 0x3e, 0x96,
+	0xa8, 0xf0, 0xb9, 0x56, 0xe9, 0x23, 0x8f, 0x10, 0x9a, 0x88, 0x0e, 0x4a, 0x01, 0x7f, 0x2c, 0xe0,
+	0x6a, 0xbf, 0xe4, 0x40, 0x69, 0xea, 0xa4, 0xb8, 0x22, 0x35, 0x10, 0xa4, 0x05, 0x9e, 0xce, 0x87,
+	0xfc, 0x2d, 0xb2, 0x10, 0x3f, 0x24, 0xe5, 0x45, 0x30, 0x91, 0x62, 0x8c, 0x1f, 0x90, 0xa6, 0xf8,
+	0x0b, 0x3f, 0x02, 0x20, 0xa6, 0x12, 0xe9, 0xeb, 0xa9, 0x44, 0xd6, 0x1d, 0xa9, 0x83, 0x0d, 0x0a,
+	0xc9, 0xd7, 0x58, 0x9e, 0xc5, 0x4b, 0xd9, 0x02, 0xf9, 0x48, 0x5e, 0x19, 0xbe, 0x40, 0x89, 0xa8,
+	0x79, 0x4e, 0x23, 0x1e, 0xe0, 0x0b, 0x04, 0x7d, 0xb0, 0x1e, 0x4f, 0x77, 0x88, 0x02, 0xdb, 0xe3,
+	0xe7, 0xc6, 0x6a, 0x02, 0x2b, 0x81, 0x31, 0xe0, 0x8e, 0xc2, 0x85, 0x0f, 0x41, 0x91, 0x85, 0x84,
+	0x5b, 0x63, 0xd5, 0xcb, 0x48, 0xa6, 0xf2, 0xf0, 0xb2, 0x9a, 0x3f, 0x08, 0x09, 0x1f, 0x29, 0x5f,
+	0x9e, 0x8d, 0x9f, 0x5c, 0xf8, 0x14, 0xdc, 0x88, 0x4f, 0x73, 0x1c, 0x9e, 0x95, 0xe1, 0x9b, 0xc3,
+	0xcb, 0xea, 0x7a, 0xec, 0x5a, 0x34, 0x42, 0x59, 0xf7, 0x66, 0x8c, 0x2e, 0x1c, 0x00, 0xe3, 0x04,
+	0xa1, 0x10, 0x51, 0x8b, 0xa2, 0x53, 0x9b, 0xba, 0x56, 0x88, 0xa8, 0x83, 0x02, 0x6e, 0xf7, 0x90,
+	0x01, 0x12, 0x58, 0xf8, 0x4d, 0x85, 0x6e, 0x4a, 0xf0, 0xce, 0x08, 0x5b, 0x5c, 0xae, 0xff, 0xe7,
+	0x1c, 0x23, 0xe7, 0xc4, 0x1a, 0x5f, 0x9b, 0xf0, 0x85, 0x5a, 0x11, 0x0e, 0x5c, 0x74, 0x66, 0x39,
+	0xa4, 0x1f, 0x70, 0x23, 0x97, 0xc0, 0x4b, 0xde, 0x91, 0x44, 0xad, 0x69, 0x9e, 0x3d, 0x41, 0xd3,
+	0x12, 0x2c, 0xf3, 0xe5, 0x26, 0xff, 0xaf, 0xc8, 0x8d, 0x05, 0xf2, 0x8e, 0x47, 0x18, 0x8a, 0x58,
+	0x0a, 0x49, 0x9c, 0x2d, 0x12, 0x51, 0x13, 0x0c, 0x80, 0x11, 0x2f, 0x0f, 0x7d, 0x50, 0x2a, 0xed,
+	0x28, 0x26, 0xf1, 0x46, 0x63, 0xe8, 0x87, 0x12, 0x5c, 0x29, 0xc8, 0xed, 0xf1, 0xf6, 0x94, 0x42,
+	0x56, 0x92, 0x42, 0x16, 0x6d, 0xb0, 0x43, 0xa1, 0x67, 0x0e, 0x28, 0x52, 0xe4, 0x22, 0x3f, 0x94,
+	0xa3, 0x84, 0x4a, 0x94, 0x13, 0x98, 0x50, 0x61, 0x8c, 0x29, 0x64, 0xe2, 0x39, 0xd8, 0x9c, 0x58,
+	0xff, 0xa9, 0x1d, 0x5a, 0xa7, 0x38, 0x70, 0xc9, 0xa9, 0xb1, 0xa6, 0x65, 0x4d, 0xf5, 0xd0, 0xf5,
+	0xa8, 0x87, 0xae, 0xb7, 0x75, 0x8f, 0xdd, 0xcc, 0x88, 0x89, 0xfc, 0xf8, 0xa6, 0x9a, 0x32, 0xe3,
+	0x5b, 0xec, 0xf0, 0xd4, 0x0e, 0x3f, 0x95, 0x08, 0xb5, 0x6f, 0x17, 0xc1, 0xe6, 0x15, 0xdd, 0x9b,
+	0xbc, 0x9b, 0x8d, 0x1b, 0x0b, 0x99, 0x03, 0xa5, 0xf0, 0xc5, 0xb1, 0x59, 0xa6, 0xa1, 0x0b, 0xb6,
+	0xaf, 0xee, 0x2b, 0x75, 0x9f, 0xb0, 0x3d, 0x33, 0xc9, 0xc3, 0xa8, 0xd1, 0x57, 0xb3, 0x7c, 0x21,
+	0x66, 0x69, 0x5c, 0xd5, 0x2f, 0x42, 0x04, 0x4a, 0x38, 0xe0, 0x88, 0x22, 0xc6, 0xff, 0xf9, 0xf1,
+	0x39, 0x9b, 0xeb, 0x62, 0x04, 0xaa, 0x8a, 0xad, 0xf6, 0x53, 0x0a, 0xdc, 0x98, 0xdb, 0x4d, 0x5e,
+	0x3f, 0x1b, 0x08, 0x94, 0xa6, 0x1a, 0x5b, 0x63, 0x31, 0x81, 0x9d, 0x57, 0x9c, 0x6c, 0x66, 0x6b,
+	0x5f, 0x2f, 0x83, 0xf2, 0x74, 0xb3, 0x78, 0xfd, 0x49, 0x3e, 0x01, 0x79, 0x55, 0x43, 0x96, 0xfc,
+	0xbe, 0xf0, 0x4e, 0x2f, 0x29, 0xa7, 0x22, 0xe5, 0x77, 0x0a, 0x58, 0x03, 0xf9, 0x58, 0x65, 0x31,
+	0xf9, 0x52, 0xd2, 0xe6, 0x84, 0x0d, 0x9e, 0x4d, 0x74, 0xa8, 0x0c, 0xe1, 0x0b, 0x24, 0x1a, 0xb4,
+	0xa5, 0xbf, 0x3f, 0x92, 0xdf, 0xd3, 0x9d, 0xe9, 0xee, 0x35, 0xd2, 0x25, 0x02, 0x26, 0x3a, 0xd6,
+	0x03, 0x49, 0x02, 0x39, 0x28, 0xe9, 0x5b, 0x80, 0x9a, 0x0e, 0x72, 0x8d, 0xe5, 0xe4, 0x79, 0x8b,
+	0xea, 0xda, 0x10, 0x51, 0xc0, 0xaf, 0xe6, 0x9f, 0xbb, 0x2b, 0xc9, 0x33, 0xcf, 0x3b, 0x86, 0x29,
+	0x28, 0x4e, 0x9c, 0x80, 0xd1, 0x87, 0x9d, 0x44, 0x89, 0x0b, 0xf1, 0x63, 0x90, 0x35, 0x5b, 0xaf,
+	0x86, 0x95, 0xd4, 0xeb, 0x61, 0x25, 0xf5, 0xe7, 0xb0, 0x92, 0x7a, 0xf1, 0xb6, 0xb2, 0xf0, 0xfa,
+	0x6d, 0x65, 0xe1, 0xf7, 0xb7, 0x95, 0x85, 0xcf, 0xef, 0xc6, 0x20, 0xc5, 0x51, 0x4c, 0x18, 0x66,
+	0xf7, 0x3c, 0xbb, 0xcb, 0x1a, 0xf2, 0x53, 0xe2, 0x99, 0xfc, 0x98, 0x28, 0x91, 0xbb, 0x2b, 0xb2,
+	0xea, 0xde, 0xff, 0x6b, 0x00, 0x72, 0x91, 0xa3, 0x95, 0xd2, 0x14, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -553,6 +799,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LiquidationStats) > 0 {
+		for iNdEx := len(m.LiquidationStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MultiCDPs) > 0 {
+		for iNdEx := len(m.MultiCDPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiCDPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -661,6 +935,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PegController.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.MultiCdpDebtLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.CircuitBreaker {
 		i--
 		if m.CircuitBreaker {
@@ -748,6 +1042,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PegController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PegController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PegController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxStabilityFee.Size()
+		i -= size
+		if _, err := m.MaxStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinStabilityFee.Size()
+		i -= size
+		if _, err := m.MinStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Sensitivity.Size()
+		i -= size
+		if _, err := m.Sensitivity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetPrice.Size()
+		i -= size
+		if _, err := m.TargetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DebtParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -825,6 +1189,55 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LiquidationTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LiquidationTwapWindow):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.RedemptionFee.Size()
+		i -= size
+		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.LiquidationTargetRatio.Size()
+		i -= size
+		if _, err := m.LiquidationTargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -966,12 +1379,12 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
@@ -1024,19 +1437,118 @@ func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LiquidationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
+
+func (m *LiquidationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeeperRewards) > 0 {
+		for iNdEx := len(m.KeeperRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeeperRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LiquidationPenalty) > 0 {
+		for iNdEx := len(m.LiquidationPenalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationPenalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DebtLiquidated) > 0 {
+		for iNdEx := len(m.DebtLiquidated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DebtLiquidated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CollateralSeized) > 0 {
+		for iNdEx := len(m.CollateralSeized) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralSeized[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Liquidations != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Liquidations))
+		i--
+		dAtA[i] = 0x18
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1078,6 +1590,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MultiCDPs) > 0 {
+		for _, e := range m.MultiCDPs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidationStats) > 0 {
+		for _, e := range m.LiquidationStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1108,6 +1632,31 @@ func (m *Params) Size() (n int) {
 	if m.CircuitBreaker {
 		n += 2
 	}
+	l = m.MultiCdpDebtLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PegController.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PegController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TargetPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Sensitivity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinStabilityFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxStabilityFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1170,6 +1719,18 @@ func (m *CollateralParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LiquidationTargetRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RedemptionFee.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LiquidationTwapWindow)
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1205,6 +1766,48 @@ func (m *GenesisTotalPrincipal) Size() (n int) {
 	return n
 }
 
+func (m *LiquidationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Liquidations != 0 {
+		n += 1 + sovGenesis(uint64(m.Liquidations))
+	}
+	if len(m.CollateralSeized) > 0 {
+		for _, e := range m.CollateralSeized {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DebtLiquidated) > 0 {
+		for _, e := range m.DebtLiquidated {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidationPenalty) > 0 {
+		for _, e := range m.LiquidationPenalty {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeeperRewards) > 0 {
+		for _, e := range m.KeeperRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1492,6 +2095,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCDPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCDPs = append(m.MultiCDPs, MultiCDP{})
+			if err := m.MultiCDPs[len(m.MultiCDPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationStats = append(m.LiquidationStats, LiquidationStats{})
+			if err := m.LiquidationStats[len(m.LiquidationStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1798,61 +2469,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CircuitBreaker = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DebtParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DebtParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebtParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCdpDebtLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1862,95 +2483,30 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceAsset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReferenceAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MultiCdpDebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtFloor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PegController", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1960,23 +2516,22 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PegController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2001,7 +2556,7 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollateralParam) Unmarshal(dAtA []byte) error {
+func (m *PegController) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,15 +2579,15 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollateralParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: PegController: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollateralParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PegController: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2060,11 +2615,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,11 +2647,13 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitivity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2124,15 +2681,15 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sensitivity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilityFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2142,28 +2699,29 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStabilityFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,13 +2749,63 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebtParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebtParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebtParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2225,13 +2833,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuctionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceAsset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2259,13 +2865,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReferenceAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2293,11 +2897,13 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtFloor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2325,11 +2931,63 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidationMarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.DebtFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2357,13 +3015,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckCollateralizationIndexCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2391,9 +3047,308 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CheckCollateralizationIndexCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckCollateralizationIndexCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CheckCollateralizationIndexCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
@@ -2418,14 +3373,181 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTargetRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationTargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LiquidationTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2715,6 +3837,276 @@ func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LiquidationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			}
+			m.Liquidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Liquidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralSeized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralSeized = append(m.CollateralSeized, types.Coin{})
+			if err := m.CollateralSeized[len(m.CollateralSeized)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLiquidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtLiquidated = append(m.DebtLiquidated, types.Coin{})
+			if err := m.DebtLiquidated[len(m.DebtLiquidated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationPenalty = append(m.LiquidationPenalty, types.Coin{})
+			if err := m.LiquidationPenalty[len(m.LiquidationPenalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeeperRewards = append(m.KeeperRewards, types.Coin{})
+			if err := m.KeeperRewards[len(m.KeeperRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
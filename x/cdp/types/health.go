@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCDPHealthResponse creates a new CDPHealthResponse object, a nil health factor is returned as an empty string
+func NewCDPHealthResponse(id uint64, owner sdk.AccAddress, collateralType string, healthFactor sdk.Dec,
+	liquidationPrices LiquidationPrices, maxDraw sdk.Coin, maxWithdraw TypedCollaterals,
+) CDPHealthResponse {
+	health := CDPHealthResponse{
+		ID:                id,
+		Owner:             owner.String(),
+		Type:              collateralType,
+		LiquidationPrices: liquidationPrices,
+		MaxDraw:           maxDraw,
+		MaxWithdraw:       maxWithdraw,
+	}
+	if !healthFactor.IsNil() {
+		health.HealthFactor = healthFactor.String()
+	}
+	return health
+}
+
+// CDPHealthResponses a collection of CDPHealthResponse objects
+type CDPHealthResponses []CDPHealthResponse
+
+// NewLiquidationPrice creates a new LiquidationPrice object
+func NewLiquidationPrice(collateralType string, price sdk.Dec) LiquidationPrice {
+	return LiquidationPrice{
+		CollateralType: collateralType,
+		Price:          price.String(),
+	}
+}
+
+// LiquidationPrices a collection of LiquidationPrice objects
+type LiquidationPrices []LiquidationPrice
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -45,6 +46,14 @@ var sep = []byte(":")
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
 // - 0x10:totalDistributed
+// - 0x14<cdpID_Bytes>: MultiCDP
+// - 0x15<cdpOwner_Bytes>: cdpID
+//    - One cdp owner can control one multi-collateral cdp
+// - 0x16<collateralType>: multiCdpTotalPrincipal
+//    - the debt of multi-collateral cdps is totalled by the collateral type it is charged to
+// - 0x17<collateralDenomPrefix>:<collateralDebtRatio_Bytes>:<cdpID_Bytes>: cdpID
+//    - each collateral of a multi-collateral cdp is indexed by the ratio of that collateral to the cdp's debt
+// - 0x18<windowStart_Bytes><collateralType>: LiquidationStats
 
 // KVStore key prefixes
 var (
@@ -59,6 +68,11 @@ var (
 	PricefeedStatusKeyPrefix   = []byte{0x10}
 	PreviousAccrualTimePrefix  = []byte{0x12}
 	InterestFactorPrefix       = []byte{0x13}
+	MultiCdpKeyPrefix          = []byte{0x14}
+	MultiCdpOwnerKeyPrefix     = []byte{0x15}
+	MultiCdpPrincipalPrefix    = []byte{0x16}
+	MultiCdpRatioIndexPrefix   = []byte{0x17}
+	LiquidationStatsKeyPrefix  = []byte{0x18}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -154,6 +168,11 @@ func CollateralRatioIterKey(collateralType string, ratio sdk.Dec) []byte {
 	return createKey([]byte(collateralType), sep, ratioBytes)
 }
 
+// CollateralRatioTypePrefix returns the prefix of the collateral ratio index keys of a collateral type
+func CollateralRatioTypePrefix(collateralType string) []byte {
+	return createKey([]byte(collateralType), sep)
+}
+
 // SplitCollateralRatioIterKey split the collateral ratio key and return the denom, cdp id, and collateral:debt ratio
 func SplitCollateralRatioIterKey(key []byte) (string, sdk.Dec) {
 	split := bytes.Split(key, sep)
@@ -166,6 +185,16 @@ func SplitCollateralRatioIterKey(key []byte) (string, sdk.Dec) {
 	return collateralType, ratio
 }
 
+// LiquidationStatsKey key of the liquidation stats of a collateral type for the window starting at windowStart
+func LiquidationStatsKey(windowStart time.Time, collateralType string) []byte {
+	return createKey(sdk.FormatTimeBytes(windowStart), []byte(collateralType))
+}
+
+// MultiCdpPrincipalKey key of the total principal of the multi-collateral cdps charged to a collateral type
+func MultiCdpPrincipalKey(collateralType string) []byte {
+	return []byte(collateralType)
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
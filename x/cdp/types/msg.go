@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -18,6 +19,14 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgCreateMultiCDP{}
+	_ sdk.Msg = &MsgDepositMultiCDP{}
+	_ sdk.Msg = &MsgWithdrawMultiCDP{}
+	_ sdk.Msg = &MsgDrawMultiCDPDebt{}
+	_ sdk.Msg = &MsgRepayMultiCDPDebt{}
+	_ sdk.Msg = &MsgLiquidateMultiCDP{}
+	_ sdk.Msg = &MsgRedeemDebt{}
+	_ sdk.Msg = &MsgAdjustCDP{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -310,3 +319,371 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgCreateMultiCDP returns a new MsgCreateMultiCDP.
+func NewMsgCreateMultiCDP(sender sdk.AccAddress, collateral TypedCollaterals, principal sdk.Coin) MsgCreateMultiCDP {
+	return MsgCreateMultiCDP{
+		Sender:     sender.String(),
+		Collateral: collateral,
+		Principal:  principal,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCreateMultiCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCreateMultiCDP) Type() string { return "create_multi_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCreateMultiCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if len(msg.Collateral) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "collateral cannot be empty")
+	}
+	if err := msg.Collateral.Validate(); err != nil {
+		return err
+	}
+	for _, tc := range msg.Collateral {
+		if tc.Amount.IsZero() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", tc.Amount)
+		}
+	}
+	if msg.Principal.IsZero() || !msg.Principal.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal amount %s", msg.Principal)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCreateMultiCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCreateMultiCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDepositMultiCDP returns a new MsgDepositMultiCDP
+func NewMsgDepositMultiCDP(sender sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgDepositMultiCDP {
+	return MsgDepositMultiCDP{
+		Sender:         sender.String(),
+		Collateral:     collateral,
+		CollateralType: collateralType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositMultiCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositMultiCDP) Type() string { return "deposit_multi_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositMultiCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", msg.Collateral)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositMultiCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositMultiCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgWithdrawMultiCDP returns a new MsgWithdrawMultiCDP
+func NewMsgWithdrawMultiCDP(sender sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgWithdrawMultiCDP {
+	return MsgWithdrawMultiCDP{
+		Sender:         sender.String(),
+		Collateral:     collateral,
+		CollateralType: collateralType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawMultiCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawMultiCDP) Type() string { return "withdraw_multi_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawMultiCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", msg.Collateral)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawMultiCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawMultiCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDrawMultiCDPDebt returns a new MsgDrawMultiCDPDebt
+func NewMsgDrawMultiCDPDebt(sender sdk.AccAddress, principal sdk.Coin) MsgDrawMultiCDPDebt {
+	return MsgDrawMultiCDPDebt{
+		Sender:    sender.String(),
+		Principal: principal,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDrawMultiCDPDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDrawMultiCDPDebt) Type() string { return "draw_multi_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDrawMultiCDPDebt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Principal.IsZero() || !msg.Principal.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal amount %s", msg.Principal)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDrawMultiCDPDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDrawMultiCDPDebt) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRepayMultiCDPDebt returns a new MsgRepayMultiCDPDebt
+func NewMsgRepayMultiCDPDebt(sender sdk.AccAddress, payment sdk.Coin) MsgRepayMultiCDPDebt {
+	return MsgRepayMultiCDPDebt{
+		Sender:  sender.String(),
+		Payment: payment,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRepayMultiCDPDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRepayMultiCDPDebt) Type() string { return "repay_multi_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRepayMultiCDPDebt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Payment.IsZero() || !msg.Payment.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment amount %s", msg.Payment)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRepayMultiCDPDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRepayMultiCDPDebt) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgLiquidateMultiCDP returns a new MsgLiquidateMultiCDP
+func NewMsgLiquidateMultiCDP(keeper, borrower sdk.AccAddress) MsgLiquidateMultiCDP {
+	return MsgLiquidateMultiCDP{
+		Keeper:   keeper.String(),
+		Borrower: borrower.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidateMultiCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidateMultiCDP) Type() string { return "liquidate_multi_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidateMultiCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid keeper address %s", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidateMultiCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidateMultiCDP) GetSigners() []sdk.AccAddress {
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{keeper}
+}
+
+// NewMsgRedeemDebt returns a new MsgRedeemDebt
+func NewMsgRedeemDebt(sender sdk.AccAddress, collateralType string, amount sdk.Coin) MsgRedeemDebt {
+	return MsgRedeemDebt{
+		Sender:         sender.String(),
+		CollateralType: collateralType,
+		Amount:         amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemDebt) Type() string { return "redeem_debt" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemDebt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "redemption amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemDebt) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgAdjustCDP returns a new MsgAdjustCDP
+func NewMsgAdjustCDP(sender sdk.AccAddress, collateralType string, collateralDelta, principalDelta sdkmath.Int) MsgAdjustCDP {
+	return MsgAdjustCDP{
+		Sender:          sender.String(),
+		CollateralType:  collateralType,
+		CollateralDelta: collateralDelta,
+		PrincipalDelta:  principalDelta,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgAdjustCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgAdjustCDP) Type() string { return "adjust_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgAdjustCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	if msg.CollateralDelta.IsNil() || msg.PrincipalDelta.IsNil() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "collateral and principal deltas must be set")
+	}
+	if msg.CollateralDelta.IsZero() && msg.PrincipalDelta.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "collateral and principal deltas cannot both be zero")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgAdjustCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgAdjustCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -167,3 +168,147 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCreateMultiCDP(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		collateral  TypedCollaterals
+		principal   sdk.Coin
+		expectPass  bool
+	}{
+		{"create multi cdp", addrs[0], TypedCollaterals{NewTypedCollateral("type-a", coinsSingle), NewTypedCollateral("type-b", coinsSingle)}, coinsSingle, true},
+		{"create multi cdp no collateral", addrs[0], TypedCollaterals{}, coinsSingle, false},
+		{"create multi cdp zero collateral", addrs[0], TypedCollaterals{NewTypedCollateral("type-a", coinsZero)}, coinsSingle, false},
+		{"create multi cdp duplicate type", addrs[0], TypedCollaterals{NewTypedCollateral("type-a", coinsSingle), NewTypedCollateral("type-a", coinsSingle)}, coinsSingle, false},
+		{"create multi cdp empty type", addrs[0], TypedCollaterals{NewTypedCollateral("", coinsSingle)}, coinsSingle, false},
+		{"create multi cdp no debt", addrs[0], TypedCollaterals{NewTypedCollateral("type-a", coinsSingle)}, coinsZero, false},
+		{"create multi cdp empty owner", sdk.AccAddress{}, TypedCollaterals{NewTypedCollateral("type-a", coinsSingle)}, coinsSingle, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCreateMultiCDP(
+			tc.sender,
+			tc.collateral,
+			tc.principal,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgDepositMultiCDP(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		collateral     sdk.Coin
+		collateralType string
+		expectPass     bool
+	}{
+		{"deposit", addrs[0], coinsSingle, "type-a", true},
+		{"deposit empty sender", sdk.AccAddress{}, coinsSingle, "type-a", false},
+		{"deposit no collateral", addrs[0], coinsZero, "type-a", false},
+		{"deposit empty type", addrs[0], coinsSingle, "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgDepositMultiCDP(
+			tc.sender,
+			tc.collateral,
+			tc.collateralType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgLiquidateMultiCDP(t *testing.T) {
+	tests := []struct {
+		description string
+		keeper      sdk.AccAddress
+		borrower    sdk.AccAddress
+		expectPass  bool
+	}{
+		{"liquidate", addrs[0], addrs[1], true},
+		{"liquidate empty keeper", sdk.AccAddress{}, addrs[1], false},
+		{"liquidate empty borrower", addrs[0], sdk.AccAddress{}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgLiquidateMultiCDP(
+			tc.keeper,
+			tc.borrower,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgRedeemDebt(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		ctype       string
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"redeem debt", addrs[0], "bnb-a", coinsSingle, true},
+		{"redeem debt no amount", addrs[0], "bnb-a", coinsZero, false},
+		{"redeem debt empty sender", sdk.AccAddress{}, "bnb-a", coinsSingle, false},
+		{"redeem debt empty collateral type", addrs[0], "", coinsSingle, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemDebt(
+			tc.sender,
+			tc.ctype,
+			tc.amount,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgAdjustCDP(t *testing.T) {
+	tests := []struct {
+		description     string
+		sender          sdk.AccAddress
+		ctype           string
+		collateralDelta sdkmath.Int
+		principalDelta  sdkmath.Int
+		expectPass      bool
+	}{
+		{"adjust cdp", addrs[0], "bnb-a", sdkmath.NewInt(-100), sdkmath.NewInt(100), true},
+		{"adjust cdp collateral only", addrs[0], "bnb-a", sdkmath.NewInt(100), sdkmath.ZeroInt(), true},
+		{"adjust cdp no change", addrs[0], "bnb-a", sdkmath.ZeroInt(), sdkmath.ZeroInt(), false},
+		{"adjust cdp nil delta", addrs[0], "bnb-a", sdkmath.Int{}, sdkmath.NewInt(100), false},
+		{"adjust cdp empty sender", sdk.AccAddress{}, "bnb-a", sdkmath.NewInt(100), sdkmath.NewInt(100), false},
+		{"adjust cdp empty collateral type", addrs[0], "", sdkmath.NewInt(100), sdkmath.NewInt(100), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgAdjustCDP(
+			tc.sender,
+			tc.ctype,
+			tc.collateralDelta,
+			tc.principalDelta,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
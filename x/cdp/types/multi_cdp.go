@@ -0,0 +1,176 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTypedCollateral returns a new TypedCollateral
+func NewTypedCollateral(collateralType string, amount sdk.Coin) TypedCollateral {
+	return TypedCollateral{
+		CollateralType: collateralType,
+		Amount:         amount,
+	}
+}
+
+// Validate performs a basic validation of the typed collateral fields.
+func (tc TypedCollateral) Validate() error {
+	if strings.TrimSpace(tc.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	if !tc.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral %s", tc.Amount)
+	}
+	return nil
+}
+
+// TypedCollaterals a collection of TypedCollateral objects
+type TypedCollaterals []TypedCollateral
+
+// Validate validates each typed collateral and checks that no collateral type appears twice
+func (tcs TypedCollaterals) Validate() error {
+	seenTypes := make(map[string]bool)
+	for _, tc := range tcs {
+		if err := tc.Validate(); err != nil {
+			return err
+		}
+		if seenTypes[tc.CollateralType] {
+			return fmt.Errorf("duplicate collateral type %s", tc.CollateralType)
+		}
+		seenTypes[tc.CollateralType] = true
+	}
+	return nil
+}
+
+// AmountOf returns the collateral held for the input collateral type, and whether it was found
+func (tcs TypedCollaterals) AmountOf(collateralType string) (sdk.Coin, bool) {
+	for _, tc := range tcs {
+		if tc.CollateralType == collateralType {
+			return tc.Amount, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
+// Add returns the collateral with the input amount added to the input collateral type.
+// A new entry is appended if the collateral type is not yet present.
+func (tcs TypedCollaterals) Add(collateralType string, amount sdk.Coin) TypedCollaterals {
+	updated := make(TypedCollaterals, 0, len(tcs)+1)
+	found := false
+	for _, tc := range tcs {
+		if tc.CollateralType == collateralType {
+			tc.Amount = tc.Amount.Add(amount)
+			found = true
+		}
+		updated = append(updated, tc)
+	}
+	if !found {
+		updated = append(updated, NewTypedCollateral(collateralType, amount))
+	}
+	return updated
+}
+
+// Sub returns the collateral with the input amount removed from the input collateral type.
+// Entries that are reduced to zero are removed.
+// CONTRACT: the collateral type must be present and hold at least the input amount.
+func (tcs TypedCollaterals) Sub(collateralType string, amount sdk.Coin) TypedCollaterals {
+	updated := make(TypedCollaterals, 0, len(tcs))
+	for _, tc := range tcs {
+		if tc.CollateralType == collateralType {
+			tc.Amount = tc.Amount.Sub(amount)
+			if tc.Amount.IsZero() {
+				continue
+			}
+		}
+		updated = append(updated, tc)
+	}
+	return updated
+}
+
+// NewMultiCDP creates a new MultiCDP object
+func NewMultiCDP(id uint64, owner sdk.AccAddress, collateral TypedCollaterals, collateralType string, principal sdk.Coin, time time.Time, interestFactor sdk.Dec) MultiCDP {
+	fees := sdk.NewCoin(principal.Denom, sdk.ZeroInt())
+	return MultiCDP{
+		ID:              id,
+		Owner:           owner,
+		Type:            collateralType,
+		Collateral:      collateral,
+		Principal:       principal,
+		AccumulatedFees: fees,
+		FeesUpdated:     time,
+		InterestFactor:  interestFactor,
+	}
+}
+
+// Validate performs a basic validation of the MultiCDP fields.
+func (cdp MultiCDP) Validate() error {
+	if cdp.ID == 0 {
+		return errors.New("cdp id cannot be 0")
+	}
+	if cdp.Owner.Empty() {
+		return errors.New("cdp owner cannot be empty")
+	}
+	if len(cdp.Collateral) == 0 {
+		return errors.New("cdp collateral cannot be empty")
+	}
+	if err := cdp.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !cdp.Principal.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal %s", cdp.Principal)
+	}
+	if !cdp.AccumulatedFees.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "accumulated fees %s", cdp.AccumulatedFees)
+	}
+	if cdp.FeesUpdated.Unix() <= 0 {
+		return errors.New("cdp updated fee time cannot be zero")
+	}
+	if strings.TrimSpace(cdp.Type) == "" {
+		return fmt.Errorf("cdp type cannot be empty")
+	}
+	return nil
+}
+
+// GetTotalPrincipal returns the total principle for the cdp
+func (cdp MultiCDP) GetTotalPrincipal() sdk.Coin {
+	return cdp.Principal.Add(cdp.AccumulatedFees)
+}
+
+// MultiCDPs a collection of MultiCDP objects
+type MultiCDPs []MultiCDP
+
+// Validate validates each MultiCDP
+func (cdps MultiCDPs) Validate() error {
+	for _, cdp := range cdps {
+		if err := cdp.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMultiCDPResponse creates a new MultiCDPResponse object
+func NewMultiCDPResponse(cdp MultiCDP, collateralValue sdk.Coin, collateralizationRatio, liquidationRatio sdk.Dec) MultiCDPResponse {
+	return MultiCDPResponse{
+		ID:                     cdp.ID,
+		Owner:                  cdp.Owner.String(),
+		Type:                   cdp.Type,
+		Collateral:             cdp.Collateral,
+		Principal:              cdp.Principal,
+		AccumulatedFees:        cdp.AccumulatedFees,
+		FeesUpdated:            cdp.FeesUpdated,
+		InterestFactor:         cdp.InterestFactor.String(),
+		CollateralValue:        collateralValue,
+		CollateralizationRatio: collateralizationRatio.String(),
+		LiquidationRatio:       liquidationRatio.String(),
+	}
+}
+
+// MultiCDPResponses a collection of MultiCDPResponse objects
+type MultiCDPResponses []MultiCDPResponse
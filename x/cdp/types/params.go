@@ -3,12 +3,15 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
 )
 
 // Parameter keys
@@ -21,6 +24,8 @@ var (
 	KeyDebtLot              = []byte("DebtLot")
 	KeySurplusThreshold     = []byte("SurplusThreshold")
 	KeySurplusLot           = []byte("SurplusLot")
+	KeyPegController        = []byte("PegController")
+	KeyMultiCdpDebtLimit    = []byte("MultiCdpDebtLimit")
 	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker   = false
 	DefaultCollateralParams = CollateralParams{}
@@ -38,13 +43,21 @@ var (
 	DefaultDebtThreshold    = sdkmath.NewInt(100000000000)
 	DefaultSurplusLot       = sdkmath.NewInt(10000000000)
 	DefaultDebtLot          = sdkmath.NewInt(10000000000)
-	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
+	DefaultPegController    = PegController{
+		MarketID:        "",
+		TargetPrice:     sdk.OneDec(),
+		Sensitivity:     sdk.ZeroDec(),
+		MinStabilityFee: sdk.OneDec(),
+		MaxStabilityFee: stabilityFeeMax,
+	}
+	DefaultMultiCdpDebtLimit = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
+	stabilityFeeMax          = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 )
 
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool,
+	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool, pegController PegController, multiCdpDebtLimit sdk.Coin,
 ) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
@@ -55,6 +68,8 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		PegController:           pegController,
+		MultiCdpDebtLimit:       multiCdpDebtLimit,
 	}
 }
 
@@ -63,7 +78,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker,
+		DefaultCircuitBreaker, DefaultPegController, DefaultMultiCdpDebtLimit,
 	)
 }
 
@@ -71,6 +86,7 @@ func DefaultParams() Params {
 func NewCollateralParam(
 	denom, ctype string, liqRatio sdk.Dec, debtLimit sdk.Coin, stabilityFee sdk.Dec, auctionSize sdkmath.Int,
 	liqPenalty sdk.Dec, spotMarketID, liquidationMarketID string, keeperReward sdk.Dec, checkIndexCount sdkmath.Int, conversionFactor sdkmath.Int,
+	closeFactor, liqTargetRatio sdk.Dec, auctionType string, redemptionFee sdk.Dec, liqTwapWindow time.Duration,
 ) CollateralParam {
 	return CollateralParam{
 		Denom:                            denom,
@@ -85,7 +101,70 @@ func NewCollateralParam(
 		KeeperRewardPercentage:           keeperReward,
 		CheckCollateralizationIndexCount: checkIndexCount,
 		ConversionFactor:                 conversionFactor,
+		CloseFactor:                      closeFactor,
+		LiquidationTargetRatio:           liqTargetRatio,
+		AuctionType:                      auctionType,
+		RedemptionFee:                    redemptionFee,
+		LiquidationTwapWindow:            liqTwapWindow,
+	}
+}
+
+// PartialLiquidationEnabled returns true if cdps of this collateral type are partially liquidated
+func (cp CollateralParam) PartialLiquidationEnabled() bool {
+	return !cp.CloseFactor.IsNil() && cp.CloseFactor.IsPositive()
+}
+
+// DutchAuctionEnabled returns true if collateral of this type is sold in dutch auctions
+func (cp CollateralParam) DutchAuctionEnabled() bool {
+	return cp.AuctionType == auctiontypes.DutchAuctionType
+}
+
+// BatchAuctionEnabled returns true if the collateral of each liquidated cdp of this type is sold in a single batch auction
+func (cp CollateralParam) BatchAuctionEnabled() bool {
+	return cp.AuctionType == auctiontypes.BatchAuctionType
+}
+
+// LiquidationTwapEnabled returns true if liquidation checks use the average liquidation market price over a window
+func (cp CollateralParam) LiquidationTwapEnabled() bool {
+	return cp.LiquidationTwapWindow > 0
+}
+
+// GetRedemptionFee returns the fraction of redeemed collateral kept as a fee, zero if unset
+func (cp CollateralParam) GetRedemptionFee() sdk.Dec {
+	if cp.RedemptionFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return cp.RedemptionFee
+}
+
+// NewPegController returns a new PegController
+func NewPegController(marketID string, targetPrice, sensitivity, minStabilityFee, maxStabilityFee sdk.Dec) PegController {
+	return PegController{
+		MarketID:        marketID,
+		TargetPrice:     targetPrice,
+		Sensitivity:     sensitivity,
+		MinStabilityFee: minStabilityFee,
+		MaxStabilityFee: maxStabilityFee,
+	}
+}
+
+// Enabled returns true if stability fees are adjusted by the controller
+func (pc PegController) Enabled() bool {
+	return pc.MarketID != ""
+}
+
+// AdjustStabilityFee returns the stability fee adjusted for the deviation of price from the target price,
+// bounded by the controller's minimum and maximum stability fees.
+func (pc PegController) AdjustStabilityFee(stabilityFee, price sdk.Dec) sdk.Dec {
+	deviation := pc.TargetPrice.Sub(price).Quo(pc.TargetPrice)
+	fee := stabilityFee.Add(pc.Sensitivity.Mul(deviation))
+	if fee.LT(pc.MinStabilityFee) {
+		return pc.MinStabilityFee
+	}
+	if fee.GT(pc.MaxStabilityFee) {
+		return pc.MaxStabilityFee
 	}
+	return fee
 }
 
 // CollateralParams array of CollateralParam
@@ -122,6 +201,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		paramtypes.NewParamSetPair(KeyPegController, &p.PegController, validatePegControllerParam),
+		paramtypes.NewParamSetPair(KeyMultiCdpDebtLimit, &p.MultiCdpDebtLimit, validateMultiCdpDebtLimitParam),
 	}
 }
 
@@ -159,6 +240,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePegControllerParam(p.PegController); err != nil {
+		return err
+	}
+
+	if err := validateMultiCdpDebtLimitParam(p.MultiCdpDebtLimit); err != nil {
+		return err
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -200,6 +289,17 @@ func (p Params) Validate() error {
 			collateralParamsDebtLimit, p.GlobalDebtLimit)
 	}
 
+	if p.MultiCdpDebtLimit.Denom != "" {
+		if p.MultiCdpDebtLimit.Denom != p.GlobalDebtLimit.Denom {
+			return fmt.Errorf("multi cdp debt limit denom %s does not match global debt limit denom %s",
+				p.MultiCdpDebtLimit.Denom, p.GlobalDebtLimit.Denom)
+		}
+
+		if p.MultiCdpDebtLimit.Amount.GT(p.GlobalDebtLimit.Amount) {
+			return fmt.Errorf("multi cdp debt limit %s exceeds global debt limit: %s", p.MultiCdpDebtLimit, p.GlobalDebtLimit)
+		}
+	}
+
 	return nil
 }
 
@@ -216,6 +316,24 @@ func validateGlobalDebtLimitParam(i interface{}) error {
 	return nil
 }
 
+func validateMultiCdpDebtLimitParam(i interface{}) error {
+	multiCdpDebtLimit, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset limit disables multi-collateral cdps
+	if multiCdpDebtLimit.Denom == "" {
+		return nil
+	}
+
+	if !multiCdpDebtLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "multi cdp debt limit %s", multiCdpDebtLimit.String())
+	}
+
+	return nil
+}
+
 func validateCollateralParams(i interface{}) error {
 	collateralParams, ok := i.(CollateralParams)
 	if !ok {
@@ -269,6 +387,28 @@ func validateCollateralParams(i interface{}) error {
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
+		if !cp.CloseFactor.IsNil() && (cp.CloseFactor.IsNegative() || cp.CloseFactor.GT(sdk.OneDec())) {
+			return fmt.Errorf("close factor should be between 0 and 1, is %s for %s", cp.CloseFactor, cp.Denom)
+		}
+		if cp.PartialLiquidationEnabled() {
+			if cp.LiquidationTargetRatio.IsNil() || cp.LiquidationTargetRatio.LTE(cp.LiquidationRatio) {
+				return fmt.Errorf("liquidation target ratio must be > liquidation ratio %s, is %s for %s", cp.LiquidationRatio, cp.LiquidationTargetRatio, cp.Denom)
+			}
+			if cp.LiquidationTargetRatio.LTE(sdk.OneDec().Add(cp.LiquidationPenalty)) {
+				return fmt.Errorf("liquidation target ratio must be > 1 + liquidation penalty, is %s for %s", cp.LiquidationTargetRatio, cp.Denom)
+			}
+		}
+		if !cp.RedemptionFee.IsNil() && (cp.RedemptionFee.IsNegative() || cp.RedemptionFee.GTE(sdk.OneDec())) {
+			return fmt.Errorf("redemption fee should be between 0 and 1, is %s for %s", cp.RedemptionFee, cp.Denom)
+		}
+		if cp.LiquidationTwapWindow < 0 {
+			return fmt.Errorf("liquidation twap window cannot be negative, is %s for %s", cp.LiquidationTwapWindow, cp.Denom)
+		}
+		switch cp.AuctionType {
+		case "", auctiontypes.CollateralAuctionType, auctiontypes.DutchAuctionType, auctiontypes.BatchAuctionType:
+		default:
+			return fmt.Errorf("invalid auction type %s for %s", cp.AuctionType, cp.Denom)
+		}
 	}
 
 	return nil
@@ -346,3 +486,40 @@ func validateDebtAuctionLotParam(i interface{}) error {
 
 	return nil
 }
+
+func validatePegControllerParam(i interface{}) error {
+	pc, ok := i.(PegController)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !pc.Enabled() {
+		return nil
+	}
+
+	if strings.TrimSpace(pc.MarketID) != pc.MarketID {
+		return fmt.Errorf("peg controller market id cannot contain whitespace: %s", pc.MarketID)
+	}
+
+	if pc.TargetPrice.IsNil() || !pc.TargetPrice.IsPositive() {
+		return fmt.Errorf("peg controller target price should be positive: %s", pc.TargetPrice)
+	}
+
+	if pc.Sensitivity.IsNil() || pc.Sensitivity.IsNegative() {
+		return fmt.Errorf("peg controller sensitivity should not be negative: %s", pc.Sensitivity)
+	}
+
+	if pc.MinStabilityFee.IsNil() || pc.MinStabilityFee.LT(sdk.OneDec()) {
+		return fmt.Errorf("peg controller min stability fee must be ≥ 1.0, is %s", pc.MinStabilityFee)
+	}
+
+	if pc.MaxStabilityFee.IsNil() || pc.MaxStabilityFee.GT(stabilityFeeMax) {
+		return fmt.Errorf("peg controller max stability fee is too high, must be under 500%% APR, is %s", pc.MaxStabilityFee)
+	}
+
+	if pc.MinStabilityFee.GT(pc.MaxStabilityFee) {
+		return fmt.Errorf("peg controller min stability fee %s exceeds max stability fee %s", pc.MinStabilityFee, pc.MaxStabilityFee)
+	}
+
+	return nil
+}
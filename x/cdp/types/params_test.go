@@ -20,14 +20,16 @@ func (suite *ParamsTestSuite) SetupTest() {
 
 func (suite *ParamsTestSuite) TestParamValidation() {
 	type args struct {
-		globalDebtLimit  sdk.Coin
-		collateralParams types.CollateralParams
-		debtParam        types.DebtParam
-		surplusThreshold sdkmath.Int
-		surplusLot       sdkmath.Int
-		debtThreshold    sdkmath.Int
-		debtLot          sdkmath.Int
-		breaker          bool
+		globalDebtLimit   sdk.Coin
+		collateralParams  types.CollateralParams
+		debtParam         types.DebtParam
+		surplusThreshold  sdkmath.Int
+		surplusLot        sdkmath.Int
+		debtThreshold     sdkmath.Int
+		debtLot           sdkmath.Int
+		breaker           bool
+		pegController     types.PegController
+		multiCdpDebtLimit sdk.Coin
 	}
 	type errArgs struct {
 		expectPass bool
@@ -93,6 +95,203 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "",
 			},
 		},
+		{
+			name: "valid partial liquidation",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("0.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("2.0"),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid close factor",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("1.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("2.0"),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "close factor should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid auction type",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("0.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("2.0"),
+						AuctionType:                      "english",
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid auction type",
+			},
+		},
+		{
+			name: "invalid redemption fee",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("0.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("2.0"),
+						RedemptionFee:                    sdk.OneDec(),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "redemption fee should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid liquidation target ratio below liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("0.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("1.4"),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation target ratio must be > liquidation ratio",
+			},
+		},
 		{
 			name: "invalid single-collateral mismatched debt denoms",
 			args: args{
@@ -797,10 +996,270 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "debt auction lot should be positive",
 			},
 		},
+		{
+			name: "valid multi cdp debt limit",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold:  types.DefaultSurplusThreshold,
+				surplusLot:        types.DefaultSurplusLot,
+				debtThreshold:     types.DefaultDebtThreshold,
+				debtLot:           types.DefaultDebtLot,
+				breaker:           types.DefaultCircuitBreaker,
+				multiCdpDebtLimit: sdk.NewInt64Coin("usdf", 1000000000000),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid multi cdp debt limit denom",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold:  types.DefaultSurplusThreshold,
+				surplusLot:        types.DefaultSurplusLot,
+				debtThreshold:     types.DefaultDebtThreshold,
+				debtLot:           types.DefaultDebtLot,
+				breaker:           types.DefaultCircuitBreaker,
+				multiCdpDebtLimit: sdk.NewInt64Coin("susd", 1000000000000),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "does not match global debt limit denom",
+			},
+		},
+		{
+			name: "multi cdp debt limit exceeds global debt limit",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold:  types.DefaultSurplusThreshold,
+				surplusLot:        types.DefaultSurplusLot,
+				debtThreshold:     types.DefaultDebtThreshold,
+				debtLot:           types.DefaultDebtLot,
+				breaker:           types.DefaultCircuitBreaker,
+				multiCdpDebtLimit: sdk.NewInt64Coin("usdf", 5000000000000),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "exceeds global debt limit",
+			},
+		},
+		{
+			name: "invalid multi cdp debt limit",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdf", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdf", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdf",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdkmath.NewInt(6),
+					DebtFloor:        sdkmath.NewInt(10000000),
+				},
+				surplusThreshold:  types.DefaultSurplusThreshold,
+				surplusLot:        types.DefaultSurplusLot,
+				debtThreshold:     types.DefaultDebtThreshold,
+				debtLot:           types.DefaultDebtLot,
+				breaker:           types.DefaultCircuitBreaker,
+				multiCdpDebtLimit: sdk.Coin{Denom: "usdf", Amount: sdkmath.NewInt(-1)},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "multi cdp debt limit",
+			},
+		},
+		{
+			name: "valid peg controller",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegController:    types.NewPegController("usdf:usd", sdk.OneDec(), sdk.MustNewDecFromStr("0.0000001"), sdk.OneDec(), sdk.MustNewDecFromStr("1.000000012")),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid peg controller target price",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegController:    types.NewPegController("usdf:usd", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.0000001"), sdk.OneDec(), sdk.MustNewDecFromStr("1.000000012")),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg controller target price should be positive",
+			},
+		},
+		{
+			name: "invalid peg controller sensitivity",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegController:    types.NewPegController("usdf:usd", sdk.OneDec(), sdk.MustNewDecFromStr("-0.0000001"), sdk.OneDec(), sdk.MustNewDecFromStr("1.000000012")),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg controller sensitivity should not be negative",
+			},
+		},
+		{
+			name: "invalid peg controller min stability fee",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegController:    types.NewPegController("usdf:usd", sdk.OneDec(), sdk.MustNewDecFromStr("0.0000001"), sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("1.000000012")),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg controller min stability fee must be ≥ 1.0",
+			},
+		},
+		{
+			name: "invalid peg controller max stability fee",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegController:    types.NewPegController("usdf:usd", sdk.OneDec(), sdk.MustNewDecFromStr("0.0000001"), sdk.OneDec(), sdk.MustNewDecFromStr("1.1")),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg controller max stability fee is too high",
+			},
+		},
+		{
+			name: "invalid peg controller stability fee bounds",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				pegController:    types.NewPegController("usdf:usd", sdk.OneDec(), sdk.MustNewDecFromStr("0.0000001"), sdk.MustNewDecFromStr("1.000000012"), sdk.MustNewDecFromStr("1.000000001")),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "exceeds max stability fee",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.pegController, tc.args.multiCdpDebtLimit)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
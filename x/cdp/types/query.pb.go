@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -423,6 +424,116 @@ func (m *QueryCdpsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAdjustCdpRequest defines the request type for the Query/AdjustCdp RPC method.
+type QueryAdjustCdpRequest struct {
+	CollateralType  string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Owner           string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	CollateralDelta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=collateral_delta,json=collateralDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"collateral_delta"`
+	PrincipalDelta  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=principal_delta,json=principalDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"principal_delta"`
+}
+
+func (m *QueryAdjustCdpRequest) Reset()         { *m = QueryAdjustCdpRequest{} }
+func (m *QueryAdjustCdpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdjustCdpRequest) ProtoMessage()    {}
+func (*QueryAdjustCdpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{8}
+}
+func (m *QueryAdjustCdpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdjustCdpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdjustCdpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdjustCdpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdjustCdpRequest.Merge(m, src)
+}
+func (m *QueryAdjustCdpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdjustCdpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdjustCdpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdjustCdpRequest proto.InternalMessageInfo
+
+func (m *QueryAdjustCdpRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *QueryAdjustCdpRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryAdjustCdpResponse defines the response type for the Query/AdjustCdp RPC method.
+type QueryAdjustCdpResponse struct {
+	// cdp is the CDP after the adjustment.
+	Cdp CDPResponse `protobuf:"bytes,1,opt,name=cdp,proto3" json:"cdp"`
+	// closed is true if the adjustment repays all debt, closing the CDP.
+	Closed bool `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+}
+
+func (m *QueryAdjustCdpResponse) Reset()         { *m = QueryAdjustCdpResponse{} }
+func (m *QueryAdjustCdpResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdjustCdpResponse) ProtoMessage()    {}
+func (*QueryAdjustCdpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{9}
+}
+func (m *QueryAdjustCdpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdjustCdpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdjustCdpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdjustCdpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdjustCdpResponse.Merge(m, src)
+}
+func (m *QueryAdjustCdpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdjustCdpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdjustCdpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdjustCdpResponse proto.InternalMessageInfo
+
+func (m *QueryAdjustCdpResponse) GetCdp() CDPResponse {
+	if m != nil {
+		return m.Cdp
+	}
+	return CDPResponse{}
+}
+
+func (m *QueryAdjustCdpResponse) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 // QueryDepositsRequest defines the request type for the Query/Deposits RPC method.
 type QueryDepositsRequest struct {
 	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -433,7 +544,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{8}
+	return fileDescriptor_f8caaf4da7412dac, []int{10}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +596,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{9}
+	return fileDescriptor_f8caaf4da7412dac, []int{11}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +641,7 @@ func (m *QueryTotalPrincipalRequest) Reset()         { *m = QueryTotalPrincipalR
 func (m *QueryTotalPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPrincipalRequest) ProtoMessage()    {}
 func (*QueryTotalPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{10}
+	return fileDescriptor_f8caaf4da7412dac, []int{12}
 }
 func (m *QueryTotalPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +686,7 @@ func (m *QueryTotalPrincipalResponse) Reset()         { *m = QueryTotalPrincipal
 func (m *QueryTotalPrincipalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPrincipalResponse) ProtoMessage()    {}
 func (*QueryTotalPrincipalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{11}
+	return fileDescriptor_f8caaf4da7412dac, []int{13}
 }
 func (m *QueryTotalPrincipalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +731,7 @@ func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollatera
 func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralRequest) ProtoMessage()    {}
 func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{12}
+	return fileDescriptor_f8caaf4da7412dac, []int{14}
 }
 func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +776,7 @@ func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollater
 func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralResponse) ProtoMessage()    {}
 func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{13}
+	return fileDescriptor_f8caaf4da7412dac, []int{15}
 }
 func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,32 +812,28 @@ func (m *QueryTotalCollateralResponse) GetTotalCollateral() TotalCollaterals {
 	return nil
 }
 
-// CDPResponse defines the state of a single collateralized debt position.
-type CDPResponse struct {
-	ID                     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Owner                  string      `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Type                   string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Collateral             types1.Coin `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral"`
-	Principal              types1.Coin `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
-	AccumulatedFees        types1.Coin `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
-	FeesUpdated            time.Time   `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
-	InterestFactor         string      `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3" json:"interest_factor,omitempty"`
-	CollateralValue        types1.Coin `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3" json:"collateral_value"`
-	CollateralizationRatio string      `protobuf:"bytes,10,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+// QueryLiquidationStatsRequest defines the request type for the Query/LiquidationStats RPC method.
+type QueryLiquidationStatsRequest struct {
+	// collateral_type filters the stats by collateral type, all collateral types are returned if empty
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// start_time excludes windows starting before it, if set
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time excludes windows starting at or after it, if set
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
-func (m *CDPResponse) Reset()         { *m = CDPResponse{} }
-func (m *CDPResponse) String() string { return proto.CompactTextString(m) }
-func (*CDPResponse) ProtoMessage()    {}
-func (*CDPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8caaf4da7412dac, []int{14}
+func (m *QueryLiquidationStatsRequest) Reset()         { *m = QueryLiquidationStatsRequest{} }
+func (m *QueryLiquidationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationStatsRequest) ProtoMessage()    {}
+func (*QueryLiquidationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{16}
 }
-func (m *CDPResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidationStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CDPResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidationStatsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -736,1365 +843,5939 @@ func (m *CDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CDPResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CDPResponse.Merge(m, src)
+func (m *QueryLiquidationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationStatsRequest.Merge(m, src)
 }
-func (m *CDPResponse) XXX_Size() int {
+func (m *QueryLiquidationStatsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CDPResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CDPResponse.DiscardUnknown(m)
+func (m *QueryLiquidationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CDPResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidationStatsRequest proto.InternalMessageInfo
 
-func (m *CDPResponse) GetID() uint64 {
+func (m *QueryLiquidationStatsRequest) GetCollateralType() string {
 	if m != nil {
-		return m.ID
+		return m.CollateralType
 	}
-	return 0
+	return ""
 }
 
-func (m *CDPResponse) GetOwner() string {
+func (m *QueryLiquidationStatsRequest) GetStartTime() time.Time {
 	if m != nil {
-		return m.Owner
+		return m.StartTime
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *CDPResponse) GetType() string {
+func (m *QueryLiquidationStatsRequest) GetEndTime() time.Time {
 	if m != nil {
-		return m.Type
+		return m.EndTime
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *CDPResponse) GetCollateral() types1.Coin {
-	if m != nil {
-		return m.Collateral
-	}
-	return types1.Coin{}
+// QueryLiquidationStatsResponse defines the response type for the Query/LiquidationStats RPC method.
+type QueryLiquidationStatsResponse struct {
+	// stats are the totals of each collateral type for each time window
+	Stats LiquidationStatsList `protobuf:"bytes,1,rep,name=stats,proto3,castrepeated=LiquidationStatsList" json:"stats"`
+	// totals are the totals of each collateral type summed over all returned windows
+	Totals LiquidationStatsList `protobuf:"bytes,2,rep,name=totals,proto3,castrepeated=LiquidationStatsList" json:"totals"`
 }
 
-func (m *CDPResponse) GetPrincipal() types1.Coin {
-	if m != nil {
-		return m.Principal
+func (m *QueryLiquidationStatsResponse) Reset()         { *m = QueryLiquidationStatsResponse{} }
+func (m *QueryLiquidationStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationStatsResponse) ProtoMessage()    {}
+func (*QueryLiquidationStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{17}
+}
+func (m *QueryLiquidationStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidationStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return types1.Coin{}
+}
+func (m *QueryLiquidationStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationStatsResponse.Merge(m, src)
+}
+func (m *QueryLiquidationStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidationStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationStatsResponse.DiscardUnknown(m)
 }
 
-func (m *CDPResponse) GetAccumulatedFees() types1.Coin {
+var xxx_messageInfo_QueryLiquidationStatsResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidationStatsResponse) GetStats() LiquidationStatsList {
 	if m != nil {
-		return m.AccumulatedFees
+		return m.Stats
 	}
-	return types1.Coin{}
+	return nil
 }
 
-func (m *CDPResponse) GetFeesUpdated() time.Time {
+func (m *QueryLiquidationStatsResponse) GetTotals() LiquidationStatsList {
 	if m != nil {
-		return m.FeesUpdated
+		return m.Totals
 	}
-	return time.Time{}
+	return nil
 }
 
-func (m *CDPResponse) GetInterestFactor() string {
-	if m != nil {
-		return m.InterestFactor
-	}
-	return ""
+// QueryMultiCdpRequest defines the request type for the Query/MultiCdp RPC method.
+type QueryMultiCdpRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *CDPResponse) GetCollateralValue() types1.Coin {
-	if m != nil {
-		return m.CollateralValue
+func (m *QueryMultiCdpRequest) Reset()         { *m = QueryMultiCdpRequest{} }
+func (m *QueryMultiCdpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCdpRequest) ProtoMessage()    {}
+func (*QueryMultiCdpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{18}
+}
+func (m *QueryMultiCdpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCdpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCdpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return types1.Coin{}
+}
+func (m *QueryMultiCdpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCdpRequest.Merge(m, src)
+}
+func (m *QueryMultiCdpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCdpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCdpRequest.DiscardUnknown(m)
 }
 
-func (m *CDPResponse) GetCollateralizationRatio() string {
+var xxx_messageInfo_QueryMultiCdpRequest proto.InternalMessageInfo
+
+func (m *QueryMultiCdpRequest) GetOwner() string {
 	if m != nil {
-		return m.CollateralizationRatio
+		return m.Owner
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "fury.cdp.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fury.cdp.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryAccountsRequest)(nil), "fury.cdp.v1beta1.QueryAccountsRequest")
-	proto.RegisterType((*QueryAccountsResponse)(nil), "fury.cdp.v1beta1.QueryAccountsResponse")
-	proto.RegisterType((*QueryCdpRequest)(nil), "fury.cdp.v1beta1.QueryCdpRequest")
-	proto.RegisterType((*QueryCdpResponse)(nil), "fury.cdp.v1beta1.QueryCdpResponse")
-	proto.RegisterType((*QueryCdpsRequest)(nil), "fury.cdp.v1beta1.QueryCdpsRequest")
-	proto.RegisterType((*QueryCdpsResponse)(nil), "fury.cdp.v1beta1.QueryCdpsResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "fury.cdp.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "fury.cdp.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryTotalPrincipalRequest)(nil), "fury.cdp.v1beta1.QueryTotalPrincipalRequest")
-	proto.RegisterType((*QueryTotalPrincipalResponse)(nil), "fury.cdp.v1beta1.QueryTotalPrincipalResponse")
-	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "fury.cdp.v1beta1.QueryTotalCollateralRequest")
-	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "fury.cdp.v1beta1.QueryTotalCollateralResponse")
-	proto.RegisterType((*CDPResponse)(nil), "fury.cdp.v1beta1.CDPResponse")
+// QueryMultiCdpResponse defines the response type for the Query/MultiCdp RPC method.
+type QueryMultiCdpResponse struct {
+	Cdp MultiCDPResponse `protobuf:"bytes,1,opt,name=cdp,proto3" json:"cdp"`
 }
 
-func init() { proto.RegisterFile("fury/cdp/v1beta1/query.proto", fileDescriptor_f8caaf4da7412dac) }
-
-var fileDescriptor_f8caaf4da7412dac = []byte{
-	// 1146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0x8f, 0xd3, 0xb4, 0x4b, 0x5f, 0xa7, 0x26, 0x3c, 0x42, 0xe7, 0x9a, 0x92, 0xa4, 0x1e, 0xac,
-	0x1d, 0xa2, 0x36, 0x2b, 0xe2, 0xb7, 0x10, 0x6a, 0x5a, 0x3a, 0x0d, 0x09, 0xa9, 0x98, 0x02, 0x12,
-	0x12, 0x0a, 0x8e, 0xfd, 0x9a, 0x19, 0x25, 0x7e, 0x9e, 0x9f, 0xbd, 0x51, 0xa6, 0x09, 0xc1, 0x61,
-	0xe2, 0x38, 0xc1, 0x81, 0x03, 0x12, 0xda, 0x85, 0x0b, 0x67, 0xfe, 0x88, 0x1d, 0x27, 0xe0, 0xc0,
-	0x69, 0x83, 0x96, 0x03, 0x7f, 0xc6, 0xf4, 0xde, 0xfb, 0x3a, 0x76, 0xe2, 0xa4, 0xcd, 0x0e, 0xbb,
-	0x44, 0xf1, 0xf7, 0xd7, 0xe7, 0xf3, 0xfd, 0xfa, 0xfb, 0xc3, 0x68, 0xe5, 0x20, 0x0e, 0x0f, 0x4d,
-	0xc7, 0x0d, 0xcc, 0xeb, 0x97, 0x3a, 0x24, 0xb2, 0x2f, 0x99, 0xd7, 0x62, 0x12, 0x1e, 0x1a, 0x41,
-	0x48, 0x23, 0x8a, 0xab, 0x5c, 0x6b, 0x38, 0x6e, 0x60, 0x80, 0x56, 0xab, 0x3b, 0x94, 0xf5, 0x29,
-	0x33, 0xed, 0x38, 0xba, 0x3a, 0x70, 0xe1, 0x0f, 0xd2, 0x43, 0x7b, 0x11, 0xf4, 0x1d, 0x9b, 0x11,
-	0x19, 0x6a, 0x60, 0x15, 0xd8, 0x5d, 0xcf, 0xb7, 0x23, 0x8f, 0xfa, 0x60, 0x5b, 0xcf, 0xda, 0x26,
-	0x56, 0x0e, 0xf5, 0x12, 0xfd, 0xb2, 0xd4, 0xb7, 0xc5, 0x93, 0x29, 0x1f, 0x40, 0xa5, 0xe5, 0x68,
-	0x73, 0x92, 0x10, 0x36, 0xa7, 0xeb, 0x12, 0x9f, 0x30, 0x2f, 0xf1, 0xad, 0x75, 0x69, 0x97, 0xca,
-	0x98, 0xfc, 0x1f, 0x48, 0x57, 0xba, 0x94, 0x76, 0x7b, 0xc4, 0xb4, 0x03, 0xcf, 0xb4, 0x7d, 0x9f,
-	0x46, 0x82, 0x69, 0xe2, 0xd3, 0x00, 0xad, 0x78, 0xea, 0xc4, 0x07, 0x66, 0xe4, 0xf5, 0x09, 0x8b,
-	0xec, 0x3e, 0x80, 0xea, 0x35, 0x84, 0x3f, 0xe4, 0xd9, 0xee, 0xd9, 0xa1, 0xdd, 0x67, 0x16, 0xb9,
-	0x16, 0x13, 0x16, 0xe9, 0x9f, 0xa2, 0xa7, 0x87, 0xa4, 0x2c, 0xa0, 0x3e, 0x23, 0xf8, 0x35, 0x34,
-	0x17, 0x08, 0x89, 0xaa, 0x34, 0x95, 0xf5, 0x85, 0x4d, 0xd5, 0x18, 0xad, 0xb3, 0x21, 0x3d, 0x5a,
-	0xa5, 0x7b, 0x0f, 0x1a, 0x05, 0x0b, 0xac, 0xdf, 0x2a, 0x7f, 0x7f, 0xb7, 0x51, 0xf8, 0xff, 0x6e,
-	0xa3, 0xa0, 0x2f, 0xa1, 0x9a, 0x08, 0xbc, 0xe5, 0x38, 0x34, 0xf6, 0xa3, 0x01, 0xe0, 0xe7, 0xe8,
-	0x99, 0x11, 0x39, 0x40, 0xee, 0xa0, 0xb2, 0x0d, 0x32, 0x55, 0x69, 0xce, 0xac, 0x2f, 0x6c, 0xea,
-	0x06, 0x54, 0x54, 0xbc, 0xbd, 0x04, 0xf7, 0x03, 0xea, 0xc6, 0x3d, 0x02, 0xee, 0x00, 0x3f, 0xf0,
-	0xd4, 0xbf, 0x44, 0x15, 0x11, 0x7e, 0xdb, 0x0d, 0x00, 0x11, 0xaf, 0xa1, 0x8a, 0x43, 0x7b, 0x3d,
-	0x3b, 0x22, 0xa1, 0xdd, 0x6b, 0x47, 0x87, 0x01, 0x11, 0x49, 0xcd, 0x5b, 0x8b, 0xa9, 0x78, 0xff,
-	0x30, 0x20, 0xd8, 0x40, 0xb3, 0xf4, 0x86, 0x4f, 0x42, 0xb5, 0xc8, 0xd5, 0x2d, 0xf5, 0x8f, 0xdf,
-	0x37, 0x6a, 0xc0, 0x60, 0xcb, 0x75, 0x43, 0xc2, 0xd8, 0x47, 0x51, 0xe8, 0xf9, 0x5d, 0x4b, 0x9a,
-	0xe9, 0x57, 0x50, 0x35, 0xc5, 0x82, 0x2c, 0x5e, 0x45, 0x33, 0x8e, 0x1b, 0x40, 0xd5, 0x9e, 0xcb,
-	0x57, 0x6d, 0x7b, 0x67, 0x2f, 0xb1, 0x05, 0xee, 0xdc, 0x5e, 0xff, 0x57, 0x49, 0x63, 0xb1, 0x27,
-	0x4d, 0x1c, 0x2f, 0xa1, 0xa2, 0xe7, 0xaa, 0x33, 0x4d, 0x65, 0xbd, 0xd4, 0x9a, 0x3b, 0x7a, 0xd0,
-	0x28, 0x5e, 0xd9, 0xb1, 0x8a, 0x9e, 0x8b, 0x6b, 0x68, 0x36, 0xe4, 0x4d, 0xa5, 0x96, 0x04, 0x8c,
-	0x7c, 0xc0, 0xbb, 0x08, 0xa5, 0x83, 0xa1, 0xce, 0x8a, 0xcc, 0x2e, 0x24, 0xaf, 0x86, 0x4f, 0x86,
-	0x21, 0x07, 0x32, 0x6d, 0x8c, 0x2e, 0x81, 0x14, 0xac, 0x8c, 0xa7, 0xfe, 0xab, 0x82, 0x9e, 0xca,
-	0xe4, 0x08, 0x05, 0xbb, 0x8c, 0x4a, 0x8e, 0x1b, 0x24, 0xaf, 0xfc, 0x94, 0x8a, 0xd5, 0x78, 0xc5,
-	0x7e, 0x7b, 0xd8, 0x38, 0x9b, 0x11, 0x32, 0x4b, 0x04, 0xc0, 0x97, 0x87, 0x68, 0x16, 0x05, 0xcd,
-	0xb5, 0x53, 0x69, 0xca, 0x18, 0x43, 0x3c, 0x29, 0x74, 0xee, 0x0e, 0x09, 0x28, 0xf3, 0xa2, 0x27,
-	0xfe, 0x3a, 0xf4, 0x2f, 0x60, 0x24, 0x52, 0xc0, 0x41, 0x6d, 0xca, 0x2e, 0xc8, 0xa0, 0x3e, 0xcb,
-	0xf9, 0xfa, 0x80, 0x57, 0xab, 0x0a, 0xb5, 0x29, 0x0f, 0xc2, 0x0c, 0x9c, 0xf5, 0xf7, 0x90, 0x26,
-	0x10, 0xf6, 0x69, 0x64, 0xf7, 0xf6, 0x42, 0xcf, 0x77, 0xbc, 0xc0, 0xee, 0x3d, 0x6e, 0x62, 0xfa,
-	0xb7, 0x0a, 0x7a, 0x76, 0x6c, 0x1c, 0xe0, 0xdb, 0x41, 0x95, 0x88, 0x6b, 0xda, 0x41, 0xa2, 0x02,
-	0xda, 0xcd, 0x3c, 0xed, 0xe1, 0x10, 0xad, 0x73, 0xc0, 0xbe, 0x32, 0x2c, 0x67, 0xd6, 0x62, 0x34,
-	0x24, 0xd0, 0x77, 0xb3, 0x14, 0xb6, 0x07, 0xfc, 0x1e, 0x3b, 0x97, 0xdb, 0x0a, 0x5a, 0x19, 0x1f,
-	0x08, 0x92, 0x39, 0x40, 0x55, 0x99, 0x4c, 0xea, 0x08, 0xd9, 0xac, 0x4e, 0xc8, 0x26, 0x0d, 0xd2,
-	0x52, 0x21, 0x9d, 0xea, 0x88, 0x82, 0x59, 0xb2, 0x42, 0xa9, 0x44, 0xff, 0xa1, 0x84, 0x16, 0x32,
-	0xed, 0x0c, 0xc3, 0xa9, 0x8c, 0x1b, 0xce, 0x4c, 0x57, 0x25, 0xa3, 0x8c, 0x51, 0x49, 0x24, 0x39,
-	0x23, 0x84, 0xe2, 0x3f, 0x7e, 0x17, 0xa1, 0x0c, 0xe7, 0x92, 0x98, 0x84, 0xe5, 0xa1, 0x49, 0x18,
-	0xcc, 0x16, 0xf5, 0x7c, 0x58, 0x43, 0x19, 0x17, 0xfc, 0x0e, 0x9a, 0x4f, 0xdf, 0xe0, 0xec, 0x74,
-	0xfe, 0xa9, 0x07, 0x7e, 0x1f, 0x55, 0x6d, 0xc7, 0x89, 0xfb, 0x31, 0x8f, 0xe7, 0xb6, 0x0f, 0x08,
-	0x61, 0xea, 0xdc, 0x74, 0x51, 0x2a, 0x19, 0xc7, 0x5d, 0x42, 0xf8, 0x54, 0x9f, 0xe5, 0xfe, 0xed,
-	0x38, 0x70, 0xb9, 0x4c, 0x3d, 0x23, 0xe2, 0x68, 0x86, 0xbc, 0x76, 0x46, 0x72, 0xed, 0x8c, 0xfd,
-	0xe4, 0xda, 0xb5, 0xca, 0x3c, 0xd0, 0x9d, 0x87, 0x0d, 0xc5, 0x5a, 0xe0, 0x9e, 0x1f, 0x4b, 0x47,
-	0xde, 0x18, 0x9e, 0x1f, 0x91, 0x90, 0xb0, 0xa8, 0x7d, 0x60, 0x3b, 0x11, 0x0d, 0xd5, 0xb2, 0x6c,
-	0x8c, 0x44, 0xbc, 0x2b, 0xa4, 0x9c, 0x7d, 0xa6, 0x83, 0xae, 0xdb, 0xbd, 0x98, 0xa8, 0xf3, 0x53,
-	0xb2, 0x4f, 0x1d, 0x3f, 0xe1, 0x7e, 0xf8, 0x75, 0x74, 0x2e, 0x15, 0x79, 0x5f, 0x8b, 0xfd, 0xd2,
-	0x96, 0x2b, 0x16, 0x09, 0xf0, 0xa5, 0x9c, 0xda, 0xe2, 0xbf, 0x9b, 0x7f, 0x9d, 0x41, 0xb3, 0xa2,
-	0x3b, 0xf1, 0x0d, 0x34, 0x27, 0x2f, 0x2d, 0x7e, 0x3e, 0xdf, 0x76, 0xf9, 0x83, 0xae, 0xbd, 0x70,
-	0x8a, 0x95, 0xec, 0x32, 0xbd, 0xf9, 0xdd, 0x9f, 0xff, 0xfd, 0x58, 0xd4, 0xb0, 0x6a, 0xe6, 0xbe,
-	0x45, 0xe4, 0x29, 0xc7, 0xdf, 0xa0, 0x72, 0x72, 0xa3, 0xf1, 0x85, 0x09, 0x41, 0x47, 0x8e, 0xbb,
-	0xb6, 0x76, 0xaa, 0x1d, 0xc0, 0xeb, 0x02, 0x7e, 0x05, 0x6b, 0x79, 0xf8, 0xe4, 0x94, 0xe3, 0x9f,
-	0x14, 0xb4, 0x38, 0xbc, 0x0d, 0xf0, 0x4b, 0x13, 0xe2, 0x8f, 0xdd, 0x6b, 0xda, 0xc6, 0x94, 0xd6,
-	0xc0, 0x69, 0x5d, 0x70, 0xd2, 0x71, 0x33, 0xcf, 0x69, 0x78, 0x07, 0xe1, 0x9f, 0x15, 0x54, 0x19,
-	0x19, 0x6c, 0x7c, 0x22, 0x58, 0x6e, 0x4f, 0x69, 0xc6, 0xb4, 0xe6, 0x40, 0xee, 0xa2, 0x20, 0x77,
-	0x1e, 0xaf, 0x4e, 0x20, 0x97, 0x61, 0x42, 0x51, 0x89, 0x5f, 0x58, 0xac, 0x4f, 0x80, 0xc8, 0x7c,
-	0x62, 0x68, 0xe7, 0x4f, 0xb4, 0x01, 0xec, 0xba, 0xc0, 0x56, 0xf1, 0x92, 0x39, 0xee, 0x9b, 0x96,
-	0xe1, 0xdb, 0x0a, 0x9a, 0xd9, 0x76, 0x03, 0xbc, 0x3a, 0x39, 0x58, 0x82, 0xa7, 0x9f, 0x64, 0x02,
-	0x70, 0x6f, 0x08, 0xb8, 0x4d, 0xfc, 0xf2, 0x78, 0x38, 0xf3, 0xa6, 0xd8, 0x7c, 0xb7, 0xcc, 0x9b,
-	0x23, 0x8b, 0xfe, 0x16, 0xfe, 0x45, 0x41, 0x83, 0xeb, 0x37, 0xb1, 0x67, 0x47, 0xce, 0xfa, 0xc4,
-	0x9e, 0x1d, 0xbd, 0xc6, 0xfa, 0x96, 0xe0, 0xf5, 0x36, 0x7e, 0x73, 0x02, 0xaf, 0xe4, 0xda, 0x4e,
-	0x26, 0xd8, 0xda, 0xbe, 0x77, 0x54, 0x57, 0xee, 0x1f, 0xd5, 0x95, 0x7f, 0x8e, 0xea, 0xca, 0x9d,
-	0xe3, 0x7a, 0xe1, 0xfe, 0x71, 0xbd, 0xf0, 0xf7, 0x71, 0xbd, 0xf0, 0xd9, 0xc5, 0xae, 0x17, 0x5d,
-	0x8d, 0x3b, 0x86, 0x43, 0xfb, 0x66, 0x40, 0x42, 0x87, 0x32, 0x8f, 0x6d, 0xf4, 0xec, 0x0e, 0x93,
-	0x60, 0x5f, 0x09, 0x38, 0x1e, 0x84, 0x75, 0xe6, 0xc4, 0xd2, 0x7b, 0xe5, 0x51, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x11, 0x0f, 0x10, 0x6e, 0x19, 0x0d, 0x00, 0x00,
+func (m *QueryMultiCdpResponse) Reset()         { *m = QueryMultiCdpResponse{} }
+func (m *QueryMultiCdpResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCdpResponse) ProtoMessage()    {}
+func (*QueryMultiCdpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{19}
+}
+func (m *QueryMultiCdpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCdpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCdpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiCdpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCdpResponse.Merge(m, src)
+}
+func (m *QueryMultiCdpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCdpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCdpResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryMultiCdpResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the cdp module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Accounts queries the CDP module accounts.
-	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
-	// TotalPrincipal queries the total principal of a given collateral type.
-	TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error)
-	// TotalCollateral queries the total collateral of a given collateral type.
-	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
-	// Cdps queries all active CDPs.
-	Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error)
-	// Cdp queries a CDP with the input owner address and collateral type.
-	Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error)
-	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+func (m *QueryMultiCdpResponse) GetCdp() MultiCDPResponse {
+	if m != nil {
+		return m.Cdp
+	}
+	return MultiCDPResponse{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryMultiCdpsRequest defines the request type for the Query/MultiCdps RPC method.
+type QueryMultiCdpsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryMultiCdpsRequest) Reset()         { *m = QueryMultiCdpsRequest{} }
+func (m *QueryMultiCdpsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCdpsRequest) ProtoMessage()    {}
+func (*QueryMultiCdpsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{20}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryMultiCdpsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
-	out := new(QueryAccountsResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Accounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMultiCdpsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCdpsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMultiCdpsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCdpsRequest.Merge(m, src)
+}
+func (m *QueryMultiCdpsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCdpsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCdpsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error) {
-	out := new(QueryTotalPrincipalResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/TotalPrincipal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultiCdpsRequest proto.InternalMessageInfo
+
+func (m *QueryMultiCdpsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error) {
-	out := new(QueryTotalCollateralResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/TotalCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryMultiCdpsResponse defines the response type for the Query/MultiCdps RPC method.
+type QueryMultiCdpsResponse struct {
+	Cdps       MultiCDPResponses   `protobuf:"bytes,1,rep,name=cdps,proto3,castrepeated=MultiCDPResponses" json:"cdps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error) {
-	out := new(QueryCdpsResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Cdps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMultiCdpsResponse) Reset()         { *m = QueryMultiCdpsResponse{} }
+func (m *QueryMultiCdpsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCdpsResponse) ProtoMessage()    {}
+func (*QueryMultiCdpsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{21}
+}
+func (m *QueryMultiCdpsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCdpsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCdpsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMultiCdpsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCdpsResponse.Merge(m, src)
+}
+func (m *QueryMultiCdpsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCdpsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCdpsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error) {
-	out := new(QueryCdpResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Cdp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultiCdpsResponse proto.InternalMessageInfo
+
+func (m *QueryMultiCdpsResponse) GetCdps() MultiCDPResponses {
+	if m != nil {
+		return m.Cdps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMultiCdpsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the cdp module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Accounts queries the CDP module accounts.
-	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
-	// TotalPrincipal queries the total principal of a given collateral type.
-	TotalPrincipal(context.Context, *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error)
-	// TotalCollateral queries the total collateral of a given collateral type.
-	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
-	// Cdps queries all active CDPs.
-	Cdps(context.Context, *QueryCdpsRequest) (*QueryCdpsResponse, error)
-	// Cdp queries a CDP with the input owner address and collateral type.
-	Cdp(context.Context, *QueryCdpRequest) (*QueryCdpResponse, error)
-	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+// QueryCdpHealthRequest defines the request type for the Query/CdpHealth RPC method.
+type QueryCdpHealthRequest struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCdpHealthRequest) Reset()         { *m = QueryCdpHealthRequest{} }
+func (m *QueryCdpHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCdpHealthRequest) ProtoMessage()    {}
+func (*QueryCdpHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{22}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryCdpHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+func (m *QueryCdpHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCdpHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalPrincipal(ctx context.Context, req *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPrincipal not implemented")
+func (m *QueryCdpHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCdpHealthRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalCollateral not implemented")
+func (m *QueryCdpHealthRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Cdps(ctx context.Context, req *QueryCdpsRequest) (*QueryCdpsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cdps not implemented")
+func (m *QueryCdpHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCdpHealthRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Cdp(ctx context.Context, req *QueryCdpRequest) (*QueryCdpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cdp not implemented")
+
+var xxx_messageInfo_QueryCdpHealthRequest proto.InternalMessageInfo
+
+func (m *QueryCdpHealthRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+
+func (m *QueryCdpHealthRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryCdpHealthResponse defines the response type for the Query/CdpHealth RPC method.
+type QueryCdpHealthResponse struct {
+	Health CDPHealthResponse `protobuf:"bytes,1,opt,name=health,proto3" json:"health"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryCdpHealthResponse) Reset()         { *m = QueryCdpHealthResponse{} }
+func (m *QueryCdpHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCdpHealthResponse) ProtoMessage()    {}
+func (*QueryCdpHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{23}
+}
+func (m *QueryCdpHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCdpHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCdpHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCdpHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCdpHealthResponse.Merge(m, src)
+}
+func (m *QueryCdpHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCdpHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCdpHealthResponse.DiscardUnknown(m)
 }
 
-func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Accounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/Accounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+var xxx_messageInfo_QueryCdpHealthResponse proto.InternalMessageInfo
+
+func (m *QueryCdpHealthResponse) GetHealth() CDPHealthResponse {
+	if m != nil {
+		return m.Health
 	}
-	return interceptor(ctx, in, info, handler)
+	return CDPHealthResponse{}
 }
 
-func _Query_TotalPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalPrincipalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPrincipal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/TotalPrincipal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPrincipal(ctx, req.(*QueryTotalPrincipalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryMultiCdpHealthRequest defines the request type for the Query/MultiCdpHealth RPC method.
+type QueryMultiCdpHealthRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func _Query_TotalCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalCollateralRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/TotalCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalCollateral(ctx, req.(*QueryTotalCollateralRequest))
+func (m *QueryMultiCdpHealthRequest) Reset()         { *m = QueryMultiCdpHealthRequest{} }
+func (m *QueryMultiCdpHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCdpHealthRequest) ProtoMessage()    {}
+func (*QueryMultiCdpHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{24}
+}
+func (m *QueryMultiCdpHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCdpHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCdpHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMultiCdpHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCdpHealthRequest.Merge(m, src)
+}
+func (m *QueryMultiCdpHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCdpHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCdpHealthRequest.DiscardUnknown(m)
 }
 
-func _Query_Cdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCdpsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cdps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/Cdps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cdps(ctx, req.(*QueryCdpsRequest))
+var xxx_messageInfo_QueryMultiCdpHealthRequest proto.InternalMessageInfo
+
+func (m *QueryMultiCdpHealthRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Cdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCdpRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cdp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/Cdp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cdp(ctx, req.(*QueryCdpRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryMultiCdpHealthResponse defines the response type for the Query/MultiCdpHealth RPC method.
+type QueryMultiCdpHealthResponse struct {
+	Health CDPHealthResponse `protobuf:"bytes,1,opt,name=health,proto3" json:"health"`
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryMultiCdpHealthResponse) Reset()         { *m = QueryMultiCdpHealthResponse{} }
+func (m *QueryMultiCdpHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCdpHealthResponse) ProtoMessage()    {}
+func (*QueryMultiCdpHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{25}
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.cdp.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Accounts",
-			Handler:    _Query_Accounts_Handler,
-		},
-		{
-			MethodName: "TotalPrincipal",
-			Handler:    _Query_TotalPrincipal_Handler,
-		},
-		{
-			MethodName: "TotalCollateral",
-			Handler:    _Query_TotalCollateral_Handler,
-		},
-		{
-			MethodName: "Cdps",
-			Handler:    _Query_Cdps_Handler,
-		},
-		{
-			MethodName: "Cdp",
-			Handler:    _Query_Cdp_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/cdp/v1beta1/query.proto",
+func (m *QueryMultiCdpHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMultiCdpHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCdpHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMultiCdpHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCdpHealthResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMultiCdpHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCdpHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCdpHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultiCdpHealthResponse proto.InternalMessageInfo
+
+func (m *QueryMultiCdpHealthResponse) GetHealth() CDPHealthResponse {
+	if m != nil {
+		return m.Health
 	}
-	return dAtA[:n], nil
+	return CDPHealthResponse{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCdpsAtRiskRequest defines the request type for the Query/CdpsAtRisk RPC method.
+type QueryCdpsAtRiskRequest struct {
+	CollateralType string             `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCdpsAtRiskRequest) Reset()         { *m = QueryCdpsAtRiskRequest{} }
+func (m *QueryCdpsAtRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCdpsAtRiskRequest) ProtoMessage()    {}
+func (*QueryCdpsAtRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{26}
+}
+func (m *QueryCdpsAtRiskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCdpsAtRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCdpsAtRiskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCdpsAtRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCdpsAtRiskRequest.Merge(m, src)
 }
-
-func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCdpsAtRiskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCdpsAtRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCdpsAtRiskRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCdpsAtRiskRequest proto.InternalMessageInfo
+
+func (m *QueryCdpsAtRiskRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
 }
 
-func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCdpsAtRiskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCdpsAtRiskResponse defines the response type for the Query/CdpsAtRisk RPC method.
+type QueryCdpsAtRiskResponse struct {
+	Cdps       CDPHealthResponses  `protobuf:"bytes,1,rep,name=cdps,proto3,castrepeated=CDPHealthResponses" json:"cdps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCdpsAtRiskResponse) Reset()         { *m = QueryCdpsAtRiskResponse{} }
+func (m *QueryCdpsAtRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCdpsAtRiskResponse) ProtoMessage()    {}
+func (*QueryCdpsAtRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{27}
+}
+func (m *QueryCdpsAtRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCdpsAtRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCdpsAtRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCdpRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCdpsAtRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCdpsAtRiskResponse.Merge(m, src)
 }
-
-func (m *QueryCdpRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCdpsAtRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCdpsAtRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCdpsAtRiskResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryCdpsAtRiskResponse proto.InternalMessageInfo
+
+func (m *QueryCdpsAtRiskResponse) GetCdps() CDPHealthResponses {
+	if m != nil {
+		return m.Cdps
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCdpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCdpsAtRiskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCdpResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CDPResponse defines the state of a single collateralized debt position.
+type CDPResponse struct {
+	ID                     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                  string      `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type                   string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Collateral             types1.Coin `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral"`
+	Principal              types1.Coin `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
+	AccumulatedFees        types1.Coin `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	FeesUpdated            time.Time   `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
+	InterestFactor         string      `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3" json:"interest_factor,omitempty"`
+	CollateralValue        types1.Coin `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3" json:"collateral_value"`
+	CollateralizationRatio string      `protobuf:"bytes,10,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
 }
 
-func (m *QueryCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
+func (m *CDPResponse) Reset()         { *m = CDPResponse{} }
+func (m *CDPResponse) String() string { return proto.CompactTextString(m) }
+func (*CDPResponse) ProtoMessage()    {}
+func (*CDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{28}
+}
+func (m *CDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CDPResponse.Merge(m, src)
+}
+func (m *CDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CDPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCdpsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CDPResponse proto.InternalMessageInfo
+
+func (m *CDPResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *QueryCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (m *CDPResponse) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if len(m.Ratio) > 0 {
-		i -= len(m.Ratio)
-		copy(dAtA[i:], m.Ratio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ratio)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *CDPResponse) GetCollateral() types1.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	if m.ID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x18
+	return types1.Coin{}
+}
+
+func (m *CDPResponse) GetPrincipal() types1.Coin {
+	if m != nil {
+		return m.Principal
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	return types1.Coin{}
+}
+
+func (m *CDPResponse) GetAccumulatedFees() types1.Coin {
+	if m != nil {
+		return m.AccumulatedFees
 	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+	return types1.Coin{}
+}
+
+func (m *CDPResponse) GetFeesUpdated() time.Time {
+	if m != nil {
+		return m.FeesUpdated
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryCdpsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CDPResponse) GetInterestFactor() string {
+	if m != nil {
+		return m.InterestFactor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) GetCollateralValue() types1.Coin {
+	if m != nil {
+		return m.CollateralValue
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *CDPResponse) GetCollateralizationRatio() string {
+	if m != nil {
+		return m.CollateralizationRatio
 	}
-	if len(m.Cdps) > 0 {
-		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return ""
+}
+
+// MultiCDPResponse defines the state of a single multi-collateral CDP.
+type MultiCDPResponse struct {
+	ID                     uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                  string           `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type                   string           `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Collateral             TypedCollaterals `protobuf:"bytes,4,rep,name=collateral,proto3,castrepeated=TypedCollaterals" json:"collateral"`
+	Principal              types1.Coin      `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
+	AccumulatedFees        types1.Coin      `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	FeesUpdated            time.Time        `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
+	InterestFactor         string           `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3" json:"interest_factor,omitempty"`
+	CollateralValue        types1.Coin      `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3" json:"collateral_value"`
+	CollateralizationRatio string           `protobuf:"bytes,10,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+	// liquidation_ratio is the value-weighted liquidation ratio of the collateral basket
+	LiquidationRatio string `protobuf:"bytes,11,opt,name=liquidation_ratio,json=liquidationRatio,proto3" json:"liquidation_ratio,omitempty"`
+}
+
+func (m *MultiCDPResponse) Reset()         { *m = MultiCDPResponse{} }
+func (m *MultiCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiCDPResponse) ProtoMessage()    {}
+func (*MultiCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{29}
+}
+func (m *MultiCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MultiCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCDPResponse.Merge(m, src)
+}
+func (m *MultiCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCDPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiCDPResponse proto.InternalMessageInfo
+
+func (m *MultiCDPResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MultiCDPResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+func (m *MultiCDPResponse) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MultiCDPResponse) GetCollateral() TypedCollaterals {
+	if m != nil {
+		return m.Collateral
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiCDPResponse) GetPrincipal() types1.Coin {
+	if m != nil {
+		return m.Principal
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MultiCDPResponse) GetAccumulatedFees() types1.Coin {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MultiCDPResponse) GetFeesUpdated() time.Time {
+	if m != nil {
+		return m.FeesUpdated
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryTotalPrincipalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiCDPResponse) GetInterestFactor() string {
+	if m != nil {
+		return m.InterestFactor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTotalPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MultiCDPResponse) GetCollateralValue() types1.Coin {
+	if m != nil {
+		return m.CollateralValue
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryTotalPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+func (m *MultiCDPResponse) GetCollateralizationRatio() string {
+	if m != nil {
+		return m.CollateralizationRatio
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTotalPrincipalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiCDPResponse) GetLiquidationRatio() string {
+	if m != nil {
+		return m.LiquidationRatio
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTotalPrincipalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CDPHealthResponse describes how close a single or multi-collateral CDP is to liquidation.
+type CDPHealthResponse struct {
+	ID    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type  string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// health_factor is the debt the collateral supports at liquidation prices, its value divided by the liquidation
+	// ratio, divided by the debt. The CDP can be liquidated below 1.
+	HealthFactor string `protobuf:"bytes,4,opt,name=health_factor,json=healthFactor,proto3" json:"health_factor,omitempty"`
+	// liquidation_prices are the liquidation market prices of each collateral type below which the CDP can be
+	// liquidated, with the prices of any other collateral unchanged. Empty once the CDP can be liquidated.
+	LiquidationPrices LiquidationPrices `protobuf:"bytes,5,rep,name=liquidation_prices,json=liquidationPrices,proto3,castrepeated=LiquidationPrices" json:"liquidation_prices"`
+	// max_draw is the principal that can still be drawn at spot prices, before debt limits.
+	MaxDraw types1.Coin `protobuf:"bytes,6,opt,name=max_draw,json=maxDraw,proto3" json:"max_draw"`
+	// max_withdraw is the amount of each collateral that can be withdrawn on its own at spot prices.
+	MaxWithdraw TypedCollaterals `protobuf:"bytes,7,rep,name=max_withdraw,json=maxWithdraw,proto3,castrepeated=TypedCollaterals" json:"max_withdraw"`
+}
+
+func (m *CDPHealthResponse) Reset()         { *m = CDPHealthResponse{} }
+func (m *CDPHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*CDPHealthResponse) ProtoMessage()    {}
+func (*CDPHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{30}
+}
+func (m *CDPHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryTotalPrincipalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalPrincipal) > 0 {
-		for iNdEx := len(m.TotalPrincipal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalPrincipal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CDPHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CDPHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CDPHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CDPHealthResponse.Merge(m, src)
+}
+func (m *CDPHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CDPHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CDPHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalCollateralRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CDPHealthResponse proto.InternalMessageInfo
+
+func (m *CDPHealthResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTotalCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPHealthResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *QueryTotalCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+func (m *CDPHealthResponse) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTotalCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CDPHealthResponse) GetHealthFactor() string {
+	if m != nil {
+		return m.HealthFactor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTotalCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPHealthResponse) GetLiquidationPrices() LiquidationPrices {
+	if m != nil {
+		return m.LiquidationPrices
+	}
+	return nil
 }
 
-func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalCollateral) > 0 {
-		for iNdEx := len(m.TotalCollateral) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CDPHealthResponse) GetMaxDraw() types1.Coin {
+	if m != nil {
+		return m.MaxDraw
 	}
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CDPHealthResponse) GetMaxWithdraw() TypedCollaterals {
+	if m != nil {
+		return m.MaxWithdraw
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CDPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LiquidationPrice defines the price of a collateral type below which a CDP can be liquidated.
+type LiquidationPrice struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Price          string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralizationRatio) > 0 {
-		i -= len(m.CollateralizationRatio)
-		copy(dAtA[i:], m.CollateralizationRatio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
-		i--
-		dAtA[i] = 0x52
-	}
-	{
-		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.InterestFactor) > 0 {
-		i -= len(m.InterestFactor)
-		copy(dAtA[i:], m.InterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+func (m *LiquidationPrice) Reset()         { *m = LiquidationPrice{} }
+func (m *LiquidationPrice) String() string { return proto.CompactTextString(m) }
+func (*LiquidationPrice) ProtoMessage()    {}
+func (*LiquidationPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{31}
+}
+func (m *LiquidationPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *LiquidationPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationPrice.Merge(m, src)
+}
+func (m *LiquidationPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationPrice proto.InternalMessageInfo
+
+func (m *LiquidationPrice) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *LiquidationPrice) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "fury.cdp.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fury.cdp.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryAccountsRequest)(nil), "fury.cdp.v1beta1.QueryAccountsRequest")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "fury.cdp.v1beta1.QueryAccountsResponse")
+	proto.RegisterType((*QueryCdpRequest)(nil), "fury.cdp.v1beta1.QueryCdpRequest")
+	proto.RegisterType((*QueryCdpResponse)(nil), "fury.cdp.v1beta1.QueryCdpResponse")
+	proto.RegisterType((*QueryCdpsRequest)(nil), "fury.cdp.v1beta1.QueryCdpsRequest")
+	proto.RegisterType((*QueryCdpsResponse)(nil), "fury.cdp.v1beta1.QueryCdpsResponse")
+	proto.RegisterType((*QueryAdjustCdpRequest)(nil), "fury.cdp.v1beta1.QueryAdjustCdpRequest")
+	proto.RegisterType((*QueryAdjustCdpResponse)(nil), "fury.cdp.v1beta1.QueryAdjustCdpResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "fury.cdp.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "fury.cdp.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryTotalPrincipalRequest)(nil), "fury.cdp.v1beta1.QueryTotalPrincipalRequest")
+	proto.RegisterType((*QueryTotalPrincipalResponse)(nil), "fury.cdp.v1beta1.QueryTotalPrincipalResponse")
+	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "fury.cdp.v1beta1.QueryTotalCollateralRequest")
+	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "fury.cdp.v1beta1.QueryTotalCollateralResponse")
+	proto.RegisterType((*QueryLiquidationStatsRequest)(nil), "fury.cdp.v1beta1.QueryLiquidationStatsRequest")
+	proto.RegisterType((*QueryLiquidationStatsResponse)(nil), "fury.cdp.v1beta1.QueryLiquidationStatsResponse")
+	proto.RegisterType((*QueryMultiCdpRequest)(nil), "fury.cdp.v1beta1.QueryMultiCdpRequest")
+	proto.RegisterType((*QueryMultiCdpResponse)(nil), "fury.cdp.v1beta1.QueryMultiCdpResponse")
+	proto.RegisterType((*QueryMultiCdpsRequest)(nil), "fury.cdp.v1beta1.QueryMultiCdpsRequest")
+	proto.RegisterType((*QueryMultiCdpsResponse)(nil), "fury.cdp.v1beta1.QueryMultiCdpsResponse")
+	proto.RegisterType((*QueryCdpHealthRequest)(nil), "fury.cdp.v1beta1.QueryCdpHealthRequest")
+	proto.RegisterType((*QueryCdpHealthResponse)(nil), "fury.cdp.v1beta1.QueryCdpHealthResponse")
+	proto.RegisterType((*QueryMultiCdpHealthRequest)(nil), "fury.cdp.v1beta1.QueryMultiCdpHealthRequest")
+	proto.RegisterType((*QueryMultiCdpHealthResponse)(nil), "fury.cdp.v1beta1.QueryMultiCdpHealthResponse")
+	proto.RegisterType((*QueryCdpsAtRiskRequest)(nil), "fury.cdp.v1beta1.QueryCdpsAtRiskRequest")
+	proto.RegisterType((*QueryCdpsAtRiskResponse)(nil), "fury.cdp.v1beta1.QueryCdpsAtRiskResponse")
+	proto.RegisterType((*CDPResponse)(nil), "fury.cdp.v1beta1.CDPResponse")
+	proto.RegisterType((*MultiCDPResponse)(nil), "fury.cdp.v1beta1.MultiCDPResponse")
+	proto.RegisterType((*CDPHealthResponse)(nil), "fury.cdp.v1beta1.CDPHealthResponse")
+	proto.RegisterType((*LiquidationPrice)(nil), "fury.cdp.v1beta1.LiquidationPrice")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func init() { proto.RegisterFile("fury/cdp/v1beta1/query.proto", fileDescriptor_f8caaf4da7412dac) }
+
+var fileDescriptor_f8caaf4da7412dac = []byte{
+	// 1873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xd8, 0x6b, 0x67, 0x7d, 0x1c, 0xec, 0xf5, 0xc5, 0x71, 0x26, 0x13, 0x67, 0xed, 0x8c,
+	0xdb, 0xd8, 0x49, 0xeb, 0xdd, 0xd6, 0x88, 0x96, 0x06, 0x50, 0xe4, 0xb5, 0x71, 0x09, 0x4a, 0xa5,
+	0x64, 0x12, 0x5a, 0x44, 0x05, 0xdb, 0xf1, 0xcc, 0xf5, 0x7a, 0xda, 0xdd, 0x99, 0xc9, 0xdc, 0xbb,
+	0x75, 0x4c, 0x55, 0x21, 0x3e, 0x54, 0x15, 0xf1, 0x52, 0xc1, 0x03, 0x42, 0x48, 0x50, 0x1e, 0x78,
+	0xe1, 0x39, 0x12, 0xaf, 0x3c, 0xf6, 0x05, 0xa9, 0x94, 0x17, 0xc4, 0x43, 0x1a, 0x1c, 0x1e, 0xf8,
+	0x33, 0xd0, 0xbd, 0x73, 0x66, 0x76, 0x3e, 0x76, 0xbc, 0xeb, 0xc4, 0x41, 0x3c, 0xf0, 0x62, 0xef,
+	0x9c, 0xcf, 0xdf, 0x39, 0xf7, 0xdc, 0x7b, 0xee, 0x3d, 0xb0, 0xb0, 0xdb, 0x0d, 0x0e, 0xea, 0x96,
+	0xed, 0xd7, 0xdf, 0x7d, 0x71, 0x87, 0x72, 0xf3, 0xc5, 0xfa, 0xdd, 0x2e, 0x0d, 0x0e, 0x6a, 0x7e,
+	0xe0, 0x71, 0x8f, 0x54, 0x04, 0xb7, 0x66, 0xd9, 0x7e, 0x0d, 0xb9, 0x5a, 0xd5, 0xf2, 0x58, 0xc7,
+	0x63, 0x75, 0xb3, 0xcb, 0xf7, 0x62, 0x15, 0xf1, 0x11, 0x6a, 0x68, 0x57, 0x90, 0xbf, 0x63, 0x32,
+	0x1a, 0x9a, 0x8a, 0xa5, 0x7c, 0xb3, 0xe5, 0xb8, 0x26, 0x77, 0x3c, 0x17, 0x65, 0xab, 0x49, 0xd9,
+	0x48, 0xca, 0xf2, 0x9c, 0x88, 0x7f, 0x2e, 0xe4, 0x37, 0xe5, 0x57, 0x3d, 0xfc, 0x40, 0x96, 0x96,
+	0x83, 0x2d, 0x40, 0xa2, 0xd9, 0x1c, 0xaf, 0x45, 0x5d, 0xca, 0x9c, 0x48, 0x77, 0xae, 0xe5, 0xb5,
+	0xbc, 0xd0, 0xa6, 0xf8, 0x85, 0xd4, 0x85, 0x96, 0xe7, 0xb5, 0xda, 0xb4, 0x6e, 0xfa, 0x4e, 0xdd,
+	0x74, 0x5d, 0x8f, 0x4b, 0xa4, 0x91, 0xce, 0x22, 0x72, 0xe5, 0xd7, 0x4e, 0x77, 0xb7, 0xce, 0x9d,
+	0x0e, 0x65, 0xdc, 0xec, 0xa0, 0x53, 0x7d, 0x0e, 0xc8, 0x2d, 0x11, 0xed, 0x4d, 0x33, 0x30, 0x3b,
+	0xcc, 0xa0, 0x77, 0xbb, 0x94, 0x71, 0xfd, 0x0d, 0xf8, 0x62, 0x8a, 0xca, 0x7c, 0xcf, 0x65, 0x94,
+	0xbc, 0x04, 0x13, 0xbe, 0xa4, 0xa8, 0xca, 0x92, 0xb2, 0x3a, 0xb5, 0xae, 0xd6, 0xb2, 0x79, 0xae,
+	0x85, 0x1a, 0x8d, 0xd2, 0x27, 0x0f, 0x16, 0x47, 0x0c, 0x94, 0xbe, 0x5a, 0xfe, 0xf0, 0xe3, 0xc5,
+	0x91, 0x7f, 0x7f, 0xbc, 0x38, 0xa2, 0xcf, 0xc3, 0x9c, 0x34, 0xbc, 0x61, 0x59, 0x5e, 0xd7, 0xe5,
+	0xb1, 0xc3, 0xef, 0xc1, 0x99, 0x0c, 0x1d, 0x5d, 0x6e, 0x41, 0xd9, 0x44, 0x9a, 0xaa, 0x2c, 0x8d,
+	0xad, 0x4e, 0xad, 0xeb, 0x35, 0xcc, 0xa8, 0x5c, 0xbd, 0xc8, 0xef, 0x6b, 0x9e, 0xdd, 0x6d, 0x53,
+	0x54, 0x47, 0xf7, 0xb1, 0xa6, 0xfe, 0x36, 0xcc, 0x48, 0xf3, 0x9b, 0xb6, 0x8f, 0x1e, 0xc9, 0x0a,
+	0xcc, 0x58, 0x5e, 0xbb, 0x6d, 0x72, 0x1a, 0x98, 0xed, 0x26, 0x3f, 0xf0, 0xa9, 0x0c, 0x6a, 0xd2,
+	0x98, 0xee, 0x91, 0xef, 0x1c, 0xf8, 0x94, 0xd4, 0x60, 0xdc, 0xdb, 0x77, 0x69, 0xa0, 0x8e, 0x0a,
+	0x76, 0x43, 0xfd, 0xec, 0xfe, 0xda, 0x1c, 0x22, 0xd8, 0xb0, 0xed, 0x80, 0x32, 0x76, 0x9b, 0x07,
+	0x8e, 0xdb, 0x32, 0x42, 0x31, 0xfd, 0x3a, 0x54, 0x7a, 0xbe, 0x30, 0x8a, 0x2f, 0xc3, 0x98, 0x65,
+	0xfb, 0x98, 0xb5, 0x0b, 0xf9, 0xac, 0x6d, 0x6e, 0xdd, 0x8c, 0x64, 0x11, 0xbb, 0x90, 0xd7, 0xff,
+	0xa9, 0xf4, 0x6c, 0xb1, 0xa7, 0x0d, 0x9c, 0xcc, 0xc3, 0xa8, 0x63, 0xab, 0x63, 0x4b, 0xca, 0x6a,
+	0xa9, 0x31, 0x71, 0xf8, 0x60, 0x71, 0xf4, 0xfa, 0x96, 0x31, 0xea, 0xd8, 0x64, 0x0e, 0xc6, 0x03,
+	0x51, 0x54, 0x6a, 0x49, 0xba, 0x09, 0x3f, 0xc8, 0x36, 0x40, 0x6f, 0x63, 0xa8, 0xe3, 0x32, 0xb2,
+	0x4b, 0xd1, 0xd2, 0x88, 0x9d, 0x51, 0x0b, 0x37, 0x64, 0xaf, 0x30, 0x5a, 0x14, 0x43, 0x30, 0x12,
+	0x9a, 0xfa, 0x1f, 0x14, 0x98, 0x4d, 0xc4, 0x88, 0x09, 0x7b, 0x15, 0x4a, 0x96, 0xed, 0x47, 0x4b,
+	0x3e, 0x20, 0x63, 0x73, 0x22, 0x63, 0x7f, 0xfc, 0x7c, 0xf1, 0x74, 0x82, 0xc8, 0x0c, 0x69, 0x80,
+	0xbc, 0x9a, 0x82, 0x39, 0x2a, 0x61, 0xae, 0x0c, 0x84, 0x19, 0xda, 0x48, 0xe1, 0xfc, 0xf3, 0x68,
+	0x54, 0xa2, 0xf6, 0xdb, 0x5d, 0xc6, 0xff, 0x0b, 0x95, 0x44, 0x5a, 0x50, 0x49, 0x18, 0xb6, 0x69,
+	0x9b, 0x9b, 0x72, 0x79, 0x26, 0x1b, 0x5f, 0x13, 0x11, 0xff, 0xe3, 0xc1, 0xe2, 0xa5, 0x96, 0xc3,
+	0xf7, 0xba, 0x3b, 0x35, 0xcb, 0xeb, 0xe0, 0x39, 0x83, 0xff, 0xd6, 0x98, 0xfd, 0x4e, 0x5d, 0x40,
+	0x61, 0xb5, 0xeb, 0x2e, 0xff, 0xec, 0xfe, 0x1a, 0xa0, 0xa3, 0xeb, 0x2e, 0x37, 0x12, 0x70, 0xb7,
+	0x84, 0x51, 0x42, 0x61, 0xc6, 0x0f, 0x1c, 0xd7, 0x72, 0xfc, 0xd8, 0x4f, 0xe9, 0x04, 0xfc, 0x4c,
+	0xc7, 0x46, 0xa5, 0x1b, 0xbd, 0x05, 0xf3, 0xd9, 0x0c, 0x3e, 0xd1, 0xfe, 0x20, 0xf3, 0x30, 0x61,
+	0xb5, 0x3d, 0x46, 0x6d, 0x99, 0xd1, 0xb2, 0x81, 0x5f, 0xba, 0x87, 0xa7, 0xcc, 0x16, 0xf5, 0x3d,
+	0xe6, 0xf0, 0xa7, 0xbe, 0x75, 0xf4, 0xb7, 0xe0, 0x4c, 0xc6, 0x61, 0x5c, 0xc7, 0x65, 0x1b, 0x69,
+	0x58, 0xcb, 0xe7, 0xf2, 0xd1, 0xa1, 0x56, 0xa3, 0x82, 0x75, 0x5c, 0x8e, 0xcd, 0xc4, 0xca, 0xfa,
+	0x37, 0x40, 0x93, 0x1e, 0xee, 0x78, 0xdc, 0x6c, 0xdf, 0x8c, 0xf2, 0x7a, 0xdc, 0xc0, 0xf4, 0x1f,
+	0x29, 0x70, 0xbe, 0xaf, 0x1d, 0xc4, 0xbb, 0x03, 0x33, 0x5c, 0x70, 0x9a, 0xf1, 0xd2, 0x21, 0xec,
+	0xa5, 0x3c, 0xec, 0xb4, 0x89, 0xc6, 0x59, 0x44, 0x3f, 0x93, 0xa6, 0x33, 0x63, 0x9a, 0xa7, 0x08,
+	0xfa, 0x76, 0x12, 0xc2, 0x66, 0x8c, 0xef, 0xd8, 0xb1, 0x7c, 0xa0, 0xc0, 0x42, 0x7f, 0x43, 0x18,
+	0xcc, 0x2e, 0x54, 0xc2, 0x60, 0x7a, 0x8a, 0x18, 0xcd, 0xc5, 0x82, 0x68, 0x7a, 0x46, 0x1a, 0x2a,
+	0x86, 0x53, 0xc9, 0x30, 0x98, 0x31, 0xc3, 0xd3, 0x14, 0xfd, 0x2f, 0x11, 0x90, 0x1b, 0xce, 0xdd,
+	0xae, 0x63, 0xcb, 0xf3, 0xe2, 0x36, 0x37, 0x1f, 0xa3, 0xee, 0x36, 0x01, 0x18, 0x37, 0x03, 0xde,
+	0x14, 0x6d, 0x1a, 0x4f, 0x2b, 0xad, 0x16, 0xf6, 0xf0, 0x5a, 0xd4, 0xc3, 0x6b, 0x77, 0xa2, 0x1e,
+	0xde, 0x28, 0x0b, 0x90, 0x1f, 0x7d, 0xbe, 0xa8, 0x18, 0x93, 0x52, 0x4f, 0x70, 0xc8, 0x35, 0x28,
+	0x53, 0xd7, 0x0e, 0x4d, 0x8c, 0x1d, 0xc3, 0xc4, 0x29, 0xea, 0xda, 0x82, 0xae, 0xff, 0x55, 0x81,
+	0x0b, 0x05, 0xf1, 0x60, 0x66, 0x5f, 0x87, 0x71, 0x26, 0x08, 0x71, 0x4b, 0xce, 0xa5, 0x33, 0xab,
+	0xda, 0x58, 0xc0, 0x7c, 0xce, 0x65, 0x39, 0x37, 0x1c, 0xc6, 0x8d, 0xd0, 0x1c, 0xf9, 0x0e, 0x4c,
+	0xc8, 0xe4, 0x32, 0x75, 0xf4, 0x84, 0x0c, 0xa3, 0x3d, 0x7d, 0x1b, 0x8f, 0x84, 0xd7, 0xba, 0x6d,
+	0xee, 0x24, 0x0e, 0xef, 0x78, 0xa7, 0x2b, 0xc3, 0xed, 0xf4, 0xdb, 0x70, 0x26, 0x63, 0x07, 0x53,
+	0x72, 0x35, 0x79, 0x84, 0xf5, 0xc1, 0x1d, 0x2a, 0xf4, 0xef, 0xf3, 0xcd, 0x8c, 0xd1, 0xb8, 0x70,
+	0xd2, 0x4d, 0x56, 0x79, 0xec, 0x26, 0x7b, 0x5f, 0x81, 0xf9, 0xac, 0x07, 0xc4, 0x7d, 0x2b, 0xd5,
+	0x69, 0x87, 0x01, 0x7e, 0x0e, 0x13, 0x3e, 0x9b, 0xe5, 0x9c, 0x78, 0xcf, 0xf5, 0x31, 0x2f, 0x9b,
+	0xb6, 0xff, 0x4d, 0x6a, 0xb6, 0xf9, 0xde, 0x53, 0x3f, 0xc8, 0xdf, 0x84, 0xf9, 0xac, 0x47, 0xcc,
+	0xd3, 0x06, 0x4c, 0xec, 0x49, 0x0a, 0x2e, 0xc3, 0x72, 0xdf, 0x2e, 0x95, 0x56, 0x8a, 0xae, 0xc1,
+	0xa1, 0xa2, 0x7e, 0x03, 0xcf, 0xf0, 0x68, 0x11, 0xd2, 0x31, 0x1d, 0xb7, 0x12, 0xdf, 0x82, 0xf3,
+	0x7d, 0xad, 0x9d, 0x1c, 0xde, 0x9f, 0x29, 0xbd, 0x6c, 0xb0, 0x0d, 0x6e, 0x38, 0xec, 0x9d, 0x63,
+	0x2f, 0xc0, 0x76, 0x9f, 0x5a, 0x78, 0x9c, 0x0a, 0xfe, 0x93, 0x02, 0x67, 0x73, 0x58, 0x30, 0xd4,
+	0xdb, 0xa9, 0x12, 0x1e, 0x2a, 0x50, 0x0d, 0x6b, 0x98, 0xe4, 0x58, 0x27, 0x5e, 0xc4, 0xbf, 0x28,
+	0xc1, 0x54, 0x62, 0x93, 0xe0, 0x35, 0x5b, 0xe9, 0x77, 0xcd, 0x4e, 0x94, 0x6a, 0x74, 0x07, 0x24,
+	0x50, 0x92, 0xd9, 0x95, 0xf7, 0x3e, 0x43, 0xfe, 0x26, 0xd7, 0x00, 0x12, 0x1d, 0xad, 0x24, 0xa1,
+	0x9d, 0x4b, 0x41, 0x8b, 0x03, 0xf7, 0x1c, 0x17, 0x17, 0x35, 0xa1, 0x42, 0xbe, 0x0e, 0x93, 0xbd,
+	0xfe, 0x3e, 0x3e, 0x9c, 0x7e, 0x4f, 0x83, 0x7c, 0x0b, 0x2a, 0xa6, 0x65, 0x75, 0x3b, 0x5d, 0x61,
+	0xcf, 0x6e, 0xee, 0x52, 0xca, 0xd4, 0x89, 0xe1, 0xac, 0xcc, 0x24, 0x14, 0xb7, 0x29, 0x15, 0x69,
+	0x3e, 0x2d, 0xf4, 0x9b, 0x5d, 0xdf, 0x16, 0x34, 0xf5, 0xd4, 0x31, 0x1a, 0xd6, 0x94, 0xd0, 0xfc,
+	0x76, 0xa8, 0x28, 0x2a, 0xd2, 0x71, 0x39, 0x0d, 0x28, 0xe3, 0xcd, 0x5d, 0xd3, 0xe2, 0x5e, 0xa0,
+	0x96, 0xc3, 0x8a, 0x8c, 0xc8, 0xdb, 0x92, 0x2a, 0xd0, 0x27, 0x4a, 0xf7, 0x5d, 0xb3, 0xdd, 0xa5,
+	0xea, 0xe4, 0x90, 0xe8, 0x7b, 0x8a, 0xaf, 0x0b, 0x3d, 0xf2, 0x32, 0x9c, 0xed, 0x91, 0x9c, 0x1f,
+	0xc8, 0x05, 0x6f, 0x86, 0x8f, 0x25, 0x90, 0xce, 0xe7, 0x73, 0x6c, 0x43, 0xfc, 0xd5, 0x1f, 0x96,
+	0xa0, 0x92, 0x3d, 0x3e, 0x4f, 0xa0, 0x32, 0xde, 0xcc, 0x54, 0x46, 0xd1, 0x5d, 0xe7, 0xc0, 0xa7,
+	0x76, 0xdf, 0xbb, 0x4e, 0x9a, 0xc1, 0xfe, 0x5f, 0x35, 0xff, 0x4b, 0x55, 0x43, 0x9e, 0x83, 0xd9,
+	0x76, 0xef, 0x92, 0x83, 0x2a, 0x53, 0x52, 0xa5, 0x92, 0x60, 0x84, 0x25, 0xf6, 0xd3, 0x31, 0x98,
+	0xcd, 0x9d, 0x6e, 0x27, 0x50, 0x63, 0xcb, 0xf0, 0x85, 0xb0, 0x3f, 0x44, 0x09, 0x0b, 0xc7, 0x02,
+	0xa7, 0x43, 0x22, 0xa6, 0xab, 0x0d, 0x24, 0x89, 0xd4, 0x0f, 0x1c, 0x8b, 0x32, 0x75, 0x7c, 0x88,
+	0x4b, 0xdd, 0x4d, 0x21, 0xda, 0xbb, 0x63, 0x64, 0x39, 0xcc, 0x98, 0x6d, 0x67, 0x49, 0xe4, 0x2a,
+	0x94, 0x3b, 0xe6, 0xbd, 0xa6, 0x1d, 0x98, 0xfb, 0xc3, 0x96, 0xd4, 0xa9, 0x8e, 0x79, 0x6f, 0x2b,
+	0x30, 0xf7, 0xc9, 0xf7, 0xe1, 0xb4, 0xd0, 0xdd, 0x77, 0xf8, 0x9e, 0xd4, 0x3f, 0xf5, 0xe4, 0x9b,
+	0x66, 0xaa, 0x63, 0xde, 0x7b, 0x03, 0xed, 0xe9, 0xb7, 0xa0, 0x92, 0x8d, 0x61, 0xf8, 0xee, 0x39,
+	0x07, 0xe3, 0x32, 0x75, 0xd1, 0xaa, 0xc8, 0x8f, 0xf5, 0x9f, 0x57, 0x60, 0x5c, 0xf6, 0x42, 0xb2,
+	0x0f, 0x13, 0xe1, 0xc0, 0x8d, 0x3c, 0x93, 0x07, 0x9c, 0x9f, 0xeb, 0x69, 0xcf, 0x0e, 0x90, 0x0a,
+	0x8b, 0x44, 0x5f, 0xfa, 0xf1, 0xdf, 0xfe, 0xf5, 0xcb, 0x51, 0x8d, 0xa8, 0xf5, 0xdc, 0x48, 0x32,
+	0x9c, 0xe8, 0x91, 0x1f, 0x42, 0x39, 0x1a, 0xd5, 0x91, 0x4b, 0x05, 0x46, 0x33, 0x33, 0x3e, 0x6d,
+	0x65, 0xa0, 0x1c, 0xba, 0xd7, 0xa5, 0xfb, 0x05, 0xa2, 0xe5, 0xdd, 0x47, 0x13, 0x3d, 0xf2, 0x2b,
+	0x05, 0xa6, 0xd3, 0x0f, 0x4d, 0xf2, 0x7c, 0x81, 0xfd, 0xbe, 0x4f, 0x66, 0x6d, 0x6d, 0x48, 0x69,
+	0xc4, 0xb4, 0x2a, 0x31, 0xe9, 0x64, 0x29, 0x8f, 0x29, 0xfd, 0xbc, 0x25, 0xbf, 0x51, 0x60, 0x26,
+	0xf3, 0x66, 0x24, 0x47, 0x3a, 0xcb, 0x3d, 0x81, 0xb5, 0xda, 0xb0, 0xe2, 0x08, 0xee, 0xb2, 0x04,
+	0xb7, 0x4c, 0x2e, 0x16, 0x80, 0x4b, 0x20, 0xf1, 0xa0, 0x24, 0x6e, 0x50, 0x44, 0x2f, 0x70, 0x91,
+	0x78, 0x7d, 0x68, 0xcb, 0x47, 0xca, 0xa0, 0xef, 0xaa, 0xf4, 0xad, 0x92, 0xf9, 0x7a, 0xbf, 0xd1,
+	0x36, 0x23, 0x1f, 0x28, 0x30, 0xb6, 0x69, 0xfb, 0xe4, 0x62, 0xb1, 0xb1, 0xc8, 0x9f, 0x7e, 0x94,
+	0x08, 0xba, 0xfb, 0x8a, 0x74, 0xb7, 0x4e, 0x5e, 0xe8, 0xef, 0xae, 0xfe, 0x9e, 0x3c, 0xb8, 0xde,
+	0xaf, 0xbf, 0x97, 0xd9, 0x60, 0xef, 0x93, 0xdf, 0x29, 0x30, 0x19, 0x4f, 0x9e, 0x48, 0x61, 0x31,
+	0x66, 0xa6, 0x7b, 0xda, 0xea, 0x60, 0x41, 0x84, 0x76, 0x4d, 0x42, 0x7b, 0x85, 0xbc, 0x7c, 0x5c,
+	0x68, 0x75, 0x53, 0xda, 0x22, 0xbf, 0x55, 0x20, 0x1e, 0xfd, 0x14, 0xee, 0xaa, 0xcc, 0x4c, 0x4b,
+	0x5b, 0x19, 0x28, 0x87, 0xf0, 0x36, 0x24, 0xbc, 0xaf, 0x92, 0x57, 0x0a, 0xe0, 0x45, 0xa3, 0xa6,
+	0xa3, 0x53, 0x58, 0xc9, 0xbe, 0xb2, 0x49, 0x51, 0xb1, 0x16, 0x0c, 0x43, 0xb4, 0xfa, 0xd0, 0xf2,
+	0x08, 0xfc, 0x8a, 0x04, 0xfe, 0x0c, 0xd1, 0xf3, 0xc0, 0xdb, 0x59, 0x30, 0x1f, 0x2a, 0x50, 0x8e,
+	0x1e, 0x44, 0x85, 0x29, 0xcc, 0xcc, 0x00, 0xb4, 0x95, 0x81, 0x72, 0x88, 0xe4, 0x39, 0x89, 0xe4,
+	0x59, 0xb2, 0x9c, 0x47, 0xd2, 0x41, 0xd9, 0x38, 0x7d, 0xe4, 0x27, 0x0a, 0x4c, 0x46, 0x16, 0x18,
+	0x19, 0xe4, 0x83, 0x0d, 0xaa, 0xb7, 0xdc, 0xcb, 0x5d, 0x5f, 0x96, 0x68, 0x2e, 0x90, 0xf3, 0x47,
+	0xa0, 0x91, 0x55, 0x1f, 0x3f, 0x0e, 0x0b, 0x51, 0x64, 0x1f, 0xa3, 0xda, 0xea, 0x60, 0xc1, 0x27,
+	0xae, 0xfa, 0xf0, 0xc2, 0x40, 0x7e, 0xaf, 0xc0, 0x74, 0xfa, 0x0d, 0x5b, 0x78, 0x92, 0xf7, 0x7d,
+	0x38, 0x6b, 0x6b, 0x43, 0x4a, 0x23, 0xe0, 0x75, 0x09, 0xf8, 0x79, 0x72, 0x65, 0x88, 0x45, 0x8c,
+	0x30, 0xfe, 0x5a, 0x01, 0xe8, 0x3d, 0x3c, 0xc9, 0x11, 0xd9, 0x49, 0xbf, 0x93, 0xb5, 0xcb, 0x43,
+	0x48, 0x22, 0xae, 0x97, 0x24, 0xae, 0x17, 0x48, 0xad, 0x7f, 0x22, 0x43, 0xe9, 0x7c, 0x1a, 0x1b,
+	0x9b, 0x9f, 0x1c, 0x56, 0x95, 0x4f, 0x0f, 0xab, 0xca, 0xc3, 0xc3, 0xaa, 0xf2, 0xd1, 0xa3, 0xea,
+	0xc8, 0xa7, 0x8f, 0xaa, 0x23, 0x7f, 0x7f, 0x54, 0x1d, 0xf9, 0xee, 0xe5, 0xc4, 0xd4, 0xde, 0xa7,
+	0x81, 0xe5, 0x31, 0x87, 0xad, 0xb5, 0xcd, 0x1d, 0x16, 0x7a, 0xb8, 0x27, 0x7d, 0x08, 0x23, 0x6c,
+	0x67, 0x42, 0x5e, 0x99, 0xbf, 0xf4, 0x9f, 0x01, 0x00, 0x03, 0x6e, 0x77, 0xaf, 0x57, 0x1d, 0x00,
+	0x00,
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the cdp module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Accounts queries the CDP module accounts.
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// TotalPrincipal queries the total principal of a given collateral type.
+	TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error)
+	// TotalCollateral queries the total collateral of a given collateral type.
+	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
+	// Cdps queries all active CDPs.
+	Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error)
+	// Cdp queries a CDP with the input owner address and collateral type.
+	Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error)
+	// AdjustCdp simulates adjusting the CDP owned by an address for a collateral type, returning the resulting CDP.
+	AdjustCdp(ctx context.Context, in *QueryAdjustCdpRequest, opts ...grpc.CallOption) (*QueryAdjustCdpResponse, error)
+	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// LiquidationStats queries the liquidation totals of collateral types over time windows.
+	LiquidationStats(ctx context.Context, in *QueryLiquidationStatsRequest, opts ...grpc.CallOption) (*QueryLiquidationStatsResponse, error)
+	// MultiCdp queries the multi-collateral CDP owned by an address.
+	MultiCdp(ctx context.Context, in *QueryMultiCdpRequest, opts ...grpc.CallOption) (*QueryMultiCdpResponse, error)
+	// MultiCdps queries all active multi-collateral CDPs.
+	MultiCdps(ctx context.Context, in *QueryMultiCdpsRequest, opts ...grpc.CallOption) (*QueryMultiCdpsResponse, error)
+	// CdpHealth queries how close the CDP owned by an address for a collateral type is to liquidation.
+	CdpHealth(ctx context.Context, in *QueryCdpHealthRequest, opts ...grpc.CallOption) (*QueryCdpHealthResponse, error)
+	// MultiCdpHealth queries how close the multi-collateral CDP owned by an address is to liquidation.
+	MultiCdpHealth(ctx context.Context, in *QueryMultiCdpHealthRequest, opts ...grpc.CallOption) (*QueryMultiCdpHealthResponse, error)
+	// CdpsAtRisk queries the CDPs of a collateral type, ordered from the lowest collateral to debt ratio.
+	CdpsAtRisk(ctx context.Context, in *QueryCdpsAtRiskRequest, opts ...grpc.CallOption) (*QueryCdpsAtRiskResponse, error)
 }
 
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryCdpRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error) {
+	out := new(QueryTotalPrincipalResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/TotalPrincipal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCdpResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error) {
+	out := new(QueryTotalCollateralResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/TotalCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Cdp.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryCdpsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error) {
+	out := new(QueryCdpsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Cdps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error) {
+	out := new(QueryCdpResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Cdp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AdjustCdp(ctx context.Context, in *QueryAdjustCdpRequest, opts ...grpc.CallOption) (*QueryAdjustCdpResponse, error) {
+	out := new(QueryAdjustCdpResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/AdjustCdp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
+	return out, nil
+}
+
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Ratio)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) LiquidationStats(ctx context.Context, in *QueryLiquidationStatsRequest, opts ...grpc.CallOption) (*QueryLiquidationStatsResponse, error) {
+	out := new(QueryLiquidationStatsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/LiquidationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) MultiCdp(ctx context.Context, in *QueryMultiCdpRequest, opts ...grpc.CallOption) (*QueryMultiCdpResponse, error) {
+	out := new(QueryMultiCdpResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/MultiCdp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCdpsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MultiCdps(ctx context.Context, in *QueryMultiCdpsRequest, opts ...grpc.CallOption) (*QueryMultiCdpsResponse, error) {
+	out := new(QueryMultiCdpsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/MultiCdps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Cdps) > 0 {
-		for _, e := range m.Cdps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) CdpHealth(ctx context.Context, in *QueryCdpHealthRequest, opts ...grpc.CallOption) (*QueryCdpHealthResponse, error) {
+	out := new(QueryCdpHealthResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/CdpHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) MultiCdpHealth(ctx context.Context, in *QueryMultiCdpHealthRequest, opts ...grpc.CallOption) (*QueryMultiCdpHealthResponse, error) {
+	out := new(QueryMultiCdpHealthResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/MultiCdpHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CdpsAtRisk(ctx context.Context, in *QueryCdpsAtRiskRequest, opts ...grpc.CallOption) (*QueryCdpsAtRiskResponse, error) {
+	out := new(QueryCdpsAtRiskResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/CdpsAtRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the cdp module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Accounts queries the CDP module accounts.
+	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	// TotalPrincipal queries the total principal of a given collateral type.
+	TotalPrincipal(context.Context, *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error)
+	// TotalCollateral queries the total collateral of a given collateral type.
+	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
+	// Cdps queries all active CDPs.
+	Cdps(context.Context, *QueryCdpsRequest) (*QueryCdpsResponse, error)
+	// Cdp queries a CDP with the input owner address and collateral type.
+	Cdp(context.Context, *QueryCdpRequest) (*QueryCdpResponse, error)
+	// AdjustCdp simulates adjusting the CDP owned by an address for a collateral type, returning the resulting CDP.
+	AdjustCdp(context.Context, *QueryAdjustCdpRequest) (*QueryAdjustCdpResponse, error)
+	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// LiquidationStats queries the liquidation totals of collateral types over time windows.
+	LiquidationStats(context.Context, *QueryLiquidationStatsRequest) (*QueryLiquidationStatsResponse, error)
+	// MultiCdp queries the multi-collateral CDP owned by an address.
+	MultiCdp(context.Context, *QueryMultiCdpRequest) (*QueryMultiCdpResponse, error)
+	// MultiCdps queries all active multi-collateral CDPs.
+	MultiCdps(context.Context, *QueryMultiCdpsRequest) (*QueryMultiCdpsResponse, error)
+	// CdpHealth queries how close the CDP owned by an address for a collateral type is to liquidation.
+	CdpHealth(context.Context, *QueryCdpHealthRequest) (*QueryCdpHealthResponse, error)
+	// MultiCdpHealth queries how close the multi-collateral CDP owned by an address is to liquidation.
+	MultiCdpHealth(context.Context, *QueryMultiCdpHealthRequest) (*QueryMultiCdpHealthResponse, error)
+	// CdpsAtRisk queries the CDPs of a collateral type, ordered from the lowest collateral to debt ratio.
+	CdpsAtRisk(context.Context, *QueryCdpsAtRiskRequest) (*QueryCdpsAtRiskResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedQueryServer) TotalPrincipal(ctx context.Context, req *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPrincipal not implemented")
+}
+func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalCollateral not implemented")
+}
+func (*UnimplementedQueryServer) Cdps(ctx context.Context, req *QueryCdpsRequest) (*QueryCdpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cdps not implemented")
+}
+func (*UnimplementedQueryServer) Cdp(ctx context.Context, req *QueryCdpRequest) (*QueryCdpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cdp not implemented")
+}
+func (*UnimplementedQueryServer) AdjustCdp(ctx context.Context, req *QueryAdjustCdpRequest) (*QueryAdjustCdpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustCdp not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) LiquidationStats(ctx context.Context, req *QueryLiquidationStatsRequest) (*QueryLiquidationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidationStats not implemented")
+}
+func (*UnimplementedQueryServer) MultiCdp(ctx context.Context, req *QueryMultiCdpRequest) (*QueryMultiCdpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCdp not implemented")
+}
+func (*UnimplementedQueryServer) MultiCdps(ctx context.Context, req *QueryMultiCdpsRequest) (*QueryMultiCdpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCdps not implemented")
+}
+func (*UnimplementedQueryServer) CdpHealth(ctx context.Context, req *QueryCdpHealthRequest) (*QueryCdpHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CdpHealth not implemented")
+}
+func (*UnimplementedQueryServer) MultiCdpHealth(ctx context.Context, req *QueryMultiCdpHealthRequest) (*QueryMultiCdpHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCdpHealth not implemented")
+}
+func (*UnimplementedQueryServer) CdpsAtRisk(ctx context.Context, req *QueryCdpsAtRiskRequest) (*QueryCdpsAtRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CdpsAtRisk not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalPrincipalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TotalPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPrincipalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPrincipal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/TotalPrincipal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPrincipal(ctx, req.(*QueryTotalPrincipalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalPrincipalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TotalCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TotalPrincipal) > 0 {
-		for _, e := range m.TotalPrincipal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalCollateral(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/TotalCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalCollateral(ctx, req.(*QueryTotalCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Cdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Cdps(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/Cdps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cdps(ctx, req.(*QueryCdpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Cdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TotalCollateral) > 0 {
-		for _, e := range m.TotalCollateral {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Cdp(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/Cdp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cdp(ctx, req.(*QueryCdpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CDPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AdjustCdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdjustCdpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
+	if interceptor == nil {
+		return srv.(QueryServer).AdjustCdp(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/AdjustCdp",
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdjustCdp(ctx, req.(*QueryAdjustCdpRequest))
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AccumulatedFees.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.InterestFactor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/LiquidationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidationStats(ctx, req.(*QueryLiquidationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCdpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCdp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/MultiCdp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCdp(ctx, req.(*QueryMultiCdpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCdpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCdps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/MultiCdps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCdps(ctx, req.(*QueryMultiCdpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CdpHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CdpHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/CdpHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CdpHealth(ctx, req.(*QueryCdpHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCdpHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCdpHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCdpHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/MultiCdpHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCdpHealth(ctx, req.(*QueryMultiCdpHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CdpsAtRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpsAtRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CdpsAtRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/CdpsAtRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CdpsAtRisk(ctx, req.(*QueryCdpsAtRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.cdp.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "TotalPrincipal",
+			Handler:    _Query_TotalPrincipal_Handler,
+		},
+		{
+			MethodName: "TotalCollateral",
+			Handler:    _Query_TotalCollateral_Handler,
+		},
+		{
+			MethodName: "Cdps",
+			Handler:    _Query_Cdps_Handler,
+		},
+		{
+			MethodName: "Cdp",
+			Handler:    _Query_Cdp_Handler,
+		},
+		{
+			MethodName: "AdjustCdp",
+			Handler:    _Query_AdjustCdp_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "LiquidationStats",
+			Handler:    _Query_LiquidationStats_Handler,
+		},
+		{
+			MethodName: "MultiCdp",
+			Handler:    _Query_MultiCdp_Handler,
+		},
+		{
+			MethodName: "MultiCdps",
+			Handler:    _Query_MultiCdps_Handler,
+		},
+		{
+			MethodName: "CdpHealth",
+			Handler:    _Query_CdpHealth_Handler,
+		},
+		{
+			MethodName: "MultiCdpHealth",
+			Handler:    _Query_MultiCdpHealth_Handler,
+		},
+		{
+			MethodName: "CdpsAtRisk",
+			Handler:    _Query_CdpsAtRisk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/cdp/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Ratio) > 0 {
+		i -= len(m.Ratio)
+		copy(dAtA[i:], m.Ratio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ratio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cdps) > 0 {
+		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdjustCdpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdjustCdpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdjustCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PrincipalDelta.Size()
+		i -= size
+		if _, err := m.PrincipalDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralDelta.Size()
+		i -= size
+		if _, err := m.CollateralDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdjustCdpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdjustCdpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdjustCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPrincipalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPrincipalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPrincipalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPrincipalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalPrincipal) > 0 {
+		for iNdEx := len(m.TotalPrincipal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPrincipal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCollateral) > 0 {
+		for iNdEx := len(m.TotalCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCdpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCdpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCdpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCdpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCdpsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCdpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cdps) > 0 {
+		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCdpHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCdpHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCdpHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCdpHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCdpHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCdpHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpsAtRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpsAtRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpsAtRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpsAtRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpsAtRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpsAtRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cdps) > 0 {
+		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralizationRatio) > 0 {
+		i -= len(m.CollateralizationRatio)
+		copy(dAtA[i:], m.CollateralizationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.InterestFactor) > 0 {
+		i -= len(m.InterestFactor)
+		copy(dAtA[i:], m.InterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidationRatio) > 0 {
+		i -= len(m.LiquidationRatio)
+		copy(dAtA[i:], m.LiquidationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidationRatio)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CollateralizationRatio) > 0 {
+		i -= len(m.CollateralizationRatio)
+		copy(dAtA[i:], m.CollateralizationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.InterestFactor) > 0 {
+		i -= len(m.InterestFactor)
+		copy(dAtA[i:], m.InterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintQuery(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CDPHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CDPHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDPHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxWithdraw) > 0 {
+		for iNdEx := len(m.MaxWithdraw) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxWithdraw[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.MaxDraw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LiquidationPrices) > 0 {
+		for iNdEx := len(m.LiquidationPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.HealthFactor) > 0 {
+		i -= len(m.HealthFactor)
+		copy(dAtA[i:], m.HealthFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HealthFactor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCdpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCdpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cdp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCdpsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Ratio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCdpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cdps) > 0 {
+		for _, e := range m.Cdps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdjustCdpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CollateralDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PrincipalDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAdjustCdpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cdp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Closed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalPrincipalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPrincipalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalPrincipal) > 0 {
+		for _, e := range m.TotalPrincipal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalCollateral) > 0 {
+		for _, e := range m.TotalCollateral {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiquidationStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidationStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMultiCdpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiCdpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cdp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiCdpsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiCdpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cdps) > 0 {
+		for _, e := range m.Cdps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCdpHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCdpHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiCdpHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiCdpHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCdpsAtRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCdpsAtRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cdps) > 0 {
+		for _, e := range m.Cdps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.InterestFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CollateralizationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.InterestFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CollateralizationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CDPHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HealthFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LiquidationPrices) > 0 {
+		for _, e := range m.LiquidationPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MaxDraw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MaxWithdraw) > 0 {
+		for _, e := range m.MaxWithdraw {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidationPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, types.ModuleAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cdps = append(m.Cdps, CDPResponse{})
+			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdjustCdpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdjustCdpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdjustCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrincipalDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdjustCdpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdjustCdpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdjustCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
+			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
+			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, LiquidationStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Totals = append(m.Totals, LiquidationStats{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCdpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCdpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCdpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCdpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCdpsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCdpsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.CollateralValue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.CollateralizationRatio)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryMultiCdpsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCdpsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cdps = append(m.Cdps, MultiCDPResponse{})
+			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCdpHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2117,12 +6798,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCdpHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCdpHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2144,7 +6889,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCdpHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,15 +6912,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCdpHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCdpHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2202,7 +6947,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2227,7 +6972,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMultiCdpHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,12 +6995,44 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultiCdpHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultiCdpHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2277,7 +7054,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMultiCdpHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2300,15 +7077,15 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultiCdpHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultiCdpHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2335,8 +7112,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, types.ModuleAccount{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2361,7 +7137,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCdpsAtRiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,10 +7160,10 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCdpsAtRiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCdpsAtRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2424,9 +7200,9 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2436,23 +7212,27 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2475,7 +7255,7 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCdpsAtRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,15 +7278,15 @@ func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCdpsAtRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCdpsAtRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2533,7 +7313,44 @@ func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cdps = append(m.Cdps, CDPHealthResponse{})
+			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2558,7 +7375,7 @@ func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
+func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2581,15 +7398,34 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2617,11 +7453,11 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2649,13 +7485,13 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2665,16 +7501,30 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2684,27 +7534,28 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ratio = string(dAtA[iNdEx:postIndex])
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2731,66 +7582,78 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2817,16 +7680,15 @@ func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cdps = append(m.Cdps, CDPResponse{})
-			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2836,27 +7698,23 @@ func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2879,7 +7737,7 @@ func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *MultiCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2902,15 +7760,34 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2938,11 +7815,11 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2970,61 +7847,45 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Collateral = append(m.Collateral, TypedCollateral{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3051,66 +7912,48 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3120,77 +7963,60 @@ func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InterestFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3217,64 +8043,13 @@ func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
-			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3302,63 +8077,13 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3368,25 +8093,23 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
-			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3409,7 +8132,7 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CDPResponse) Unmarshal(dAtA []byte) error {
+func (m *CDPHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3432,10 +8155,10 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CDPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CDPHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CDPHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3523,9 +8246,9 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3535,28 +8258,27 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3583,13 +8305,14 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidationPrices = append(m.LiquidationPrices, LiquidationPrice{})
+			if err := m.LiquidationPrices[len(m.LiquidationPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDraw", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3616,13 +8339,13 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdraw", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3649,47 +8372,66 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxWithdraw = append(m.MaxWithdraw, TypedCollateral{})
+			if err := m.MaxWithdraw[len(m.MaxWithdraw)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestFactor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3699,28 +8441,27 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3748,7 +8489,7 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StatsWindowDuration is the length of the time windows liquidation stats are recorded in
+const StatsWindowDuration = 24 * time.Hour
+
+// StatsWindowStart returns the start of the stats window containing the input time
+func StatsWindowStart(t time.Time) time.Time {
+	return t.UTC().Truncate(StatsWindowDuration)
+}
+
+// NewLiquidationStats returns empty liquidation stats for a collateral type and window
+func NewLiquidationStats(collateralType string, windowStart time.Time) LiquidationStats {
+	return LiquidationStats{
+		CollateralType:     collateralType,
+		WindowStart:        windowStart,
+		CollateralSeized:   sdk.NewCoins(),
+		DebtLiquidated:     sdk.NewCoins(),
+		LiquidationPenalty: sdk.NewCoins(),
+		KeeperRewards:      sdk.NewCoins(),
+	}
+}
+
+// Add returns the sum of the counts and amounts of the input stats, keeping the collateral type and window of the receiver
+func (ls LiquidationStats) Add(other LiquidationStats) LiquidationStats {
+	ls.Liquidations += other.Liquidations
+	ls.CollateralSeized = ls.CollateralSeized.Add(other.CollateralSeized...)
+	ls.DebtLiquidated = ls.DebtLiquidated.Add(other.DebtLiquidated...)
+	ls.LiquidationPenalty = ls.LiquidationPenalty.Add(other.LiquidationPenalty...)
+	ls.KeeperRewards = ls.KeeperRewards.Add(other.KeeperRewards...)
+	return ls
+}
+
+// Validate performs a basic validation of liquidation stats
+func (ls LiquidationStats) Validate() error {
+	if strings.TrimSpace(ls.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	if !ls.WindowStart.Equal(StatsWindowStart(ls.WindowStart)) {
+		return fmt.Errorf("window start %s is not the start of a stats window", ls.WindowStart)
+	}
+	for _, coins := range []sdk.Coins{ls.CollateralSeized, ls.DebtLiquidated, ls.LiquidationPenalty, ls.KeeperRewards} {
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid liquidation stats for %s: %w", ls.CollateralType, err)
+		}
+	}
+	return nil
+}
+
+// LiquidationStatsList is a slice of LiquidationStats
+type LiquidationStatsList []LiquidationStats
+
+// Validate validates each stats and checks there is at most one per collateral type and window
+func (lsl LiquidationStatsList) Validate() error {
+	seen := make(map[string]bool)
+	for _, ls := range lsl {
+		if err := ls.Validate(); err != nil {
+			return err
+		}
+		key := string(LiquidationStatsKey(ls.WindowStart, ls.CollateralType))
+		if seen[key] {
+			return fmt.Errorf("duplicate liquidation stats for %s at %s", ls.CollateralType, ls.WindowStart)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -651,989 +652,4443 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateCDP)(nil), "fury.cdp.v1beta1.MsgCreateCDP")
-	proto.RegisterType((*MsgCreateCDPResponse)(nil), "fury.cdp.v1beta1.MsgCreateCDPResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "fury.cdp.v1beta1.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "fury.cdp.v1beta1.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "fury.cdp.v1beta1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "fury.cdp.v1beta1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgDrawDebt)(nil), "fury.cdp.v1beta1.MsgDrawDebt")
-	proto.RegisterType((*MsgDrawDebtResponse)(nil), "fury.cdp.v1beta1.MsgDrawDebtResponse")
-	proto.RegisterType((*MsgRepayDebt)(nil), "fury.cdp.v1beta1.MsgRepayDebt")
-	proto.RegisterType((*MsgRepayDebtResponse)(nil), "fury.cdp.v1beta1.MsgRepayDebtResponse")
-	proto.RegisterType((*MsgLiquidate)(nil), "fury.cdp.v1beta1.MsgLiquidate")
-	proto.RegisterType((*MsgLiquidateResponse)(nil), "fury.cdp.v1beta1.MsgLiquidateResponse")
+// MsgCreateMultiCDP defines a message to create a new CDP backed by several collateral types.
+// The debt of the CDP accrues interest at the stability fee of the first collateral type.
+type MsgCreateMultiCDP struct {
+	Sender     string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Collateral TypedCollaterals `protobuf:"bytes,2,rep,name=collateral,proto3,castrepeated=TypedCollaterals" json:"collateral"`
+	Principal  types.Coin       `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal"`
 }
 
-func init() { proto.RegisterFile("fury/cdp/v1beta1/tx.proto", fileDescriptor_e4920fb6256fc07f) }
-
-var fileDescriptor_e4920fb6256fc07f = []byte{
-	// 643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xfe, 0x65, 0xfa, 0xe9, 0x03, 0x99, 0x80, 0xd2, 0x08, 0xdc, 0x2a, 0xe2, 0xa7,
-	0x2c, 0x6a, 0xd3, 0x82, 0x10, 0x2c, 0x10, 0x22, 0xc9, 0xa6, 0x12, 0x91, 0x2a, 0x17, 0x09, 0x89,
-	0x4d, 0x34, 0xf6, 0x0c, 0xee, 0x88, 0xc4, 0x33, 0xcc, 0x4c, 0x48, 0xf3, 0x16, 0xbc, 0x01, 0x1b,
-	0x24, 0x5e, 0x80, 0x87, 0xe8, 0xb2, 0x62, 0xc5, 0x2a, 0xa0, 0x64, 0xc5, 0x13, 0xb0, 0x45, 0xf6,
-	0xd8, 0xe3, 0x28, 0x75, 0x42, 0x00, 0xb1, 0x61, 0xe7, 0x99, 0x73, 0xef, 0xd5, 0x39, 0xc7, 0xf7,
-	0xde, 0x01, 0x9b, 0x2f, 0x7b, 0x7c, 0xe0, 0xf8, 0x88, 0x39, 0x6f, 0xf6, 0x3c, 0x2c, 0xe1, 0x9e,
-	0x23, 0x4f, 0x6c, 0xc6, 0xa9, 0xa4, 0xe6, 0xc5, 0x08, 0xb2, 0x7d, 0xc4, 0xec, 0x04, 0xaa, 0x5a,
-	0x3e, 0x15, 0x5d, 0x2a, 0x1c, 0x0f, 0x0a, 0xac, 0xe3, 0x7d, 0x4a, 0x42, 0x95, 0x51, 0xdd, 0x54,
-	0x78, 0x3b, 0x3e, 0x39, 0xea, 0x90, 0x40, 0xe5, 0x80, 0x06, 0x54, 0xdd, 0x47, 0x5f, 0xea, 0xb6,
-	0xf6, 0xcd, 0x00, 0xff, 0xb5, 0x44, 0xd0, 0xe0, 0x18, 0x4a, 0xdc, 0x68, 0x1e, 0x9a, 0x77, 0xc0,
-	0xaa, 0xc0, 0x21, 0xc2, 0xbc, 0x62, 0x6c, 0x1b, 0x3b, 0xa5, 0x7a, 0xe5, 0xd3, 0xc7, 0xdd, 0x72,
-	0x52, 0xe8, 0x09, 0x42, 0x1c, 0x0b, 0x71, 0x24, 0x39, 0x09, 0x03, 0x37, 0x89, 0x33, 0x1f, 0x03,
-	0xe0, 0xd3, 0x4e, 0x07, 0x4a, 0xcc, 0x61, 0xa7, 0xb2, 0xb4, 0x6d, 0xec, 0x6c, 0xec, 0x6f, 0xda,
-	0x49, 0x4a, 0x44, 0x34, 0x65, 0x6f, 0x37, 0x28, 0x09, 0xeb, 0xcb, 0xa7, 0xc3, 0xad, 0x82, 0x3b,
-	0x91, 0x62, 0x3e, 0x02, 0x25, 0xc6, 0x49, 0xe8, 0x13, 0x06, 0x3b, 0x95, 0xe2, 0x62, 0xf9, 0x59,
-	0x86, 0x79, 0x0b, 0x5c, 0xc8, 0x8a, 0xb5, 0xe5, 0x80, 0xe1, 0xca, 0x72, 0x44, 0xdd, 0xfd, 0x3f,
-	0xbb, 0x7e, 0x36, 0x60, 0xb8, 0xf6, 0x00, 0x94, 0x27, 0xa5, 0xba, 0x58, 0x30, 0x1a, 0x0a, 0x6c,
-	0x6e, 0x83, 0x55, 0x1f, 0xb1, 0x36, 0x41, 0xb1, 0xe4, 0xe5, 0x7a, 0x69, 0x34, 0xdc, 0x5a, 0x69,
-	0x20, 0x76, 0xd0, 0x74, 0x57, 0x7c, 0xc4, 0x0e, 0x50, 0x6d, 0x68, 0x00, 0xd0, 0x12, 0x41, 0x13,
-	0x33, 0x2a, 0x88, 0x34, 0xef, 0x83, 0x12, 0x52, 0x9f, 0xf4, 0xe7, 0x36, 0x65, 0xa1, 0xa6, 0x0d,
-	0x56, 0x68, 0x3f, 0xc4, 0x3c, 0x36, 0x69, 0x5e, 0x8e, 0x0a, 0x9b, 0x72, 0xb6, 0xf8, 0xeb, 0xce,
-	0x2e, 0x6c, 0x4d, 0x19, 0x98, 0x99, 0xbe, 0xd4, 0x98, 0xda, 0x17, 0x03, 0x6c, 0xb4, 0x44, 0xf0,
-	0x9c, 0xc8, 0x63, 0xc4, 0x61, 0xff, 0x1f, 0xd4, 0x7d, 0x19, 0x5c, 0x9a, 0x10, 0xa8, 0x85, 0x7f,
-	0x50, 0xc2, 0x9b, 0x1c, 0xf6, 0x9b, 0xd8, 0x93, 0xbf, 0x31, 0x14, 0x39, 0x0c, 0x96, 0xf2, 0x18,
-	0xfc, 0x61, 0xf3, 0x27, 0x02, 0x52, 0xa2, 0x5a, 0xc0, 0x7b, 0x35, 0xd6, 0x2e, 0x66, 0x70, 0xf0,
-	0xb7, 0x15, 0x3c, 0x04, 0x6b, 0x0c, 0x0e, 0xba, 0x38, 0x94, 0x8b, 0xf2, 0x4f, 0xe3, 0x6b, 0x57,
-	0xe2, 0x89, 0xd4, 0x2c, 0x35, 0xfd, 0x77, 0x8a, 0xfe, 0x53, 0xf2, 0xba, 0x47, 0x10, 0x94, 0x38,
-	0xa2, 0xff, 0x0a, 0x63, 0xb6, 0x08, 0x7d, 0x15, 0x67, 0xde, 0x03, 0xeb, 0x1e, 0xe5, 0x9c, 0xf6,
-	0x17, 0x68, 0x3b, 0x1d, 0x99, 0x27, 0xba, 0x98, 0xdb, 0x38, 0x8a, 0xb9, 0x26, 0x98, 0x32, 0xdf,
-	0xff, 0x5e, 0x04, 0xc5, 0x96, 0x08, 0xcc, 0x23, 0x50, 0xca, 0x76, 0xaa, 0x65, 0x4f, 0x2f, 0x72,
-	0x7b, 0x72, 0x11, 0x55, 0x6f, 0xce, 0xc7, 0xf5, 0xa2, 0x6a, 0x81, 0xb5, 0x74, 0x05, 0x5d, 0xcd,
-	0x4d, 0x49, 0xd0, 0xea, 0xf5, 0x79, 0xa8, 0x2e, 0x77, 0x08, 0xd6, 0xf5, 0x68, 0x5f, 0xcb, 0xcd,
-	0x48, 0xe1, 0xea, 0x8d, 0xb9, 0xf0, 0x64, 0x45, 0x3d, 0x33, 0xf9, 0x15, 0x53, 0x78, 0x46, 0xc5,
-	0xe9, 0x46, 0x8e, 0x7c, 0xcc, 0x9a, 0x38, 0xdf, 0x47, 0x8d, 0xcf, 0xf0, 0xf1, 0x5c, 0x7b, 0x45,
-	0x45, 0xb3, 0xd6, 0xca, 0x2f, 0xaa, 0xf1, 0x19, 0x45, 0xcf, 0xfd, 0xf9, 0x7a, 0xe3, 0x74, 0x64,
-	0x19, 0x67, 0x23, 0xcb, 0xf8, 0x3a, 0xb2, 0x8c, 0xb7, 0x63, 0xab, 0x70, 0x36, 0xb6, 0x0a, 0x9f,
-	0xc7, 0x56, 0xe1, 0xc5, 0xed, 0x80, 0xc8, 0xe3, 0x9e, 0x67, 0xfb, 0xb4, 0xeb, 0x30, 0xcc, 0x7d,
-	0x2a, 0x88, 0xd8, 0xed, 0x40, 0x4f, 0x38, 0xf1, 0xd3, 0x7f, 0x12, 0x3f, 0xfe, 0x51, 0xaf, 0x09,
-	0x6f, 0x35, 0x7e, 0x95, 0xef, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xe6, 0x88, 0xc8, 0x15,
-	0x08, 0x00, 0x00,
+func (m *MsgCreateMultiCDP) Reset()         { *m = MsgCreateMultiCDP{} }
+func (m *MsgCreateMultiCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultiCDP) ProtoMessage()    {}
+func (*MsgCreateMultiCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{12}
+}
+func (m *MsgCreateMultiCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMultiCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultiCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMultiCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultiCDP.Merge(m, src)
+}
+func (m *MsgCreateMultiCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultiCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultiCDP.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgCreateMultiCDP proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCreateMultiCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateCDP defines a method to create a new CDP.
-	CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error)
-	// Deposit defines a method to deposit to a CDP.
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	// Withdraw defines a method to withdraw collateral from a CDP.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// DrawDebt defines a method to draw debt from a CDP.
-	DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error)
-	// RepayDebt defines a method to repay debt from a CDP.
-	RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error)
-	// Liquidate defines a method to attempt to liquidate a CDP whos
-	// collateralization ratio is under its liquidation ratio.
-	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+func (m *MsgCreateMultiCDP) GetCollateral() TypedCollaterals {
+	if m != nil {
+		return m.Collateral
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateMultiCDP) GetPrincipal() types.Coin {
+	if m != nil {
+		return m.Principal
+	}
+	return types.Coin{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgCreateMultiCDPResponse defines the Msg/CreateMultiCDP response type.
+type MsgCreateMultiCDPResponse struct {
+	CdpID uint64 `protobuf:"varint,1,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
-func (c *msgClient) CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error) {
-	out := new(MsgCreateCDPResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/CreateCDP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiCDPResponse) Reset()         { *m = MsgCreateMultiCDPResponse{} }
+func (m *MsgCreateMultiCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultiCDPResponse) ProtoMessage()    {}
+func (*MsgCreateMultiCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{13}
+}
+func (m *MsgCreateMultiCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMultiCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultiCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateMultiCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultiCDPResponse.Merge(m, src)
+}
+func (m *MsgCreateMultiCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultiCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultiCDPResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateMultiCDPResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMultiCDPResponse) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDepositMultiCDP defines a message to deposit collateral to a multi-collateral CDP.
+type MsgDepositMultiCDP struct {
+	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Collateral     types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
+	CollateralType string     `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 }
 
-func (c *msgClient) DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error) {
-	out := new(MsgDrawDebtResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/DrawDebt", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositMultiCDP) Reset()         { *m = MsgDepositMultiCDP{} }
+func (m *MsgDepositMultiCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositMultiCDP) ProtoMessage()    {}
+func (*MsgDepositMultiCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{14}
+}
+func (m *MsgDepositMultiCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositMultiCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositMultiCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDepositMultiCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositMultiCDP.Merge(m, src)
+}
+func (m *MsgDepositMultiCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositMultiCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositMultiCDP.DiscardUnknown(m)
 }
 
-func (c *msgClient) RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error) {
-	out := new(MsgRepayDebtResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/RepayDebt", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositMultiCDP proto.InternalMessageInfo
+
+func (m *MsgDepositMultiCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
-	out := new(MsgLiquidateResponse)
-	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/Liquidate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositMultiCDP) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateCDP defines a method to create a new CDP.
-	CreateCDP(context.Context, *MsgCreateCDP) (*MsgCreateCDPResponse, error)
-	// Deposit defines a method to deposit to a CDP.
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	// Withdraw defines a method to withdraw collateral from a CDP.
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	// DrawDebt defines a method to draw debt from a CDP.
-	DrawDebt(context.Context, *MsgDrawDebt) (*MsgDrawDebtResponse, error)
-	// RepayDebt defines a method to repay debt from a CDP.
-	RepayDebt(context.Context, *MsgRepayDebt) (*MsgRepayDebtResponse, error)
-	// Liquidate defines a method to attempt to liquidate a CDP whos
-	// collateralization ratio is under its liquidation ratio.
-	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+func (m *MsgDepositMultiCDP) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgDepositMultiCDPResponse defines the Msg/DepositMultiCDP response type.
+type MsgDepositMultiCDPResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateCDP(ctx context.Context, req *MsgCreateCDP) (*MsgCreateCDPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCDP not implemented")
+func (m *MsgDepositMultiCDPResponse) Reset()         { *m = MsgDepositMultiCDPResponse{} }
+func (m *MsgDepositMultiCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositMultiCDPResponse) ProtoMessage()    {}
+func (*MsgDepositMultiCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{15}
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgDepositMultiCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgDepositMultiCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositMultiCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DrawDebt(ctx context.Context, req *MsgDrawDebt) (*MsgDrawDebtResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DrawDebt not implemented")
+func (m *MsgDepositMultiCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositMultiCDPResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RepayDebt(ctx context.Context, req *MsgRepayDebt) (*MsgRepayDebtResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepayDebt not implemented")
+func (m *MsgDepositMultiCDPResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+func (m *MsgDepositMultiCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositMultiCDPResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgDepositMultiCDPResponse proto.InternalMessageInfo
+
+// MsgWithdrawMultiCDP defines a message to withdraw collateral from a multi-collateral CDP.
+type MsgWithdrawMultiCDP struct {
+	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Collateral     types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
+	CollateralType string     `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 }
 
-func _Msg_CreateCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateCDP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateCDP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Msg/CreateCDP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateCDP(ctx, req.(*MsgCreateCDP))
+func (m *MsgWithdrawMultiCDP) Reset()         { *m = MsgWithdrawMultiCDP{} }
+func (m *MsgWithdrawMultiCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawMultiCDP) ProtoMessage()    {}
+func (*MsgWithdrawMultiCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{16}
+}
+func (m *MsgWithdrawMultiCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawMultiCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawMultiCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWithdrawMultiCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawMultiCDP.Merge(m, src)
+}
+func (m *MsgWithdrawMultiCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawMultiCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawMultiCDP.DiscardUnknown(m)
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Msg/Deposit",
+var xxx_messageInfo_MsgWithdrawMultiCDP proto.InternalMessageInfo
+
+func (m *MsgWithdrawMultiCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	return ""
+}
+
+func (m *MsgWithdrawMultiCDP) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+func (m *MsgWithdrawMultiCDP) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DrawDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDrawDebt)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DrawDebt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Msg/DrawDebt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DrawDebt(ctx, req.(*MsgDrawDebt))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgWithdrawMultiCDPResponse defines the Msg/WithdrawMultiCDP response type.
+type MsgWithdrawMultiCDPResponse struct {
 }
 
-func _Msg_RepayDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayDebt)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepayDebt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Msg/RepayDebt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayDebt(ctx, req.(*MsgRepayDebt))
+func (m *MsgWithdrawMultiCDPResponse) Reset()         { *m = MsgWithdrawMultiCDPResponse{} }
+func (m *MsgWithdrawMultiCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawMultiCDPResponse) ProtoMessage()    {}
+func (*MsgWithdrawMultiCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{17}
+}
+func (m *MsgWithdrawMultiCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawMultiCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawMultiCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWithdrawMultiCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawMultiCDPResponse.Merge(m, src)
+}
+func (m *MsgWithdrawMultiCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawMultiCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawMultiCDPResponse.DiscardUnknown(m)
 }
 
-func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Liquidate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.cdp.v1beta1.Msg/Liquidate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+var xxx_messageInfo_MsgWithdrawMultiCDPResponse proto.InternalMessageInfo
+
+// MsgDrawMultiCDPDebt defines a message to draw debt from a multi-collateral CDP.
+type MsgDrawMultiCDPDebt struct {
+	Sender    string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Principal types.Coin `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal"`
+}
+
+func (m *MsgDrawMultiCDPDebt) Reset()         { *m = MsgDrawMultiCDPDebt{} }
+func (m *MsgDrawMultiCDPDebt) String() string { return proto.CompactTextString(m) }
+func (*MsgDrawMultiCDPDebt) ProtoMessage()    {}
+func (*MsgDrawMultiCDPDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{18}
+}
+func (m *MsgDrawMultiCDPDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrawMultiCDPDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrawMultiCDPDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDrawMultiCDPDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrawMultiCDPDebt.Merge(m, src)
+}
+func (m *MsgDrawMultiCDPDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrawMultiCDPDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrawMultiCDPDebt.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.cdp.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateCDP",
-			Handler:    _Msg_CreateCDP_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "DrawDebt",
-			Handler:    _Msg_DrawDebt_Handler,
-		},
-		{
-			MethodName: "RepayDebt",
-			Handler:    _Msg_RepayDebt_Handler,
-		},
-		{
-			MethodName: "Liquidate",
-			Handler:    _Msg_Liquidate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/cdp/v1beta1/tx.proto",
+var xxx_messageInfo_MsgDrawMultiCDPDebt proto.InternalMessageInfo
+
+func (m *MsgDrawMultiCDPDebt) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgCreateCDP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDrawMultiCDPDebt) GetPrincipal() types.Coin {
+	if m != nil {
+		return m.Principal
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgCreateCDP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDrawMultiCDPDebtResponse defines the Msg/DrawMultiCDPDebt response type.
+type MsgDrawMultiCDPDebtResponse struct {
 }
 
-func (m *MsgCreateCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDrawMultiCDPDebtResponse) Reset()         { *m = MsgDrawMultiCDPDebtResponse{} }
+func (m *MsgDrawMultiCDPDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDrawMultiCDPDebtResponse) ProtoMessage()    {}
+func (*MsgDrawMultiCDPDebtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{19}
+}
+func (m *MsgDrawMultiCDPDebtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrawMultiCDPDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrawMultiCDPDebtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgDrawMultiCDPDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrawMultiCDPDebtResponse.Merge(m, src)
+}
+func (m *MsgDrawMultiCDPDebtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrawMultiCDPDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrawMultiCDPDebtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDrawMultiCDPDebtResponse proto.InternalMessageInfo
+
+// MsgRepayMultiCDPDebt defines a message to repay debt from a multi-collateral CDP.
+type MsgRepayMultiCDPDebt struct {
+	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Payment types.Coin `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment"`
+}
+
+func (m *MsgRepayMultiCDPDebt) Reset()         { *m = MsgRepayMultiCDPDebt{} }
+func (m *MsgRepayMultiCDPDebt) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayMultiCDPDebt) ProtoMessage()    {}
+func (*MsgRepayMultiCDPDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{20}
+}
+func (m *MsgRepayMultiCDPDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayMultiCDPDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayMultiCDPDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateCDPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRepayMultiCDPDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayMultiCDPDebt.Merge(m, src)
 }
-
-func (m *MsgCreateCDPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRepayMultiCDPDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepayMultiCDPDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayMultiCDPDebt.DiscardUnknown(m)
 }
 
-func (m *MsgCreateCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CdpID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgRepayMultiCDPDebt proto.InternalMessageInfo
+
+func (m *MsgRepayMultiCDPDebt) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRepayMultiCDPDebt) GetPayment() types.Coin {
+	if m != nil {
+		return m.Payment
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRepayMultiCDPDebtResponse defines the Msg/RepayMultiCDPDebt response type.
+type MsgRepayMultiCDPDebtResponse struct {
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRepayMultiCDPDebtResponse) Reset()         { *m = MsgRepayMultiCDPDebtResponse{} }
+func (m *MsgRepayMultiCDPDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayMultiCDPDebtResponse) ProtoMessage()    {}
+func (*MsgRepayMultiCDPDebtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{21}
+}
+func (m *MsgRepayMultiCDPDebtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayMultiCDPDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayMultiCDPDebtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRepayMultiCDPDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayMultiCDPDebtResponse.Merge(m, src)
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRepayMultiCDPDebtResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRepayMultiCDPDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayMultiCDPDebtResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRepayMultiCDPDebtResponse proto.InternalMessageInfo
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgLiquidateMultiCDP defines a message to attempt to liquidate a multi-collateral CDP
+// whose collateral no longer covers its debt at the liquidation ratio of each collateral type.
+type MsgLiquidateMultiCDP struct {
+	Keeper   string `protobuf:"bytes,1,opt,name=keeper,proto3" json:"keeper,omitempty"`
+	Borrower string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgLiquidateMultiCDP) Reset()         { *m = MsgLiquidateMultiCDP{} }
+func (m *MsgLiquidateMultiCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidateMultiCDP) ProtoMessage()    {}
+func (*MsgLiquidateMultiCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{22}
+}
+func (m *MsgLiquidateMultiCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidateMultiCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidateMultiCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgLiquidateMultiCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidateMultiCDP.Merge(m, src)
+}
+func (m *MsgLiquidateMultiCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidateMultiCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidateMultiCDP.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgLiquidateMultiCDP proto.InternalMessageInfo
+
+func (m *MsgLiquidateMultiCDP) GetKeeper() string {
+	if m != nil {
+		return m.Keeper
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLiquidateMultiCDP) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgLiquidateMultiCDPResponse defines the Msg/LiquidateMultiCDP response type.
+type MsgLiquidateMultiCDPResponse struct {
 }
 
-func (m *MsgDrawDebt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLiquidateMultiCDPResponse) Reset()         { *m = MsgLiquidateMultiCDPResponse{} }
+func (m *MsgLiquidateMultiCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidateMultiCDPResponse) ProtoMessage()    {}
+func (*MsgLiquidateMultiCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{23}
+}
+func (m *MsgLiquidateMultiCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidateMultiCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidateMultiCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgLiquidateMultiCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidateMultiCDPResponse.Merge(m, src)
+}
+func (m *MsgLiquidateMultiCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidateMultiCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidateMultiCDPResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDrawDebt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgLiquidateMultiCDPResponse proto.InternalMessageInfo
+
+// MsgRedeemDebt defines a message to redeem stable asset for an equal value of collateral, taken from the
+// lowest collateralized CDPs of a collateral type.
+type MsgRedeemDebt struct {
+	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	CollateralType string     `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Amount         types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgDrawDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRedeemDebt) Reset()         { *m = MsgRedeemDebt{} }
+func (m *MsgRedeemDebt) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemDebt) ProtoMessage()    {}
+func (*MsgRedeemDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{24}
+}
+func (m *MsgRedeemDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRedeemDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemDebt.Merge(m, src)
+}
+func (m *MsgRedeemDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemDebt proto.InternalMessageInfo
+
+func (m *MsgRedeemDebt) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDrawDebtResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemDebt) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDrawDebtResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRedeemDebt) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgDrawDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRedeemDebtResponse defines the Msg/RedeemDebt response type.
+type MsgRedeemDebtResponse struct {
+	// redeemed is the amount of stable asset burned, which can be less than requested if there was not enough
+	// debt to redeem against.
+	Redeemed types.Coin `protobuf:"bytes,1,opt,name=redeemed,proto3" json:"redeemed"`
+	// collateral is the collateral sent to the sender, after the redemption fee.
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
 }
 
-func (m *MsgRepayDebt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemDebtResponse) Reset()         { *m = MsgRedeemDebtResponse{} }
+func (m *MsgRedeemDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemDebtResponse) ProtoMessage()    {}
+func (*MsgRedeemDebtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{25}
+}
+func (m *MsgRedeemDebtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemDebtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRepayDebt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRedeemDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemDebtResponse.Merge(m, src)
+}
+func (m *MsgRedeemDebtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemDebtResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRepayDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgRedeemDebtResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemDebtResponse) GetRedeemed() types.Coin {
+	if m != nil {
+		return m.Redeemed
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRedeemDebtResponse) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+// MsgAdjustCDP defines a message to apply collateral and principal changes to a CDP atomically.
+// Collateralization and the debt floor are only checked on the final state of the CDP.
+type MsgAdjustCDP struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	CollateralType string `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// collateral_delta is deposited to the CDP when positive, and withdrawn from the sender's deposit when negative.
+	CollateralDelta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=collateral_delta,json=collateralDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"collateral_delta"`
+	// principal_delta is drawn from the CDP when positive, and repaid when negative.
+	PrincipalDelta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=principal_delta,json=principalDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"principal_delta"`
+}
+
+func (m *MsgAdjustCDP) Reset()         { *m = MsgAdjustCDP{} }
+func (m *MsgAdjustCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgAdjustCDP) ProtoMessage()    {}
+func (*MsgAdjustCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{26}
+}
+func (m *MsgAdjustCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdjustCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdjustCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgAdjustCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdjustCDP.Merge(m, src)
+}
+func (m *MsgAdjustCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdjustCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdjustCDP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdjustCDP proto.InternalMessageInfo
+
+func (m *MsgAdjustCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAdjustCDP) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRepayDebtResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgAdjustCDPResponse defines the Msg/AdjustCDP response type.
+type MsgAdjustCDPResponse struct {
+}
+
+func (m *MsgAdjustCDPResponse) Reset()         { *m = MsgAdjustCDPResponse{} }
+func (m *MsgAdjustCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdjustCDPResponse) ProtoMessage()    {}
+func (*MsgAdjustCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{27}
+}
+func (m *MsgAdjustCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdjustCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdjustCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAdjustCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdjustCDPResponse.Merge(m, src)
+}
+func (m *MsgAdjustCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdjustCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdjustCDPResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRepayDebtResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAdjustCDPResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateCDP)(nil), "fury.cdp.v1beta1.MsgCreateCDP")
+	proto.RegisterType((*MsgCreateCDPResponse)(nil), "fury.cdp.v1beta1.MsgCreateCDPResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "fury.cdp.v1beta1.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "fury.cdp.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "fury.cdp.v1beta1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "fury.cdp.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgDrawDebt)(nil), "fury.cdp.v1beta1.MsgDrawDebt")
+	proto.RegisterType((*MsgDrawDebtResponse)(nil), "fury.cdp.v1beta1.MsgDrawDebtResponse")
+	proto.RegisterType((*MsgRepayDebt)(nil), "fury.cdp.v1beta1.MsgRepayDebt")
+	proto.RegisterType((*MsgRepayDebtResponse)(nil), "fury.cdp.v1beta1.MsgRepayDebtResponse")
+	proto.RegisterType((*MsgLiquidate)(nil), "fury.cdp.v1beta1.MsgLiquidate")
+	proto.RegisterType((*MsgLiquidateResponse)(nil), "fury.cdp.v1beta1.MsgLiquidateResponse")
+	proto.RegisterType((*MsgCreateMultiCDP)(nil), "fury.cdp.v1beta1.MsgCreateMultiCDP")
+	proto.RegisterType((*MsgCreateMultiCDPResponse)(nil), "fury.cdp.v1beta1.MsgCreateMultiCDPResponse")
+	proto.RegisterType((*MsgDepositMultiCDP)(nil), "fury.cdp.v1beta1.MsgDepositMultiCDP")
+	proto.RegisterType((*MsgDepositMultiCDPResponse)(nil), "fury.cdp.v1beta1.MsgDepositMultiCDPResponse")
+	proto.RegisterType((*MsgWithdrawMultiCDP)(nil), "fury.cdp.v1beta1.MsgWithdrawMultiCDP")
+	proto.RegisterType((*MsgWithdrawMultiCDPResponse)(nil), "fury.cdp.v1beta1.MsgWithdrawMultiCDPResponse")
+	proto.RegisterType((*MsgDrawMultiCDPDebt)(nil), "fury.cdp.v1beta1.MsgDrawMultiCDPDebt")
+	proto.RegisterType((*MsgDrawMultiCDPDebtResponse)(nil), "fury.cdp.v1beta1.MsgDrawMultiCDPDebtResponse")
+	proto.RegisterType((*MsgRepayMultiCDPDebt)(nil), "fury.cdp.v1beta1.MsgRepayMultiCDPDebt")
+	proto.RegisterType((*MsgRepayMultiCDPDebtResponse)(nil), "fury.cdp.v1beta1.MsgRepayMultiCDPDebtResponse")
+	proto.RegisterType((*MsgLiquidateMultiCDP)(nil), "fury.cdp.v1beta1.MsgLiquidateMultiCDP")
+	proto.RegisterType((*MsgLiquidateMultiCDPResponse)(nil), "fury.cdp.v1beta1.MsgLiquidateMultiCDPResponse")
+	proto.RegisterType((*MsgRedeemDebt)(nil), "fury.cdp.v1beta1.MsgRedeemDebt")
+	proto.RegisterType((*MsgRedeemDebtResponse)(nil), "fury.cdp.v1beta1.MsgRedeemDebtResponse")
+	proto.RegisterType((*MsgAdjustCDP)(nil), "fury.cdp.v1beta1.MsgAdjustCDP")
+	proto.RegisterType((*MsgAdjustCDPResponse)(nil), "fury.cdp.v1beta1.MsgAdjustCDPResponse")
 }
 
-func (m *MsgRepayDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("fury/cdp/v1beta1/tx.proto", fileDescriptor_e4920fb6256fc07f) }
+
+var fileDescriptor_e4920fb6256fc07f = []byte{
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x1f, 0x8d, 0x5f, 0x20, 0x49, 0x17, 0x17, 0x39, 0x4b, 0x6a, 0x07, 0x43, 0xdd,
+	0x20, 0xf0, 0x9a, 0x06, 0xc4, 0x87, 0xa0, 0x42, 0xb5, 0x7d, 0x89, 0x84, 0xa5, 0xca, 0x41, 0x20,
+	0xc1, 0x21, 0x5a, 0xef, 0x0c, 0xce, 0x12, 0x7b, 0x67, 0xd9, 0x19, 0x93, 0xfa, 0xc2, 0x05, 0x89,
+	0x33, 0x17, 0x8e, 0x88, 0x03, 0x48, 0x48, 0x9c, 0x40, 0xea, 0x85, 0xff, 0xa0, 0xc7, 0xaa, 0x27,
+	0xc4, 0x21, 0x85, 0xe4, 0xc4, 0x7f, 0x81, 0xf6, 0xeb, 0xed, 0xd8, 0xde, 0x6e, 0x36, 0x71, 0x8b,
+	0x04, 0x27, 0xdb, 0xfb, 0xfb, 0xcd, 0x7b, 0xbf, 0xf7, 0xe6, 0xcd, 0x7b, 0xe3, 0x85, 0x8d, 0x4f,
+	0x87, 0xee, 0xa8, 0x6e, 0x12, 0xa7, 0xfe, 0xc5, 0x8d, 0x2e, 0x15, 0xc6, 0x8d, 0xba, 0xb8, 0xa3,
+	0x3b, 0x2e, 0x13, 0x4c, 0x5d, 0xf7, 0x20, 0xdd, 0x24, 0x8e, 0x1e, 0x42, 0x5a, 0xc9, 0x64, 0x7c,
+	0xc0, 0x78, 0xbd, 0x6b, 0x70, 0x8a, 0x7c, 0x93, 0x59, 0x76, 0xb0, 0x42, 0xdb, 0x08, 0xf0, 0x7d,
+	0xff, 0x57, 0x3d, 0xf8, 0x11, 0x42, 0xda, 0x94, 0x1f, 0xcf, 0x70, 0x80, 0x15, 0x7a, 0xac, 0xc7,
+	0x82, 0x35, 0xde, 0xb7, 0xe0, 0x69, 0xe5, 0x6f, 0x05, 0x9e, 0x6a, 0xf3, 0x5e, 0xd3, 0xa5, 0x86,
+	0xa0, 0xcd, 0xd6, 0x6d, 0xf5, 0x55, 0x58, 0xe2, 0xd4, 0x26, 0xd4, 0x2d, 0x2a, 0x5b, 0xca, 0x76,
+	0xbe, 0x51, 0x7c, 0x70, 0xb7, 0x56, 0x08, 0x9d, 0xdc, 0x22, 0xc4, 0xa5, 0x9c, 0xef, 0x09, 0xd7,
+	0xb2, 0x7b, 0x9d, 0x90, 0xa7, 0xbe, 0x07, 0x60, 0xb2, 0x7e, 0xdf, 0x10, 0xd4, 0x35, 0xfa, 0xc5,
+	0xdc, 0x96, 0xb2, 0xbd, 0xb2, 0xb3, 0xa1, 0x87, 0x4b, 0xbc, 0x20, 0xa2, 0xc8, 0xf4, 0x26, 0xb3,
+	0xec, 0xc6, 0xc2, 0xbd, 0xe3, 0xf2, 0x5c, 0x47, 0x5a, 0xa2, 0xde, 0x84, 0xbc, 0xe3, 0x5a, 0xb6,
+	0x69, 0x39, 0x46, 0xbf, 0x38, 0x9f, 0x6d, 0x7d, 0xbc, 0x42, 0xbd, 0x0e, 0x6b, 0xb1, 0xb1, 0x7d,
+	0x31, 0x72, 0x68, 0x71, 0xc1, 0x93, 0xde, 0x59, 0x8d, 0x1f, 0x7f, 0x30, 0x72, 0x68, 0xe5, 0x2d,
+	0x28, 0xc8, 0xa1, 0x76, 0x28, 0x77, 0x98, 0xcd, 0xa9, 0xba, 0x05, 0x4b, 0x26, 0x71, 0xf6, 0x2d,
+	0xe2, 0x87, 0xbc, 0xd0, 0xc8, 0x9f, 0x1c, 0x97, 0x17, 0x9b, 0xc4, 0xd9, 0x6d, 0x75, 0x16, 0x4d,
+	0xe2, 0xec, 0x92, 0xca, 0xb1, 0x02, 0xd0, 0xe6, 0xbd, 0x16, 0x75, 0x18, 0xb7, 0x84, 0xfa, 0x06,
+	0xe4, 0x49, 0xf0, 0x95, 0x9d, 0x9d, 0xa6, 0x98, 0xaa, 0xea, 0xb0, 0xc8, 0x8e, 0x6c, 0xea, 0x16,
+	0x73, 0x67, 0xac, 0x09, 0x68, 0x13, 0x99, 0x9d, 0x3f, 0x7f, 0x66, 0x33, 0xa7, 0xa6, 0x00, 0x6a,
+	0x1c, 0x5f, 0x94, 0x98, 0xca, 0x43, 0x05, 0x56, 0xda, 0xbc, 0xf7, 0x91, 0x25, 0x0e, 0x88, 0x6b,
+	0x1c, 0xfd, 0x0f, 0xe3, 0xbe, 0x02, 0xcf, 0x48, 0x01, 0x62, 0xe0, 0x3f, 0x05, 0x81, 0xb7, 0x5c,
+	0xe3, 0xa8, 0x45, 0xbb, 0xe2, 0x02, 0x87, 0x22, 0x41, 0x41, 0x2e, 0x49, 0xc1, 0x8c, 0xc5, 0x1f,
+	0x06, 0x10, 0x09, 0xc5, 0x00, 0x7e, 0x0c, 0x8e, 0x75, 0x87, 0x3a, 0xc6, 0xe8, 0x49, 0x47, 0xf0,
+	0x36, 0x5c, 0x72, 0x8c, 0xd1, 0x80, 0xda, 0x22, 0xab, 0xfe, 0x88, 0x5f, 0x79, 0x16, 0x0a, 0xb2,
+	0x4a, 0x94, 0xff, 0x7d, 0x20, 0xff, 0x7d, 0xeb, 0xf3, 0xa1, 0x45, 0x0c, 0x41, 0x3d, 0xf9, 0x87,
+	0x94, 0x3a, 0x59, 0xe4, 0x07, 0x3c, 0xf5, 0x75, 0x58, 0xee, 0x32, 0xd7, 0x65, 0x47, 0x19, 0xca,
+	0x0e, 0x99, 0x49, 0x41, 0xcf, 0x27, 0x16, 0x4e, 0xa0, 0x1c, 0x05, 0xa2, 0xf2, 0xbf, 0x14, 0xb8,
+	0x8c, 0x4d, 0xa6, 0x3d, 0xec, 0x0b, 0xeb, 0x62, 0x4d, 0xf5, 0x93, 0x89, 0xa6, 0x3a, 0xbf, 0xbd,
+	0xb2, 0xf3, 0xbc, 0x3e, 0x39, 0x2b, 0x74, 0x4f, 0x0b, 0x69, 0x22, 0xb1, 0x51, 0xf4, 0xf2, 0xfb,
+	0xf3, 0xc3, 0xf2, 0xfa, 0x04, 0xc0, 0x1f, 0x63, 0xc3, 0xad, 0xdc, 0x84, 0x8d, 0xa9, 0x10, 0xcf,
+	0xd1, 0x4c, 0x7f, 0x51, 0xe4, 0x66, 0x33, 0x43, 0x8e, 0x66, 0x1e, 0x3c, 0x99, 0x77, 0x7b, 0x13,
+	0xb4, 0x69, 0xc5, 0xb8, 0xe7, 0xbf, 0x2a, 0x63, 0x5d, 0xe4, 0x3f, 0x11, 0xd1, 0x55, 0x78, 0x2e,
+	0x41, 0x32, 0x86, 0xf4, 0xb5, 0x82, 0x7d, 0x25, 0xc2, 0x2e, 0xd8, 0x46, 0xc6, 0x6a, 0x2d, 0x77,
+	0xee, 0x5a, 0x0b, 0x74, 0x4e, 0xea, 0x40, 0x9d, 0x5f, 0x29, 0x71, 0x07, 0x99, 0x51, 0xa8, 0xd4,
+	0xc6, 0x72, 0xe7, 0x6c, 0x63, 0x25, 0xd8, 0x4c, 0x12, 0x81, 0x2a, 0xbf, 0x1c, 0x6f, 0x16, 0x72,
+	0x81, 0xfc, 0x1b, 0x5d, 0x2d, 0xd4, 0x37, 0xe5, 0x1f, 0xf5, 0xfd, 0xa0, 0xc0, 0xd3, 0x7e, 0x00,
+	0x84, 0xd2, 0xc1, 0x93, 0x1e, 0x17, 0x6f, 0xc2, 0x92, 0x31, 0x60, 0xc3, 0xec, 0xd3, 0x22, 0xa4,
+	0x57, 0xbe, 0x55, 0xe0, 0xca, 0x98, 0x4a, 0xec, 0x39, 0xef, 0xc0, 0xb2, 0xeb, 0x3f, 0xa5, 0x41,
+	0xd7, 0xc9, 0x60, 0x14, 0x17, 0xcc, 0x7c, 0xe6, 0x2a, 0xbf, 0xe5, 0xfc, 0x61, 0x75, 0x8b, 0x7c,
+	0x36, 0xe4, 0xe2, 0x62, 0xe7, 0x3e, 0x73, 0xf2, 0x7a, 0xb0, 0x2e, 0x11, 0x09, 0xed, 0x0b, 0x23,
+	0x38, 0xe0, 0x8d, 0x77, 0x3d, 0x5d, 0x7f, 0x1c, 0x97, 0xab, 0x3d, 0x4b, 0x1c, 0x0c, 0xbb, 0xba,
+	0xc9, 0x06, 0xe1, 0x7f, 0x83, 0xf0, 0xa3, 0xc6, 0xc9, 0x61, 0xdd, 0x33, 0xcd, 0xf5, 0x5d, 0x5b,
+	0x3c, 0xb8, 0x5b, 0x83, 0x50, 0xd2, 0xae, 0x2d, 0x3a, 0x92, 0xfb, 0x96, 0x67, 0x54, 0xa5, 0xb0,
+	0x86, 0x87, 0x30, 0xf4, 0xb3, 0xf0, 0x18, 0xfc, 0xac, 0xa2, 0x51, 0xdf, 0x4d, 0x38, 0x46, 0x31,
+	0x75, 0xd1, 0x8e, 0xee, 0x7c, 0x07, 0x30, 0xdf, 0xe6, 0x3d, 0x75, 0x0f, 0xf2, 0xf1, 0x5f, 0x93,
+	0xd2, 0xf4, 0xfc, 0x93, 0xef, 0xf3, 0x5a, 0x35, 0x1d, 0xc7, 0x72, 0x69, 0xc3, 0xa5, 0xe8, 0x26,
+	0xbf, 0x99, 0xb8, 0x24, 0x44, 0xb5, 0x17, 0xd3, 0x50, 0x34, 0x77, 0x1b, 0x96, 0xf1, 0x86, 0x7c,
+	0x35, 0x71, 0x45, 0x04, 0x6b, 0xd7, 0x52, 0x61, 0xd9, 0x22, 0x5e, 0x3d, 0x93, 0x2d, 0x46, 0xb0,
+	0x76, 0x2d, 0x15, 0x46, 0x8b, 0x7b, 0x90, 0x8f, 0xef, 0x82, 0xc9, 0x79, 0x44, 0x5c, 0xab, 0xa6,
+	0xe3, 0xb2, 0xd1, 0xf8, 0x86, 0x96, 0x6c, 0x14, 0x71, 0xad, 0x9a, 0x8e, 0xa3, 0xd1, 0x2e, 0xac,
+	0x4e, 0x5c, 0x9e, 0x5e, 0x48, 0xd9, 0xd6, 0x88, 0xa4, 0xbd, 0x9c, 0x81, 0x84, 0x3e, 0x28, 0xac,
+	0x4d, 0xde, 0x3e, 0x52, 0xb7, 0x1a, 0xbd, 0xbc, 0x92, 0x85, 0x85, 0x6e, 0x0e, 0x60, 0x7d, 0xea,
+	0x4e, 0x90, 0x5e, 0x01, 0xe8, 0xa8, 0x96, 0x89, 0x26, 0x7b, 0x9a, 0x1a, 0xd5, 0x8f, 0xae, 0x0c,
+	0x99, 0xa6, 0xd5, 0x32, 0xd1, 0xd0, 0xd3, 0x21, 0x5c, 0x9e, 0x1e, 0xb6, 0x29, 0x05, 0x33, 0xe6,
+	0x4b, 0xcf, 0xc6, 0x93, 0x9d, 0x4d, 0x0f, 0xcd, 0x33, 0x0a, 0x09, 0x53, 0xa8, 0x67, 0xe3, 0xa1,
+	0xb3, 0x0f, 0x01, 0xa4, 0x01, 0x58, 0x7e, 0x84, 0xd4, 0x88, 0xa0, 0x5d, 0x3f, 0x83, 0x20, 0x9f,
+	0x92, 0x78, 0x34, 0x24, 0x9f, 0x12, 0xc4, 0xb5, 0x6a, 0x3a, 0x1e, 0x19, 0x6d, 0x34, 0xef, 0x9d,
+	0x94, 0x94, 0xfb, 0x27, 0x25, 0xe5, 0xcf, 0x93, 0x92, 0xf2, 0xcd, 0x69, 0x69, 0xee, 0xfe, 0x69,
+	0x69, 0xee, 0xf7, 0xd3, 0xd2, 0xdc, 0xc7, 0x2f, 0x49, 0x7d, 0xd9, 0xa1, 0xae, 0xc9, 0xb8, 0xc5,
+	0x6b, 0x7d, 0xa3, 0xcb, 0xeb, 0xfe, 0xbb, 0xa1, 0x3b, 0xfe, 0xdb, 0x21, 0xbf, 0x3d, 0x77, 0x97,
+	0xfc, 0x57, 0x40, 0xaf, 0xfd, 0x33, 0x00, 0xfa, 0xe8, 0xe1, 0x0d, 0x9e, 0x12, 0x00, 0x00,
 }
 
-func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateCDP defines a method to create a new CDP.
+	CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error)
+	// Deposit defines a method to deposit to a CDP.
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// Withdraw defines a method to withdraw collateral from a CDP.
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// DrawDebt defines a method to draw debt from a CDP.
+	DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error)
+	// RepayDebt defines a method to repay debt from a CDP.
+	RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error)
+	// Liquidate defines a method to attempt to liquidate a CDP whos
+	// collateralization ratio is under its liquidation ratio.
+	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// CreateMultiCDP defines a method to create a new CDP backed by several collateral types.
+	CreateMultiCDP(ctx context.Context, in *MsgCreateMultiCDP, opts ...grpc.CallOption) (*MsgCreateMultiCDPResponse, error)
+	// DepositMultiCDP defines a method to deposit collateral to a multi-collateral CDP.
+	DepositMultiCDP(ctx context.Context, in *MsgDepositMultiCDP, opts ...grpc.CallOption) (*MsgDepositMultiCDPResponse, error)
+	// WithdrawMultiCDP defines a method to withdraw collateral from a multi-collateral CDP.
+	WithdrawMultiCDP(ctx context.Context, in *MsgWithdrawMultiCDP, opts ...grpc.CallOption) (*MsgWithdrawMultiCDPResponse, error)
+	// DrawMultiCDPDebt defines a method to draw debt from a multi-collateral CDP.
+	DrawMultiCDPDebt(ctx context.Context, in *MsgDrawMultiCDPDebt, opts ...grpc.CallOption) (*MsgDrawMultiCDPDebtResponse, error)
+	// RepayMultiCDPDebt defines a method to repay debt from a multi-collateral CDP.
+	RepayMultiCDPDebt(ctx context.Context, in *MsgRepayMultiCDPDebt, opts ...grpc.CallOption) (*MsgRepayMultiCDPDebtResponse, error)
+	// LiquidateMultiCDP defines a method to attempt to liquidate a multi-collateral CDP
+	// whose collateral no longer covers its debt at the liquidation ratio of each collateral type.
+	LiquidateMultiCDP(ctx context.Context, in *MsgLiquidateMultiCDP, opts ...grpc.CallOption) (*MsgLiquidateMultiCDPResponse, error)
+	// RedeemDebt defines a method to redeem stable asset for collateral at face value from the
+	// lowest collateralized CDPs of a collateral type.
+	RedeemDebt(ctx context.Context, in *MsgRedeemDebt, opts ...grpc.CallOption) (*MsgRedeemDebtResponse, error)
+	// AdjustCDP defines a method to change the collateral and principal of a CDP in a single step.
+	AdjustCDP(ctx context.Context, in *MsgAdjustCDP, opts ...grpc.CallOption) (*MsgAdjustCDPResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error) {
+	out := new(MsgCreateCDPResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/CreateCDP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Keeper) > 0 {
-		i -= len(m.Keeper)
-		copy(dAtA[i:], m.Keeper)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error) {
+	out := new(MsgDrawDebtResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/DrawDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error) {
+	out := new(MsgRepayDebtResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/RepayDebt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
+	out := new(MsgLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/Liquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateMultiCDP(ctx context.Context, in *MsgCreateMultiCDP, opts ...grpc.CallOption) (*MsgCreateMultiCDPResponse, error) {
+	out := new(MsgCreateMultiCDPResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/CreateMultiCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) DepositMultiCDP(ctx context.Context, in *MsgDepositMultiCDP, opts ...grpc.CallOption) (*MsgDepositMultiCDPResponse, error) {
+	out := new(MsgDepositMultiCDPResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/DepositMultiCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateCDP) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) WithdrawMultiCDP(ctx context.Context, in *MsgWithdrawMultiCDP, opts ...grpc.CallOption) (*MsgWithdrawMultiCDPResponse, error) {
+	out := new(MsgWithdrawMultiCDPResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/WithdrawMultiCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateCDPResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CdpID != 0 {
-		n += 1 + sovTx(uint64(m.CdpID))
+func (c *msgClient) DrawMultiCDPDebt(ctx context.Context, in *MsgDrawMultiCDPDebt, opts ...grpc.CallOption) (*MsgDrawMultiCDPDebtResponse, error) {
+	out := new(MsgDrawMultiCDPDebtResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/DrawMultiCDPDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) RepayMultiCDPDebt(ctx context.Context, in *MsgRepayMultiCDPDebt, opts ...grpc.CallOption) (*MsgRepayMultiCDPDebtResponse, error) {
+	out := new(MsgRepayMultiCDPDebtResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/RepayMultiCDPDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) LiquidateMultiCDP(ctx context.Context, in *MsgLiquidateMultiCDP, opts ...grpc.CallOption) (*MsgLiquidateMultiCDPResponse, error) {
+	out := new(MsgLiquidateMultiCDPResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/LiquidateMultiCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RedeemDebt(ctx context.Context, in *MsgRedeemDebt, opts ...grpc.CallOption) (*MsgRedeemDebtResponse, error) {
+	out := new(MsgRedeemDebtResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/RedeemDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AdjustCDP(ctx context.Context, in *MsgAdjustCDP, opts ...grpc.CallOption) (*MsgAdjustCDPResponse, error) {
+	out := new(MsgAdjustCDPResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/AdjustCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateCDP defines a method to create a new CDP.
+	CreateCDP(context.Context, *MsgCreateCDP) (*MsgCreateCDPResponse, error)
+	// Deposit defines a method to deposit to a CDP.
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// Withdraw defines a method to withdraw collateral from a CDP.
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// DrawDebt defines a method to draw debt from a CDP.
+	DrawDebt(context.Context, *MsgDrawDebt) (*MsgDrawDebtResponse, error)
+	// RepayDebt defines a method to repay debt from a CDP.
+	RepayDebt(context.Context, *MsgRepayDebt) (*MsgRepayDebtResponse, error)
+	// Liquidate defines a method to attempt to liquidate a CDP whos
+	// collateralization ratio is under its liquidation ratio.
+	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// CreateMultiCDP defines a method to create a new CDP backed by several collateral types.
+	CreateMultiCDP(context.Context, *MsgCreateMultiCDP) (*MsgCreateMultiCDPResponse, error)
+	// DepositMultiCDP defines a method to deposit collateral to a multi-collateral CDP.
+	DepositMultiCDP(context.Context, *MsgDepositMultiCDP) (*MsgDepositMultiCDPResponse, error)
+	// WithdrawMultiCDP defines a method to withdraw collateral from a multi-collateral CDP.
+	WithdrawMultiCDP(context.Context, *MsgWithdrawMultiCDP) (*MsgWithdrawMultiCDPResponse, error)
+	// DrawMultiCDPDebt defines a method to draw debt from a multi-collateral CDP.
+	DrawMultiCDPDebt(context.Context, *MsgDrawMultiCDPDebt) (*MsgDrawMultiCDPDebtResponse, error)
+	// RepayMultiCDPDebt defines a method to repay debt from a multi-collateral CDP.
+	RepayMultiCDPDebt(context.Context, *MsgRepayMultiCDPDebt) (*MsgRepayMultiCDPDebtResponse, error)
+	// LiquidateMultiCDP defines a method to attempt to liquidate a multi-collateral CDP
+	// whose collateral no longer covers its debt at the liquidation ratio of each collateral type.
+	LiquidateMultiCDP(context.Context, *MsgLiquidateMultiCDP) (*MsgLiquidateMultiCDPResponse, error)
+	// RedeemDebt defines a method to redeem stable asset for collateral at face value from the
+	// lowest collateralized CDPs of a collateral type.
+	RedeemDebt(context.Context, *MsgRedeemDebt) (*MsgRedeemDebtResponse, error)
+	// AdjustCDP defines a method to change the collateral and principal of a CDP in a single step.
+	AdjustCDP(context.Context, *MsgAdjustCDP) (*MsgAdjustCDPResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateCDP(ctx context.Context, req *MsgCreateCDP) (*MsgCreateCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCDP not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) DrawDebt(ctx context.Context, req *MsgDrawDebt) (*MsgDrawDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawDebt not implemented")
+}
+func (*UnimplementedMsgServer) RepayDebt(ctx context.Context, req *MsgRepayDebt) (*MsgRepayDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayDebt not implemented")
+}
+func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+}
+func (*UnimplementedMsgServer) CreateMultiCDP(ctx context.Context, req *MsgCreateMultiCDP) (*MsgCreateMultiCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultiCDP not implemented")
+}
+func (*UnimplementedMsgServer) DepositMultiCDP(ctx context.Context, req *MsgDepositMultiCDP) (*MsgDepositMultiCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositMultiCDP not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawMultiCDP(ctx context.Context, req *MsgWithdrawMultiCDP) (*MsgWithdrawMultiCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMultiCDP not implemented")
+}
+func (*UnimplementedMsgServer) DrawMultiCDPDebt(ctx context.Context, req *MsgDrawMultiCDPDebt) (*MsgDrawMultiCDPDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawMultiCDPDebt not implemented")
+}
+func (*UnimplementedMsgServer) RepayMultiCDPDebt(ctx context.Context, req *MsgRepayMultiCDPDebt) (*MsgRepayMultiCDPDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayMultiCDPDebt not implemented")
+}
+func (*UnimplementedMsgServer) LiquidateMultiCDP(ctx context.Context, req *MsgLiquidateMultiCDP) (*MsgLiquidateMultiCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidateMultiCDP not implemented")
+}
+func (*UnimplementedMsgServer) RedeemDebt(ctx context.Context, req *MsgRedeemDebt) (*MsgRedeemDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemDebt not implemented")
+}
+func (*UnimplementedMsgServer) AdjustCDP(ctx context.Context, req *MsgAdjustCDP) (*MsgAdjustCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustCDP not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCDP)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCDP(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/CreateCDP",
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCDP(ctx, req.(*MsgCreateCDP))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDrawDebt) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/Deposit",
 	}
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
 	}
-	l = m.Principal.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDrawDebtResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRepayDebt) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/Withdraw",
 	}
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
 	}
-	l = m.Payment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayDebtResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DrawDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrawDebt)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DrawDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/DrawDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DrawDebt(ctx, req.(*MsgDrawDebt))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Keeper)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RepayDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayDebt)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RepayDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/RepayDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayDebt(ctx, req.(*MsgRepayDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Liquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/Liquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMultiCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMultiCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMultiCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/CreateMultiCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMultiCDP(ctx, req.(*MsgCreateMultiCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositMultiCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositMultiCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositMultiCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/DepositMultiCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositMultiCDP(ctx, req.(*MsgDepositMultiCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawMultiCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawMultiCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawMultiCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/WithdrawMultiCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawMultiCDP(ctx, req.(*MsgWithdrawMultiCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DrawMultiCDPDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrawMultiCDPDebt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DrawMultiCDPDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/DrawMultiCDPDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DrawMultiCDPDebt(ctx, req.(*MsgDrawMultiCDPDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayMultiCDPDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayMultiCDPDebt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayMultiCDPDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/RepayMultiCDPDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayMultiCDPDebt(ctx, req.(*MsgRepayMultiCDPDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidateMultiCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidateMultiCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidateMultiCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/LiquidateMultiCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidateMultiCDP(ctx, req.(*MsgLiquidateMultiCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemDebt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/RedeemDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemDebt(ctx, req.(*MsgRedeemDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdjustCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdjustCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdjustCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/AdjustCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdjustCDP(ctx, req.(*MsgAdjustCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.cdp.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCDP",
+			Handler:    _Msg_CreateCDP_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "DrawDebt",
+			Handler:    _Msg_DrawDebt_Handler,
+		},
+		{
+			MethodName: "RepayDebt",
+			Handler:    _Msg_RepayDebt_Handler,
+		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
+		{
+			MethodName: "CreateMultiCDP",
+			Handler:    _Msg_CreateMultiCDP_Handler,
+		},
+		{
+			MethodName: "DepositMultiCDP",
+			Handler:    _Msg_DepositMultiCDP_Handler,
+		},
+		{
+			MethodName: "WithdrawMultiCDP",
+			Handler:    _Msg_WithdrawMultiCDP_Handler,
+		},
+		{
+			MethodName: "DrawMultiCDPDebt",
+			Handler:    _Msg_DrawMultiCDPDebt_Handler,
+		},
+		{
+			MethodName: "RepayMultiCDPDebt",
+			Handler:    _Msg_RepayMultiCDPDebt_Handler,
+		},
+		{
+			MethodName: "LiquidateMultiCDP",
+			Handler:    _Msg_LiquidateMultiCDP_Handler,
+		},
+		{
+			MethodName: "RedeemDebt",
+			Handler:    _Msg_RedeemDebt_Handler,
+		},
+		{
+			MethodName: "AdjustCDP",
+			Handler:    _Msg_AdjustCDP_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/cdp/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keeper) > 0 {
+		i -= len(m.Keeper)
+		copy(dAtA[i:], m.Keeper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultiCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultiCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultiCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultiCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultiCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultiCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositMultiCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositMultiCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositMultiCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositMultiCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositMultiCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositMultiCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawMultiCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawMultiCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawMultiCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawMultiCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawMultiCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawMultiCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawMultiCDPDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawMultiCDPDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawMultiCDPDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawMultiCDPDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawMultiCDPDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawMultiCDPDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayMultiCDPDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayMultiCDPDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayMultiCDPDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayMultiCDPDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayMultiCDPDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayMultiCDPDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateMultiCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateMultiCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateMultiCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keeper) > 0 {
+		i -= len(m.Keeper)
+		copy(dAtA[i:], m.Keeper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateMultiCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateMultiCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateMultiCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Redeemed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdjustCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdjustCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdjustCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PrincipalDelta.Size()
+		i -= size
+		if _, err := m.PrincipalDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralDelta.Size()
+		i -= size
+		if _, err := m.CollateralDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdjustCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdjustCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdjustCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrawDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDrawDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Payment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRepayDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keeper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMultiCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMultiCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDepositMultiCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositMultiCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawMultiCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawMultiCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrawMultiCDPDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDrawMultiCDPDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayMultiCDPDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Payment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRepayMultiCDPDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidateMultiCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keeper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidateMultiCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redeemed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAdjustCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.CollateralType)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	l = m.CollateralDelta.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PrincipalDelta.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAdjustCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keeper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultiCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultiCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultiCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, TypedCollateral{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultiCDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultiCDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultiCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositMultiCDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1656,10 +5111,10 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCDP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositMultiCDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositMultiCDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1728,39 +5183,6 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
@@ -1813,7 +5235,7 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositMultiCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1836,31 +5258,12 @@ func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCDPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositMultiCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositMultiCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
-			}
-			m.CdpID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CdpID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1882,7 +5285,7 @@ func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawMultiCDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1905,15 +5308,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawMultiCDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawMultiCDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1941,13 +5344,13 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1957,29 +5360,30 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1989,28 +5393,127 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawMultiCDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawMultiCDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawMultiCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawMultiCDPDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawMultiCDPDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawMultiCDPDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2031,14 +5534,47 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2061,7 +5597,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDrawMultiCDPDebtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2084,10 +5620,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDrawMultiCDPDebtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDrawMultiCDPDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2111,7 +5647,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayMultiCDPDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2134,15 +5670,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayMultiCDPDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayMultiCDPDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2170,43 +5706,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2233,42 +5737,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2290,7 +5762,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayMultiCDPDebtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,10 +5785,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayMultiCDPDebtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayMultiCDPDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2340,7 +5812,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateMultiCDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2363,15 +5835,15 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateMultiCDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateMultiCDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2399,11 +5871,11 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Keeper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2431,40 +5903,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2487,7 +5926,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateMultiCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2510,10 +5949,10 @@ func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawDebtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateMultiCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateMultiCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2537,7 +5976,7 @@ func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,10 +5999,10 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2632,7 +6071,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2659,7 +6098,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2684,7 +6123,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemDebtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2707,12 +6146,78 @@ func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayDebtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemDebtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redeemed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2734,7 +6239,7 @@ func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgAdjustCDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2757,15 +6262,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdjustCDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdjustCDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2793,11 +6298,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keeper = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2825,11 +6330,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDelta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,7 +6362,43 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrincipalDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2880,7 +6421,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAdjustCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2903,10 +6444,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdjustCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdjustCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
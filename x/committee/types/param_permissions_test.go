@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"encoding/json"
 	fmt "fmt"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/percosis-labs/fury/app"
 	cdptypes "github.com/percosis-labs/fury/x/cdp/types"
 	types "github.com/percosis-labs/fury/x/committee/types"
+	jinxtypes "github.com/percosis-labs/fury/x/jinx/types"
 	pricefeedtypes "github.com/percosis-labs/fury/x/pricefeed/types"
 )
 
@@ -247,7 +249,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 		"liquidation_market_id": "bnb:usd",
 		"keeper_reward_percentage": "0",
 		"check_collateralization_index_count": "0",
-		"conversion_factor": "6"
+		"conversion_factor": "6",
+		"close_factor": "0",
+		"liquidation_target_ratio": "0",
+		"redemption_fee": "0",
+		"liquidation_twap_window": "0"
 	}`
 	unchangedBtcValue := `{
 		"denom": "btc",
@@ -261,7 +267,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 		"liquidation_market_id": "btc:usd",
 		"keeper_reward_percentage": "0.12",
 		"check_collateralization_index_count": "1",
-		"conversion_factor": "8"
+		"conversion_factor": "8",
+		"close_factor": "0",
+		"liquidation_target_ratio": "0",
+		"redemption_fee": "0",
+		"liquidation_twap_window": "0"
 	}`
 
 	testcases := []struct {
@@ -293,7 +303,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "bnb:usd",
 					"keeper_reward_percentage": "0",
 					"check_collateralization_index_count": "0",
-					"conversion_factor": "9"
+					"conversion_factor": "9",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				},
 				{
 					"denom": "btc",
@@ -307,7 +321,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.000000000000000000",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				}]`,
 			},
 		},
@@ -378,7 +396,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				}`),
 			},
 		},
@@ -419,7 +441,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				}`),
 			},
 		},
@@ -447,7 +473,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				}`),
 			},
 		},
@@ -475,7 +505,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				}`),
 			},
 		},
@@ -504,7 +538,11 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0",
+					"redemption_fee": "0",
+					"liquidation_twap_window": "0"
 				}`),
 			},
 		},
@@ -703,14 +741,24 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdf",
 				"oracles": [],
-				"active": true
+				"active": true,
+				"trim_fraction": "0",
+				"twap_window": "0",
+				"max_price_deviation": "0",
+				"oracle_deviation_threshold": "0",
+				"derivation": {}
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"trim_fraction": "0",
+				"twap_window": "0",
+				"max_price_deviation": "0",
+				"oracle_deviation_threshold": "0",
+				"derivation": {}
 			}]`, oracles[1].String()),
 		},
 		{
@@ -721,14 +769,24 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdf",
 				"oracles": ["%s"],
-				"active": true
+				"active": true,
+				"trim_fraction": "0",
+				"twap_window": "0",
+				"max_price_deviation": "0",
+				"oracle_deviation_threshold": "0",
+				"derivation": {}
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"trim_fraction": "0",
+				"twap_window": "0",
+				"max_price_deviation": "0",
+				"oracle_deviation_threshold": "0",
+				"derivation": {}
 			}]`, oracles[0].String(), oracles[2].String()),
 		},
 	}
@@ -1103,3 +1161,101 @@ func TestAllowedParamsChanges_Delete(t *testing.T) {
 		})
 	}
 }
+
+func (s *ParamsChangeTestSuite) TestMultiSubparams_JinxMoneyMarkets() {
+	irm := jinxtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	moneyMarkets := jinxtypes.MoneyMarkets{
+		jinxtypes.NewMoneyMarket("bnb", jinxtypes.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd", sdkmath.NewInt(100000000), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		jinxtypes.NewMoneyMarket("usdf", jinxtypes.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdf:usd", sdkmath.NewInt(1000000), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+	}
+	requirements := []types.SubparamRequirement{
+		{
+			Key:                        "denom",
+			Val:                        "bnb",
+			AllowedSubparamAttrChanges: []string{"supply_limit"},
+		},
+		{
+			Key:                        "denom",
+			Val:                        "usdf",
+			AllowedSubparamAttrChanges: []string{},
+		},
+	}
+
+	// changeMoneyMarket returns the current money markets json with one attribute of a money market replaced
+	changeMoneyMarket := func(currentRaw []byte, index int, attr string, value interface{}) string {
+		var records types.MultiSubparamChanges
+		s.Require().NoError(json.Unmarshal(currentRaw, &records))
+		records[index][attr] = value
+		bz, err := json.Marshal(records)
+		s.Require().NoError(err)
+		return string(bz)
+	}
+	newSupplyLimit := map[string]interface{}{
+		"has_max_limit":            true,
+		"maximum_limit":            "1000000000000.000000000000000000",
+		"has_max_collateral_value": true,
+		"max_collateral_value":     "5000000.000000000000000000",
+	}
+
+	testcases := []struct {
+		name     string
+		expected bool
+		index    int
+		attr     string
+		value    interface{}
+	}{
+		{
+			name:     "succeeds when changing an allowed supply limit",
+			expected: true,
+			index:    0,
+			attr:     "supply_limit",
+			value:    newSupplyLimit,
+		},
+		{
+			name:     "fails when changing a supply limit that is not allowed",
+			expected: false,
+			index:    1,
+			attr:     "supply_limit",
+			value:    newSupplyLimit,
+		},
+		{
+			name:     "fails when changing another attribute",
+			expected: false,
+			index:    0,
+			attr:     "spot_market_id",
+			value:    "bnbc:usd",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			subspace, found := s.pk.GetSubspace(jinxtypes.ModuleName)
+			s.Require().True(found)
+			subspace.Set(s.ctx, jinxtypes.KeyMoneyMarkets, moneyMarkets)
+			currentRaw := subspace.GetRaw(s.ctx, jinxtypes.KeyMoneyMarkets)
+
+			permission := types.ParamsChangePermission{
+				AllowedParamsChanges: types.AllowedParamsChanges{{
+					Subspace:                   jinxtypes.ModuleName,
+					Key:                        string(jinxtypes.KeyMoneyMarkets),
+					MultiSubparamsRequirements: requirements,
+				}},
+			}
+			proposal := paramsproposal.NewParameterChangeProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]paramsproposal.ParamChange{{
+					Subspace: jinxtypes.ModuleName,
+					Key:      string(jinxtypes.KeyMoneyMarkets),
+					Value:    changeMoneyMarket(currentRaw, tc.index, tc.attr, tc.value),
+				}},
+			)
+			s.Require().Equal(
+				tc.expected,
+				permission.Allows(s.ctx, s.pk, proposal),
+			)
+		})
+	}
+}
@@ -143,6 +143,8 @@ func (suite *Suite) SetupTest() {
 			),
 		},
 		sdk.NewDec(10),
+		jinxtypes.DefaultIsolatedMarkets,
+		jinxtypes.DefaultEModeCategories,
 	),
 		jinxtypes.DefaultAccumulationTimes,
 		jinxtypes.DefaultDeposits,
@@ -150,6 +152,10 @@ func (suite *Suite) SetupTest() {
 		jinxtypes.DefaultTotalSupplied,
 		jinxtypes.DefaultTotalBorrowed,
 		jinxtypes.DefaultTotalReserves,
+		jinxtypes.DefaultIsolatedMarketStates,
+		jinxtypes.DefaultIsolatedPositions,
+		jinxtypes.DefaultAccountEModes,
+		jinxtypes.DefaultIsolatedAuctions,
 	)
 
 	savingsGS := savingstypes.NewGenesisState(
@@ -59,6 +59,8 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 				jinxtypes.NewMoneyMarket("bnb", jinxtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), jinxtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			jinxtypes.DefaultIsolatedMarkets,
+			jinxtypes.DefaultEModeCategories,
 		),
 		jinxtypes.DefaultAccumulationTimes,
 		jinxtypes.DefaultDeposits,
@@ -66,6 +68,10 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 		jinxtypes.DefaultTotalSupplied,
 		jinxtypes.DefaultTotalBorrowed,
 		jinxtypes.DefaultTotalReserves,
+		jinxtypes.DefaultIsolatedMarketStates,
+		jinxtypes.DefaultIsolatedPositions,
+		jinxtypes.DefaultAccountEModes,
+		jinxtypes.DefaultIsolatedAuctions,
 	)
 
 	suite.genesisState = types.NewGenesisState(
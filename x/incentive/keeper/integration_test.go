@@ -231,6 +231,7 @@ func NewSwapGenesisState(cdc codec.JSONCodec) app.GenesisState {
 		),
 		swaptypes.DefaultPoolRecords,
 		swaptypes.DefaultShareRecords,
+		swaptypes.DefaultPoolPriceSnapshots,
 	)
 	return app.GenesisState{
 		swaptypes.ModuleName: cdc.MustMarshalJSON(&genesis),
@@ -13,6 +13,7 @@ import (
 	furydisttypes "github.com/percosis-labs/fury/x/furydist/types"
 	"github.com/percosis-labs/fury/x/incentive/testutil"
 	"github.com/percosis-labs/fury/x/incentive/types"
+	swaptypes "github.com/percosis-labs/fury/x/swap/types"
 )
 
 const secondsPerDay = 24 * 60 * 60
@@ -189,3 +190,42 @@ func (suite *HandlerTestSuite) TestPayoutSwapClaimSingleDenom() {
 	// Check that claimed coins have been removed from a claim's reward
 	suite.SwapRewardEquals(userAddr, cs(c("jinx", 7*1e6)))
 }
+
+func (suite *HandlerTestSuite) TestPayoutSwapClaimWeightedPool() {
+	userAddr := suite.addrs[0]
+	pool := swaptypes.NewWeightedAllowedPool([]string{"busd", "jinx", "ufury"}, []uint64{50, 25, 25})
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12), c("busd", 1e12), c("jinx", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod(pool.Name(), cs(c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+	suite.App.GetSwapKeeper().SetParams(suite.Ctx, swaptypes.NewParams(swaptypes.NewAllowedPools(pool), d("0.0")))
+
+	// deposit into a weighted swap pool
+	suite.NoError(
+		suite.DeliverSwapMsgDepositToPool(userAddr, pool.Name(), cs(c("busd", 2e9), c("jinx", 1e9), c("ufury", 1e9))),
+	)
+	// accumulate some swap rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimSwapReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("swap", "large"),
+		},
+	)
+
+	// Claim rewards
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	// Check rewards were paid out for the weighted pool id
+	expectedRewards := c("swap", 7*1e6)
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewards))
+	suite.SwapRewardEquals(userAddr, nil)
+}
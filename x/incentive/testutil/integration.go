@@ -245,6 +245,20 @@ func (suite *IntegrationTester) DeliverSwapMsgDeposit(depositor sdk.AccAddress,
 	return err
 }
 
+func (suite *IntegrationTester) DeliverSwapMsgDepositToPool(depositor sdk.AccAddress, poolID string, tokens sdk.Coins) error {
+	msg := swaptypes.NewMsgDepositToPool(
+		depositor.String(),
+		poolID,
+		tokens,
+		sdk.ZeroInt(),
+		suite.Ctx.BlockTime().Add(time.Hour).Unix(), // ensure msg will not fail due to short deadline
+	)
+	msgServer := swapkeeper.NewMsgServerImpl(suite.App.GetSwapKeeper())
+	_, err := msgServer.DepositToPool(sdk.WrapSDKContext(suite.Ctx), msg)
+
+	return err
+}
+
 func (suite *IntegrationTester) DeliverJinxMsgDeposit(owner sdk.AccAddress, deposit sdk.Coins) error {
 	msg := jinxtypes.NewMsgDeposit(owner, deposit)
 	msgServer := jinxkeeper.NewMsgServerImpl(suite.App.GetJinxKeeper())
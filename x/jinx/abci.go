@@ -14,4 +14,5 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.ApplyInterestRateUpdates(ctx)
+	k.ApplyIsolatedInterestUpdates(ctx)
 }
@@ -16,9 +16,10 @@ import (
 
 // flags for cli queries
 const (
-	flagName  = "name"
-	flagDenom = "denom"
-	flagOwner = "owner"
+	flagName   = "name"
+	flagDenom  = "denom"
+	flagOwner  = "owner"
+	flagMarket = "market"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -43,6 +44,11 @@ func GetQueryCmd() *cobra.Command {
 		queryInterestRateCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryIsolatedMarketsCmd(),
+		queryIsolatedPositionsCmd(),
+		queryEModeCmd(),
+		queryPositionHealthCmd(),
+		queryPositionsAtRiskCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -532,3 +538,191 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryIsolatedMarketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "isolated-markets",
+		Short: "get the state of isolated markets",
+		Long:  "Get the total supplied, borrowed and reserve coins and interest factors of isolated markets.",
+		Example: fmt.Sprintf(`%[1]s q %[2]s isolated-markets
+%[1]s q %[2]s isolated-markets --market bnb-ufury`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			market, err := cmd.Flags().GetString(flagMarket)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsolatedMarkets(context.Background(), &types.QueryIsolatedMarketsRequest{
+				Market: market,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagMarket, "", "(optional) filter by isolated market name")
+
+	return cmd
+}
+
+func queryIsolatedPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "isolated-positions",
+		Short: "query isolated market positions with optional filters",
+		Long:  "query for all isolated market positions or the positions of a market or owner using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s isolated-positions
+%[1]s q %[2]s isolated-positions --market bnb-ufury
+%[1]s q %[2]s isolated-positions --owner fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			market, err := cmd.Flags().GetString(flagMarket)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIsolatedPositionsRequest{
+				Market:     market,
+				Pagination: pageReq,
+			}
+
+			if len(ownerBech) != 0 {
+				owner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				req.Owner = owner.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsolatedPositions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "isolated positions")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for isolated positions by owner address")
+	cmd.Flags().String(flagMarket, "", "(optional) filter for isolated positions by market name")
+
+	return cmd
+}
+
+func queryEModeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "e-mode [address]",
+		Short:   "get the e-mode category of an account",
+		Long:    "Get the e-mode category an account has opted in to, and whether its deposits and borrows are all in it.",
+		Example: fmt.Sprintf(`%s q %s e-mode fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EMode(context.Background(), &types.QueryEModeRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryPositionHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "position-health [address]",
+		Short:   "get how close an account's position is to liquidation",
+		Long:    "Get the health factor and liquidation prices of an account's position, along with the value it can still borrow and the deposits it can withdraw.",
+		Example: fmt.Sprintf(`%s q %s position-health fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PositionHealth(context.Background(), &types.QueryPositionHealthRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryPositionsAtRiskCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "positions-at-risk",
+		Short:   "query positions ordered from the closest to liquidation",
+		Long:    "Query the health of all positions with borrows, ordered from the lowest health factor.",
+		Example: fmt.Sprintf(`%s q %s positions-at-risk --limit 10`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PositionsAtRisk(context.Background(), &types.QueryPositionsAtRiskRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "positions at risk")
+
+	return cmd
+}
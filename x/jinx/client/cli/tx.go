@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/percosis-labs/fury/x/jinx/types"
 )
@@ -35,8 +36,21 @@ func GetTxCmd() *cobra.Command {
 
 	for _, cmd := range cmds {
 		flags.AddTxFlagsToCmd(cmd)
+		cmd.Flags().String(flagMarket, "", "(optional) isolated market name, defaults to the shared money markets")
 	}
 
+	partialLiquidateCmd := getCmdPartialLiquidate()
+	flags.AddTxFlagsToCmd(partialLiquidateCmd)
+	cmds = append(cmds, partialLiquidateCmd)
+
+	flashLoanCmd := getCmdFlashLoan()
+	flags.AddTxFlagsToCmd(flashLoanCmd)
+	cmds = append(cmds, flashLoanCmd)
+
+	setEModeCmd := getCmdSetEMode()
+	flags.AddTxFlagsToCmd(setEModeCmd)
+	cmds = append(cmds, setEModeCmd)
+
 	jinxTxCmd.AddCommand(cmds...)
 
 	return jinxTxCmd
@@ -60,6 +74,9 @@ func getCmdDeposit() *cobra.Command {
 				return err
 			}
 			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), amount)
+			if msg.Market, err = cmd.Flags().GetString(flagMarket); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -87,6 +104,9 @@ func getCmdWithdraw() *cobra.Command {
 				return err
 			}
 			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), amount)
+			if msg.Market, err = cmd.Flags().GetString(flagMarket); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -116,6 +136,9 @@ func getCmdBorrow() *cobra.Command {
 			}
 
 			msg := types.NewMsgBorrow(clientCtx.GetFromAddress(), coins)
+			if msg.Market, err = cmd.Flags().GetString(flagMarket); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -163,6 +186,9 @@ func getCmdRepay() *cobra.Command {
 			}
 
 			msg := types.NewMsgRepay(clientCtx.GetFromAddress(), owner, coins)
+			if msg.Market, err = cmd.Flags().GetString(flagMarket); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -196,6 +222,112 @@ func getCmdLiquidate() *cobra.Command {
 			}
 
 			msg := types.NewMsgLiquidate(clientCtx.GetFromAddress(), borrower)
+			if msg.Market, err = cmd.Flags().GetString(flagMarket); err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdPartialLiquidate() *cobra.Command {
+	return &cobra.Command{
+		Use:   "partial-liquidate [borrower-addr] [repay-amount] [collateral-denom]",
+		Short: "repay part of an unhealthy borrow in exchange for the borrower's deposit",
+		Long: strings.TrimSpace(`repay part of the borrow of a borrower that's over their loan-to-value ratio, up to the
+close factor of the borrowed asset, and receive the same value of the borrower's collateral plus its liquidation bonus`),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(
+			`%s tx %s partial-liquidate fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j 100000000usdf bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			repay, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPartialLiquidate(clientCtx.GetFromAddress(), borrower, repay, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdFlashLoan() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-loan [amount] [msg-tx-json-file]",
+		Short: "borrow coins and execute the messages of a generated tx, repaying the loan plus fee at the end",
+		Long: `Borrow coins from the jinx money markets, execute the messages contained in a transaction generated
+with --generate-only, then repay the loan plus the flash loan fee. The whole transaction fails if the loan is not
+repaid.`,
+		Example: fmt.Sprintf(
+			`%s tx %s flash-loan 10000000usdf tx.json --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			msg, err := types.NewMsgFlashLoan(clientCtx.GetFromAddress(), amount, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetEMode() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-e-mode [category]",
+		Short: "opt in to an e-mode category, or out of the current one if no category is given",
+		Long: strings.TrimSpace(`opt in to an e-mode category, allowing deposits of its denoms to be borrowed against at its
+higher loan-to-value. All deposits and borrows must be in the category. Omit the category to opt out.`),
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(
+			`%[1]s tx %[2]s set-e-mode stablecoins --from <key>
+%[1]s tx %[2]s set-e-mode --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var category string
+			if len(args) > 0 {
+				category = args[0]
+			}
+
+			msg := types.NewMsgSetEMode(clientCtx.GetFromAddress(), category)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -25,6 +25,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 		k.SetPreviousAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
 		k.SetSupplyInterestFactor(ctx, gat.CollateralType, gat.SupplyInterestFactor)
 		k.SetBorrowInterestFactor(ctx, gat.CollateralType, gat.BorrowInterestFactor)
+		if !gat.RateAtTarget.IsNil() && gat.RateAtTarget.IsPositive() {
+			k.SetRateAtTarget(ctx, gat.CollateralType, gat.RateAtTarget)
+		}
 	}
 
 	for _, deposit := range gs.Deposits {
@@ -39,11 +42,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	for _, state := range gs.IsolatedMarketStates {
+		k.SetIsolatedMarketState(ctx, state)
+	}
+
+	for _, position := range gs.IsolatedPositions {
+		k.SetIsolatedPosition(ctx, position)
+	}
+
+	for _, accountEMode := range gs.AccountEModes {
+		k.SetAccountEMode(ctx, accountEMode)
+	}
+
+	for _, auction := range gs.IsolatedAuctions {
+		k.SetIsolatedAuction(ctx, auction)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	if DepositModuleAccount == nil {
 		panic(fmt.Sprintf("%s module account has not been set", DepositModuleAccount))
 	}
+	if accountKeeper.GetModuleAccount(ctx, types.IsolatedModuleAccountName) == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.IsolatedModuleAccountName))
+	}
 }
 
 // ExportGenesis export genesis state for jinx module
@@ -106,11 +128,42 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 			panic(fmt.Sprintf("expected previous accrual time to be set in state for %s", mm.Denom))
 		}
 		gat := types.NewGenesisAccumulationTime(mm.Denom, previousAccrualTime, supplyFactor, borrowFactor)
+		if rateAtTarget, f := k.GetRateAtTarget(ctx, mm.Denom); f {
+			gat.RateAtTarget = rateAtTarget
+		}
 		gats = append(gats, gat)
 
 	}
+
+	isolatedMarketStates := types.IsolatedMarketStates{}
+	k.IterateIsolatedMarketStates(ctx, func(state types.IsolatedMarketState) bool {
+		isolatedMarketStates = append(isolatedMarketStates, state)
+		return false
+	})
+
+	isolatedPositions := types.IsolatedPositions{}
+	k.IterateIsolatedPositions(ctx, "", func(position types.IsolatedPosition) bool {
+		syncedPosition, _ := k.GetSyncedIsolatedPosition(ctx, position.Market, position.Owner)
+		isolatedPositions = append(isolatedPositions, syncedPosition)
+		return false
+	})
+
+	accountEModes := types.AccountEModes{}
+	k.IterateAccountEModes(ctx, func(accountEMode types.AccountEMode) bool {
+		accountEModes = append(accountEModes, accountEMode)
+		return false
+	})
+
+	isolatedAuctions := types.IsolatedAuctions{}
+	k.IterateIsolatedAuctions(ctx, func(auction types.IsolatedAuction) bool {
+		isolatedAuctions = append(isolatedAuctions, auction)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
+		isolatedMarketStates, isolatedPositions, accountEModes,
+		isolatedAuctions,
 	)
 }
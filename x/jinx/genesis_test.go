@@ -41,28 +41,51 @@ func (suite *GenesisTestSuite) SetupTest() {
 
 func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 	loanToValue, _ := sdk.NewDecFromStr("0.6")
-	params := types.NewParams(
-		types.MoneyMarkets{
-			types.NewMoneyMarket(
-				"ufury",
-				types.NewBorrowLimit(
-					false,
-					sdk.NewDec(1e15),
-					loanToValue,
-				),
-				"fury:usd",
-				sdkmath.NewInt(1e6),
-				types.NewInterestRateModel(
-					sdk.MustNewDecFromStr("0.05"),
-					sdk.MustNewDecFromStr("2"),
-					sdk.MustNewDecFromStr("0.8"),
-					sdk.MustNewDecFromStr("10"),
-				),
+	moneyMarkets := types.MoneyMarkets{
+		types.NewMoneyMarket(
+			"ufury",
+			types.NewBorrowLimit(
+				false,
+				sdk.NewDec(1e15),
+				loanToValue,
+			),
+			"fury:usd",
+			sdkmath.NewInt(1e6),
+			types.NewInterestRateModel(
 				sdk.MustNewDecFromStr("0.05"),
-				sdk.ZeroDec(),
+				sdk.MustNewDecFromStr("2"),
+				sdk.MustNewDecFromStr("0.8"),
+				sdk.MustNewDecFromStr("10"),
 			),
-		},
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.ZeroDec(),
+		),
+	}
+	isolatedMoneyMarkets := append(types.MoneyMarkets{
+		types.NewMoneyMarket(
+			"usdf",
+			types.NewBorrowLimit(false, sdk.NewDec(1e15), loanToValue),
+			"usdf:usd",
+			sdkmath.NewInt(1e6),
+			types.NewInterestRateModel(
+				sdk.MustNewDecFromStr("0.05"),
+				sdk.MustNewDecFromStr("2"),
+				sdk.MustNewDecFromStr("0.8"),
+				sdk.MustNewDecFromStr("10"),
+			),
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.ZeroDec(),
+		),
+	}, moneyMarkets...)
+	params := types.NewParams(
+		moneyMarkets,
 		sdk.NewDec(10),
+		types.IsolatedMarkets{
+			types.NewIsolatedMarket("ufury-isolated", isolatedMoneyMarkets, []string{"ufury"}, []string{"usdf"}),
+		},
+		types.EModeCategories{
+			types.NewEModeCategory("ufury-correlated", []string{"ufury"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.93")),
+		},
 	)
 
 	deposits := types.Deposits{
@@ -115,6 +138,14 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		totalSupplied,
 		totalBorrowed,
 		sdk.Coins{},
+		types.DefaultIsolatedMarketStates,
+		types.DefaultIsolatedPositions,
+		types.AccountEModes{
+			types.NewAccountEMode(suite.addrs[1], "ufury-correlated"),
+		},
+		types.IsolatedAuctions{
+			types.NewIsolatedAuction(1, "ufury-isolated", sdk.NewCoin("usdf", sdkmath.NewInt(1e6))),
+		},
 	)
 
 	suite.NotPanics(
@@ -117,6 +117,10 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		return types.ErrBorrowEmptyCoins
 	}
 
+	if err := k.ValidateEModeDenoms(ctx, borrower, amount); err != nil {
+		return err
+	}
+
 	// The reserve coins aren't available for users to borrow
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	jinxMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
@@ -171,6 +175,11 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if !found {
 		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
+	existingBorrow, foundBorrow := k.GetBorrow(ctx, borrower)
+
+	// The e-mode loan-to-value replaces each money market's when the account's deposits and borrows are all in its category
+	eModeCategory, eModeActive := k.GetActiveEModeCategory(ctx, borrower, deposit.Amount, existingBorrow.Amount, amount)
+
 	totalBorrowableAmount := sdk.ZeroDec()
 	for _, coin := range deposit.Amount {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
@@ -184,14 +193,17 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		loanToValue := moneyMarket.BorrowLimit.LoanToValue
+		if eModeActive {
+			loanToValue = eModeCategory.LoanToValue
+		}
+		borrowableAmountForDeposit := moneyMarket.SupplyLimit.CollateralValue(depositUSDValue).Mul(loanToValue)
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
 	// Get the total USD value of user's existing borrows
 	existingBorrowUSDValue := sdk.ZeroDec()
-	existingBorrow, found := k.GetBorrow(ctx, borrower)
-	if found {
+	if foundBorrow {
 		for _, coin := range existingBorrow.Amount {
 			moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
 			if !found {
@@ -321,8 +321,11 @@ func (suite *KeeperTestSuite) TestBorrow() {
 					types.NewMoneyMarket("xyz", types.NewBorrowLimit(false, sdk.NewDec(1), tc.args.loanToValueBNB), "xyz:usd", sdkmath.NewInt(1), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.NewDec(10),
+				types.DefaultIsolatedMarkets,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
 			)
 
 			// Pricefeed module genesis state
@@ -470,6 +473,8 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
 			},
 			sdk.NewDec(10),
+			types.DefaultIsolatedMarkets,
+			types.DefaultEModeCategories,
 		),
 		types.DefaultAccumulationTimes,
 		types.DefaultDeposits,
@@ -477,6 +482,10 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 		types.DefaultTotalSupplied,
 		types.DefaultTotalBorrowed,
 		types.DefaultTotalReserves,
+		types.DefaultIsolatedMarketStates,
+		types.DefaultIsolatedPositions,
+		types.DefaultAccountEModes,
+		types.DefaultIsolatedAuctions,
 	)
 
 	// Pricefeed module genesis state
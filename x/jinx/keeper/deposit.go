@@ -38,6 +38,21 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
+	err = k.ValidateEModeDenoms(ctx, depositor, coins)
+	if err != nil {
+		return err
+	}
+
+	suppliedCoins, _ := k.GetSuppliedCoins(ctx)
+	syncedDeposit, _ := k.GetDeposit(ctx, depositor)
+	for _, coin := range coins {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		err = k.ValidateSupplyLimit(ctx, mm, coin, suppliedCoins.AmountOf(coin.Denom), syncedDeposit.Amount.AmountOf(coin.Denom))
+		if err != nil {
+			return err
+		}
+	}
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
@@ -116,6 +131,35 @@ func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
 	return nil
 }
 
+// ValidateSupplyLimit validates a deposit of a coin against the money market's supply limit, given the total amount
+// supplied and the amount already deposited by the account
+func (k Keeper) ValidateSupplyLimit(ctx sdk.Context, mm types.MoneyMarket, coin sdk.Coin, totalSupplied, deposited sdkmath.Int) error {
+	if mm.SupplyLimit.HasMaxLimit {
+		proposedTotalSupplied := sdk.NewDecFromInt(totalSupplied.Add(coin.Amount))
+		if proposedTotalSupplied.GT(mm.SupplyLimit.MaximumLimit) {
+			return errorsmod.Wrapf(types.ErrGreaterThanAssetSupplyLimit,
+				"proposed deposit would result in %s%s supplied, but the maximum global asset supply limit is %s",
+				proposedTotalSupplied, coin.Denom, mm.SupplyLimit.MaximumLimit)
+		}
+	}
+
+	if mm.SupplyLimit.HasMaxCollateralValue {
+		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", mm.SpotMarketID)
+		}
+		proposedDeposit := deposited.Add(coin.Amount)
+		proposedUSDValue := sdk.NewDecFromInt(proposedDeposit).Quo(sdk.NewDecFromInt(mm.ConversionFactor)).Mul(assetPriceInfo.Price)
+		if proposedUSDValue.GT(mm.SupplyLimit.MaxCollateralValue) {
+			return errorsmod.Wrapf(types.ErrGreaterThanCollateralValueLimit,
+				"proposed deposit would result in $%s of %s deposited, but the maximum collateral value is $%s",
+				proposedUSDValue, coin.Denom, mm.SupplyLimit.MaxCollateralValue)
+		}
+	}
+
+	return nil
+}
+
 // GetTotalDeposited returns the total amount deposited for the input deposit type and deposit denom
 func (k Keeper) GetTotalDeposited(ctx sdk.Context, depositDenom string) (total sdkmath.Int) {
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
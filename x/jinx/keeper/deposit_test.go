@@ -122,8 +122,11 @@ func (suite *KeeperTestSuite) TestDeposit() {
 					types.NewMoneyMarket("btcb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "btcb:usd", sdkmath.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.NewDec(10),
+				types.DefaultIsolatedMarkets,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
 			)
 
 			// Pricefeed module genesis state
@@ -284,8 +287,11 @@ func (suite *KeeperTestSuite) TestDecrementSuppliedCoins() {
 					types.NewMoneyMarket("xrpb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "xrpb:usd", sdkmath.NewInt(100000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.MustNewDecFromStr("10"),
+				types.DefaultIsolatedMarkets,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
 			)
 			// Pricefeed module genesis state
 			pricefeedGS := pricefeedtypes.GenesisState{
@@ -342,3 +348,141 @@ func (suite *KeeperTestSuite) TestDecrementSuppliedCoins() {
 
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
+
+func (suite *KeeperTestSuite) TestDepositSupplyLimit() {
+	type args struct {
+		supplyLimit  types.SupplyLimit
+		priorDeposit sdk.Coins
+		amount       sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		err        error
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			"valid: within supply limit",
+			args{
+				supplyLimit:  types.NewSupplyLimit(true, sdk.NewDec(150*BNB_CF), false, sdk.ZeroDec()),
+				priorDeposit: cs(c("bnb", 100*BNB_CF)),
+				amount:       cs(c("bnb", 50*BNB_CF)),
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"invalid: exceeds supply limit",
+			args{
+				supplyLimit:  types.NewSupplyLimit(true, sdk.NewDec(150*BNB_CF), false, sdk.ZeroDec()),
+				priorDeposit: cs(c("bnb", 100*BNB_CF)),
+				amount:       cs(c("bnb", 51*BNB_CF)),
+			},
+			errArgs{expectPass: false, err: types.ErrGreaterThanAssetSupplyLimit},
+		},
+		{
+			"valid: within collateral value limit",
+			args{
+				supplyLimit:  types.NewSupplyLimit(false, sdk.ZeroDec(), true, sdk.NewDec(500)),
+				priorDeposit: sdk.NewCoins(),
+				amount:       cs(c("bnb", 50*BNB_CF)),
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"invalid: exceeds collateral value limit",
+			args{
+				supplyLimit:  types.NewSupplyLimit(false, sdk.ZeroDec(), true, sdk.NewDec(500)),
+				priorDeposit: sdk.NewCoins(),
+				amount:       cs(c("bnb", 51*BNB_CF)),
+			},
+			errArgs{expectPass: false, err: types.ErrGreaterThanCollateralValueLimit},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			lender, depositor := suite.setupSupplyLimitApp(tc.args.supplyLimit)
+
+			if !tc.args.priorDeposit.Empty() {
+				suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, tc.args.priorDeposit))
+			}
+
+			err := suite.keeper.Deposit(suite.ctx, depositor, tc.args.amount)
+			if !tc.errArgs.expectPass {
+				suite.Require().ErrorIs(err, tc.errArgs.err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			dep, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.amount, dep.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCollateralValueLimitCapsBorrowingPower() {
+	lender, depositor := suite.setupSupplyLimitApp(types.NewSupplyLimit(false, sdk.ZeroDec(), true, sdk.NewDec(500)))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdf", 1000*USDF_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, cs(c("bnb", 50*BNB_CF))))
+
+	// doubling the bnb price doubles the deposit value, but only $500 of it counts towards the borrow limit
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("20.00"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	err = suite.keeper.Borrow(suite.ctx, depositor, cs(c("usdf", 401*USDF_CF)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, depositor, cs(c("usdf", 400*USDF_CF))))
+}
+
+func (suite *KeeperTestSuite) setupSupplyLimitApp(supplyLimit types.SupplyLimit) (sdk.AccAddress, sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		cs(c("bnb", 1000*BNB_CF), c("usdf", 1000*USDF_CF)),
+		addrs,
+	)
+
+	irm := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	bnbMM := types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "bnb:usd", sdkmath.NewInt(BNB_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	bnbMM.SupplyLimit = supplyLimit
+	usdfMM := types.NewMoneyMarket("usdf", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdf:usd", sdkmath.NewInt(USDF_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	jinxGS := types.NewGenesisState(
+		types.NewParams(types.MoneyMarkets{bnbMM, usdfMM}, sdk.NewDec(10), types.DefaultIsolatedMarkets, types.DefaultEModeCategories),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdf:usd", BaseAsset: "usdf", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdf:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: time.Now().Add(time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&jinxGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetJinxKeeper()
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	return addrs[0], addrs[1]
+}
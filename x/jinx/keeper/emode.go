@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/jinx/types"
+)
+
+// SetEMode opts an account in to an e-mode category, or out of its current category if the category is empty
+func (k Keeper) SetEMode(ctx sdk.Context, account sdk.AccAddress, category string) error {
+	existingDeposit, foundDeposit := k.GetDeposit(ctx, account)
+	if foundDeposit {
+		k.BeforeDepositModified(ctx, existingDeposit)
+	}
+	existingBorrow, foundBorrow := k.GetBorrow(ctx, account)
+	if foundBorrow {
+		k.BeforeBorrowModified(ctx, existingBorrow)
+	}
+
+	// Sync any outstanding interest
+	k.SyncBorrowInterest(ctx, account)
+	k.SyncSupplyInterest(ctx, account)
+
+	// Refresh positions after syncing interest
+	deposit, _ := k.GetDeposit(ctx, account)
+	borrow, _ := k.GetBorrow(ctx, account)
+
+	if category == "" {
+		k.DeleteAccountEMode(ctx, account)
+	} else {
+		eModeCategory, found := k.GetEModeCategory(ctx, category)
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidEModeCategory, "%s", category)
+		}
+		if !eModeCategory.ContainsAll(deposit.Amount, borrow.Amount) {
+			return errorsmod.Wrapf(types.ErrEModeCategoryMismatch,
+				"deposits %s and borrows %s must all be in e-mode category %s", deposit.Amount, borrow.Amount, category)
+		}
+		k.SetAccountEMode(ctx, types.NewAccountEMode(account, category))
+	}
+
+	// Changing category can lower the account's liquidation threshold, so the position must remain valid
+	if foundDeposit && foundBorrow {
+		valid, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "changing e-mode category would put borrows %s outside loan-to-value range", borrow.Amount)
+		}
+	}
+
+	// Call incentive hooks
+	if foundDeposit {
+		k.AfterDepositModified(ctx, deposit)
+	}
+	if foundBorrow {
+		k.AfterBorrowModified(ctx, borrow)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxSetEMode,
+			sdk.NewAttribute(types.AttributeKeyOwner, account.String()),
+			sdk.NewAttribute(types.AttributeKeyEModeCategory, category),
+		),
+	)
+
+	return nil
+}
+
+// ValidateEModeDenoms validates that an account that has opted in to an e-mode category only deposits or borrows
+// coins in the category
+func (k Keeper) ValidateEModeDenoms(ctx sdk.Context, account sdk.AccAddress, coins sdk.Coins) error {
+	category, found := k.GetAccountEMode(ctx, account)
+	if !found {
+		return nil
+	}
+	eModeCategory, found := k.GetEModeCategory(ctx, category)
+	if !found {
+		return nil
+	}
+	if !eModeCategory.ContainsAll(coins) {
+		return errorsmod.Wrapf(types.ErrEModeCategoryMismatch, "%s must be in e-mode category %s", coins, category)
+	}
+	return nil
+}
+
+// GetActiveEModeCategory returns the e-mode category of an account if all of the coins are in it
+func (k Keeper) GetActiveEModeCategory(ctx sdk.Context, account sdk.AccAddress, coins ...sdk.Coins) (types.EModeCategory, bool) {
+	if account.Empty() {
+		return types.EModeCategory{}, false
+	}
+	category, found := k.GetAccountEMode(ctx, account)
+	if !found {
+		return types.EModeCategory{}, false
+	}
+	eModeCategory, found := k.GetEModeCategory(ctx, category)
+	if !found || !eModeCategory.ContainsAll(coins...) {
+		return types.EModeCategory{}, false
+	}
+	return eModeCategory, true
+}
+
+// GetEModeCategory returns an e-mode category from the params
+func (k Keeper) GetEModeCategory(ctx sdk.Context, name string) (types.EModeCategory, bool) {
+	return k.GetParams(ctx).EModeCategories.Get(name)
+}
+
+// GetAccountEMode returns the name of the e-mode category an account has opted in to
+func (k Keeper) GetAccountEMode(ctx sdk.Context, account sdk.AccAddress) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	bz := store.Get(account.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetAccountEMode sets the e-mode category of an account in the store
+func (k Keeper) SetAccountEMode(ctx sdk.Context, accountEMode types.AccountEMode) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	store.Set(accountEMode.Account.Bytes(), []byte(accountEMode.Category))
+}
+
+// DeleteAccountEMode deletes the e-mode category of an account from the store
+func (k Keeper) DeleteAccountEMode(ctx sdk.Context, account sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	store.Delete(account.Bytes())
+}
+
+// IterateAccountEModes iterates over the e-mode categories of all accounts and performs a callback function
+func (k Keeper) IterateAccountEModes(ctx sdk.Context, cb func(accountEMode types.AccountEMode) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		accountEMode := types.NewAccountEMode(sdk.AccAddress(iterator.Key()), string(iterator.Value()))
+		if cb(accountEMode) {
+			break
+		}
+	}
+}
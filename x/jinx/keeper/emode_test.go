@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	incentivetypes "github.com/percosis-labs/fury/x/incentive/types"
+	"github.com/percosis-labs/fury/x/jinx"
+	"github.com/percosis-labs/fury/x/jinx/types"
+	pricefeedtypes "github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+const eModeCategory = "stablecoins"
+
+func (suite *KeeperTestSuite) TestSetEMode() {
+	type args struct {
+		deposit  sdk.Coins
+		borrow   sdk.Coins
+		category string
+	}
+	type errArgs struct {
+		expectPass bool
+		err        error
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			"valid: no position",
+			args{category: eModeCategory},
+			errArgs{expectPass: true},
+		},
+		{
+			"valid: deposits and borrows in category",
+			args{
+				deposit:  cs(c("usdf", 100*USDF_CF)),
+				borrow:   cs(c("usdx", 40*USDF_CF)),
+				category: eModeCategory,
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"invalid: unknown category",
+			args{category: "volatile"},
+			errArgs{expectPass: false, err: types.ErrInvalidEModeCategory},
+		},
+		{
+			"invalid: deposit outside category",
+			args{
+				deposit:  cs(c("usdf", 100*USDF_CF), c("bnb", 1*BNB_CF)),
+				category: eModeCategory,
+			},
+			errArgs{expectPass: false, err: types.ErrEModeCategoryMismatch},
+		},
+		{
+			"invalid: borrow outside category",
+			args{
+				deposit:  cs(c("usdf", 100*USDF_CF)),
+				borrow:   cs(c("bnb", 1*BNB_CF)),
+				category: eModeCategory,
+			},
+			errArgs{expectPass: false, err: types.ErrEModeCategoryMismatch},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			lender, account := suite.setupEModeApp()
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF), c("bnb", 10*BNB_CF))))
+			if !tc.args.deposit.Empty() {
+				suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, tc.args.deposit))
+			}
+			if !tc.args.borrow.Empty() {
+				suite.Require().NoError(suite.keeper.Borrow(suite.ctx, account, tc.args.borrow))
+			}
+
+			err := suite.keeper.SetEMode(suite.ctx, account, tc.args.category)
+			if !tc.errArgs.expectPass {
+				suite.Require().ErrorIs(err, tc.errArgs.err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			category, found := suite.keeper.GetAccountEMode(suite.ctx, account)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.category, category)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEModeBorrowLimit() {
+	lender, account := suite.setupEModeApp()
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF), c("bnb", 10*BNB_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, cs(c("usdf", 100*USDF_CF))))
+
+	// outside of e-mode the money market loan-to-value of 0.5 applies
+	err := suite.keeper.Borrow(suite.ctx, account, cs(c("usdx", 51*USDF_CF)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, account, eModeCategory))
+
+	// in e-mode the category loan-to-value of 0.9 applies
+	err = suite.keeper.Borrow(suite.ctx, account, cs(c("usdx", 91*USDF_CF)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, account, cs(c("usdx", 90*USDF_CF))))
+
+	// coins outside of the category can't be deposited or borrowed
+	err = suite.keeper.Deposit(suite.ctx, account, cs(c("bnb", 1*BNB_CF)))
+	suite.Require().ErrorIs(err, types.ErrEModeCategoryMismatch)
+	err = suite.keeper.Borrow(suite.ctx, account, cs(c("bnb", 1)))
+	suite.Require().ErrorIs(err, types.ErrEModeCategoryMismatch)
+
+	// leaving e-mode would put the borrow over the money market loan-to-value
+	err = suite.keeper.SetEMode(suite.ctx, account, "")
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+}
+
+func (suite *KeeperTestSuite) TestEModeWithdrawLimit() {
+	lender, account := suite.setupEModeApp()
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF), c("bnb", 10*BNB_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, cs(c("usdf", 100*USDF_CF))))
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, account, eModeCategory))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, account, cs(c("usdx", 81*USDF_CF))))
+
+	// withdrawals are held to the category loan-to-value of 0.9, not its liquidation threshold of 0.95
+	health, err := suite.keeper.GetPositionHealth(suite.ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("usdf", 10*USDF_CF)), health.MaxWithdraw)
+
+	err = suite.keeper.Withdraw(suite.ctx, account, cs(c("usdf", 10*USDF_CF+1)))
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawAmount)
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, account, cs(c("usdf", 10*USDF_CF))))
+
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, account)
+	suite.Require().Equal(cs(c("usdf", 90*USDF_CF)), deposit.Amount)
+}
+
+func (suite *KeeperTestSuite) TestEModeLiquidationThreshold() {
+	lender, account := suite.setupEModeApp()
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF), c("bnb", 10*BNB_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, cs(c("usdf", 100*USDF_CF))))
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, account, eModeCategory))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, account, cs(c("usdx", 90*USDF_CF))))
+
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, account)
+	borrow, _ := suite.keeper.GetBorrow(suite.ctx, account)
+
+	// $94.5 borrowed is within the liquidation threshold of 0.95
+	suite.setEModePrice("usdx:usd", sdk.MustNewDecFromStr("1.05"))
+	valid, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.Require().True(valid)
+
+	// $95.4 borrowed is over it
+	suite.setEModePrice("usdx:usd", sdk.MustNewDecFromStr("1.06"))
+	valid, err = suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.Require().False(valid)
+
+	// the category no longer applies once a deposit is outside of it
+	suite.setEModePrice("usdx:usd", sdk.MustNewDecFromStr("1.00"))
+	deposit.Amount = deposit.Amount.Add(c("bnb", 1*BNB_CF))
+	valid, err = suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.Require().False(valid)
+}
+
+func (suite *KeeperTestSuite) setEModePrice(marketID string, price sdk.Dec) {
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, marketID, price, suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, marketID))
+}
+
+func (suite *KeeperTestSuite) setupEModeApp() (sdk.AccAddress, sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		cs(c("bnb", 100*BNB_CF), c("usdf", 1000*USDF_CF), c("usdx", 1000*USDF_CF)),
+		addrs,
+	)
+
+	irm := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	borrowLimit := types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5"))
+	jinxGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("usdf", borrowLimit, "usdf:usd", sdkmath.NewInt(USDF_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				types.NewMoneyMarket("usdx", borrowLimit, "usdx:usd", sdkmath.NewInt(USDF_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				types.NewMoneyMarket("bnb", borrowLimit, "bnb:usd", sdkmath.NewInt(BNB_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+			},
+			sdk.NewDec(10),
+			types.DefaultIsolatedMarkets,
+			types.EModeCategories{
+				types.NewEModeCategory(eModeCategory, []string{"usdf", "usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")),
+			},
+		),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdf:usd", BaseAsset: "usdf", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdf:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: time.Now().Add(time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&jinxGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetJinxKeeper()
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	return addrs[0], addrs[1]
+}
+
+func (suite *KeeperTestSuite) TestSetEModeSyncsIncentives() {
+	lender, account := suite.setupEModeApp()
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF), c("bnb", 10*BNB_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, cs(c("usdf", 100*USDF_CF))))
+
+	// rewards accrue to usdf suppliers while the account is outside of e-mode
+	ik := suite.app.GetIncentiveKeeper()
+	globalIndexes := incentivetypes.RewardIndexes{incentivetypes.NewRewardIndex("jinx", sdk.MustNewDecFromStr("0.1"))}
+	ik.SetJinxSupplyRewardIndexes(suite.ctx, "usdf", globalIndexes)
+
+	suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, account, eModeCategory))
+
+	// the accrued rewards are checkpointed against the deposit before the category change
+	claim, found := ik.GetJinxLiquidityProviderClaim(suite.ctx, account)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("jinx", 10*USDF_CF)), claim.Reward)
+	indexes, found := claim.SupplyRewardIndexes.Get("usdf")
+	suite.Require().True(found)
+	suite.Require().Equal(globalIndexes, indexes)
+}
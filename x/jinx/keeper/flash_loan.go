@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/percosis-labs/fury/x/jinx/types"
+)
+
+// FlashLoan lends coins from the money markets to a borrower, executes messages on their behalf, then takes back the
+// loan plus the flash loan fee from the borrower's account. If any message fails or the loan is not repaid an error
+// is returned, reverting the whole transaction.
+func (k Keeper) FlashLoan(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) error {
+	// The msgs are checked again here as ValidateBasic is not run when a flash loan is executed through authz
+	if err := types.ValidateFlashLoanMsgs(borrower, msgs); err != nil {
+		return err
+	}
+
+	fees := sdk.NewCoins()
+	for _, coin := range amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		if !mm.FlashLoanFee.IsNil() {
+			fee := mm.FlashLoanFee.MulInt(coin.Amount).Ceil().TruncateInt()
+			fees = fees.Add(sdk.NewCoin(coin.Denom, fee))
+		}
+	}
+
+	// Only cash that is not held as reserves can be lent
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	cash := k.bankKeeper.SpendableCoins(ctx, macc.GetAddress())
+	reserves, _ := k.GetTotalReserves(ctx)
+	for _, coin := range amount {
+		available := cash.AmountOf(coin.Denom).Sub(reserves.AmountOf(coin.Denom))
+		if coin.Amount.GT(available) {
+			return errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested flash loan %s > available %s%s", coin, available, coin.Denom)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, amount); err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to execute message %s", sdk.MsgTypeURL(msg))
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	repayment := amount.Add(fees...)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.ModuleAccountName, repayment)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrFlashLoanNotRepaid, "%s must be repaid: %s", repayment, err)
+	}
+
+	if !fees.Empty() {
+		k.SetTotalReserves(ctx, reserves.Add(fees...))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxFlashLoan,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fees.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/jinx"
+	"github.com/percosis-labs/fury/x/jinx/types"
+	pricefeedtypes "github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashLoan() {
+	type args struct {
+		flashLoanFee sdk.Dec
+		amount       sdk.Coins
+		sendAmount   sdk.Coins
+		sendByLender bool
+		expectedFee  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		err        error
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			"valid: fee added to reserves",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 50*USDF_CF)),
+				sendAmount:   cs(c("usdf", 1*USDF_CF)),
+				expectedFee:  cs(c("usdf", 50*USDF_CF/1000)),
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"valid: fee rounded up",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 1001)),
+				sendAmount:   cs(c("usdf", 1)),
+				expectedFee:  cs(c("usdf", 2)),
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"valid: zero fee",
+			args{
+				flashLoanFee: sdk.ZeroDec(),
+				amount:       cs(c("usdf", 50*USDF_CF)),
+				sendAmount:   cs(c("usdf", 1*USDF_CF)),
+				expectedFee:  sdk.NewCoins(),
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"invalid: loan not repaid",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 50*USDF_CF)),
+				sendAmount:   cs(c("usdf", 1000*USDF_CF)),
+			},
+			errArgs{expectPass: false, err: types.ErrFlashLoanNotRepaid},
+		},
+		{
+			"invalid: nested message fails",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 50*USDF_CF)),
+				sendAmount:   cs(c("usdf", 2000*USDF_CF)),
+			},
+			errArgs{expectPass: false, err: sdkerrors.ErrInsufficientFunds},
+		},
+		{
+			"invalid: message not signed by borrower",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 50*USDF_CF)),
+				sendAmount:   cs(c("usdf", 1*USDF_CF)),
+				sendByLender: true,
+			},
+			errArgs{expectPass: false, err: types.ErrInvalidFlashLoanMsg},
+		},
+		{
+			"invalid: message fails validation",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 50*USDF_CF)),
+				sendAmount:   sdk.NewCoins(),
+			},
+			errArgs{expectPass: false, err: sdkerrors.ErrInvalidCoins},
+		},
+		{
+			"invalid: exceeds available cash",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("usdf", 101*USDF_CF)),
+				sendAmount:   cs(c("usdf", 1*USDF_CF)),
+			},
+			errArgs{expectPass: false, err: types.ErrExceedsProtocolBorrowableBalance},
+		},
+		{
+			"invalid: no money market",
+			args{
+				flashLoanFee: sdk.MustNewDecFromStr("0.001"),
+				amount:       cs(c("xrp", 1*USDF_CF)),
+				sendAmount:   cs(c("usdf", 1*USDF_CF)),
+			},
+			errArgs{expectPass: false, err: types.ErrMarketNotFound},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			lender, borrower, recipient := suite.setupFlashLoanApp(tc.args.flashLoanFee)
+			bk := suite.app.GetBankKeeper()
+			initialBalance := bk.GetAllBalances(suite.ctx, borrower)
+			initialReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+
+			sender := borrower
+			if tc.args.sendByLender {
+				sender = lender
+			}
+			initialLenderBalance := bk.GetAllBalances(suite.ctx, lender)
+
+			send := banktypes.NewMsgSend(sender, recipient, tc.args.sendAmount)
+			err := suite.keeper.FlashLoan(suite.ctx, borrower, tc.args.amount, []sdk.Msg{send})
+			if !tc.errArgs.expectPass {
+				suite.Require().ErrorIs(err, tc.errArgs.err)
+				suite.Require().Equal(initialLenderBalance, bk.GetAllBalances(suite.ctx, lender))
+				return
+			}
+			suite.Require().NoError(err)
+
+			expectedBalance := initialBalance.Sub(tc.args.sendAmount...).Sub(tc.args.expectedFee...)
+			suite.Require().Equal(expectedBalance, bk.GetAllBalances(suite.ctx, borrower))
+
+			reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+			suite.Require().True(initialReserves.Add(tc.args.expectedFee...).IsEqual(reserves))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFlashLoanWithinAuthzExec() {
+	testCases := []struct {
+		name         string
+		sendByLender bool
+		expectedErr  error
+	}{
+		{
+			name: "valid: msgs signed by the borrower",
+		},
+		{
+			name:         "invalid: msg signed by another account",
+			sendByLender: true,
+			expectedErr:  types.ErrInvalidFlashLoanMsg,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			lender, borrower, recipient := suite.setupFlashLoanApp(sdk.ZeroDec())
+			bk := suite.app.GetBankKeeper()
+			initialLenderBalance := bk.GetAllBalances(suite.ctx, lender)
+
+			sender := borrower
+			if tc.sendByLender {
+				sender = lender
+			}
+			send := cs(c("usdf", 1*USDF_CF))
+			flashLoan, err := types.NewMsgFlashLoan(borrower, cs(c("usdf", 50*USDF_CF)), []sdk.Msg{banktypes.NewMsgSend(sender, recipient, send)})
+			suite.Require().NoError(err)
+
+			// authz executes msgs signed by the grantee without a grant, and without running their ValidateBasic
+			exec := authz.NewMsgExec(borrower, []sdk.Msg{&flashLoan})
+			handler := suite.app.MsgServiceRouter().Handler(&exec)
+			suite.Require().NotNil(handler)
+			_, err = handler(suite.ctx, &exec)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().Equal(initialLenderBalance, bk.GetAllBalances(suite.ctx, lender))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(send, bk.GetAllBalances(suite.ctx, recipient).Sub(cs(c("bnb", 100*BNB_CF), c("usdf", 1000*USDF_CF))...))
+		})
+	}
+}
+
+// setupFlashLoanApp sets up a usdf money market with the flash loan fee, and returns a lender that has deposited 100
+// usdf along with a borrower and recipient
+func (suite *KeeperTestSuite) setupFlashLoanApp(flashLoanFee sdk.Dec) (sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	lender, borrower, recipient := addrs[0], addrs[1], addrs[2]
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		cs(c("bnb", 100*BNB_CF), c("usdf", 1000*USDF_CF)),
+		addrs,
+	)
+
+	irm := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	usdfMM := types.NewMoneyMarket("usdf", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdf:usd", sdkmath.NewInt(USDF_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	usdfMM.FlashLoanFee = flashLoanFee
+	jinxGS := types.NewGenesisState(
+		types.NewParams(types.MoneyMarkets{usdfMM}, sdk.NewDec(10), types.DefaultIsolatedMarkets, types.DefaultEModeCategories),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdf:usd", BaseAsset: "usdf", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdf:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&jinxGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetJinxKeeper()
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdf", 100*USDF_CF))))
+	return lender, borrower, recipient
+}
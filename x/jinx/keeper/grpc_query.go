@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -448,7 +449,8 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has ien borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		rateAtTarget := s.keeper.getRateAtTarget(sdkCtx, denom, moneyMarket.InterestRateModel)
+		borrowAPY, err := calculateBorrowRate(moneyMarket.InterestRateModel, rateAtTarget, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
@@ -544,3 +546,154 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) IsolatedMarkets(ctx context.Context, req *types.QueryIsolatedMarketsRequest) (*types.QueryIsolatedMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var states types.IsolatedMarketStates
+	for _, market := range s.keeper.GetParams(sdkCtx).IsolatedMarkets {
+		if len(req.Market) > 0 && market.Name != req.Market {
+			continue
+		}
+		state, found := s.keeper.GetIsolatedMarketState(sdkCtx, market.Name)
+		if !found {
+			state = types.NewIsolatedMarketState(market.Name)
+		}
+		states = append(states, state)
+	}
+
+	return &types.QueryIsolatedMarketsResponse{
+		IsolatedMarketStates: states,
+	}, nil
+}
+
+func (s queryServer) IsolatedPositions(ctx context.Context, req *types.QueryIsolatedPositionsRequest) (*types.QueryIsolatedPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var owner sdk.AccAddress
+	var err error
+	if len(req.Owner) > 0 {
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	var positions types.IsolatedPositions
+	s.keeper.IterateIsolatedPositions(sdkCtx, req.Market, func(position types.IsolatedPosition) (stop bool) {
+		if owner.Empty() || position.Owner.Equals(owner) {
+			synced, _ := s.keeper.GetSyncedIsolatedPosition(sdkCtx, position.Market, position.Owner)
+			positions = append(positions, synced)
+		}
+		return false
+	})
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(positions), page, limit, 100)
+	if start < 0 || end < 0 {
+		positions = types.IsolatedPositions{}
+	} else {
+		positions = positions[start:end]
+	}
+
+	return &types.QueryIsolatedPositionsResponse{
+		IsolatedPositions: positions.ToResponse(),
+		Pagination:        nil,
+	}, nil
+}
+
+func (s queryServer) EMode(ctx context.Context, req *types.QueryEModeRequest) (*types.QueryEModeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	category, _ := s.keeper.GetAccountEMode(sdkCtx, account)
+	deposit, _ := s.keeper.GetDeposit(sdkCtx, account)
+	borrow, _ := s.keeper.GetBorrow(sdkCtx, account)
+	_, active := s.keeper.GetActiveEModeCategory(sdkCtx, account, deposit.Amount, borrow.Amount)
+
+	return &types.QueryEModeResponse{
+		Category: category,
+		Active:   active,
+	}, nil
+}
+
+func (s queryServer) PositionHealth(ctx context.Context, req *types.QueryPositionHealthRequest) (*types.QueryPositionHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	health, err := s.keeper.GetPositionHealth(sdkCtx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPositionHealthResponse{
+		Health: health.ToResponse(),
+	}, nil
+}
+
+func (s queryServer) PositionsAtRisk(ctx context.Context, req *types.QueryPositionsAtRiskRequest) (*types.QueryPositionsAtRiskResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Positions whose health can't be calculated, such as those with a denom missing a price, are left out
+	var positions types.PositionHealths
+	s.keeper.IterateBorrows(sdkCtx, func(borrow types.Borrow) (stop bool) {
+		health, err := s.keeper.GetPositionHealth(sdkCtx, borrow.Borrower)
+		if err == nil {
+			positions = append(positions, health)
+		}
+		return false
+	})
+
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].HealthFactor.LT(positions[j].HealthFactor)
+	})
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(positions), page, limit, 100)
+	if start < 0 || end < 0 {
+		positions = types.PositionHealths{}
+	} else {
+		positions = positions[start:end]
+	}
+
+	return &types.QueryPositionsAtRiskResponse{
+		Positions:  positions.ToResponse(),
+		Pagination: nil,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/percosis-labs/fury/app"
 	"github.com/percosis-labs/fury/x/jinx/keeper"
 	"github.com/percosis-labs/fury/x/jinx/types"
@@ -64,10 +65,90 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 	var expected types.GenesisState
 	defaultJINXState := NewJINXGenState(suite.tApp.AppCodec())
 	suite.tApp.AppCodec().MustUnmarshalJSON(defaultJINXState[types.ModuleName], &expected)
+	// no isolated markets, e-mode categories or kinks are read from the store as nil, rather than the empty list in json
+	suite.Empty(res.Params.IsolatedMarkets)
+	expected.Params.IsolatedMarkets = res.Params.IsolatedMarkets
+	suite.Empty(res.Params.EModeCategories)
+	expected.Params.EModeCategories = res.Params.EModeCategories
+	for i, mm := range res.Params.MoneyMarkets {
+		suite.Empty(mm.InterestRateModel.Kinks)
+		expected.Params.MoneyMarkets[i].InterestRateModel.Kinks = mm.InterestRateModel.Kinks
+	}
 
 	suite.Equal(expected.Params, res.Params, "params should equal test genesis state")
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryEMode() {
+	res, err := suite.queryServer.EMode(sdk.WrapSDKContext(suite.ctx), &types.QueryEModeRequest{
+		Account: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryEModeResponse{}, res)
+
+	// a category removed from the params is returned but isn't active
+	suite.keeper.SetAccountEMode(suite.ctx, types.NewAccountEMode(suite.addrs[0], "stablecoins"))
+	res, err = suite.queryServer.EMode(sdk.WrapSDKContext(suite.ctx), &types.QueryEModeRequest{
+		Account: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryEModeResponse{Category: "stablecoins", Active: false}, res)
+
+	_, err = suite.queryServer.EMode(sdk.WrapSDKContext(suite.ctx), &types.QueryEModeRequest{
+		Account: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryPositionHealth() {
+	_, err := suite.queryServer.PositionHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionHealthRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrDepositNotFound)
+
+	suite.addDeposits()
+	suite.addBorrows()
+
+	health, err := suite.keeper.GetPositionHealth(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.PositionHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionHealthRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(health.ToResponse(), res.Health)
+	suite.Require().NotEmpty(res.Health.HealthFactor)
+
+	_, err = suite.queryServer.PositionHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionHealthRequest{
+		Owner: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryPositionsAtRisk() {
+	res, err := suite.queryServer.PositionsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionsAtRiskRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Positions)
+
+	suite.addDeposits()
+	suite.addBorrows()
+
+	res, err = suite.queryServer.PositionsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionsAtRiskRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Positions, 2)
+
+	// positions are ordered from the lowest health factor
+	first := sdk.MustNewDecFromStr(res.Positions[0].HealthFactor)
+	second := sdk.MustNewDecFromStr(res.Positions[1].HealthFactor)
+	suite.Require().True(first.LTE(second))
+
+	res, err = suite.queryServer.PositionsAtRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionsAtRiskRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Positions, 1)
+	suite.Require().Equal(first.String(), res.Positions[0].HealthFactor)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryAccounts() {
 	res, err := suite.queryServer.Accounts(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountsRequest{})
 	suite.Require().NoError(err)
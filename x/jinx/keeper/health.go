@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/jinx/types"
+)
+
+// GetPositionHealth returns the health of an account's position, including any outstanding interest
+func (k Keeper) GetPositionHealth(ctx sdk.Context, owner sdk.AccAddress) (types.PositionHealth, error) {
+	deposit, foundDeposit := k.GetSyncedDeposit(ctx, owner)
+	borrow, foundBorrow := k.GetSyncedBorrow(ctx, owner)
+	if !foundDeposit && !foundBorrow {
+		return types.PositionHealth{}, errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit or borrow found for %s", owner)
+	}
+	deposit.Depositor = owner
+	borrow.Borrower = owner
+
+	return k.CalculatePositionHealth(ctx, deposit, borrow)
+}
+
+// CalculatePositionHealth calculates how close a deposit and borrow are to liquidation at current prices, the USD value
+// that can still be borrowed against the deposit, and how much of each deposited coin can be withdrawn
+func (k Keeper) CalculatePositionHealth(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (types.PositionHealth, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return types.PositionHealth{}, err
+	}
+
+	owner := deposit.Depositor
+	if owner.Empty() {
+		owner = borrow.Borrower
+	}
+	eModeCategory, eModeActive := k.GetActiveEModeCategory(ctx, owner, deposit.Amount, borrow.Amount)
+
+	// The liquidation capacity uses the liquidation data's loan-to-values, which are the e-mode liquidation threshold
+	// when it applies, while the borrow capacity uses the loan-to-values that limit new borrows
+	depositUSDValue := sdk.ZeroDec()
+	liquidationCapacity := sdk.ZeroDec()
+	borrowCapacity := sdk.ZeroDec()
+	loanToValues := make(map[string]sdk.Dec)
+	for _, coin := range deposit.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := wholeUnits(coin.Amount, lData.conversionFactor).Mul(lData.price)
+		collateralValue := lData.supplyLimit.CollateralValue(usdValue)
+
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		loanToValue := mm.BorrowLimit.LoanToValue
+		if eModeActive {
+			loanToValue = eModeCategory.LoanToValue
+		}
+
+		loanToValues[coin.Denom] = loanToValue
+
+		depositUSDValue = depositUSDValue.Add(usdValue)
+		liquidationCapacity = liquidationCapacity.Add(collateralValue.Mul(lData.ltv))
+		borrowCapacity = borrowCapacity.Add(collateralValue.Mul(loanToValue))
+	}
+
+	borrowUSDValue := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		borrowUSDValue = borrowUSDValue.Add(wholeUnits(coin.Amount, lData.conversionFactor).Mul(lData.price))
+	}
+
+	health := types.PositionHealth{
+		Owner:             owner,
+		DepositUSDValue:   depositUSDValue,
+		BorrowUSDValue:    borrowUSDValue,
+		MaxBorrowUSDValue: sdk.MaxDec(borrowCapacity.Sub(borrowUSDValue), sdk.ZeroDec()),
+		MaxWithdraw:       sdk.NewCoins(),
+		LiquidationPrices: types.LiquidationPrices{},
+	}
+	if borrowUSDValue.IsPositive() {
+		health.HealthFactor = liquidationCapacity.Quo(borrowUSDValue)
+	}
+
+	// Each deposit can be withdrawn until the position's borrow capacity falls to the value of its borrows, as
+	// withdrawals are held to the same limit as new borrows
+	spareCapacity := borrowCapacity.Sub(borrowUSDValue)
+	for _, coin := range deposit.Amount {
+		lData := liqMap[coin.Denom]
+		loanToValue := loanToValues[coin.Denom]
+		if borrowUSDValue.IsZero() || loanToValue.IsZero() || !lData.price.IsPositive() {
+			health.MaxWithdraw = health.MaxWithdraw.Add(coin)
+			continue
+		}
+		if !spareCapacity.IsPositive() {
+			continue
+		}
+		collateralValue := lData.supplyLimit.CollateralValue(wholeUnits(coin.Amount, lData.conversionFactor).Mul(lData.price))
+		remainingValue := collateralValue.Sub(spareCapacity.Quo(loanToValue))
+		if !remainingValue.IsPositive() {
+			health.MaxWithdraw = health.MaxWithdraw.Add(coin)
+			continue
+		}
+		remaining := remainingValue.Quo(lData.price).MulInt(lData.conversionFactor).Ceil().TruncateInt()
+		if withdrawable := coin.Amount.Sub(remaining); withdrawable.IsPositive() {
+			health.MaxWithdraw = health.MaxWithdraw.Add(sdk.NewCoin(coin.Denom, withdrawable))
+		}
+	}
+
+	// A position that is already liquidatable has no liquidation prices
+	excess := liquidationCapacity.Sub(borrowUSDValue)
+	if excess.IsNegative() {
+		return health, nil
+	}
+	for _, denom := range removeDuplicates(getDenoms(borrow.Amount), getDenoms(deposit.Amount)) {
+		lData := liqMap[denom]
+		deposited := wholeUnits(deposit.Amount.AmountOf(denom), lData.conversionFactor)
+		borrowed := wholeUnits(borrow.Amount.AmountOf(denom), lData.conversionFactor)
+
+		// The liquidation capacity of the rest of the position net of the rest of its borrows
+		contribution := lData.supplyLimit.CollateralValue(deposited.Mul(lData.price)).Mul(lData.ltv).Sub(borrowed.Mul(lData.price))
+		rest := excess.Sub(contribution)
+
+		lower, upper := liquidationPriceRange(deposited, borrowed, lData.ltv, lData.supplyLimit, rest)
+		if !lower.IsNil() || !upper.IsNil() {
+			health.LiquidationPrices = append(health.LiquidationPrices, types.NewLiquidationPrice(denom, lower, upper))
+		}
+	}
+
+	return health, nil
+}
+
+// liquidationPriceRange returns the prices of an asset below and above which a valid position becomes liquidatable.
+// The position's liquidation capacity net of its borrows is a concave function of the asset's price: it rises with the
+// collateral value of the deposit until the collateral value reaches its cap and falls with the value of the borrow. The
+// lower price is its root while it rises, and the upper price its root once it falls. A nil price means there is none.
+func liquidationPriceRange(deposited, borrowed, ltv sdk.Dec, supplyLimit types.SupplyLimit, rest sdk.Dec) (lower, upper sdk.Dec) {
+	slope := deposited.Mul(ltv).Sub(borrowed)
+	if rest.IsNegative() && slope.IsPositive() {
+		lower = rest.Neg().Quo(slope)
+	}
+
+	if supplyLimit.HasMaxCollateralValue && deposited.IsPositive() {
+		capPrice := supplyLimit.MaxCollateralValue.Quo(deposited)
+		cappedCapacity := supplyLimit.MaxCollateralValue.Mul(ltv).Add(rest)
+		if !cappedCapacity.Sub(borrowed.Mul(capPrice)).IsNegative() {
+			// The position is still valid once the deposit's collateral value is capped, so only the borrow moves it
+			if borrowed.IsPositive() {
+				upper = cappedCapacity.Quo(borrowed)
+			}
+			return lower, upper
+		}
+	}
+	if slope.IsNegative() {
+		upper = rest.Quo(slope.Neg())
+	}
+	return lower, upper
+}
+
+// wholeUnits converts an amount in the smallest unit of a token to whole units
+func wholeUnits(amount sdkmath.Int, conversionFactor sdkmath.Int) sdk.Dec {
+	return sdk.NewDecFromInt(amount).Quo(sdk.NewDecFromInt(conversionFactor))
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/jinx/types"
+)
+
+func (suite *KeeperTestSuite) TestGetPositionHealth() {
+	type args struct {
+		deposit sdk.Coins
+		borrow  sdk.Coins
+		eMode   bool
+		// maxCollateralValue caps the collateral value of bnb if set
+		maxCollateralValue sdk.Dec
+	}
+	type expected struct {
+		healthFactor      sdk.Dec
+		maxBorrowUSDValue sdk.Dec
+		maxWithdraw       sdk.Coins
+		liquidationPrices types.LiquidationPrices
+	}
+	testCases := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			"deposit only",
+			args{
+				deposit: cs(c("bnb", 10*BNB_CF)),
+			},
+			expected{
+				healthFactor:      sdk.Dec{},
+				maxBorrowUSDValue: sdk.NewDec(50),
+				maxWithdraw:       cs(c("bnb", 10*BNB_CF)),
+				liquidationPrices: types.LiquidationPrices{},
+			},
+		},
+		{
+			"deposit and borrow",
+			args{
+				deposit: cs(c("bnb", 10*BNB_CF)),
+				borrow:  cs(c("usdx", 40*USDF_CF)),
+			},
+			expected{
+				healthFactor:      sdk.MustNewDecFromStr("1.25"),
+				maxBorrowUSDValue: sdk.NewDec(10),
+				maxWithdraw:       cs(c("bnb", 2*BNB_CF)),
+				liquidationPrices: types.LiquidationPrices{
+					types.NewLiquidationPrice("bnb", sdk.NewDec(8), sdk.Dec{}),
+					types.NewLiquidationPrice("usdx", sdk.Dec{}, sdk.MustNewDecFromStr("1.25")),
+				},
+			},
+		},
+		{
+			"collateral value cap",
+			args{
+				deposit:            cs(c("bnb", 10*BNB_CF)),
+				borrow:             cs(c("usdx", 20*USDF_CF)),
+				maxCollateralValue: sdk.NewDec(60),
+			},
+			expected{
+				healthFactor:      sdk.MustNewDecFromStr("1.5"),
+				maxBorrowUSDValue: sdk.NewDec(10),
+				maxWithdraw:       cs(c("bnb", 6*BNB_CF)),
+				liquidationPrices: types.LiquidationPrices{
+					types.NewLiquidationPrice("bnb", sdk.NewDec(4), sdk.Dec{}),
+					types.NewLiquidationPrice("usdx", sdk.Dec{}, sdk.MustNewDecFromStr("1.5")),
+				},
+			},
+		},
+		{
+			"e-mode",
+			args{
+				deposit: cs(c("usdf", 100*USDF_CF)),
+				borrow:  cs(c("usdx", 90*USDF_CF)),
+				eMode:   true,
+			},
+			expected{
+				healthFactor:      sdk.NewDec(95).Quo(sdk.NewDec(90)),
+				maxBorrowUSDValue: sdk.ZeroDec(),
+				maxWithdraw:       sdk.NewCoins(), // withdrawals are held to the e-mode loan-to-value
+				liquidationPrices: types.LiquidationPrices{
+					types.NewLiquidationPrice("usdf", sdk.NewDec(90).Quo(sdk.NewDec(95)), sdk.Dec{}),
+					types.NewLiquidationPrice("usdx", sdk.Dec{}, sdk.NewDec(95).Quo(sdk.NewDec(90))),
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			lender, account := suite.setupEModeApp()
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF), c("bnb", 10*BNB_CF))))
+			if tc.args.eMode {
+				suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, account, eModeCategory))
+			}
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, tc.args.deposit))
+			if !tc.args.borrow.Empty() {
+				suite.Require().NoError(suite.keeper.Borrow(suite.ctx, account, tc.args.borrow))
+			}
+
+			// the cap is set after depositing as deposits over it are rejected
+			if !tc.args.maxCollateralValue.IsNil() {
+				mm, found := suite.keeper.GetMoneyMarket(suite.ctx, "bnb")
+				suite.Require().True(found)
+				mm.SupplyLimit = types.NewSupplyLimit(false, sdk.ZeroDec(), true, tc.args.maxCollateralValue)
+				suite.keeper.SetMoneyMarket(suite.ctx, "bnb", mm)
+			}
+
+			health, err := suite.keeper.GetPositionHealth(suite.ctx, account)
+			suite.Require().NoError(err)
+			suite.Require().Equal(account, health.Owner)
+			if tc.expected.healthFactor.IsNil() {
+				suite.Require().True(health.HealthFactor.IsNil())
+			} else {
+				suite.Require().Equal(tc.expected.healthFactor, health.HealthFactor)
+			}
+			suite.Require().Equal(tc.expected.maxBorrowUSDValue.String(), health.MaxBorrowUSDValue.String())
+			suite.Require().Equal(tc.expected.maxWithdraw, health.MaxWithdraw)
+			suite.Require().Equal(tc.expected.liquidationPrices, health.LiquidationPrices)
+
+			// withdrawing the maximum amount of a deposit keeps the position valid
+			for _, coin := range health.MaxWithdraw {
+				cacheCtx, _ := suite.ctx.CacheContext()
+				suite.Require().NoError(suite.keeper.Withdraw(cacheCtx, account, cs(coin)))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetPositionHealth_Liquidatable() {
+	lender, account := suite.setupEModeApp()
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdx", 500*USDF_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, account, cs(c("bnb", 10*BNB_CF))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, account, cs(c("usdx", 50*USDF_CF))))
+
+	suite.setEModePrice("bnb:usd", sdk.MustNewDecFromStr("9.00"))
+
+	health, err := suite.keeper.GetPositionHealth(suite.ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.9"), health.HealthFactor)
+	suite.Require().True(health.IsLiquidatable())
+	suite.Require().True(health.MaxBorrowUSDValue.IsZero())
+	suite.Require().Empty(health.MaxWithdraw)
+	suite.Require().Empty(health.LiquidationPrices)
+
+	_, err = suite.keeper.GetPositionHealth(suite.ctx, sdk.AccAddress("no position"))
+	suite.Require().ErrorIs(err, types.ErrDepositNotFound)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
 	"github.com/percosis-labs/fury/x/jinx/types"
 )
 
@@ -50,3 +51,18 @@ func (k Keeper) AfterBorrowModified(ctx sdk.Context, borrow types.Borrow) {
 		k.hooks.AfterBorrowModified(ctx, borrow)
 	}
 }
+
+// AuctionHooks wrapper struct for the auction hooks run by jinx
+type AuctionHooks struct {
+	k Keeper
+}
+
+var _ auctiontypes.AuctionHooks = AuctionHooks{}
+
+// AuctionHooks returns the hooks jinx runs in response to auctions
+func (k Keeper) AuctionHooks() AuctionHooks { return AuctionHooks{k} }
+
+// AfterAuctionClosed credits the proceeds of an auction started by an isolated market liquidation to its market
+func (h AuctionHooks) AfterAuctionClosed(ctx sdk.Context, auction auctiontypes.Auction) {
+	h.k.CloseIsolatedAuction(ctx, auction.GetID(), auction.GetBid())
+}
@@ -72,6 +72,8 @@ func NewJINXGenState(cdc codec.JSONCodec) app.GenesisState {
 				},
 			},
 			sdk.MustNewDecFromStr("10"),
+			types.DefaultIsolatedMarkets,
+			types.DefaultEModeCategories,
 		),
 		PreviousAccumulationTimes: types.GenesisAccumulationTimes{
 			types.NewGenesisAccumulationTime(
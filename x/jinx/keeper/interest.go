@@ -116,7 +116,8 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	rateAtTarget := k.getRateAtTarget(ctx, denom, mm.InterestRateModel)
+	borrowRateApy, err := calculateBorrowRate(mm.InterestRateModel, rateAtTarget, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
 	if err != nil {
 		return err
 	}
@@ -153,14 +154,45 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
 	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
 
+	// Adjust the rate at target of adaptive models for the time utilization spent away from the target
+	if mm.InterestRateModel.IsAdaptive() {
+		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+		k.SetRateAtTarget(ctx, denom, CalculateRateAtTarget(mm.InterestRateModel, rateAtTarget, utilRatio, sdkmath.NewInt(timeElapsed)))
+	}
+
 	return nil
 }
 
+// getRateAtTarget returns the current rate at target of a money market's adaptive interest rate model, which starts
+// at the model's base rate and is kept within its bounds if the model changes.
+func (k Keeper) getRateAtTarget(ctx sdk.Context, denom string, model types.InterestRateModel) sdk.Dec {
+	rateAtTarget, found := k.GetRateAtTarget(ctx, denom)
+	return boundRateAtTarget(model, rateAtTarget, found)
+}
+
+func boundRateAtTarget(model types.InterestRateModel, rateAtTarget sdk.Dec, found bool) sdk.Dec {
+	if !model.IsAdaptive() || !found || rateAtTarget.IsNil() || !rateAtTarget.IsPositive() {
+		return model.BaseRateAPY
+	}
+	return sdk.MaxDec(model.MinRateAtTarget, sdk.MinDec(model.MaxRateAtTarget, rateAtTarget))
+}
+
 // CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
-// based on the current utilization.
+// based on the current utilization. Adaptive models are evaluated at their initial rate at target.
 func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
+	return calculateBorrowRate(model, model.BaseRateAPY, cash, borrows, reserves)
+}
+
+func calculateBorrowRate(model types.InterestRateModel, rateAtTarget, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
 	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
 
+	switch model.ModelType {
+	case types.InterestRateModelTypePiecewise:
+		return CalculatePiecewiseBorrowRate(model.BaseRateAPY, model.Kinks, utilRatio), nil
+	case types.InterestRateModelTypeAdaptive:
+		return CalculateAdaptiveBorrowRate(model, rateAtTarget, utilRatio), nil
+	}
+
 	// Calculate normal borrow rate (under kink)
 	if utilRatio.LTE(model.Kink) {
 		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
@@ -172,6 +204,51 @@ func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves
 	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
 }
 
+// CalculatePiecewiseBorrowRate calculates the borrow rate of a piecewise model by interpolating linearly between the
+// base rate at zero utilization and the kinks on either side of the utilization.
+func CalculatePiecewiseBorrowRate(baseRateAPY sdk.Dec, kinks types.InterestRateKinks, utilRatio sdk.Dec) sdk.Dec {
+	prevUtil, prevRate := sdk.ZeroDec(), baseRateAPY
+	for _, kink := range kinks {
+		if utilRatio.LTE(kink.Utilization) {
+			slope := kink.RateAPY.Sub(prevRate).Quo(kink.Utilization.Sub(prevUtil))
+			return utilRatio.Sub(prevUtil).Mul(slope).Add(prevRate)
+		}
+		prevUtil, prevRate = kink.Utilization, kink.RateAPY
+	}
+	return prevRate
+}
+
+// CalculateAdaptiveBorrowRate calculates the borrow rate of an adaptive model. The rate equals the rate at target at
+// the target utilization, falling linearly to rateAtTarget / steepness at zero utilization and rising linearly to
+// rateAtTarget * steepness at full utilization.
+func CalculateAdaptiveBorrowRate(model types.InterestRateModel, rateAtTarget, utilRatio sdk.Dec) sdk.Dec {
+	distance := adaptiveDistance(model.TargetUtilization, utilRatio)
+	coefficient := model.CurveSteepness.Sub(sdk.OneDec())
+	if distance.IsNegative() {
+		coefficient = sdk.OneDec().Sub(sdk.OneDec().Quo(model.CurveSteepness))
+	}
+	return rateAtTarget.Mul(sdk.OneDec().Add(distance.Mul(coefficient)))
+}
+
+// CalculateRateAtTarget calculates the rate at target of an adaptive model after utilization stayed at utilRatio for
+// secondsElapsed. The rate changes by up to the model's adjustment speed per year, in proportion to how far
+// utilization is from the target, and is kept between the model's min and max rate at target.
+func CalculateRateAtTarget(model types.InterestRateModel, rateAtTarget, utilRatio sdk.Dec, secondsElapsed sdkmath.Int) sdk.Dec {
+	distance := adaptiveDistance(model.TargetUtilization, utilRatio)
+	years := sdk.NewDecFromInt(secondsElapsed).QuoInt64(int64(secondsPerYear))
+	adjusted := rateAtTarget.Mul(sdk.OneDec().Add(model.AdjustmentSpeed.Mul(distance).Mul(years)))
+	return sdk.MaxDec(model.MinRateAtTarget, sdk.MinDec(model.MaxRateAtTarget, adjusted))
+}
+
+// adaptiveDistance returns how far utilization is from the target, scaled to -1 at zero utilization and 1 at full
+// utilization
+func adaptiveDistance(target, utilRatio sdk.Dec) sdk.Dec {
+	if utilRatio.GT(target) {
+		return utilRatio.Sub(target).Quo(sdk.OneDec().Sub(target))
+	}
+	return utilRatio.Sub(target).Quo(target)
+}
+
 // CalculateUtilizationRatio calculates an asset's current utilization rate
 func CalculateUtilizationRatio(cash, borrows, reserves sdk.Dec) sdk.Dec {
 	// Utilization rate is 0 when there are no borrows
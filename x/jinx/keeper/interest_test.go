@@ -211,6 +211,35 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 		},
 	}
 
+	testCases = append(testCases,
+		test{
+			"piecewise model",
+			args{
+				cash:     sdk.MustNewDecFromStr("600"),
+				borrows:  sdk.MustNewDecFromStr("500"),
+				reserves: sdk.MustNewDecFromStr("100"),
+				model: types.NewPiecewiseInterestRateModel(sdk.MustNewDecFromStr("0.02"), types.InterestRateKinks{
+					types.NewInterestRateKink(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.1")),
+					types.NewInterestRateKink(sdk.OneDec(), sdk.OneDec()),
+				}),
+				expectedValue: sdk.MustNewDecFromStr("0.07"),
+			},
+		},
+		test{
+			"adaptive model at initial rate at target",
+			args{
+				cash:     sdk.MustNewDecFromStr("600"),
+				borrows:  sdk.MustNewDecFromStr("500"),
+				reserves: sdk.MustNewDecFromStr("100"),
+				model: types.NewAdaptiveInterestRateModel(
+					sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("50"),
+					sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("4"),
+				),
+				expectedValue: sdk.MustNewDecFromStr("0.04"),
+			},
+		},
+	)
+
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			borrowRate, err := keeper.CalculateBorrowRate(tc.args.model, tc.args.cash, tc.args.borrows, tc.args.reserves)
@@ -220,6 +249,99 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 	}
 }
 
+func (suite *InterestTestSuite) TestCalculatePiecewiseBorrowRate() {
+	kinks := types.InterestRateKinks{
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.1")),
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.3")),
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("1.0"), sdk.MustNewDecFromStr("2.0")),
+	}
+	baseRate := sdk.MustNewDecFromStr("0.02")
+
+	testCases := []struct {
+		name          string
+		utilRatio     sdk.Dec
+		expectedValue sdk.Dec
+	}{
+		{"zero utilization", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.02")},
+		{"first segment", sdk.MustNewDecFromStr("0.25"), sdk.MustNewDecFromStr("0.06")},
+		{"at kink", sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.1")},
+		{"second segment", sdk.MustNewDecFromStr("0.7"), sdk.MustNewDecFromStr("0.2")},
+		{"last segment", sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("1.15")},
+		{"full utilization", sdk.OneDec(), sdk.MustNewDecFromStr("2.0")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			borrowRate := keeper.CalculatePiecewiseBorrowRate(baseRate, kinks, tc.utilRatio)
+			suite.Require().Equal(tc.expectedValue, borrowRate)
+		})
+	}
+}
+
+func (suite *InterestTestSuite) TestCalculateAdaptiveBorrowRate() {
+	model := types.NewAdaptiveInterestRateModel(
+		sdk.MustNewDecFromStr("0.04"), // initial rate at target
+		sdk.MustNewDecFromStr("0.9"),  // target utilization
+		sdk.MustNewDecFromStr("50"),   // adjustment speed
+		sdk.MustNewDecFromStr("0.001"),
+		sdk.MustNewDecFromStr("2"),
+		sdk.MustNewDecFromStr("4"), // curve steepness
+	)
+
+	testCases := []struct {
+		name          string
+		rateAtTarget  sdk.Dec
+		utilRatio     sdk.Dec
+		expectedValue sdk.Dec
+	}{
+		{"at target", sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.04")},
+		{"zero utilization", sdk.MustNewDecFromStr("0.04"), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.01")},
+		{"below target", sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.45"), sdk.MustNewDecFromStr("0.025")},
+		{"above target", sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.1")},
+		{"full utilization", sdk.MustNewDecFromStr("0.04"), sdk.OneDec(), sdk.MustNewDecFromStr("0.16")},
+		{"adjusted rate at target", sdk.MustNewDecFromStr("0.08"), sdk.OneDec(), sdk.MustNewDecFromStr("0.32")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			borrowRate := keeper.CalculateAdaptiveBorrowRate(model, tc.rateAtTarget, tc.utilRatio)
+			suite.Require().Equal(tc.expectedValue, borrowRate)
+		})
+	}
+}
+
+func (suite *InterestTestSuite) TestCalculateRateAtTarget() {
+	model := types.NewAdaptiveInterestRateModel(
+		sdk.MustNewDecFromStr("0.04"),
+		sdk.MustNewDecFromStr("0.9"),
+		sdk.MustNewDecFromStr("50"),
+		sdk.MustNewDecFromStr("0.001"),
+		sdk.MustNewDecFromStr("2"),
+		sdk.MustNewDecFromStr("4"),
+	)
+	oneYearInSeconds := int64(31536000)
+
+	testCases := []struct {
+		name          string
+		utilRatio     sdk.Dec
+		elapsed       int64
+		expectedValue sdk.Dec
+	}{
+		{"at target", sdk.MustNewDecFromStr("0.9"), oneYearInSeconds, sdk.MustNewDecFromStr("0.04")},
+		{"above target", sdk.OneDec(), oneYearInSeconds / 10, sdk.MustNewDecFromStr("0.24")},
+		{"below target", sdk.MustNewDecFromStr("0.45"), oneYearInSeconds / 100, sdk.MustNewDecFromStr("0.03")},
+		{"capped at max", sdk.OneDec(), oneYearInSeconds, sdk.MustNewDecFromStr("2")},
+		{"floored at min", sdk.ZeroDec(), oneYearInSeconds, sdk.MustNewDecFromStr("0.001")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rateAtTarget := keeper.CalculateRateAtTarget(model, sdk.MustNewDecFromStr("0.04"), tc.utilRatio, sdkmath.NewInt(tc.elapsed))
+			suite.Require().Equal(tc.expectedValue, rateAtTarget)
+		})
+	}
+}
+
 func (suite *InterestTestSuite) TestCalculateBorrowInterestFactor() {
 	type args struct {
 		perSecondInterestRate sdk.Dec
@@ -851,8 +973,11 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultIsolatedMarkets,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
 			)
 
 			// Pricefeed module genesis state
@@ -975,6 +1100,88 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAdaptiveInterestRateModel() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	lender, borrower := addrs[0], addrs[1]
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		cs(c("bnb", 1000*BNB_CF), c("usdf", 1000*USDF_CF)),
+		addrs,
+	)
+
+	// target 80% utilization, rate at target moves by up to 10x its value per year
+	adaptiveModel := types.NewAdaptiveInterestRateModel(
+		sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"),
+		sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("1"), sdk.MustNewDecFromStr("4"),
+	)
+	jumpModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	jinxGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("usdf", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdf:usd", sdkmath.NewInt(USDF_CF), adaptiveModel, sdk.ZeroDec(), sdk.ZeroDec()),
+				types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "bnb:usd", sdkmath.NewInt(BNB_CF), jumpModel, sdk.ZeroDec(), sdk.ZeroDec()),
+			},
+			sdk.NewDec(10),
+			types.DefaultIsolatedMarkets,
+			types.DefaultEModeCategories,
+		),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
+	)
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdf:usd", BaseAsset: "usdf", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdf:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * 24 * time.Hour)},
+			{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: time.Now().Add(100 * 24 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&jinxGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetJinxKeeper()
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	// 95% utilization of usdf
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdf", 100*USDF_CF))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, cs(c("bnb", 100*BNB_CF))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, cs(c("usdf", 95*USDF_CF))))
+
+	// the borrow rate starts from the initial rate at target
+	borrowRate := keeper.CalculateAdaptiveBorrowRate(adaptiveModel, adaptiveModel.BaseRateAPY, sdk.MustNewDecFromStr("0.95"))
+	borrowRateSpy, err := keeper.APYToSPY(sdk.OneDec().Add(borrowRate))
+	suite.Require().NoError(err)
+	elapsed := int64(31536000 / 10)
+	expectedFactor := keeper.CalculateBorrowInterestFactor(borrowRateSpy, sdkmath.NewInt(elapsed))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(elapsed) * time.Second))
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	factor, found := suite.keeper.GetBorrowInterestFactor(suite.ctx, "usdf")
+	suite.Require().True(found)
+	suite.Require().Equal(expectedFactor, factor)
+
+	// 0.04 * (1 + 10 * (0.95-0.8)/(1-0.8) * 0.1)
+	rateAtTarget, found := suite.keeper.GetRateAtTarget(suite.ctx, "usdf")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.07"), rateAtTarget)
+
+	// markets with other models are unaffected
+	_, found = suite.keeper.GetRateAtTarget(suite.ctx, "bnb")
+	suite.Require().False(found)
+}
+
 type ExpectedSupplyInterest struct {
 	elapsedTime  int64
 	shouldSupply bool
@@ -1263,8 +1470,11 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultIsolatedMarkets,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
 			)
 
 			// Pricefeed module genesis state
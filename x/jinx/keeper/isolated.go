@@ -0,0 +1,804 @@
+package keeper
+
+import (
+	"errors"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/percosis-labs/fury/x/jinx/types"
+)
+
+// IsolatedDeposit deposits coins in an isolated market
+func (k Keeper) IsolatedDeposit(ctx sdk.Context, depositor sdk.AccAddress, marketName string, coins sdk.Coins) error {
+	market, state, err := k.getIsolatedMarket(ctx, marketName)
+	if err != nil {
+		return err
+	}
+	for _, coin := range coins {
+		if _, found := market.GetMoneyMarket(coin.Denom); !found {
+			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, "isolated market %s has no money market for %s", marketName, coin.Denom)
+		}
+	}
+
+	position := k.getSyncedIsolatedPosition(ctx, state, marketName, depositor)
+
+	for _, coin := range coins {
+		mm, _ := market.GetMoneyMarket(coin.Denom)
+		err = k.ValidateSupplyLimit(ctx, mm, coin, state.TotalSupplied.AmountOf(coin.Denom), position.Deposit.AmountOf(coin.Denom))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.IsolatedModuleAccountName, coins)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+			accCoins := k.bankKeeper.SpendableCoins(ctx, depositor)
+			return errorsmod.Wrapf(types.ErrBorrowExceedsAvailableBalance,
+				"insufficient funds: the requested deposit amount of %s exceeds the total available account funds of %s",
+				coins, accCoins,
+			)
+		}
+		return err
+	}
+
+	for _, coin := range coins {
+		at := ensureIsolatedAccumulationTime(ctx, &state, coin.Denom)
+		position.DepositIndex = position.DepositIndex.SetInterestFactor(coin.Denom, at.SupplyInterestFactor)
+	}
+	position.Deposit = position.Deposit.Add(coins...)
+	state.TotalSupplied = state.TotalSupplied.Add(coins...)
+
+	k.SetIsolatedPosition(ctx, position)
+	k.SetIsolatedMarketState(ctx, state)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyIsolatedMarket, marketName),
+		),
+	)
+	return nil
+}
+
+// IsolatedWithdraw returns some or all of a deposit in an isolated market to the depositor
+func (k Keeper) IsolatedWithdraw(ctx sdk.Context, depositor sdk.AccAddress, marketName string, coins sdk.Coins) error {
+	market, state, err := k.getIsolatedMarket(ctx, marketName)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetIsolatedPosition(ctx, marketName, depositor); !found {
+		return errorsmod.Wrapf(types.ErrIsolatedPositionNotFound, "no position found for %s in %s", depositor, marketName)
+	}
+	position := k.getSyncedIsolatedPosition(ctx, state, marketName, depositor)
+
+	amount, err := k.CalculateWithdrawAmount(position.Deposit, coins)
+	if err != nil {
+		return err
+	}
+	for _, coin := range amount {
+		available := state.TotalSupplied.AmountOf(coin.Denom).Sub(state.TotalBorrowed.AmountOf(coin.Denom))
+		if coin.Amount.GT(available) {
+			return errorsmod.Wrapf(types.ErrInsufficientModAccountBalance, "requested withdraw %s > %s%s available in %s", coin, available, coin.Denom, marketName)
+		}
+	}
+
+	proposed := position
+	proposed.Deposit = position.Deposit.Sub(amount...)
+	borrowable, borrowed, err := k.getIsolatedPositionValues(ctx, market, proposed)
+	if err != nil {
+		return err
+	}
+	if borrowed.GT(borrowable) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "proposed withdraw outside loan-to-value range")
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IsolatedModuleAccountName, depositor, amount)
+	if err != nil {
+		return err
+	}
+
+	// If any coin denoms have been completely withdrawn remove the denom's supply index factor
+	for _, coin := range amount {
+		if !proposed.Deposit.AmountOf(coin.Denom).IsPositive() {
+			position.DepositIndex, _ = position.DepositIndex.RemoveInterestFactor(coin.Denom)
+		}
+	}
+	position.Deposit = proposed.Deposit
+	state.TotalSupplied = subCoinsFloor(state.TotalSupplied, amount)
+
+	k.SetIsolatedPosition(ctx, position)
+	k.SetIsolatedMarketState(ctx, state)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyIsolatedMarket, marketName),
+		),
+	)
+	return nil
+}
+
+// IsolatedBorrow borrows coins from an isolated market against the borrower's collateral in that market
+func (k Keeper) IsolatedBorrow(ctx sdk.Context, borrower sdk.AccAddress, marketName string, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return types.ErrBorrowEmptyCoins
+	}
+	market, state, err := k.getIsolatedMarket(ctx, marketName)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetIsolatedPosition(ctx, marketName, borrower); !found {
+		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s in %s", borrower, marketName)
+	}
+
+	for _, coin := range coins {
+		if !market.IsBorrowable(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrInvalidBorrowDenom, "%s cannot be borrowed from %s", coin.Denom, marketName)
+		}
+		mm, _ := market.GetMoneyMarket(coin.Denom)
+
+		available := state.TotalSupplied.AmountOf(coin.Denom).Sub(state.TotalBorrowed.AmountOf(coin.Denom))
+		if coin.Amount.GT(available) {
+			return errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested borrow %s > available to borrow %s%s", coin, available, coin.Denom)
+		}
+
+		if mm.BorrowLimit.HasMaxLimit {
+			proposedTotal := sdk.NewDecFromInt(state.TotalBorrowed.AmountOf(coin.Denom).Add(coin.Amount))
+			if proposedTotal.GT(mm.BorrowLimit.MaximumLimit) {
+				return errorsmod.Wrapf(types.ErrGreaterThanAssetBorrowLimit,
+					"proposed borrow would result in %s borrowed, but the maximum asset borrow limit of %s is %s",
+					proposedTotal, marketName, mm.BorrowLimit.MaximumLimit)
+			}
+		}
+	}
+
+	position := k.getSyncedIsolatedPosition(ctx, state, marketName, borrower)
+	proposed := position
+	proposed.Borrow = position.Borrow.Add(coins...)
+	borrowable, borrowed, err := k.getIsolatedPositionValues(ctx, market, proposed)
+	if err != nil {
+		return err
+	}
+	if borrowed.LT(k.GetMinimumBorrowUSDValue(ctx)) {
+		return errorsmod.Wrapf(types.ErrBelowMinimumBorrowValue, "the proposed borrow's USD value $%s is below the minimum borrow limit $%s", borrowed, k.GetMinimumBorrowUSDValue(ctx))
+	}
+	if borrowed.GT(borrowable) {
+		return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "requested borrow %s exceeds the allowable amount as determined by the collateralization ratio", coins)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IsolatedModuleAccountName, borrower, coins)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		at := ensureIsolatedAccumulationTime(ctx, &state, coin.Denom)
+		position.BorrowIndex = position.BorrowIndex.SetInterestFactor(coin.Denom, at.BorrowInterestFactor)
+	}
+	position.Borrow = proposed.Borrow
+	state.TotalBorrowed = state.TotalBorrowed.Add(coins...)
+
+	k.SetIsolatedPosition(ctx, position)
+	k.SetIsolatedMarketState(ctx, state)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxBorrow,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyIsolatedMarket, marketName),
+		),
+	)
+	return nil
+}
+
+// IsolatedRepay repays coins borrowed from an isolated market
+func (k Keeper) IsolatedRepay(ctx sdk.Context, sender, owner sdk.AccAddress, marketName string, coins sdk.Coins) error {
+	market, state, err := k.getIsolatedMarket(ctx, marketName)
+	if err != nil {
+		return err
+	}
+	existing, found := k.GetIsolatedPosition(ctx, marketName, owner)
+	if !found || existing.Borrow.Empty() {
+		return errorsmod.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s in %s", owner, marketName)
+	}
+	position := k.getSyncedIsolatedPosition(ctx, state, marketName, owner)
+
+	// cap the repayment by what's available to repay (the borrow amount)
+	payment, err := k.CalculatePaymentAmount(position.Borrow, coins)
+	if err != nil {
+		return err
+	}
+	senderCoins := k.bankKeeper.SpendableCoins(ctx, sender)
+	for _, coin := range payment {
+		if senderCoins.AmountOf(coin.Denom).LT(coin.Amount) {
+			return errorsmod.Wrapf(types.ErrInsufficientBalanceForRepay, "account can only repay up to %s%s", senderCoins.AmountOf(coin.Denom), coin.Denom)
+		}
+	}
+
+	// Unless the loan is fully repaid, the remaining borrow must be worth at least the minimum borrow USD value
+	proposed := position
+	proposed.Borrow = position.Borrow.Sub(payment...)
+	if !proposed.Borrow.Empty() {
+		_, borrowed, err := k.getIsolatedPositionValues(ctx, market, proposed)
+		if err != nil {
+			return err
+		}
+		if borrowed.LT(k.GetMinimumBorrowUSDValue(ctx)) {
+			return errorsmod.Wrapf(types.ErrBelowMinimumBorrowValue, "the proposed borrow's USD value $%s is below the minimum borrow limit $%s", borrowed, k.GetMinimumBorrowUSDValue(ctx))
+		}
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.IsolatedModuleAccountName, payment)
+	if err != nil {
+		return err
+	}
+
+	// If any coin denoms have been completely repaid remove the denom's borrow index factor
+	for _, coin := range payment {
+		if !proposed.Borrow.AmountOf(coin.Denom).IsPositive() {
+			position.BorrowIndex, _ = position.BorrowIndex.RemoveInterestFactor(coin.Denom)
+		}
+	}
+	position.Borrow = proposed.Borrow
+	state.TotalBorrowed = subCoinsFloor(state.TotalBorrowed, payment)
+
+	k.SetIsolatedPosition(ctx, position)
+	k.SetIsolatedMarketState(ctx, state)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxRepay,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyIsolatedMarket, marketName),
+		),
+	)
+	return nil
+}
+
+// AttemptIsolatedKeeperLiquidation enables a keeper to liquidate a borrower's position in an isolated market. Only the
+// position's collateral in the market is considered when checking its loan-to-value ratio.
+func (k Keeper) AttemptIsolatedKeeperLiquidation(ctx sdk.Context, keeper, borrower sdk.AccAddress, marketName string) error {
+	market, state, err := k.getIsolatedMarket(ctx, marketName)
+	if err != nil {
+		return err
+	}
+	existing, found := k.GetIsolatedPosition(ctx, marketName, borrower)
+	if !found || existing.Borrow.Empty() {
+		return errorsmod.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s in %s", borrower, marketName)
+	}
+	position := k.getSyncedIsolatedPosition(ctx, state, marketName, borrower)
+
+	borrowable, borrowed, err := k.getIsolatedPositionValues(ctx, market, position)
+	if err != nil {
+		return err
+	}
+	if borrowed.LTE(borrowable) {
+		return errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	}
+
+	liqMap := make(map[string]LiqData)
+	for _, mm := range market.MoneyMarkets {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", mm.SpotMarketID)
+		}
+		liqMap[mm.Denom] = LiqData{price.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor, mm.AuctionType, mm.SupplyLimit}
+	}
+
+	// All isolated markets share a module account, so the market can only pay out of its own cash. Deposits beyond it
+	// stay in the position and can be withdrawn once the market's borrowers repay.
+	paid := sdk.NewCoins()
+	for _, depCoin := range position.Deposit {
+		paid = paid.Add(sdk.NewCoin(depCoin.Denom, sdk.MinInt(depCoin.Amount, state.Cash(depCoin.Denom))))
+	}
+	unpaid := position.Deposit.Sub(paid...)
+
+	// Seize % of every collateral deposit and send to the keeper
+	keeperRewardCoins := sdk.Coins{}
+	depositCoinValues := types.NewValuationMap()
+	aucDeposits := sdk.Coins{}
+	for _, depCoin := range paid {
+		if !market.IsCollateral(depCoin.Denom) {
+			continue
+		}
+		mm, _ := market.GetMoneyMarket(depCoin.Denom)
+		keeperReward := mm.KeeperRewardPercentage.MulInt(depCoin.Amount).TruncateInt()
+		if keeperReward.IsPositive() {
+			keeperRewardCoins = keeperRewardCoins.Add(sdk.NewCoin(depCoin.Denom, keeperReward))
+		}
+		aucDeposit := sdk.NewCoin(depCoin.Denom, depCoin.Amount.Sub(keeperReward))
+		aucDeposits = aucDeposits.Add(aucDeposit)
+
+		dData := liqMap[depCoin.Denom]
+		depositCoinValues.Increment(depCoin.Denom, sdk.NewDecFromInt(aucDeposit.Amount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price))
+	}
+	if !keeperRewardCoins.Empty() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IsolatedModuleAccountName, keeper, keeperRewardCoins); err != nil {
+			return err
+		}
+	}
+
+	// Deposits that are not collateral in the market are returned to the borrower
+	remaining := paid.Sub(keeperRewardCoins...).Sub(aucDeposits...)
+	if !remaining.Empty() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IsolatedModuleAccountName, borrower, remaining); err != nil {
+			return err
+		}
+	}
+
+	borrowCoinValues := types.NewValuationMap()
+	for _, bCoin := range position.Borrow {
+		bData := liqMap[bCoin.Denom]
+		borrowCoinValues.Increment(bCoin.Denom, sdk.NewDecFromInt(bCoin.Amount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.price))
+	}
+
+	var liquidatedCoins sdk.Coins
+	auctionDebt := sdk.NewCoins()
+	depositUsdValue := depositCoinValues.Sum()
+	if depositUsdValue.IsPositive() {
+		ltv := borrowCoinValues.Sum().Quo(depositUsdValue)
+		liquidatedCoins, err = k.startAuctions(ctx, types.IsolatedModuleAccountName, aucDeposits, borrower, position.Borrow, aucDeposits,
+			depositCoinValues, borrowCoinValues, ltv, liqMap,
+			func(auctionID uint64, lot, bid sdk.Coin) error {
+				k.SetIsolatedAuction(ctx, types.NewIsolatedAuction(auctionID, marketName, bid))
+				auctionDebt = auctionDebt.Add(bid)
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The paid deposits leave the market, and the borrow stays in the market's total borrowed until the auction
+	// proceeds repay it. Any borrow not covered by an auction is written off.
+	state.TotalSupplied = subCoinsFloor(state.TotalSupplied, paid)
+	state.AuctionDebt = state.AuctionDebt.Add(auctionDebt...)
+	for _, coin := range subCoinsFloor(position.Borrow, auctionDebt) {
+		writeOffIsolatedDebt(ctx, &state, coin)
+	}
+	k.SetIsolatedMarketState(ctx, state)
+
+	if unpaid.Empty() {
+		k.DeleteIsolatedPosition(ctx, position)
+	} else {
+		for _, coin := range paid {
+			if !unpaid.AmountOf(coin.Denom).IsPositive() {
+				position.DepositIndex, _ = position.DepositIndex.RemoveInterestFactor(coin.Denom)
+			}
+		}
+		position.Deposit = unpaid
+		position.Borrow = sdk.Coins{}
+		position.BorrowIndex = types.BorrowInterestFactors{}
+		k.SetIsolatedPosition(ctx, position)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, liquidatedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeperRewardCoins, keeperRewardCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyIsolatedMarket, marketName),
+		),
+	)
+	return nil
+}
+
+// CloseIsolatedAuction credits the proceeds of a closed auction to the isolated market whose position it liquidated.
+// Debt the proceeds did not repay is written off against the market's reserves and suppliers.
+func (k Keeper) CloseIsolatedAuction(ctx sdk.Context, auctionID uint64, proceeds sdk.Coin) {
+	auction, found := k.GetIsolatedAuction(ctx, auctionID)
+	if !found {
+		return
+	}
+	state, found := k.GetIsolatedMarketState(ctx, auction.Market)
+	if !found {
+		state = types.NewIsolatedMarketState(auction.Market)
+	}
+
+	repaid := sdk.NewCoin(auction.Debt.Denom, sdk.ZeroInt())
+	if proceeds.Denom == auction.Debt.Denom {
+		repaid.Amount = sdk.MinInt(proceeds.Amount, auction.Debt.Amount)
+	}
+	state.TotalBorrowed = subCoinsFloor(state.TotalBorrowed, sdk.NewCoins(repaid))
+	state.AuctionDebt = subCoinsFloor(state.AuctionDebt, sdk.NewCoins(auction.Debt))
+	if shortfall := auction.Debt.Sub(repaid); shortfall.IsPositive() {
+		writeOffIsolatedDebt(ctx, &state, shortfall)
+	}
+
+	k.SetIsolatedMarketState(ctx, state)
+	k.DeleteIsolatedAuction(ctx, auctionID)
+}
+
+// writeOffIsolatedDebt removes borrowed coins that will not be repaid from an isolated market. The loss is taken from
+// the market's reserves first, and then from its suppliers by lowering the supply interest factor.
+func writeOffIsolatedDebt(ctx sdk.Context, state *types.IsolatedMarketState, coin sdk.Coin) {
+	state.TotalBorrowed = subCoinsFloor(state.TotalBorrowed, sdk.NewCoins(coin))
+
+	fromReserves := sdk.MinInt(coin.Amount, state.TotalReserves.AmountOf(coin.Denom))
+	state.TotalReserves = subCoinsFloor(state.TotalReserves, sdk.NewCoins(sdk.NewCoin(coin.Denom, fromReserves)))
+
+	supplied := state.TotalSupplied.AmountOf(coin.Denom)
+	loss := sdk.MinInt(coin.Amount.Sub(fromReserves), supplied)
+	if !loss.IsPositive() {
+		return
+	}
+	// the factor is kept positive so positions can still be synced once all supply is lost
+	ratio := sdk.NewDecFromInt(supplied.Sub(loss)).QuoInt(supplied)
+	if ratio.IsZero() {
+		ratio = sdk.SmallestDec()
+	}
+	at := ensureIsolatedAccumulationTime(ctx, state, coin.Denom)
+	at.SupplyInterestFactor = at.SupplyInterestFactor.Mul(ratio)
+	state.SetAccumulationTime(at)
+	state.TotalSupplied = subCoinsFloor(state.TotalSupplied, sdk.NewCoins(sdk.NewCoin(coin.Denom, loss)))
+}
+
+// getIsolatedMarket returns an isolated market from the params along with its state
+func (k Keeper) getIsolatedMarket(ctx sdk.Context, marketName string) (types.IsolatedMarket, types.IsolatedMarketState, error) {
+	market, found := k.GetParams(ctx).IsolatedMarkets.Get(marketName)
+	if !found {
+		return types.IsolatedMarket{}, types.IsolatedMarketState{}, errorsmod.Wrapf(types.ErrIsolatedMarketNotFound, "%s", marketName)
+	}
+	state, found := k.GetIsolatedMarketState(ctx, marketName)
+	if !found {
+		state = types.NewIsolatedMarketState(marketName)
+	}
+	return market, state, nil
+}
+
+// getIsolatedPositionValues returns the USD value that can be borrowed against a position's collateral, and the USD
+// value of its borrow. Only the assets of the isolated market are considered.
+func (k Keeper) getIsolatedPositionValues(ctx sdk.Context, market types.IsolatedMarket, position types.IsolatedPosition) (sdk.Dec, sdk.Dec, error) {
+	borrowable := sdk.ZeroDec()
+	for _, coin := range position.Deposit {
+		if !market.IsCollateral(coin.Denom) {
+			continue
+		}
+		mm, _ := market.GetMoneyMarket(coin.Denom)
+		value, err := k.getIsolatedCoinValue(ctx, mm, coin)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		borrowable = borrowable.Add(mm.SupplyLimit.CollateralValue(value).Mul(mm.BorrowLimit.LoanToValue))
+	}
+
+	borrowed := sdk.ZeroDec()
+	for _, coin := range position.Borrow {
+		mm, found := market.GetMoneyMarket(coin.Denom)
+		if !found {
+			return sdk.Dec{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s in %s", coin.Denom, market.Name)
+		}
+		value, err := k.getIsolatedCoinValue(ctx, mm, coin)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		borrowed = borrowed.Add(value)
+	}
+	return borrowable, borrowed, nil
+}
+
+// getIsolatedCoinValue returns the USD value of a coin at the current price of its money market
+func (k Keeper) getIsolatedCoinValue(ctx sdk.Context, mm types.MoneyMarket, coin sdk.Coin) (sdk.Dec, error) {
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", mm.SpotMarketID)
+	}
+	return sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(mm.ConversionFactor)).Mul(price.Price), nil
+}
+
+// ApplyIsolatedInterestUpdates accrues interest in each isolated market
+func (k Keeper) ApplyIsolatedInterestUpdates(ctx sdk.Context) {
+	for _, market := range k.GetParams(ctx).IsolatedMarkets {
+		if err := k.AccrueIsolatedInterest(ctx, market); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// AccrueIsolatedInterest applies accrued interest to the borrows and reserves of each asset in an isolated market,
+// using the market's own interest rate models and utilization.
+func (k Keeper) AccrueIsolatedInterest(ctx sdk.Context, market types.IsolatedMarket) error {
+	state, found := k.GetIsolatedMarketState(ctx, market.Name)
+	if !found {
+		state = types.NewIsolatedMarketState(market.Name)
+	}
+	for _, mm := range market.MoneyMarkets {
+		if err := accrueIsolatedInterest(ctx, &state, mm); err != nil {
+			return err
+		}
+	}
+	k.SetIsolatedMarketState(ctx, state)
+	return nil
+}
+
+func accrueIsolatedInterest(ctx sdk.Context, state *types.IsolatedMarketState, mm types.MoneyMarket) error {
+	at, found := state.GetAccumulationTime(mm.Denom)
+	if !found {
+		ensureIsolatedAccumulationTime(ctx, state, mm.Denom)
+		return nil
+	}
+
+	timeElapsed := int64(math.RoundToEven(
+		ctx.BlockTime().Sub(at.PreviousAccrualTime).Seconds(),
+	))
+	if timeElapsed == 0 {
+		return nil
+	}
+
+	// Interest only accrues on the borrows of open positions, not on debt waiting for auction proceeds
+	borrowedPrior := state.TotalBorrowed.AmountOf(mm.Denom)
+	owedPrior := borrowedPrior.Sub(state.AuctionDebt.AmountOf(mm.Denom))
+	if !owedPrior.IsPositive() {
+		at.PreviousAccrualTime = ctx.BlockTime()
+		state.SetAccumulationTime(at)
+		return nil
+	}
+	cashPrior := state.Cash(mm.Denom)
+	reservesPrior := state.TotalReserves.AmountOf(mm.Denom)
+
+	rateAtTarget := boundRateAtTarget(mm.InterestRateModel, at.RateAtTarget, true)
+	borrowRateApy, err := calculateBorrowRate(mm.InterestRateModel, rateAtTarget, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior), sdk.NewDecFromInt(reservesPrior))
+	if err != nil {
+		return err
+	}
+	borrowRateSpy, err := APYToSPY(sdk.OneDec().Add(borrowRateApy))
+	if err != nil {
+		return err
+	}
+
+	borrowInterestFactor := CalculateBorrowInterestFactor(borrowRateSpy, sdkmath.NewInt(timeElapsed))
+	interestBorrowAccumulated := borrowInterestFactor.Mul(sdk.NewDecFromInt(owedPrior)).TruncateInt().Sub(owedPrior)
+	if interestBorrowAccumulated.IsZero() && borrowRateApy.IsPositive() {
+		// don't accumulate if borrow interest is rounding to zero
+		return nil
+	}
+
+	reservesNew := sdk.NewDecFromInt(interestBorrowAccumulated).Mul(mm.ReserveFactor).TruncateInt()
+	supplyInterestNew := interestBorrowAccumulated.Sub(reservesNew)
+	supplyInterestFactor := CalculateSupplyInterestFactor(sdk.NewDecFromInt(supplyInterestNew), sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior), sdk.NewDecFromInt(reservesPrior))
+
+	at.BorrowInterestFactor = at.BorrowInterestFactor.Mul(borrowInterestFactor)
+	at.SupplyInterestFactor = at.SupplyInterestFactor.Mul(supplyInterestFactor)
+	at.PreviousAccrualTime = ctx.BlockTime()
+	if mm.InterestRateModel.IsAdaptive() {
+		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior), sdk.NewDecFromInt(reservesPrior))
+		at.RateAtTarget = CalculateRateAtTarget(mm.InterestRateModel, rateAtTarget, utilRatio, sdkmath.NewInt(timeElapsed))
+	}
+	state.SetAccumulationTime(at)
+
+	state.TotalBorrowed = state.TotalBorrowed.Add(sdk.NewCoins(sdk.NewCoin(mm.Denom, interestBorrowAccumulated))...)
+	state.TotalSupplied = state.TotalSupplied.Add(sdk.NewCoins(sdk.NewCoin(mm.Denom, supplyInterestNew))...)
+	state.TotalReserves = state.TotalReserves.Add(sdk.NewCoins(sdk.NewCoin(mm.Denom, reservesNew))...)
+	return nil
+}
+
+// ensureIsolatedAccumulationTime returns the accumulation time of a denom in an isolated market, starting it from the
+// current block if the denom has none
+func ensureIsolatedAccumulationTime(ctx sdk.Context, state *types.IsolatedMarketState, denom string) types.IsolatedAccumulationTime {
+	at, found := state.GetAccumulationTime(denom)
+	if !found {
+		at = types.NewIsolatedAccumulationTime(denom, ctx.BlockTime(), sdk.OneDec(), sdk.OneDec())
+		state.SetAccumulationTime(at)
+	}
+	return at
+}
+
+// GetSyncedIsolatedPosition returns an isolated position including the interest accrued since it was last updated
+func (k Keeper) GetSyncedIsolatedPosition(ctx sdk.Context, marketName string, owner sdk.AccAddress) (types.IsolatedPosition, bool) {
+	position, found := k.GetIsolatedPosition(ctx, marketName, owner)
+	if !found {
+		return types.IsolatedPosition{}, false
+	}
+	state, found := k.GetIsolatedMarketState(ctx, marketName)
+	if !found {
+		return position, true
+	}
+	return loadSyncedIsolatedPosition(state, position), true
+}
+
+// getSyncedIsolatedPosition returns an account's synced position in an isolated market, or a new empty position
+func (k Keeper) getSyncedIsolatedPosition(ctx sdk.Context, state types.IsolatedMarketState, marketName string, owner sdk.AccAddress) types.IsolatedPosition {
+	position, found := k.GetIsolatedPosition(ctx, marketName, owner)
+	if !found {
+		return types.NewIsolatedPosition(marketName, owner, sdk.Coins{}, types.SupplyInterestFactors{}, sdk.Coins{}, types.BorrowInterestFactors{})
+	}
+	return loadSyncedIsolatedPosition(state, position)
+}
+
+// loadSyncedIsolatedPosition adds the interest accrued since a position was last updated to its deposit and borrow,
+// and updates its interest factors to the market's current factors. Deposits shrink if debt has been written off
+// against the market's suppliers.
+func loadSyncedIsolatedPosition(state types.IsolatedMarketState, position types.IsolatedPosition) types.IsolatedPosition {
+	deposit := sdk.Coins{}
+	depositIndex := types.SupplyInterestFactors{}
+	for _, coin := range position.Deposit {
+		at, found := state.GetAccumulationTime(coin.Denom)
+		if !found {
+			if userFactor, found := position.DepositIndex.GetInterestFactor(coin.Denom); found {
+				depositIndex = append(depositIndex, types.NewSupplyInterestFactor(coin.Denom, userFactor))
+			}
+			deposit = deposit.Add(coin)
+			continue
+		}
+		syncedCoin := coin
+		if userFactor, found := position.DepositIndex.GetInterestFactor(coin.Denom); found {
+			syncedCoin.Amount = sdk.NewDecFromInt(coin.Amount).Mul(at.SupplyInterestFactor).Quo(userFactor).TruncateInt()
+		}
+		deposit = deposit.Add(syncedCoin)
+		depositIndex = append(depositIndex, types.NewSupplyInterestFactor(coin.Denom, at.SupplyInterestFactor))
+	}
+
+	borrowInterest := sdk.Coins{}
+	borrowIndex := types.BorrowInterestFactors{}
+	for _, coin := range position.Borrow {
+		at, found := state.GetAccumulationTime(coin.Denom)
+		if !found {
+			if userFactor, found := position.BorrowIndex.GetInterestFactor(coin.Denom); found {
+				borrowIndex = append(borrowIndex, types.NewBorrowInterestFactor(coin.Denom, userFactor))
+			}
+			continue
+		}
+		if userFactor, found := position.BorrowIndex.GetInterestFactor(coin.Denom); found {
+			storedAmount := sdk.NewDecFromInt(coin.Amount)
+			interest := storedAmount.Quo(userFactor).Mul(at.BorrowInterestFactor).Sub(storedAmount)
+			if interest.TruncateInt().IsPositive() {
+				borrowInterest = borrowInterest.Add(sdk.NewCoin(coin.Denom, interest.TruncateInt()))
+			}
+		}
+		borrowIndex = append(borrowIndex, types.NewBorrowInterestFactor(coin.Denom, at.BorrowInterestFactor))
+	}
+
+	return types.NewIsolatedPosition(
+		position.Market, position.Owner,
+		deposit, depositIndex,
+		position.Borrow.Add(borrowInterest...), borrowIndex,
+	)
+}
+
+// subCoinsFloor subtracts coins from a total, without taking any denom below zero
+func subCoinsFloor(total, coins sdk.Coins) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, coin := range total {
+		amount := coin.Amount.Sub(coins.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			result = result.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return result
+}
+
+// GetIsolatedPosition returns an account's position in an isolated market
+func (k Keeper) GetIsolatedPosition(ctx sdk.Context, marketName string, owner sdk.AccAddress) (types.IsolatedPosition, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedPositionsPrefix)
+	bz := store.Get(types.IsolatedPositionKey(marketName, owner))
+	if len(bz) == 0 {
+		return types.IsolatedPosition{}, false
+	}
+	var position types.IsolatedPosition
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, true
+}
+
+// SetIsolatedPosition sets a position in the store, deleting it once nothing is deposited or borrowed
+func (k Keeper) SetIsolatedPosition(ctx sdk.Context, position types.IsolatedPosition) {
+	if position.IsEmpty() {
+		k.DeleteIsolatedPosition(ctx, position)
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedPositionsPrefix)
+	bz := k.cdc.MustMarshal(&position)
+	store.Set(types.IsolatedPositionKey(position.Market, position.Owner), bz)
+}
+
+// DeleteIsolatedPosition deletes a position from the store
+func (k Keeper) DeleteIsolatedPosition(ctx sdk.Context, position types.IsolatedPosition) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedPositionsPrefix)
+	store.Delete(types.IsolatedPositionKey(position.Market, position.Owner))
+}
+
+// IterateIsolatedPositions iterates over the positions in an isolated market, or all isolated markets if the market
+// name is empty, and performs a callback function
+func (k Keeper) IterateIsolatedPositions(ctx sdk.Context, marketName string, cb func(position types.IsolatedPosition) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedPositionsPrefix)
+	var iteratorKey []byte
+	if marketName != "" {
+		iteratorKey = types.IsolatedPositionIteratorKey(marketName)
+	}
+	iterator := sdk.KVStorePrefixIterator(store, iteratorKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var position types.IsolatedPosition
+		k.cdc.MustUnmarshal(iterator.Value(), &position)
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// GetIsolatedMarketState returns the state of an isolated market
+func (k Keeper) GetIsolatedMarketState(ctx sdk.Context, marketName string) (types.IsolatedMarketState, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedMarketStatesPrefix)
+	bz := store.Get([]byte(marketName))
+	if len(bz) == 0 {
+		return types.IsolatedMarketState{}, false
+	}
+	var state types.IsolatedMarketState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// SetIsolatedMarketState sets the state of an isolated market in the store
+func (k Keeper) SetIsolatedMarketState(ctx sdk.Context, state types.IsolatedMarketState) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedMarketStatesPrefix)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set([]byte(state.Market), bz)
+}
+
+// IterateIsolatedMarketStates iterates over the states of all isolated markets and performs a callback function
+func (k Keeper) IterateIsolatedMarketStates(ctx sdk.Context, cb func(state types.IsolatedMarketState) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedMarketStatesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.IsolatedMarketState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetIsolatedAuction returns an auction started by an isolated market liquidation
+func (k Keeper) GetIsolatedAuction(ctx sdk.Context, auctionID uint64) (types.IsolatedAuction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedAuctionsPrefix)
+	bz := store.Get(types.IsolatedAuctionKey(auctionID))
+	if len(bz) == 0 {
+		return types.IsolatedAuction{}, false
+	}
+	var auction types.IsolatedAuction
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+// SetIsolatedAuction sets an isolated auction in the store
+func (k Keeper) SetIsolatedAuction(ctx sdk.Context, auction types.IsolatedAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedAuctionsPrefix)
+	bz := k.cdc.MustMarshal(&auction)
+	store.Set(types.IsolatedAuctionKey(auction.AuctionID), bz)
+}
+
+// DeleteIsolatedAuction deletes an isolated auction from the store
+func (k Keeper) DeleteIsolatedAuction(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedAuctionsPrefix)
+	store.Delete(types.IsolatedAuctionKey(auctionID))
+}
+
+// IterateIsolatedAuctions iterates over all isolated auctions and performs a callback function
+func (k Keeper) IterateIsolatedAuctions(ctx sdk.Context, cb func(auction types.IsolatedAuction) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedAuctionsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.IsolatedAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		if cb(auction) {
+			break
+		}
+	}
+}
@@ -0,0 +1,385 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/jinx"
+	"github.com/percosis-labs/fury/x/jinx/types"
+	pricefeedtypes "github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+const isolatedMarketName = "bnb-usdf"
+
+// setupIsolatedMarket initializes an app with a bnb-usdf isolated market, where bnb is collateral and usdf can be
+// borrowed, and a shared money market for each asset. The returned lender has deposited usdf in the isolated market.
+func (suite *KeeperTestSuite) setupIsolatedMarket() (lender, borrower, liquidator sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	lender, borrower, liquidator = addrs[0], addrs[1], addrs[2]
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		sdk.NewCoins(
+			sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF)),
+			sdk.NewCoin("usdf", sdkmath.NewInt(1000*USDF_CF)),
+		),
+		addrs,
+	)
+
+	irm := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	moneyMarkets := types.MoneyMarkets{
+		types.NewMoneyMarket("usdf", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdf:usd", sdkmath.NewInt(USDF_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd", sdkmath.NewInt(BNB_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+	}
+	isolatedMarkets := types.IsolatedMarkets{
+		types.NewIsolatedMarket(isolatedMarketName, moneyMarkets, []string{"bnb"}, []string{"usdf"}),
+	}
+	jinxGS := types.NewGenesisState(
+		types.NewParams(moneyMarkets, sdk.NewDec(10), isolatedMarkets, types.DefaultEModeCategories),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdf:usd", BaseAsset: "usdf", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdf:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * 24 * time.Hour)},
+			{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: time.Now().Add(100 * 24 * time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&jinxGS)},
+	)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetJinxKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.IsolatedDeposit(suite.ctx, lender, isolatedMarketName, cs(c("usdf", 100*USDF_CF)))
+	suite.Require().NoError(err)
+	return lender, borrower, liquidator
+}
+
+func (suite *KeeperTestSuite) TestIsolatedBorrow() {
+	type args struct {
+		depositCoins sdk.Coins
+		borrowCoins  sdk.Coins
+		market       string
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectedErr error
+	}{
+		{
+			"valid",
+			args{cs(c("bnb", 10*BNB_CF)), cs(c("usdf", 50*USDF_CF)), isolatedMarketName},
+			nil,
+		},
+		{
+			"invalid: exceeds collateral loan-to-value",
+			args{cs(c("bnb", 10*BNB_CF)), cs(c("usdf", 51*USDF_CF)), isolatedMarketName},
+			types.ErrInsufficientLoanToValue,
+		},
+		{
+			"invalid: denom is not borrowable",
+			args{cs(c("bnb", 10*BNB_CF)), cs(c("bnb", 1*BNB_CF)), isolatedMarketName},
+			types.ErrInvalidBorrowDenom,
+		},
+		{
+			"invalid: exceeds isolated market supply",
+			args{cs(c("bnb", 100*BNB_CF)), cs(c("usdf", 101*USDF_CF)), isolatedMarketName},
+			types.ErrExceedsProtocolBorrowableBalance,
+		},
+		{
+			"invalid: below minimum borrow value",
+			args{cs(c("bnb", 10*BNB_CF)), cs(c("usdf", 5*USDF_CF)), isolatedMarketName},
+			types.ErrBelowMinimumBorrowValue,
+		},
+		{
+			"invalid: market not found",
+			args{cs(c("bnb", 10*BNB_CF)), cs(c("usdf", 10*USDF_CF)), "other"},
+			types.ErrIsolatedMarketNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, borrower, _ := suite.setupIsolatedMarket()
+
+			err := suite.keeper.IsolatedDeposit(suite.ctx, borrower, isolatedMarketName, tc.args.depositCoins)
+			suite.Require().NoError(err)
+
+			err = suite.keeper.IsolatedBorrow(suite.ctx, borrower, tc.args.market, tc.args.borrowCoins)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			position, found := suite.keeper.GetIsolatedPosition(suite.ctx, isolatedMarketName, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.depositCoins, position.Deposit)
+			suite.Require().Equal(tc.args.borrowCoins, position.Borrow)
+
+			state, found := suite.keeper.GetIsolatedMarketState(suite.ctx, isolatedMarketName)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.borrowCoins, state.TotalBorrowed)
+
+			// coins are held by the isolated module account, not the shared jinx account
+			isolatedAcc := suite.getModuleAccount(types.IsolatedModuleAccountName)
+			suite.Require().Equal(
+				cs(c("usdf", 100*USDF_CF)).Add(tc.args.depositCoins...).Sub(tc.args.borrowCoins...),
+				suite.getAccountCoins(isolatedAcc),
+			)
+			suite.Require().True(suite.getAccountCoins(suite.getModuleAccount(types.ModuleAccountName)).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIsolatedPositionsAreSeparate() {
+	_, borrower, _ := suite.setupIsolatedMarket()
+
+	// collateral in the shared money markets cannot be borrowed against in an isolated market
+	err := suite.keeper.Deposit(suite.ctx, borrower, cs(c("bnb", 10*BNB_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedBorrow(suite.ctx, borrower, isolatedMarketName, cs(c("usdf", 20*USDF_CF)))
+	suite.Require().ErrorIs(err, types.ErrDepositsNotFound)
+
+	// and isolated collateral cannot be borrowed against in the shared money markets
+	err = suite.keeper.IsolatedDeposit(suite.ctx, borrower, isolatedMarketName, cs(c("bnb", 10*BNB_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, cs(c("bnb", 6*BNB_CF)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("bnb", 10*BNB_CF)), deposit.Amount)
+
+	err = suite.keeper.IsolatedWithdraw(suite.ctx, borrower, isolatedMarketName, cs(c("bnb", 10*BNB_CF)))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetIsolatedPosition(suite.ctx, isolatedMarketName, borrower)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestIsolatedInterest() {
+	lender, borrower, _ := suite.setupIsolatedMarket()
+
+	err := suite.keeper.IsolatedDeposit(suite.ctx, borrower, isolatedMarketName, cs(c("bnb", 20*BNB_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedBorrow(suite.ctx, borrower, isolatedMarketName, cs(c("usdf", 50*USDF_CF)))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+	state, found := suite.keeper.GetIsolatedMarketState(suite.ctx, isolatedMarketName)
+	suite.Require().True(found)
+	interest := state.TotalBorrowed.AmountOf("usdf").Sub(sdkmath.NewInt(50 * USDF_CF))
+	suite.Require().True(interest.IsPositive())
+	suite.Require().True(state.TotalReserves.AmountOf("usdf").IsPositive())
+	suite.Require().Equal(
+		interest,
+		state.TotalReserves.AmountOf("usdf").Add(state.TotalSupplied.AmountOf("usdf")).Sub(sdkmath.NewInt(100*USDF_CF)),
+	)
+
+	borrow, found := suite.keeper.GetSyncedIsolatedPosition(suite.ctx, isolatedMarketName, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(state.TotalBorrowed.AmountOf("usdf"), borrow.Borrow.AmountOf("usdf"))
+
+	deposit, found := suite.keeper.GetSyncedIsolatedPosition(suite.ctx, isolatedMarketName, lender)
+	suite.Require().True(found)
+	suite.Require().True(deposit.Deposit.AmountOf("usdf").GT(sdkmath.NewInt(100 * USDF_CF)))
+
+	// the shared money markets are unaffected
+	_, found = suite.keeper.GetBorrowedCoins(suite.ctx)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestIsolatedLiquidation() {
+	_, borrower, liquidator := suite.setupIsolatedMarket()
+
+	err := suite.keeper.IsolatedDeposit(suite.ctx, borrower, isolatedMarketName, cs(c("bnb", 10*BNB_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedBorrow(suite.ctx, borrower, isolatedMarketName, cs(c("usdf", 45*USDF_CF)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.AttemptIsolatedKeeperLiquidation(suite.ctx, liquidator, borrower, isolatedMarketName)
+	suite.Require().ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("5.00"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	err = suite.keeper.AttemptIsolatedKeeperLiquidation(suite.ctx, liquidator, borrower, isolatedMarketName)
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetIsolatedPosition(suite.ctx, isolatedMarketName, borrower)
+	suite.Require().False(found)
+
+	// the liquidator is paid from the isolated market collateral
+	liquidatorAcc := suite.getAccount(liquidator)
+	suite.Require().Equal(sdkmath.NewInt(100*BNB_CF+BNB_CF/2), suite.getAccountCoins(liquidatorAcc).AmountOf("bnb"))
+
+	auctions := suite.auctionKeeper.GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 1)
+	suite.Require().Equal(types.IsolatedModuleAccountName, auctions[0].GetInitiator())
+
+	// any collateral not needed to cover the borrow is returned to the borrower
+	borrowerBnb := suite.getAccountCoins(suite.getAccount(borrower)).AmountOf("bnb")
+	suite.Require().Equal(sdkmath.NewInt(100*BNB_CF-BNB_CF/2), borrowerBnb.Add(auctions[0].GetLot().Amount))
+	suite.Require().True(suite.getAccountCoins(suite.getModuleAccount(types.IsolatedModuleAccountName)).AmountOf("bnb").IsZero())
+
+	// the borrow stays in the market until the auction closes
+	state, found := suite.keeper.GetIsolatedMarketState(suite.ctx, isolatedMarketName)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdf", 45*USDF_CF)), state.TotalBorrowed)
+	suite.Require().Equal(cs(c("usdf", 45*USDF_CF)), state.AuctionDebt)
+	suite.Require().Equal(cs(c("usdf", 100*USDF_CF)), state.TotalSupplied)
+
+	isolatedAuction, found := suite.keeper.GetIsolatedAuction(suite.ctx, auctions[0].GetID())
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewIsolatedAuction(auctions[0].GetID(), isolatedMarketName, c("usdf", 45*USDF_CF)), isolatedAuction)
+}
+
+func (suite *KeeperTestSuite) TestIsolatedLiquidationLossStaysInMarket() {
+	lender, borrower, liquidator := suite.setupIsolatedMarket()
+
+	// a second market lending the same denom shares the isolated module account with the first
+	const otherMarketName = "bnb-usdf-2"
+	params := suite.keeper.GetParams(suite.ctx)
+	params.IsolatedMarkets = append(params.IsolatedMarkets,
+		types.NewIsolatedMarket(otherMarketName, params.IsolatedMarkets[0].MoneyMarkets, []string{"bnb"}, []string{"usdf"}),
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+	err := suite.keeper.IsolatedDeposit(suite.ctx, lender, otherMarketName, cs(c("usdf", 100*USDF_CF)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.IsolatedDeposit(suite.ctx, borrower, isolatedMarketName, cs(c("bnb", 10*BNB_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedBorrow(suite.ctx, borrower, isolatedMarketName, cs(c("usdf", 45*USDF_CF)))
+	suite.Require().NoError(err)
+
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("5.00"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	err = suite.keeper.AttemptIsolatedKeeperLiquidation(suite.ctx, liquidator, borrower, isolatedMarketName)
+	suite.Require().NoError(err)
+
+	// the auction only raises 10 usdf of the 45 usdf owed
+	auctions := suite.auctionKeeper.GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 1)
+	err = suite.auctionKeeper.PlaceBid(suite.ctx, auctions[0].GetID(), liquidator, c("usdf", 10*USDF_CF))
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(auctions[0].GetEndTime())
+	err = suite.auctionKeeper.CloseAuction(suite.ctx, auctions[0].GetID())
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetIsolatedAuction(suite.ctx, auctions[0].GetID())
+	suite.Require().False(found)
+
+	// the 35 usdf shortfall is written off against the liquidated market's suppliers
+	state, found := suite.keeper.GetIsolatedMarketState(suite.ctx, isolatedMarketName)
+	suite.Require().True(found)
+	suite.Require().True(state.TotalBorrowed.IsZero())
+	suite.Require().True(state.AuctionDebt.IsZero())
+	suite.Require().Equal(cs(c("usdf", 65*USDF_CF)), state.TotalSupplied)
+	position, found := suite.keeper.GetSyncedIsolatedPosition(suite.ctx, isolatedMarketName, lender)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdf", 65*USDF_CF)), position.Deposit)
+
+	// the other market is untouched and its suppliers can withdraw in full
+	otherState, found := suite.keeper.GetIsolatedMarketState(suite.ctx, otherMarketName)
+	suite.Require().True(found)
+	suite.Require().True(otherState.TotalBorrowed.IsZero())
+	suite.Require().Equal(cs(c("usdf", 100*USDF_CF)), otherState.TotalSupplied)
+	err = suite.keeper.IsolatedWithdraw(suite.ctx, lender, otherMarketName, cs(c("usdf", 100*USDF_CF)))
+	suite.Require().NoError(err)
+
+	// which leaves exactly the liquidated market's remaining supply in the module account
+	suite.Require().Equal(
+		sdkmath.NewInt(65*USDF_CF),
+		suite.getAccountCoins(suite.getModuleAccount(types.IsolatedModuleAccountName)).AmountOf("usdf"),
+	)
+	err = suite.keeper.IsolatedWithdraw(suite.ctx, lender, isolatedMarketName, cs(c("usdf", 65*USDF_CF)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestIsolatedLiquidationPaysFromMarketCash() {
+	lender, borrower, liquidator := suite.setupIsolatedMarket()
+
+	// a second market lending the same denom shares the isolated module account with the first
+	const otherMarketName = "bnb-usdf-2"
+	params := suite.keeper.GetParams(suite.ctx)
+	params.IsolatedMarkets = append(params.IsolatedMarkets,
+		types.NewIsolatedMarket(otherMarketName, params.IsolatedMarkets[0].MoneyMarkets, []string{"bnb"}, []string{"usdf"}),
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+	err := suite.keeper.IsolatedDeposit(suite.ctx, lender, otherMarketName, cs(c("usdf", 100*USDF_CF)))
+	suite.Require().NoError(err)
+
+	// the borrower also supplies usdf, and the market lends out all but 5 usdf of its 120 usdf supply
+	err = suite.keeper.IsolatedDeposit(suite.ctx, borrower, isolatedMarketName, cs(c("bnb", 10*BNB_CF), c("usdf", 20*USDF_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedBorrow(suite.ctx, borrower, isolatedMarketName, cs(c("usdf", 45*USDF_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedDeposit(suite.ctx, lender, isolatedMarketName, cs(c("bnb", 20*BNB_CF)))
+	suite.Require().NoError(err)
+	err = suite.keeper.IsolatedBorrow(suite.ctx, lender, isolatedMarketName, cs(c("usdf", 70*USDF_CF)))
+	suite.Require().NoError(err)
+
+	otherState, found := suite.keeper.GetIsolatedMarketState(suite.ctx, otherMarketName)
+	suite.Require().True(found)
+	isolatedAcc := suite.getModuleAccount(types.IsolatedModuleAccountName)
+	suite.Require().Equal(sdkmath.NewInt(105*USDF_CF), suite.getAccountCoins(isolatedAcc).AmountOf("usdf"))
+
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("5.00"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	err = suite.keeper.AttemptIsolatedKeeperLiquidation(suite.ctx, liquidator, borrower, isolatedMarketName)
+	suite.Require().NoError(err)
+
+	// only the market's 5 usdf of cash is returned, the rest of the usdf deposit stays in the position
+	suite.Require().Equal(
+		sdkmath.NewInt(1000*USDF_CF-20*USDF_CF+45*USDF_CF+5*USDF_CF),
+		suite.getAccountCoins(suite.getAccount(borrower)).AmountOf("usdf"),
+	)
+	position, found := suite.keeper.GetIsolatedPosition(suite.ctx, isolatedMarketName, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdf", 15*USDF_CF)), position.Deposit)
+	suite.Require().True(position.Borrow.Empty())
+
+	state, found := suite.keeper.GetIsolatedMarketState(suite.ctx, isolatedMarketName)
+	suite.Require().True(found)
+	suite.Require().True(state.Cash("usdf").IsZero())
+
+	// the other market's balance in the module account is unchanged
+	otherStateAfter, found := suite.keeper.GetIsolatedMarketState(suite.ctx, otherMarketName)
+	suite.Require().True(found)
+	suite.Require().Equal(otherState, otherStateAfter)
+	suite.Require().Equal(sdkmath.NewInt(100*USDF_CF), suite.getAccountCoins(isolatedAcc).AmountOf("usdf"))
+	err = suite.keeper.IsolatedWithdraw(suite.ctx, lender, otherMarketName, cs(c("usdf", 100*USDF_CF)))
+	suite.Require().NoError(err)
+}
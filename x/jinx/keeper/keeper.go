@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -21,13 +22,14 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	msgRouter       *baseapp.MsgServiceRouter
 	hooks           types.JINXHooks
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -41,6 +43,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		bankKeeper:      bk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		msgRouter:       msgRouter,
 		hooks:           nil,
 	}
 }
@@ -315,6 +318,25 @@ func (k Keeper) SetBorrowInterestFactor(ctx sdk.Context, denom string, borrowInt
 	store.Set([]byte(denom), bz)
 }
 
+// GetRateAtTarget returns the current rate at target of a money market using an adaptive interest rate model
+func (k Keeper) GetRateAtTarget(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateAtTargetPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var rateAtTarget sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &rateAtTarget)
+	return rateAtTarget.Dec, true
+}
+
+// SetRateAtTarget sets the current rate at target of a money market using an adaptive interest rate model
+func (k Keeper) SetRateAtTarget(ctx sdk.Context, denom string, rateAtTarget sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateAtTargetPrefix)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: rateAtTarget})
+	store.Set([]byte(denom), bz)
+}
+
 // IterateBorrowInterestFactors iterates over all borrow interest factors in the store and returns
 // both the borrow interest factor and the key (denom) it's stored under
 func (k Keeper) IterateBorrowInterestFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool)) {
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
 	"github.com/percosis-labs/fury/x/jinx/types"
 )
 
@@ -15,6 +16,8 @@ type LiqData struct {
 	price            sdk.Dec
 	ltv              sdk.Dec
 	conversionFactor sdkmath.Int
+	auctionType      string
+	supplyLimit      types.SupplyLimit
 }
 
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
@@ -72,6 +75,145 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 	return nil
 }
 
+// PartialLiquidate enables a keeper to repay part of the borrow of a position that is outside its valid LTV range,
+// receiving the equivalent value of one of the position's deposits plus the deposit's liquidation bonus. The repaid
+// amount is capped by the close factor of the borrowed denom's money market, and the rest of the position stays open.
+func (k Keeper) PartialLiquidate(ctx sdk.Context, keeper, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) error {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return types.ErrDepositNotFound
+	}
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return types.ErrBorrowNotFound
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	deposit, found = k.GetDeposit(ctx, borrower)
+	if !found {
+		return types.ErrDepositNotFound
+	}
+	borrow, found = k.GetBorrow(ctx, borrower)
+	if !found {
+		return types.ErrBorrowNotFound
+	}
+
+	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	if isWithinRange {
+		return errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	}
+
+	borrowMM, found := k.GetMoneyMarket(ctx, repay.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", repay.Denom)
+	}
+	if borrowMM.CloseFactor.IsNil() || !borrowMM.CloseFactor.IsPositive() {
+		return errorsmod.Wrapf(types.ErrPartialLiquidationDisabled, "%s has no close factor", repay.Denom)
+	}
+	if !borrow.Amount.AmountOf(repay.Denom).IsPositive() {
+		return errorsmod.Wrapf(types.ErrBorrowNotFound, "no %s borrow found for %s", repay.Denom, borrower)
+	}
+	collateralMM, found := k.GetMoneyMarket(ctx, collateralDenom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", collateralDenom)
+	}
+	if !deposit.Amount.AmountOf(collateralDenom).IsPositive() {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "no %s deposit found for %s", collateralDenom, borrower)
+	}
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	bonus := sdk.OneDec()
+	if !collateralMM.LiquidationBonus.IsNil() {
+		bonus = bonus.Add(collateralMM.LiquidationBonus)
+	}
+
+	// Cap the repayment by the close factor, then find the deposit it buys at the bonus
+	maxRepay := borrowMM.CloseFactor.MulInt(borrow.Amount.AmountOf(repay.Denom)).TruncateInt()
+	if repay.Amount.GT(maxRepay) {
+		repay = sdk.NewCoin(repay.Denom, maxRepay)
+	}
+	bData, dData := liqMap[repay.Denom], liqMap[collateralDenom]
+	repayValue := sdk.NewDecFromInt(repay.Amount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.price)
+	seizeAmount := repayValue.Mul(bonus).MulInt(dData.conversionFactor).Quo(dData.price).TruncateInt()
+
+	// If the deposit is too small, seize all of it and reduce the repayment to match
+	if seizeAmount.GT(deposit.Amount.AmountOf(collateralDenom)) {
+		seizeAmount = deposit.Amount.AmountOf(collateralDenom)
+		seizeValue := sdk.NewDecFromInt(seizeAmount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price)
+		repay = sdk.NewCoin(repay.Denom, seizeValue.Quo(bonus).MulInt(bData.conversionFactor).Quo(bData.price).TruncateInt())
+	}
+	if repay.IsZero() || seizeAmount.IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientCoins, "repay amount %s is too small to liquidate", repay)
+	}
+	seize := sdk.NewCoin(collateralDenom, seizeAmount)
+
+	keeperCoins := k.bankKeeper.SpendableCoins(ctx, keeper)
+	if keeperCoins.AmountOf(repay.Denom).LT(repay.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientBalanceForRepay, "account can only repay up to %s%s", keeperCoins.AmountOf(repay.Denom), repay.Denom)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, keeper, types.ModuleAccountName, sdk.NewCoins(repay)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, keeper, sdk.NewCoins(seize)); err != nil {
+		return err
+	}
+
+	if err := k.DecrementBorrowedCoins(ctx, sdk.NewCoins(repay)); err != nil {
+		return err
+	}
+	if err := k.DecrementSuppliedCoins(ctx, sdk.NewCoins(seize)); err != nil {
+		return err
+	}
+
+	// Emptied records are removed as in a full liquidation, the hooks see each record as it was stored
+	borrow.Amount = borrow.Amount.Sub(repay)
+	if !borrow.Amount.AmountOf(repay.Denom).IsPositive() {
+		borrow.Index, _ = borrow.Index.RemoveInterestFactor(repay.Denom)
+	}
+	if borrow.Amount.Empty() {
+		borrow.Amount = sdk.NewCoins()
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+	k.AfterBorrowModified(ctx, borrow)
+
+	deposit.Amount = deposit.Amount.Sub(seize)
+	if !deposit.Amount.AmountOf(collateralDenom).IsPositive() {
+		deposit.Index, _ = deposit.Index.RemoveInterestFactor(collateralDenom)
+	}
+	if deposit.Amount.Empty() {
+		deposit.Amount = sdk.NewCoins()
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	k.AfterDepositModified(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJinxLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, seize.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repay.String()),
+		),
+	)
+	return nil
+}
+
 // SeizeDeposits seizes a list of deposits and sends them to auction
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
 	borrow types.Borrow, dDenoms, bDenoms []string,
@@ -149,6 +291,25 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 // StartAuctions attempts to start auctions for seized assets
 func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows, deposits sdk.Coins,
 	depositCoinValues, borrowCoinValues types.ValuationMap, ltv sdk.Dec, liqMap map[string]LiqData,
+) (sdk.Coins, error) {
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	maccCoins := k.bankKeeper.SpendableCoins(ctx, macc.GetAddress())
+	return k.startAuctions(ctx, types.ModuleAccountName, maccCoins, borrower, borrows, deposits, depositCoinValues, borrowCoinValues, ltv, liqMap,
+		func(_ uint64, lot, bid sdk.Coin) error {
+			// Decrement supplied coins and decrement borrowed coins optimistically
+			if err := k.DecrementSuppliedCoins(ctx, sdk.Coins{lot}); err != nil {
+				return err
+			}
+			return k.DecrementBorrowedCoins(ctx, sdk.Coins{bid})
+		},
+	)
+}
+
+// startAuctions starts auctions for assets seized into a module account, calling onAuction with the id, lot and bid of
+// each auction started. Lots are capped at the available coins the module account can spend on them.
+func (k Keeper) startAuctions(ctx sdk.Context, moduleAccountName string, available sdk.Coins, borrower sdk.AccAddress, borrows, deposits sdk.Coins,
+	depositCoinValues, borrowCoinValues types.ValuationMap, ltv sdk.Dec, liqMap map[string]LiqData,
+	onAuction func(auctionID uint64, lot, bid sdk.Coin) error,
 ) (sdk.Coins, error) {
 	// Sort keys to ensure deterministic behavior
 	bKeys := borrowCoinValues.GetSortedKeys()
@@ -159,9 +320,6 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 	weights := []sdkmath.Int{sdkmath.NewInt(100)}
 	debt := sdk.NewCoin("debt", sdk.ZeroInt())
 
-	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-	maccCoins := k.bankKeeper.SpendableCoins(ctx, macc.GetAddress())
-
 	var liquidatedCoins sdk.Coins
 	for _, bKey := range bKeys {
 		bValue := borrowCoinValues.Get(bKey)
@@ -183,9 +341,9 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				lot := sdk.NewCoin(dKey, lotSize.TruncateInt())
 
 				insufficientLotFunds := false
-				if lot.Amount.GT(maccCoins.AmountOf(dKey)) {
+				if lot.Amount.GT(available.AmountOf(dKey)) {
 					insufficientLotFunds = true
-					lot = sdk.NewCoin(lot.Denom, maccCoins.AmountOf(dKey))
+					lot = sdk.NewCoin(lot.Denom, available.AmountOf(dKey))
 				}
 
 				// Sanity check that we can deliver coins to the liquidator account
@@ -194,16 +352,11 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
-				if err != nil {
-					return liquidatedCoins, err
-				}
-				// Decrement supplied coins and decrement borrowed coins optimistically
-				err = k.DecrementSuppliedCoins(ctx, sdk.Coins{lot})
+				auctionID, err := k.startAuction(ctx, moduleAccountName, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
-				err = k.DecrementBorrowedCoins(ctx, sdk.Coins{bid})
+				err = onAuction(auctionID, lot, bid)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -234,9 +387,9 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				insufficientLotFunds := false
-				if lot.Amount.GT(maccCoins.AmountOf(dKey)) {
+				if lot.Amount.GT(available.AmountOf(dKey)) {
 					insufficientLotFunds = true
-					lot = sdk.NewCoin(lot.Denom, maccCoins.AmountOf(dKey))
+					lot = sdk.NewCoin(lot.Denom, available.AmountOf(dKey))
 				}
 
 				// Sanity check that we can deliver coins to the liquidator account
@@ -245,16 +398,11 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
-				if err != nil {
-					return liquidatedCoins, err
-				}
-				// Decrement supplied coins and decrement borrowed coins optimistically
-				err = k.DecrementSuppliedCoins(ctx, sdk.Coins{lot})
+				auctionID, err := k.startAuction(ctx, moduleAccountName, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
-				err = k.DecrementBorrowedCoins(ctx, sdk.Coins{bid})
+				err = onAuction(auctionID, lot, bid)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -284,7 +432,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 		remaining := deposits.AmountOf(dKey)
 		if remaining.GT(sdk.ZeroInt()) {
 			returnCoin := sdk.NewCoins(sdk.NewCoin(dKey, remaining))
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, returnCoin)
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleAccountName, borrower, returnCoin)
 			if err != nil {
 				return liquidatedCoins, err
 			}
@@ -294,18 +442,58 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 	return liquidatedCoins, nil
 }
 
+// startAuction starts an auction of the lot using the auction type of the lot's money market, returning its id
+func (k Keeper) startAuction(ctx sdk.Context, moduleAccountName string, lot, bid sdk.Coin, returnAddrs []sdk.AccAddress, weights []sdkmath.Int,
+	debt sdk.Coin, liqMap map[string]LiqData,
+) (uint64, error) {
+	lotData := liqMap[lot.Denom]
+	if lotData.auctionType != auctiontypes.DutchAuctionType {
+		return k.auctionKeeper.StartCollateralAuction(ctx, moduleAccountName, lot, bid, returnAddrs, weights, debt)
+	}
+
+	// dutch auctions start from the price of one unit of lot in units of the borrowed asset
+	bidData := liqMap[bid.Denom]
+	marketPrice := lotData.price.MulInt(bidData.conversionFactor).QuoInt(lotData.conversionFactor).Quo(bidData.price)
+	return k.auctionKeeper.StartDutchAuction(ctx, moduleAccountName, lot, bid, marketPrice, returnAddrs, weights, debt)
+}
+
 // IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices
 func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
 	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
 	if err != nil {
 		return false, err
 	}
+	return isWithinLtvRange(liqMap, deposit, borrow), nil
+}
 
+// IsWithinBorrowLimit compares a borrow and deposit to see if the borrow is within the limit for new borrows at current
+// prices. This is the valid LTV range, except in e-mode, where new borrows are limited by the category's loan-to-value
+// rather than its liquidation threshold.
+func (k Keeper) IsWithinBorrowLimit(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return false, err
+	}
+
+	account := deposit.Depositor
+	if account.Empty() {
+		account = borrow.Borrower
+	}
+	if eModeCategory, eModeActive := k.GetActiveEModeCategory(ctx, account, deposit.Amount, borrow.Amount); eModeActive {
+		for denom, lData := range liqMap {
+			lData.ltv = eModeCategory.LoanToValue
+			liqMap[denom] = lData
+		}
+	}
+	return isWithinLtvRange(liqMap, deposit, borrow), nil
+}
+
+func isWithinLtvRange(liqMap map[string]LiqData, deposit types.Deposit, borrow types.Borrow) bool {
 	totalBorrowableUSDAmount := sdk.ZeroDec()
 	for _, depCoin := range deposit.Amount {
 		lData := liqMap[depCoin.Denom]
 		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
-		borrowableUSDAmountForDeposit := usdValue.Mul(lData.ltv)
+		borrowableUSDAmountForDeposit := lData.supplyLimit.CollateralValue(usdValue).Mul(lData.ltv)
 		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(borrowableUSDAmountForDeposit)
 	}
 
@@ -317,11 +505,7 @@ func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, bo
 	}
 
 	// Check if the user's has borrowed more than they're allowed to
-	if totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount) {
-		return false, nil
-	}
-
-	return true, nil
+	return !totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount)
 }
 
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
@@ -385,6 +569,14 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 	depositDenoms := getDenoms(deposit.Amount)
 	denoms := removeDuplicates(borrowDenoms, depositDenoms)
 
+	// The e-mode liquidation threshold replaces each money market's loan-to-value when the account's deposits and
+	// borrows are all in its category
+	account := deposit.Depositor
+	if account.Empty() {
+		account = borrow.Borrower
+	}
+	eModeCategory, eModeActive := k.GetActiveEModeCategory(ctx, account, deposit.Amount, borrow.Amount)
+
 	// Load required liquidation data for every deposit/borrow denom
 	for _, denom := range denoms {
 		mm, found := k.GetMoneyMarket(ctx, denom)
@@ -397,7 +589,11 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		ltv := mm.BorrowLimit.LoanToValue
+		if eModeActive {
+			ltv = eModeCategory.LiquidationThreshold
+		}
+		liqMap[denom] = LiqData{priceData.Price, ltv, mm.ConversionFactor, mm.AuctionType, mm.SupplyLimit}
 	}
 
 	return liqMap, nil
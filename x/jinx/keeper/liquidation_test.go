@@ -603,8 +603,11 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						tc.args.keeperRewardPercent), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				types.DefaultIsolatedMarkets,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
 			)
 
 			// Pricefeed module genesis state
@@ -763,3 +766,178 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPartialLiquidate() {
+	type args struct {
+		closeFactor      sdk.Dec
+		liquidationBonus sdk.Dec
+		bnbPrice         sdk.Dec
+		repay            sdk.Coin
+		collateralDenom  string
+		expectedRepay    sdk.Coin
+		expectedSeize    sdk.Coin
+	}
+	type errArgs struct {
+		expectPass bool
+		err        error
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			"valid: repay capped by close factor",
+			args{
+				closeFactor:      sdk.MustNewDecFromStr("0.5"),
+				liquidationBonus: sdk.MustNewDecFromStr("0.1"),
+				bnbPrice:         sdk.MustNewDecFromStr("8.00"),
+				repay:            c("usdf", 30*USDF_CF),
+				collateralDenom:  "bnb",
+				expectedRepay:    c("usdf", 25*USDF_CF),
+				expectedSeize:    c("bnb", 34375*BNB_CF/10000), // $25 * 1.1 / $8
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"valid: repay reduced to the available deposit",
+			args{
+				closeFactor:      sdk.OneDec(),
+				liquidationBonus: sdk.MustNewDecFromStr("0.25"),
+				bnbPrice:         sdk.MustNewDecFromStr("4.00"),
+				repay:            c("usdf", 50*USDF_CF),
+				collateralDenom:  "bnb",
+				expectedRepay:    c("usdf", 32*USDF_CF), // $40 / 1.25
+				expectedSeize:    c("bnb", 10*BNB_CF),
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"invalid: position within valid LTV range",
+			args{
+				closeFactor:      sdk.MustNewDecFromStr("0.5"),
+				liquidationBonus: sdk.MustNewDecFromStr("0.1"),
+				bnbPrice:         sdk.MustNewDecFromStr("10.00"),
+				repay:            c("usdf", 10*USDF_CF),
+				collateralDenom:  "bnb",
+			},
+			errArgs{expectPass: false, err: types.ErrBorrowNotLiquidatable},
+		},
+		{
+			"invalid: partial liquidation disabled",
+			args{
+				closeFactor:      sdk.ZeroDec(),
+				liquidationBonus: sdk.MustNewDecFromStr("0.1"),
+				bnbPrice:         sdk.MustNewDecFromStr("8.00"),
+				repay:            c("usdf", 10*USDF_CF),
+				collateralDenom:  "bnb",
+			},
+			errArgs{expectPass: false, err: types.ErrPartialLiquidationDisabled},
+		},
+		{
+			"invalid: no deposit of collateral denom",
+			args{
+				closeFactor:      sdk.MustNewDecFromStr("0.5"),
+				liquidationBonus: sdk.MustNewDecFromStr("0.1"),
+				bnbPrice:         sdk.MustNewDecFromStr("8.00"),
+				repay:            c("usdf", 10*USDF_CF),
+				collateralDenom:  "usdf",
+			},
+			errArgs{expectPass: false, err: types.ErrDepositNotFound},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now()})
+			_, addrs := app.GeneratePrivKeyAddressPairs(3)
+			lender, borrower, keeper := addrs[0], addrs[1], addrs[2]
+
+			authGS := app.NewFundedGenStateWithSameCoins(
+				tApp.AppCodec(),
+				cs(c("bnb", 100*BNB_CF), c("usdf", 1000*USDF_CF)),
+				addrs,
+			)
+
+			irm := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+			usdfMM := types.NewMoneyMarket("usdf", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdf:usd", sdkmath.NewInt(USDF_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+			usdfMM.CloseFactor = tc.args.closeFactor
+			bnbMM := types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd", sdkmath.NewInt(BNB_CF), irm, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+			bnbMM.LiquidationBonus = tc.args.liquidationBonus
+			jinxGS := types.NewGenesisState(
+				types.NewParams(types.MoneyMarkets{usdfMM, bnbMM}, sdk.NewDec(10), types.DefaultIsolatedMarkets, types.DefaultEModeCategories),
+				types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultIsolatedMarketStates, types.DefaultIsolatedPositions, types.DefaultAccountEModes, types.DefaultIsolatedAuctions,
+			)
+
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "usdf:usd", BaseAsset: "usdf", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{MarketID: "usdf:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(time.Hour)},
+					{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: time.Now().Add(time.Hour)},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&jinxGS)},
+			)
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetJinxKeeper()
+			jinx.BeginBlocker(suite.ctx, suite.keeper)
+
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, cs(c("usdf", 100*USDF_CF))))
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, cs(c("bnb", 10*BNB_CF))))
+			suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, cs(c("usdf", 50*USDF_CF))))
+
+			pk := tApp.GetPriceFeedKeeper()
+			_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", tc.args.bnbPrice, suite.ctx.BlockTime().Add(time.Hour))
+			suite.Require().NoError(err)
+			suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+			err = suite.keeper.PartialLiquidate(suite.ctx, keeper, borrower, tc.args.repay, tc.args.collateralDenom)
+			if !tc.errArgs.expectPass {
+				suite.Require().ErrorIs(err, tc.errArgs.err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the keeper pays the repayment and receives the seized deposit
+			keeperCoins := suite.getAccountCoins(suite.getAccount(keeper))
+			suite.Require().Equal(
+				cs(c("bnb", 100*BNB_CF), c("usdf", 1000*USDF_CF)).Sub(tc.args.expectedRepay).Add(tc.args.expectedSeize),
+				keeperCoins,
+			)
+
+			// the rest of the position stays open
+			borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(cs(c("usdf", 50*USDF_CF)).Sub(tc.args.expectedRepay), borrow.Amount)
+			expectedDeposit := cs(c("bnb", 10*BNB_CF)).Sub(tc.args.expectedSeize)
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+			suite.Require().Equal(!expectedDeposit.Empty(), found)
+			if found {
+				suite.Require().Equal(expectedDeposit, deposit.Amount)
+			}
+
+			// the borrower's reward indexes follow the remaining position
+			claim, found := tApp.GetIncentiveKeeper().GetJinxLiquidityProviderClaim(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Len(claim.SupplyRewardIndexes, len(expectedDeposit))
+			suite.Require().Len(claim.BorrowRewardIndexes, len(borrow.Amount))
+
+			totalBorrowed, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+			suite.Require().Equal(borrow.Amount, totalBorrowed)
+
+			// no auctions are started
+			suite.Require().Empty(tApp.GetAuctionKeeper().GetAllAuctions(suite.ctx))
+		})
+	}
+}
@@ -28,7 +28,11 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	if msg.Market != "" {
+		err = k.keeper.IsolatedDeposit(ctx, depositor, msg.Market, msg.Amount)
+	} else {
+		err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +55,11 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.Withdraw(ctx, depositor, msg.Amount)
+	if msg.Market != "" {
+		err = k.keeper.IsolatedWithdraw(ctx, depositor, msg.Market, msg.Amount)
+	} else {
+		err = k.keeper.Withdraw(ctx, depositor, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +82,11 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.M
 		return nil, err
 	}
 
-	err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	if msg.Market != "" {
+		err = k.keeper.IsolatedBorrow(ctx, borrower, msg.Market, msg.Amount)
+	} else {
+		err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +114,11 @@ func (k msgServer) Repay(goCtx context.Context, msg *types.MsgRepay) (*types.Msg
 		return nil, err
 	}
 
-	err = k.keeper.Repay(ctx, sender, owner, msg.Amount)
+	if msg.Market != "" {
+		err = k.keeper.IsolatedRepay(ctx, sender, owner, msg.Market, msg.Amount)
+	} else {
+		err = k.keeper.Repay(ctx, sender, owner, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +146,11 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 		return nil, err
 	}
 
-	err = k.keeper.AttemptKeeperLiquidation(ctx, keeper, borrower)
+	if msg.Market != "" {
+		err = k.keeper.AttemptIsolatedKeeperLiquidation(ctx, keeper, borrower, msg.Market)
+	} else {
+		err = k.keeper.AttemptKeeperLiquidation(ctx, keeper, borrower)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -144,3 +164,80 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) PartialLiquidate(goCtx context.Context, msg *types.MsgPartialLiquidate) (*types.MsgPartialLiquidateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PartialLiquidate(ctx, keeper, borrower, msg.Repay, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Keeper),
+		),
+	)
+	return &types.MsgPartialLiquidateResponse{}, nil
+}
+
+func (k msgServer) FlashLoan(goCtx context.Context, msg *types.MsgFlashLoan) (*types.MsgFlashLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.FlashLoan(ctx, borrower, msg.Amount, msgs); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Borrower),
+		),
+	)
+	return &types.MsgFlashLoanResponse{}, nil
+}
+
+func (k msgServer) SetEMode(goCtx context.Context, msg *types.MsgSetEMode) (*types.MsgSetEModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.SetEMode(ctx, account, msg.Category); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Account),
+		),
+	)
+	return &types.MsgSetEModeResponse{}, nil
+}
@@ -429,7 +429,8 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper, lega
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		rateAtTarget := k.getRateAtTarget(ctx, denom, moneyMarket.InterestRateModel)
+		borrowAPY, err := calculateBorrowRate(moneyMarket.InterestRateModel, rateAtTarget, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
@@ -39,7 +39,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	}
 
 	proposedDeposit := types.NewDeposit(deposit.Depositor, deposit.Amount.Sub(amount...), types.SupplyInterestFactors{})
-	valid, err := k.IsWithinValidLtvRange(ctx, proposedDeposit, borrow)
+	valid, err := k.IsWithinBorrowLimit(ctx, proposedDeposit, borrow)
 	if err != nil {
 		return err
 	}
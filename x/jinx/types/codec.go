@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "jinx/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "jinx/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "jinx/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgPartialLiquidate{}, "jinx/MsgPartialLiquidate", nil)
+	cdc.RegisterConcrete(&MsgFlashLoan{}, "jinx/MsgFlashLoan", nil)
+	cdc.RegisterConcrete(&MsgSetEMode{}, "jinx/MsgSetEMode", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +27,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgPartialLiquidate{},
+		&MsgFlashLoan{},
+		&MsgSetEMode{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEModeCategory returns a new EModeCategory
+func NewEModeCategory(name string, denoms []string, loanToValue, liquidationThreshold sdk.Dec) EModeCategory {
+	return EModeCategory{
+		Name:                 name,
+		Denoms:               denoms,
+		LoanToValue:          loanToValue,
+		LiquidationThreshold: liquidationThreshold,
+	}
+}
+
+// Validate EModeCategory param
+func (c EModeCategory) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("e-mode category name cannot be blank")
+	}
+	if len(c.Denoms) == 0 {
+		return fmt.Errorf("e-mode category %s has no denoms", c.Name)
+	}
+	seen := make(map[string]bool, len(c.Denoms))
+	for _, denom := range c.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("e-mode category %s: %w", c.Name, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("e-mode category %s has duplicate denom %s", c.Name, denom)
+		}
+		seen[denom] = true
+	}
+
+	if c.LoanToValue.IsNil() || !c.LoanToValue.IsPositive() {
+		return fmt.Errorf("e-mode category %s loan-to-value must be positive: %s", c.Name, c.LoanToValue)
+	}
+	if c.LiquidationThreshold.IsNil() || c.LiquidationThreshold.LT(c.LoanToValue) {
+		return fmt.Errorf("e-mode category %s liquidation threshold cannot be less than its loan-to-value: %s", c.Name, c.LiquidationThreshold)
+	}
+	if c.LiquidationThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("e-mode category %s liquidation threshold cannot be greater than 1.0: %s", c.Name, c.LiquidationThreshold)
+	}
+	return nil
+}
+
+// Contains returns true if the denom is in the e-mode category
+func (c EModeCategory) Contains(denom string) bool {
+	return containsDenom(c.Denoms, denom)
+}
+
+// ContainsAll returns true if every denom of the coins is in the e-mode category
+func (c EModeCategory) ContainsAll(coins ...sdk.Coins) bool {
+	for _, cs := range coins {
+		for _, coin := range cs {
+			if !c.Contains(coin.Denom) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EModeCategories slice of EModeCategory
+type EModeCategories []EModeCategory
+
+// Validate EModeCategories
+func (cs EModeCategories) Validate() error {
+	names := make(map[string]bool, len(cs))
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if names[c.Name] {
+			return fmt.Errorf("duplicate e-mode category %s", c.Name)
+		}
+		names[c.Name] = true
+	}
+	return nil
+}
+
+// Get returns the e-mode category with a name
+func (cs EModeCategories) Get(name string) (EModeCategory, bool) {
+	for _, c := range cs {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return EModeCategory{}, false
+}
+
+// NewAccountEMode returns a new AccountEMode
+func NewAccountEMode(account sdk.AccAddress, category string) AccountEMode {
+	return AccountEMode{
+		Account:  account,
+		Category: category,
+	}
+}
+
+// Validate performs a stateless validation of the AccountEMode
+func (e AccountEMode) Validate() error {
+	if e.Account.Empty() {
+		return fmt.Errorf("account e-mode account cannot be empty")
+	}
+	if strings.TrimSpace(e.Category) == "" {
+		return fmt.Errorf("account e-mode category cannot be blank for %s", e.Account)
+	}
+	return nil
+}
+
+// AccountEModes slice of AccountEMode
+type AccountEModes []AccountEMode
+
+// Validate validates AccountEModes
+func (es AccountEModes) Validate() error {
+	seen := make(map[string]bool, len(es))
+	for _, e := range es {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if seen[e.Account.String()] {
+			return fmt.Errorf("duplicate account e-mode for %s", e.Account)
+		}
+		seen[e.Account.String()] = true
+	}
+	return nil
+}
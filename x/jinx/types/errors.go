@@ -67,4 +67,24 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrIsolatedMarketNotFound error for when an isolated market is not found in the params
+	ErrIsolatedMarketNotFound = errorsmod.Register(ModuleName, 33, "isolated market not found")
+	// ErrIsolatedPositionNotFound error for when an account has no position in an isolated market
+	ErrIsolatedPositionNotFound = errorsmod.Register(ModuleName, 34, "isolated position not found")
+	// ErrInvalidBorrowDenom error for when a denom cannot be borrowed from an isolated market
+	ErrInvalidBorrowDenom = errorsmod.Register(ModuleName, 35, "invalid borrow denom")
+	// ErrPartialLiquidationDisabled error for when a money market's borrows cannot be directly liquidated
+	ErrPartialLiquidationDisabled = errorsmod.Register(ModuleName, 36, "partial liquidation disabled")
+	// ErrInvalidFlashLoanMsg error for when a message cannot be executed within a flash loan
+	ErrInvalidFlashLoanMsg = errorsmod.Register(ModuleName, 37, "invalid flash loan message")
+	// ErrFlashLoanNotRepaid error for when a flash loan and its fee are not repaid
+	ErrFlashLoanNotRepaid = errorsmod.Register(ModuleName, 38, "flash loan not repaid")
+	// ErrGreaterThanAssetSupplyLimit error for when a proposed deposit would increase supplied amount over the asset's global supply limit
+	ErrGreaterThanAssetSupplyLimit = errorsmod.Register(ModuleName, 39, "fails global asset supply limit validation")
+	// ErrGreaterThanCollateralValueLimit error for when a proposed deposit would increase an account's deposit of an asset over its collateral value limit
+	ErrGreaterThanCollateralValueLimit = errorsmod.Register(ModuleName, 40, "exceeds asset collateral value limit")
+	// ErrInvalidEModeCategory error for when an e-mode category is not found in the params
+	ErrInvalidEModeCategory = errorsmod.Register(ModuleName, 41, "invalid e-mode category")
+	// ErrEModeCategoryMismatch error for when an account's deposits or borrows are outside of its e-mode category
+	ErrEModeCategoryMismatch = errorsmod.Register(ModuleName, 42, "deposits and borrows not in e-mode category")
 )
@@ -7,6 +7,8 @@ const (
 	EventTypeJinxBorrow           = "jinx_borrow"
 	EventTypeJinxLiquidation      = "jinx_liquidation"
 	EventTypeJinxRepay            = "jinx_repay"
+	EventTypeJinxFlashLoan        = "jinx_flash_loan"
+	EventTypeJinxSetEMode         = "jinx_set_e_mode"
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDeposit           = "deposit"
 	AttributeKeyDepositDenom      = "deposit_denom"
@@ -22,4 +24,7 @@ const (
 	AttributeKeyKeeper            = "keeper"
 	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
 	AttributeKeyOwner             = "owner"
+	AttributeKeyIsolatedMarket    = "isolated_market"
+	AttributeKeyFlashLoanFee      = "flash_loan_fee"
+	AttributeKeyEModeCategory     = "e_mode_category"
 )
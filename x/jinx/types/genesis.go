@@ -11,6 +11,8 @@ import (
 func NewGenesisState(
 	params Params, prevAccumulationTimes GenesisAccumulationTimes, deposits Deposits,
 	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins,
+	isolatedMarketStates IsolatedMarketStates, isolatedPositions IsolatedPositions, accountEModes AccountEModes,
+	isolatedAuctions IsolatedAuctions,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -20,6 +22,10 @@ func NewGenesisState(
 		TotalSupplied:             totalSupplied,
 		TotalBorrowed:             totalBorrowed,
 		TotalReserves:             totalReserves,
+		IsolatedMarketStates:      isolatedMarketStates,
+		IsolatedPositions:         isolatedPositions,
+		AccountEModes:             accountEModes,
+		IsolatedAuctions:          isolatedAuctions,
 	}
 }
 
@@ -33,6 +39,10 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		IsolatedMarketStates:      DefaultIsolatedMarketStates,
+		IsolatedPositions:         DefaultIsolatedPositions,
+		AccountEModes:             DefaultAccountEModes,
+		IsolatedAuctions:          DefaultIsolatedAuctions,
 	}
 }
 
@@ -61,7 +71,16 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
-	return nil
+	if err := gs.IsolatedMarketStates.Validate(); err != nil {
+		return err
+	}
+	if err := gs.IsolatedPositions.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AccountEModes.Validate(); err != nil {
+		return err
+	}
+	return gs.IsolatedAuctions.Validate()
 }
 
 // NewGenesisAccumulationTime returns a new GenesisAccumulationTime
@@ -71,6 +90,7 @@ func NewGenesisAccumulationTime(ctype string, prevTime time.Time, supplyFactor,
 		PreviousAccumulationTime: prevTime,
 		SupplyInterestFactor:     supplyFactor,
 		BorrowInterestFactor:     borrowFactor,
+		RateAtTarget:             sdk.ZeroDec(),
 	}
 }
 
@@ -95,5 +115,8 @@ func (gat GenesisAccumulationTime) Validate() error {
 	if gat.BorrowInterestFactor.LT(sdk.OneDec()) {
 		return fmt.Errorf("borrow interest factor should be ≥ 1.0, is %s for %s", gat.BorrowInterestFactor, gat.CollateralType)
 	}
+	if !gat.RateAtTarget.IsNil() && gat.RateAtTarget.IsNegative() {
+		return fmt.Errorf("rate at target should not be negative, is %s for %s", gat.RateAtTarget, gat.CollateralType)
+	}
 	return nil
 }
@@ -39,6 +39,10 @@ type GenesisState struct {
 	TotalSupplied             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
 	TotalBorrowed             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
 	TotalReserves             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	IsolatedMarketStates      IsolatedMarketStates                     `protobuf:"bytes,8,rep,name=isolated_market_states,json=isolatedMarketStates,proto3,castrepeated=IsolatedMarketStates" json:"isolated_market_states"`
+	IsolatedPositions         IsolatedPositions                        `protobuf:"bytes,9,rep,name=isolated_positions,json=isolatedPositions,proto3,castrepeated=IsolatedPositions" json:"isolated_positions"`
+	AccountEModes             AccountEModes                            `protobuf:"bytes,10,rep,name=account_e_modes,json=accountEModes,proto3,castrepeated=AccountEModes" json:"account_e_modes"`
+	IsolatedAuctions          IsolatedAuctions                         `protobuf:"bytes,11,rep,name=isolated_auctions,json=isolatedAuctions,proto3,castrepeated=IsolatedAuctions" json:"isolated_auctions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,12 +127,42 @@ func (m *GenesisState) GetTotalReserves() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetIsolatedMarketStates() IsolatedMarketStates {
+	if m != nil {
+		return m.IsolatedMarketStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIsolatedPositions() IsolatedPositions {
+	if m != nil {
+		return m.IsolatedPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountEModes() AccountEModes {
+	if m != nil {
+		return m.AccountEModes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIsolatedAuctions() IsolatedAuctions {
+	if m != nil {
+		return m.IsolatedAuctions
+	}
+	return nil
+}
+
 // GenesisAccumulationTime stores the previous distribution time and its corresponding denom.
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	PreviousAccumulationTime time.Time                              `protobuf:"bytes,2,opt,name=previous_accumulation_time,json=previousAccumulationTime,proto3,stdtime" json:"previous_accumulation_time"`
 	SupplyInterestFactor     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=supply_interest_factor,json=supplyInterestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_interest_factor"`
 	BorrowInterestFactor     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=borrow_interest_factor,json=borrowInterestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_interest_factor"`
+	// rate_at_target is the current rate at target of an adaptive interest rate model, zero when unset.
+	RateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=rate_at_target,json=rateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_at_target"`
 }
 
 func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime{} }
@@ -186,45 +220,55 @@ func init() {
 func init() { proto.RegisterFile("fury/jinx/v1beta1/genesis.proto", fileDescriptor_3172de6771813fc7) }
 
 var fileDescriptor_3172de6771813fc7 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x5f, 0x6c, 0xa1, 0x05, 0xab, 0x02, 0x37, 0x20, 0x3b, 0xea, 0x01, 0xa2,
-	0x4a, 0xb1, 0x69, 0x39, 0x70, 0xe1, 0x82, 0x89, 0xf8, 0xb8, 0x21, 0x37, 0x27, 0x2e, 0xd6, 0xda,
-	0xd9, 0x98, 0x05, 0xdb, 0x6b, 0xed, 0xac, 0x43, 0xf3, 0x0e, 0x08, 0xf5, 0x39, 0x38, 0xf3, 0x04,
-	0x9c, 0x7a, 0xac, 0x38, 0x21, 0x0e, 0x2d, 0x4a, 0x5e, 0x04, 0x79, 0x77, 0x93, 0x14, 0x25, 0x91,
-	0x38, 0xd0, 0x53, 0xb2, 0x3b, 0xff, 0xf9, 0xff, 0xd6, 0xb3, 0x33, 0x8b, 0x9c, 0x7e, 0xc9, 0x87,
-	0xde, 0x07, 0x9a, 0x9f, 0x78, 0x83, 0xc3, 0x88, 0x08, 0x7c, 0xe8, 0x25, 0x24, 0x27, 0x40, 0xc1,
-	0x2d, 0x38, 0x13, 0xcc, 0xbc, 0x53, 0x09, 0xdc, 0x4a, 0xe0, 0x6a, 0x41, 0xc3, 0x8e, 0x19, 0x64,
-	0x0c, 0xbc, 0x08, 0x03, 0x99, 0x66, 0xc5, 0x8c, 0xe6, 0x2a, 0xa5, 0xb1, 0xa7, 0xe2, 0xa1, 0x5c,
-	0x79, 0x6a, 0xa1, 0x43, 0x0f, 0xe6, 0x71, 0xd2, 0x5a, 0x45, 0x77, 0x13, 0x96, 0x30, 0x95, 0x55,
-	0xfd, 0xd3, 0xbb, 0x4e, 0xc2, 0x58, 0x92, 0x12, 0x4f, 0xae, 0xa2, 0xb2, 0xef, 0x09, 0x9a, 0x11,
-	0x10, 0x38, 0x2b, 0x94, 0x60, 0xff, 0xfb, 0x1a, 0xba, 0xf9, 0x4a, 0x1d, 0xfa, 0x58, 0x60, 0x41,
-	0xcc, 0xa7, 0x68, 0xbd, 0xc0, 0x1c, 0x67, 0x60, 0x19, 0x4d, 0xa3, 0xb5, 0x75, 0xb4, 0xe7, 0xce,
-	0x7d, 0x84, 0xfb, 0x56, 0x0a, 0xfc, 0xd5, 0xb3, 0x0b, 0xa7, 0x16, 0x68, 0xb9, 0xf9, 0xd9, 0x40,
-	0xf7, 0x0b, 0x4e, 0x06, 0x94, 0x95, 0x10, 0xe2, 0x38, 0x2e, 0xb3, 0x32, 0xc5, 0x82, 0xb2, 0x3c,
-	0x94, 0x4c, 0x6b, 0xa5, 0x59, 0x6f, 0x6d, 0x1d, 0x1d, 0x2c, 0xb0, 0xd3, 0xfc, 0xe7, 0x57, 0x72,
-	0xba, 0x34, 0x23, 0x7e, 0xb3, 0xf2, 0xff, 0x7a, 0xe9, 0x58, 0x4b, 0x04, 0x10, 0xec, 0x4d, 0x80,
-	0x73, 0x21, 0xf3, 0x35, 0xda, 0xec, 0x91, 0x82, 0x01, 0x15, 0x60, 0xd5, 0x25, 0xba, 0xb1, 0x00,
-	0xdd, 0x51, 0x12, 0xff, 0xb6, 0x46, 0x6d, 0xea, 0x0d, 0x08, 0xa6, 0xd9, 0x66, 0x07, 0x6d, 0x44,
-	0x8c, 0x73, 0xf6, 0x09, 0xac, 0x55, 0x69, 0xb4, 0xa8, 0x24, 0xbe, 0x54, 0xf8, 0x3b, 0xda, 0x67,
-	0x43, 0xad, 0x21, 0x98, 0xa4, 0x9a, 0x1c, 0x6d, 0x0b, 0x26, 0x70, 0x1a, 0x42, 0x59, 0x14, 0x29,
-	0x25, 0x3d, 0x6b, 0x4d, 0x9b, 0xe9, 0x4b, 0xae, 0x3a, 0x62, 0x6a, 0xf7, 0x82, 0xd1, 0xdc, 0x7f,
-	0xac, 0xcd, 0x5a, 0x09, 0x15, 0xef, 0xcb, 0xc8, 0x8d, 0x59, 0xa6, 0x3b, 0x42, 0xff, 0xb4, 0xa1,
-	0xf7, 0xd1, 0x13, 0xc3, 0x82, 0x80, 0x4c, 0x80, 0xe0, 0x96, 0x44, 0x1c, 0x6b, 0xc2, 0x8c, 0xa9,
-	0x0e, 0x41, 0x7a, 0xd6, 0xfa, 0x75, 0x31, 0x7d, 0x4d, 0x98, 0x31, 0x39, 0x01, 0xc2, 0x07, 0x04,
-	0xac, 0x8d, 0xeb, 0x62, 0x06, 0x9a, 0xb0, 0xff, 0xa5, 0x8e, 0xee, 0x2d, 0xe9, 0x11, 0xf3, 0x11,
-	0xda, 0x89, 0x59, 0x9a, 0x62, 0x41, 0x38, 0x4e, 0xc3, 0xca, 0x44, 0x36, 0xf6, 0x8d, 0x60, 0x7b,
-	0xb6, 0xdd, 0x1d, 0x16, 0xc4, 0x8c, 0x50, 0x63, 0x79, 0xfb, 0x5a, 0x2b, 0x72, 0x18, 0x1a, 0xae,
-	0x9a, 0x27, 0x77, 0x32, 0x4f, 0x6e, 0x77, 0x32, 0x4f, 0xfe, 0x66, 0xf5, 0x15, 0xa7, 0x97, 0x8e,
-	0x11, 0x58, 0xcb, 0xba, 0xd2, 0xe4, 0xe8, 0xae, 0xbc, 0xfe, 0x61, 0x48, 0x73, 0x41, 0x38, 0x01,
-	0x11, 0xf6, 0x71, 0x2c, 0x18, 0xb7, 0xea, 0xd5, 0x99, 0xfc, 0x67, 0x95, 0xc7, 0xaf, 0x0b, 0xe7,
-	0xe1, 0x3f, 0x54, 0xa2, 0x43, 0xe2, 0x1f, 0xdf, 0xda, 0x48, 0x57, 0xb5, 0x43, 0xe2, 0x60, 0x57,
-	0x79, 0xbf, 0xd1, 0xd6, 0x2f, 0xa5, 0x73, 0xc5, 0x54, 0xd7, 0x3f, 0xc7, 0x5c, 0xfd, 0x1f, 0x4c,
-	0xe5, 0xfd, 0x37, 0xd3, 0xef, 0x9c, 0x8d, 0x6c, 0xe3, 0x7c, 0x64, 0x1b, 0xbf, 0x47, 0xb6, 0x71,
-	0x3a, 0xb6, 0x6b, 0xe7, 0x63, 0xbb, 0xf6, 0x73, 0x6c, 0xd7, 0xde, 0x1d, 0x5c, 0xa1, 0x14, 0x84,
-	0xc7, 0x0c, 0x28, 0xb4, 0x53, 0x1c, 0x81, 0x27, 0x5f, 0xb7, 0x13, 0xf5, 0xbe, 0x49, 0x5a, 0xb4,
-	0x2e, 0xab, 0xfc, 0xe4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xd5, 0xda, 0x22, 0x68, 0x05,
-	0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc7, 0x13, 0xc2, 0x47, 0x18, 0xbe, 0xad, 0x94, 0x4e, 0x52, 0x94, 0x44, 0x54, 0xa2, 0x08,
+	0x09, 0xbb, 0xd0, 0x45, 0x37, 0xdd, 0xc4, 0x4d, 0x3f, 0x58, 0x20, 0x21, 0x93, 0x55, 0x17, 0xb5,
+	0xc6, 0xce, 0x90, 0x4e, 0xb1, 0x3d, 0xd6, 0x9c, 0x31, 0x25, 0x7d, 0x86, 0x2e, 0x78, 0x8e, 0xae,
+	0xfb, 0x10, 0x2c, 0x51, 0x57, 0x55, 0x17, 0x70, 0x05, 0x6f, 0x70, 0x57, 0x77, 0x79, 0xe5, 0x99,
+	0x49, 0x88, 0xf2, 0x81, 0xee, 0x02, 0x56, 0x70, 0xce, 0xf9, 0x9f, 0xff, 0x6f, 0x9c, 0x39, 0x3e,
+	0x46, 0x8d, 0x8b, 0x4c, 0xf4, 0x9d, 0xdf, 0x59, 0x72, 0xed, 0x5c, 0x1d, 0x05, 0x54, 0x92, 0x23,
+	0xa7, 0x47, 0x13, 0x0a, 0x0c, 0xec, 0x54, 0x70, 0xc9, 0xad, 0xad, 0x5c, 0x60, 0xe7, 0x02, 0xdb,
+	0x08, 0x6a, 0xf5, 0x90, 0x43, 0xcc, 0xc1, 0x09, 0x08, 0xd0, 0x61, 0x57, 0xc8, 0x59, 0xa2, 0x5b,
+	0x6a, 0x55, 0x5d, 0xf7, 0x55, 0xe4, 0xe8, 0xc0, 0x94, 0x76, 0x26, 0x71, 0xca, 0x5a, 0x57, 0x2b,
+	0x3d, 0xde, 0xe3, 0xba, 0x2b, 0xff, 0xcf, 0x64, 0x1b, 0x3d, 0xce, 0x7b, 0x11, 0x75, 0x54, 0x14,
+	0x64, 0x17, 0x8e, 0x64, 0x31, 0x05, 0x49, 0xe2, 0x54, 0x0b, 0x76, 0x3f, 0x94, 0xd1, 0xea, 0x4f,
+	0xfa, 0xd0, 0xe7, 0x92, 0x48, 0x6a, 0x7d, 0x8b, 0x16, 0x53, 0x22, 0x48, 0x0c, 0xb8, 0xd8, 0x2c,
+	0xee, 0xaf, 0x1c, 0x57, 0xed, 0x89, 0x87, 0xb0, 0xcf, 0x94, 0xc0, 0x9d, 0xbf, 0xbd, 0x6f, 0x14,
+	0x3c, 0x23, 0xb7, 0xfe, 0x2a, 0xa2, 0x2f, 0x52, 0x41, 0xaf, 0x18, 0xcf, 0xc0, 0x27, 0x61, 0x98,
+	0xc5, 0x59, 0x44, 0x24, 0xe3, 0x89, 0xaf, 0x98, 0x78, 0xae, 0x59, 0xda, 0x5f, 0x39, 0x3e, 0x98,
+	0x62, 0x67, 0xf8, 0xad, 0x91, 0x9e, 0x0e, 0x8b, 0xa9, 0xdb, 0xcc, 0xfd, 0xff, 0x7e, 0x68, 0xe0,
+	0x19, 0x02, 0xf0, 0xaa, 0x03, 0xe0, 0x44, 0xc9, 0xfa, 0x19, 0x95, 0xbb, 0x34, 0xe5, 0xc0, 0x24,
+	0xe0, 0x92, 0x42, 0xd7, 0xa6, 0xa0, 0xdb, 0x5a, 0xe2, 0x6e, 0x1a, 0x54, 0xd9, 0x24, 0xc0, 0x1b,
+	0x76, 0x5b, 0x6d, 0xb4, 0x14, 0x70, 0x21, 0xf8, 0x1f, 0x80, 0xe7, 0x9b, 0xa5, 0x19, 0x3f, 0x89,
+	0xab, 0x14, 0xee, 0x86, 0xf1, 0x59, 0xd2, 0x31, 0x78, 0x83, 0x56, 0x4b, 0xa0, 0x75, 0xc9, 0x25,
+	0x89, 0x7c, 0xc8, 0xd2, 0x34, 0x62, 0xb4, 0x8b, 0x17, 0x8c, 0x99, 0xb9, 0xe4, 0x7c, 0x22, 0x86,
+	0x76, 0xdf, 0x73, 0x96, 0xb8, 0x5f, 0x1b, 0xb3, 0xfd, 0x1e, 0x93, 0xbf, 0x65, 0x81, 0x1d, 0xf2,
+	0xd8, 0x4c, 0x84, 0xf9, 0x73, 0x08, 0xdd, 0x4b, 0x47, 0xf6, 0x53, 0x0a, 0xaa, 0x01, 0xbc, 0x35,
+	0x85, 0x38, 0x37, 0x84, 0x67, 0xa6, 0x3e, 0x04, 0xed, 0xe2, 0xc5, 0xb7, 0x62, 0xba, 0x86, 0xf0,
+	0xcc, 0x14, 0x14, 0xa8, 0xb8, 0xa2, 0x80, 0x97, 0xde, 0x8a, 0xe9, 0x19, 0x82, 0xf5, 0x27, 0xda,
+	0x66, 0xc0, 0x23, 0x22, 0x69, 0xd7, 0x8f, 0x89, 0xb8, 0xa4, 0xd2, 0x87, 0x7c, 0x98, 0x01, 0x97,
+	0x15, 0x7b, 0x6f, 0xca, 0x85, 0x9d, 0x98, 0x86, 0x53, 0xa5, 0x57, 0xb3, 0xef, 0xee, 0x98, 0x83,
+	0x54, 0xa6, 0x14, 0xc1, 0xab, 0xb0, 0x29, 0x59, 0x2b, 0x46, 0xd6, 0x90, 0xad, 0x06, 0x86, 0xf1,
+	0x04, 0xf0, 0xb2, 0xe2, 0x7e, 0xf9, 0x02, 0xf7, 0xcc, 0x68, 0xdd, 0xaa, 0x81, 0x6e, 0x8d, 0x57,
+	0xc0, 0xdb, 0x62, 0xe3, 0x29, 0xeb, 0x57, 0xb4, 0x41, 0xc2, 0x90, 0x67, 0x89, 0xf4, 0xa9, 0x1f,
+	0xf3, 0x2e, 0x05, 0x8c, 0x14, 0xab, 0x31, 0x85, 0xd5, 0xd2, 0xca, 0x1f, 0x4e, 0x79, 0x97, 0xba,
+	0x9f, 0x19, 0xce, 0xda, 0x68, 0x16, 0xbc, 0x35, 0x32, 0x1a, 0x5a, 0x0c, 0x0d, 0xa1, 0x3e, 0xc9,
+	0x42, 0xfd, 0x34, 0x2b, 0x8a, 0xb0, 0xfb, 0xc2, 0xd3, 0xb4, 0xb4, 0xd4, 0xc5, 0x06, 0xb2, 0x39,
+	0x56, 0x00, 0x6f, 0x93, 0x8d, 0x65, 0x76, 0xdf, 0x97, 0xd0, 0xe7, 0x33, 0xde, 0x6c, 0xeb, 0x2b,
+	0xb4, 0x11, 0xf2, 0x28, 0xd7, 0x0b, 0x12, 0xf9, 0xf9, 0xd5, 0xab, 0x75, 0xb4, 0xec, 0xad, 0x3f,
+	0xa7, 0x3b, 0xfd, 0x94, 0x5a, 0x01, 0xaa, 0xcd, 0x5e, 0x3a, 0x78, 0x4e, 0xad, 0xb0, 0x9a, 0xad,
+	0xb7, 0xa0, 0x3d, 0xd8, 0x82, 0x76, 0x67, 0xb0, 0x05, 0xdd, 0x72, 0x7e, 0xe0, 0x9b, 0x87, 0x46,
+	0xd1, 0xc3, 0xb3, 0x76, 0x89, 0x25, 0xd0, 0xb6, 0x7a, 0x69, 0xfb, 0x3e, 0x4b, 0x24, 0x15, 0x14,
+	0xa4, 0x7f, 0x41, 0x42, 0xc9, 0x05, 0x2e, 0xe5, 0x67, 0x72, 0xbf, 0xcb, 0x3d, 0xfe, 0xbf, 0x6f,
+	0xec, 0x7d, 0xc2, 0xfc, 0xb6, 0x69, 0xf8, 0xef, 0x3f, 0x87, 0x48, 0xe7, 0xf3, 0xc8, 0xab, 0x68,
+	0xef, 0x13, 0x63, 0xfd, 0xa3, 0x72, 0xce, 0x99, 0xfa, 0xa5, 0x9d, 0x60, 0xce, 0xbf, 0x06, 0x53,
+	0x7b, 0x8f, 0x31, 0x03, 0xb4, 0x2e, 0x88, 0xa4, 0x3e, 0x91, 0xbe, 0x24, 0xa2, 0x47, 0x25, 0x5e,
+	0x78, 0x05, 0xd6, 0x6a, 0xee, 0xd9, 0x92, 0x1d, 0xe5, 0xe8, 0xb6, 0x6f, 0x1f, 0xeb, 0xc5, 0xbb,
+	0xc7, 0x7a, 0xf1, 0xdd, 0x63, 0xbd, 0x78, 0xf3, 0x54, 0x2f, 0xdc, 0x3d, 0xd5, 0x0b, 0xff, 0x3d,
+	0xd5, 0x0b, 0xbf, 0x1c, 0x8c, 0xb8, 0xa7, 0x54, 0x84, 0x1c, 0x18, 0x1c, 0x46, 0x24, 0x00, 0x47,
+	0x7d, 0xf7, 0xae, 0xf5, 0x97, 0x4f, 0x51, 0x82, 0x45, 0x75, 0x93, 0xdf, 0x7c, 0x1c, 0x00, 0xb3,
+	0x32, 0xef, 0x03, 0x82, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -247,6 +291,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IsolatedAuctions) > 0 {
+		for iNdEx := len(m.IsolatedAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AccountEModes) > 0 {
+		for iNdEx := len(m.AccountEModes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountEModes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.IsolatedPositions) > 0 {
+		for iNdEx := len(m.IsolatedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IsolatedMarketStates) > 0 {
+		for iNdEx := len(m.IsolatedMarketStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedMarketStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TotalReserves) > 0 {
 		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -364,6 +464,16 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.RateAtTarget.Size()
+		i -= size
+		if _, err := m.RateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.BorrowInterestFactor.Size()
 		i -= size
@@ -457,6 +567,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IsolatedMarketStates) > 0 {
+		for _, e := range m.IsolatedMarketStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IsolatedPositions) > 0 {
+		for _, e := range m.IsolatedPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountEModes) > 0 {
+		for _, e := range m.AccountEModes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IsolatedAuctions) > 0 {
+		for _, e := range m.IsolatedAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -476,6 +610,8 @@ func (m *GenesisAccumulationTime) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.BorrowInterestFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RateAtTarget.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -751,6 +887,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedMarketStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedMarketStates = append(m.IsolatedMarketStates, IsolatedMarketState{})
+			if err := m.IsolatedMarketStates[len(m.IsolatedMarketStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedPositions = append(m.IsolatedPositions, IsolatedPosition{})
+			if err := m.IsolatedPositions[len(m.IsolatedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountEModes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountEModes = append(m.AccountEModes, AccountEMode{})
+			if err := m.AccountEModes[len(m.AccountEModes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedAuctions = append(m.IsolatedAuctions, IsolatedAuction{})
+			if err := m.IsolatedAuctions[len(m.IsolatedAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -934,6 +1206,40 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
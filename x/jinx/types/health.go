@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PositionHealth describes how close an account's deposits and borrows are to liquidation at current prices
+type PositionHealth struct {
+	Owner sdk.AccAddress
+	// HealthFactor is nil if the position has no borrows
+	HealthFactor      sdk.Dec
+	DepositUSDValue   sdk.Dec
+	BorrowUSDValue    sdk.Dec
+	MaxBorrowUSDValue sdk.Dec
+	MaxWithdraw       sdk.Coins
+	LiquidationPrices LiquidationPrices
+}
+
+// IsLiquidatable returns true if the position is outside its valid LTV range
+func (h PositionHealth) IsLiquidatable() bool {
+	return !h.HealthFactor.IsNil() && h.HealthFactor.LT(sdk.OneDec())
+}
+
+// ToResponse converts PositionHealth to PositionHealthResponse
+func (h PositionHealth) ToResponse() PositionHealthResponse {
+	return PositionHealthResponse{
+		Owner:             h.Owner.String(),
+		HealthFactor:      decStringOrEmpty(h.HealthFactor),
+		DepositUsdValue:   h.DepositUSDValue.String(),
+		BorrowUsdValue:    h.BorrowUSDValue.String(),
+		MaxBorrowUsdValue: h.MaxBorrowUSDValue.String(),
+		MaxWithdraw:       h.MaxWithdraw,
+		LiquidationPrices: h.LiquidationPrices,
+	}
+}
+
+// PositionHealths is a slice of PositionHealth
+type PositionHealths []PositionHealth
+
+// ToResponse converts PositionHealths to PositionHealthResponses
+func (hs PositionHealths) ToResponse() PositionHealthResponses {
+	var responses PositionHealthResponses
+	for _, h := range hs {
+		responses = append(responses, h.ToResponse())
+	}
+	return responses
+}
+
+// PositionHealthResponses is a slice of PositionHealthResponse
+type PositionHealthResponses []PositionHealthResponse
+
+// NewLiquidationPrice returns a new LiquidationPrice, a nil price means there is no liquidation price in that direction
+func NewLiquidationPrice(denom string, lowerPrice, upperPrice sdk.Dec) LiquidationPrice {
+	return LiquidationPrice{
+		Denom:      denom,
+		LowerPrice: decStringOrEmpty(lowerPrice),
+		UpperPrice: decStringOrEmpty(upperPrice),
+	}
+}
+
+// LiquidationPrices is a slice of LiquidationPrice
+type LiquidationPrices []LiquidationPrice
+
+func decStringOrEmpty(d sdk.Dec) string {
+	if d.IsNil() {
+		return ""
+	}
+	return d.String()
+}
@@ -0,0 +1,377 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewIsolatedMarket returns a new IsolatedMarket
+func NewIsolatedMarket(name string, moneyMarkets MoneyMarkets, collateralDenoms, borrowDenoms []string) IsolatedMarket {
+	return IsolatedMarket{
+		Name:             name,
+		MoneyMarkets:     moneyMarkets,
+		CollateralDenoms: collateralDenoms,
+		BorrowDenoms:     borrowDenoms,
+	}
+}
+
+// Validate IsolatedMarket param
+func (im IsolatedMarket) Validate() error {
+	if strings.TrimSpace(im.Name) == "" {
+		return fmt.Errorf("isolated market name cannot be blank")
+	}
+	if len(im.MoneyMarkets) == 0 {
+		return fmt.Errorf("isolated market %s has no money markets", im.Name)
+	}
+
+	denoms := make(map[string]bool, len(im.MoneyMarkets))
+	for _, mm := range im.MoneyMarkets {
+		if err := mm.Validate(); err != nil {
+			return fmt.Errorf("isolated market %s: %w", im.Name, err)
+		}
+		if denoms[mm.Denom] {
+			return fmt.Errorf("isolated market %s has duplicate money market %s", im.Name, mm.Denom)
+		}
+		denoms[mm.Denom] = true
+	}
+
+	if err := validateIsolatedDenoms(im.Name, "collateral", im.CollateralDenoms, denoms); err != nil {
+		return err
+	}
+	if err := validateIsolatedDenoms(im.Name, "borrow", im.BorrowDenoms, denoms); err != nil {
+		return err
+	}
+	if len(im.BorrowDenoms) == 0 {
+		return fmt.Errorf("isolated market %s has no borrow denoms", im.Name)
+	}
+	// collateral is seized from the market's cash on liquidation, so it must never be lent out
+	for _, denom := range im.CollateralDenoms {
+		if containsDenom(im.BorrowDenoms, denom) {
+			return fmt.Errorf("isolated market %s denom %s cannot be both collateral and borrowable", im.Name, denom)
+		}
+	}
+	return nil
+}
+
+func validateIsolatedDenoms(market, kind string, denoms []string, moneyMarkets map[string]bool) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if !moneyMarkets[denom] {
+			return fmt.Errorf("isolated market %s %s denom %s has no money market", market, kind, denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("isolated market %s has duplicate %s denom %s", market, kind, denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// GetMoneyMarket returns the money market of a denom in the isolated market
+func (im IsolatedMarket) GetMoneyMarket(denom string) (MoneyMarket, bool) {
+	for _, mm := range im.MoneyMarkets {
+		if mm.Denom == denom {
+			return mm, true
+		}
+	}
+	return MoneyMarket{}, false
+}
+
+// IsCollateral returns true if deposits of the denom can be borrowed against in the isolated market
+func (im IsolatedMarket) IsCollateral(denom string) bool {
+	return containsDenom(im.CollateralDenoms, denom)
+}
+
+// IsBorrowable returns true if the denom can be borrowed from the isolated market
+func (im IsolatedMarket) IsBorrowable(denom string) bool {
+	return containsDenom(im.BorrowDenoms, denom)
+}
+
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsolatedMarkets slice of IsolatedMarket
+type IsolatedMarkets []IsolatedMarket
+
+// Validate IsolatedMarkets
+func (ims IsolatedMarkets) Validate() error {
+	names := make(map[string]bool, len(ims))
+	for _, im := range ims {
+		if err := im.Validate(); err != nil {
+			return err
+		}
+		if names[im.Name] {
+			return fmt.Errorf("duplicate isolated market %s", im.Name)
+		}
+		names[im.Name] = true
+	}
+	return nil
+}
+
+// Get returns the isolated market with a name
+func (ims IsolatedMarkets) Get(name string) (IsolatedMarket, bool) {
+	for _, im := range ims {
+		if im.Name == name {
+			return im, true
+		}
+	}
+	return IsolatedMarket{}, false
+}
+
+// NewIsolatedPosition returns a new IsolatedPosition
+func NewIsolatedPosition(market string, owner sdk.AccAddress, deposit sdk.Coins, depositIndex SupplyInterestFactors,
+	borrow sdk.Coins, borrowIndex BorrowInterestFactors,
+) IsolatedPosition {
+	return IsolatedPosition{
+		Market:       market,
+		Owner:        owner,
+		Deposit:      deposit,
+		DepositIndex: depositIndex,
+		Borrow:       borrow,
+		BorrowIndex:  borrowIndex,
+	}
+}
+
+// IsEmpty returns true if nothing is deposited in or borrowed from the position
+func (p IsolatedPosition) IsEmpty() bool {
+	return p.Deposit.Empty() && p.Borrow.Empty()
+}
+
+// Validate IsolatedPosition
+func (p IsolatedPosition) Validate() error {
+	if strings.TrimSpace(p.Market) == "" {
+		return fmt.Errorf("isolated position market cannot be blank")
+	}
+	if p.Owner.Empty() {
+		return fmt.Errorf("isolated position owner cannot be empty")
+	}
+	if !p.Deposit.IsValid() {
+		return fmt.Errorf("invalid isolated position deposit coins: %s", p.Deposit)
+	}
+	if err := p.DepositIndex.Validate(); err != nil {
+		return err
+	}
+	if !p.Borrow.IsValid() {
+		return fmt.Errorf("invalid isolated position borrow coins: %s", p.Borrow)
+	}
+	return p.BorrowIndex.Validate()
+}
+
+// ToResponse converts IsolatedPosition to IsolatedPositionResponse
+func (p IsolatedPosition) ToResponse() IsolatedPositionResponse {
+	return IsolatedPositionResponse{
+		Market:       p.Market,
+		Owner:        p.Owner.String(),
+		Deposit:      p.Deposit,
+		DepositIndex: p.DepositIndex.ToResponse(),
+		Borrow:       p.Borrow,
+		BorrowIndex:  p.BorrowIndex.ToResponse(),
+	}
+}
+
+// IsolatedPositions is a slice of IsolatedPosition
+type IsolatedPositions []IsolatedPosition
+
+// Validate validates IsolatedPositions
+func (ps IsolatedPositions) Validate() error {
+	seen := make(map[string]bool, len(ps))
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		key := string(IsolatedPositionKey(p.Market, p.Owner))
+		if seen[key] {
+			return fmt.Errorf("duplicate isolated position for %s in %s", p.Owner, p.Market)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ToResponse converts IsolatedPositions to IsolatedPositionResponses
+func (ps IsolatedPositions) ToResponse() IsolatedPositionResponses {
+	var responses IsolatedPositionResponses
+	for _, p := range ps {
+		responses = append(responses, p.ToResponse())
+	}
+	return responses
+}
+
+// IsolatedPositionResponses is a slice of IsolatedPositionResponse
+type IsolatedPositionResponses []IsolatedPositionResponse
+
+// NewIsolatedMarketState returns a new IsolatedMarketState with no supplied, borrowed or reserve coins
+func NewIsolatedMarketState(market string) IsolatedMarketState {
+	return IsolatedMarketState{
+		Market:            market,
+		TotalSupplied:     sdk.Coins{},
+		TotalBorrowed:     sdk.Coins{},
+		TotalReserves:     sdk.Coins{},
+		AccumulationTimes: IsolatedAccumulationTimes{},
+		AuctionDebt:       sdk.Coins{},
+	}
+}
+
+// GetAccumulationTime returns the accumulation time of a denom in the isolated market
+func (s IsolatedMarketState) GetAccumulationTime(denom string) (IsolatedAccumulationTime, bool) {
+	for _, at := range s.AccumulationTimes {
+		if at.Denom == denom {
+			return at, true
+		}
+	}
+	return IsolatedAccumulationTime{}, false
+}
+
+// SetAccumulationTime sets the accumulation time of a denom in the isolated market
+func (s *IsolatedMarketState) SetAccumulationTime(accumulationTime IsolatedAccumulationTime) {
+	for i, at := range s.AccumulationTimes {
+		if at.Denom == accumulationTime.Denom {
+			s.AccumulationTimes[i] = accumulationTime
+			return
+		}
+	}
+	s.AccumulationTimes = append(s.AccumulationTimes, accumulationTime)
+}
+
+// Cash returns the amount of a denom held for the isolated market, which is the supplied and reserve coins that
+// have not been borrowed
+func (s IsolatedMarketState) Cash(denom string) sdkmath.Int {
+	cash := s.TotalSupplied.AmountOf(denom).Add(s.TotalReserves.AmountOf(denom)).Sub(s.TotalBorrowed.AmountOf(denom))
+	if cash.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return cash
+}
+
+// Validate IsolatedMarketState
+func (s IsolatedMarketState) Validate() error {
+	if strings.TrimSpace(s.Market) == "" {
+		return fmt.Errorf("isolated market state market cannot be blank")
+	}
+	if !s.TotalSupplied.IsValid() {
+		return fmt.Errorf("invalid isolated market total supplied coins: %s", s.TotalSupplied)
+	}
+	if !s.TotalBorrowed.IsValid() {
+		return fmt.Errorf("invalid isolated market total borrowed coins: %s", s.TotalBorrowed)
+	}
+	if !s.TotalReserves.IsValid() {
+		return fmt.Errorf("invalid isolated market total reserves coins: %s", s.TotalReserves)
+	}
+	if !s.AuctionDebt.IsValid() {
+		return fmt.Errorf("invalid isolated market auction debt coins: %s", s.AuctionDebt)
+	}
+	if !s.AuctionDebt.IsAllLTE(s.TotalBorrowed) {
+		return fmt.Errorf("isolated market auction debt %s exceeds total borrowed %s", s.AuctionDebt, s.TotalBorrowed)
+	}
+	return s.AccumulationTimes.Validate()
+}
+
+// IsolatedMarketStates is a slice of IsolatedMarketState
+type IsolatedMarketStates []IsolatedMarketState
+
+// Validate validates IsolatedMarketStates
+func (ss IsolatedMarketStates) Validate() error {
+	seen := make(map[string]bool, len(ss))
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if seen[s.Market] {
+			return fmt.Errorf("duplicate isolated market state %s", s.Market)
+		}
+		seen[s.Market] = true
+	}
+	return nil
+}
+
+// NewIsolatedAccumulationTime returns a new IsolatedAccumulationTime
+func NewIsolatedAccumulationTime(denom string, previousAccrualTime time.Time, supplyFactor, borrowFactor sdk.Dec) IsolatedAccumulationTime {
+	return IsolatedAccumulationTime{
+		Denom:                denom,
+		PreviousAccrualTime:  previousAccrualTime,
+		SupplyInterestFactor: supplyFactor,
+		BorrowInterestFactor: borrowFactor,
+		RateAtTarget:         sdk.ZeroDec(),
+	}
+}
+
+// Validate IsolatedAccumulationTime
+func (at IsolatedAccumulationTime) Validate() error {
+	if err := sdk.ValidateDenom(at.Denom); err != nil {
+		return err
+	}
+	// the supply interest factor falls below 1.0 when bad debt is written off against suppliers
+	if !at.SupplyInterestFactor.IsPositive() {
+		return fmt.Errorf("supply interest factor should be positive, is %s for %s", at.SupplyInterestFactor, at.Denom)
+	}
+	if at.BorrowInterestFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("borrow interest factor should be ≥ 1.0, is %s for %s", at.BorrowInterestFactor, at.Denom)
+	}
+	return nil
+}
+
+// IsolatedAccumulationTimes is a slice of IsolatedAccumulationTime
+type IsolatedAccumulationTimes []IsolatedAccumulationTime
+
+// Validate validates IsolatedAccumulationTimes
+func (ats IsolatedAccumulationTimes) Validate() error {
+	seen := make(map[string]bool, len(ats))
+	for _, at := range ats {
+		if err := at.Validate(); err != nil {
+			return err
+		}
+		if seen[at.Denom] {
+			return fmt.Errorf("duplicate accumulation time for %s", at.Denom)
+		}
+		seen[at.Denom] = true
+	}
+	return nil
+}
+
+// NewIsolatedAuction returns a new IsolatedAuction
+func NewIsolatedAuction(auctionID uint64, market string, debt sdk.Coin) IsolatedAuction {
+	return IsolatedAuction{
+		AuctionID: auctionID,
+		Market:    market,
+		Debt:      debt,
+	}
+}
+
+// Validate IsolatedAuction
+func (a IsolatedAuction) Validate() error {
+	if strings.TrimSpace(a.Market) == "" {
+		return fmt.Errorf("isolated auction market cannot be blank")
+	}
+	if !a.Debt.IsValid() || !a.Debt.IsPositive() {
+		return fmt.Errorf("invalid isolated auction debt: %s", a.Debt)
+	}
+	return nil
+}
+
+// IsolatedAuctions is a slice of IsolatedAuction
+type IsolatedAuctions []IsolatedAuction
+
+// Validate validates IsolatedAuctions
+func (as IsolatedAuctions) Validate() error {
+	seen := make(map[uint64]bool, len(as))
+	for _, a := range as {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if seen[a.AuctionID] {
+			return fmt.Errorf("duplicate isolated auction %d", a.AuctionID)
+		}
+		seen[a.AuctionID] = true
+	}
+	return nil
+}
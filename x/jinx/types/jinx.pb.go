@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
 	MinimumBorrowUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minimum_borrow_usd_value,json=minimumBorrowUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_borrow_usd_value"`
+	IsolatedMarkets       IsolatedMarkets                        `protobuf:"bytes,3,rep,name=isolated_markets,json=isolatedMarkets,proto3,castrepeated=IsolatedMarkets" json:"isolated_markets"`
+	EModeCategories       EModeCategories                        `protobuf:"bytes,4,rep,name=e_mode_categories,json=eModeCategories,proto3,castrepeated=EModeCategories" json:"e_mode_categories"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,6 +80,19 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// auction_type is the type of auction liquidated deposits of this denom are sold in, either "collateral" (the
+	// default when empty) or "dutch".
+	AuctionType string `protobuf:"bytes,8,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// close_factor is the maximum fraction of a borrow of this denom that can be repaid in a single direct
+	// liquidation. Zero disables direct liquidation of borrows of this denom.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
+	// liquidation_bonus is the fraction of the repaid value a liquidator receives on top of it when seizing
+	// deposits of this denom in a direct liquidation.
+	LiquidationBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=liquidation_bonus,json=liquidationBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_bonus"`
+	// flash_loan_fee is the fraction of a flash loan of this denom that must be repaid on top of it. Fees are added to
+	// the money market's reserves.
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	SupplyLimit  SupplyLimit                            `protobuf:"bytes,12,opt,name=supply_limit,json=supplyLimit,proto3" json:"supply_limit"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
@@ -149,19 +168,77 @@ func (m *BorrowLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowLimit proto.InternalMessageInfo
 
+// SupplyLimit enforces restrictions on deposits in a money market.
+type SupplyLimit struct {
+	HasMaxLimit bool `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
+	// maximum_limit is the maximum total amount of the asset that can be supplied.
+	MaximumLimit          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maximum_limit,json=maximumLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_limit"`
+	HasMaxCollateralValue bool                                   `protobuf:"varint,3,opt,name=has_max_collateral_value,json=hasMaxCollateralValue,proto3" json:"has_max_collateral_value"`
+	// max_collateral_value is the maximum USD value of an account's deposit of the asset that counts towards its
+	// borrow limit.
+	MaxCollateralValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_collateral_value,json=maxCollateralValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_collateral_value"`
+}
+
+func (m *SupplyLimit) Reset()         { *m = SupplyLimit{} }
+func (m *SupplyLimit) String() string { return proto.CompactTextString(m) }
+func (*SupplyLimit) ProtoMessage()    {}
+func (*SupplyLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{3}
+}
+func (m *SupplyLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyLimit.Merge(m, src)
+}
+func (m *SupplyLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyLimit proto.InternalMessageInfo
+
 // InterestRateModel contains information about an asset's interest rate.
 type InterestRateModel struct {
 	BaseRateAPY    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate_apy,json=baseRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate_apy"`
 	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
 	Kink           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kink"`
 	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
+	// model_type selects how the borrow rate is calculated from utilization, either "jump" (the default when empty),
+	// "piecewise" or "adaptive".
+	ModelType string `protobuf:"bytes,5,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	// kinks are the points of a piecewise model's curve, starting from base_rate_apy at zero utilization.
+	Kinks InterestRateKinks `protobuf:"bytes,6,rep,name=kinks,proto3,castrepeated=InterestRateKinks" json:"kinks"`
+	// target_utilization is the utilization an adaptive model steers its rate towards.
+	TargetUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=target_utilization,json=targetUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_utilization"`
+	// adjustment_speed is the yearly fraction an adaptive model's rate at target changes by at full distance from
+	// the target utilization.
+	AdjustmentSpeed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=adjustment_speed,json=adjustmentSpeed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_speed"`
+	MinRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_rate_at_target,json=minRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rate_at_target"`
+	MaxRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_rate_at_target,json=maxRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate_at_target"`
+	// curve_steepness is the ratio of an adaptive model's rate at full utilization to its rate at target.
+	CurveSteepness github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=curve_steepness,json=curveSteepness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"curve_steepness"`
 }
 
 func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71d78220d7e9a866, []int{3}
+	return fileDescriptor_71d78220d7e9a866, []int{4}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,6 +267,45 @@ func (m *InterestRateModel) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterestRateModel proto.InternalMessageInfo
 
+// InterestRateKink is a point on a piecewise interest rate curve.
+type InterestRateKink struct {
+	Utilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization"`
+	RateAPY     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate_apy,json=rateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_apy"`
+}
+
+func (m *InterestRateKink) Reset()         { *m = InterestRateKink{} }
+func (m *InterestRateKink) String() string { return proto.CompactTextString(m) }
+func (*InterestRateKink) ProtoMessage()    {}
+func (*InterestRateKink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{5}
+}
+func (m *InterestRateKink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestRateKink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestRateKink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestRateKink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestRateKink.Merge(m, src)
+}
+func (m *InterestRateKink) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestRateKink) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestRateKink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestRateKink proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a jinx module account.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
@@ -201,7 +317,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71d78220d7e9a866, []int{4}
+	return fileDescriptor_71d78220d7e9a866, []int{6}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +357,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71d78220d7e9a866, []int{5}
+	return fileDescriptor_71d78220d7e9a866, []int{7}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +396,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71d78220d7e9a866, []int{6}
+	return fileDescriptor_71d78220d7e9a866, []int{8}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +435,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71d78220d7e9a866, []int{7}
+	return fileDescriptor_71d78220d7e9a866, []int{9}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,6 +464,309 @@ func (m *BorrowInterestFactor) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowInterestFactor proto.InternalMessageInfo
 
+// IsolatedMarket is a named lending market whose positions are kept apart from the shared money markets, so its
+// assets can only be borrowed against its own collateral.
+type IsolatedMarket struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// money_markets are the assets that can be supplied to the market, with the borrow limits and interest rate model
+	// each is lent under within it.
+	MoneyMarkets MoneyMarkets `protobuf:"bytes,2,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
+	// collateral_denoms are the supplied assets that can be borrowed against.
+	CollateralDenoms []string `protobuf:"bytes,3,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	// borrow_denoms are the supplied assets that can be borrowed.
+	BorrowDenoms []string `protobuf:"bytes,4,rep,name=borrow_denoms,json=borrowDenoms,proto3" json:"borrow_denoms,omitempty"`
+}
+
+func (m *IsolatedMarket) Reset()         { *m = IsolatedMarket{} }
+func (m *IsolatedMarket) String() string { return proto.CompactTextString(m) }
+func (*IsolatedMarket) ProtoMessage()    {}
+func (*IsolatedMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{10}
+}
+func (m *IsolatedMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedMarket.Merge(m, src)
+}
+func (m *IsolatedMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedMarket proto.InternalMessageInfo
+
+// IsolatedPosition defines the coins an account has deposited in, and borrowed from, an isolated market.
+type IsolatedPosition struct {
+	Market       string                                        `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Owner        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Deposit      github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	DepositIndex SupplyInterestFactors                         `protobuf:"bytes,4,rep,name=deposit_index,json=depositIndex,proto3,castrepeated=SupplyInterestFactors" json:"deposit_index"`
+	Borrow       github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,5,rep,name=borrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrow"`
+	BorrowIndex  BorrowInterestFactors                         `protobuf:"bytes,6,rep,name=borrow_index,json=borrowIndex,proto3,castrepeated=BorrowInterestFactors" json:"borrow_index"`
+}
+
+func (m *IsolatedPosition) Reset()         { *m = IsolatedPosition{} }
+func (m *IsolatedPosition) String() string { return proto.CompactTextString(m) }
+func (*IsolatedPosition) ProtoMessage()    {}
+func (*IsolatedPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{11}
+}
+func (m *IsolatedPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedPosition.Merge(m, src)
+}
+func (m *IsolatedPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedPosition proto.InternalMessageInfo
+
+// IsolatedMarketState defines the totals and interest accumulators of an isolated market.
+type IsolatedMarketState struct {
+	Market            string                                   `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	TotalSupplied     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
+	TotalBorrowed     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
+	TotalReserves     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	AccumulationTimes IsolatedAccumulationTimes                `protobuf:"bytes,5,rep,name=accumulation_times,json=accumulationTimes,proto3,castrepeated=IsolatedAccumulationTimes" json:"accumulation_times"`
+	// auction_debt is the part of total_borrowed owed by liquidated positions, until their auctions close.
+	AuctionDebt github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=auction_debt,json=auctionDebt,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"auction_debt"`
+}
+
+func (m *IsolatedMarketState) Reset()         { *m = IsolatedMarketState{} }
+func (m *IsolatedMarketState) String() string { return proto.CompactTextString(m) }
+func (*IsolatedMarketState) ProtoMessage()    {}
+func (*IsolatedMarketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{12}
+}
+func (m *IsolatedMarketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedMarketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedMarketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedMarketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedMarketState.Merge(m, src)
+}
+func (m *IsolatedMarketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedMarketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedMarketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedMarketState proto.InternalMessageInfo
+
+// IsolatedAuction defines an auction of collateral seized from a position in an isolated market, and the debt its
+// proceeds repay.
+type IsolatedAuction struct {
+	AuctionID uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Market    string     `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	Debt      types.Coin `protobuf:"bytes,3,opt,name=debt,proto3" json:"debt"`
+}
+
+func (m *IsolatedAuction) Reset()         { *m = IsolatedAuction{} }
+func (m *IsolatedAuction) String() string { return proto.CompactTextString(m) }
+func (*IsolatedAuction) ProtoMessage()    {}
+func (*IsolatedAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{13}
+}
+func (m *IsolatedAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedAuction.Merge(m, src)
+}
+func (m *IsolatedAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedAuction proto.InternalMessageInfo
+
+// IsolatedAccumulationTime stores the previous accrual time and interest factors of an asset in an isolated market.
+type IsolatedAccumulationTime struct {
+	Denom                string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PreviousAccrualTime  time.Time                              `protobuf:"bytes,2,opt,name=previous_accrual_time,json=previousAccrualTime,proto3,stdtime" json:"previous_accrual_time"`
+	SupplyInterestFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=supply_interest_factor,json=supplyInterestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_interest_factor"`
+	BorrowInterestFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=borrow_interest_factor,json=borrowInterestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_interest_factor"`
+	// rate_at_target is the current rate at target of an adaptive interest rate model, zero when unset.
+	RateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=rate_at_target,json=rateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_at_target"`
+}
+
+func (m *IsolatedAccumulationTime) Reset()         { *m = IsolatedAccumulationTime{} }
+func (m *IsolatedAccumulationTime) String() string { return proto.CompactTextString(m) }
+func (*IsolatedAccumulationTime) ProtoMessage()    {}
+func (*IsolatedAccumulationTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{14}
+}
+func (m *IsolatedAccumulationTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedAccumulationTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedAccumulationTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedAccumulationTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedAccumulationTime.Merge(m, src)
+}
+func (m *IsolatedAccumulationTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedAccumulationTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedAccumulationTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedAccumulationTime proto.InternalMessageInfo
+
+// EModeCategory is a named group of correlated assets that can be borrowed against each other at a higher
+// loan-to-value by accounts that opt in to it.
+type EModeCategory struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// denoms are the money markets in the category. The category only applies to an account while all of its deposits
+	// and borrows are in these denoms.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// loan_to_value replaces the borrow limit loan-to-value of the category's money markets when borrowing.
+	LoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	// liquidation_threshold replaces the loan-to-value of the category's money markets when checking if a position can
+	// be liquidated or withdrawn from.
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold"`
+}
+
+func (m *EModeCategory) Reset()         { *m = EModeCategory{} }
+func (m *EModeCategory) String() string { return proto.CompactTextString(m) }
+func (*EModeCategory) ProtoMessage()    {}
+func (*EModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{15}
+}
+func (m *EModeCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EModeCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EModeCategory.Merge(m, src)
+}
+func (m *EModeCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EModeCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EModeCategory proto.InternalMessageInfo
+
+// AccountEMode defines the e-mode category an account has opted in to.
+type AccountEMode struct {
+	Account  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=account,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"account,omitempty"`
+	Category string                                        `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *AccountEMode) Reset()         { *m = AccountEMode{} }
+func (m *AccountEMode) String() string { return proto.CompactTextString(m) }
+func (*AccountEMode) ProtoMessage()    {}
+func (*AccountEMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71d78220d7e9a866, []int{16}
+}
+func (m *AccountEMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountEMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountEMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountEMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountEMode.Merge(m, src)
+}
+func (m *AccountEMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountEMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountEMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountEMode proto.InternalMessageInfo
+
 // CoinsProto defines a Protobuf wrapper around a Coins slice
 type CoinsProto struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
@@ -357,7 +776,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71d78220d7e9a866, []int{8}
+	return fileDescriptor_71d78220d7e9a866, []int{17}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,76 +809,142 @@ func init() {
 	proto.RegisterType((*Params)(nil), "fury.jinx.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "fury.jinx.v1beta1.MoneyMarket")
 	proto.RegisterType((*BorrowLimit)(nil), "fury.jinx.v1beta1.BorrowLimit")
+	proto.RegisterType((*SupplyLimit)(nil), "fury.jinx.v1beta1.SupplyLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "fury.jinx.v1beta1.InterestRateModel")
+	proto.RegisterType((*InterestRateKink)(nil), "fury.jinx.v1beta1.InterestRateKink")
 	proto.RegisterType((*Deposit)(nil), "fury.jinx.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "fury.jinx.v1beta1.Borrow")
 	proto.RegisterType((*SupplyInterestFactor)(nil), "fury.jinx.v1beta1.SupplyInterestFactor")
 	proto.RegisterType((*BorrowInterestFactor)(nil), "fury.jinx.v1beta1.BorrowInterestFactor")
+	proto.RegisterType((*IsolatedMarket)(nil), "fury.jinx.v1beta1.IsolatedMarket")
+	proto.RegisterType((*IsolatedPosition)(nil), "fury.jinx.v1beta1.IsolatedPosition")
+	proto.RegisterType((*IsolatedMarketState)(nil), "fury.jinx.v1beta1.IsolatedMarketState")
+	proto.RegisterType((*IsolatedAuction)(nil), "fury.jinx.v1beta1.IsolatedAuction")
+	proto.RegisterType((*IsolatedAccumulationTime)(nil), "fury.jinx.v1beta1.IsolatedAccumulationTime")
+	proto.RegisterType((*EModeCategory)(nil), "fury.jinx.v1beta1.EModeCategory")
+	proto.RegisterType((*AccountEMode)(nil), "fury.jinx.v1beta1.AccountEMode")
 	proto.RegisterType((*CoinsProto)(nil), "fury.jinx.v1beta1.CoinsProto")
 }
 
 func init() { proto.RegisterFile("fury/jinx/v1beta1/jinx.proto", fileDescriptor_71d78220d7e9a866) }
 
 var fileDescriptor_71d78220d7e9a866 = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x8f, 0x1b, 0x35,
-	0x14, 0xcf, 0x6c, 0x3e, 0xda, 0x3a, 0xc9, 0xd2, 0xb8, 0xbb, 0x68, 0x5a, 0xc1, 0xa4, 0x8a, 0x10,
-	0xac, 0x90, 0x92, 0x50, 0x10, 0x9c, 0xb8, 0xec, 0x10, 0x41, 0x57, 0x10, 0x29, 0x9a, 0xa5, 0x48,
-	0xad, 0x90, 0x06, 0x67, 0xc6, 0xbb, 0xeb, 0x26, 0x1e, 0x8f, 0x6c, 0x4f, 0x9a, 0xdc, 0xb8, 0x72,
-	0x41, 0xfc, 0x11, 0x9c, 0xb8, 0x21, 0xed, 0x1f, 0xb1, 0xc7, 0xaa, 0x27, 0xc4, 0x21, 0x40, 0xf6,
-	0xc6, 0x99, 0x13, 0x27, 0xe4, 0x8f, 0x7c, 0x74, 0x9b, 0x4a, 0x5d, 0x6d, 0x84, 0x7a, 0x9a, 0xb1,
-	0xdf, 0xf3, 0xef, 0xfd, 0xde, 0xcf, 0xcf, 0xf6, 0x03, 0x6f, 0x1d, 0x65, 0x7c, 0xd2, 0x7e, 0x4c,
-	0x92, 0x71, 0x7b, 0x74, 0xaf, 0x8f, 0x25, 0xba, 0xa7, 0x07, 0xad, 0x94, 0x33, 0xc9, 0x60, 0x4d,
-	0x59, 0x5b, 0x7a, 0xc2, 0x5a, 0xef, 0x78, 0x11, 0x13, 0x94, 0x89, 0x76, 0x1f, 0x09, 0xbc, 0x58,
-	0x12, 0x31, 0x92, 0x98, 0x25, 0x77, 0x6e, 0x1b, 0x7b, 0xa8, 0x47, 0x6d, 0x33, 0xb0, 0xa6, 0x9d,
-	0x63, 0x76, 0xcc, 0xcc, 0xbc, 0xfa, 0x33, 0xb3, 0x8d, 0x7f, 0x1c, 0x50, 0xea, 0x21, 0x8e, 0xa8,
-	0x80, 0x0f, 0x41, 0x95, 0xb2, 0x04, 0x4f, 0x42, 0x8a, 0xf8, 0x00, 0x4b, 0xe1, 0x3a, 0x77, 0xf3,
-	0x7b, 0xe5, 0x0f, 0xbd, 0xd6, 0x0b, 0x34, 0x5a, 0x5d, 0xe5, 0xd7, 0xd5, 0x6e, 0xfe, 0xce, 0xd9,
-	0xb4, 0x9e, 0xfb, 0xe5, 0x8f, 0x7a, 0x65, 0x65, 0x52, 0x04, 0x15, 0xba, 0x32, 0x82, 0x3f, 0x3a,
-	0xc0, 0xa5, 0x24, 0x21, 0x34, 0xa3, 0x61, 0x9f, 0x71, 0xce, 0x9e, 0x84, 0x99, 0x88, 0xc3, 0x11,
-	0x1a, 0x66, 0xd8, 0xdd, 0xba, 0xeb, 0xec, 0xdd, 0xf0, 0x1f, 0x28, 0x98, 0xdf, 0xa7, 0xf5, 0x77,
-	0x8f, 0x89, 0x3c, 0xc9, 0xfa, 0xad, 0x88, 0x51, 0xcb, 0xdf, 0x7e, 0x9a, 0x22, 0x1e, 0xb4, 0xe5,
-	0x24, 0xc5, 0xa2, 0xd5, 0xc1, 0xd1, 0x6c, 0x5a, 0xdf, 0xed, 0x1a, 0x44, 0x5f, 0x03, 0x3e, 0x38,
-	0xec, 0x7c, 0xa3, 0xe0, 0x9e, 0x9d, 0x36, 0x81, 0xcd, 0xbb, 0x83, 0xa3, 0x60, 0x97, 0x3e, 0xe7,
-	0x24, 0x62, 0xed, 0xd4, 0x38, 0x2b, 0x80, 0xf2, 0x0a, 0x5f, 0xb8, 0x03, 0x8a, 0x31, 0x4e, 0x18,
-	0x75, 0x1d, 0x45, 0x26, 0x30, 0x03, 0xf8, 0x05, 0xa8, 0x58, 0xb6, 0x43, 0x42, 0x89, 0xd4, 0x4c,
-	0xd7, 0x0b, 0x62, 0xe0, 0xbf, 0x52, 0x5e, 0x7e, 0x41, 0x65, 0x12, 0x94, 0xfb, 0xcb, 0x29, 0xf8,
-	0x09, 0xd8, 0x16, 0x29, 0x93, 0x56, 0xd9, 0x90, 0xc4, 0x6e, 0x5e, 0x27, 0x7d, 0x73, 0x36, 0xad,
-	0x57, 0x0e, 0x53, 0x26, 0x0d, 0x8d, 0x83, 0x4e, 0x50, 0x11, 0xcb, 0x51, 0x0c, 0x09, 0xa8, 0x45,
-	0x2c, 0x19, 0x61, 0x2e, 0x08, 0x4b, 0xc2, 0x23, 0x14, 0x49, 0xc6, 0xdd, 0x82, 0x5e, 0xfa, 0xe9,
-	0x25, 0xf4, 0x3a, 0x48, 0xe4, 0x8a, 0x2c, 0x07, 0x89, 0x0c, 0x6e, 0x2e, 0x61, 0x3f, 0xd7, 0xa8,
-	0xf0, 0x11, 0xb8, 0x45, 0x12, 0x89, 0x39, 0x16, 0x32, 0xe4, 0x48, 0xe2, 0x90, 0xb2, 0x18, 0x0f,
-	0xdd, 0xa2, 0x4e, 0xf9, 0x9d, 0x35, 0x29, 0x1f, 0x58, 0xef, 0x00, 0x49, 0xdc, 0x55, 0xbe, 0x36,
-	0xf1, 0x1a, 0xb9, 0x68, 0x80, 0x11, 0xd8, 0xe6, 0x58, 0x60, 0x3e, 0xc2, 0xf3, 0x1c, 0x4a, 0x97,
-	0xce, 0xa1, 0x83, 0xa3, 0x0b, 0x5b, 0x5b, 0xb5, 0x98, 0x36, 0x81, 0x11, 0x70, 0x07, 0x18, 0xa7,
-	0x98, 0x87, 0x1c, 0x3f, 0x41, 0x3c, 0x0e, 0x53, 0xcc, 0x23, 0x9c, 0x48, 0x74, 0x8c, 0xdd, 0x6b,
-	0x1b, 0x08, 0xf7, 0xa6, 0x41, 0x0f, 0x34, 0x78, 0x6f, 0x81, 0xdd, 0xf8, 0x61, 0x0b, 0x94, 0x57,
-	0xb6, 0x1f, 0x7e, 0x0c, 0xaa, 0x27, 0x48, 0x84, 0x14, 0x8d, 0x6d, 0xd5, 0xa8, 0x92, 0xba, 0xee,
-	0xd7, 0xfe, 0x9e, 0xd6, 0x9f, 0x37, 0x04, 0xe5, 0x13, 0x24, 0xba, 0x68, 0x6c, 0x96, 0x21, 0x50,
-	0xa5, 0x68, 0xac, 0x4f, 0xc8, 0xb2, 0xd8, 0xae, 0xca, 0xb9, 0x62, 0x21, 0x4d, 0x88, 0xef, 0x40,
-	0x75, 0xc8, 0x50, 0x12, 0x4a, 0x66, 0x4f, 0x5e, 0x7e, 0x03, 0x21, 0xca, 0x0a, 0xf2, 0x6b, 0x66,
-	0x8e, 0xd5, 0xcf, 0x79, 0x50, 0x7b, 0xa1, 0x2e, 0x20, 0x03, 0x55, 0x75, 0x5f, 0x99, 0xb2, 0x42,
-	0xe9, 0xc4, 0x1c, 0x32, 0xff, 0xcb, 0x4b, 0x9f, 0xf8, 0xb2, 0x8f, 0x04, 0x56, 0xb8, 0xfb, 0xbd,
-	0x87, 0x17, 0x69, 0xf4, 0xe7, 0xa6, 0x74, 0x02, 0x31, 0x78, 0x43, 0x07, 0xa4, 0xd9, 0x50, 0x92,
-	0x74, 0x48, 0x30, 0xdf, 0x88, 0x9a, 0xdb, 0x0a, 0xb4, 0xbb, 0xc0, 0x84, 0x3d, 0x50, 0x18, 0x90,
-	0x64, 0xb0, 0x11, 0x19, 0x35, 0x92, 0x22, 0xfe, 0x38, 0xa3, 0xe9, 0x2a, 0xf1, 0xc2, 0x26, 0x88,
-	0x2b, 0xd0, 0x25, 0xf1, 0xc6, 0xe9, 0x16, 0xb8, 0xd6, 0xc1, 0x29, 0x13, 0x44, 0xc2, 0x23, 0x70,
-	0x23, 0x36, 0xbf, 0x8c, 0xdb, 0x8d, 0xb9, 0xff, 0xef, 0xb4, 0xde, 0x7c, 0x85, 0x40, 0xfb, 0x51,
-	0xb4, 0x1f, 0xc7, 0x1c, 0x0b, 0xf1, 0xec, 0xb4, 0x79, 0xcb, 0xc6, 0xb3, 0x33, 0xfe, 0x44, 0x62,
-	0x11, 0x2c, 0xa1, 0x61, 0x04, 0x4a, 0x88, 0xb2, 0x2c, 0x51, 0x85, 0xad, 0x9e, 0x95, 0xdb, 0x2d,
-	0xbb, 0x40, 0x89, 0xba, 0xb8, 0x54, 0x3e, 0x63, 0x24, 0xf1, 0x3f, 0xb0, 0x2f, 0xca, 0xde, 0x2b,
-	0x70, 0x50, 0x0b, 0x44, 0x60, 0xa1, 0xe1, 0xb7, 0xa0, 0x48, 0x92, 0x18, 0x8f, 0xdd, 0xbc, 0x8e,
-	0xf1, 0xde, 0x9a, 0x6b, 0xeb, 0x30, 0x4b, 0xd3, 0xe1, 0x64, 0x5e, 0xa4, 0xe6, 0xee, 0xf0, 0xdf,
-	0xb6, 0x11, 0x77, 0xd7, 0x59, 0x45, 0x60, 0x40, 0x1b, 0xbf, 0x6e, 0x81, 0x92, 0x39, 0xe9, 0x30,
-	0x06, 0xd7, 0xcd, 0xfd, 0x8e, 0x37, 0x2f, 0xda, 0x02, 0xf9, 0xb5, 0xd1, 0xcc, 0x24, 0xfd, 0x32,
-	0xcd, 0xd6, 0x59, 0x17, 0x9a, 0x7d, 0xef, 0x80, 0x9d, 0x75, 0xa2, 0xbe, 0xe4, 0xc5, 0x0d, 0x40,
-	0x71, 0xb5, 0x29, 0xb8, 0x5a, 0xd9, 0x1b, 0x28, 0x4d, 0x61, 0x1d, 0xc7, 0xff, 0x91, 0x02, 0x03,
-	0x40, 0x8b, 0xde, 0xd3, 0x7d, 0x1d, 0x02, 0x45, 0xd5, 0xb2, 0xcd, 0x1b, 0xac, 0x8d, 0xee, 0xaa,
-	0x41, 0xf6, 0xef, 0x9f, 0xfd, 0xe5, 0xe5, 0xce, 0x66, 0x9e, 0xf3, 0x74, 0xe6, 0x39, 0x7f, 0xce,
-	0x3c, 0xe7, 0xa7, 0x73, 0x2f, 0xf7, 0xf4, 0xdc, 0xcb, 0xfd, 0x76, 0xee, 0xe5, 0x1e, 0xbd, 0xbf,
-	0x02, 0xa7, 0x5e, 0x49, 0x26, 0x88, 0x68, 0x0e, 0x51, 0x5f, 0xb4, 0x75, 0x3f, 0x3a, 0x36, 0x1d,
-	0xa9, 0x86, 0xed, 0x97, 0x74, 0x9f, 0xf8, 0xd1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xea, 0x8d,
-	0xe8, 0xe7, 0xab, 0x0a, 0x00, 0x00,
+	// 1839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xf7, 0x7c, 0x7a, 0xfc, 0xe6, 0xc3, 0x9e, 0x5a, 0xdb, 0xf4, 0xae, 0x92, 0x99, 0xdd, 0x09,
+	0x82, 0x15, 0xc1, 0x63, 0x42, 0x04, 0x27, 0x2e, 0xee, 0x1d, 0x92, 0x58, 0x89, 0x25, 0xab, 0xed,
+	0x45, 0x24, 0x42, 0x69, 0x6a, 0xba, 0xcb, 0xe3, 0x5a, 0x77, 0x77, 0x75, 0xba, 0xaa, 0xbd, 0x1e,
+	0x24, 0x24, 0xae, 0x48, 0x28, 0xda, 0xbf, 0x03, 0x21, 0x24, 0xa4, 0xfd, 0x0b, 0x38, 0xed, 0x31,
+	0xe4, 0x10, 0x21, 0x0e, 0x0e, 0x78, 0x39, 0xe5, 0xc0, 0x19, 0x71, 0x42, 0xf5, 0x31, 0x33, 0x3d,
+	0xde, 0x99, 0x90, 0x55, 0xda, 0x28, 0xa7, 0x99, 0x7a, 0xf5, 0xea, 0xf7, 0x3e, 0xaa, 0xde, 0x7b,
+	0x55, 0xaf, 0xe1, 0x95, 0x93, 0x34, 0x19, 0xef, 0x3e, 0xa2, 0xd1, 0xc5, 0xee, 0xf9, 0x1b, 0x43,
+	0x22, 0xf0, 0x1b, 0x6a, 0xd0, 0x8f, 0x13, 0x26, 0x18, 0x6a, 0xcb, 0xd9, 0xbe, 0x22, 0x98, 0xd9,
+	0x3b, 0x1d, 0x8f, 0xf1, 0x90, 0xf1, 0xdd, 0x21, 0xe6, 0x64, 0xba, 0xc4, 0x63, 0x34, 0xd2, 0x4b,
+	0xee, 0xdc, 0xd6, 0xf3, 0xae, 0x1a, 0xed, 0xea, 0x81, 0x99, 0xda, 0x1c, 0xb1, 0x11, 0xd3, 0x74,
+	0xf9, 0xcf, 0x50, 0xbb, 0x23, 0xc6, 0x46, 0x01, 0xd9, 0x55, 0xa3, 0x61, 0x7a, 0xb2, 0x2b, 0x68,
+	0x48, 0xb8, 0xc0, 0x61, 0xac, 0x19, 0x7a, 0x7f, 0x2e, 0x41, 0xf5, 0x10, 0x27, 0x38, 0xe4, 0xe8,
+	0x7d, 0x68, 0x86, 0x2c, 0x22, 0x63, 0x37, 0xc4, 0xc9, 0x19, 0x11, 0xdc, 0x2a, 0xdc, 0x2d, 0xdd,
+	0xaf, 0xff, 0xb0, 0xd3, 0x7f, 0x41, 0xcf, 0xfe, 0x81, 0xe4, 0x3b, 0x50, 0x6c, 0xf6, 0xe6, 0xb3,
+	0xcb, 0xee, 0xca, 0xef, 0x3f, 0xef, 0x36, 0x32, 0x44, 0xee, 0x34, 0xc2, 0xcc, 0x08, 0x7d, 0x5c,
+	0x00, 0x2b, 0xa4, 0x11, 0x0d, 0xd3, 0xd0, 0x1d, 0xb2, 0x24, 0x61, 0x8f, 0xdd, 0x94, 0xfb, 0xee,
+	0x39, 0x0e, 0x52, 0x62, 0x15, 0xef, 0x16, 0xee, 0xaf, 0xd9, 0x0f, 0x25, 0xcc, 0xdf, 0x2e, 0xbb,
+	0xdf, 0x19, 0x51, 0x71, 0x9a, 0x0e, 0xfb, 0x1e, 0x0b, 0x8d, 0x81, 0xe6, 0x67, 0x87, 0xfb, 0x67,
+	0xbb, 0x62, 0x1c, 0x13, 0xde, 0x1f, 0x10, 0xef, 0xea, 0xb2, 0xbb, 0x75, 0xa0, 0x11, 0x6d, 0x05,
+	0xf8, 0xf0, 0x68, 0xf0, 0x33, 0x09, 0xf7, 0xe9, 0xd3, 0x1d, 0x30, 0x8e, 0x19, 0x10, 0xcf, 0xd9,
+	0x0a, 0xe7, 0x98, 0xb8, 0xaf, 0x98, 0x10, 0x81, 0x0d, 0xca, 0x59, 0x80, 0x05, 0xf1, 0xa7, 0xe6,
+	0x96, 0x94, 0xb9, 0xf7, 0x16, 0x98, 0xbb, 0x6f, 0x58, 0x8d, 0xc5, 0xdf, 0x32, 0x16, 0xaf, 0xcf,
+	0xd3, 0xb9, 0xb3, 0x4e, 0xe7, 0x09, 0x88, 0x40, 0x9b, 0xb8, 0x21, 0xf3, 0x89, 0xeb, 0x61, 0x41,
+	0x46, 0x2c, 0xa1, 0x84, 0x5b, 0x65, 0x25, 0xe7, 0xee, 0x02, 0x39, 0x3f, 0x3d, 0x60, 0x3e, 0x79,
+	0xa0, 0x39, 0xc7, 0x33, 0x31, 0x59, 0x32, 0x25, 0xdc, 0x59, 0x27, 0xf3, 0x84, 0xde, 0x3f, 0x57,
+	0xa1, 0x9e, 0xf1, 0x3e, 0xda, 0x84, 0x8a, 0x4f, 0x22, 0x16, 0x5a, 0x05, 0xe9, 0x5a, 0x47, 0x0f,
+	0xd0, 0xdb, 0xd0, 0x30, 0xbe, 0x0f, 0x68, 0x48, 0x85, 0xf2, 0xfb, 0xe2, 0xed, 0xd5, 0xce, 0x7a,
+	0x4f, 0x72, 0xd9, 0x65, 0xa9, 0x85, 0x53, 0x1f, 0xce, 0x48, 0xe8, 0xc7, 0xd0, 0xe2, 0x31, 0x13,
+	0xc6, 0x71, 0x2e, 0xf5, 0xad, 0x92, 0xda, 0xc2, 0x8d, 0xab, 0xcb, 0x6e, 0xe3, 0x28, 0x66, 0x42,
+	0xab, 0xb1, 0x3f, 0x70, 0x1a, 0x7c, 0x36, 0xf2, 0x11, 0x85, 0xb6, 0xc7, 0xa2, 0x73, 0x92, 0x70,
+	0xca, 0x22, 0xf7, 0x04, 0x7b, 0x82, 0x25, 0x56, 0x59, 0x2d, 0xfd, 0xc9, 0x4b, 0xec, 0xfe, 0x7e,
+	0x24, 0x32, 0x9b, 0xbc, 0x1f, 0x09, 0x67, 0x63, 0x06, 0xfb, 0x96, 0x42, 0x45, 0x1f, 0xc0, 0x2d,
+	0x1a, 0x09, 0x92, 0x10, 0x2e, 0xdc, 0x04, 0x0b, 0xbd, 0x09, 0x81, 0x55, 0x51, 0x26, 0x7f, 0x7b,
+	0xd1, 0x16, 0x1b, 0x6e, 0x07, 0x0b, 0xe5, 0xdd, 0xc0, 0x18, 0xde, 0xa6, 0xd7, 0x27, 0x90, 0x07,
+	0xad, 0x84, 0x70, 0x92, 0x9c, 0x93, 0x89, 0x0d, 0xd5, 0x97, 0xb6, 0x61, 0x40, 0xbc, 0x6b, 0x07,
+	0xb5, 0x69, 0x30, 0x8d, 0x01, 0xe7, 0x60, 0x9d, 0x11, 0x12, 0x93, 0xc4, 0x4d, 0xc8, 0x63, 0x9c,
+	0xf8, 0x6e, 0x4c, 0x12, 0x8f, 0x44, 0x02, 0x8f, 0x88, 0xb5, 0x9a, 0x83, 0xb8, 0x6d, 0x8d, 0xee,
+	0x28, 0xf0, 0xc3, 0x29, 0x36, 0xba, 0x07, 0x0d, 0x9c, 0x7a, 0x42, 0x6e, 0x90, 0x5c, 0x6a, 0xd5,
+	0xd4, 0x09, 0xaa, 0x1b, 0xda, 0xf1, 0x38, 0x26, 0xc8, 0x85, 0x86, 0x17, 0x30, 0x3e, 0xb5, 0x7e,
+	0x2d, 0x07, 0x75, 0xea, 0x0a, 0xd1, 0xd8, 0x4e, 0xa1, 0x1d, 0xd0, 0x8f, 0x52, 0xea, 0x63, 0xa5,
+	0xc7, 0x90, 0x45, 0x29, 0xb7, 0x20, 0x07, 0x29, 0x1b, 0x19, 0x58, 0x5b, 0xa2, 0xa2, 0x21, 0xb4,
+	0x4e, 0x02, 0xcc, 0x4f, 0xdd, 0x80, 0xe1, 0xc8, 0x3d, 0x21, 0xc4, 0xaa, 0xe7, 0x20, 0xa7, 0xa1,
+	0x30, 0xdf, 0x63, 0x38, 0x7a, 0x8b, 0x10, 0x19, 0x77, 0x3c, 0x8d, 0xe3, 0x60, 0x6c, 0xe2, 0xae,
+	0xb1, 0x34, 0xee, 0x8e, 0x14, 0xdb, 0x5c, 0xdc, 0xf1, 0x19, 0xa9, 0xf7, 0xdb, 0x22, 0xd4, 0x33,
+	0xa1, 0x89, 0x7e, 0x04, 0xcd, 0x53, 0xcc, 0xdd, 0x10, 0x5f, 0x18, 0x64, 0x19, 0xee, 0x35, 0xbb,
+	0xfd, 0xc5, 0x65, 0x77, 0x7e, 0xc2, 0xa9, 0x9f, 0x62, 0x7e, 0x80, 0x2f, 0xf4, 0x32, 0x0c, 0xcd,
+	0x10, 0x5f, 0xa8, 0x5c, 0x3c, 0x4b, 0x04, 0x5f, 0xdb, 0x64, 0x03, 0xa9, 0x45, 0xfc, 0x12, 0x9a,
+	0xca, 0xa1, 0x82, 0x99, 0x1c, 0x5f, 0xca, 0xe3, 0x8c, 0x48, 0xc8, 0x63, 0xa6, 0x12, 0x78, 0xef,
+	0x5f, 0x45, 0xa8, 0x67, 0xdc, 0xf5, 0x0d, 0xf6, 0xc5, 0x43, 0xb0, 0x26, 0x0a, 0x78, 0x2c, 0x90,
+	0xe5, 0x21, 0xc1, 0x41, 0xc6, 0x2d, 0x35, 0xfb, 0x95, 0x2f, 0x2e, 0xbb, 0x4b, 0x79, 0x9c, 0x2d,
+	0xad, 0xef, 0x83, 0x29, 0x5d, 0x57, 0xb0, 0x08, 0x36, 0x17, 0x42, 0x96, 0x73, 0x30, 0x00, 0x85,
+	0x2f, 0xc8, 0xeb, 0xfd, 0xa1, 0x06, 0xed, 0x17, 0x92, 0x24, 0x62, 0xd0, 0x94, 0x77, 0x15, 0x9d,
+	0x63, 0x71, 0x3c, 0xd6, 0x15, 0xc7, 0x7e, 0xf7, 0xa5, 0x8b, 0x79, 0xdd, 0xc6, 0x9c, 0x48, 0xdc,
+	0xbd, 0xc3, 0xf7, 0xaf, 0xef, 0xfb, 0x70, 0x32, 0x15, 0x8f, 0x11, 0x81, 0x75, 0x25, 0x30, 0x4c,
+	0x03, 0x41, 0xe3, 0x80, 0x92, 0x24, 0x97, 0x2d, 0x6b, 0x49, 0xd0, 0x83, 0x29, 0x26, 0x3a, 0x84,
+	0xf2, 0x19, 0x8d, 0xce, 0x72, 0x39, 0xb7, 0x0a, 0x49, 0x2a, 0xfe, 0x28, 0x0d, 0xe3, 0xac, 0xe2,
+	0x79, 0x6c, 0x55, 0x4b, 0x82, 0x66, 0x14, 0x7f, 0x15, 0x40, 0x95, 0x3a, 0x9d, 0xbd, 0x2b, 0x2a,
+	0x7b, 0xaf, 0x29, 0x8a, 0xca, 0xdd, 0xc7, 0x50, 0x91, 0xda, 0x70, 0xab, 0xaa, 0x2e, 0x21, 0xaf,
+	0xfd, 0x8f, 0x4a, 0xf8, 0x2e, 0x8d, 0xce, 0xec, 0xdb, 0xe6, 0x1e, 0xd2, 0xbe, 0x3e, 0xc3, 0x1d,
+	0x0d, 0x86, 0xce, 0x00, 0x09, 0x9c, 0x8c, 0x88, 0x70, 0x53, 0x41, 0x03, 0xfa, 0x2b, 0x95, 0x60,
+	0x73, 0x29, 0x53, 0x6d, 0x8d, 0xfb, 0x70, 0x06, 0x8b, 0x46, 0xb0, 0x81, 0xfd, 0x47, 0x29, 0x17,
+	0x21, 0x89, 0x84, 0xcb, 0x63, 0x42, 0x7c, 0xab, 0x96, 0x83, 0xa8, 0xf5, 0x19, 0xea, 0x91, 0x04,
+	0x45, 0x14, 0x50, 0x48, 0x23, 0x73, 0xb4, 0x85, 0xab, 0x35, 0xc9, 0xa5, 0xda, 0xad, 0x87, 0x34,
+	0x52, 0x07, 0x5a, 0x1c, 0x2b, 0x50, 0x25, 0x0a, 0x5f, 0x5c, 0x17, 0x05, 0xb9, 0x88, 0xc2, 0x17,
+	0x73, 0xa2, 0x08, 0xac, 0x7b, 0xa9, 0xbc, 0xbb, 0x70, 0x41, 0x48, 0x1c, 0x11, 0xce, 0x73, 0x29,
+	0x79, 0x2d, 0x05, 0x7a, 0x34, 0xc1, 0xec, 0x7d, 0x56, 0x80, 0x8d, 0xeb, 0xe7, 0x05, 0x7d, 0x08,
+	0xf5, 0xec, 0x01, 0x29, 0xe4, 0x51, 0x14, 0x32, 0x80, 0x68, 0x08, 0xb5, 0x69, 0x22, 0xd2, 0x59,
+	0xe1, 0xed, 0x97, 0x4e, 0x44, 0xab, 0x8b, 0x93, 0xd0, 0x6a, 0xa2, 0x13, 0x50, 0xef, 0x69, 0x11,
+	0x56, 0x07, 0x24, 0x66, 0x9c, 0x0a, 0x74, 0x02, 0x6b, 0xbe, 0xfe, 0xcb, 0x12, 0x63, 0xcd, 0x3b,
+	0xff, 0xb9, 0xec, 0xee, 0x7c, 0x05, 0x61, 0x7b, 0x9e, 0xb7, 0xe7, 0xfb, 0x09, 0xe1, 0xfc, 0xd3,
+	0xa7, 0x3b, 0xb7, 0x8c, 0x20, 0x43, 0xb1, 0xc7, 0x82, 0x70, 0x67, 0x06, 0x8d, 0x3c, 0xa8, 0xe2,
+	0x90, 0xa5, 0x91, 0x2c, 0x4f, 0x32, 0x6c, 0x6f, 0xf7, 0xcd, 0x02, 0x99, 0xb5, 0xa6, 0x81, 0xfb,
+	0x80, 0xd1, 0xc8, 0xfe, 0x81, 0x09, 0xd6, 0xfb, 0x5f, 0x41, 0x07, 0xb9, 0x80, 0x3b, 0x06, 0x1a,
+	0xfd, 0x02, 0x2a, 0x34, 0xf2, 0xc9, 0x85, 0x79, 0x07, 0x7d, 0x77, 0xe9, 0xfd, 0x64, 0xb2, 0xad,
+	0xfa, 0xb6, 0x66, 0xbf, 0x6a, 0x24, 0x6e, 0x2d, 0x9a, 0xe5, 0x8e, 0x06, 0xed, 0xfd, 0xa9, 0x08,
+	0x55, 0x7d, 0x77, 0x41, 0x3e, 0xd4, 0xf4, 0x6b, 0x82, 0xe4, 0xef, 0xb4, 0x29, 0xf2, 0x37, 0xc6,
+	0x67, 0xda, 0xe8, 0x65, 0x3e, 0x5b, 0x34, 0x3b, 0xf5, 0xd9, 0x6f, 0x0a, 0xb0, 0xb9, 0xc8, 0xa9,
+	0x4b, 0xde, 0x77, 0x0e, 0x54, 0xb2, 0x0f, 0xea, 0xaf, 0x17, 0x57, 0x1a, 0x4a, 0xa9, 0xb0, 0x48,
+	0xc7, 0xff, 0xa3, 0x0a, 0x7f, 0x29, 0x40, 0x6b, 0xfe, 0xa1, 0x8d, 0x10, 0x94, 0x23, 0x1c, 0x12,
+	0x23, 0x5b, 0xfd, 0x7f, 0xb1, 0x7b, 0x51, 0xcc, 0xad, 0x7b, 0xf1, 0xba, 0x7c, 0xb7, 0x4e, 0xaf,
+	0x59, 0xca, 0x52, 0xdd, 0x2d, 0x58, 0x73, 0x36, 0x66, 0x13, 0x03, 0x45, 0x47, 0xaf, 0x41, 0xd3,
+	0xbc, 0xb2, 0x0d, 0x63, 0x59, 0x31, 0x9a, 0xa7, 0xb7, 0x66, 0xea, 0xfd, 0xb1, 0x0c, 0x1b, 0x13,
+	0x9b, 0x0e, 0x65, 0x90, 0xcb, 0xec, 0xb5, 0x0d, 0x55, 0xad, 0xbb, 0xb1, 0xcb, 0x8c, 0xd0, 0x87,
+	0x50, 0x61, 0x8f, 0xa3, 0xe9, 0x45, 0x27, 0xbf, 0x60, 0xd1, 0xb0, 0x88, 0xc0, 0xaa, 0x49, 0x35,
+	0x56, 0x29, 0xff, 0x50, 0x99, 0x60, 0xa3, 0x33, 0x68, 0x9a, 0xbf, 0xae, 0x8e, 0x99, 0x72, 0xae,
+	0x79, 0xa6, 0x61, 0xc0, 0xf7, 0x25, 0xb6, 0x8c, 0x7e, 0xed, 0x70, 0xab, 0x72, 0x03, 0xd1, 0xaf,
+	0xa1, 0x11, 0x9d, 0x36, 0x54, 0xb4, 0x41, 0xd5, 0x5c, 0x93, 0x80, 0x69, 0xb9, 0x28, 0x7b, 0x7a,
+	0xff, 0x2e, 0xc3, 0xad, 0xf9, 0x20, 0x38, 0x12, 0x58, 0x90, 0xa5, 0x67, 0x26, 0x81, 0x96, 0x60,
+	0x02, 0x07, 0xae, 0x7a, 0x3f, 0x52, 0xe2, 0xdf, 0x44, 0x16, 0x6c, 0x2a, 0x11, 0x47, 0x46, 0xc2,
+	0x4c, 0xa6, 0xc9, 0xc1, 0xbe, 0x55, 0xba, 0x29, 0x99, 0xb6, 0x91, 0x30, 0x93, 0x69, 0xba, 0x27,
+	0x93, 0xee, 0xda, 0x0d, 0xc8, 0x74, 0x8c, 0x04, 0xf4, 0x6b, 0x40, 0xd8, 0xf3, 0xd2, 0x30, 0x0d,
+	0x74, 0x7f, 0x42, 0xb5, 0x54, 0xcd, 0x39, 0x7b, 0xfd, 0x4b, 0xba, 0x87, 0x7b, 0x99, 0x45, 0xc7,
+	0x34, 0x24, 0xf6, 0x3d, 0xa3, 0xc9, 0xed, 0x65, 0x1c, 0xdc, 0x69, 0xe3, 0xeb, 0x24, 0x14, 0xcd,
+	0x3a, 0x34, 0x3e, 0x19, 0x0a, 0xab, 0x9a, 0xbf, 0xc1, 0x93, 0x76, 0xcf, 0x80, 0x0c, 0x45, 0xef,
+	0x77, 0x05, 0x98, 0x36, 0x3a, 0xf7, 0x34, 0x1d, 0x7d, 0x1f, 0x60, 0xa2, 0x03, 0xf5, 0xd5, 0xd1,
+	0x2b, 0xdb, 0xcd, 0xab, 0xcb, 0xee, 0x9a, 0x61, 0xd8, 0x1f, 0x38, 0x6b, 0x86, 0x61, 0xdf, 0xcf,
+	0x1c, 0xd2, 0xe2, 0xdc, 0x21, 0x7d, 0x13, 0xca, 0xca, 0x82, 0xd2, 0xdd, 0xc2, 0x97, 0x5b, 0xa0,
+	0x7b, 0x21, 0x8a, 0xb9, 0xf7, 0x59, 0x09, 0xac, 0x65, 0xfe, 0x5a, 0x52, 0x95, 0x7e, 0x0e, 0x5b,
+	0x71, 0x42, 0xce, 0x29, 0x4b, 0xb9, 0x8b, 0x3d, 0x2f, 0x49, 0x71, 0xa0, 0x36, 0xcd, 0x74, 0x40,
+	0xef, 0xf4, 0x75, 0x93, 0xbc, 0x3f, 0x69, 0x92, 0xf7, 0x8f, 0x27, 0x4d, 0x72, 0xbb, 0x26, 0x25,
+	0x3f, 0xf9, 0xbc, 0x5b, 0x70, 0x6e, 0x4d, 0x20, 0xf6, 0x34, 0x82, 0x92, 0x97, 0xc0, 0xb6, 0x69,
+	0xed, 0x4c, 0xbb, 0x8d, 0xa6, 0x29, 0x96, 0xc7, 0xc3, 0x71, 0x93, 0x2f, 0x2a, 0xfe, 0x09, 0x6c,
+	0x4f, 0xb3, 0xce, 0xbc, 0xcc, 0x3c, 0xde, 0x93, 0x9b, 0xc3, 0x45, 0xd5, 0x7e, 0x08, 0xad, 0x6b,
+	0x6f, 0x93, 0x4a, 0x1e, 0x7d, 0x92, 0x24, 0xf3, 0x30, 0xe9, 0x3d, 0x29, 0x42, 0x73, 0xae, 0x01,
+	0xbe, 0xb0, 0xcc, 0x6f, 0x43, 0xd5, 0xd4, 0xd5, 0xa2, 0xaa, 0xab, 0x66, 0x74, 0xf3, 0x1d, 0x27,
+	0xf4, 0x11, 0x6c, 0x65, 0xbb, 0x92, 0xe2, 0x34, 0x21, 0xfc, 0x94, 0x05, 0x7e, 0x3e, 0x6e, 0xcf,
+	0x40, 0x1f, 0x4f, 0x90, 0x7b, 0x1f, 0x17, 0xa0, 0xb1, 0xe7, 0x79, 0xf2, 0xaa, 0xa9, 0x3c, 0x83,
+	0x86, 0xb0, 0x8a, 0xf5, 0x38, 0xf7, 0x9b, 0xf3, 0x04, 0x18, 0xdd, 0x81, 0x9a, 0xf9, 0x58, 0x61,
+	0x1e, 0x51, 0xce, 0x74, 0xdc, 0x63, 0x00, 0x2a, 0x43, 0x1c, 0xaa, 0x0f, 0x58, 0x18, 0x2a, 0xf2,
+	0xdb, 0xd4, 0xe4, 0x43, 0x51, 0xae, 0x29, 0x48, 0x23, 0xdb, 0xef, 0x3c, 0xfb, 0x47, 0x67, 0xe5,
+	0xd9, 0x55, 0xa7, 0xf0, 0xc9, 0x55, 0xa7, 0xf0, 0xf7, 0xab, 0x4e, 0xe1, 0xc9, 0xf3, 0xce, 0xca,
+	0x27, 0xcf, 0x3b, 0x2b, 0x7f, 0x7d, 0xde, 0x59, 0xf9, 0xe0, 0x7b, 0x19, 0x38, 0xd9, 0x1f, 0x67,
+	0x9c, 0xf2, 0x9d, 0x00, 0x0f, 0xf9, 0xae, 0xfa, 0xf0, 0x76, 0xa1, 0x3f, 0xbd, 0x29, 0xd8, 0x61,
+	0x55, 0x45, 0xf7, 0x9b, 0xff, 0x1d, 0x00, 0xb8, 0xfb, 0x15, 0xcc, 0x94, 0x1b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -482,6 +967,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EModeCategories) > 0 {
+		for iNdEx := len(m.EModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EModeCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IsolatedMarkets) > 0 {
+		for iNdEx := len(m.IsolatedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.MinimumBorrowUSDValue.Size()
 		i -= size
@@ -529,6 +1042,53 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SupplyLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LiquidationBonus.Size()
+		i -= size
+		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -649,7 +1209,7 @@ func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
+func (m *SupplyLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -659,16 +1219,150 @@ func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
+func (m *SupplyLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SupplyLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCollateralValue.Size()
+		i -= size
+		if _, err := m.MaxCollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HasMaxCollateralValue {
+		i--
+		if m.HasMaxCollateralValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaximumLimit.Size()
+		i -= size
+		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.HasMaxLimit {
+		i--
+		if m.HasMaxLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurveSteepness.Size()
+		i -= size
+		if _, err := m.CurveSteepness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxRateAtTarget.Size()
+		i -= size
+		if _, err := m.MaxRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinRateAtTarget.Size()
+		i -= size
+		if _, err := m.MinRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AdjustmentSpeed.Size()
+		i -= size
+		if _, err := m.AdjustmentSpeed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TargetUtilization.Size()
+		i -= size
+		if _, err := m.TargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Kinks) > 0 {
+		for iNdEx := len(m.Kinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ModelType) > 0 {
+		i -= len(m.ModelType)
+		copy(dAtA[i:], m.ModelType)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.ModelType)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.JumpMultiplier.Size()
 		i -= size
@@ -712,6 +1406,49 @@ func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InterestRateKink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestRateKink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestRateKink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RateAPY.Size()
+		i -= size
+		if _, err := m.RateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -908,7 +1645,7 @@ func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+func (m *IsolatedMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -918,20 +1655,38 @@ func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsolatedMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IsolatedMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BorrowDenoms) > 0 {
+		for iNdEx := len(m.BorrowDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BorrowDenoms[iNdEx])
+			copy(dAtA[i:], m.BorrowDenoms[iNdEx])
+			i = encodeVarintJinx(dAtA, i, uint64(len(m.BorrowDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for iNdEx := len(m.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CollateralDenoms[iNdEx])
+			copy(dAtA[i:], m.CollateralDenoms[iNdEx])
+			i = encodeVarintJinx(dAtA, i, uint64(len(m.CollateralDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MoneyMarkets) > 0 {
+		for iNdEx := len(m.MoneyMarkets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MoneyMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -939,202 +1694,2921 @@ func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintJinx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintJinx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovJinx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IsolatedPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MoneyMarkets) > 0 {
-		for _, e := range m.MoneyMarkets {
-			l = e.Size()
-			n += 1 + l + sovJinx(uint64(l))
-		}
-	}
-	l = m.MinimumBorrowUSDValue.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	return n
+
+func (m *IsolatedPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoneyMarket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IsolatedPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovJinx(uint64(l))
-	}
-	l = m.BorrowLimit.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = len(m.SpotMarketID)
-	if l > 0 {
-		n += 1 + l + sovJinx(uint64(l))
+	if len(m.BorrowIndex) > 0 {
+		for iNdEx := len(m.BorrowIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BorrowIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = m.ConversionFactor.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.InterestRateModel.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.ReserveFactor.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.KeeperRewardPercentage.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	return n
-}
-
-func (m *BorrowLimit) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Borrow) > 0 {
+		for iNdEx := len(m.Borrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	if m.HasMaxLimit {
-		n += 2
+	if len(m.DepositIndex) > 0 {
+		for iNdEx := len(m.DepositIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.MaximumLimit.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.LoanToValue.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	return n
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IsolatedMarketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BaseRateAPY.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.BaseMultiplier.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.Kink.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	l = m.JumpMultiplier.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IsolatedMarketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedMarketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovJinx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovJinx(uint64(l))
+	if len(m.AuctionDebt) > 0 {
+		for iNdEx := len(m.AuctionDebt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionDebt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovJinx(uint64(l))
+	if len(m.AccumulationTimes) > 0 {
+		for iNdEx := len(m.AccumulationTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulationTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return n
-}
-
-func (m *Borrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovJinx(uint64(l))
+	if len(m.TotalReserves) > 0 {
+		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovJinx(uint64(l))
+	if len(m.TotalBorrowed) > 0 {
+		for iNdEx := len(m.TotalBorrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBorrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovJinx(uint64(l))
+	if len(m.TotalSupplied) > 0 {
+		for iNdEx := len(m.TotalSupplied) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSupplied[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SupplyInterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IsolatedAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolatedAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovJinx(uint64(l))
+	{
+		size, err := m.Debt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintJinx(dAtA, i, uint64(size))
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionID != 0 {
+		i = encodeVarintJinx(dAtA, i, uint64(m.AuctionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BorrowInterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IsolatedAccumulationTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolatedAccumulationTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovJinx(uint64(l))
+	{
+		size := m.RateAtTarget.Size()
+		i -= size
+		if _, err := m.RateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovJinx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BorrowInterestFactor.Size()
+		i -= size
+		if _, err := m.BorrowInterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SupplyInterestFactor.Size()
+		i -= size
+		if _, err := m.SupplyInterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccrualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccrualTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintJinx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CoinsProto) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovJinx(uint64(l))
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJinx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintJinx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountEMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountEMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountEMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintJinx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJinx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJinx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJinx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MoneyMarkets) > 0 {
+		for _, e := range m.MoneyMarkets {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	l = m.MinimumBorrowUSDValue.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	if len(m.IsolatedMarkets) > 0 {
+		for _, e := range m.IsolatedMarkets {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.EModeCategories) > 0 {
+		for _, e := range m.EModeCategories {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MoneyMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = m.BorrowLimit.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = len(m.SpotMarketID)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.InterestRateModel.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.ReserveFactor.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.KeeperRewardPercentage.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.LiquidationBonus.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.SupplyLimit.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *BorrowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMaxLimit {
+		n += 2
+	}
+	l = m.MaximumLimit.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *SupplyLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMaxLimit {
+		n += 2
+	}
+	l = m.MaximumLimit.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	if m.HasMaxCollateralValue {
+		n += 2
+	}
+	l = m.MaxCollateralValue.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *InterestRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseRateAPY.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.Kink.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.JumpMultiplier.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = len(m.ModelType)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.Kinks) > 0 {
+		for _, e := range m.Kinks {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	l = m.TargetUtilization.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.AdjustmentSpeed.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.MinRateAtTarget.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.MaxRateAtTarget.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.CurveSteepness.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *InterestRateKink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Utilization.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.RateAPY.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Borrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *BorrowInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *IsolatedMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.MoneyMarkets) > 0 {
+		for _, e := range m.MoneyMarkets {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for _, s := range m.CollateralDenoms {
+			l = len(s)
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.BorrowDenoms) > 0 {
+		for _, s := range m.BorrowDenoms {
+			l = len(s)
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IsolatedPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.DepositIndex) > 0 {
+		for _, e := range m.DepositIndex {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.Borrow) > 0 {
+		for _, e := range m.Borrow {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.BorrowIndex) > 0 {
+		for _, e := range m.BorrowIndex {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IsolatedMarketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.TotalSupplied) > 0 {
+		for _, e := range m.TotalSupplied {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.TotalBorrowed) > 0 {
+		for _, e := range m.TotalBorrowed {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.TotalReserves) > 0 {
+		for _, e := range m.TotalReserves {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.AccumulationTimes) > 0 {
+		for _, e := range m.AccumulationTimes {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	if len(m.AuctionDebt) > 0 {
+		for _, e := range m.AuctionDebt {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IsolatedAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionID != 0 {
+		n += 1 + sovJinx(uint64(m.AuctionID))
+	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = m.Debt.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *IsolatedAccumulationTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccrualTime)
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.SupplyInterestFactor.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.BorrowInterestFactor.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.RateAtTarget.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *EModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovJinx(uint64(l))
+	return n
+}
+
+func (m *AccountEMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovJinx(uint64(l))
+	}
+	return n
+}
+
+func (m *CoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovJinx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovJinx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJinx(x uint64) (n int) {
+	return sovJinx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
+			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedMarkets = append(m.IsolatedMarkets, IsolatedMarket{})
+			if err := m.IsolatedMarkets[len(m.IsolatedMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategories = append(m.EModeCategories, EModeCategory{})
+			if err := m.EModeCategories[len(m.EModeCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxCollateralValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxCollateralValue = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCollateralValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kinks = append(m.Kinks, InterestRateKink{})
+			if err := m.Kinks[len(m.Kinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentSpeed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustmentSpeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveSteepness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurveSteepness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestRateKink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestRateKink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestRateKink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, SupplyInterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Borrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Borrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Borrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, BorrowInterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyInterestFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJinx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowInterestFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJinx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovJinx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozJinx(x uint64) (n int) {
-	return sovJinx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *IsolatedMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1157,13 +4631,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
 			}
@@ -1197,9 +4703,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1227,9 +4733,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CollateralDenoms = append(m.CollateralDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BorrowDenoms = append(m.BorrowDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1252,7 +4788,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+func (m *IsolatedPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1275,15 +4811,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1311,44 +4847,11 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJinx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthJinx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthJinx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1376,13 +4879,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			m.Owner = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1392,29 +4895,29 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1441,15 +4944,16 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositIndex = append(m.DepositIndex, SupplyInterestFactor{})
+			if err := m.DepositIndex[len(m.DepositIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1459,31 +4963,31 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrow = append(m.Borrow, types.Coin{})
+			if err := m.Borrow[len(m.Borrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1493,23 +4997,23 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BorrowIndex = append(m.BorrowIndex, BorrowInterestFactor{})
+			if err := m.BorrowIndex[len(m.BorrowIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1534,7 +5038,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+func (m *IsolatedMarketState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,35 +5061,15 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedMarketState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedMarketState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJinx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasMaxLimit = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1613,15 +5097,13 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplied", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1631,81 +5113,31 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipJinx(dAtA[iNdEx:])
-			if err != nil {
+			m.TotalSupplied = append(m.TotalSupplied, types.Coin{})
+			if err := m.TotalSupplied[len(m.TotalSupplied)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthJinx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowJinx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBorrowed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1715,31 +5147,31 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalBorrowed = append(m.TotalBorrowed, types.Coin{})
+			if err := m.TotalBorrowed[len(m.TotalBorrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReserves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1749,31 +5181,31 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalReserves = append(m.TotalReserves, types.Coin{})
+			if err := m.TotalReserves[len(m.TotalReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulationTimes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1783,31 +5215,31 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccumulationTimes = append(m.AccumulationTimes, IsolatedAccumulationTime{})
+			if err := m.AccumulationTimes[len(m.AccumulationTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1817,23 +5249,23 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AuctionDebt = append(m.AuctionDebt, types.Coin{})
+			if err := m.AuctionDebt[len(m.AuctionDebt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1858,7 +5290,7 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *IsolatedAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1881,17 +5313,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionID", wireType)
 			}
-			var stringLen uint64
+			m.AuctionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1901,29 +5333,16 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthJinx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthJinx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -1933,29 +5352,27 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1982,8 +5399,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, SupplyInterestFactor{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Debt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2008,7 +5424,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Borrow) Unmarshal(dAtA []byte) error {
+func (m *IsolatedAccumulationTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2031,15 +5447,15 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Borrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedAccumulationTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Borrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedAccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2067,11 +5483,11 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccrualTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2098,16 +5514,15 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyInterestFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJinx
@@ -2117,23 +5532,91 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJinx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJinx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, BorrowInterestFactor{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SupplyInterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowInterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2158,7 +5641,7 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
+func (m *EModeCategory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2181,15 +5664,15 @@ func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyInterestFactor: wiretype end group for non-group")
+			return fmt.Errorf("proto: EModeCategory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2217,11 +5700,11 @@ func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2249,7 +5732,73 @@ func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJinx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJinx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJinx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2274,7 +5823,7 @@ func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
+func (m *AccountEMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2297,15 +5846,15 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowInterestFactor: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountEMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountEMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,11 +5882,11 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Account = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2365,9 +5914,7 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "jinx"
@@ -7,6 +12,9 @@ const (
 	// ModuleAccountName name of module account used to hold deposits
 	ModuleAccountName = "jinx"
 
+	// IsolatedModuleAccountName name of module account used to hold isolated market deposits
+	IsolatedModuleAccountName = "jinx_isolated"
+
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
@@ -31,6 +39,11 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	IsolatedPositionsPrefix       = []byte{0x11} // market, owner -> IsolatedPosition
+	IsolatedMarketStatesPrefix    = []byte{0x12} // market -> IsolatedMarketState
+	RateAtTargetPrefix            = []byte{0x13} // denom -> sdk.Dec
+	AccountEModesPrefix           = []byte{0x14} // owner -> e-mode category name
+	IsolatedAuctionsPrefix        = []byte{0x15} // auction id -> IsolatedAuction
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -38,6 +51,21 @@ func DepositTypeIteratorKey(denom string) []byte {
 	return createKey([]byte(denom))
 }
 
+// IsolatedPositionKey returns the key of an account's position in an isolated market
+func IsolatedPositionKey(market string, owner sdk.AccAddress) []byte {
+	return createKey(IsolatedPositionIteratorKey(market), owner)
+}
+
+// IsolatedPositionIteratorKey returns an iterator prefix for iterating over the positions in an isolated market
+func IsolatedPositionIteratorKey(market string) []byte {
+	return address.MustLengthPrefix([]byte(market))
+}
+
+// IsolatedAuctionKey returns the key of an isolated auction
+func IsolatedAuctionKey(auctionID uint64) []byte {
+	return sdk.Uint64ToBigEndian(auctionID)
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
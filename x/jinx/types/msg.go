@@ -1,9 +1,18 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cdptypes "github.com/percosis-labs/fury/x/cdp/types"
+	swaptypes "github.com/percosis-labs/fury/x/swap/types"
 )
 
 // ensure Msg interface compliance at compile time
@@ -13,6 +22,11 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgPartialLiquidate{}
+	_ sdk.Msg = &MsgFlashLoan{}
+	_ sdk.Msg = &MsgSetEMode{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgFlashLoan{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -226,3 +240,196 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgPartialLiquidate returns a new MsgPartialLiquidate
+func NewMsgPartialLiquidate(keeper, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) MsgPartialLiquidate {
+	return MsgPartialLiquidate{
+		Keeper:          keeper.String(),
+		Borrower:        borrower.String(),
+		Repay:           repay,
+		CollateralDenom: collateralDenom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPartialLiquidate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPartialLiquidate) Type() string { return "partial_liquidate" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPartialLiquidate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Repay.IsValid() || msg.Repay.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "repay amount %s", msg.Repay)
+	}
+	if err := sdk.ValidateDenom(msg.CollateralDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPartialLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPartialLiquidate) GetSigners() []sdk.AccAddress {
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{keeper}
+}
+
+// IsFlashLoanMsg returns true if the msg can be executed within a flash loan.
+// Only the msgs listed here are allowed, any other msg, such as an evm tx or an authz exec, is rejected.
+func IsFlashLoanMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *banktypes.MsgSend,
+		*MsgDeposit, *MsgWithdraw, *MsgBorrow, *MsgRepay, *MsgLiquidate, *MsgPartialLiquidate,
+		*swaptypes.MsgSwapExactForTokens, *swaptypes.MsgSwapForExactTokens,
+		*swaptypes.MsgSwapExactForTokensMultiHop, *swaptypes.MsgSwapForExactTokensMultiHop,
+		*cdptypes.MsgCreateCDP, *cdptypes.MsgDeposit, *cdptypes.MsgWithdraw, *cdptypes.MsgDrawDebt,
+		*cdptypes.MsgRepayDebt, *cdptypes.MsgLiquidate, *cdptypes.MsgRepayMultiCDPDebt, *cdptypes.MsgLiquidateMultiCDP:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewMsgFlashLoan returns a new MsgFlashLoan
+func NewMsgFlashLoan(borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) (MsgFlashLoan, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return MsgFlashLoan{}, err
+	}
+	return MsgFlashLoan{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Msgs:     anys,
+	}, nil
+}
+
+// GetMessages returns the messages executed with the flash loan
+func (msg MsgFlashLoan) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgFlashLoan")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (msg MsgFlashLoan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashLoan) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashLoan) Type() string { return "jinx_flash_loan" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashLoan) ValidateBasic() error {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "flash loan amount %s", msg.Amount)
+	}
+	if len(msg.Msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidFlashLoanMsg, "no messages")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	return ValidateFlashLoanMsgs(borrower, msgs)
+}
+
+// ValidateFlashLoanMsgs checks that msgs can be executed within a flash loan taken by the borrower. Each msg must be
+// allowed in flash loans, be signed only by the borrower and pass its own ValidateBasic.
+func ValidateFlashLoanMsgs(borrower sdk.AccAddress, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		if _, ok := m.(*MsgFlashLoan); ok {
+			return errorsmod.Wrap(ErrInvalidFlashLoanMsg, "flash loans cannot be nested")
+		}
+		if !IsFlashLoanMsg(m) {
+			return errorsmod.Wrapf(ErrInvalidFlashLoanMsg, "%s cannot be executed within a flash loan", sdk.MsgTypeURL(m))
+		}
+		signers := m.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return errorsmod.Wrapf(ErrInvalidFlashLoanMsg, "%s must only be signed by the borrower", sdk.MsgTypeURL(m))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+// The authz amino codec is used as every module registers its messages there, allowing nested msgs to be encoded.
+func (msg MsgFlashLoan) GetSignBytes() []byte {
+	bz := authzcodec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashLoan) GetSigners() []sdk.AccAddress {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{borrower}
+}
+
+// NewMsgSetEMode returns a new MsgSetEMode
+func NewMsgSetEMode(account sdk.AccAddress, category string) MsgSetEMode {
+	return MsgSetEMode{
+		Account:  account.String(),
+		Category: category,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetEMode) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetEMode) Type() string { return "jinx_set_e_mode" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetEMode) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Category != strings.TrimSpace(msg.Category) {
+		return errorsmod.Wrapf(ErrInvalidEModeCategory, "category %q has leading or trailing whitespace", msg.Category)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetEMode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetEMode) GetSigners() []sdk.AccAddress {
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{account}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"github.com/percosis-labs/fury/x/jinx/types"
 )
@@ -190,6 +192,253 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgPartialLiquidate() {
+	type args struct {
+		keeper          sdk.AccAddress
+		borrower        sdk.AccAddress
+		repay           sdk.Coin
+		collateralDenom string
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				keeper:          addrs[0],
+				borrower:        addrs[1],
+				repay:           sdk.NewCoin("usdf", sdkmath.NewInt(1000000)),
+				collateralDenom: "bnb",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid zero repay",
+			args: args{
+				keeper:          addrs[0],
+				borrower:        addrs[1],
+				repay:           sdk.NewCoin("usdf", sdk.ZeroInt()),
+				collateralDenom: "bnb",
+			},
+			expectPass:  false,
+			expectedErr: "repay amount",
+		},
+		{
+			name: "invalid collateral denom",
+			args: args{
+				keeper:          addrs[0],
+				borrower:        addrs[1],
+				repay:           sdk.NewCoin("usdf", sdkmath.NewInt(1000000)),
+				collateralDenom: "",
+			},
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgPartialLiquidate(tc.args.keeper, tc.args.borrower, tc.args.repay, tc.args.collateralDenom)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgFlashLoan() {
+	type args struct {
+		borrower sdk.AccAddress
+		amount   sdk.Coins
+		msgs     []sdk.Msg
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	loan := sdk.NewCoins(sdk.NewCoin("usdf", sdkmath.NewInt(1000000)))
+	repay := types.NewMsgRepay(addrs[0], addrs[0], loan)
+	otherRepay := types.NewMsgRepay(addrs[1], addrs[1], loan)
+	nested, err := types.NewMsgFlashLoan(addrs[0], loan, []sdk.Msg{&repay})
+	suite.Require().NoError(err)
+	exec := authz.NewMsgExec(addrs[0], []sdk.Msg{&repay})
+	setEMode := types.NewMsgSetEMode(addrs[0], "stablecoins")
+
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{&repay},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid zero amount",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(),
+				msgs:     []sdk.Msg{&repay},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan amount",
+		},
+		{
+			name: "invalid no msgs",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{},
+			},
+			expectPass:  false,
+			expectedErr: "no messages",
+		},
+		{
+			name: "invalid msg signer",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{&otherRepay},
+			},
+			expectPass:  false,
+			expectedErr: "must only be signed by the borrower",
+		},
+		{
+			name: "invalid nested flash loan",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{&nested},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be nested",
+		},
+		{
+			name: "invalid evm tx",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{&evmtypes.MsgEthereumTx{}},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be executed within a flash loan",
+		},
+		{
+			name: "invalid authz exec",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{&exec},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be executed within a flash loan",
+		},
+		{
+			name: "invalid msg not allowed",
+			args: args{
+				borrower: addrs[0],
+				amount:   loan,
+				msgs:     []sdk.Msg{&setEMode},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be executed within a flash loan",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgFlashLoan(tc.args.borrower, tc.args.amount, tc.args.msgs)
+			suite.Require().NoError(err)
+			err = msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetEMode() {
+	type args struct {
+		account  sdk.AccAddress
+		category string
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				account:  sdk.AccAddress("test1"),
+				category: "stablecoins",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "valid opt out",
+			args: args{
+				account:  sdk.AccAddress("test1"),
+				category: "",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid empty account",
+			args: args{
+				account:  sdk.AccAddress{},
+				category: "stablecoins",
+			},
+			expectPass:  false,
+			expectedErr: "empty address string is not allowed",
+		},
+		{
+			name: "invalid category whitespace",
+			args: args{
+				account:  sdk.AccAddress("test1"),
+				category: " stablecoins",
+			},
+			expectPass:  false,
+			expectedErr: "leading or trailing whitespace",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetEMode(tc.args.account, tc.args.category)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
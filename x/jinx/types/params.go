@@ -7,12 +7,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	auctiontypes "github.com/percosis-labs/fury/x/auction/types"
 )
 
 // Parameter keys and default values
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
 	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
+	KeyIsolatedMarkets           = []byte("IsolatedMarkets")
+	KeyEModeCategories           = []byte("EModeCategories")
 	DefaultMoneyMarkets          = MoneyMarkets{}
 	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
 	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
@@ -21,8 +25,25 @@ var (
 	DefaultTotalReserves         = sdk.Coins{}
 	DefaultDeposits              = Deposits{}
 	DefaultBorrows               = Borrows{}
+	DefaultIsolatedMarkets       = IsolatedMarkets{}
+	DefaultIsolatedMarketStates  = IsolatedMarketStates{}
+	DefaultIsolatedPositions     = IsolatedPositions{}
+	DefaultEModeCategories       = EModeCategories{}
+	DefaultAccountEModes         = AccountEModes{}
+	DefaultIsolatedAuctions      = IsolatedAuctions{}
+)
+
+// Interest rate model types
+const (
+	InterestRateModelTypeJump      = "jump"
+	InterestRateModelTypePiecewise = "piecewise"
+	InterestRateModelTypeAdaptive  = "adaptive"
 )
 
+// MaxBorrowRateAPY is the largest borrow rate an interest rate model can reach. Interest accrual converts the rate to
+// a per second rate, which fails for rates of ~178 or more.
+var MaxBorrowRateAPY = sdk.NewDec(100)
+
 // NewBorrowLimit returns a new BorrowLimit
 func NewBorrowLimit(hasMaxLimit bool, maximumLimit, loanToValue sdk.Dec) BorrowLimit {
 	return BorrowLimit{
@@ -60,6 +81,52 @@ func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 	return true
 }
 
+// NewSupplyLimit returns a new SupplyLimit
+func NewSupplyLimit(hasMaxLimit bool, maximumLimit sdk.Dec, hasMaxCollateralValue bool, maxCollateralValue sdk.Dec) SupplyLimit {
+	return SupplyLimit{
+		HasMaxLimit:           hasMaxLimit,
+		MaximumLimit:          maximumLimit,
+		HasMaxCollateralValue: hasMaxCollateralValue,
+		MaxCollateralValue:    maxCollateralValue,
+	}
+}
+
+// Validate SupplyLimit
+func (sl SupplyLimit) Validate() error {
+	if sl.HasMaxLimit && (sl.MaximumLimit.IsNil() || sl.MaximumLimit.IsNegative()) {
+		return fmt.Errorf("supply limit maximum limit cannot be negative: %s", sl.MaximumLimit)
+	}
+	if sl.HasMaxCollateralValue && (sl.MaxCollateralValue.IsNil() || sl.MaxCollateralValue.IsNegative()) {
+		return fmt.Errorf("supply limit max collateral value cannot be negative: %s", sl.MaxCollateralValue)
+	}
+	return nil
+}
+
+// Equal returns true if two SupplyLimits are equal
+func (sl SupplyLimit) Equal(slCompareTo SupplyLimit) bool {
+	if sl.HasMaxLimit != slCompareTo.HasMaxLimit {
+		return false
+	}
+	if !decOrZero(sl.MaximumLimit).Equal(decOrZero(slCompareTo.MaximumLimit)) {
+		return false
+	}
+	if sl.HasMaxCollateralValue != slCompareTo.HasMaxCollateralValue {
+		return false
+	}
+	if !decOrZero(sl.MaxCollateralValue).Equal(decOrZero(slCompareTo.MaxCollateralValue)) {
+		return false
+	}
+	return true
+}
+
+// CollateralValue returns the part of a deposit's USD value that counts towards an account's borrow limit
+func (sl SupplyLimit) CollateralValue(usdValue sdk.Dec) sdk.Dec {
+	if sl.HasMaxCollateralValue && usdValue.GT(sl.MaxCollateralValue) {
+		return sl.MaxCollateralValue
+	}
+	return usdValue
+}
+
 // NewMoneyMarket returns a new MoneyMarket
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdkmath.Int,
 	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec,
@@ -72,6 +139,10 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		CloseFactor:            sdk.ZeroDec(),
+		LiquidationBonus:       sdk.ZeroDec(),
+		FlashLoanFee:           sdk.ZeroDec(),
+		SupplyLimit:            NewSupplyLimit(false, sdk.ZeroDec(), false, sdk.ZeroDec()),
 	}
 }
 
@@ -101,6 +172,28 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	switch mm.AuctionType {
+	case "", auctiontypes.CollateralAuctionType, auctiontypes.DutchAuctionType:
+	default:
+		return fmt.Errorf("invalid auction type %s", mm.AuctionType)
+	}
+
+	if !mm.CloseFactor.IsNil() && (mm.CloseFactor.IsNegative() || mm.CloseFactor.GT(sdk.OneDec())) {
+		return fmt.Errorf("close factor must be between 0.0-1.0")
+	}
+
+	if !mm.LiquidationBonus.IsNil() && (mm.LiquidationBonus.IsNegative() || mm.LiquidationBonus.GT(sdk.OneDec())) {
+		return fmt.Errorf("liquidation bonus must be between 0.0-1.0")
+	}
+
+	if !mm.FlashLoanFee.IsNil() && (mm.FlashLoanFee.IsNegative() || mm.FlashLoanFee.GT(sdk.OneDec())) {
+		return fmt.Errorf("flash loan fee must be between 0.0-1.0")
+	}
+
+	if err := mm.SupplyLimit.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -127,9 +220,32 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if mm.AuctionType != mmCompareTo.AuctionType {
+		return false
+	}
+	if !decOrZero(mm.CloseFactor).Equal(decOrZero(mmCompareTo.CloseFactor)) {
+		return false
+	}
+	if !decOrZero(mm.LiquidationBonus).Equal(decOrZero(mmCompareTo.LiquidationBonus)) {
+		return false
+	}
+	if !decOrZero(mm.FlashLoanFee).Equal(decOrZero(mmCompareTo.FlashLoanFee)) {
+		return false
+	}
+	if !mm.SupplyLimit.Equal(mmCompareTo.SupplyLimit) {
+		return false
+	}
 	return true
 }
 
+// decOrZero returns zero for an unset Dec
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
+
 // MoneyMarkets slice of MoneyMarket
 type MoneyMarkets []MoneyMarket
 
@@ -143,16 +259,45 @@ func (mms MoneyMarkets) Validate() error {
 	return nil
 }
 
-// NewInterestRateModel returns a new InterestRateModel
+// NewInterestRateModel returns a new InterestRateModel using the kinked jump model
 func NewInterestRateModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.Dec) InterestRateModel {
 	return InterestRateModel{
-		BaseRateAPY:    baseRateAPY,
-		BaseMultiplier: baseMultiplier,
-		Kink:           kink,
-		JumpMultiplier: jumpMultiplier,
+		BaseRateAPY:       baseRateAPY,
+		BaseMultiplier:    baseMultiplier,
+		Kink:              kink,
+		JumpMultiplier:    jumpMultiplier,
+		TargetUtilization: sdk.ZeroDec(),
+		AdjustmentSpeed:   sdk.ZeroDec(),
+		MinRateAtTarget:   sdk.ZeroDec(),
+		MaxRateAtTarget:   sdk.ZeroDec(),
+		CurveSteepness:    sdk.ZeroDec(),
 	}
 }
 
+// NewPiecewiseInterestRateModel returns a new InterestRateModel whose borrow rate is interpolated linearly between
+// the base rate at zero utilization and each of the kinks
+func NewPiecewiseInterestRateModel(baseRateAPY sdk.Dec, kinks InterestRateKinks) InterestRateModel {
+	irm := NewInterestRateModel(baseRateAPY, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	irm.ModelType = InterestRateModelTypePiecewise
+	irm.Kinks = kinks
+	return irm
+}
+
+// NewAdaptiveInterestRateModel returns a new InterestRateModel whose rate at the target utilization starts at
+// initialRateAtTarget and drifts up while utilization is above the target, and down while it is below
+func NewAdaptiveInterestRateModel(initialRateAtTarget, targetUtilization, adjustmentSpeed, minRateAtTarget,
+	maxRateAtTarget, curveSteepness sdk.Dec,
+) InterestRateModel {
+	irm := NewInterestRateModel(initialRateAtTarget, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	irm.ModelType = InterestRateModelTypeAdaptive
+	irm.TargetUtilization = targetUtilization
+	irm.AdjustmentSpeed = adjustmentSpeed
+	irm.MinRateAtTarget = minRateAtTarget
+	irm.MaxRateAtTarget = maxRateAtTarget
+	irm.CurveSteepness = curveSteepness
+	return irm
+}
+
 // Validate InterestRateModel param
 func (irm InterestRateModel) Validate() error {
 	if irm.BaseRateAPY.IsNegative() || irm.BaseRateAPY.GT(sdk.OneDec()) {
@@ -171,6 +316,60 @@ func (irm InterestRateModel) Validate() error {
 		return fmt.Errorf("jump multiplier must not be negative")
 	}
 
+	switch irm.ModelType {
+	case "", InterestRateModelTypeJump:
+		// the rate is highest at full utilization
+		maxRate := irm.BaseRateAPY.Add(irm.Kink.Mul(irm.BaseMultiplier)).Add(sdk.OneDec().Sub(irm.Kink).Mul(irm.JumpMultiplier))
+		if maxRate.GT(MaxBorrowRateAPY) {
+			return fmt.Errorf("borrow rate at full utilization %s must be ≤ %s", maxRate, MaxBorrowRateAPY)
+		}
+		return nil
+	case InterestRateModelTypePiecewise:
+		return irm.Kinks.Validate()
+	case InterestRateModelTypeAdaptive:
+		return irm.validateAdaptive()
+	default:
+		return fmt.Errorf("invalid interest rate model type %s", irm.ModelType)
+	}
+}
+
+func (irm InterestRateModel) validateAdaptive() error {
+	for _, d := range []sdk.Dec{irm.TargetUtilization, irm.AdjustmentSpeed, irm.MinRateAtTarget, irm.MaxRateAtTarget, irm.CurveSteepness} {
+		if d.IsNil() {
+			return fmt.Errorf("adaptive interest rate model parameters must be set")
+		}
+	}
+
+	if !irm.TargetUtilization.IsPositive() || irm.TargetUtilization.GTE(sdk.OneDec()) {
+		return fmt.Errorf("target utilization must be in the exclusive range 0.0-1.0")
+	}
+
+	if irm.AdjustmentSpeed.IsNegative() {
+		return fmt.Errorf("adjustment speed must not be negative")
+	}
+
+	// a rate at target of zero could never move, as it is adjusted proportionally
+	if !irm.MinRateAtTarget.IsPositive() {
+		return fmt.Errorf("min rate at target must be positive")
+	}
+
+	if irm.MaxRateAtTarget.LT(irm.MinRateAtTarget) {
+		return fmt.Errorf("max rate at target must be ≥ min rate at target")
+	}
+
+	if irm.BaseRateAPY.LT(irm.MinRateAtTarget) || irm.BaseRateAPY.GT(irm.MaxRateAtTarget) {
+		return fmt.Errorf("base rate APY must be between the min and max rate at target")
+	}
+
+	if irm.CurveSteepness.LT(sdk.OneDec()) {
+		return fmt.Errorf("curve steepness must be ≥ one")
+	}
+
+	// the rate is highest at full utilization with the max rate at target
+	if maxRate := irm.MaxRateAtTarget.Mul(irm.CurveSteepness); maxRate.GT(MaxBorrowRateAPY) {
+		return fmt.Errorf("max rate at target × curve steepness %s must be ≤ %s", maxRate, MaxBorrowRateAPY)
+	}
+
 	return nil
 }
 
@@ -188,6 +387,80 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 	if !irm.JumpMultiplier.Equal(irmCompareTo.JumpMultiplier) {
 		return false
 	}
+	if irm.ModelType != irmCompareTo.ModelType {
+		return false
+	}
+	if !irm.Kinks.Equal(irmCompareTo.Kinks) {
+		return false
+	}
+	if !decOrZero(irm.TargetUtilization).Equal(decOrZero(irmCompareTo.TargetUtilization)) {
+		return false
+	}
+	if !decOrZero(irm.AdjustmentSpeed).Equal(decOrZero(irmCompareTo.AdjustmentSpeed)) {
+		return false
+	}
+	if !decOrZero(irm.MinRateAtTarget).Equal(decOrZero(irmCompareTo.MinRateAtTarget)) {
+		return false
+	}
+	if !decOrZero(irm.MaxRateAtTarget).Equal(decOrZero(irmCompareTo.MaxRateAtTarget)) {
+		return false
+	}
+	if !decOrZero(irm.CurveSteepness).Equal(decOrZero(irmCompareTo.CurveSteepness)) {
+		return false
+	}
+	return true
+}
+
+// IsAdaptive returns true if the model's rate at target is adjusted over time
+func (irm InterestRateModel) IsAdaptive() bool {
+	return irm.ModelType == InterestRateModelTypeAdaptive
+}
+
+// NewInterestRateKink returns a new InterestRateKink
+func NewInterestRateKink(utilization, rateAPY sdk.Dec) InterestRateKink {
+	return InterestRateKink{
+		Utilization: utilization,
+		RateAPY:     rateAPY,
+	}
+}
+
+// InterestRateKinks slice of InterestRateKink
+type InterestRateKinks []InterestRateKink
+
+// Validate checks the kinks are in increasing order of utilization and end at full utilization
+func (kinks InterestRateKinks) Validate() error {
+	if len(kinks) == 0 {
+		return fmt.Errorf("piecewise interest rate model must have at least one kink")
+	}
+	previous := sdk.ZeroDec()
+	for _, kink := range kinks {
+		if kink.Utilization.IsNil() || kink.RateAPY.IsNil() {
+			return fmt.Errorf("kink utilization and rate must be set")
+		}
+		if kink.Utilization.LTE(previous) || kink.Utilization.GT(sdk.OneDec()) {
+			return fmt.Errorf("kink utilizations must be increasing and in the range 0.0-1.0, got %s", kink.Utilization)
+		}
+		if kink.RateAPY.IsNegative() || kink.RateAPY.GT(MaxBorrowRateAPY) {
+			return fmt.Errorf("kink rate APY must be in the inclusive range 0.0-%s", MaxBorrowRateAPY)
+		}
+		previous = kink.Utilization
+	}
+	if !previous.Equal(sdk.OneDec()) {
+		return fmt.Errorf("last kink utilization must be 1.0, got %s", previous)
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if two slices of InterestRateKink are equal
+func (kinks InterestRateKinks) Equal(kinksCompareTo InterestRateKinks) bool {
+	if len(kinks) != len(kinksCompareTo) {
+		return false
+	}
+	for i := range kinks {
+		if !kinks[i].Utilization.Equal(kinksCompareTo[i].Utilization) || !kinks[i].RateAPY.Equal(kinksCompareTo[i].RateAPY) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -195,16 +468,20 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 type InterestRateModels []InterestRateModel
 
 // NewParams returns a new params object
-func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params {
+func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec, isolatedMarkets IsolatedMarkets,
+	eModeCategories EModeCategories,
+) Params {
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		IsolatedMarkets:       isolatedMarkets,
+		EModeCategories:       eModeCategories,
 	}
 }
 
 // DefaultParams returns default params for jinx module
 func DefaultParams() Params {
-	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue)
+	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue, DefaultIsolatedMarkets, DefaultEModeCategories)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -217,6 +494,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		paramtypes.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		paramtypes.NewParamSetPair(KeyIsolatedMarkets, &p.IsolatedMarkets, validateIsolatedMarketParams),
+		paramtypes.NewParamSetPair(KeyEModeCategories, &p.EModeCategories, validateEModeCategoryParams),
 	}
 }
 
@@ -226,7 +505,29 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMoneyMarketParams(p.MoneyMarkets)
+	if err := validateMoneyMarketParams(p.MoneyMarkets); err != nil {
+		return err
+	}
+
+	if err := validateIsolatedMarketParams(p.IsolatedMarkets); err != nil {
+		return err
+	}
+
+	if err := validateEModeCategoryParams(p.EModeCategories); err != nil {
+		return err
+	}
+	denoms := make(map[string]bool, len(p.MoneyMarkets))
+	for _, mm := range p.MoneyMarkets {
+		denoms[mm.Denom] = true
+	}
+	for _, c := range p.EModeCategories {
+		for _, denom := range c.Denoms {
+			if !denoms[denom] {
+				return fmt.Errorf("e-mode category %s denom %s has no money market", c.Name, denom)
+			}
+		}
+	}
+	return nil
 }
 
 func validateMinimumBorrowUSDValue(i interface{}) error {
@@ -250,3 +551,21 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateIsolatedMarketParams(i interface{}) error {
+	ims, ok := i.(IsolatedMarkets)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ims.Validate()
+}
+
+func validateEModeCategoryParams(i interface{}) error {
+	cs, ok := i.(EModeCategories)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return cs.Validate()
+}
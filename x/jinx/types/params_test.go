@@ -18,6 +18,8 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	type args struct {
 		minBorrowVal sdk.Dec
 		mms          types.MoneyMarkets
+		ims          types.IsolatedMarkets
+		ecs          types.EModeCategories
 	}
 	testCases := []struct {
 		name        string
@@ -57,10 +59,356 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "invalid: auction type",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdkmath.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						AuctionType:            "english",
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "invalid auction type english",
+		},
+		{
+			name: "invalid: close factor > one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdkmath.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						CloseFactor:            sdk.MustNewDecFromStr("1.5"),
+						LiquidationBonus:       sdk.MustNewDecFromStr("0.1"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "close factor must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: flash loan fee > one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdkmath.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						FlashLoanFee:           sdk.MustNewDecFromStr("1.5"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan fee must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: negative supply limit",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdkmath.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						SupplyLimit:            types.NewSupplyLimit(true, sdk.MustNewDecFromStr("-1"), false, sdk.ZeroDec()),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "supply limit maximum limit cannot be negative",
+		},
+		{
+			name: "valid: piecewise interest rate model",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewPiecewiseInterestRateModel(sdk.MustNewDecFromStr("0.02"), types.InterestRateKinks{
+							types.NewInterestRateKink(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.1")),
+							types.NewInterestRateKink(sdk.OneDec(), sdk.OneDec()),
+						}),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: piecewise kinks not ending at full utilization",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewPiecewiseInterestRateModel(sdk.MustNewDecFromStr("0.02"), types.InterestRateKinks{
+							types.NewInterestRateKink(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.1")),
+							types.NewInterestRateKink(sdk.MustNewDecFromStr("0.9"), sdk.OneDec()),
+						}),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "last kink utilization must be 1.0",
+		},
+		{
+			name: "valid: adaptive interest rate model",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewAdaptiveInterestRateModel(
+							sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("50"),
+							sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("4"),
+						),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: adaptive target utilization",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewAdaptiveInterestRateModel(
+							sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("1"), sdk.MustNewDecFromStr("50"),
+							sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("4"),
+						),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "target utilization must be in the exclusive range 0.0-1.0",
+		},
+		{
+			name: "invalid: adaptive max rate too high",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewAdaptiveInterestRateModel(
+							sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("50"),
+							sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("30"), sdk.MustNewDecFromStr("4"),
+						),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "max rate at target × curve steepness 120.000000000000000000 must be ≤ 100",
+		},
+		{
+			name: "invalid: piecewise kink rate too high",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewPiecewiseInterestRateModel(sdk.MustNewDecFromStr("0.02"), types.InterestRateKinks{
+							types.NewInterestRateKink(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.1")),
+							types.NewInterestRateKink(sdk.OneDec(), sdk.MustNewDecFromStr("180")),
+						}),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "kink rate APY must be in the inclusive range 0.0-100",
+		},
+		{
+			name: "invalid: jump rate too high",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					types.NewMoneyMarket("btcb",
+						types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+						"btc:usd",
+						sdkmath.NewInt(100000000),
+						types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1000")),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "borrow rate at full utilization 201.650000000000000000 must be ≤ 100",
+		},
+		{
+			name: "valid: e-mode category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          isolatedMoneyMarkets("usdf", "usdx"),
+				ecs: types.EModeCategories{
+					types.NewEModeCategory("stablecoins", []string{"usdf", "usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.93")),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: e-mode liquidation threshold below loan-to-value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          isolatedMoneyMarkets("usdf", "usdx"),
+				ecs: types.EModeCategories{
+					types.NewEModeCategory("stablecoins", []string{"usdf", "usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.85")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode category stablecoins liquidation threshold cannot be less than its loan-to-value",
+		},
+		{
+			name: "invalid: e-mode category denom without money market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          isolatedMoneyMarkets("usdf"),
+				ecs: types.EModeCategories{
+					types.NewEModeCategory("stablecoins", []string{"usdf", "usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.93")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode category stablecoins denom usdx has no money market",
+		},
+		{
+			name: "invalid: duplicate e-mode categories",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms:          isolatedMoneyMarkets("usdf", "usdx"),
+				ecs: types.EModeCategories{
+					types.NewEModeCategory("stablecoins", []string{"usdf", "usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.93")),
+					types.NewEModeCategory("stablecoins", []string{"usdf"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.93")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate e-mode category stablecoins",
+		},
+		{
+			name: "valid: isolated market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				ims: types.IsolatedMarkets{
+					types.NewIsolatedMarket("pepe", isolatedMoneyMarkets("pepe", "usdf"), []string{"pepe"}, []string{"usdf"}),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: isolated market collateral without money market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				ims: types.IsolatedMarkets{
+					types.NewIsolatedMarket("pepe", isolatedMoneyMarkets("usdf"), []string{"pepe"}, []string{"usdf"}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolated market pepe collateral denom pepe has no money market",
+		},
+		{
+			name: "invalid: isolated market without borrow denoms",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				ims: types.IsolatedMarkets{
+					types.NewIsolatedMarket("pepe", isolatedMoneyMarkets("pepe", "usdf"), []string{"pepe"}, nil),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolated market pepe has no borrow denoms",
+		},
+		{
+			name: "invalid: isolated market collateral is borrowable",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				ims: types.IsolatedMarkets{
+					types.NewIsolatedMarket("pepe", isolatedMoneyMarkets("pepe", "usdf"), []string{"pepe"}, []string{"pepe", "usdf"}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolated market pepe denom pepe cannot be both collateral and borrowable",
+		},
+		{
+			name: "invalid: duplicate isolated markets",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				ims: types.IsolatedMarkets{
+					types.NewIsolatedMarket("pepe", isolatedMoneyMarkets("pepe", "usdf"), []string{"pepe"}, []string{"usdf"}),
+					types.NewIsolatedMarket("pepe", isolatedMoneyMarkets("pepe", "usdf"), []string{"pepe"}, []string{"usdf"}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate isolated market pepe",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal)
+			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal, tc.args.ims, tc.args.ecs)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -72,6 +420,21 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func isolatedMoneyMarkets(denoms ...string) types.MoneyMarkets {
+	var mms types.MoneyMarkets
+	for _, denom := range denoms {
+		mms = append(mms, types.NewMoneyMarket(denom,
+			types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+			denom+":usd",
+			sdkmath.NewInt(1000000),
+			types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.MustNewDecFromStr("0.05"),
+		))
+	}
+	return mms
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -1104,25 +1104,23 @@ func (m *QueryInterestFactorsResponse) GetInterestFactors() InterestFactors {
 	return nil
 }
 
-// DepositResponse defines an amount of coins deposited into a jinx module account.
-type DepositResponse struct {
-	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	Index     SupplyInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=SupplyInterestFactorResponses" json:"index"`
+// QueryIsolatedMarketsRequest is the request type for the Query/IsolatedMarkets RPC method.
+type QueryIsolatedMarketsRequest struct {
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
 }
 
-func (m *DepositResponse) Reset()         { *m = DepositResponse{} }
-func (m *DepositResponse) String() string { return proto.CompactTextString(m) }
-func (*DepositResponse) ProtoMessage()    {}
-func (*DepositResponse) Descriptor() ([]byte, []int) {
+func (m *QueryIsolatedMarketsRequest) Reset()         { *m = QueryIsolatedMarketsRequest{} }
+func (m *QueryIsolatedMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsolatedMarketsRequest) ProtoMessage()    {}
+func (*QueryIsolatedMarketsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0b7cf6034fe0ed27, []int{22}
 }
-func (m *DepositResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryIsolatedMarketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIsolatedMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIsolatedMarketsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1132,58 +1130,89 @@ func (m *DepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DepositResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositResponse.Merge(m, src)
+func (m *QueryIsolatedMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsolatedMarketsRequest.Merge(m, src)
 }
-func (m *DepositResponse) XXX_Size() int {
+func (m *QueryIsolatedMarketsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositResponse.DiscardUnknown(m)
+func (m *QueryIsolatedMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsolatedMarketsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIsolatedMarketsRequest proto.InternalMessageInfo
 
-func (m *DepositResponse) GetDepositor() string {
+func (m *QueryIsolatedMarketsRequest) GetMarket() string {
 	if m != nil {
-		return m.Depositor
+		return m.Market
 	}
 	return ""
 }
 
-func (m *DepositResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.Amount
+// QueryIsolatedMarketsResponse is the response type for the Query/IsolatedMarkets RPC method.
+type QueryIsolatedMarketsResponse struct {
+	IsolatedMarketStates IsolatedMarketStates `protobuf:"bytes,1,rep,name=isolated_market_states,json=isolatedMarketStates,proto3,castrepeated=IsolatedMarketStates" json:"isolated_market_states"`
+}
+
+func (m *QueryIsolatedMarketsResponse) Reset()         { *m = QueryIsolatedMarketsResponse{} }
+func (m *QueryIsolatedMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsolatedMarketsResponse) ProtoMessage()    {}
+func (*QueryIsolatedMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{23}
+}
+func (m *QueryIsolatedMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsolatedMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsolatedMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QueryIsolatedMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsolatedMarketsResponse.Merge(m, src)
+}
+func (m *QueryIsolatedMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsolatedMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsolatedMarketsResponse.DiscardUnknown(m)
 }
 
-func (m *DepositResponse) GetIndex() SupplyInterestFactorResponses {
+var xxx_messageInfo_QueryIsolatedMarketsResponse proto.InternalMessageInfo
+
+func (m *QueryIsolatedMarketsResponse) GetIsolatedMarketStates() IsolatedMarketStates {
 	if m != nil {
-		return m.Index
+		return m.IsolatedMarketStates
 	}
 	return nil
 }
 
-// SupplyInterestFactorResponse defines an individual borrow interest factor.
-type SupplyInterestFactorResponse struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// sdk.Dec as string
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+// QueryIsolatedPositionsRequest is the request type for the Query/IsolatedPositions RPC method.
+type QueryIsolatedPositionsRequest struct {
+	Market     string             `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Owner      string             `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *SupplyInterestFactorResponse) Reset()         { *m = SupplyInterestFactorResponse{} }
-func (m *SupplyInterestFactorResponse) String() string { return proto.CompactTextString(m) }
-func (*SupplyInterestFactorResponse) ProtoMessage()    {}
-func (*SupplyInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b7cf6034fe0ed27, []int{23}
+func (m *QueryIsolatedPositionsRequest) Reset()         { *m = QueryIsolatedPositionsRequest{} }
+func (m *QueryIsolatedPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsolatedPositionsRequest) ProtoMessage()    {}
+func (*QueryIsolatedPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{24}
 }
-func (m *SupplyInterestFactorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryIsolatedPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SupplyInterestFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIsolatedPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SupplyInterestFactorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIsolatedPositionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1193,51 +1222,57 @@ func (m *SupplyInterestFactorResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *SupplyInterestFactorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SupplyInterestFactorResponse.Merge(m, src)
+func (m *QueryIsolatedPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsolatedPositionsRequest.Merge(m, src)
 }
-func (m *SupplyInterestFactorResponse) XXX_Size() int {
+func (m *QueryIsolatedPositionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SupplyInterestFactorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SupplyInterestFactorResponse.DiscardUnknown(m)
+func (m *QueryIsolatedPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsolatedPositionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SupplyInterestFactorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIsolatedPositionsRequest proto.InternalMessageInfo
 
-func (m *SupplyInterestFactorResponse) GetDenom() string {
+func (m *QueryIsolatedPositionsRequest) GetMarket() string {
 	if m != nil {
-		return m.Denom
+		return m.Market
 	}
 	return ""
 }
 
-func (m *SupplyInterestFactorResponse) GetValue() string {
+func (m *QueryIsolatedPositionsRequest) GetOwner() string {
 	if m != nil {
-		return m.Value
+		return m.Owner
 	}
 	return ""
 }
 
-// BorrowResponse defines an amount of coins borrowed from a jinx module account.
-type BorrowResponse struct {
-	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
-	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	Index    BorrowInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=BorrowInterestFactorResponses" json:"index"`
+func (m *QueryIsolatedPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *BorrowResponse) Reset()         { *m = BorrowResponse{} }
-func (m *BorrowResponse) String() string { return proto.CompactTextString(m) }
-func (*BorrowResponse) ProtoMessage()    {}
-func (*BorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b7cf6034fe0ed27, []int{24}
+// QueryIsolatedPositionsResponse is the response type for the Query/IsolatedPositions RPC method.
+type QueryIsolatedPositionsResponse struct {
+	IsolatedPositions IsolatedPositionResponses `protobuf:"bytes,1,rep,name=isolated_positions,json=isolatedPositions,proto3,castrepeated=IsolatedPositionResponses" json:"isolated_positions"`
+	Pagination        *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *BorrowResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryIsolatedPositionsResponse) Reset()         { *m = QueryIsolatedPositionsResponse{} }
+func (m *QueryIsolatedPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsolatedPositionsResponse) ProtoMessage()    {}
+func (*QueryIsolatedPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{25}
+}
+func (m *QueryIsolatedPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIsolatedPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BorrowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIsolatedPositionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1247,58 +1282,98 @@ func (m *BorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *BorrowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BorrowResponse.Merge(m, src)
+func (m *QueryIsolatedPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsolatedPositionsResponse.Merge(m, src)
 }
-func (m *BorrowResponse) XXX_Size() int {
+func (m *QueryIsolatedPositionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BorrowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BorrowResponse.DiscardUnknown(m)
+func (m *QueryIsolatedPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsolatedPositionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BorrowResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIsolatedPositionsResponse proto.InternalMessageInfo
 
-func (m *BorrowResponse) GetBorrower() string {
+func (m *QueryIsolatedPositionsResponse) GetIsolatedPositions() IsolatedPositionResponses {
 	if m != nil {
-		return m.Borrower
+		return m.IsolatedPositions
 	}
-	return ""
+	return nil
 }
 
-func (m *BorrowResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryIsolatedPositionsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Amount
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *BorrowResponse) GetIndex() BorrowInterestFactorResponses {
+// QueryEModeRequest is the request type for the Query/EMode RPC method.
+type QueryEModeRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryEModeRequest) Reset()         { *m = QueryEModeRequest{} }
+func (m *QueryEModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEModeRequest) ProtoMessage()    {}
+func (*QueryEModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{26}
+}
+func (m *QueryEModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEModeRequest.Merge(m, src)
+}
+func (m *QueryEModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEModeRequest proto.InternalMessageInfo
+
+func (m *QueryEModeRequest) GetAccount() string {
 	if m != nil {
-		return m.Index
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-// BorrowInterestFactorResponse defines an individual borrow interest factor.
-type BorrowInterestFactorResponse struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// sdk.Dec as string
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+// QueryEModeResponse is the response type for the Query/EMode RPC method.
+type QueryEModeResponse struct {
+	// category is the name of the account's e-mode category, empty if it hasn't opted in to one.
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// active is true when all of the account's deposits and borrows are in the category, so its e-mode loan-to-value
+	// and liquidation threshold apply.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (m *BorrowInterestFactorResponse) Reset()         { *m = BorrowInterestFactorResponse{} }
-func (m *BorrowInterestFactorResponse) String() string { return proto.CompactTextString(m) }
-func (*BorrowInterestFactorResponse) ProtoMessage()    {}
-func (*BorrowInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b7cf6034fe0ed27, []int{25}
+func (m *QueryEModeResponse) Reset()         { *m = QueryEModeResponse{} }
+func (m *QueryEModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEModeResponse) ProtoMessage()    {}
+func (*QueryEModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{27}
 }
-func (m *BorrowInterestFactorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEModeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BorrowInterestFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BorrowInterestFactorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEModeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1308,53 +1383,49 @@ func (m *BorrowInterestFactorResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *BorrowInterestFactorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BorrowInterestFactorResponse.Merge(m, src)
+func (m *QueryEModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEModeResponse.Merge(m, src)
 }
-func (m *BorrowInterestFactorResponse) XXX_Size() int {
+func (m *QueryEModeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BorrowInterestFactorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BorrowInterestFactorResponse.DiscardUnknown(m)
+func (m *QueryEModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEModeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BorrowInterestFactorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEModeResponse proto.InternalMessageInfo
 
-func (m *BorrowInterestFactorResponse) GetDenom() string {
+func (m *QueryEModeResponse) GetCategory() string {
 	if m != nil {
-		return m.Denom
+		return m.Category
 	}
 	return ""
 }
 
-func (m *BorrowInterestFactorResponse) GetValue() string {
+func (m *QueryEModeResponse) GetActive() bool {
 	if m != nil {
-		return m.Value
+		return m.Active
 	}
-	return ""
+	return false
 }
 
-// MoneyMarketInterestRate is a unique type returned by interest rate queries
-type MoneyMarketInterestRate struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// sdk.Dec as String
-	SupplyInterestRate string `protobuf:"bytes,2,opt,name=supply_interest_rate,json=supplyInterestRate,proto3" json:"supply_interest_rate,omitempty"`
-	// sdk.Dec as String
-	BorrowInterestRate string `protobuf:"bytes,3,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3" json:"borrow_interest_rate,omitempty"`
+// QueryPositionHealthRequest is the request type for the Query/PositionHealth RPC method.
+type QueryPositionHealthRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *MoneyMarketInterestRate) Reset()         { *m = MoneyMarketInterestRate{} }
-func (m *MoneyMarketInterestRate) String() string { return proto.CompactTextString(m) }
-func (*MoneyMarketInterestRate) ProtoMessage()    {}
-func (*MoneyMarketInterestRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b7cf6034fe0ed27, []int{26}
+func (m *QueryPositionHealthRequest) Reset()         { *m = QueryPositionHealthRequest{} }
+func (m *QueryPositionHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionHealthRequest) ProtoMessage()    {}
+func (*QueryPositionHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{28}
 }
-func (m *MoneyMarketInterestRate) XXX_Unmarshal(b []byte) error {
+func (m *QueryPositionHealthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MoneyMarketInterestRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPositionHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MoneyMarketInterestRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPositionHealthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1364,60 +1435,87 @@ func (m *MoneyMarketInterestRate) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MoneyMarketInterestRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MoneyMarketInterestRate.Merge(m, src)
+func (m *QueryPositionHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionHealthRequest.Merge(m, src)
 }
-func (m *MoneyMarketInterestRate) XXX_Size() int {
+func (m *QueryPositionHealthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MoneyMarketInterestRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MoneyMarketInterestRate.DiscardUnknown(m)
+func (m *QueryPositionHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionHealthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MoneyMarketInterestRate proto.InternalMessageInfo
+var xxx_messageInfo_QueryPositionHealthRequest proto.InternalMessageInfo
 
-func (m *MoneyMarketInterestRate) GetDenom() string {
+func (m *QueryPositionHealthRequest) GetOwner() string {
 	if m != nil {
-		return m.Denom
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MoneyMarketInterestRate) GetSupplyInterestRate() string {
-	if m != nil {
-		return m.SupplyInterestRate
+// QueryPositionHealthResponse is the response type for the Query/PositionHealth RPC method.
+type QueryPositionHealthResponse struct {
+	Health PositionHealthResponse `protobuf:"bytes,1,opt,name=health,proto3" json:"health"`
+}
+
+func (m *QueryPositionHealthResponse) Reset()         { *m = QueryPositionHealthResponse{} }
+func (m *QueryPositionHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionHealthResponse) ProtoMessage()    {}
+func (*QueryPositionHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{29}
+}
+func (m *QueryPositionHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryPositionHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionHealthResponse.Merge(m, src)
+}
+func (m *QueryPositionHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionHealthResponse.DiscardUnknown(m)
 }
 
-func (m *MoneyMarketInterestRate) GetBorrowInterestRate() string {
+var xxx_messageInfo_QueryPositionHealthResponse proto.InternalMessageInfo
+
+func (m *QueryPositionHealthResponse) GetHealth() PositionHealthResponse {
 	if m != nil {
-		return m.BorrowInterestRate
+		return m.Health
 	}
-	return ""
+	return PositionHealthResponse{}
 }
 
-// InterestFactor is a unique type returned by interest factor queries
-type InterestFactor struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// sdk.Dec as String
-	BorrowInterestFactor string `protobuf:"bytes,2,opt,name=borrow_interest_factor,json=borrowInterestFactor,proto3" json:"borrow_interest_factor,omitempty"`
-	// sdk.Dec as String
-	SupplyInterestFactor string `protobuf:"bytes,3,opt,name=supply_interest_factor,json=supplyInterestFactor,proto3" json:"supply_interest_factor,omitempty"`
+// QueryPositionsAtRiskRequest is the request type for the Query/PositionsAtRisk RPC method.
+type QueryPositionsAtRiskRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *InterestFactor) Reset()         { *m = InterestFactor{} }
-func (m *InterestFactor) String() string { return proto.CompactTextString(m) }
-func (*InterestFactor) ProtoMessage()    {}
-func (*InterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b7cf6034fe0ed27, []int{27}
+func (m *QueryPositionsAtRiskRequest) Reset()         { *m = QueryPositionsAtRiskRequest{} }
+func (m *QueryPositionsAtRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsAtRiskRequest) ProtoMessage()    {}
+func (*QueryPositionsAtRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{30}
 }
-func (m *InterestFactor) XXX_Unmarshal(b []byte) error {
+func (m *QueryPositionsAtRiskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InterestFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPositionsAtRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InterestFactor.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPositionsAtRiskRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1427,1398 +1525,1515 @@ func (m *InterestFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *InterestFactor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InterestFactor.Merge(m, src)
+func (m *QueryPositionsAtRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsAtRiskRequest.Merge(m, src)
 }
-func (m *InterestFactor) XXX_Size() int {
+func (m *QueryPositionsAtRiskRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InterestFactor) XXX_DiscardUnknown() {
-	xxx_messageInfo_InterestFactor.DiscardUnknown(m)
+func (m *QueryPositionsAtRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsAtRiskRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InterestFactor proto.InternalMessageInfo
+var xxx_messageInfo_QueryPositionsAtRiskRequest proto.InternalMessageInfo
 
-func (m *InterestFactor) GetDenom() string {
+func (m *QueryPositionsAtRiskRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Denom
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *InterestFactor) GetBorrowInterestFactor() string {
-	if m != nil {
-		return m.BorrowInterestFactor
-	}
-	return ""
+// QueryPositionsAtRiskResponse is the response type for the Query/PositionsAtRisk RPC method.
+type QueryPositionsAtRiskResponse struct {
+	Positions  PositionHealthResponses `protobuf:"bytes,1,rep,name=positions,proto3,castrepeated=PositionHealthResponses" json:"positions"`
+	Pagination *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *InterestFactor) GetSupplyInterestFactor() string {
-	if m != nil {
-		return m.SupplyInterestFactor
+func (m *QueryPositionsAtRiskResponse) Reset()         { *m = QueryPositionsAtRiskResponse{} }
+func (m *QueryPositionsAtRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsAtRiskResponse) ProtoMessage()    {}
+func (*QueryPositionsAtRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{31}
+}
+func (m *QueryPositionsAtRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsAtRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsAtRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryPositionsAtRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsAtRiskResponse.Merge(m, src)
+}
+func (m *QueryPositionsAtRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsAtRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsAtRiskResponse.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "fury.jinx.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fury.jinx.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryAccountsRequest)(nil), "fury.jinx.v1beta1.QueryAccountsRequest")
-	proto.RegisterType((*QueryAccountsResponse)(nil), "fury.jinx.v1beta1.QueryAccountsResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "fury.jinx.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "fury.jinx.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryUnsyncedDepositsRequest)(nil), "fury.jinx.v1beta1.QueryUnsyncedDepositsRequest")
-	proto.RegisterType((*QueryUnsyncedDepositsResponse)(nil), "fury.jinx.v1beta1.QueryUnsyncedDepositsResponse")
-	proto.RegisterType((*QueryTotalDepositedRequest)(nil), "fury.jinx.v1beta1.QueryTotalDepositedRequest")
-	proto.RegisterType((*QueryTotalDepositedResponse)(nil), "fury.jinx.v1beta1.QueryTotalDepositedResponse")
-	proto.RegisterType((*QueryBorrowsRequest)(nil), "fury.jinx.v1beta1.QueryBorrowsRequest")
-	proto.RegisterType((*QueryBorrowsResponse)(nil), "fury.jinx.v1beta1.QueryBorrowsResponse")
-	proto.RegisterType((*QueryUnsyncedBorrowsRequest)(nil), "fury.jinx.v1beta1.QueryUnsyncedBorrowsRequest")
-	proto.RegisterType((*QueryUnsyncedBorrowsResponse)(nil), "fury.jinx.v1beta1.QueryUnsyncedBorrowsResponse")
-	proto.RegisterType((*QueryTotalBorrowedRequest)(nil), "fury.jinx.v1beta1.QueryTotalBorrowedRequest")
-	proto.RegisterType((*QueryTotalBorrowedResponse)(nil), "fury.jinx.v1beta1.QueryTotalBorrowedResponse")
-	proto.RegisterType((*QueryInterestRateRequest)(nil), "fury.jinx.v1beta1.QueryInterestRateRequest")
-	proto.RegisterType((*QueryInterestRateResponse)(nil), "fury.jinx.v1beta1.QueryInterestRateResponse")
-	proto.RegisterType((*QueryReservesRequest)(nil), "fury.jinx.v1beta1.QueryReservesRequest")
-	proto.RegisterType((*QueryReservesResponse)(nil), "fury.jinx.v1beta1.QueryReservesResponse")
-	proto.RegisterType((*QueryInterestFactorsRequest)(nil), "fury.jinx.v1beta1.QueryInterestFactorsRequest")
-	proto.RegisterType((*QueryInterestFactorsResponse)(nil), "fury.jinx.v1beta1.QueryInterestFactorsResponse")
-	proto.RegisterType((*DepositResponse)(nil), "fury.jinx.v1beta1.DepositResponse")
-	proto.RegisterType((*SupplyInterestFactorResponse)(nil), "fury.jinx.v1beta1.SupplyInterestFactorResponse")
-	proto.RegisterType((*BorrowResponse)(nil), "fury.jinx.v1beta1.BorrowResponse")
-	proto.RegisterType((*BorrowInterestFactorResponse)(nil), "fury.jinx.v1beta1.BorrowInterestFactorResponse")
-	proto.RegisterType((*MoneyMarketInterestRate)(nil), "fury.jinx.v1beta1.MoneyMarketInterestRate")
-	proto.RegisterType((*InterestFactor)(nil), "fury.jinx.v1beta1.InterestFactor")
-}
-
-func init() { proto.RegisterFile("fury/jinx/v1beta1/query.proto", fileDescriptor_0b7cf6034fe0ed27) }
+var xxx_messageInfo_QueryPositionsAtRiskResponse proto.InternalMessageInfo
 
-var fileDescriptor_0b7cf6034fe0ed27 = []byte{
-	// 1322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x3a, 0xdf, 0xa4, 0xe9, 0x6b, 0x9b, 0xf4, 0x3b, 0x98, 0xd6, 0x76, 0x12, 0x37, 0xd9,
-	0x34, 0x3f, 0x48, 0x63, 0x6f, 0x92, 0x56, 0x70, 0xae, 0x89, 0x8a, 0x40, 0x0a, 0x82, 0x6d, 0x91,
-	0x10, 0x12, 0x8a, 0xd6, 0xde, 0xa9, 0xbb, 0xd4, 0xd9, 0x71, 0x77, 0xd6, 0x69, 0x8c, 0x10, 0x87,
-	0x4a, 0xdc, 0x0b, 0x39, 0x20, 0x04, 0x12, 0x87, 0x72, 0x02, 0x8e, 0x70, 0x41, 0xe2, 0xc2, 0xa9,
-	0xe2, 0x54, 0xc1, 0x85, 0x13, 0xa0, 0x84, 0x3f, 0x04, 0xed, 0xcc, 0x9b, 0xb5, 0x77, 0xbd, 0xbb,
-	0x0e, 0x12, 0x45, 0xe9, 0x29, 0x99, 0x99, 0xf7, 0xe3, 0x33, 0x9f, 0x79, 0xef, 0xed, 0x7b, 0x86,
-	0xd9, 0xdb, 0x1d, 0xaf, 0x6b, 0xbc, 0xe7, 0xb8, 0xfb, 0xc6, 0xde, 0x46, 0x9d, 0xfa, 0xd6, 0x86,
-	0x71, 0xaf, 0x43, 0xbd, 0x6e, 0xb5, 0xed, 0x31, 0x9f, 0x91, 0xff, 0x07, 0xc7, 0xd5, 0xe0, 0xb8,
-	0x8a, 0xc7, 0xa5, 0x72, 0x83, 0xf1, 0x5d, 0xc6, 0x0d, 0xab, 0xe3, 0xdf, 0x09, 0x75, 0x82, 0x85,
-	0x54, 0x29, 0xad, 0xe2, 0x79, 0xdd, 0xe2, 0x54, 0xda, 0x0a, 0xa5, 0xda, 0x56, 0xd3, 0x71, 0x2d,
-	0xdf, 0x61, 0x2e, 0xca, 0x96, 0xfb, 0x65, 0x95, 0x54, 0x83, 0x39, 0xea, 0xbc, 0x28, 0xcf, 0x77,
-	0xc4, 0xca, 0x90, 0x0b, 0x3c, 0x9a, 0x19, 0x04, 0x2e, 0x60, 0xca, 0xd3, 0x7c, 0x93, 0x35, 0x99,
-	0xd4, 0x0a, 0xfe, 0x53, 0x3a, 0x4d, 0xc6, 0x9a, 0x2d, 0x6a, 0x58, 0x6d, 0xc7, 0xb0, 0x5c, 0x97,
-	0xf9, 0x02, 0x0b, 0x5a, 0xd4, 0xf3, 0x40, 0xde, 0x0c, 0xe0, 0xbe, 0x61, 0x79, 0xd6, 0x2e, 0x37,
-	0xe9, 0xbd, 0x0e, 0xe5, 0xbe, 0xfe, 0x3a, 0x3c, 0x17, 0xd9, 0xe5, 0x6d, 0xe6, 0x72, 0x4a, 0x5e,
-	0x82, 0xf1, 0xb6, 0xd8, 0x29, 0x68, 0x73, 0xda, 0xca, 0x99, 0xcd, 0x62, 0x75, 0x80, 0xa9, 0xaa,
-	0x54, 0xa9, 0xfd, 0xef, 0xf1, 0xef, 0x97, 0x46, 0x4c, 0x14, 0xd7, 0x2f, 0x40, 0x5e, 0xd8, 0xbb,
-	0xde, 0x68, 0xb0, 0x8e, 0xeb, 0x87, 0x7e, 0xde, 0x85, 0xe7, 0x63, 0xfb, 0xe8, 0x69, 0x0b, 0x26,
-	0x2c, 0xdc, 0x2b, 0x68, 0x73, 0xa3, 0x2b, 0x67, 0x36, 0xf5, 0x2a, 0x32, 0x21, 0x58, 0x57, 0xde,
-	0xb6, 0x99, 0xdd, 0x69, 0x51, 0x54, 0x47, 0xa7, 0xa1, 0xa6, 0xfe, 0x95, 0x86, 0x7e, 0xb7, 0x68,
-	0x9b, 0x71, 0x27, 0xf4, 0x4b, 0xf2, 0x30, 0x66, 0x53, 0x97, 0xed, 0x8a, 0x7b, 0x9c, 0x36, 0xe5,
-	0x82, 0x54, 0x61, 0x8c, 0xdd, 0x77, 0xa9, 0x57, 0xc8, 0x05, 0xbb, 0xb5, 0xc2, 0x2f, 0xdf, 0x55,
-	0xf2, 0xe8, 0xf4, 0xba, 0x6d, 0x7b, 0x94, 0xf3, 0x9b, 0xbe, 0xe7, 0xb8, 0x4d, 0x53, 0x8a, 0x91,
-	0x1b, 0x00, 0xbd, 0xc7, 0x2d, 0x8c, 0x0a, 0x4a, 0x96, 0x14, 0xcc, 0xe0, 0x75, 0xab, 0x32, 0xaa,
-	0x7a, 0xd4, 0x34, 0x29, 0x22, 0x30, 0xfb, 0x34, 0xf5, 0x1f, 0x34, 0xa4, 0xa1, 0x07, 0x13, 0x69,
-	0x78, 0x1b, 0x26, 0x6c, 0xdc, 0x0b, 0x69, 0x18, 0xa4, 0x1c, 0xd5, 0x94, 0x56, 0xad, 0x10, 0xd0,
-	0xf0, 0xf5, 0x1f, 0x97, 0xce, 0xc7, 0x0e, 0xb8, 0x19, 0x5a, 0x23, 0xaf, 0x44, 0xb0, 0xe7, 0x04,
-	0xf6, 0xe5, 0xa1, 0xd8, 0xa5, 0x9d, 0x08, 0xf8, 0x6f, 0x35, 0x98, 0x11, 0xe0, 0xdf, 0x72, 0x79,
-	0xd7, 0x6d, 0x50, 0xfb, 0x64, 0x73, 0xfd, 0x93, 0x06, 0xb3, 0x29, 0x70, 0x9f, 0x1d, 0xce, 0x37,
-	0xa1, 0x24, 0xee, 0x70, 0x8b, 0xf9, 0x56, 0x0b, 0x1d, 0x52, 0x3b, 0x93, 0x70, 0xfd, 0x63, 0x0d,
-	0xa6, 0x13, 0x95, 0xf0, 0xda, 0x1e, 0x4c, 0xf2, 0x4e, 0xbb, 0xdd, 0x72, 0xa8, 0xbd, 0x13, 0x14,
-	0x23, 0x5e, 0xc8, 0x89, 0xcb, 0x17, 0x23, 0x00, 0x15, 0xb4, 0x97, 0x99, 0xe3, 0xd6, 0xd6, 0xf1,
-	0xce, 0x2b, 0x4d, 0xc7, 0xbf, 0xd3, 0xa9, 0x57, 0x1b, 0x6c, 0x17, 0xcb, 0x15, 0xfe, 0xa9, 0x70,
-	0xfb, 0xae, 0xe1, 0x77, 0xdb, 0x94, 0x0b, 0x05, 0x6e, 0x9e, 0x53, 0x2e, 0xc4, 0x52, 0x7f, 0xa4,
-	0x61, 0x9d, 0xa9, 0x31, 0xcf, 0x63, 0xf7, 0x4f, 0x68, 0xc8, 0x7c, 0xaf, 0xaa, 0x48, 0x88, 0x12,
-	0x29, 0xbb, 0x05, 0xa7, 0xea, 0x72, 0x0b, 0x03, 0x65, 0x3e, 0x21, 0x50, 0xa4, 0x52, 0x18, 0x27,
-	0x17, 0x91, 0xb3, 0xa9, 0xe8, 0x3e, 0x37, 0x95, 0xa9, 0x7f, 0x2f, 0x4a, 0xbe, 0x51, 0x2f, 0xae,
-	0x42, 0xfd, 0x44, 0xb3, 0xfc, 0x63, 0xbc, 0x8e, 0x3c, 0x63, 0x6c, 0x6f, 0x40, 0xb1, 0x97, 0x5e,
-	0xd2, 0xdd, 0xb0, 0x94, 0x7c, 0xa8, 0xf5, 0xe7, 0x71, 0x4f, 0xa7, 0x97, 0x91, 0x75, 0xdc, 0x7b,
-	0x8a, 0x19, 0xa9, 0x5c, 0xc8, 0x8c, 0x5c, 0x87, 0x82, 0x40, 0xf4, 0xaa, 0xeb, 0x53, 0x2f, 0x78,
-	0x22, 0xcb, 0xa7, 0x43, 0x2f, 0x51, 0x4c, 0x50, 0xc1, 0x3b, 0x70, 0x98, 0x74, 0x70, 0x7f, 0xc7,
-	0xb3, 0x7c, 0xaa, 0xde, 0x6e, 0x35, 0xe1, 0xed, 0xb6, 0x99, 0x4b, 0xbb, 0xdb, 0x96, 0x77, 0x97,
-	0xfa, 0xfd, 0xb6, 0x6a, 0x73, 0x78, 0xa9, 0x42, 0x8a, 0x00, 0x37, 0xcf, 0x39, 0xfd, 0x4b, 0x7d,
-	0x0d, 0xf3, 0xd5, 0xa4, 0x9c, 0x7a, 0x7b, 0x34, 0x3b, 0xe0, 0xf5, 0x0f, 0xf0, 0xe3, 0xdb, 0x93,
-	0x46, 0xec, 0x0d, 0x18, 0xb7, 0x76, 0x83, 0x46, 0xe2, 0x69, 0xf0, 0x8e, 0xa6, 0xf5, 0xab, 0x98,
-	0xa3, 0xea, 0x42, 0x37, 0xac, 0x86, 0xcf, 0xbc, 0x21, 0x90, 0x3f, 0x52, 0xb9, 0x32, 0xa0, 0x85,
-	0xd0, 0x29, 0x9c, 0x0f, 0x69, 0xbf, 0x2d, 0xcf, 0x32, 0x92, 0x26, 0x6a, 0xa5, 0x97, 0x34, 0x71,
-	0xeb, 0x53, 0x4e, 0x74, 0x43, 0xff, 0x22, 0x07, 0x53, 0xb1, 0xef, 0x1d, 0x79, 0x11, 0x4e, 0xe3,
-	0x07, 0x8f, 0x79, 0x12, 0x75, 0x46, 0x0d, 0xe9, 0x89, 0xfe, 0x27, 0x6c, 0x93, 0x16, 0x8c, 0x39,
-	0xae, 0x4d, 0xf7, 0x0b, 0xa3, 0xc2, 0x87, 0x91, 0x40, 0xc6, 0xcd, 0xe0, 0x0b, 0x15, 0x23, 0x36,
-	0xac, 0x27, 0x8b, 0xe8, 0x79, 0x36, 0x4b, 0x8a, 0x9b, 0xd2, 0x89, 0xfe, 0x1a, 0xcc, 0x64, 0xc9,
-	0xa5, 0x14, 0xe0, 0x3c, 0x8c, 0xed, 0x59, 0xad, 0x0e, 0x95, 0x05, 0xd8, 0x94, 0x0b, 0xfd, 0xb3,
-	0x1c, 0x4c, 0x46, 0x8b, 0x18, 0xb9, 0x06, 0x13, 0x98, 0xbc, 0xc3, 0x89, 0x0e, 0x25, 0x4f, 0x0c,
-	0xcf, 0xf2, 0x32, 0xc3, 0x78, 0xce, 0x92, 0xea, 0xe7, 0x39, 0x4b, 0xee, 0x1f, 0xf1, 0x7c, 0xa0,
-	0xc1, 0xc5, 0x94, 0x3a, 0x93, 0x62, 0x67, 0x1d, 0xf2, 0xa2, 0xab, 0xe9, 0xee, 0x44, 0x2a, 0x1d,
-	0x9a, 0x25, 0x3c, 0x12, 0x01, 0xc2, 0xce, 0x3a, 0xe4, 0xe5, 0x73, 0xc4, 0x34, 0x46, 0xa5, 0x46,
-	0x3d, 0x72, 0x97, 0x40, 0x43, 0xff, 0x44, 0x83, 0xc9, 0xe8, 0xe5, 0x52, 0xc0, 0x5c, 0x83, 0x0b,
-	0x71, 0xd3, 0x32, 0xff, 0x11, 0x4e, 0xbe, 0x9e, 0x40, 0x54, 0xa0, 0x15, 0xbf, 0x02, 0x6a, 0x49,
-	0x48, 0x79, 0x9e, 0x10, 0xc6, 0x9b, 0x3f, 0x9f, 0x85, 0x31, 0x51, 0x85, 0xc8, 0xfb, 0x30, 0x2e,
-	0xc7, 0x3e, 0xb2, 0x98, 0xf0, 0xd2, 0x83, 0xf3, 0x65, 0x69, 0x69, 0x98, 0x98, 0x7c, 0x39, 0x7d,
-	0xfe, 0xc1, 0xaf, 0x7f, 0x1d, 0xe4, 0xa6, 0x49, 0xd1, 0x18, 0x1c, 0x7c, 0xe5, 0x68, 0x49, 0x1e,
-	0x68, 0x30, 0xa1, 0xc6, 0x47, 0xb2, 0x9c, 0x66, 0x37, 0x36, 0x78, 0x96, 0x56, 0x86, 0x0b, 0x22,
-	0x84, 0x05, 0x01, 0x61, 0x96, 0x4c, 0x27, 0x40, 0x50, 0x83, 0xa6, 0x00, 0xa1, 0x06, 0x89, 0x74,
-	0x10, 0xb1, 0xc9, 0x28, 0x1d, 0x44, 0x7c, 0x26, 0xc9, 0x04, 0x11, 0x8e, 0x17, 0x8f, 0x34, 0x38,
-	0x1f, 0x9f, 0x6a, 0x88, 0x91, 0xe6, 0x23, 0x65, 0x5c, 0x2b, 0xad, 0x1f, 0x5f, 0x01, 0xc1, 0xad,
-	0x09, 0x70, 0x4b, 0xe4, 0x72, 0x02, 0xb8, 0x0e, 0x2a, 0x55, 0x42, 0x94, 0x9f, 0x6b, 0x30, 0x19,
-	0x1d, 0x41, 0x48, 0x25, 0xcd, 0x65, 0xe2, 0x7c, 0x53, 0xaa, 0x1e, 0x57, 0x1c, 0xf1, 0xad, 0x0a,
-	0x7c, 0x97, 0x89, 0x9e, 0x80, 0xcf, 0x0f, 0x54, 0x14, 0x38, 0x6a, 0x93, 0x0f, 0xe1, 0x14, 0xf6,
-	0x9d, 0x24, 0x35, 0x46, 0xa3, 0x6d, 0x74, 0x69, 0x79, 0xa8, 0x1c, 0xe2, 0xd0, 0x05, 0x8e, 0x19,
-	0x52, 0x4a, 0xc0, 0xa1, 0xda, 0xd1, 0x2f, 0x35, 0x98, 0x8a, 0x35, 0xc0, 0xa4, 0x3a, 0xec, 0x45,
-	0x62, 0x80, 0x8c, 0x63, 0xcb, 0x23, 0xb0, 0x2b, 0x02, 0xd8, 0x22, 0x59, 0xc8, 0x7a, 0x40, 0x85,
-	0xf0, 0x53, 0x0d, 0xce, 0x45, 0xfa, 0x55, 0xb2, 0x96, 0xf9, 0x1e, 0xb1, 0x56, 0xb8, 0x54, 0x39,
-	0xa6, 0x34, 0x62, 0x7b, 0x41, 0x60, 0x5b, 0x20, 0xf3, 0xa9, 0x8f, 0xa7, 0x1a, 0x58, 0x72, 0xa0,
-	0xc1, 0xd9, 0x48, 0x9d, 0xbd, 0x92, 0xe6, 0x2a, 0xa1, 0xbb, 0x2d, 0xad, 0x1d, 0x4f, 0x18, 0x61,
-	0xad, 0x08, 0x58, 0x3a, 0x99, 0x4b, 0x80, 0xa5, 0x6a, 0x68, 0x25, 0x28, 0xea, 0xa2, 0x34, 0xa8,
-	0xd6, 0x32, 0xbd, 0x34, 0xc4, 0x5a, 0xd5, 0xf4, 0xd2, 0x10, 0xef, 0x52, 0x33, 0x4b, 0x83, 0xa7,
-	0xfc, 0x06, 0x61, 0x15, 0xeb, 0xe6, 0xd2, 0xc3, 0x2a, 0xb9, 0x15, 0x4d, 0x0f, 0xab, 0x94, 0x26,
-	0x34, 0x33, 0xac, 0x42, 0x8e, 0xb0, 0x3b, 0xad, 0x6d, 0x3d, 0x3e, 0x2c, 0x6b, 0x4f, 0x0e, 0xcb,
-	0xda, 0x9f, 0x87, 0x65, 0xed, 0xe1, 0x51, 0x79, 0xe4, 0xc9, 0x51, 0x79, 0xe4, 0xb7, 0xa3, 0xf2,
-	0xc8, 0x3b, 0xab, 0x7d, 0xdd, 0x47, 0x9b, 0x7a, 0x0d, 0xc6, 0x1d, 0x5e, 0x69, 0x59, 0x75, 0x2e,
-	0xcd, 0xee, 0x4b, 0xc3, 0xa2, 0x0b, 0xa9, 0x8f, 0x8b, 0x1f, 0x35, 0xaf, 0xfe, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xb6, 0x55, 0xa4, 0x9d, 0xe1, 0x15, 0x00, 0x00,
+func (m *QueryPositionsAtRiskResponse) GetPositions() PositionHealthResponses {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries module params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Accounts queries module accounts.
-	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
-	// Deposits queries jinx deposits.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// UnsyncedDeposits queries unsynced deposits.
-	UnsyncedDeposits(ctx context.Context, in *QueryUnsyncedDepositsRequest, opts ...grpc.CallOption) (*QueryUnsyncedDepositsResponse, error)
-	// TotalDeposited queries total coins deposited to jinx liquidity pools.
-	TotalDeposited(ctx context.Context, in *QueryTotalDepositedRequest, opts ...grpc.CallOption) (*QueryTotalDepositedResponse, error)
-	// Borrows queries jinx borrows.
-	Borrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error)
-	// UnsyncedBorrows queries unsynced borrows.
-	UnsyncedBorrows(ctx context.Context, in *QueryUnsyncedBorrowsRequest, opts ...grpc.CallOption) (*QueryUnsyncedBorrowsResponse, error)
-	// TotalBorrowed queries total coins borrowed from jinx liquidity pools.
-	TotalBorrowed(ctx context.Context, in *QueryTotalBorrowedRequest, opts ...grpc.CallOption) (*QueryTotalBorrowedResponse, error)
-	// InterestRate queries the jinx module interest rates.
-	InterestRate(ctx context.Context, in *QueryInterestRateRequest, opts ...grpc.CallOption) (*QueryInterestRateResponse, error)
-	// Reserves queries total jinx reserve coins.
-	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
-	// InterestFactors queries jinx module interest factors.
-	InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error)
+func (m *QueryPositionsAtRiskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// DepositResponse defines an amount of coins deposited into a jinx module account.
+type DepositResponse struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Index     SupplyInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=SupplyInterestFactorResponses" json:"index"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *DepositResponse) Reset()         { *m = DepositResponse{} }
+func (m *DepositResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositResponse) ProtoMessage()    {}
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{32}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
-	out := new(QueryAccountsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Accounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositResponse.Merge(m, src)
+}
+func (m *DepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UnsyncedDeposits(ctx context.Context, in *QueryUnsyncedDepositsRequest, opts ...grpc.CallOption) (*QueryUnsyncedDepositsResponse, error) {
-	out := new(QueryUnsyncedDepositsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/UnsyncedDeposits", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DepositResponse proto.InternalMessageInfo
+
+func (m *DepositResponse) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TotalDeposited(ctx context.Context, in *QueryTotalDepositedRequest, opts ...grpc.CallOption) (*QueryTotalDepositedResponse, error) {
-	out := new(QueryTotalDepositedResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/TotalDeposited", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DepositResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Borrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error) {
-	out := new(QueryBorrowsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Borrows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DepositResponse) GetIndex() SupplyInterestFactorResponses {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UnsyncedBorrows(ctx context.Context, in *QueryUnsyncedBorrowsRequest, opts ...grpc.CallOption) (*QueryUnsyncedBorrowsResponse, error) {
-	out := new(QueryUnsyncedBorrowsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/UnsyncedBorrows", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// SupplyInterestFactorResponse defines an individual borrow interest factor.
+type SupplyInterestFactorResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sdk.Dec as string
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (c *queryClient) TotalBorrowed(ctx context.Context, in *QueryTotalBorrowedRequest, opts ...grpc.CallOption) (*QueryTotalBorrowedResponse, error) {
-	out := new(QueryTotalBorrowedResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/TotalBorrowed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SupplyInterestFactorResponse) Reset()         { *m = SupplyInterestFactorResponse{} }
+func (m *SupplyInterestFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*SupplyInterestFactorResponse) ProtoMessage()    {}
+func (*SupplyInterestFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{33}
+}
+func (m *SupplyInterestFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyInterestFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyInterestFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SupplyInterestFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyInterestFactorResponse.Merge(m, src)
+}
+func (m *SupplyInterestFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyInterestFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyInterestFactorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) InterestRate(ctx context.Context, in *QueryInterestRateRequest, opts ...grpc.CallOption) (*QueryInterestRateResponse, error) {
-	out := new(QueryInterestRateResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/InterestRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SupplyInterestFactorResponse proto.InternalMessageInfo
+
+func (m *SupplyInterestFactorResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
-	out := new(QueryReservesResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Reserves", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SupplyInterestFactorResponse) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error) {
-	out := new(QueryInterestFactorsResponse)
-	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/InterestFactors", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// BorrowResponse defines an amount of coins borrowed from a jinx module account.
+type BorrowResponse struct {
+	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Index    BorrowInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=BorrowInterestFactorResponses" json:"index"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries module params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Accounts queries module accounts.
-	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
-	// Deposits queries jinx deposits.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// UnsyncedDeposits queries unsynced deposits.
-	UnsyncedDeposits(context.Context, *QueryUnsyncedDepositsRequest) (*QueryUnsyncedDepositsResponse, error)
-	// TotalDeposited queries total coins deposited to jinx liquidity pools.
-	TotalDeposited(context.Context, *QueryTotalDepositedRequest) (*QueryTotalDepositedResponse, error)
-	// Borrows queries jinx borrows.
-	Borrows(context.Context, *QueryBorrowsRequest) (*QueryBorrowsResponse, error)
-	// UnsyncedBorrows queries unsynced borrows.
-	UnsyncedBorrows(context.Context, *QueryUnsyncedBorrowsRequest) (*QueryUnsyncedBorrowsResponse, error)
-	// TotalBorrowed queries total coins borrowed from jinx liquidity pools.
-	TotalBorrowed(context.Context, *QueryTotalBorrowedRequest) (*QueryTotalBorrowedResponse, error)
-	// InterestRate queries the jinx module interest rates.
-	InterestRate(context.Context, *QueryInterestRateRequest) (*QueryInterestRateResponse, error)
-	// Reserves queries total jinx reserve coins.
-	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
-	// InterestFactors queries jinx module interest factors.
-	InterestFactors(context.Context, *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error)
+func (m *BorrowResponse) Reset()         { *m = BorrowResponse{} }
+func (m *BorrowResponse) String() string { return proto.CompactTextString(m) }
+func (*BorrowResponse) ProtoMessage()    {}
+func (*BorrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{34}
+}
+func (m *BorrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BorrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BorrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BorrowResponse.Merge(m, src)
+}
+func (m *BorrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BorrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BorrowResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_BorrowResponse proto.InternalMessageInfo
+
+func (m *BorrowResponse) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *BorrowResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+
+func (m *BorrowResponse) GetIndex() BorrowInterestFactorResponses {
+	if m != nil {
+		return m.Index
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+
+// BorrowInterestFactorResponse defines an individual borrow interest factor.
+type BorrowInterestFactorResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sdk.Dec as string
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
-func (*UnimplementedQueryServer) UnsyncedDeposits(ctx context.Context, req *QueryUnsyncedDepositsRequest) (*QueryUnsyncedDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsyncedDeposits not implemented")
+
+func (m *BorrowInterestFactorResponse) Reset()         { *m = BorrowInterestFactorResponse{} }
+func (m *BorrowInterestFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*BorrowInterestFactorResponse) ProtoMessage()    {}
+func (*BorrowInterestFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{35}
 }
-func (*UnimplementedQueryServer) TotalDeposited(ctx context.Context, req *QueryTotalDepositedRequest) (*QueryTotalDepositedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalDeposited not implemented")
+func (m *BorrowInterestFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Borrows(ctx context.Context, req *QueryBorrowsRequest) (*QueryBorrowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Borrows not implemented")
+func (m *BorrowInterestFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BorrowInterestFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UnsyncedBorrows(ctx context.Context, req *QueryUnsyncedBorrowsRequest) (*QueryUnsyncedBorrowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsyncedBorrows not implemented")
+func (m *BorrowInterestFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BorrowInterestFactorResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalBorrowed(ctx context.Context, req *QueryTotalBorrowedRequest) (*QueryTotalBorrowedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalBorrowed not implemented")
+func (m *BorrowInterestFactorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) InterestRate(ctx context.Context, req *QueryInterestRateRequest) (*QueryInterestRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InterestRate not implemented")
+func (m *BorrowInterestFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BorrowInterestFactorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReservesRequest) (*QueryReservesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reserves not implemented")
+
+var xxx_messageInfo_BorrowInterestFactorResponse proto.InternalMessageInfo
+
+func (m *BorrowInterestFactorResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) InterestFactors(ctx context.Context, req *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InterestFactors not implemented")
+
+func (m *BorrowInterestFactorResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// IsolatedPositionResponse defines the coins an account has deposited in, and borrowed from, an isolated market.
+type IsolatedPositionResponse struct {
+	Market       string                                   `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Owner        string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Deposit      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	DepositIndex SupplyInterestFactorResponses            `protobuf:"bytes,4,rep,name=deposit_index,json=depositIndex,proto3,castrepeated=SupplyInterestFactorResponses" json:"deposit_index"`
+	Borrow       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=borrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrow"`
+	BorrowIndex  BorrowInterestFactorResponses            `protobuf:"bytes,6,rep,name=borrow_index,json=borrowIndex,proto3,castrepeated=BorrowInterestFactorResponses" json:"borrow_index"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *IsolatedPositionResponse) Reset()         { *m = IsolatedPositionResponse{} }
+func (m *IsolatedPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*IsolatedPositionResponse) ProtoMessage()    {}
+func (*IsolatedPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{36}
+}
+func (m *IsolatedPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *IsolatedPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedPositionResponse.Merge(m, src)
+}
+func (m *IsolatedPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedPositionResponse.DiscardUnknown(m)
 }
 
-func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Accounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/Accounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+var xxx_messageInfo_IsolatedPositionResponse proto.InternalMessageInfo
+
+func (m *IsolatedPositionResponse) GetMarket() string {
+	if m != nil {
+		return m.Market
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+func (m *IsolatedPositionResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_UnsyncedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnsyncedDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsyncedDeposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/UnsyncedDeposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsyncedDeposits(ctx, req.(*QueryUnsyncedDepositsRequest))
+func (m *IsolatedPositionResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalDeposited_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalDepositedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalDeposited(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/TotalDeposited",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalDeposited(ctx, req.(*QueryTotalDepositedRequest))
+func (m *IsolatedPositionResponse) GetDepositIndex() SupplyInterestFactorResponses {
+	if m != nil {
+		return m.DepositIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Borrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBorrowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Borrows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/Borrows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Borrows(ctx, req.(*QueryBorrowsRequest))
+func (m *IsolatedPositionResponse) GetBorrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Borrow
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnsyncedBorrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnsyncedBorrowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsyncedBorrows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/UnsyncedBorrows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsyncedBorrows(ctx, req.(*QueryUnsyncedBorrowsRequest))
+func (m *IsolatedPositionResponse) GetBorrowIndex() BorrowInterestFactorResponses {
+	if m != nil {
+		return m.BorrowIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalBorrowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalBorrowedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalBorrowed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/TotalBorrowed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalBorrowed(ctx, req.(*QueryTotalBorrowedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MoneyMarketInterestRate is a unique type returned by interest rate queries
+type MoneyMarketInterestRate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sdk.Dec as String
+	SupplyInterestRate string `protobuf:"bytes,2,opt,name=supply_interest_rate,json=supplyInterestRate,proto3" json:"supply_interest_rate,omitempty"`
+	// sdk.Dec as String
+	BorrowInterestRate string `protobuf:"bytes,3,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3" json:"borrow_interest_rate,omitempty"`
 }
 
-func _Query_InterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInterestRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InterestRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/InterestRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InterestRate(ctx, req.(*QueryInterestRateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MoneyMarketInterestRate) Reset()         { *m = MoneyMarketInterestRate{} }
+func (m *MoneyMarketInterestRate) String() string { return proto.CompactTextString(m) }
+func (*MoneyMarketInterestRate) ProtoMessage()    {}
+func (*MoneyMarketInterestRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{37}
 }
-
-func _Query_Reserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReservesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Reserves(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/Reserves",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Reserves(ctx, req.(*QueryReservesRequest))
+func (m *MoneyMarketInterestRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoneyMarketInterestRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoneyMarketInterestRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MoneyMarketInterestRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoneyMarketInterestRate.Merge(m, src)
+}
+func (m *MoneyMarketInterestRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoneyMarketInterestRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoneyMarketInterestRate.DiscardUnknown(m)
 }
 
-func _Query_InterestFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInterestFactorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InterestFactors(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.jinx.v1beta1.Query/InterestFactors",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InterestFactors(ctx, req.(*QueryInterestFactorsRequest))
+var xxx_messageInfo_MoneyMarketInterestRate proto.InternalMessageInfo
+
+func (m *MoneyMarketInterestRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.jinx.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Accounts",
-			Handler:    _Query_Accounts_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "UnsyncedDeposits",
-			Handler:    _Query_UnsyncedDeposits_Handler,
-		},
-		{
-			MethodName: "TotalDeposited",
-			Handler:    _Query_TotalDeposited_Handler,
-		},
-		{
-			MethodName: "Borrows",
-			Handler:    _Query_Borrows_Handler,
-		},
-		{
-			MethodName: "UnsyncedBorrows",
-			Handler:    _Query_UnsyncedBorrows_Handler,
-		},
-		{
-			MethodName: "TotalBorrowed",
-			Handler:    _Query_TotalBorrowed_Handler,
-		},
-		{
-			MethodName: "InterestRate",
-			Handler:    _Query_InterestRate_Handler,
-		},
-		{
-			MethodName: "Reserves",
-			Handler:    _Query_Reserves_Handler,
-		},
-		{
-			MethodName: "InterestFactors",
-			Handler:    _Query_InterestFactors_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/jinx/v1beta1/query.proto",
+func (m *MoneyMarketInterestRate) GetSupplyInterestRate() string {
+	if m != nil {
+		return m.SupplyInterestRate
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MoneyMarketInterestRate) GetBorrowInterestRate() string {
+	if m != nil {
+		return m.BorrowInterestRate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InterestFactor is a unique type returned by interest factor queries
+type InterestFactor struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sdk.Dec as String
+	BorrowInterestFactor string `protobuf:"bytes,2,opt,name=borrow_interest_factor,json=borrowInterestFactor,proto3" json:"borrow_interest_factor,omitempty"`
+	// sdk.Dec as String
+	SupplyInterestFactor string `protobuf:"bytes,3,opt,name=supply_interest_factor,json=supplyInterestFactor,proto3" json:"supply_interest_factor,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *InterestFactor) Reset()         { *m = InterestFactor{} }
+func (m *InterestFactor) String() string { return proto.CompactTextString(m) }
+func (*InterestFactor) ProtoMessage()    {}
+func (*InterestFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{38}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InterestFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InterestFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestFactor.Merge(m, src)
+}
+func (m *InterestFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestFactor.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_InterestFactor proto.InternalMessageInfo
+
+func (m *InterestFactor) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InterestFactor) GetBorrowInterestFactor() string {
+	if m != nil {
+		return m.BorrowInterestFactor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InterestFactor) GetSupplyInterestFactor() string {
+	if m != nil {
+		return m.SupplyInterestFactor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PositionHealthResponse describes how close an account's deposits and borrows are to liquidation at current prices.
+type PositionHealthResponse struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// health_factor is the USD value of the deposits weighted by their liquidation thresholds divided by the USD value of
+	// the borrows. The position can be liquidated when it is below 1. Empty if the position has no borrows.
+	// sdk.Dec as String
+	HealthFactor string `protobuf:"bytes,2,opt,name=health_factor,json=healthFactor,proto3" json:"health_factor,omitempty"`
+	// sdk.Dec as String
+	DepositUsdValue string `protobuf:"bytes,3,opt,name=deposit_usd_value,json=depositUsdValue,proto3" json:"deposit_usd_value,omitempty"`
+	// sdk.Dec as String
+	BorrowUsdValue string `protobuf:"bytes,4,opt,name=borrow_usd_value,json=borrowUsdValue,proto3" json:"borrow_usd_value,omitempty"`
+	// max_borrow_usd_value is the USD value that can still be borrowed under the position's loan-to-values.
+	// sdk.Dec as String
+	MaxBorrowUsdValue string `protobuf:"bytes,5,opt,name=max_borrow_usd_value,json=maxBorrowUsdValue,proto3" json:"max_borrow_usd_value,omitempty"`
+	// max_withdraw is the amount of each deposit that can be withdrawn on its own without the position becoming
+	// liquidatable.
+	MaxWithdraw github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=max_withdraw,json=maxWithdraw,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_withdraw"`
+	// liquidation_prices holds the assets whose price alone can make the position liquidatable. It is empty once the
+	// position can be liquidated.
+	LiquidationPrices LiquidationPrices `protobuf:"bytes,7,rep,name=liquidation_prices,json=liquidationPrices,proto3,castrepeated=LiquidationPrices" json:"liquidation_prices"`
+}
+
+func (m *PositionHealthResponse) Reset()         { *m = PositionHealthResponse{} }
+func (m *PositionHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionHealthResponse) ProtoMessage()    {}
+func (*PositionHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{39}
+}
+func (m *PositionHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PositionHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PositionHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionHealthResponse.Merge(m, src)
+}
+func (m *PositionHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionHealthResponse proto.InternalMessageInfo
+
+func (m *PositionHealthResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionHealthResponse) GetHealthFactor() string {
+	if m != nil {
+		return m.HealthFactor
+	}
+	return ""
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *PositionHealthResponse) GetDepositUsdValue() string {
+	if m != nil {
+		return m.DepositUsdValue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PositionHealthResponse) GetBorrowUsdValue() string {
+	if m != nil {
+		return m.BorrowUsdValue
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionHealthResponse) GetMaxBorrowUsdValue() string {
+	if m != nil {
+		return m.MaxBorrowUsdValue
+	}
+	return ""
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *PositionHealthResponse) GetMaxWithdraw() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxWithdraw
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryUnsyncedDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PositionHealthResponse) GetLiquidationPrices() LiquidationPrices {
+	if m != nil {
+		return m.LiquidationPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUnsyncedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LiquidationPrice defines the prices of a deposited or borrowed asset at which a position becomes liquidatable, with
+// the prices of all other assets unchanged.
+type LiquidationPrice struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// lower_price is the price below which the position can be liquidated, empty if there is none.
+	// sdk.Dec as String
+	LowerPrice string `protobuf:"bytes,2,opt,name=lower_price,json=lowerPrice,proto3" json:"lower_price,omitempty"`
+	// upper_price is the price above which the position can be liquidated, empty if there is none.
+	// sdk.Dec as String
+	UpperPrice string `protobuf:"bytes,3,opt,name=upper_price,json=upperPrice,proto3" json:"upper_price,omitempty"`
 }
 
-func (m *QueryUnsyncedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *LiquidationPrice) Reset()         { *m = LiquidationPrice{} }
+func (m *LiquidationPrice) String() string { return proto.CompactTextString(m) }
+func (*LiquidationPrice) ProtoMessage()    {}
+func (*LiquidationPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7cf6034fe0ed27, []int{40}
+}
+func (m *LiquidationPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *LiquidationPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationPrice.Merge(m, src)
+}
+func (m *LiquidationPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationPrice.DiscardUnknown(m)
 }
 
-func (m *QueryUnsyncedDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LiquidationPrice proto.InternalMessageInfo
+
+func (m *LiquidationPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnsyncedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LiquidationPrice) GetLowerPrice() string {
+	if m != nil {
+		return m.LowerPrice
+	}
+	return ""
 }
 
-func (m *QueryUnsyncedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *LiquidationPrice) GetUpperPrice() string {
+	if m != nil {
+		return m.UpperPrice
 	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTotalDepositedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "fury.jinx.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fury.jinx.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryAccountsRequest)(nil), "fury.jinx.v1beta1.QueryAccountsRequest")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "fury.jinx.v1beta1.QueryAccountsResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "fury.jinx.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "fury.jinx.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryUnsyncedDepositsRequest)(nil), "fury.jinx.v1beta1.QueryUnsyncedDepositsRequest")
+	proto.RegisterType((*QueryUnsyncedDepositsResponse)(nil), "fury.jinx.v1beta1.QueryUnsyncedDepositsResponse")
+	proto.RegisterType((*QueryTotalDepositedRequest)(nil), "fury.jinx.v1beta1.QueryTotalDepositedRequest")
+	proto.RegisterType((*QueryTotalDepositedResponse)(nil), "fury.jinx.v1beta1.QueryTotalDepositedResponse")
+	proto.RegisterType((*QueryBorrowsRequest)(nil), "fury.jinx.v1beta1.QueryBorrowsRequest")
+	proto.RegisterType((*QueryBorrowsResponse)(nil), "fury.jinx.v1beta1.QueryBorrowsResponse")
+	proto.RegisterType((*QueryUnsyncedBorrowsRequest)(nil), "fury.jinx.v1beta1.QueryUnsyncedBorrowsRequest")
+	proto.RegisterType((*QueryUnsyncedBorrowsResponse)(nil), "fury.jinx.v1beta1.QueryUnsyncedBorrowsResponse")
+	proto.RegisterType((*QueryTotalBorrowedRequest)(nil), "fury.jinx.v1beta1.QueryTotalBorrowedRequest")
+	proto.RegisterType((*QueryTotalBorrowedResponse)(nil), "fury.jinx.v1beta1.QueryTotalBorrowedResponse")
+	proto.RegisterType((*QueryInterestRateRequest)(nil), "fury.jinx.v1beta1.QueryInterestRateRequest")
+	proto.RegisterType((*QueryInterestRateResponse)(nil), "fury.jinx.v1beta1.QueryInterestRateResponse")
+	proto.RegisterType((*QueryReservesRequest)(nil), "fury.jinx.v1beta1.QueryReservesRequest")
+	proto.RegisterType((*QueryReservesResponse)(nil), "fury.jinx.v1beta1.QueryReservesResponse")
+	proto.RegisterType((*QueryInterestFactorsRequest)(nil), "fury.jinx.v1beta1.QueryInterestFactorsRequest")
+	proto.RegisterType((*QueryInterestFactorsResponse)(nil), "fury.jinx.v1beta1.QueryInterestFactorsResponse")
+	proto.RegisterType((*QueryIsolatedMarketsRequest)(nil), "fury.jinx.v1beta1.QueryIsolatedMarketsRequest")
+	proto.RegisterType((*QueryIsolatedMarketsResponse)(nil), "fury.jinx.v1beta1.QueryIsolatedMarketsResponse")
+	proto.RegisterType((*QueryIsolatedPositionsRequest)(nil), "fury.jinx.v1beta1.QueryIsolatedPositionsRequest")
+	proto.RegisterType((*QueryIsolatedPositionsResponse)(nil), "fury.jinx.v1beta1.QueryIsolatedPositionsResponse")
+	proto.RegisterType((*QueryEModeRequest)(nil), "fury.jinx.v1beta1.QueryEModeRequest")
+	proto.RegisterType((*QueryEModeResponse)(nil), "fury.jinx.v1beta1.QueryEModeResponse")
+	proto.RegisterType((*QueryPositionHealthRequest)(nil), "fury.jinx.v1beta1.QueryPositionHealthRequest")
+	proto.RegisterType((*QueryPositionHealthResponse)(nil), "fury.jinx.v1beta1.QueryPositionHealthResponse")
+	proto.RegisterType((*QueryPositionsAtRiskRequest)(nil), "fury.jinx.v1beta1.QueryPositionsAtRiskRequest")
+	proto.RegisterType((*QueryPositionsAtRiskResponse)(nil), "fury.jinx.v1beta1.QueryPositionsAtRiskResponse")
+	proto.RegisterType((*DepositResponse)(nil), "fury.jinx.v1beta1.DepositResponse")
+	proto.RegisterType((*SupplyInterestFactorResponse)(nil), "fury.jinx.v1beta1.SupplyInterestFactorResponse")
+	proto.RegisterType((*BorrowResponse)(nil), "fury.jinx.v1beta1.BorrowResponse")
+	proto.RegisterType((*BorrowInterestFactorResponse)(nil), "fury.jinx.v1beta1.BorrowInterestFactorResponse")
+	proto.RegisterType((*IsolatedPositionResponse)(nil), "fury.jinx.v1beta1.IsolatedPositionResponse")
+	proto.RegisterType((*MoneyMarketInterestRate)(nil), "fury.jinx.v1beta1.MoneyMarketInterestRate")
+	proto.RegisterType((*InterestFactor)(nil), "fury.jinx.v1beta1.InterestFactor")
+	proto.RegisterType((*PositionHealthResponse)(nil), "fury.jinx.v1beta1.PositionHealthResponse")
+	proto.RegisterType((*LiquidationPrice)(nil), "fury.jinx.v1beta1.LiquidationPrice")
+}
+
+func init() { proto.RegisterFile("fury/jinx/v1beta1/query.proto", fileDescriptor_0b7cf6034fe0ed27) }
+
+var fileDescriptor_0b7cf6034fe0ed27 = []byte{
+	// 1965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xdb, 0xf1, 0xd7, 0xf3, 0x77, 0x31, 0x71, 0xc6, 0x1d, 0x67, 0x9c, 0xb4, 0xe3, 0xc4,
+	0xeb, 0x78, 0xa6, 0x1d, 0xef, 0x02, 0xe7, 0x0c, 0x61, 0xb3, 0x8b, 0x36, 0x28, 0x74, 0x76, 0x01,
+	0x21, 0xa1, 0x51, 0xcf, 0x74, 0x65, 0xdc, 0x9b, 0x99, 0xae, 0x49, 0x57, 0x4f, 0xe2, 0xd9, 0x10,
+	0x24, 0x56, 0xe2, 0xbe, 0x90, 0x03, 0xe2, 0x43, 0x02, 0x69, 0x11, 0x48, 0x80, 0xc4, 0x05, 0x2e,
+	0x48, 0x5c, 0x38, 0xed, 0x71, 0x61, 0x2f, 0x9c, 0x00, 0x25, 0x1c, 0xf8, 0x33, 0x50, 0x57, 0xbd,
+	0xea, 0x99, 0xee, 0xe9, 0x9e, 0x9e, 0x20, 0x9b, 0xf5, 0x9e, 0x32, 0x55, 0xf5, 0x7b, 0xef, 0xfd,
+	0xde, 0x47, 0x7d, 0xf4, 0x8b, 0xe1, 0xe2, 0xfd, 0xae, 0xdf, 0x33, 0xdf, 0x75, 0xbd, 0x23, 0xf3,
+	0xd1, 0x8d, 0x3a, 0x0d, 0xec, 0x1b, 0xe6, 0xc3, 0x2e, 0xf5, 0x7b, 0x95, 0x8e, 0xcf, 0x02, 0x46,
+	0x56, 0xc3, 0xe5, 0x4a, 0xb8, 0x5c, 0xc1, 0x65, 0xbd, 0xd4, 0x60, 0xbc, 0xcd, 0xb8, 0x69, 0x77,
+	0x83, 0xc3, 0x48, 0x26, 0x1c, 0x48, 0x11, 0x7d, 0x17, 0xd7, 0xeb, 0x36, 0xa7, 0x52, 0x57, 0x84,
+	0xea, 0xd8, 0x4d, 0xd7, 0xb3, 0x03, 0x97, 0x79, 0x88, 0x2d, 0x0d, 0x62, 0x15, 0xaa, 0xc1, 0x5c,
+	0xb5, 0xbe, 0x2e, 0xd7, 0x6b, 0x62, 0x64, 0xca, 0x01, 0x2e, 0x6d, 0x0c, 0x13, 0x17, 0x34, 0xe5,
+	0x6a, 0xa1, 0xc9, 0x9a, 0x4c, 0x4a, 0x85, 0xbf, 0x94, 0x4c, 0x93, 0xb1, 0x66, 0x8b, 0x9a, 0x76,
+	0xc7, 0x35, 0x6d, 0xcf, 0x63, 0x81, 0xe0, 0x82, 0x1a, 0x8d, 0x02, 0x90, 0xaf, 0x85, 0x74, 0xef,
+	0xda, 0xbe, 0xdd, 0xe6, 0x16, 0x7d, 0xd8, 0xa5, 0x3c, 0x30, 0xbe, 0x0a, 0x9f, 0x8b, 0xcd, 0xf2,
+	0x0e, 0xf3, 0x38, 0x25, 0x5f, 0x84, 0xe9, 0x8e, 0x98, 0x29, 0x6a, 0x97, 0xb4, 0x9d, 0xf9, 0x83,
+	0xf5, 0xca, 0x50, 0xa4, 0x2a, 0x52, 0xa4, 0x7a, 0xf6, 0xa3, 0x7f, 0x6c, 0x9e, 0xb1, 0x10, 0x6e,
+	0xac, 0x41, 0x41, 0xe8, 0xbb, 0xd9, 0x68, 0xb0, 0xae, 0x17, 0x44, 0x76, 0xbe, 0x0d, 0xe7, 0x12,
+	0xf3, 0x68, 0xe9, 0x16, 0xcc, 0xda, 0x38, 0x57, 0xd4, 0x2e, 0x4d, 0xee, 0xcc, 0x1f, 0x18, 0x15,
+	0x8c, 0x84, 0x88, 0xba, 0xb2, 0x76, 0x87, 0x39, 0xdd, 0x16, 0x45, 0x71, 0x34, 0x1a, 0x49, 0x1a,
+	0xbf, 0xd4, 0xd0, 0xee, 0x2d, 0xda, 0x61, 0xdc, 0x8d, 0xec, 0x92, 0x02, 0x4c, 0x39, 0xd4, 0x63,
+	0x6d, 0xe1, 0xc7, 0x9c, 0x25, 0x07, 0xa4, 0x02, 0x53, 0xec, 0xb1, 0x47, 0xfd, 0xe2, 0x44, 0x38,
+	0x5b, 0x2d, 0xfe, 0xed, 0x0f, 0xe5, 0x02, 0x1a, 0xbd, 0xe9, 0x38, 0x3e, 0xe5, 0xfc, 0x5e, 0xe0,
+	0xbb, 0x5e, 0xd3, 0x92, 0x30, 0xf2, 0x3a, 0x40, 0x3f, 0xb9, 0xc5, 0x49, 0x11, 0x92, 0xab, 0x8a,
+	0x66, 0x98, 0xdd, 0x8a, 0xac, 0xaa, 0x7e, 0x68, 0x9a, 0x14, 0x19, 0x58, 0x03, 0x92, 0xc6, 0x9f,
+	0x34, 0x38, 0x97, 0xa0, 0x89, 0x61, 0xf8, 0x26, 0xcc, 0x3a, 0x38, 0x17, 0x85, 0x61, 0x38, 0xe4,
+	0x28, 0xa6, 0xa4, 0xaa, 0xc5, 0x30, 0x0c, 0xbf, 0xf9, 0xe7, 0xe6, 0x4a, 0x62, 0x81, 0x5b, 0x91,
+	0x36, 0x72, 0x3b, 0xc6, 0x7d, 0x42, 0x70, 0xbf, 0x96, 0xcb, 0x5d, 0xea, 0x89, 0x91, 0xff, 0x9d,
+	0x06, 0x1b, 0x82, 0xfc, 0x3b, 0x1e, 0xef, 0x79, 0x0d, 0xea, 0x9c, 0xee, 0x58, 0xff, 0x45, 0x83,
+	0x8b, 0x19, 0x74, 0x3f, 0x3b, 0x31, 0x3f, 0x00, 0x5d, 0xf8, 0xf0, 0x36, 0x0b, 0xec, 0x16, 0x1a,
+	0xa4, 0xce, 0xc8, 0x80, 0x1b, 0x3f, 0xd0, 0xe0, 0x42, 0xaa, 0x10, 0xba, 0xed, 0xc3, 0x12, 0xef,
+	0x76, 0x3a, 0x2d, 0x97, 0x3a, 0xb5, 0xf0, 0x30, 0xe2, 0xc5, 0x09, 0xe1, 0xfc, 0x7a, 0x8c, 0xa0,
+	0xa2, 0xf6, 0x25, 0xe6, 0x7a, 0xd5, 0x7d, 0xf4, 0x79, 0xa7, 0xe9, 0x06, 0x87, 0xdd, 0x7a, 0xa5,
+	0xc1, 0xda, 0x78, 0x5c, 0xe1, 0x3f, 0x65, 0xee, 0x3c, 0x30, 0x83, 0x5e, 0x87, 0x72, 0x21, 0xc0,
+	0xad, 0x45, 0x65, 0x42, 0x0c, 0x8d, 0x0f, 0x35, 0x3c, 0x67, 0xaa, 0xcc, 0xf7, 0xd9, 0xe3, 0x53,
+	0x5a, 0x32, 0x7f, 0x54, 0xa7, 0x48, 0xc4, 0x12, 0x43, 0xf6, 0x36, 0xcc, 0xd4, 0xe5, 0x14, 0x16,
+	0xca, 0xe5, 0x94, 0x42, 0x91, 0x42, 0x51, 0x9d, 0x9c, 0xc7, 0x98, 0x2d, 0xc7, 0xe7, 0xb9, 0xa5,
+	0x54, 0x1d, 0x5f, 0x95, 0xfc, 0x56, 0x65, 0x5c, 0x95, 0xfa, 0xa9, 0x8e, 0xf2, 0x9f, 0x93, 0xe7,
+	0xc8, 0x67, 0x2c, 0xda, 0x37, 0x60, 0xbd, 0xbf, 0xbd, 0xa4, 0xb9, 0xbc, 0x2d, 0xf9, 0x81, 0x06,
+	0x7a, 0x9a, 0x4c, 0x7f, 0x47, 0xd6, 0x71, 0xee, 0x04, 0x77, 0xa4, 0x32, 0x21, 0x77, 0xe4, 0x3e,
+	0x14, 0x05, 0xa3, 0x37, 0xbd, 0x80, 0xfa, 0x61, 0x8a, 0xec, 0x80, 0xe6, 0x3a, 0xb1, 0x9e, 0x22,
+	0x82, 0x3e, 0x70, 0x58, 0x72, 0x71, 0xbe, 0xe6, 0xdb, 0x01, 0x55, 0xb9, 0xdb, 0x4d, 0xc9, 0xdd,
+	0x1d, 0xe6, 0xd1, 0xde, 0x1d, 0xdb, 0x7f, 0x40, 0x83, 0x41, 0x5d, 0xd5, 0x4b, 0xe8, 0x54, 0x31,
+	0x03, 0xc0, 0xad, 0x45, 0x77, 0x70, 0x68, 0xec, 0xe1, 0x7e, 0xb5, 0x28, 0xa7, 0xfe, 0x23, 0x3a,
+	0xba, 0xe0, 0x8d, 0xef, 0xc0, 0xb9, 0x04, 0x1a, 0xb9, 0x37, 0x60, 0xda, 0x6e, 0xb3, 0xae, 0x17,
+	0x9c, 0x44, 0xdc, 0x51, 0xb5, 0xf1, 0x2a, 0xee, 0x51, 0xe5, 0xd0, 0xeb, 0x76, 0x23, 0x60, 0x7e,
+	0x0e, 0xe5, 0xef, 0xab, 0xbd, 0x32, 0x24, 0x85, 0xd4, 0x29, 0xac, 0x44, 0x61, 0xbf, 0x2f, 0xd7,
+	0x46, 0x6c, 0x9a, 0xb8, 0x96, 0xfe, 0xa6, 0x49, 0x6a, 0x5f, 0x76, 0xe3, 0x13, 0xc6, 0xe7, 0x15,
+	0x79, 0xce, 0x5a, 0x76, 0x40, 0x1d, 0x99, 0x9b, 0x88, 0xfc, 0x1a, 0x4c, 0xb7, 0xc5, 0x0c, 0xb2,
+	0xc7, 0x91, 0xf1, 0x93, 0x88, 0x7e, 0x52, 0x0e, 0xe9, 0xbf, 0x07, 0x6b, 0x2e, 0x2e, 0xd5, 0xa4,
+	0x4c, 0x8d, 0x07, 0x03, 0xd5, 0x73, 0x35, 0xcd, 0x89, 0x98, 0xae, 0x7b, 0x21, 0xbc, 0xba, 0x81,
+	0x9e, 0x14, 0x52, 0x16, 0xb9, 0x55, 0x70, 0x53, 0x66, 0x8d, 0xdf, 0xab, 0x07, 0x82, 0x92, 0xb9,
+	0x1b, 0x5e, 0x94, 0x2e, 0xf3, 0xf2, 0xdc, 0xfa, 0xd4, 0x4e, 0xce, 0xff, 0x68, 0x50, 0xca, 0x62,
+	0x8c, 0x01, 0x7d, 0x0a, 0x24, 0x0a, 0x68, 0x47, 0xad, 0x62, 0x30, 0xaf, 0x8f, 0x08, 0xa6, 0xd2,
+	0x14, 0x1d, 0xa8, 0x97, 0x31, 0xa2, 0xeb, 0x59, 0x08, 0x6e, 0xad, 0xba, 0x49, 0x1a, 0xc7, 0x77,
+	0xc8, 0xde, 0x86, 0x55, 0xe1, 0xe9, 0x97, 0xef, 0x30, 0x27, 0x3a, 0x97, 0x0e, 0x60, 0x06, 0x5f,
+	0xfc, 0x45, 0x2d, 0x27, 0xf2, 0x0a, 0x68, 0xbc, 0x01, 0x64, 0x50, 0x11, 0x86, 0x49, 0x87, 0xd9,
+	0x86, 0x1d, 0xd0, 0x26, 0xf3, 0x7b, 0x98, 0xdb, 0x68, 0x1c, 0x66, 0xdd, 0x6e, 0x04, 0xee, 0x23,
+	0x2a, 0xf8, 0xcf, 0x5a, 0x38, 0x32, 0xde, 0xc2, 0x33, 0x5c, 0x79, 0xfb, 0x06, 0xb5, 0x5b, 0xc1,
+	0xa1, 0xe2, 0x16, 0xd5, 0x84, 0x36, 0x56, 0x4d, 0x18, 0xf7, 0xe1, 0x42, 0xaa, 0x36, 0x24, 0x78,
+	0x1b, 0xa6, 0x0f, 0xc5, 0x0c, 0x7e, 0x80, 0xbd, 0x92, 0xf6, 0x01, 0x96, 0x2a, 0xaa, 0x3e, 0xc8,
+	0xa4, 0xb8, 0x41, 0x13, 0x76, 0xf8, 0xcd, 0xc0, 0x72, 0xf9, 0x03, 0x45, 0x3b, 0x5e, 0x9a, 0xda,
+	0xff, 0x5c, 0x9a, 0x7f, 0x55, 0x3b, 0x7d, 0xc8, 0x0e, 0x3a, 0x74, 0x08, 0x73, 0xc9, 0x7a, 0x7c,
+	0x09, 0x9f, 0x36, 0xb1, 0x1a, 0xcf, 0xa7, 0xaf, 0x73, 0x6b, 0xae, 0x73, 0xfc, 0x35, 0xf8, 0xb3,
+	0x09, 0x58, 0x4e, 0x3c, 0xf2, 0xc9, 0x17, 0x60, 0x0e, 0x5f, 0xf9, 0x2c, 0x3f, 0xd5, 0x7d, 0xe8,
+	0xff, 0xe5, 0x8a, 0x21, 0x2d, 0x98, 0x72, 0x3d, 0x87, 0x1e, 0x15, 0x27, 0x85, 0x0d, 0x33, 0x25,
+	0xbe, 0xf7, 0xc2, 0x67, 0x79, 0xe2, 0x36, 0x89, 0xa2, 0xbc, 0x8d, 0x96, 0x2f, 0x8e, 0x42, 0x71,
+	0x4b, 0x1a, 0x31, 0xbe, 0x02, 0x1b, 0xa3, 0x70, 0x19, 0xaf, 0xce, 0x02, 0x4c, 0x3d, 0xb2, 0x5b,
+	0x5d, 0xb9, 0xb9, 0xe6, 0x2c, 0x39, 0x30, 0x7e, 0x3c, 0x01, 0x4b, 0xf1, 0x97, 0x1b, 0x79, 0x0d,
+	0x66, 0xf1, 0xc5, 0x92, 0x1f, 0xe8, 0x08, 0x79, 0x6a, 0xe2, 0x2c, 0x9d, 0xc9, 0x8b, 0xf3, 0x28,
+	0xd4, 0x60, 0x9c, 0x47, 0xe1, 0x5e, 0x2a, 0xce, 0xbf, 0x3a, 0x0b, 0xc5, 0xac, 0x03, 0xfd, 0xd8,
+	0xae, 0x3b, 0x0a, 0x33, 0x58, 0xf8, 0xc5, 0xc9, 0xe3, 0x4f, 0x82, 0xd2, 0x4d, 0x9e, 0xc0, 0x22,
+	0xfe, 0xac, 0xc9, 0x6c, 0x9c, 0x3d, 0xd1, 0xaa, 0x5f, 0x40, 0x63, 0x6f, 0x86, 0xb6, 0xc2, 0x3a,
+	0x93, 0x35, 0x57, 0x9c, 0x3a, 0x81, 0x3a, 0x93, 0xaa, 0x49, 0x0f, 0x16, 0xe4, 0x2f, 0x74, 0x70,
+	0xfa, 0x44, 0xcb, 0x6d, 0xbe, 0x8e, 0xcb, 0x61, 0xd1, 0x3d, 0xd3, 0xe0, 0x7c, 0xc6, 0x2b, 0x3c,
+	0xa3, 0xe0, 0xf6, 0xa1, 0x20, 0xbe, 0xf9, 0x7b, 0xb5, 0xd8, 0x77, 0x00, 0xd6, 0x1f, 0xe1, 0xb1,
+	0xe0, 0x0a, 0x3d, 0xfb, 0x50, 0x88, 0xdc, 0x1b, 0x94, 0x98, 0x94, 0x12, 0xf5, 0x18, 0xdb, 0x50,
+	0xc2, 0xf8, 0xa1, 0x06, 0x4b, 0x71, 0xfa, 0x19, 0x64, 0x5e, 0x83, 0xb5, 0xa4, 0x6a, 0xf9, 0x3a,
+	0x46, 0x3a, 0x85, 0x7a, 0x4a, 0x28, 0x42, 0xa9, 0xa4, 0x0b, 0x28, 0x25, 0x29, 0x15, 0x78, 0x4a,
+	0x85, 0x18, 0x9f, 0x4c, 0xc2, 0x5a, 0xc6, 0x2d, 0xfe, 0x92, 0x8f, 0x02, 0xb2, 0x05, 0x8b, 0xf2,
+	0xda, 0x8e, 0xb3, 0x5d, 0x90, 0x93, 0xc8, 0x72, 0x17, 0x56, 0x55, 0xdd, 0x77, 0xb9, 0x53, 0x93,
+	0xbb, 0x5c, 0x12, 0x5c, 0xc6, 0x85, 0x77, 0xb8, 0xf3, 0xf5, 0x70, 0x9a, 0xec, 0xc0, 0x0a, 0xc6,
+	0xa1, 0x0f, 0x3d, 0x2b, 0xa0, 0xf8, 0xc5, 0x19, 0x21, 0x4d, 0x28, 0xb4, 0xed, 0xa3, 0xda, 0x10,
+	0x7a, 0x4a, 0xa0, 0x57, 0xdb, 0xf6, 0x51, 0x35, 0x2e, 0xe0, 0xc1, 0x42, 0x28, 0xf0, 0xd8, 0x0d,
+	0x0e, 0x1d, 0xdf, 0x7e, 0x5c, 0x9c, 0x3e, 0xfe, 0x7d, 0x30, 0xdf, 0xb6, 0x8f, 0xbe, 0x81, 0xfa,
+	0x49, 0x1b, 0x48, 0xcb, 0x7d, 0xd8, 0x75, 0x1d, 0x71, 0x39, 0xd7, 0x3a, 0xbe, 0xdb, 0xa0, 0xbc,
+	0x38, 0x23, 0xac, 0x6e, 0xa5, 0x6c, 0x89, 0xb7, 0xfa, 0xe0, 0xbb, 0x21, 0xb6, 0xba, 0x8e, 0xf6,
+	0x57, 0x93, 0x2b, 0xdc, 0x5a, 0x6d, 0x25, 0xa7, 0x8c, 0x77, 0x61, 0x25, 0x89, 0xcb, 0xa8, 0xb5,
+	0x4d, 0x98, 0x6f, 0x85, 0x77, 0x8f, 0xa4, 0x84, 0x29, 0x03, 0x31, 0x25, 0xc5, 0x36, 0x61, 0xbe,
+	0xdb, 0xe9, 0x44, 0x00, 0x99, 0x2a, 0x10, 0x53, 0x02, 0x70, 0xf0, 0x82, 0xc0, 0x94, 0x78, 0x3c,
+	0x91, 0xf7, 0x60, 0x5a, 0xb6, 0xd5, 0xc9, 0x76, 0x8a, 0x4b, 0xc3, 0xfd, 0x7b, 0xfd, 0x6a, 0x1e,
+	0x4c, 0x56, 0xa2, 0x71, 0xf9, 0xfd, 0x4f, 0xfe, 0xfd, 0x6c, 0xe2, 0x02, 0x59, 0x37, 0x87, 0xff,
+	0x63, 0x41, 0xb6, 0xee, 0xc9, 0xfb, 0x1a, 0xcc, 0xaa, 0xf6, 0x3c, 0xb9, 0x96, 0xa5, 0x37, 0xd1,
+	0xd8, 0xd7, 0x77, 0xf2, 0x81, 0x48, 0x61, 0x4b, 0x50, 0xb8, 0x48, 0x2e, 0xa4, 0x50, 0x50, 0x8d,
+	0x7c, 0x41, 0x42, 0x35, 0x6a, 0xb3, 0x49, 0x24, 0x3a, 0xcf, 0xfa, 0x4e, 0x3e, 0x70, 0x0c, 0x12,
+	0x51, 0xfb, 0xf6, 0x43, 0x0d, 0x56, 0x92, 0x5d, 0x63, 0x62, 0x66, 0xd9, 0xc8, 0x68, 0x87, 0xeb,
+	0xfb, 0xe3, 0x0b, 0x20, 0xb9, 0x3d, 0x41, 0xee, 0x2a, 0xb9, 0x92, 0x42, 0xae, 0x8b, 0x42, 0xe5,
+	0x88, 0xe5, 0x4f, 0x35, 0x58, 0x8a, 0xb7, 0x78, 0x49, 0x39, 0xcb, 0x64, 0x6a, 0xff, 0x58, 0xaf,
+	0x8c, 0x0b, 0x47, 0x7e, 0xbb, 0x82, 0xdf, 0x15, 0x62, 0xa4, 0xf0, 0x0b, 0x42, 0x11, 0x45, 0x8e,
+	0x3a, 0xe4, 0xbb, 0x30, 0x83, 0x7d, 0x3d, 0x92, 0x59, 0xa3, 0xf1, 0x36, 0xa5, 0x7e, 0x2d, 0x17,
+	0x87, 0x3c, 0x0c, 0xc1, 0x63, 0x83, 0xe8, 0x29, 0x3c, 0x54, 0xbb, 0xef, 0xe7, 0x1a, 0x2c, 0x27,
+	0x1a, 0x8c, 0xa4, 0x92, 0x97, 0x91, 0x04, 0x21, 0x73, 0x6c, 0x3c, 0x12, 0xbb, 0x2e, 0x88, 0x6d,
+	0x93, 0xad, 0x51, 0x09, 0x54, 0x0c, 0x7f, 0xa4, 0xc1, 0x62, 0xac, 0x1f, 0x48, 0xf6, 0x46, 0xe6,
+	0x23, 0xd1, 0x6a, 0xd4, 0xcb, 0x63, 0xa2, 0x91, 0xdb, 0x2b, 0x82, 0xdb, 0x16, 0xb9, 0x9c, 0x99,
+	0x3c, 0xd5, 0x20, 0x24, 0xcf, 0x34, 0x58, 0x88, 0xdd, 0xd4, 0xd7, 0xb3, 0x4c, 0xa5, 0x74, 0x0f,
+	0xf5, 0xbd, 0xf1, 0xc0, 0x48, 0x6b, 0x47, 0xd0, 0x32, 0xc8, 0xa5, 0x14, 0x5a, 0xea, 0x16, 0x2e,
+	0xfb, 0x21, 0x89, 0xf0, 0x68, 0x50, 0xad, 0xbb, 0xec, 0xa3, 0x21, 0xd1, 0x0a, 0xd4, 0x77, 0xf2,
+	0x81, 0x63, 0x1c, 0x0d, 0xbe, 0xb2, 0x1b, 0x96, 0x55, 0xa2, 0x5b, 0x96, 0x5d, 0x56, 0xe9, 0xad,
+	0x3e, 0xdd, 0x1c, 0x1b, 0x3f, 0x46, 0x59, 0x45, 0x31, 0xc2, 0xee, 0x9f, 0x64, 0x18, 0x6f, 0xb7,
+	0x8d, 0x60, 0x98, 0xda, 0xcf, 0xd3, 0xcd, 0xb1, 0xf1, 0xe3, 0x30, 0x44, 0x99, 0x72, 0x1b, 0xd9,
+	0xfc, 0x5a, 0x83, 0xd5, 0xa1, 0x0e, 0x16, 0xd9, 0xcf, 0xb3, 0x99, 0x6c, 0xcf, 0xe9, 0x37, 0x5e,
+	0x42, 0x02, 0x79, 0x96, 0x05, 0xcf, 0x6b, 0x64, 0x7b, 0x14, 0xcf, 0x7e, 0x2b, 0xe1, 0x7b, 0x1a,
+	0x4c, 0x89, 0xc6, 0x11, 0xb9, 0x92, 0x65, 0x6b, 0xb0, 0x41, 0xa5, 0x6f, 0xe7, 0xa0, 0xc6, 0x88,
+	0x16, 0x2d, 0xb7, 0x99, 0x43, 0xcd, 0x27, 0x78, 0x23, 0x3e, 0x0d, 0x2f, 0xa3, 0xa5, 0xf8, 0xf3,
+	0x32, 0xfb, 0x98, 0x4f, 0x6d, 0x4d, 0xe9, 0x95, 0x71, 0xe1, 0x48, 0xef, 0x40, 0xd0, 0xdb, 0x23,
+	0xbb, 0x69, 0x6f, 0x05, 0x14, 0x29, 0xcb, 0x27, 0xa9, 0xf9, 0x44, 0x3c, 0x5c, 0x9f, 0x92, 0x5f,
+	0x68, 0xb0, 0x9c, 0x68, 0xfd, 0x90, 0x5c, 0xbb, 0xf1, 0x5e, 0x94, 0x6e, 0x8e, 0x8d, 0x1f, 0xe3,
+	0xbe, 0x8c, 0x92, 0x58, 0xb6, 0x83, 0xb2, 0xef, 0xf2, 0x07, 0xd5, 0x5b, 0x1f, 0x3d, 0x2f, 0x69,
+	0x1f, 0x3f, 0x2f, 0x69, 0xff, 0x7a, 0x5e, 0xd2, 0x3e, 0x78, 0x51, 0x3a, 0xf3, 0xf1, 0x8b, 0xd2,
+	0x99, 0xbf, 0xbf, 0x28, 0x9d, 0xf9, 0xd6, 0xee, 0xc0, 0x8b, 0xb4, 0x43, 0xfd, 0x06, 0xe3, 0x2e,
+	0x2f, 0xb7, 0xec, 0x3a, 0x97, 0x7a, 0x8f, 0xa4, 0x66, 0xf1, 0x32, 0xad, 0x4f, 0x8b, 0xbf, 0xa6,
+	0x78, 0xf5, 0xbf, 0x03, 0x00, 0x1e, 0xec, 0x05, 0x91, 0x5a, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Accounts queries module accounts.
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// Deposits queries jinx deposits.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// UnsyncedDeposits queries unsynced deposits.
+	UnsyncedDeposits(ctx context.Context, in *QueryUnsyncedDepositsRequest, opts ...grpc.CallOption) (*QueryUnsyncedDepositsResponse, error)
+	// TotalDeposited queries total coins deposited to jinx liquidity pools.
+	TotalDeposited(ctx context.Context, in *QueryTotalDepositedRequest, opts ...grpc.CallOption) (*QueryTotalDepositedResponse, error)
+	// Borrows queries jinx borrows.
+	Borrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error)
+	// UnsyncedBorrows queries unsynced borrows.
+	UnsyncedBorrows(ctx context.Context, in *QueryUnsyncedBorrowsRequest, opts ...grpc.CallOption) (*QueryUnsyncedBorrowsResponse, error)
+	// TotalBorrowed queries total coins borrowed from jinx liquidity pools.
+	TotalBorrowed(ctx context.Context, in *QueryTotalBorrowedRequest, opts ...grpc.CallOption) (*QueryTotalBorrowedResponse, error)
+	// InterestRate queries the jinx module interest rates.
+	InterestRate(ctx context.Context, in *QueryInterestRateRequest, opts ...grpc.CallOption) (*QueryInterestRateResponse, error)
+	// Reserves queries total jinx reserve coins.
+	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
+	// InterestFactors queries jinx module interest factors.
+	InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error)
+	// IsolatedMarkets queries the totals and interest factors of isolated markets.
+	IsolatedMarkets(ctx context.Context, in *QueryIsolatedMarketsRequest, opts ...grpc.CallOption) (*QueryIsolatedMarketsResponse, error)
+	// IsolatedPositions queries isolated market positions with optional filters.
+	IsolatedPositions(ctx context.Context, in *QueryIsolatedPositionsRequest, opts ...grpc.CallOption) (*QueryIsolatedPositionsResponse, error)
+	// EMode queries the e-mode category an account has opted in to.
+	EMode(ctx context.Context, in *QueryEModeRequest, opts ...grpc.CallOption) (*QueryEModeResponse, error)
+	// PositionHealth queries how close an account's position is to liquidation.
+	PositionHealth(ctx context.Context, in *QueryPositionHealthRequest, opts ...grpc.CallOption) (*QueryPositionHealthResponse, error)
+	// PositionsAtRisk queries positions with borrows, ordered from the lowest health factor.
+	PositionsAtRisk(ctx context.Context, in *QueryPositionsAtRiskRequest, opts ...grpc.CallOption) (*QueryPositionsAtRiskResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalDepositedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalDepositedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalDepositedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UnsyncedDeposits(ctx context.Context, in *QueryUnsyncedDepositsRequest, opts ...grpc.CallOption) (*QueryUnsyncedDepositsResponse, error) {
+	out := new(QueryUnsyncedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/UnsyncedDeposits", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalDepositedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalDeposited(ctx context.Context, in *QueryTotalDepositedRequest, opts ...grpc.CallOption) (*QueryTotalDepositedResponse, error) {
+	out := new(QueryTotalDepositedResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/TotalDeposited", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalDepositedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SuppliedCoins) > 0 {
-		for iNdEx := len(m.SuppliedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SuppliedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *queryClient) Borrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error) {
+	out := new(QueryBorrowsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Borrows", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBorrowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UnsyncedBorrows(ctx context.Context, in *QueryUnsyncedBorrowsRequest, opts ...grpc.CallOption) (*QueryUnsyncedBorrowsResponse, error) {
+	out := new(QueryUnsyncedBorrowsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/UnsyncedBorrows", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBorrowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalBorrowed(ctx context.Context, in *QueryTotalBorrowedRequest, opts ...grpc.CallOption) (*QueryTotalBorrowedResponse, error) {
+	out := new(QueryTotalBorrowedResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/TotalBorrowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) InterestRate(ctx context.Context, in *QueryInterestRateRequest, opts ...grpc.CallOption) (*QueryInterestRateResponse, error) {
+	out := new(QueryInterestRateResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/InterestRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
+	out := new(QueryReservesResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/Reserves", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBorrowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error) {
+	out := new(QueryInterestFactorsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/InterestFactors", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBorrowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsolatedMarkets(ctx context.Context, in *QueryIsolatedMarketsRequest, opts ...grpc.CallOption) (*QueryIsolatedMarketsResponse, error) {
+	out := new(QueryIsolatedMarketsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/IsolatedMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Borrows) > 0 {
-		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) IsolatedPositions(ctx context.Context, in *QueryIsolatedPositionsRequest, opts ...grpc.CallOption) (*QueryIsolatedPositionsResponse, error) {
+	out := new(QueryIsolatedPositionsResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/IsolatedPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryUnsyncedBorrowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EMode(ctx context.Context, in *QueryEModeRequest, opts ...grpc.CallOption) (*QueryEModeResponse, error) {
+	out := new(QueryEModeResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/EMode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryUnsyncedBorrowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryUnsyncedBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PositionHealth(ctx context.Context, in *QueryPositionHealthRequest, opts ...grpc.CallOption) (*QueryPositionHealthResponse, error) {
+	out := new(QueryPositionHealthResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/PositionHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryUnsyncedBorrowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PositionsAtRisk(ctx context.Context, in *QueryPositionsAtRiskRequest, opts ...grpc.CallOption) (*QueryPositionsAtRiskResponse, error) {
+	out := new(QueryPositionsAtRiskResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Query/PositionsAtRisk", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryUnsyncedBorrowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Accounts queries module accounts.
+	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	// Deposits queries jinx deposits.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// UnsyncedDeposits queries unsynced deposits.
+	UnsyncedDeposits(context.Context, *QueryUnsyncedDepositsRequest) (*QueryUnsyncedDepositsResponse, error)
+	// TotalDeposited queries total coins deposited to jinx liquidity pools.
+	TotalDeposited(context.Context, *QueryTotalDepositedRequest) (*QueryTotalDepositedResponse, error)
+	// Borrows queries jinx borrows.
+	Borrows(context.Context, *QueryBorrowsRequest) (*QueryBorrowsResponse, error)
+	// UnsyncedBorrows queries unsynced borrows.
+	UnsyncedBorrows(context.Context, *QueryUnsyncedBorrowsRequest) (*QueryUnsyncedBorrowsResponse, error)
+	// TotalBorrowed queries total coins borrowed from jinx liquidity pools.
+	TotalBorrowed(context.Context, *QueryTotalBorrowedRequest) (*QueryTotalBorrowedResponse, error)
+	// InterestRate queries the jinx module interest rates.
+	InterestRate(context.Context, *QueryInterestRateRequest) (*QueryInterestRateResponse, error)
+	// Reserves queries total jinx reserve coins.
+	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
+	// InterestFactors queries jinx module interest factors.
+	InterestFactors(context.Context, *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error)
+	// IsolatedMarkets queries the totals and interest factors of isolated markets.
+	IsolatedMarkets(context.Context, *QueryIsolatedMarketsRequest) (*QueryIsolatedMarketsResponse, error)
+	// IsolatedPositions queries isolated market positions with optional filters.
+	IsolatedPositions(context.Context, *QueryIsolatedPositionsRequest) (*QueryIsolatedPositionsResponse, error)
+	// EMode queries the e-mode category an account has opted in to.
+	EMode(context.Context, *QueryEModeRequest) (*QueryEModeResponse, error)
+	// PositionHealth queries how close an account's position is to liquidation.
+	PositionHealth(context.Context, *QueryPositionHealthRequest) (*QueryPositionHealthResponse, error)
+	// PositionsAtRisk queries positions with borrows, ordered from the lowest health factor.
+	PositionsAtRisk(context.Context, *QueryPositionsAtRiskRequest) (*QueryPositionsAtRiskResponse, error)
 }
 
-func (m *QueryUnsyncedBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Borrows) > 0 {
-		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTotalBorrowedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryTotalBorrowedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) UnsyncedDeposits(ctx context.Context, req *QueryUnsyncedDepositsRequest) (*QueryUnsyncedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsyncedDeposits not implemented")
+}
+func (*UnimplementedQueryServer) TotalDeposited(ctx context.Context, req *QueryTotalDepositedRequest) (*QueryTotalDepositedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalDeposited not implemented")
+}
+func (*UnimplementedQueryServer) Borrows(ctx context.Context, req *QueryBorrowsRequest) (*QueryBorrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Borrows not implemented")
+}
+func (*UnimplementedQueryServer) UnsyncedBorrows(ctx context.Context, req *QueryUnsyncedBorrowsRequest) (*QueryUnsyncedBorrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsyncedBorrows not implemented")
+}
+func (*UnimplementedQueryServer) TotalBorrowed(ctx context.Context, req *QueryTotalBorrowedRequest) (*QueryTotalBorrowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBorrowed not implemented")
+}
+func (*UnimplementedQueryServer) InterestRate(ctx context.Context, req *QueryInterestRateRequest) (*QueryInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterestRate not implemented")
+}
+func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReservesRequest) (*QueryReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserves not implemented")
+}
+func (*UnimplementedQueryServer) InterestFactors(ctx context.Context, req *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterestFactors not implemented")
+}
+func (*UnimplementedQueryServer) IsolatedMarkets(ctx context.Context, req *QueryIsolatedMarketsRequest) (*QueryIsolatedMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsolatedMarkets not implemented")
+}
+func (*UnimplementedQueryServer) IsolatedPositions(ctx context.Context, req *QueryIsolatedPositionsRequest) (*QueryIsolatedPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsolatedPositions not implemented")
+}
+func (*UnimplementedQueryServer) EMode(ctx context.Context, req *QueryEModeRequest) (*QueryEModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EMode not implemented")
+}
+func (*UnimplementedQueryServer) PositionHealth(ctx context.Context, req *QueryPositionHealthRequest) (*QueryPositionHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionHealth not implemented")
+}
+func (*UnimplementedQueryServer) PositionsAtRisk(ctx context.Context, req *QueryPositionsAtRiskRequest) (*QueryPositionsAtRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionsAtRisk not implemented")
 }
 
-func (m *QueryTotalBorrowedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryTotalBorrowedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalBorrowedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalBorrowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BorrowedCoins) > 0 {
-		for iNdEx := len(m.BorrowedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BorrowedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UnsyncedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnsyncedDepositsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UnsyncedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/UnsyncedDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsyncedDeposits(ctx, req.(*QueryUnsyncedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TotalDeposited_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalDepositedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalDeposited(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/TotalDeposited",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalDeposited(ctx, req.(*QueryTotalDepositedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Borrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBorrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Borrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/Borrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Borrows(ctx, req.(*QueryBorrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UnsyncedBorrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnsyncedBorrowsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UnsyncedBorrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/UnsyncedBorrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsyncedBorrows(ctx, req.(*QueryUnsyncedBorrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TotalBorrowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBorrowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBorrowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/TotalBorrowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBorrowed(ctx, req.(*QueryTotalBorrowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterestRates) > 0 {
-		for iNdEx := len(m.InterestRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InterestRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_InterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterestRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).InterestRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/InterestRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterestRate(ctx, req.(*QueryInterestRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReservesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Reserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Reserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/Reserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserves(ctx, req.(*QueryReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReservesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_InterestFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterestFactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterestFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/InterestFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterestFactors(ctx, req.(*QueryInterestFactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func _Query_IsolatedMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsolatedMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).IsolatedMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/IsolatedMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsolatedMarkets(ctx, req.(*QueryIsolatedMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReservesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IsolatedPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsolatedPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IsolatedPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/IsolatedPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsolatedPositions(ctx, req.(*QueryIsolatedPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReservesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/EMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EMode(ctx, req.(*QueryEModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_PositionHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PositionHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/PositionHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionHealth(ctx, req.(*QueryPositionHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterestFactorsRequest) Marshal() (dAtA []byte, err error) {
+func _Query_PositionsAtRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsAtRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionsAtRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Query/PositionsAtRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionsAtRisk(ctx, req.(*QueryPositionsAtRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.jinx.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "UnsyncedDeposits",
+			Handler:    _Query_UnsyncedDeposits_Handler,
+		},
+		{
+			MethodName: "TotalDeposited",
+			Handler:    _Query_TotalDeposited_Handler,
+		},
+		{
+			MethodName: "Borrows",
+			Handler:    _Query_Borrows_Handler,
+		},
+		{
+			MethodName: "UnsyncedBorrows",
+			Handler:    _Query_UnsyncedBorrows_Handler,
+		},
+		{
+			MethodName: "TotalBorrowed",
+			Handler:    _Query_TotalBorrowed_Handler,
+		},
+		{
+			MethodName: "InterestRate",
+			Handler:    _Query_InterestRate_Handler,
+		},
+		{
+			MethodName: "Reserves",
+			Handler:    _Query_Reserves_Handler,
+		},
+		{
+			MethodName: "InterestFactors",
+			Handler:    _Query_InterestFactors_Handler,
+		},
+		{
+			MethodName: "IsolatedMarkets",
+			Handler:    _Query_IsolatedMarkets_Handler,
+		},
+		{
+			MethodName: "IsolatedPositions",
+			Handler:    _Query_IsolatedPositions_Handler,
+		},
+		{
+			MethodName: "EMode",
+			Handler:    _Query_EMode_Handler,
+		},
+		{
+			MethodName: "PositionHealth",
+			Handler:    _Query_PositionHealth_Handler,
+		},
+		{
+			MethodName: "PositionsAtRisk",
+			Handler:    _Query_PositionsAtRisk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/jinx/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2828,27 +3043,20 @@ func (m *QueryInterestFactorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInterestFactorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInterestFactorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInterestFactorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2858,34 +3066,30 @@ func (m *QueryInterestFactorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInterestFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInterestFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.InterestFactors) > 0 {
-		for iNdEx := len(m.InterestFactors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InterestFactors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2895,34 +3099,43 @@ func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2930,20 +3143,13 @@ func (m *DepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SupplyInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2953,20 +3159,32 @@ func (m *SupplyInterestFactorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SupplyInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SupplyInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2980,7 +3198,7 @@ func (m *SupplyInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2990,34 +3208,32 @@ func (m *BorrowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3025,20 +3241,13 @@ func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryUnsyncedDepositsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3048,20 +3257,32 @@ func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUnsyncedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUnsyncedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3075,7 +3296,7 @@ func (m *BorrowInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
+func (m *QueryUnsyncedDepositsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3085,41 +3306,46 @@ func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MoneyMarketInterestRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUnsyncedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoneyMarketInterestRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUnsyncedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BorrowInterestRate) > 0 {
-		i -= len(m.BorrowInterestRate)
-		copy(dAtA[i:], m.BorrowInterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SupplyInterestRate) > 0 {
-		i -= len(m.SupplyInterestRate)
-		copy(dAtA[i:], m.SupplyInterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestRate)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalDepositedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3129,30 +3355,16 @@ func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalDepositedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalDepositedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SupplyInterestFactor) > 0 {
-		i -= len(m.SupplyInterestFactor)
-		copy(dAtA[i:], m.SupplyInterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestFactor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BorrowInterestFactor) > 0 {
-		i -= len(m.BorrowInterestFactor)
-		copy(dAtA[i:], m.BorrowInterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestFactor)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -3163,494 +3375,3647 @@ func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTotalDepositedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryTotalDepositedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalDepositedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.SuppliedCoins) > 0 {
+		for iNdEx := len(m.SuppliedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuppliedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBorrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryBorrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBorrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryUnsyncedDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBorrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryUnsyncedDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Borrows) > 0 {
+		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalDepositedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUnsyncedBorrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalDepositedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SuppliedCoins) > 0 {
-		for _, e := range m.SuppliedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryUnsyncedBorrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBorrowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUnsyncedBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Borrows) > 0 {
-		for _, e := range m.Borrows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUnsyncedBorrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryUnsyncedBorrowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryUnsyncedBorrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUnsyncedBorrowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUnsyncedBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Borrows) > 0 {
-		for _, e := range m.Borrows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Borrows) > 0 {
+		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalBorrowedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTotalBorrowedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalBorrowedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalBorrowedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBorrowedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BorrowedCoins) > 0 {
-		for _, e := range m.BorrowedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInterestRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTotalBorrowedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInterestRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalBorrowedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBorrowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowedCoins) > 0 {
+		for iNdEx := len(m.BorrowedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BorrowedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterestRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterestRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.InterestRates) > 0 {
-		for _, e := range m.InterestRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.InterestRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReservesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReservesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInterestFactorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterestFactorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestFactorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestFactorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInterestFactorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterestFactorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.InterestFactors) > 0 {
-		for _, e := range m.InterestFactors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.InterestFactors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestFactors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsolatedMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsolatedMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsolatedMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsolatedMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsolatedMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsolatedMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IsolatedMarketStates) > 0 {
+		for iNdEx := len(m.IsolatedMarketStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedMarketStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsolatedPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsolatedPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsolatedPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsolatedPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsolatedPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsolatedPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IsolatedPositions) > 0 {
+		for iNdEx := len(m.IsolatedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsAtRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsAtRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsAtRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsAtRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsAtRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsAtRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorrowInterestFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorrowInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IsolatedPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolatedPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowIndex) > 0 {
+		for iNdEx := len(m.BorrowIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BorrowIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Borrow) > 0 {
+		for iNdEx := len(m.Borrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DepositIndex) > 0 {
+		for iNdEx := len(m.DepositIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoneyMarketInterestRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoneyMarketInterestRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowInterestRate) > 0 {
+		i -= len(m.BorrowInterestRate)
+		copy(dAtA[i:], m.BorrowInterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SupplyInterestRate) > 0 {
+		i -= len(m.SupplyInterestRate)
+		copy(dAtA[i:], m.SupplyInterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupplyInterestFactor) > 0 {
+		i -= len(m.SupplyInterestFactor)
+		copy(dAtA[i:], m.SupplyInterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyInterestFactor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BorrowInterestFactor) > 0 {
+		i -= len(m.BorrowInterestFactor)
+		copy(dAtA[i:], m.BorrowInterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowInterestFactor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidationPrices) > 0 {
+		for iNdEx := len(m.LiquidationPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MaxWithdraw) > 0 {
+		for iNdEx := len(m.MaxWithdraw) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxWithdraw[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MaxBorrowUsdValue) > 0 {
+		i -= len(m.MaxBorrowUsdValue)
+		copy(dAtA[i:], m.MaxBorrowUsdValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxBorrowUsdValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BorrowUsdValue) > 0 {
+		i -= len(m.BorrowUsdValue)
+		copy(dAtA[i:], m.BorrowUsdValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowUsdValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DepositUsdValue) > 0 {
+		i -= len(m.DepositUsdValue)
+		copy(dAtA[i:], m.DepositUsdValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositUsdValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HealthFactor) > 0 {
+		i -= len(m.HealthFactor)
+		copy(dAtA[i:], m.HealthFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HealthFactor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpperPrice) > 0 {
+		i -= len(m.UpperPrice)
+		copy(dAtA[i:], m.UpperPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpperPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowerPrice) > 0 {
+		i -= len(m.LowerPrice)
+		copy(dAtA[i:], m.LowerPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LowerPrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnsyncedDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnsyncedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalDepositedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalDepositedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuppliedCoins) > 0 {
+		for _, e := range m.SuppliedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBorrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBorrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Borrows) > 0 {
+		for _, e := range m.Borrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnsyncedBorrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnsyncedBorrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Borrows) > 0 {
+		for _, e := range m.Borrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalBorrowedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalBorrowedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BorrowedCoins) > 0 {
+		for _, e := range m.BorrowedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInterestRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterestRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterestRates) > 0 {
+		for _, e := range m.InterestRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInterestFactorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterestFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterestFactors) > 0 {
+		for _, e := range m.InterestFactors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIsolatedMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsolatedMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IsolatedMarketStates) > 0 {
+		for _, e := range m.IsolatedMarketStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIsolatedPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsolatedPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IsolatedPositions) > 0 {
+		for _, e := range m.IsolatedPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPositionHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionsAtRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsAtRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyInterestFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BorrowInterestFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IsolatedPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DepositIndex) > 0 {
+		for _, e := range m.DepositIndex {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Borrow) > 0 {
+		for _, e := range m.Borrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BorrowIndex) > 0 {
+		for _, e := range m.BorrowIndex {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MoneyMarketInterestRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SupplyInterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BorrowInterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BorrowInterestFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SupplyInterestFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HealthFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DepositUsdValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BorrowUsdValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaxBorrowUsdValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MaxWithdraw) > 0 {
+		for _, e := range m.MaxWithdraw {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LiquidationPrices) > 0 {
+		for _, e := range m.LiquidationPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidationPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LowerPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UpperPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, types.ModuleAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositResponse{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositResponse{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalDepositedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalDepositedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalDepositedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalDepositedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuppliedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuppliedCoins = append(m.SuppliedCoins, types1.Coin{})
+			if err := m.SuppliedCoins[len(m.SuppliedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBorrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBorrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrows = append(m.Borrows, BorrowResponse{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *SupplyInterestFactorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *BorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrows = append(m.Borrows, BorrowResponse{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *BorrowInterestFactorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *MoneyMarketInterestRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SupplyInterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BorrowInterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *InterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BorrowInterestFactor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SupplyInterestFactor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3673,12 +7038,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBorrowedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBorrowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3700,7 +7097,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3723,15 +7120,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBorrowedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBorrowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3758,7 +7155,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BorrowedCoins = append(m.BorrowedCoins, types1.Coin{})
+			if err := m.BorrowedCoins[len(m.BorrowedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3783,7 +7181,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3806,12 +7204,44 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3833,7 +7263,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3856,15 +7286,15 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3891,8 +7321,8 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, types.ModuleAccount{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterestRates = append(m.InterestRates, MoneyMarketInterestRate{})
+			if err := m.InterestRates[len(m.InterestRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3917,7 +7347,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3940,10 +7370,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReservesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3978,74 +7408,6 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4067,7 +7429,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4090,49 +7452,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposits = append(m.Deposits, DepositResponse{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4159,10 +7487,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4187,7 +7513,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4210,81 +7536,17 @@ func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestFactorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4294,27 +7556,23 @@ func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4337,7 +7595,7 @@ func (m *QueryUnsyncedDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4360,49 +7618,15 @@ func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestFactorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposits = append(m.Deposits, DepositResponse{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4429,10 +7653,8 @@ func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterestFactors = append(m.InterestFactors, InterestFactor{})
+			if err := m.InterestFactors[len(m.InterestFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4457,7 +7679,7 @@ func (m *QueryUnsyncedDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsolatedMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4480,15 +7702,15 @@ func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDepositedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsolatedMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDepositedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsolatedMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4516,7 +7738,7 @@ func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4539,7 +7761,7 @@ func (m *QueryTotalDepositedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsolatedMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4562,15 +7784,15 @@ func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDepositedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsolatedMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDepositedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsolatedMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuppliedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedMarketStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4597,8 +7819,8 @@ func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuppliedCoins = append(m.SuppliedCoins, types1.Coin{})
-			if err := m.SuppliedCoins[len(m.SuppliedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IsolatedMarketStates = append(m.IsolatedMarketStates, IsolatedMarketState{})
+			if err := m.IsolatedMarketStates[len(m.IsolatedMarketStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4623,7 +7845,7 @@ func (m *QueryTotalDepositedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsolatedPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4646,15 +7868,15 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsolatedPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsolatedPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4682,7 +7904,7 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4773,7 +7995,7 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsolatedPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4796,15 +8018,15 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsolatedPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsolatedPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4831,8 +8053,8 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrows = append(m.Borrows, BorrowResponse{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IsolatedPositions = append(m.IsolatedPositions, IsolatedPositionResponse{})
+			if err := m.IsolatedPositions[len(m.IsolatedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4893,7 +8115,191 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4916,45 +8322,13 @@ func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -4986,42 +8360,6 @@ func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5043,7 +8381,7 @@ func (m *QueryUnsyncedBorrowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5066,49 +8404,15 @@ func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnsyncedBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Borrows = append(m.Borrows, BorrowResponse{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5135,10 +8439,7 @@ func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5163,7 +8464,7 @@ func (m *QueryUnsyncedBorrowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsAtRiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5186,17 +8487,17 @@ func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBorrowedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsAtRiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBorrowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsAtRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5206,23 +8507,27 @@ func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5245,7 +8550,7 @@ func (m *QueryTotalBorrowedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsAtRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5268,15 +8573,49 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBorrowedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsAtRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBorrowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsAtRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, PositionHealthResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5303,8 +8642,10 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowedCoins = append(m.BorrowedCoins, types1.Coin{})
-			if err := m.BorrowedCoins[len(m.BorrowedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5329,7 +8670,7 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
+func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5352,15 +8693,15 @@ func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5388,61 +8729,45 @@ func (m *QueryInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5469,8 +8794,8 @@ func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRates = append(m.InterestRates, MoneyMarketInterestRate{})
-			if err := m.InterestRates[len(m.InterestRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, SupplyInterestFactorResponse{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5495,7 +8820,7 @@ func (m *QueryInterestRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
+func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5518,10 +8843,10 @@ func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReservesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyInterestFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyInterestFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5556,6 +8881,38 @@ func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5577,7 +8934,7 @@ func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
+func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5600,12 +8957,44 @@ func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BorrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
@@ -5640,6 +9029,40 @@ func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, BorrowInterestFactorResponse{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5661,7 +9084,7 @@ func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
+func (m *BorrowInterestFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5684,10 +9107,10 @@ func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestFactorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BorrowInterestFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BorrowInterestFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5722,6 +9145,38 @@ func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5743,7 +9198,7 @@ func (m *QueryInterestFactorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
+func (m *IsolatedPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5766,17 +9221,17 @@ func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterestFactorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterestFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5786,81 +9241,95 @@ func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestFactors = append(m.InterestFactors, InterestFactor{})
-			if err := m.InterestFactors[len(m.InterestFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5870,27 +9339,29 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.DepositIndex = append(m.DepositIndex, SupplyInterestFactorResponse{})
+			if err := m.DepositIndex[len(m.DepositIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5917,14 +9388,14 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrow = append(m.Borrow, types1.Coin{})
+			if err := m.Borrow[len(m.Borrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5951,8 +9422,8 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, SupplyInterestFactorResponse{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BorrowIndex = append(m.BorrowIndex, BorrowInterestFactorResponse{})
+			if err := m.BorrowIndex[len(m.BorrowIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5977,7 +9448,7 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
+func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6000,10 +9471,10 @@ func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyInterestFactorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoneyMarketInterestRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyInterestFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoneyMarketInterestRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6040,7 +9511,7 @@ func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyInterestRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6068,7 +9539,39 @@ func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.SupplyInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowInterestRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6091,7 +9594,7 @@ func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *InterestFactor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6114,15 +9617,15 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterestFactor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6150,13 +9653,13 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6166,31 +9669,29 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BorrowInterestFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyInterestFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6200,25 +9701,23 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, BorrowInterestFactorResponse{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SupplyInterestFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6241,7 +9740,7 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowInterestFactorResponse) Unmarshal(dAtA []byte) error {
+func (m *PositionHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6264,15 +9763,15 @@ func (m *BorrowInterestFactorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowInterestFactorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowInterestFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6300,11 +9799,11 @@ func (m *BorrowInterestFactorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6332,61 +9831,43 @@ func (m *BorrowInterestFactorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.HealthFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositUsdValue", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MoneyMarketInterestRate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoneyMarketInterestRate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DepositUsdValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowUsdValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6414,11 +9895,11 @@ func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.BorrowUsdValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBorrowUsdValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6446,13 +9927,13 @@ func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SupplyInterestRate = string(dAtA[iNdEx:postIndex])
+			m.MaxBorrowUsdValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdraw", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6462,23 +9943,59 @@ func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowInterestRate = string(dAtA[iNdEx:postIndex])
+			m.MaxWithdraw = append(m.MaxWithdraw, types1.Coin{})
+			if err := m.MaxWithdraw[len(m.MaxWithdraw)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationPrices = append(m.LiquidationPrices, LiquidationPrice{})
+			if err := m.LiquidationPrices[len(m.LiquidationPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6501,7 +10018,7 @@ func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterestFactor) Unmarshal(dAtA []byte) error {
+func (m *LiquidationPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6524,10 +10041,10 @@ func (m *InterestFactor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterestFactor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6564,7 +10081,7 @@ func (m *InterestFactor) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6592,11 +10109,11 @@ func (m *InterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowInterestFactor = string(dAtA[iNdEx:postIndex])
+			m.LowerPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyInterestFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6624,7 +10141,7 @@ func (m *InterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SupplyInterestFactor = string(dAtA[iNdEx:postIndex])
+			m.UpperPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -35,6 +36,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgDeposit struct {
 	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// market is the isolated market the coins are deposited in, or empty for the shared money markets.
+	Market string `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
 }
 
 func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
@@ -84,6 +87,13 @@ func (m *MsgDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgDeposit) GetMarket() string {
+	if m != nil {
+		return m.Market
+	}
+	return ""
+}
+
 // MsgDepositResponse defines the Msg/Deposit response type.
 type MsgDepositResponse struct {
 }
@@ -125,6 +135,8 @@ var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 type MsgWithdraw struct {
 	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// market is the isolated market the coins are withdrawn from, or empty for the shared money markets.
+	Market string `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
 }
 
 func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
@@ -174,6 +186,13 @@ func (m *MsgWithdraw) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgWithdraw) GetMarket() string {
+	if m != nil {
+		return m.Market
+	}
+	return ""
+}
+
 // MsgWithdrawResponse defines the Msg/Withdraw response type.
 type MsgWithdrawResponse struct {
 }
@@ -215,6 +234,8 @@ var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 type MsgBorrow struct {
 	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
 	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// market is the isolated market the coins are borrowed from, or empty for the shared money markets.
+	Market string `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
 }
 
 func (m *MsgBorrow) Reset()         { *m = MsgBorrow{} }
@@ -264,6 +285,13 @@ func (m *MsgBorrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgBorrow) GetMarket() string {
+	if m != nil {
+		return m.Market
+	}
+	return ""
+}
+
 // MsgBorrowResponse defines the Msg/Borrow response type.
 type MsgBorrowResponse struct {
 }
@@ -306,6 +334,8 @@ type MsgRepay struct {
 	Sender string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Owner  string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// market is the isolated market the coins are repaid to, or empty for the shared money markets.
+	Market string `protobuf:"bytes,4,opt,name=market,proto3" json:"market,omitempty"`
 }
 
 func (m *MsgRepay) Reset()         { *m = MsgRepay{} }
@@ -362,6 +392,13 @@ func (m *MsgRepay) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgRepay) GetMarket() string {
+	if m != nil {
+		return m.Market
+	}
+	return ""
+}
+
 // MsgRepayResponse defines the Msg/Repay response type.
 type MsgRepayResponse struct {
 }
@@ -403,6 +440,8 @@ var xxx_messageInfo_MsgRepayResponse proto.InternalMessageInfo
 type MsgLiquidate struct {
 	Keeper   string `protobuf:"bytes,1,opt,name=keeper,proto3" json:"keeper,omitempty"`
 	Borrower string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// market is the isolated market the position is liquidated in, or empty for the shared money markets.
+	Market string `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
 }
 
 func (m *MsgLiquidate) Reset()         { *m = MsgLiquidate{} }
@@ -452,6 +491,13 @@ func (m *MsgLiquidate) GetBorrower() string {
 	return ""
 }
 
+func (m *MsgLiquidate) GetMarket() string {
+	if m != nil {
+		return m.Market
+	}
+	return ""
+}
+
 // MsgLiquidateResponse defines the Msg/Liquidate response type.
 type MsgLiquidateResponse struct {
 }
@@ -489,6 +535,305 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
+// MsgPartialLiquidate defines the Msg/PartialLiquidate request type.
+type MsgPartialLiquidate struct {
+	Keeper   string `protobuf:"bytes,1,opt,name=keeper,proto3" json:"keeper,omitempty"`
+	Borrower string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// repay is the amount of the borrower's borrow the keeper repays, capped by the close factor of its money market.
+	Repay types.Coin `protobuf:"bytes,3,opt,name=repay,proto3" json:"repay"`
+	// collateral_denom is the denom of the borrower's deposit the keeper receives in exchange.
+	CollateralDenom string `protobuf:"bytes,4,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+}
+
+func (m *MsgPartialLiquidate) Reset()         { *m = MsgPartialLiquidate{} }
+func (m *MsgPartialLiquidate) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialLiquidate) ProtoMessage()    {}
+func (*MsgPartialLiquidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a79917914236f58a, []int{10}
+}
+func (m *MsgPartialLiquidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialLiquidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialLiquidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialLiquidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialLiquidate.Merge(m, src)
+}
+func (m *MsgPartialLiquidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialLiquidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialLiquidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialLiquidate proto.InternalMessageInfo
+
+func (m *MsgPartialLiquidate) GetKeeper() string {
+	if m != nil {
+		return m.Keeper
+	}
+	return ""
+}
+
+func (m *MsgPartialLiquidate) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *MsgPartialLiquidate) GetRepay() types.Coin {
+	if m != nil {
+		return m.Repay
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPartialLiquidate) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
+	}
+	return ""
+}
+
+// MsgPartialLiquidateResponse defines the Msg/PartialLiquidate response type.
+type MsgPartialLiquidateResponse struct {
+}
+
+func (m *MsgPartialLiquidateResponse) Reset()         { *m = MsgPartialLiquidateResponse{} }
+func (m *MsgPartialLiquidateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialLiquidateResponse) ProtoMessage()    {}
+func (*MsgPartialLiquidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a79917914236f58a, []int{11}
+}
+func (m *MsgPartialLiquidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialLiquidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialLiquidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialLiquidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialLiquidateResponse.Merge(m, src)
+}
+func (m *MsgPartialLiquidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialLiquidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialLiquidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialLiquidateResponse proto.InternalMessageInfo
+
+// MsgFlashLoan defines the Msg/FlashLoan request type.
+type MsgFlashLoan struct {
+	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// msgs are executed with the borrowed coins, and must only be signed by the borrower. The amount plus the flash
+	// loan fee is repaid from the borrower's account after they are executed.
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgFlashLoan) Reset()         { *m = MsgFlashLoan{} }
+func (m *MsgFlashLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashLoan) ProtoMessage()    {}
+func (*MsgFlashLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a79917914236f58a, []int{12}
+}
+func (m *MsgFlashLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashLoan.Merge(m, src)
+}
+func (m *MsgFlashLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashLoan proto.InternalMessageInfo
+
+func (m *MsgFlashLoan) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *MsgFlashLoan) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgFlashLoan) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgFlashLoanResponse defines the Msg/FlashLoan response type.
+type MsgFlashLoanResponse struct {
+}
+
+func (m *MsgFlashLoanResponse) Reset()         { *m = MsgFlashLoanResponse{} }
+func (m *MsgFlashLoanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashLoanResponse) ProtoMessage()    {}
+func (*MsgFlashLoanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a79917914236f58a, []int{13}
+}
+func (m *MsgFlashLoanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashLoanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashLoanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashLoanResponse.Merge(m, src)
+}
+func (m *MsgFlashLoanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashLoanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashLoanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashLoanResponse proto.InternalMessageInfo
+
+// MsgSetEMode defines the Msg/SetEMode request type.
+type MsgSetEMode struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// category is the name of the e-mode category to opt in to, or empty to opt out.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *MsgSetEMode) Reset()         { *m = MsgSetEMode{} }
+func (m *MsgSetEMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEMode) ProtoMessage()    {}
+func (*MsgSetEMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a79917914236f58a, []int{14}
+}
+func (m *MsgSetEMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEMode.Merge(m, src)
+}
+func (m *MsgSetEMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEMode proto.InternalMessageInfo
+
+func (m *MsgSetEMode) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgSetEMode) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+// MsgSetEModeResponse defines the Msg/SetEMode response type.
+type MsgSetEModeResponse struct {
+}
+
+func (m *MsgSetEModeResponse) Reset()         { *m = MsgSetEModeResponse{} }
+func (m *MsgSetEModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEModeResponse) ProtoMessage()    {}
+func (*MsgSetEModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a79917914236f58a, []int{15}
+}
+func (m *MsgSetEModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEModeResponse.Merge(m, src)
+}
+func (m *MsgSetEModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEModeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "fury.jinx.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "fury.jinx.v1beta1.MsgDepositResponse")
@@ -500,46 +845,67 @@ func init() {
 	proto.RegisterType((*MsgRepayResponse)(nil), "fury.jinx.v1beta1.MsgRepayResponse")
 	proto.RegisterType((*MsgLiquidate)(nil), "fury.jinx.v1beta1.MsgLiquidate")
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "fury.jinx.v1beta1.MsgLiquidateResponse")
+	proto.RegisterType((*MsgPartialLiquidate)(nil), "fury.jinx.v1beta1.MsgPartialLiquidate")
+	proto.RegisterType((*MsgPartialLiquidateResponse)(nil), "fury.jinx.v1beta1.MsgPartialLiquidateResponse")
+	proto.RegisterType((*MsgFlashLoan)(nil), "fury.jinx.v1beta1.MsgFlashLoan")
+	proto.RegisterType((*MsgFlashLoanResponse)(nil), "fury.jinx.v1beta1.MsgFlashLoanResponse")
+	proto.RegisterType((*MsgSetEMode)(nil), "fury.jinx.v1beta1.MsgSetEMode")
+	proto.RegisterType((*MsgSetEModeResponse)(nil), "fury.jinx.v1beta1.MsgSetEModeResponse")
 }
 
 func init() { proto.RegisterFile("fury/jinx/v1beta1/tx.proto", fileDescriptor_a79917914236f58a) }
 
 var fileDescriptor_a79917914236f58a = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x13, 0x35, 0x5f, 0x72, 0xfb, 0x2d, 0xa8, 0x1b, 0x50, 0x6a, 0xc0, 0xa9, 0xc2, 0x5f,
-	0x84, 0x14, 0xbb, 0x2d, 0x88, 0x3d, 0xa1, 0x1b, 0xa4, 0x5a, 0x48, 0x41, 0x08, 0x89, 0x0d, 0xb2,
-	0xe3, 0x61, 0x3a, 0x94, 0x78, 0xcc, 0xdc, 0x49, 0x93, 0xbc, 0x05, 0x6b, 0x1e, 0x00, 0x89, 0xae,
-	0x79, 0x88, 0x2e, 0x0b, 0x2b, 0x56, 0x80, 0x92, 0x17, 0x41, 0xf6, 0xd8, 0x93, 0x20, 0xa2, 0x24,
-	0x1b, 0x10, 0x2b, 0xcf, 0xcc, 0x39, 0xe7, 0xfa, 0x1c, 0xcd, 0x9d, 0x19, 0xb0, 0x5e, 0x0d, 0xc4,
-	0xd8, 0x7d, 0xcd, 0xa2, 0x91, 0x7b, 0xba, 0x1f, 0x10, 0xe9, 0xef, 0xbb, 0x72, 0xe4, 0xc4, 0x82,
-	0x4b, 0x6e, 0x6e, 0x25, 0x98, 0x93, 0x60, 0x4e, 0x86, 0x59, 0x76, 0x8f, 0x63, 0x9f, 0xa3, 0x1b,
-	0xf8, 0x48, 0xb4, 0xa0, 0xc7, 0x59, 0xa4, 0x24, 0xd6, 0x8e, 0xc2, 0x5f, 0xa6, 0x33, 0x57, 0x4d,
-	0x32, 0xa8, 0x46, 0x39, 0xe5, 0x6a, 0x3d, 0x19, 0xa9, 0xd5, 0xe6, 0x47, 0x03, 0xc0, 0x43, 0x7a,
-	0x48, 0x62, 0x8e, 0x4c, 0x9a, 0x0f, 0xa0, 0x1a, 0xaa, 0x21, 0x17, 0x75, 0x63, 0xd7, 0x68, 0x55,
-	0x3b, 0xf5, 0x2f, 0x9f, 0xda, 0xb5, 0xac, 0xd2, 0xc3, 0x30, 0x14, 0x04, 0xf1, 0xa9, 0x14, 0x2c,
-	0xa2, 0xdd, 0x19, 0xd5, 0xec, 0x41, 0xd9, 0xef, 0xf3, 0x41, 0x24, 0xeb, 0xc5, 0xdd, 0x52, 0x6b,
-	0xf3, 0x60, 0xc7, 0xc9, 0x14, 0x89, 0xd1, 0xdc, 0xbd, 0xf3, 0x88, 0xb3, 0xa8, 0xb3, 0x77, 0xfe,
-	0xad, 0x51, 0x38, 0xfb, 0xde, 0x68, 0x51, 0x26, 0x8f, 0x07, 0x81, 0xd3, 0xe3, 0xfd, 0xcc, 0x68,
-	0xf6, 0x69, 0x63, 0x78, 0xe2, 0xca, 0x71, 0x4c, 0x30, 0x15, 0x60, 0x37, 0x2b, 0xdd, 0xac, 0x81,
-	0x39, 0xb3, 0xda, 0x25, 0x18, 0xf3, 0x08, 0x49, 0xf3, 0xcc, 0x80, 0x4d, 0x0f, 0xe9, 0x73, 0x26,
-	0x8f, 0x43, 0xe1, 0x0f, 0xff, 0xed, 0x08, 0x97, 0x61, 0x7b, 0xce, 0xab, 0xce, 0xf0, 0xc1, 0x80,
-	0xaa, 0x87, 0xb4, 0xc3, 0x85, 0xe0, 0x43, 0xf3, 0x3e, 0x54, 0x82, 0x74, 0x44, 0x56, 0x07, 0xd0,
-	0xcc, 0xbf, 0xe3, 0x7f, 0x1b, 0xb6, 0xb4, 0x4f, 0xed, 0xfe, 0xb3, 0x01, 0x15, 0x0f, 0x69, 0x97,
-	0xc4, 0xfe, 0xd8, 0xdc, 0x83, 0x32, 0x92, 0x28, 0x5c, 0xc3, 0x7a, 0xc6, 0x33, 0x1d, 0xd8, 0xe0,
-	0xc3, 0x88, 0x88, 0x7a, 0x71, 0x85, 0x40, 0xd1, 0xe6, 0x82, 0x96, 0xfe, 0x5c, 0x50, 0x13, 0x2e,
-	0xe5, 0x91, 0x74, 0xce, 0x53, 0xf8, 0xdf, 0x43, 0x7a, 0xc4, 0xde, 0x0e, 0x58, 0xe8, 0x4b, 0x92,
-	0x44, 0x3d, 0x21, 0x24, 0x5e, 0x27, 0xaa, 0xe2, 0xfd, 0xb2, 0xb3, 0xc5, 0x75, 0x77, 0xb6, 0x79,
-	0x05, 0x6a, 0xf3, 0xff, 0xcd, 0xfd, 0x1c, 0xbc, 0x2f, 0x41, 0xc9, 0x43, 0x6a, 0x3e, 0x81, 0xff,
-	0xf2, 0xf3, 0x7b, 0xdd, 0xf9, 0xed, 0xce, 0x70, 0x66, 0x67, 0xc6, 0xba, 0xb5, 0x14, 0xce, 0x0b,
-	0x9b, 0x5d, 0xa8, 0xe8, 0xe3, 0x64, 0x2f, 0x96, 0xe4, 0xb8, 0x75, 0x7b, 0x39, 0xae, 0x6b, 0x1e,
-	0x41, 0x39, 0x6b, 0xef, 0x6b, 0x8b, 0x15, 0x0a, 0xb5, 0x6e, 0x2e, 0x43, 0x75, 0xb5, 0xc7, 0xb0,
-	0xa1, 0xda, 0xed, 0xea, 0x62, 0x7a, 0x0a, 0x5a, 0x37, 0x96, 0x80, 0xba, 0xd4, 0x33, 0xa8, 0xce,
-	0xb6, 0xb4, 0xb1, 0x58, 0xa1, 0x09, 0xd6, 0x9d, 0x15, 0x84, 0xbc, 0x6c, 0xe7, 0xf0, 0x7c, 0x62,
-	0x1b, 0x17, 0x13, 0xdb, 0xf8, 0x31, 0xb1, 0x8d, 0x77, 0x53, 0xbb, 0x70, 0x31, 0xb5, 0x0b, 0x5f,
-	0xa7, 0x76, 0xe1, 0xc5, 0xdd, 0xb9, 0x66, 0x8c, 0x89, 0xe8, 0x71, 0x64, 0xd8, 0x7e, 0xe3, 0x07,
-	0xe8, 0xa6, 0x6f, 0xc1, 0x48, 0xbd, 0x06, 0x69, 0x53, 0x06, 0xe5, 0xf4, 0x96, 0xbe, 0xf7, 0x33,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0x82, 0x66, 0x0d, 0x27, 0x06, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0x52, 0x5a, 0xda, 0xc7, 0x37, 0xf9, 0xc2, 0x52, 0x49, 0x59, 0x64, 0x21, 0x55, 0x01,
+	0x4d, 0xba, 0x0b, 0x28, 0xde, 0xa9, 0x68, 0x62, 0x42, 0xa3, 0x59, 0x62, 0x4c, 0x4c, 0x0c, 0x99,
+	0xee, 0x0e, 0xcb, 0xd2, 0x76, 0xa7, 0xce, 0x4c, 0x85, 0xfe, 0x13, 0xc6, 0xbf, 0xc3, 0x9b, 0x09,
+	0x67, 0x13, 0x3d, 0x11, 0x4f, 0xc4, 0x93, 0x27, 0x21, 0x70, 0xf2, 0xbf, 0x30, 0xbb, 0xb3, 0x3b,
+	0x2d, 0xd2, 0x5f, 0x27, 0xd4, 0x53, 0x77, 0xf6, 0xf3, 0x79, 0xaf, 0xef, 0xf3, 0x7e, 0xcc, 0x5b,
+	0xd0, 0x76, 0x9b, 0xb4, 0x65, 0xee, 0x7b, 0xfe, 0xa1, 0xf9, 0x76, 0xb5, 0x82, 0x39, 0x5a, 0x35,
+	0xf9, 0xa1, 0xd1, 0xa0, 0x84, 0x13, 0x75, 0x32, 0xc0, 0x8c, 0x00, 0x33, 0x22, 0x4c, 0xd3, 0x6d,
+	0xc2, 0xea, 0x84, 0x99, 0x15, 0xc4, 0xb0, 0x34, 0xb0, 0x89, 0xe7, 0x0b, 0x13, 0x6d, 0x46, 0xe0,
+	0x3b, 0xe1, 0xc9, 0x14, 0x87, 0x08, 0xca, 0xb9, 0xc4, 0x25, 0xe2, 0x7d, 0xf0, 0x14, 0x1b, 0xb8,
+	0x84, 0xb8, 0x35, 0x6c, 0x86, 0xa7, 0x4a, 0x73, 0xd7, 0x44, 0x7e, 0x4b, 0x40, 0x85, 0xcf, 0x0a,
+	0x40, 0x99, 0xb9, 0x9b, 0xb8, 0x41, 0x98, 0xc7, 0xd5, 0x87, 0x90, 0x75, 0xc4, 0x23, 0xa1, 0x79,
+	0x65, 0x41, 0x59, 0xce, 0x96, 0xf2, 0xdf, 0x8e, 0x8a, 0xb9, 0xe8, 0x4f, 0x36, 0x1c, 0x87, 0x62,
+	0xc6, 0xb6, 0x39, 0xf5, 0x7c, 0xd7, 0x6a, 0x53, 0x55, 0x1b, 0xd2, 0xa8, 0x4e, 0x9a, 0x3e, 0xcf,
+	0x8f, 0x2c, 0x24, 0x97, 0xc7, 0xd7, 0x66, 0x8c, 0xc8, 0x22, 0xd0, 0x10, 0x0b, 0x33, 0x1e, 0x11,
+	0xcf, 0x2f, 0xad, 0x1c, 0xff, 0x98, 0x4f, 0x7c, 0x38, 0x9d, 0x5f, 0x76, 0x3d, 0xbe, 0xd7, 0xac,
+	0x18, 0x36, 0xa9, 0x47, 0x1a, 0xa2, 0x9f, 0x22, 0x73, 0xaa, 0x26, 0x6f, 0x35, 0x30, 0x0b, 0x0d,
+	0x98, 0x15, 0xb9, 0x56, 0xa7, 0x21, 0x5d, 0x47, 0xb4, 0x8a, 0x79, 0x3e, 0x19, 0x44, 0x66, 0x45,
+	0xa7, 0x42, 0x0e, 0xd4, 0xb6, 0x04, 0x0b, 0xb3, 0x06, 0xf1, 0x19, 0x2e, 0x7c, 0x51, 0x60, 0xbc,
+	0xcc, 0xdc, 0x97, 0x1e, 0xdf, 0x73, 0x28, 0x3a, 0xf8, 0x37, 0xa5, 0xdd, 0x80, 0xa9, 0x0e, 0x0d,
+	0x52, 0xdb, 0x27, 0x05, 0xb2, 0x65, 0xe6, 0x96, 0x08, 0xa5, 0xe4, 0x40, 0x7d, 0x00, 0x99, 0x4a,
+	0xf8, 0x84, 0x07, 0x0b, 0x93, 0xcc, 0x3f, 0xab, 0x6b, 0x0a, 0x26, 0x65, 0xfc, 0x52, 0xd5, 0x4f,
+	0x05, 0x32, 0x65, 0xe6, 0x5a, 0xb8, 0x81, 0x5a, 0xea, 0x0a, 0xa4, 0x19, 0xf6, 0x9d, 0x21, 0x24,
+	0x45, 0x3c, 0xd5, 0x80, 0x14, 0x39, 0xf0, 0x31, 0xcd, 0x8f, 0x0c, 0x30, 0x10, 0xb4, 0x8e, 0x04,
+	0x24, 0xaf, 0x23, 0x01, 0xa3, 0x97, 0x12, 0xa0, 0xc2, 0x44, 0x2c, 0x55, 0xea, 0x7f, 0xa7, 0xc0,
+	0x7f, 0x65, 0xe6, 0x6e, 0x79, 0x6f, 0x9a, 0x9e, 0x83, 0x38, 0x0e, 0x72, 0x50, 0xc5, 0xb8, 0x31,
+	0x4c, 0x0e, 0x04, 0xef, 0x52, 0x2b, 0x8c, 0x0c, 0xdd, 0x0a, 0xbd, 0xaa, 0x34, 0x0d, 0xb9, 0xce,
+	0x78, 0x64, 0xa0, 0xa7, 0x4a, 0xd8, 0x96, 0xcf, 0x11, 0xe5, 0x1e, 0xaa, 0x5d, 0x7f, 0xbc, 0xeb,
+	0x90, 0xa2, 0x41, 0xe6, 0xc2, 0x70, 0xfb, 0x16, 0x6e, 0x34, 0x28, 0x9c, 0x25, 0xd8, 0xea, 0x5d,
+	0x98, 0xb0, 0x49, 0xad, 0x86, 0x38, 0xa6, 0xa8, 0xb6, 0xe3, 0x60, 0x9f, 0xd4, 0xa3, 0xaa, 0xfc,
+	0xdf, 0x7e, 0xbf, 0x19, 0xbc, 0x2e, 0xcc, 0xc1, 0x6c, 0x17, 0x81, 0x32, 0x01, 0x67, 0xa2, 0x52,
+	0x4f, 0x6a, 0x88, 0xed, 0x6d, 0x11, 0xe4, 0xff, 0xcd, 0x23, 0xb8, 0x0e, 0xa3, 0x75, 0xe6, 0xb2,
+	0xa8, 0xc9, 0x73, 0x86, 0xd8, 0x05, 0x46, 0xbc, 0x0b, 0x8c, 0x0d, 0xbf, 0x55, 0x1a, 0xff, 0x7a,
+	0x54, 0x1c, 0x63, 0x4e, 0xd5, 0x08, 0x7a, 0x32, 0xa4, 0x47, 0xb5, 0x97, 0x0a, 0xa5, 0xf4, 0xd7,
+	0xe1, 0xad, 0xba, 0x8d, 0xf9, 0xe3, 0x32, 0x71, 0xb0, 0xba, 0x06, 0x63, 0xc8, 0xb6, 0x43, 0x0d,
+	0x83, 0x74, 0xc7, 0x44, 0x55, 0x83, 0x8c, 0x8d, 0x38, 0x76, 0x09, 0x6d, 0x89, 0xa2, 0x5b, 0xf2,
+	0x1c, 0x5d, 0x78, 0xb1, 0xfb, 0xf8, 0x5f, 0xd7, 0x3e, 0xa6, 0x20, 0x59, 0x66, 0xae, 0xfa, 0x0c,
+	0xc6, 0xe2, 0x55, 0x35, 0x67, 0x5c, 0xd9, 0x9c, 0x46, 0x7b, 0x0d, 0x68, 0x77, 0xfa, 0xc2, 0xb1,
+	0x63, 0xd5, 0x82, 0x8c, 0xdc, 0x10, 0x7a, 0x77, 0x93, 0x18, 0xd7, 0x16, 0xfb, 0xe3, 0xd2, 0xe7,
+	0x16, 0xa4, 0xa3, 0x9b, 0xf9, 0x66, 0x77, 0x0b, 0x81, 0x6a, 0xb7, 0xfb, 0xa1, 0xd2, 0xdb, 0x53,
+	0x48, 0x89, 0x1b, 0x71, 0xb6, 0x3b, 0x3d, 0x04, 0xb5, 0x5b, 0x7d, 0x40, 0xe9, 0xea, 0x05, 0x64,
+	0xdb, 0xc3, 0x3a, 0xdf, 0xdd, 0x42, 0x12, 0xb4, 0xa5, 0x01, 0x04, 0xe9, 0x76, 0x1f, 0x26, 0xae,
+	0x5c, 0x05, 0x3d, 0x72, 0xf5, 0x3b, 0x4f, 0x33, 0x86, 0xe3, 0x75, 0x4a, 0x68, 0x4f, 0x5d, 0x0f,
+	0x09, 0x92, 0xa0, 0x2d, 0x0d, 0x20, 0x74, 0xb6, 0x81, 0x6c, 0xe9, 0x1e, 0x6d, 0x10, 0xe3, 0xda,
+	0x62, 0x7f, 0x3c, 0xf6, 0x59, 0xda, 0x3c, 0x3e, 0xd7, 0x95, 0x93, 0x73, 0x5d, 0x39, 0x3b, 0xd7,
+	0x95, 0xf7, 0x17, 0x7a, 0xe2, 0xe4, 0x42, 0x4f, 0x7c, 0xbf, 0xd0, 0x13, 0xaf, 0xee, 0x75, 0x0c,
+	0x71, 0x03, 0x53, 0x9b, 0x30, 0x8f, 0x15, 0x6b, 0xa8, 0xc2, 0xcc, 0xf0, 0x43, 0xf1, 0x50, 0x7c,
+	0x2a, 0x86, 0xc3, 0x5c, 0x49, 0x87, 0x83, 0x7a, 0xff, 0xd7, 0x00, 0x13, 0xab, 0xc3, 0x01, 0x44,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -564,6 +930,12 @@ type MsgClient interface {
 	Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error)
 	// Liquidate defines a method for attempting to liquidate a borrower that is over their loan-to-value.
 	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// PartialLiquidate defines a method for repaying part of an unhealthy borrow in exchange for deposits.
+	PartialLiquidate(ctx context.Context, in *MsgPartialLiquidate, opts ...grpc.CallOption) (*MsgPartialLiquidateResponse, error)
+	// FlashLoan defines a method for borrowing funds for the duration of a list of messages.
+	FlashLoan(ctx context.Context, in *MsgFlashLoan, opts ...grpc.CallOption) (*MsgFlashLoanResponse, error)
+	// SetEMode defines a method for opting in to, or out of, an e-mode category.
+	SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*MsgSetEModeResponse, error)
 }
 
 type msgClient struct {
@@ -619,6 +991,33 @@ func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) PartialLiquidate(ctx context.Context, in *MsgPartialLiquidate, opts ...grpc.CallOption) (*MsgPartialLiquidateResponse, error) {
+	out := new(MsgPartialLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Msg/PartialLiquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FlashLoan(ctx context.Context, in *MsgFlashLoan, opts ...grpc.CallOption) (*MsgFlashLoanResponse, error) {
+	out := new(MsgFlashLoanResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Msg/FlashLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*MsgSetEModeResponse, error) {
+	out := new(MsgSetEModeResponse)
+	err := c.cc.Invoke(ctx, "/fury.jinx.v1beta1.Msg/SetEMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing funds to jinx liquidity pool.
@@ -631,6 +1030,12 @@ type MsgServer interface {
 	Repay(context.Context, *MsgRepay) (*MsgRepayResponse, error)
 	// Liquidate defines a method for attempting to liquidate a borrower that is over their loan-to-value.
 	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// PartialLiquidate defines a method for repaying part of an unhealthy borrow in exchange for deposits.
+	PartialLiquidate(context.Context, *MsgPartialLiquidate) (*MsgPartialLiquidateResponse, error)
+	// FlashLoan defines a method for borrowing funds for the duration of a list of messages.
+	FlashLoan(context.Context, *MsgFlashLoan) (*MsgFlashLoanResponse, error)
+	// SetEMode defines a method for opting in to, or out of, an e-mode category.
+	SetEMode(context.Context, *MsgSetEMode) (*MsgSetEModeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -652,6 +1057,15 @@ func (*UnimplementedMsgServer) Repay(ctx context.Context, req *MsgRepay) (*MsgRe
 func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
 }
+func (*UnimplementedMsgServer) PartialLiquidate(ctx context.Context, req *MsgPartialLiquidate) (*MsgPartialLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialLiquidate not implemented")
+}
+func (*UnimplementedMsgServer) FlashLoan(ctx context.Context, req *MsgFlashLoan) (*MsgFlashLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashLoan not implemented")
+}
+func (*UnimplementedMsgServer) SetEMode(ctx context.Context, req *MsgSetEMode) (*MsgSetEModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEMode not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -747,38 +1161,104 @@ func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.jinx.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "Borrow",
-			Handler:    _Msg_Borrow_Handler,
-		},
-		{
-			MethodName: "Repay",
-			Handler:    _Msg_Repay_Handler,
-		},
-		{
-			MethodName: "Liquidate",
-			Handler:    _Msg_Liquidate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/jinx/v1beta1/tx.proto",
-}
-
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+func _Msg_PartialLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartialLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PartialLiquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Msg/PartialLiquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartialLiquidate(ctx, req.(*MsgPartialLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Msg/FlashLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashLoan(ctx, req.(*MsgFlashLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.jinx.v1beta1.Msg/SetEMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEMode(ctx, req.(*MsgSetEMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.jinx.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "Borrow",
+			Handler:    _Msg_Borrow_Handler,
+		},
+		{
+			MethodName: "Repay",
+			Handler:    _Msg_Repay_Handler,
+		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
+		{
+			MethodName: "PartialLiquidate",
+			Handler:    _Msg_PartialLiquidate_Handler,
+		},
+		{
+			MethodName: "FlashLoan",
+			Handler:    _Msg_FlashLoan_Handler,
+		},
+		{
+			MethodName: "SetEMode",
+			Handler:    _Msg_SetEMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/jinx/v1beta1/tx.proto",
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -796,6 +1276,13 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -863,6 +1350,13 @@ func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -930,6 +1424,13 @@ func (m *MsgBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -997,6 +1498,13 @@ func (m *MsgRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1071,6 +1579,13 @@ func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Borrower) > 0 {
 		i -= len(m.Borrower)
 		copy(dAtA[i:], m.Borrower)
@@ -1111,99 +1626,329 @@ func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPartialLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPartialLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Repay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keeper) > 0 {
+		i -= len(m.Keeper)
+		copy(dAtA[i:], m.Keeper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPartialLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFlashLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFlashLoanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBorrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFlashLoanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashLoanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetEMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgRepay) Size() (n int) {
@@ -1226,6 +1971,10 @@ func (m *MsgRepay) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1252,6 +2001,10 @@ func (m *MsgLiquidate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1264,13 +2017,699 @@ func (m *MsgLiquidateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPartialLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keeper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Repay.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPartialLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFlashLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashLoanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetEModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,15 +2732,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,9 +2768,41 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1365,6 +2836,38 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1386,7 +2889,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1409,10 +2912,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1436,7 +2939,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1459,15 +2962,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1495,13 +2998,13 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Keeper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1511,25 +3014,55 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Market = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1552,7 +3085,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,10 +3108,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1602,7 +3135,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgPartialLiquidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1625,13 +3158,45 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPartialLiquidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPartialLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keeper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
@@ -1663,9 +3228,9 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			}
 			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1692,11 +3257,42 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1718,7 +3314,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPartialLiquidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1741,10 +3337,10 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPartialLiquidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPartialLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1768,7 +3364,7 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepay) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashLoan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,15 +3387,15 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashLoan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashLoan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,13 +3423,13 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1843,27 +3439,29 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1890,8 +3488,8 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1916,7 +3514,7 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashLoanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1939,10 +3537,10 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashLoanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashLoanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1966,7 +3564,7 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1989,15 +3587,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2025,11 +3623,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keeper = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,7 +3655,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2080,7 +3678,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +3701,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14,8 +14,8 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
+	// Update the current price of each asset, derived markets after the markets they are derived from.
+	for _, market := range k.GetMarkets(ctx).SortedByDerivation() {
 		if !market.Active {
 			continue
 		}
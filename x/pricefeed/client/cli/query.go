@@ -2,11 +2,15 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/percosis-labs/fury/x/pricefeed/types"
 )
@@ -28,6 +32,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdRawPrices(),
 		GetCmdOracles(),
 		GetCmdMarkets(),
+		GetCmdFlaggedMarkets(),
+		GetCmdPriceAtHeight(),
+		GetCmdTwap(),
+		GetCmdCandles(),
+		GetCmdOracleReputations(),
 		GetCmdQueryParams(),
 	}
 
@@ -178,6 +187,225 @@ func GetCmdMarkets() *cobra.Command {
 	}
 }
 
+// GetCmdFlaggedMarkets queries the markets flagged for their price moving more than their max price deviation
+func GetCmdFlaggedMarkets() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flagged-markets",
+		Short: "get the markets flagged for a price moving more than their max deviation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FlaggedMarkets(context.Background(), &types.QueryFlaggedMarketsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// Query price history flags
+const (
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+	flagMarket    = "market"
+	flagOracle    = "oracle"
+)
+
+// GetCmdPriceAtHeight queries the price of a market at a height
+func GetCmdPriceAtHeight() *cobra.Command {
+	return &cobra.Command{
+		Use:     "price-at-height [marketID] [height]",
+		Short:   "get the price of a market at a height",
+		Long:    "Get the latest snapshot of a market's price at or before a height, from the market's price history.",
+		Example: fmt.Sprintf("%s q %s price-at-height btc:usd 1000", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PriceAtHeight(context.Background(), &types.QueryPriceAtHeightRequest{
+				MarketId: args[0],
+				Height:   height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Snapshot)
+		},
+	}
+}
+
+// GetCmdTwap queries the time-weighted average price of a market
+func GetCmdTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [marketID] [window]",
+		Short: "get the time-weighted average price of a market",
+		Long: `Get the time-weighted average price of a market over a window, from the market's price history.
+The window ends at the current block time, unless an end time is given.`,
+		Example: fmt.Sprintf(`%[1]s q %[2]s twap btc:usd 1h
+%[1]s q %[2]s twap btc:usd 30m --end-time 2022-01-01T00:00:00Z`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid window %s: %w", args[1], err)
+			}
+			endTime, err := getTimeFlag(cmd, flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Twap(context.Background(), &types.QueryTwapRequest{
+				MarketId: args[0],
+				Window:   window,
+				EndTime:  endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagEndTime, "", "(optional) end of the window, in RFC3339 format")
+
+	return cmd
+}
+
+// GetCmdCandles queries the open, high, low and close prices of a market
+func GetCmdCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [marketID] [interval]",
+		Short: "get the open, high, low and close prices of a market over consecutive intervals",
+		Long: `Get the open, high, low and close prices of a market over consecutive intervals, from the market's price history.
+Intervals start at the start of the price history and run to the current block time, unless start and end times are given.`,
+		Example: fmt.Sprintf(`%[1]s q %[2]s candles btc:usd 1h
+%[1]s q %[2]s candles btc:usd 15m --start-time 2022-01-01T00:00:00Z --end-time 2022-01-02T00:00:00Z`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid interval %s: %w", args[1], err)
+			}
+			startTime, err := getTimeFlag(cmd, flagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := getTimeFlag(cmd, flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Candles(context.Background(), &types.QueryCandlesRequest{
+				MarketId:  args[0],
+				Interval:  interval,
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStartTime, "", "(optional) start of the first interval, in RFC3339 format")
+	cmd.Flags().String(flagEndTime, "", "(optional) exclude prices at or after this time, in RFC3339 format")
+
+	return cmd
+}
+
+// GetCmdOracleReputations queries the posting records of oracles
+func GetCmdOracleReputations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-reputations",
+		Short: "get the posting records of oracles, and whether they are excluded from aggregation",
+		Long:  "Get the posted prices, missed windows and deviation from the median price of oracles, optionally filtered by market and oracle.",
+		Example: fmt.Sprintf(`%[1]s q %[2]s oracle-reputations
+%[1]s q %[2]s oracle-reputations --market btc:usd --oracle fury1...`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := cmd.Flags().GetString(flagMarket)
+			if err != nil {
+				return err
+			}
+			oracle, err := cmd.Flags().GetString(flagOracle)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OracleReputations(context.Background(), &types.QueryOracleReputationsRequest{
+				MarketId:      marketID,
+				OracleAddress: oracle,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagMarket, "", "(optional) filter by market id")
+	cmd.Flags().String(flagOracle, "", "(optional) filter by oracle address")
+
+	return cmd
+}
+
+// getTimeFlag parses an optional RFC3339 time flag, returning the zero time if it is unset
+func getTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s: %w", flag, str, err)
+	}
+	return t, nil
+}
+
 // GetCmdQueryParams queries the pricefeed module parameters
 func GetCmdQueryParams() *cobra.Command {
 	return &cobra.Command{
@@ -1,6 +1,8 @@
 package pricefeed
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/percosis-labs/fury/x/pricefeed/keeper"
@@ -21,23 +23,41 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			}
 		}
 	}
+	for _, flag := range gs.MarketFlags {
+		k.SetMarketFlag(ctx, flag)
+	}
+	for _, reference := range gs.ReferencePrices {
+		k.SetReferencePrice(ctx, reference)
+	}
+	for _, snapshot := range gs.PriceSnapshots {
+		k.AppendPriceSnapshot(ctx, snapshot)
+	}
 	params := k.GetParams(ctx)
 
 	// Set the current price (if any) based on what's now in the store
-	for _, market := range params.Markets {
+	for _, market := range params.Markets.SortedByDerivation() {
 		if !market.Active {
 			continue
 		}
 		rps := k.GetRawPrices(ctx, market.MarketID)
 
-		if len(rps) == 0 {
+		if len(rps) == 0 && !market.IsDerived() {
 			continue
 		}
+		// markets short of their minimum oracle posts are left without a price
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil {
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
 	}
+
+	// oracle reputations are restored last, replacing the posts and windows recorded while setting prices
+	for _, reputation := range k.GetAllOracleReputations(ctx) {
+		k.DeleteOracleReputation(ctx, reputation.MarketID, reputation.OracleAddress)
+	}
+	for _, reputation := range gs.OracleReputations {
+		k.SetOracleReputation(ctx, reputation)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,5 +71,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, k.GetMarketFlags(ctx), k.GetAllPriceSnapshots(ctx), k.GetAllOracleReputations(ctx), k.GetAllReferencePrices(ctx))
 }
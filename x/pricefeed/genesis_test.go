@@ -11,6 +11,7 @@ import (
 	"github.com/percosis-labs/fury/app"
 	"github.com/percosis-labs/fury/x/pricefeed"
 	"github.com/percosis-labs/fury/x/pricefeed/keeper"
+	"github.com/percosis-labs/fury/x/pricefeed/types"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -48,6 +49,11 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 
 func (suite *GenesisTestSuite) TestInitExportGenState() {
 	gs := NewPricefeedGen()
+	// the posted prices are accepted at genesis, which makes them the reference prices
+	gs.ReferencePrices = types.ReferencePrices{
+		types.NewReferencePrice("btc:usd", sdk.MustNewDecFromStr("8000.00"), suite.ctx.BlockTime()),
+		types.NewReferencePrice("xrp:usd", sdk.MustNewDecFromStr("0.25"), suite.ctx.BlockTime()),
+	}
 
 	suite.NotPanics(func() {
 		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
@@ -13,8 +13,8 @@ func NewPricefeedGen() types.GenesisState {
 	return types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				types.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{}, true),
+				types.NewMarket("xrp:usd", "xrp", "usd", []sdk.AccAddress{}, true),
 			},
 		},
 		PostedPrices: []types.PostedPrice{
@@ -43,8 +43,8 @@ func NewPricefeedGenStateWithOracles(addrs []sdk.AccAddress) app.GenesisState {
 	pfGenesis := types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: true},
+				types.NewMarket("btc:usd", "btc", "usd", addrs, true),
+				types.NewMarket("xrp:usd", "xrp", "usd", addrs, true),
 			},
 		},
 		PostedPrices: []types.PostedPrice{
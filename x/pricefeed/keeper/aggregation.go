@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+// aggregatePrice combines the unexpired oracle prices of a market into a single price, following the market's aggregation mode
+func (k Keeper) aggregatePrice(ctx sdk.Context, market types.Market, prices []types.CurrentPrice) sdk.Dec {
+	switch market.AggregationMode {
+	case types.AGGREGATION_MODE_TRIMMED_MEAN:
+		return k.CalculateTrimmedMeanPrice(prices, market.TrimFraction)
+	case types.AGGREGATION_MODE_TIME_WEIGHTED:
+		return k.calculateTimeWeightedPrice(ctx, market, k.CalculateMedianPrice(prices))
+	default:
+		return k.CalculateMedianPrice(prices)
+	}
+}
+
+// CalculateTrimmedMeanPrice calculates the mean of the input prices, after dropping the trim fraction
+// (rounded down) of the prices from each end. The input prices are not reordered.
+func (k Keeper) CalculateTrimmedMeanPrice(input []types.CurrentPrice, trimFraction sdk.Dec) sdk.Dec {
+	l := len(input)
+
+	prices := make([]types.CurrentPrice, l)
+	copy(prices, input)
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Price.LT(prices[j].Price)
+	})
+	trim := 0
+	if !trimFraction.IsNil() {
+		trim = int(trimFraction.MulInt64(int64(l)).TruncateInt64())
+	}
+	// a trim fraction below one half always leaves at least one price
+	kept := prices[trim : l-trim]
+
+	sum := sdk.ZeroDec()
+	for _, p := range kept {
+		sum = sum.Add(p.Price)
+	}
+	return sum.QuoInt64(int64(len(kept)))
+}
+
+// calculateTimeWeightedPrice records the median price of a market in its price history and returns the time-weighted
+// average of the history over the market's twap window. Markets with a history shorter than the window are averaged
+// over their whole history.
+func (k Keeper) calculateTimeWeightedPrice(ctx sdk.Context, market types.Market, median sdk.Dec) sdk.Dec {
+	k.recordPriceSnapshot(ctx, market, median)
+	price, _, err := k.GetTWAPBetween(ctx, market.MarketID, ctx.BlockTime().Add(-market.TwapWindow), ctx.BlockTime())
+	if err != nil {
+		panic(fmt.Sprintf("no price history for time weighted market %s after recording its price: %s", market.MarketID, err))
+	}
+	return price
+}
@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/pricefeed/keeper"
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+type aggregationTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+	ctx    sdk.Context
+}
+
+func (suite *aggregationTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	suite.ctx = tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.keeper = tApp.GetPriceFeedKeeper()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	suite.addrs = addrs
+}
+
+func TestAggregationTestSuite(t *testing.T) {
+	suite.Run(t, new(aggregationTestSuite))
+}
+
+func (suite *aggregationTestSuite) setMarket(market types.Market) {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{market}))
+}
+
+func (suite *aggregationTestSuite) postPrices(prices ...string) {
+	for i, price := range prices {
+		_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[i], "tstusd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *aggregationTestSuite) requireCurrentPrice(expected string) {
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr(expected).String(), price.Price.String())
+}
+
+func (suite *aggregationTestSuite) TestTrimmedMean() {
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.AggregationMode = types.AGGREGATION_MODE_TRIMMED_MEAN
+	market.TrimFraction = sdk.MustNewDecFromStr("0.2")
+	suite.setMarket(market)
+
+	// one price is dropped from each end of five
+	suite.postPrices("1.0", "100.0", "2.0", "3.0", "0.01")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("2.0")
+
+	// no prices are dropped from each end of four
+	prices := []types.CurrentPrice{
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("1.0")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("100.0")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("2.0")),
+		types.NewCurrentPrice("tstusd", sdk.MustNewDecFromStr("3.0")),
+	}
+	suite.Equal(sdk.MustNewDecFromStr("26.5").String(), suite.keeper.CalculateTrimmedMeanPrice(prices, market.TrimFraction).String())
+	// the input prices are not reordered
+	suite.Equal(sdk.MustNewDecFromStr("100.0").String(), prices[1].Price.String())
+}
+
+func (suite *aggregationTestSuite) TestMinOraclePosts() {
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MinOraclePosts = 3
+	suite.setMarket(market)
+
+	suite.postPrices("1.0", "2.0")
+	err := suite.keeper.SetCurrentPrices(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	suite.postPrices("1.0", "2.0", "4.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("2.0")
+}
+
+func (suite *aggregationTestSuite) TestTimeWeighted() {
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.AggregationMode = types.AGGREGATION_MODE_TIME_WEIGHTED
+	market.TwapWindow = time.Hour
+	suite.setMarket(market)
+	start := suite.ctx.BlockTime()
+
+	// markets with a price history shorter than the window are averaged since their first price
+	suite.postPrices("10.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.0")
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(start.Add(30 * time.Minute))
+	suite.postPrices("20.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.0")
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(start.Add(time.Hour))
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("15.0")
+
+	// the price at the start of the window is the latest median before it
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(start.Add(75 * time.Minute))
+	suite.postPrices("40.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("17.5")
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(start.Add(135 * time.Minute))
+	suite.postPrices("40.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("40.0")
+
+	// the medians are kept in the price history, which is pruned to the snapshots covering the window
+	snapshots := suite.keeper.GetAllPriceSnapshots(suite.ctx)
+	suite.Require().Len(snapshots, 2)
+	suite.Equal(start.Add(75*time.Minute), snapshots[0].Time)
+	suite.Equal(sdk.MustNewDecFromStr("40.0").String(), snapshots[1].Price.String())
+	twap, err := suite.keeper.GetTWAP(suite.ctx, "tstusd", time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("40.0").String(), twap.String())
+
+	// the history is removed once the market is no longer time weighted, unless it keeps a price history
+	suite.setMarket(types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true))
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.Empty(suite.keeper.GetAllPriceSnapshots(suite.ctx))
+}
+
+func (suite *aggregationTestSuite) TestMaxPriceDeviation() {
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MaxPriceDeviation = sdk.MustNewDecFromStr("0.1")
+	suite.setMarket(market)
+
+	// the first price is accepted without a previous price to compare to
+	suite.postPrices("10.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.0")
+
+	suite.postPrices("10.9")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.9")
+
+	// a price more than 10% away flags the market, and the current price is no longer valid
+	suite.postPrices("13.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	flag, found := suite.keeper.GetMarketFlag(suite.ctx, "tstusd")
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("13.0").String(), flag.Price.String())
+	suite.Equal(sdk.MustNewDecFromStr("10.9").String(), flag.ReferencePrice.String())
+
+	// flagged markets keep their reference price when oracle prices expire
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.ErrorIs(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"), types.ErrNoValidPrice)
+	suite.postPrices("13.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	_, found = suite.keeper.GetMarketFlag(suite.ctx, "tstusd")
+	suite.True(found)
+
+	// a price back within the deviation of the reference price unflags the market
+	suite.postPrices("11.5")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("11.5")
+	suite.Empty(suite.keeper.GetMarketFlags(suite.ctx))
+
+	// disabling the check unflags the market
+	suite.postPrices("20.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.Len(suite.keeper.GetMarketFlags(suite.ctx), 1)
+	res, err := keeper.NewQueryServerImpl(suite.keeper).FlaggedMarkets(sdk.WrapSDKContext(suite.ctx), &types.QueryFlaggedMarketsRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.MarketFlags, 1)
+
+	suite.setMarket(types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true))
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("20.0")
+	suite.Empty(suite.keeper.GetMarketFlags(suite.ctx))
+}
+
+func (suite *aggregationTestSuite) TestMaxPriceDeviationAfterExpiry() {
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MaxPriceDeviation = sdk.MustNewDecFromStr("0.1")
+	suite.setMarket(market)
+
+	suite.postPrices("10.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.0")
+
+	// the current price is cleared once oracle prices expire, but the reference price is kept
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.ErrorIs(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	reference, found := suite.keeper.GetReferencePrice(suite.ctx, "tstusd")
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("10.0").String(), reference.Price.String())
+
+	// so a spike posted after the gap still flags the market
+	suite.postPrices("50.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	flag, found := suite.keeper.GetMarketFlag(suite.ctx, "tstusd")
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("50.0").String(), flag.Price.String())
+	suite.Equal(sdk.MustNewDecFromStr("10.0").String(), flag.ReferencePrice.String())
+
+	// and accepted prices update the reference price
+	suite.postPrices("10.5")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.5")
+	reference, found = suite.keeper.GetReferencePrice(suite.ctx, "tstusd")
+	suite.Require().True(found)
+	suite.Equal(types.NewReferencePrice("tstusd", sdk.MustNewDecFromStr("10.5"), suite.ctx.BlockTime()), reference)
+}
+
+func (suite *aggregationTestSuite) TestFlagRecovery() {
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MaxPriceDeviation = sdk.MustNewDecFromStr("0.1")
+	market.FlagRecoveryRounds = 3
+	suite.setMarket(market)
+
+	suite.postPrices("10.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("10.0")
+
+	// rejected prices far from the previous rejected price restart the count
+	for _, price := range []string{"13.0", "13.5", "20.0", "20.5"} {
+		suite.postPrices(price)
+		suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	}
+	flag, found := suite.keeper.GetMarketFlag(suite.ctx, "tstusd")
+	suite.Require().True(found)
+	suite.Equal(uint32(1), flag.ConsistentRounds)
+	suite.Equal(sdk.MustNewDecFromStr("10.0").String(), flag.ReferencePrice.String())
+
+	suite.postPrices("21.0")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	flag, found = suite.keeper.GetMarketFlag(suite.ctx, "tstusd")
+	suite.Require().True(found)
+	suite.Equal(uint32(2), flag.ConsistentRounds)
+
+	// prices that hold steady for the recovery rounds are accepted, and become the new reference price
+	suite.postPrices("20.8")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("20.8")
+	suite.Empty(suite.keeper.GetMarketFlags(suite.ctx))
+
+	suite.postPrices("21.5")
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	suite.requireCurrentPrice("21.5")
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+// exchangeRatePrecision is the number of decimal places exchange rates are calculated to
+const exchangeRatePrecision = sdk.Precision
+
+// calculateDerivedPrice computes the price of a derived market from the current prices of its source markets, which
+// must be updated first. Derived markets have no valid price while any of their sources has none.
+func (k Keeper) calculateDerivedPrice(ctx sdk.Context, market types.Market) (sdk.Dec, error) {
+	sourcePrices := make([]sdk.Dec, len(market.Derivation.SourceMarketIDs))
+	for i, id := range market.Derivation.SourceMarketIDs {
+		price, err := k.GetCurrentPrice(ctx, id)
+		if err != nil {
+			return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoValidPrice, "source market %s of %s: %s", id, market.MarketID, err)
+		}
+		sourcePrices[i] = price.Price
+	}
+
+	switch market.Derivation.Type {
+	case types.DERIVATION_TYPE_INVERSE:
+		return sdk.OneDec().Quo(sourcePrices[0]), nil
+	case types.DERIVATION_TYPE_PRODUCT:
+		price := sdk.OneDec()
+		for _, p := range sourcePrices {
+			price = price.Mul(p)
+		}
+		return price, nil
+	case types.DERIVATION_TYPE_EXCHANGE_RATE:
+		rate, err := k.GetExchangeRate(ctx, market.Derivation.DerivativeDenom)
+		if err != nil {
+			return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoValidPrice, "market %s: %s", market.MarketID, err)
+		}
+		if !rate.IsPositive() {
+			return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoValidPrice, "market %s: %s has no value", market.MarketID, market.Derivation.DerivativeDenom)
+		}
+		return sourcePrices[0].Mul(rate), nil
+	case types.DERIVATION_TYPE_SWAP_TWAP:
+		price, err := k.getSwapTWAP(ctx, market)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		for _, p := range sourcePrices {
+			price = price.Mul(p)
+		}
+		return price, nil
+	default:
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidMarket, "market %s is not derived", market.MarketID)
+	}
+}
+
+// getSwapTWAP returns the time-weighted average price of a market's swap pool over the market's twap window. Pools
+// without a price history covering the whole window have no valid price, so a new pool can not set the price of a
+// market from only a few trades.
+func (k Keeper) getSwapTWAP(ctx sdk.Context, market types.Market) (sdk.Dec, error) {
+	derivation := market.Derivation
+
+	// the swap module prunes pool prices older than its price history window, so a longer twap window is never covered
+	if historyWindow := k.swapKeeper.GetPriceHistoryWindow(ctx); market.TwapWindow > historyWindow {
+		return sdk.Dec{}, errorsmod.Wrapf(
+			types.ErrNoValidPrice, "market %s: twap window %s is longer than the swap price history window %s", market.MarketID, market.TwapWindow, historyWindow,
+		)
+	}
+
+	windowStart := ctx.BlockTime().Add(-market.TwapWindow)
+
+	price, start, err := k.swapKeeper.GetTWAPBetween(
+		ctx, derivation.SwapPoolID, derivation.SwapBaseDenom, derivation.SwapQuoteDenom, windowStart, ctx.BlockTime(),
+	)
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoValidPrice, "market %s: %s", market.MarketID, err)
+	}
+	if start.After(windowStart) {
+		return sdk.Dec{}, errorsmod.Wrapf(
+			types.ErrNoValidPrice, "market %s: swap pool %s price history is shorter than %s", market.MarketID, derivation.SwapPoolID, market.TwapWindow,
+		)
+	}
+
+	// pool prices are in the smallest units of each denom, so are scaled to price whole units
+	if derivation.SwapBaseDecimals > derivation.SwapQuoteDecimals {
+		price = price.Mul(decimalFactor(derivation.SwapBaseDecimals - derivation.SwapQuoteDecimals))
+	} else if derivation.SwapQuoteDecimals > derivation.SwapBaseDecimals {
+		price = price.Quo(decimalFactor(derivation.SwapQuoteDecimals - derivation.SwapBaseDecimals))
+	}
+	return price, nil
+}
+
+// decimalFactor returns 10 to the power of decimals
+func decimalFactor(decimals uint32) sdk.Dec {
+	return sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(decimals)))
+}
+
+// GetExchangeRate returns the value of one unit of a liquid staking derivative in its underlying staked token
+func (k Keeper) GetExchangeRate(ctx sdk.Context, derivativeDenom string) (sdk.Dec, error) {
+	amount := sdkmath.NewIntWithDecimal(1, exchangeRatePrecision)
+	value, err := k.liquidKeeper.GetStakedTokensForDerivatives(ctx, sdk.NewCoins(sdk.NewCoin(derivativeDenom, amount)))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromIntWithPrec(value.Amount, exchangeRatePrecision), nil
+}
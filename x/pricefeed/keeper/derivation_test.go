@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/pricefeed"
+	"github.com/percosis-labs/fury/x/pricefeed/keeper"
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+	swaptypes "github.com/percosis-labs/fury/x/swap/types"
+)
+
+type derivationTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+	ctx    sdk.Context
+}
+
+func (suite *derivationTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	suite.ctx = tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.tApp = tApp
+	suite.keeper = tApp.GetPriceFeedKeeper()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	suite.addrs = addrs
+}
+
+func TestDerivationTestSuite(t *testing.T) {
+	suite.Run(t, new(derivationTestSuite))
+}
+
+func newDerivedMarket(id, base, quote string, derivationType types.DerivationType, sources ...string) types.Market {
+	market := types.NewMarket(id, base, quote, []sdk.AccAddress{}, true)
+	market.Derivation = types.MarketDerivation{
+		Type:            derivationType,
+		SourceMarketIDs: sources,
+	}
+	return market
+}
+
+func (suite *derivationTestSuite) postPrice(marketID, price string) {
+	_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[0], marketID, sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+}
+
+func (suite *derivationTestSuite) requireCurrentPrice(marketID, expected string) {
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, marketID)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr(expected).String(), price.Price.String())
+}
+
+func (suite *derivationTestSuite) TestDerivedMarkets() {
+	// derived markets are listed before their sources, and derived from each other
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		newDerivedMarket("usd:xrp", "usd", "xrp", types.DERIVATION_TYPE_INVERSE, "xrp:usd"),
+		newDerivedMarket("xrp:usd", "xrp", "usd", types.DERIVATION_TYPE_PRODUCT, "xrp:fury", "fury:usd"),
+		types.NewMarket("xrp:fury", "xrp", "fury", []sdk.AccAddress{suite.addrs[0]}, true),
+		types.NewMarket("fury:usd", "fury", "usd", []sdk.AccAddress{suite.addrs[0]}, true),
+	}))
+	suite.postPrice("xrp:fury", "0.5")
+	suite.postPrice("fury:usd", "4.0")
+
+	pricefeed.EndBlocker(suite.ctx, suite.keeper)
+	suite.requireCurrentPrice("xrp:usd", "2.0")
+	suite.requireCurrentPrice("usd:xrp", "0.5")
+
+	suite.postPrice("fury:usd", "8.0")
+	pricefeed.EndBlocker(suite.ctx, suite.keeper)
+	suite.requireCurrentPrice("xrp:usd", "4.0")
+	suite.requireCurrentPrice("usd:xrp", "0.25")
+
+	// derived markets have no price once a source has none
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.postPrice("xrp:fury", "0.5")
+	pricefeed.EndBlocker(suite.ctx, suite.keeper)
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "xrp:usd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "usd:xrp")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+}
+
+func (suite *derivationTestSuite) TestExchangeRateDerivation() {
+	market := newDerivedMarket("bfury:usd", "bfury", "usd", types.DERIVATION_TYPE_EXCHANGE_RATE, "fury:usd")
+	market.Derivation.DerivativeDenom = "bfury-invalid"
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		types.NewMarket("fury:usd", "fury", "usd", []sdk.AccAddress{suite.addrs[0]}, true),
+		market,
+	}))
+	suite.postPrice("fury:usd", "4.0")
+
+	// derivatives the exchange rate source does not recognize have no price
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "fury:usd"))
+	err := suite.keeper.SetCurrentPrices(suite.ctx, "bfury:usd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "bfury:usd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+}
+
+func (suite *derivationTestSuite) TestSwapTWAPDerivation() {
+	swapKeeper := suite.tApp.GetSwapKeeper()
+	swapKeeper.SetParams(suite.ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ufury", "usdf")),
+		sdk.ZeroDec(),
+	))
+	deposit := sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(10e6)), sdk.NewCoin("usdf", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.tApp.FundAccount(suite.ctx, suite.addrs[0], deposit))
+	err := swapKeeper.Deposit(suite.ctx, suite.addrs[0], deposit[0], deposit[1], sdk.OneDec())
+	suite.Require().NoError(err)
+
+	poolMarket := newDerivedMarket("fury:usdf", "fury", "usdf", types.DERIVATION_TYPE_SWAP_TWAP)
+	poolMarket.TwapWindow = time.Hour
+	poolMarket.Derivation.SwapPoolID = "ufury:usdf"
+	poolMarket.Derivation.SwapBaseDenom = "ufury"
+	poolMarket.Derivation.SwapQuoteDenom = "usdf"
+	usdMarket := poolMarket
+	usdMarket.MarketID, usdMarket.QuoteAsset = "fury:usd", "usd"
+	usdMarket.Derivation.SourceMarketIDs = []string{"usdf:usd"}
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		poolMarket,
+		usdMarket,
+		types.NewMarket("usdf:usd", "usdf", "usd", []sdk.AccAddress{suite.addrs[0]}, true),
+	}))
+	suite.postPrice("usdf:usd", "1.01")
+
+	// pools without a price history covering the whole window have no price
+	pricefeed.EndBlocker(suite.ctx, suite.keeper)
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "fury:usdf")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "fury:usd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.postPrice("usdf:usd", "1.01")
+	pricefeed.EndBlocker(suite.ctx, suite.keeper)
+	suite.requireCurrentPrice("fury:usdf", "5.0")
+	suite.requireCurrentPrice("fury:usd", "5.05")
+}
+
+func (suite *derivationTestSuite) TestSwapTWAPDerivation_Decimals() {
+	swapKeeper := suite.tApp.GetSwapKeeper()
+	swapKeeper.SetParams(suite.ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ufury", "wusdf")),
+		sdk.ZeroDec(),
+	))
+	// 10 fury with 6 decimals and 50 wusdf with 18 decimals
+	deposit := sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(10e6)), sdk.NewCoin("wusdf", sdkmath.NewIntWithDecimal(50, 18)))
+	suite.Require().NoError(suite.tApp.FundAccount(suite.ctx, suite.addrs[0], deposit))
+	err := swapKeeper.Deposit(suite.ctx, suite.addrs[0], deposit[0], deposit[1], sdk.OneDec())
+	suite.Require().NoError(err)
+
+	market := newDerivedMarket("fury:wusdf", "fury", "wusdf", types.DERIVATION_TYPE_SWAP_TWAP)
+	market.TwapWindow = time.Hour
+	market.Derivation.SwapPoolID = "ufury:wusdf"
+	market.Derivation.SwapBaseDenom = "ufury"
+	market.Derivation.SwapQuoteDenom = "wusdf"
+	market.Derivation.SwapBaseDecimals = 6
+	market.Derivation.SwapQuoteDecimals = 18
+	inverse := market
+	inverse.MarketID, inverse.BaseAsset, inverse.QuoteAsset = "wusdf:fury", "wusdf", "fury"
+	inverse.Derivation.SwapBaseDenom, inverse.Derivation.SwapQuoteDenom = "wusdf", "ufury"
+	inverse.Derivation.SwapBaseDecimals, inverse.Derivation.SwapQuoteDecimals = 18, 6
+	// the swap module only keeps a day of pool prices by default
+	tooLong := market
+	tooLong.MarketID = "fury:wusdf:25h"
+	tooLong.TwapWindow = 25 * time.Hour
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{market, inverse, tooLong}))
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	pricefeed.EndBlocker(suite.ctx, suite.keeper)
+
+	// the pool price of 5e12 in the smallest units is the price of whole units
+	suite.requireCurrentPrice("fury:wusdf", "5.0")
+	suite.requireCurrentPrice("wusdf:fury", "0.2")
+
+	err = suite.keeper.SetCurrentPrices(suite.ctx, "fury:wusdf:25h")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	suite.ErrorContains(err, "longer than the swap price history window")
+}
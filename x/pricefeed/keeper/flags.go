@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+// checkPriceDeviation flags the market and returns true if the price deviates from the market's reference price, the
+// last accepted price, by more than the market's max price deviation. The reference price is kept when the current price
+// is cleared, so prices after a gap in oracle posts are checked too. Flagged markets are unflagged once a price within
+// the deviation is aggregated, or the check is disabled. A flagged market whose rejected prices stay within the deviation
+// of each other for the market's recovery rounds takes the move as genuine, and is unflagged with the latest price accepted.
+func (k Keeper) checkPriceDeviation(ctx sdk.Context, market types.Market, price sdk.Dec) bool {
+	flag, flagged := k.GetMarketFlag(ctx, market.MarketID)
+
+	referencePrice, found := k.GetReferencePrice(ctx, market.MarketID)
+	reference := referencePrice.Price
+	if !found && flagged {
+		reference = flag.ReferencePrice
+	}
+	if market.DeviationCheckEnabled() && (found || flagged) {
+		deviation := price.Sub(reference).Abs().Quo(reference)
+		if deviation.GT(market.MaxPriceDeviation) {
+			newFlag := types.NewMarketFlag(market.MarketID, price, reference, ctx.BlockTime())
+			if flagged {
+				newFlag.FlaggedAt = flag.FlaggedAt
+				if flag.Price.IsPositive() && !price.Sub(flag.Price).Abs().Quo(flag.Price).GT(market.MaxPriceDeviation) {
+					newFlag.ConsistentRounds = flag.ConsistentRounds + 1
+				}
+			} else {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeMarketFlagged,
+						sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+						sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+						sdk.NewAttribute(types.AttributeReferencePrice, reference.String()),
+					),
+				)
+			}
+			if newFlag.ConsistentRounds < market.RecoveryRounds() {
+				k.SetMarketFlag(ctx, newFlag)
+				return true
+			}
+		}
+	}
+
+	if flagged {
+		k.DeleteMarketFlag(ctx, market.MarketID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketUnflagged,
+				sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+			),
+		)
+	}
+	return false
+}
+
+// GetMarketFlag returns the flag of a market, if it is flagged
+func (k Keeper) GetMarketFlag(ctx sdk.Context, marketID string) (types.MarketFlag, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.MarketFlagKey(marketID))
+	if bz == nil {
+		return types.MarketFlag{}, false
+	}
+	var flag types.MarketFlag
+	k.cdc.MustUnmarshal(bz, &flag)
+	return flag, true
+}
+
+// SetMarketFlag flags a market
+func (k Keeper) SetMarketFlag(ctx sdk.Context, flag types.MarketFlag) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.MarketFlagKey(flag.MarketID), k.cdc.MustMarshal(&flag))
+}
+
+// DeleteMarketFlag unflags a market
+func (k Keeper) DeleteMarketFlag(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.MarketFlagKey(marketID))
+}
+
+// IterateMarketFlags iterates over all market flags in the store and performs a callback function
+func (k Keeper) IterateMarketFlags(ctx sdk.Context, cb func(flag types.MarketFlag) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.MarketFlagPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var flag types.MarketFlag
+		k.cdc.MustUnmarshal(iterator.Value(), &flag)
+		if cb(flag) {
+			break
+		}
+	}
+}
+
+// GetMarketFlags returns all market flags from the store
+func (k Keeper) GetMarketFlags(ctx sdk.Context) types.MarketFlags {
+	var flags types.MarketFlags
+	k.IterateMarketFlags(ctx, func(flag types.MarketFlag) (stop bool) {
+		flags = append(flags, flag)
+		return false
+	})
+	return flags
+}
+
+// GetReferencePrice returns the last accepted price of a market
+func (k Keeper) GetReferencePrice(ctx sdk.Context, marketID string) (types.ReferencePrice, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.ReferencePriceKey(marketID))
+	if bz == nil {
+		return types.ReferencePrice{}, false
+	}
+	var reference types.ReferencePrice
+	k.cdc.MustUnmarshal(bz, &reference)
+	return reference, true
+}
+
+// SetReferencePrice sets the last accepted price of a market
+func (k Keeper) SetReferencePrice(ctx sdk.Context, reference types.ReferencePrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.ReferencePriceKey(reference.MarketID), k.cdc.MustMarshal(&reference))
+}
+
+// IterateReferencePrices iterates over all reference prices in the store and performs a callback function
+func (k Keeper) IterateReferencePrices(ctx sdk.Context, cb func(reference types.ReferencePrice) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.ReferencePricePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reference types.ReferencePrice
+		k.cdc.MustUnmarshal(iterator.Value(), &reference)
+		if cb(reference) {
+			break
+		}
+	}
+}
+
+// GetAllReferencePrices returns the reference prices of all markets from the store
+func (k Keeper) GetAllReferencePrices(ctx sdk.Context) types.ReferencePrices {
+	var references types.ReferencePrices
+	k.IterateReferencePrices(ctx, func(reference types.ReferencePrice) (stop bool) {
+		references = append(references, reference)
+		return false
+	})
+	return references
+}
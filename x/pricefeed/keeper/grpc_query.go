@@ -120,3 +120,115 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+func (s queryServer) FlaggedMarkets(c context.Context, req *types.QueryFlaggedMarketsRequest) (*types.QueryFlaggedMarketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFlaggedMarketsResponse{
+		MarketFlags: s.keeper.GetMarketFlags(ctx),
+	}, nil
+}
+
+func (s queryServer) PriceAtHeight(c context.Context, req *types.QueryPriceAtHeightRequest) (*types.QueryPriceAtHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	snapshot, err := s.keeper.GetPriceAtHeight(ctx, req.MarketId, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceAtHeightResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+func (s queryServer) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	if req.Window < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window cannot be negative")
+	}
+	endTime := req.EndTime
+	if endTime.IsZero() || endTime.After(ctx.BlockTime()) {
+		endTime = ctx.BlockTime()
+	}
+	price, startTime, err := s.keeper.GetTWAPBetween(ctx, req.MarketId, endTime.Add(-req.Window), endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{
+		Price:     price,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+func (s queryServer) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	if req.Interval <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval must be positive")
+	}
+	endTime := req.EndTime
+	if endTime.IsZero() {
+		endTime = ctx.BlockTime().Add(1)
+	}
+	candles, err := s.keeper.GetCandles(ctx, req.MarketId, req.Interval, req.StartTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCandlesResponse{
+		Candles: candles,
+	}, nil
+}
+
+func (s queryServer) OracleReputations(c context.Context, req *types.QueryOracleReputationsRequest) (*types.QueryOracleReputationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var oracle sdk.AccAddress
+	if req.OracleAddress != "" {
+		var err error
+		oracle, err = sdk.AccAddressFromBech32(req.OracleAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid oracle address")
+		}
+	}
+
+	var reputations types.OracleReputations
+	if req.MarketId != "" {
+		if _, found := s.keeper.GetMarket(ctx, req.MarketId); !found {
+			return nil, status.Error(codes.NotFound, "invalid market ID")
+		}
+		s.keeper.IterateOracleReputationsByMarket(ctx, req.MarketId, func(r types.OracleReputation) (stop bool) {
+			reputations = append(reputations, r)
+			return false
+		})
+	} else {
+		reputations = s.keeper.GetAllOracleReputations(ctx)
+	}
+
+	var responses types.OracleReputationResponses
+	for _, r := range reputations {
+		if oracle.Empty() || oracle.Equals(r.OracleAddress) {
+			responses = append(responses, r.ToOracleReputationResponse())
+		}
+	}
+
+	return &types.QueryOracleReputationsResponse{
+		OracleReputations: responses,
+	}, nil
+}
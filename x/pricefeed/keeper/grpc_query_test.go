@@ -45,7 +45,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 
 func (suite *grpcQueryTestSuite) setTestParams() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 }
@@ -58,7 +58,7 @@ func (suite *grpcQueryTestSuite) TestGrpcParams() {
 	}{
 		{"default params", types.DefaultParams(), true},
 		{"test params", types.NewParams([]types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		}), true},
 	}
 
@@ -119,8 +119,8 @@ func (suite *grpcQueryTestSuite) TestGrpcPrices() {
 
 func (suite *grpcQueryTestSuite) TestGrpcPrices_NoPriceSet() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tst:usd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		{MarketID: "other:usd", BaseAsset: "other", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true),
+		types.NewMarket("other:usd", "other", "usd", []sdk.AccAddress{}, true),
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 
@@ -188,7 +188,7 @@ func (suite *grpcQueryTestSuite) TestGrpcRawPrices_InvalidMarket() {
 
 func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 
@@ -197,7 +197,7 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 	suite.Empty(res.Oracles)
 
 	params = types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true},
+		types.NewMarket("tstusd", "tst", "usd", suite.addrs, true),
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 
@@ -211,7 +211,7 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 
 func (suite *grpcQueryTestSuite) TestGrpcOracles() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true},
+		types.NewMarket("tstusd", "tst", "usd", suite.addrs, true),
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 
@@ -229,8 +229,8 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_InvalidMarket() {
 
 func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		{MarketID: "btcusd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+		types.NewMarket("btcusd", "btc", "usd", []sdk.AccAddress{}, true),
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 
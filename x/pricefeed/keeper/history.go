@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+// recordPriceSnapshot appends the current price of a market to its price history, dropping the oldest snapshots beyond
+// the market's price history length. Time weighted markets record their median price instead, and also keep the
+// snapshots needed to average over their twap window. A price set more than once in a block replaces the block's
+// snapshot.
+func (k Keeper) recordPriceSnapshot(ctx sdk.Context, market types.Market, price sdk.Dec) {
+	if market.PriceHistoryLength == 0 && !market.IsTimeWeighted() {
+		k.deletePriceHistory(ctx, market.MarketID)
+		return
+	}
+
+	history, _ := k.getPriceHistory(ctx, market.MarketID)
+	cumulativePrice := sdk.ZeroDec()
+	if history.Next > history.First {
+		last := k.getPriceSnapshot(ctx, market.MarketID, history.Next-1)
+		if last.Height == ctx.BlockHeight() {
+			last.Price = price
+			k.setPriceSnapshot(ctx, history.Next-1, last)
+			return
+		}
+		cumulativePrice = last.CumulativePriceAt(ctx.BlockTime())
+	}
+
+	snapshot := types.NewPriceSnapshot(market.MarketID, ctx.BlockHeight(), ctx.BlockTime(), price, cumulativePrice)
+	k.setPriceSnapshot(ctx, history.Next, snapshot)
+	history.Next++
+	windowStart := ctx.BlockTime().Add(-market.TwapWindow)
+	for history.Next-history.First > uint64(market.PriceHistoryLength) {
+		// the latest snapshot at or before the start of the window is the price at the start of the window
+		if market.IsTimeWeighted() && (history.Next-history.First == 1 ||
+			k.getPriceSnapshot(ctx, market.MarketID, history.First+1).Time.After(windowStart)) {
+			break
+		}
+		k.deletePriceSnapshot(ctx, market.MarketID, history.First)
+		history.First++
+	}
+	k.setPriceHistory(ctx, market.MarketID, history)
+}
+
+// AppendPriceSnapshot adds a snapshot to the end of a market's price history as it is, without recalculating its
+// cumulative price. It is used to restore price histories from genesis.
+func (k Keeper) AppendPriceSnapshot(ctx sdk.Context, snapshot types.PriceSnapshot) {
+	history, _ := k.getPriceHistory(ctx, snapshot.MarketID)
+	k.setPriceSnapshot(ctx, history.Next, snapshot)
+	history.Next++
+	k.setPriceHistory(ctx, snapshot.MarketID, history)
+}
+
+// GetPriceAtHeight returns the latest snapshot of a market's price at or before a height
+func (k Keeper) GetPriceAtHeight(ctx sdk.Context, marketID string, height int64) (types.PriceSnapshot, error) {
+	history, found := k.getPriceHistory(ctx, marketID)
+	if !found || history.Next == history.First {
+		return types.PriceSnapshot{}, errorsmod.Wrapf(types.ErrNoPriceHistory, "market %s", marketID)
+	}
+	n := k.searchPriceSnapshots(ctx, marketID, history, func(ps types.PriceSnapshot) bool {
+		return ps.Height > height
+	})
+	if n == 0 {
+		first := k.getPriceSnapshot(ctx, marketID, history.First)
+		return types.PriceSnapshot{}, errorsmod.Wrapf(
+			types.ErrNoPriceHistory, "height %d is before the price history of market %s, which starts at %d", height, marketID, first.Height,
+		)
+	}
+	return k.getPriceSnapshot(ctx, marketID, history.First+n-1), nil
+}
+
+// GetTWAP returns the time-weighted average price of a market over the window ending at the current block time.
+// Markets with a price history shorter than the window are averaged over their whole price history.
+func (k Keeper) GetTWAP(ctx sdk.Context, marketID string, window time.Duration) (sdk.Dec, error) {
+	price, _, err := k.GetTWAPBetween(ctx, marketID, ctx.BlockTime().Add(-window), ctx.BlockTime())
+	return price, err
+}
+
+// GetTWAPBetween returns the time-weighted average price of a market between two times, along with the start time
+// actually averaged from, which is the start of the market's price history if that is later than the start time.
+// Each price in the history is weighted by how long it was the market's current price.
+func (k Keeper) GetTWAPBetween(ctx sdk.Context, marketID string, start, end time.Time) (sdk.Dec, time.Time, error) {
+	history, found := k.getPriceHistory(ctx, marketID)
+	if !found || history.Next == history.First {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrapf(types.ErrNoValidPrice, "no price history for market %s", marketID)
+	}
+	first := k.getPriceSnapshot(ctx, marketID, history.First)
+	if end.Before(first.Time) {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrapf(
+			types.ErrNoValidPrice, "%s is before the price history of market %s", end, marketID,
+		)
+	}
+	if end.After(ctx.BlockTime()) {
+		end = ctx.BlockTime()
+	}
+	if start.Before(first.Time) {
+		start = first.Time
+	}
+
+	endSnapshot := k.getPriceSnapshotAt(ctx, marketID, history, end)
+	if !end.After(start) {
+		return endSnapshot.Price, start, nil
+	}
+	startSnapshot := k.getPriceSnapshotAt(ctx, marketID, history, start)
+
+	sum := endSnapshot.CumulativePriceAt(end).Sub(startSnapshot.CumulativePriceAt(start))
+	return sum.Quo(types.DurationSeconds(end.Sub(start))), start, nil
+}
+
+// GetCandles returns the open, high, low and close prices of a market over consecutive intervals from the start time,
+// for the snapshots in the market's price history before the end time. Intervals without snapshots are skipped.
+func (k Keeper) GetCandles(ctx sdk.Context, marketID string, interval time.Duration, start, end time.Time) ([]types.Candle, error) {
+	if interval <= 0 {
+		return nil, errors.New("candle interval must be positive")
+	}
+
+	var candles []types.Candle
+	k.IteratePriceSnapshots(ctx, marketID, func(ps types.PriceSnapshot) (stop bool) {
+		if start.IsZero() {
+			start = ps.Time
+		}
+		if ps.Time.Before(start) {
+			return false
+		}
+		if !ps.Time.Before(end) {
+			return true
+		}
+
+		candleStart := start.Add(ps.Time.Sub(start) / interval * interval)
+		if len(candles) == 0 || !candles[len(candles)-1].StartTime.Equal(candleStart) {
+			candles = append(candles, types.NewCandle(candleStart, ps.Price))
+			return false
+		}
+		candles[len(candles)-1].Update(ps.Price)
+		return false
+	})
+	return candles, nil
+}
+
+// getPriceSnapshotAt returns the latest snapshot at or before a time, which must not be before the price history
+func (k Keeper) getPriceSnapshotAt(ctx sdk.Context, marketID string, history types.PriceHistory, t time.Time) types.PriceSnapshot {
+	n := k.searchPriceSnapshots(ctx, marketID, history, func(ps types.PriceSnapshot) bool {
+		return ps.Time.After(t)
+	})
+	return k.getPriceSnapshot(ctx, marketID, history.First+n-1)
+}
+
+// searchPriceSnapshots returns the number of snapshots in a market's price history before the first snapshot
+// the condition is true for. The condition must be false for older snapshots than those it is true for.
+func (k Keeper) searchPriceSnapshots(ctx sdk.Context, marketID string, history types.PriceHistory, f func(types.PriceSnapshot) bool) uint64 {
+	n := sort.Search(int(history.Next-history.First), func(i int) bool {
+		return f(k.getPriceSnapshot(ctx, marketID, history.First+uint64(i)))
+	})
+	return uint64(n)
+}
+
+func (k Keeper) getPriceHistory(ctx sdk.Context, marketID string) (types.PriceHistory, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceHistoryKey(marketID))
+	if bz == nil {
+		return types.PriceHistory{}, false
+	}
+	var history types.PriceHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+func (k Keeper) setPriceHistory(ctx sdk.Context, marketID string, history types.PriceHistory) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceHistoryKey(marketID), k.cdc.MustMarshal(&history))
+}
+
+// deletePriceHistory removes the price history of a market that no longer keeps one
+func (k Keeper) deletePriceHistory(ctx sdk.Context, marketID string) {
+	history, found := k.getPriceHistory(ctx, marketID)
+	if !found {
+		return
+	}
+	for seq := history.First; seq < history.Next; seq++ {
+		k.deletePriceSnapshot(ctx, marketID, seq)
+	}
+	ctx.KVStore(k.key).Delete(types.PriceHistoryKey(marketID))
+}
+
+func (k Keeper) getPriceSnapshot(ctx sdk.Context, marketID string, sequence uint64) types.PriceSnapshot {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceSnapshotKey(marketID, sequence))
+	var snapshot types.PriceSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot
+}
+
+func (k Keeper) setPriceSnapshot(ctx sdk.Context, sequence uint64, snapshot types.PriceSnapshot) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceSnapshotKey(snapshot.MarketID, sequence), k.cdc.MustMarshal(&snapshot))
+}
+
+func (k Keeper) deletePriceSnapshot(ctx sdk.Context, marketID string, sequence uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.PriceSnapshotKey(marketID, sequence))
+}
+
+// IteratePriceSnapshots iterates over the price history of a market, oldest first, and performs a callback function
+func (k Keeper) IteratePriceSnapshots(ctx sdk.Context, marketID string, cb func(ps types.PriceSnapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ps types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &ps)
+		if cb(ps) {
+			break
+		}
+	}
+}
+
+// GetAllPriceSnapshots returns the price histories of all markets, oldest first within each market
+func (k Keeper) GetAllPriceSnapshots(ctx sdk.Context) types.PriceSnapshots {
+	var snapshots types.PriceSnapshots
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ps types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &ps)
+		snapshots = append(snapshots, ps)
+	}
+	return snapshots
+}
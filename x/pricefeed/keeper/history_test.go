@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/pricefeed/keeper"
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+type historyTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+	ctx    sdk.Context
+	start  time.Time
+}
+
+func (suite *historyTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	suite.start = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockHeight(1).
+		WithBlockTime(suite.start)
+	suite.keeper = tApp.GetPriceFeedKeeper()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	suite.addrs = addrs
+
+	market := types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.PriceHistoryLength = 4
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{market}))
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(historyTestSuite))
+}
+
+// setPriceAt advances to a block and sets the current price of the test market
+func (suite *historyTestSuite) setPriceAt(height int64, elapsed time.Duration, price string) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(suite.start.Add(elapsed))
+	_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[0], "tstusd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+}
+
+func (suite *historyTestSuite) TestRecordPriceSnapshot() {
+	suite.setPriceAt(1, 0, "10.0")
+	// prices set more than once in a block replace the block's snapshot
+	suite.setPriceAt(1, 0, "11.0")
+	suite.setPriceAt(2, time.Minute, "20.0")
+
+	snapshots := suite.keeper.GetAllPriceSnapshots(suite.ctx)
+	suite.Require().Len(snapshots, 2)
+	suite.Equal(sdk.MustNewDecFromStr("11.0").String(), snapshots[0].Price.String())
+	suite.Equal(sdk.ZeroDec().String(), snapshots[0].CumulativePrice.String())
+	suite.Equal(sdk.MustNewDecFromStr("660.0").String(), snapshots[1].CumulativePrice.String())
+
+	// the oldest snapshots are dropped beyond the price history length
+	suite.setPriceAt(3, 2*time.Minute, "30.0")
+	suite.setPriceAt(4, 3*time.Minute, "40.0")
+	suite.setPriceAt(5, 4*time.Minute, "50.0")
+	snapshots = suite.keeper.GetAllPriceSnapshots(suite.ctx)
+	suite.Require().Len(snapshots, 4)
+	suite.Equal(int64(2), snapshots[0].Height)
+	suite.NoError(snapshots.Validate())
+
+	// the price history is removed once the market no longer keeps one
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+	}))
+	suite.setPriceAt(6, 5*time.Minute, "60.0")
+	suite.Empty(suite.keeper.GetAllPriceSnapshots(suite.ctx))
+	_, err := suite.keeper.GetPriceAtHeight(suite.ctx, "tstusd", 6)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+}
+
+func (suite *historyTestSuite) TestGetPriceAtHeight() {
+	suite.setPriceAt(10, 0, "10.0")
+	suite.setPriceAt(20, time.Minute, "20.0")
+
+	_, err := suite.keeper.GetPriceAtHeight(suite.ctx, "tstusd", 9)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+
+	snapshot, err := suite.keeper.GetPriceAtHeight(suite.ctx, "tstusd", 19)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("10.0").String(), snapshot.Price.String())
+
+	res, err := keeper.NewQueryServerImpl(suite.keeper).PriceAtHeight(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceAtHeightRequest{
+		MarketId: "tstusd",
+		Height:   25,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(int64(20), res.Snapshot.Height)
+	suite.Equal(sdk.MustNewDecFromStr("20.0").String(), res.Snapshot.Price.String())
+}
+
+func (suite *historyTestSuite) TestGetTWAP() {
+	_, err := suite.keeper.GetTWAP(suite.ctx, "tstusd", time.Hour)
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	suite.setPriceAt(1, 0, "10.0")
+	suite.setPriceAt(2, 30*time.Minute, "20.0")
+	suite.setPriceAt(3, 45*time.Minute, "40.0")
+	suite.ctx = suite.ctx.WithBlockTime(suite.start.Add(time.Hour))
+
+	// 30m at 10, 15m at 20 and 15m at 40
+	twap, err := suite.keeper.GetTWAP(suite.ctx, "tstusd", time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("20.0").String(), twap.String())
+
+	// windows longer than the price history are averaged over the whole history
+	twap, err = suite.keeper.GetTWAP(suite.ctx, "tstusd", 2*time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("20.0").String(), twap.String())
+
+	// the window may start and end between snapshots
+	res, err := keeper.NewQueryServerImpl(suite.keeper).Twap(sdk.WrapSDKContext(suite.ctx), &types.QueryTwapRequest{
+		MarketId: "tstusd",
+		Window:   20 * time.Minute,
+		EndTime:  suite.start.Add(40 * time.Minute),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("15.0").String(), res.Price.String())
+	suite.Equal(suite.start.Add(20*time.Minute), res.StartTime)
+
+	// a zero window is the price at the end time
+	twap, err = suite.keeper.GetTWAP(suite.ctx, "tstusd", 0)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("40.0").String(), twap.String())
+}
+
+func (suite *historyTestSuite) TestGetCandles() {
+	suite.setPriceAt(1, 0, "10.0")
+	suite.setPriceAt(2, 20*time.Minute, "30.0")
+	suite.setPriceAt(3, 40*time.Minute, "5.0")
+	suite.setPriceAt(4, 150*time.Minute, "8.0")
+
+	res, err := keeper.NewQueryServerImpl(suite.keeper).Candles(sdk.WrapSDKContext(suite.ctx), &types.QueryCandlesRequest{
+		MarketId: "tstusd",
+		Interval: time.Hour,
+	})
+	suite.Require().NoError(err)
+	// intervals without snapshots are skipped
+	suite.Require().Len(res.Candles, 2)
+	candle := res.Candles[0]
+	suite.Equal(suite.start, candle.StartTime)
+	suite.Equal(sdk.MustNewDecFromStr("10.0").String(), candle.Open.String())
+	suite.Equal(sdk.MustNewDecFromStr("30.0").String(), candle.High.String())
+	suite.Equal(sdk.MustNewDecFromStr("5.0").String(), candle.Low.String())
+	suite.Equal(sdk.MustNewDecFromStr("5.0").String(), candle.Close.String())
+	suite.Equal(suite.start.Add(2*time.Hour), res.Candles[1].StartTime)
+	suite.Equal(sdk.MustNewDecFromStr("8.0").String(), res.Candles[1].Open.String())
+
+	candles, err := suite.keeper.GetCandles(suite.ctx, "tstusd", time.Hour, suite.start.Add(10*time.Minute), suite.start.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Len(candles, 1)
+	suite.Equal(sdk.MustNewDecFromStr("30.0").String(), candles[0].Open.String())
+
+	_, err = keeper.NewQueryServerImpl(suite.keeper).Candles(sdk.WrapSDKContext(suite.ctx), &types.QueryCandlesRequest{
+		MarketId: "tstusd",
+	})
+	suite.Error(err)
+}
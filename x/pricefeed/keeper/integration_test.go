@@ -13,8 +13,8 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 	pfGenesis := types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				types.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{}, true),
+				types.NewMarket("xrp:usd", "xrp", "usd", []sdk.AccAddress{}, true),
 			},
 		},
 		PostedPrices: []types.PostedPrice{
@@ -24,11 +24,14 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	// The exchange rate source for markets derived from liquid staking derivatives
+	liquidKeeper types.LiquidKeeper
+	swapKeeper   types.SwapKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace, lk types.LiquidKeeper, sk types.SwapKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +41,8 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		liquidKeeper:  lk,
+		swapKeeper:    sk,
 	}
 }
 
@@ -76,61 +81,95 @@ func (k Keeper) SetPrice(
 
 	// Sets the raw price for a single oracle instead of an array of all oracle's raw prices
 	store.Set(types.RawPriceKey(marketID, oracle), k.cdc.MustMarshal(&newRawPrice))
+	k.recordOraclePost(ctx, marketID, oracle)
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset by aggregating all valid oracle inputs, following the market's
+// aggregation mode. Prices that move further from the last accepted price than the market's max deviation are not
+// written, and flag the market instead.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
 	// store current price
-	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-	if err != nil {
-		validPrevPrice = false
-	}
-
-	prices := k.GetRawPrices(ctx, marketID)
+	prevPrice, validPrevPrice := k.getCurrentPrice(ctx, marketID)
 
-	var notExpiredPrices []types.CurrentPrice
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
-		}
+	var price sdk.Dec
+	var err error
+	if market.IsDerived() {
+		price, err = k.calculateDerivedPrice(ctx, market)
+	} else {
+		price, err = k.calculateOraclePrice(ctx, market)
 	}
-
-	if len(notExpiredPrices) == 0 {
+	if err != nil {
 		// NOTE: The current price stored will continue storing the most recent (expired)
 		// price if this is not set.
 		// This zero's out the current price stored value for that market and ensures
 		// that CDP methods that GetCurrentPrice will return error.
 		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-		return types.ErrNoValidPrice
+		return err
 	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+	if k.checkPriceDeviation(ctx, market, price) {
+		return nil
+	}
 
 	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
+	if validPrevPrice && !price.Equal(prevPrice.Price) {
 		// only emit event if price has changed
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMarketPriceUpdated,
 				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
 			),
 		)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
+	currentPrice := types.NewCurrentPrice(marketID, price)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.SetReferencePrice(ctx, types.NewReferencePrice(marketID, price, ctx.BlockTime()))
+	// time weighted markets record their median price when it is aggregated
+	if !market.IsTimeWeighted() {
+		k.recordPriceSnapshot(ctx, market, price)
+	}
 
 	return nil
 }
 
+// calculateOraclePrice aggregates the unexpired prices posted by a market's oracles, leaving out excluded oracles.
+func (k Keeper) calculateOraclePrice(ctx sdk.Context, market types.Market) (sdk.Dec, error) {
+	prices := k.GetRawPrices(ctx, market.MarketID)
+
+	var unexpiredPrices []types.PostedPrice
+	// filter out expired prices
+	for _, v := range prices {
+		if v.Expiry.After(ctx.BlockTime()) {
+			unexpiredPrices = append(unexpiredPrices, v)
+		}
+	}
+
+	// filter out prices from oracles excluded for their reputation
+	excluded := k.updateOracleReputations(ctx, market, unexpiredPrices)
+	var notExpiredPrices []types.CurrentPrice
+	for _, v := range unexpiredPrices {
+		if !excluded[v.OracleAddress.String()] {
+			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+		}
+	}
+
+	if len(notExpiredPrices) < market.MinPosts() {
+		if len(notExpiredPrices) == 0 {
+			return sdk.Dec{}, types.ErrNoValidPrice
+		}
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoValidPrice, "%d unexpired prices, %d required", len(notExpiredPrices), market.MinPosts())
+	}
+
+	return k.aggregatePrice(ctx, market, notExpiredPrices), nil
+}
+
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
@@ -163,23 +202,34 @@ func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current aggregated price of all oracles for a specific market.
+// Markets without a price, or flagged for a price moving more than their max deviation, return ErrNoValidPrice.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	price, found := k.getCurrentPrice(ctx, marketID)
+	if !found {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	if flag, flagged := k.GetMarketFlag(ctx, marketID); flagged {
+		return types.CurrentPrice{}, errorsmod.Wrapf(
+			types.ErrNoValidPrice, "market %s is flagged, price %s deviates from %s", marketID, flag.Price, flag.ReferencePrice,
+		)
+	}
+	return price, nil
+}
+
+// getCurrentPrice fetches the stored current price of a market, returning false if it is not set or zero
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, bool) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
-
 	if bz == nil {
-		return types.CurrentPrice{}, types.ErrNoValidPrice
+		return types.CurrentPrice{}, false
 	}
 	var price types.CurrentPrice
-	err := k.cdc.Unmarshal(bz, &price)
-	if err != nil {
-		return types.CurrentPrice{}, err
+	k.cdc.MustUnmarshal(bz, &price)
+	if price.Price.IsNil() || price.Price.IsZero() {
+		return types.CurrentPrice{}, false
 	}
-	if price.Price.Equal(sdk.ZeroDec()) {
-		return types.CurrentPrice{}, types.ErrNoValidPrice
-	}
-	return price, nil
+	return price, true
 }
 
 // IterateCurrentPrices iterates over all current price objects in the store and performs a callback function
@@ -22,7 +22,7 @@ func TestKeeper_SetGetMarket(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -38,8 +38,8 @@ func TestKeeper_SetGetMarket(t *testing.T) {
 
 	mp = types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-			{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+			types.NewMarket("tst2usd", "tst2", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -61,7 +61,7 @@ func TestKeeper_GetSetPrice(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -114,7 +114,7 @@ func TestKeeper_GetSetCurrentPrice(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -204,7 +204,7 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
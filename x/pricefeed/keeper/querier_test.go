@@ -16,8 +16,8 @@ func (suite *KeeperTestSuite) TestQuerierGetParams() {
 
 	expParams := types.Params{
 		Markets: []types.Market{
-			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-			{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{}, true),
+			types.NewMarket("xrp:usd", "xrp", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	var p types.Params
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+// recordOraclePost counts a price posted by an oracle in its reputation
+func (k Keeper) recordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	reputation, found := k.GetOracleReputation(ctx, marketID, oracle)
+	if !found {
+		reputation = types.NewOracleReputation(marketID, oracle)
+	}
+	if reputation.PostCount == 0 {
+		reputation.FirstPostedAt = ctx.BlockTime()
+	}
+	reputation.PostCount++
+	reputation.LastPostedAt = ctx.BlockTime()
+	k.SetOracleReputation(ctx, reputation)
+}
+
+// updateOracleReputations records a window in the reputation of each of a market's oracles, given the market's
+// unexpired prices, and returns the oracles excluded from aggregation. Oracle prices are compared to the median of all
+// unexpired prices, so excluded oracles are reinstated once their prices are back in line.
+func (k Keeper) updateOracleReputations(ctx sdk.Context, market types.Market, prices []types.PostedPrice) map[string]bool {
+	oraclePrices := make(map[string]sdk.Dec, len(prices))
+	currentPrices := make([]types.CurrentPrice, 0, len(prices))
+	for _, pp := range prices {
+		oraclePrices[pp.OracleAddress.String()] = pp.Price
+		currentPrices = append(currentPrices, types.NewCurrentPrice(pp.MarketID, pp.Price))
+	}
+	median := sdk.ZeroDec()
+	if len(currentPrices) > 0 {
+		median = k.CalculateMedianPrice(currentPrices)
+	}
+
+	excluded := make(map[string]bool)
+	for _, oracle := range market.Oracles {
+		reputation, found := k.GetOracleReputation(ctx, market.MarketID, oracle)
+		if !found {
+			reputation = types.NewOracleReputation(market.MarketID, oracle)
+		}
+		reputation.Windows++
+
+		price, posted := oraclePrices[oracle.String()]
+		if posted {
+			reputation.ConsecutiveMissedWindows = 0
+			deviation := sdk.ZeroDec()
+			if median.IsPositive() {
+				deviation = price.Sub(median).Abs().Quo(median)
+			}
+			reputation.TotalDeviation = reputation.TotalDeviation.Add(deviation)
+			reputation.LastDeviation = deviation
+			if market.IsDeviantOracleDeviation(deviation) {
+				reputation.DeviantWindows++
+				reputation.ConsecutiveDeviantWindows++
+			} else {
+				reputation.ConsecutiveDeviantWindows = 0
+			}
+		} else {
+			reputation.MissedWindows++
+			reputation.ConsecutiveMissedWindows++
+		}
+
+		wasExcluded := reputation.Excluded
+		reputation.Excluded = market.ExcludesOracle(reputation)
+		if reputation.Excluded != wasExcluded {
+			eventType := types.EventTypeOracleReinstated
+			if reputation.Excluded {
+				eventType = types.EventTypeOracleExcluded
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					eventType,
+					sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+					sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+					sdk.NewAttribute(types.AttributeMissedWindows, strconv.FormatUint(uint64(reputation.ConsecutiveMissedWindows), 10)),
+					sdk.NewAttribute(types.AttributeDeviantWindows, strconv.FormatUint(uint64(reputation.ConsecutiveDeviantWindows), 10)),
+				),
+			)
+		}
+		if reputation.Excluded {
+			excluded[oracle.String()] = true
+		}
+		k.SetOracleReputation(ctx, reputation)
+	}
+	return excluded
+}
+
+// GetOracleReputation returns the reputation of an oracle in a market
+func (k Keeper) GetOracleReputation(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleReputation, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleReputationKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleReputation{}, false
+	}
+	var reputation types.OracleReputation
+	k.cdc.MustUnmarshal(bz, &reputation)
+	return reputation, true
+}
+
+// SetOracleReputation stores the reputation of an oracle in a market
+func (k Keeper) SetOracleReputation(ctx sdk.Context, reputation types.OracleReputation) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleReputationKey(reputation.MarketID, reputation.OracleAddress), k.cdc.MustMarshal(&reputation))
+}
+
+// DeleteOracleReputation removes the reputation of an oracle in a market
+func (k Keeper) DeleteOracleReputation(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.OracleReputationKey(marketID, oracle))
+}
+
+// IterateOracleReputationsByMarket iterates over the oracle reputations of a market and performs a callback function
+func (k Keeper) IterateOracleReputationsByMarket(ctx sdk.Context, marketID string, cb func(r types.OracleReputation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleReputationIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reputation types.OracleReputation
+		k.cdc.MustUnmarshal(iterator.Value(), &reputation)
+		if cb(reputation) {
+			break
+		}
+	}
+}
+
+// GetAllOracleReputations returns the oracle reputations of all markets
+func (k Keeper) GetAllOracleReputations(ctx sdk.Context) types.OracleReputations {
+	var reputations types.OracleReputations
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleReputationPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reputation types.OracleReputation
+		k.cdc.MustUnmarshal(iterator.Value(), &reputation)
+		reputations = append(reputations, reputation)
+	}
+	return reputations
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/percosis-labs/fury/app"
+	"github.com/percosis-labs/fury/x/pricefeed/keeper"
+	"github.com/percosis-labs/fury/x/pricefeed/types"
+)
+
+type reputationTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+	ctx    sdk.Context
+}
+
+func (suite *reputationTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	suite.ctx = tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.keeper = tApp.GetPriceFeedKeeper()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	suite.addrs = addrs
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.OracleDeviationThreshold = sdk.MustNewDecFromStr("0.5")
+	market.MaxMissedWindows = 2
+	market.MaxDeviantWindows = 2
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{market}))
+}
+
+func TestReputationTestSuite(t *testing.T) {
+	suite.Run(t, new(reputationTestSuite))
+}
+
+func (suite *reputationTestSuite) postPrice(i int, price string) {
+	_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[i], "tstusd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+}
+
+func (suite *reputationTestSuite) requireCurrentPrice(expected string) {
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr(expected).String(), price.Price.String())
+}
+
+func (suite *reputationTestSuite) reputation(i int) types.OracleReputation {
+	reputation, found := suite.keeper.GetOracleReputation(suite.ctx, "tstusd", suite.addrs[i])
+	suite.Require().True(found)
+	return reputation
+}
+
+func (suite *reputationTestSuite) TestDeviantOracle() {
+	suite.postPrice(0, "10.0")
+	suite.postPrice(1, "12.0")
+	suite.postPrice(2, "30.0")
+
+	// the first deviant window is tolerated
+	suite.requireCurrentPrice("12.0")
+	reputation := suite.reputation(2)
+	suite.Equal(uint64(1), reputation.PostCount)
+	suite.Equal(uint32(1), reputation.ConsecutiveDeviantWindows)
+	suite.Equal(sdk.MustNewDecFromStr("1.5").String(), reputation.LastDeviation.String())
+	suite.False(reputation.Excluded)
+
+	// the oracle is excluded on crossing the threshold, and its price left out of the median
+	suite.requireCurrentPrice("11.0")
+	reputation = suite.reputation(2)
+	suite.Equal(uint64(2), reputation.DeviantWindows)
+	suite.True(reputation.Excluded)
+	var excludedEvents int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeOracleExcluded {
+			excludedEvents++
+		}
+	}
+	suite.Equal(1, excludedEvents)
+
+	// an in line price reinstates the oracle
+	suite.postPrice(2, "11.0")
+	suite.requireCurrentPrice("11.0")
+	reputation = suite.reputation(2)
+	suite.Equal(uint64(2), reputation.PostCount)
+	suite.Equal(uint64(3), reputation.Windows)
+	suite.Equal(uint32(0), reputation.ConsecutiveDeviantWindows)
+	suite.False(reputation.Excluded)
+	suite.Equal(sdk.MustNewDecFromStr("1.0").String(), reputation.MeanDeviation().String())
+}
+
+func (suite *reputationTestSuite) TestMissedWindows() {
+	suite.postPrice(0, "10.0")
+	suite.postPrice(1, "12.0")
+	suite.postPrice(2, "14.0")
+	suite.requireCurrentPrice("12.0")
+
+	// oracle 1's price expires
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.postPrice(0, "10.0")
+	suite.postPrice(2, "14.0")
+	suite.requireCurrentPrice("12.0")
+	suite.Equal(uint32(1), suite.reputation(1).ConsecutiveMissedWindows)
+	suite.False(suite.reputation(1).Excluded)
+
+	suite.requireCurrentPrice("12.0")
+	reputation := suite.reputation(1)
+	suite.Equal(uint64(3), reputation.Windows)
+	suite.Equal(uint64(2), reputation.MissedWindows)
+	suite.True(reputation.Excluded)
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+	res, err := queryServer.OracleReputations(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleReputationsRequest{
+		MarketId:      "tstusd",
+		OracleAddress: suite.addrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.OracleReputations, 1)
+	suite.True(res.OracleReputations[0].Excluded)
+
+	res, err = queryServer.OracleReputations(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleReputationsRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.OracleReputations, 3)
+
+	// posting again reinstates the oracle
+	suite.postPrice(1, "12.0")
+	suite.requireCurrentPrice("12.0")
+	suite.False(suite.reputation(1).Excluded)
+}
+
+func (suite *reputationTestSuite) TestNoExclusionByDefault() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", suite.addrs, true),
+	}))
+	suite.postPrice(0, "10.0")
+	suite.postPrice(1, "12.0")
+	suite.postPrice(2, "30.0")
+
+	for i := 0; i < 5; i++ {
+		suite.requireCurrentPrice("12.0")
+	}
+	reputation := suite.reputation(2)
+	suite.Equal(uint64(5), reputation.Windows)
+	suite.Equal(uint64(0), reputation.DeviantWindows)
+	suite.False(reputation.Excluded)
+}
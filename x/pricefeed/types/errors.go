@@ -17,4 +17,6 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrNoPriceHistory error for price history queries outside of a market's price history
+	ErrNoPriceHistory = errorsmod.Register(ModuleName, 8, "price history not found")
 )
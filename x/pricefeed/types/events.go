@@ -5,10 +5,17 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketFlagged      = "market_flagged"
+	EventTypeMarketUnflagged    = "market_unflagged"
+	EventTypeOracleExcluded     = "oracle_excluded"
+	EventTypeOracleReinstated   = "oracle_reinstated"
 
-	AttributeValueCategory = ModuleName
-	AttributeMarketID      = "market_id"
-	AttributeMarketPrice   = "market_price"
-	AttributeOracle        = "oracle"
-	AttributeExpiry        = "expiry"
+	AttributeValueCategory  = ModuleName
+	AttributeMarketID       = "market_id"
+	AttributeMarketPrice    = "market_price"
+	AttributeOracle         = "oracle"
+	AttributeExpiry         = "expiry"
+	AttributeReferencePrice = "reference_price"
+	AttributeMissedWindows  = "consecutive_missed_windows"
+	AttributeDeviantWindows = "consecutive_deviant_windows"
 )
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LiquidKeeper defines the expected interface of the liquid keeper, used as the exchange rate source for derived markets
+type LiquidKeeper interface {
+	GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins) (sdk.Coin, error)
+}
+
+// SwapKeeper defines the expected interface of the swap keeper, used as the pool price source for derived markets
+type SwapKeeper interface {
+	GetTWAPBetween(ctx sdk.Context, poolID, base, quote string, start, end time.Time) (sdk.Dec, time.Time, error)
+	GetPriceHistoryWindow(ctx sdk.Context) time.Duration
+}
@@ -1,10 +1,17 @@
 package types
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(
+	p Params, pp []PostedPrice, flags []MarketFlag, snapshots []PriceSnapshot,
+	reputations []OracleReputation, references []ReferencePrice,
+) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:            p,
+		PostedPrices:      pp,
+		MarketFlags:       flags,
+		PriceSnapshots:    snapshots,
+		OracleReputations: reputations,
+		ReferencePrices:   references,
 	}
 }
 
@@ -13,6 +20,10 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]MarketFlag{},
+		[]PriceSnapshot{},
+		[]OracleReputation{},
+		[]ReferencePrice{},
 	)
 }
 
@@ -23,5 +34,17 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+	if err := gs.MarketFlags.Validate(); err != nil {
+		return err
+	}
+	if err := gs.PriceSnapshots.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OracleReputations.Validate(); err != nil {
+		return err
+	}
+	return gs.ReferencePrices.Validate()
 }
@@ -28,6 +28,11 @@ type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	MarketFlags  MarketFlags  `protobuf:"bytes,3,rep,name=market_flags,json=marketFlags,proto3,castrepeated=MarketFlags" json:"market_flags"`
+	// price_snapshots are the price histories of all markets, oldest first within each market
+	PriceSnapshots    PriceSnapshots    `protobuf:"bytes,5,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	OracleReputations OracleReputations `protobuf:"bytes,6,rep,name=oracle_reputations,json=oracleReputations,proto3,castrepeated=OracleReputations" json:"oracle_reputations"`
+	ReferencePrices   ReferencePrices   `protobuf:"bytes,7,rep,name=reference_prices,json=referencePrices,proto3,castrepeated=ReferencePrices" json:"reference_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +82,34 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetMarketFlags() MarketFlags {
+	if m != nil {
+		return m.MarketFlags
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleReputations() OracleReputations {
+	if m != nil {
+		return m.OracleReputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferencePrices() ReferencePrices {
+	if m != nil {
+		return m.ReferencePrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.pricefeed.v1beta1.GenesisState")
 }
@@ -86,24 +119,35 @@ func init() {
 }
 
 var fileDescriptor_e7375cb47ce82640 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2b, 0x2d, 0xaa,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x52, 0xc2, 0xa1, 0xbb, 0xb8,
-	0x24, 0xbf, 0x28, 0x15, 0xa2, 0x57, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1,
-	0x20, 0xa2, 0x4a, 0x6b, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0x76, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a,
-	0xd9, 0x70, 0xb1, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b,
-	0xc9, 0xe9, 0x61, 0xb7, 0x53, 0x2f, 0x00, 0xac, 0xca, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20,
-	0xa8, 0x1e, 0xa1, 0x38, 0x2e, 0xde, 0x82, 0xfc, 0xe2, 0x92, 0xd4, 0x94, 0x78, 0xb0, 0x86, 0x62,
-	0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x65, 0x9c, 0x86, 0x80, 0x15, 0x07, 0x80, 0xc4, 0x9d,
-	0x44, 0x40, 0x26, 0xad, 0xba, 0x2f, 0xcf, 0x83, 0x24, 0x58, 0x1c, 0xc4, 0x53, 0x80, 0xc4, 0x73,
-	0x0a, 0x7c, 0xf0, 0x50, 0x8e, 0x71, 0xc5, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x2f, 0x48, 0x2d, 0x4a, 0xce, 0x2f, 0xce, 0x2c, 0xd6, 0xcd, 0x49, 0x4c, 0x2a, 0xd6, 0x07, 0x87,
-	0x51, 0x05, 0x52, 0x28, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc2, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0x78, 0x5c, 0x7b, 0x98, 0x82, 0x01, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0x9a, 0x06, 0x34, 0x09, 0x4d, 0x3b, 0x54, 0xc5, 0x74, 0x31, 0xad, 0xc2, 0x8f,
+	0xb2, 0xc1, 0x56, 0xcb, 0x96, 0x95, 0x17, 0x20, 0x21, 0x21, 0xca, 0x74, 0xd7, 0x05, 0xd1, 0xd8,
+	0xbd, 0x76, 0x0d, 0xb6, 0x67, 0x34, 0x77, 0x52, 0xd1, 0xb7, 0xe0, 0x31, 0x10, 0x4f, 0xd2, 0x65,
+	0x97, 0xac, 0xa0, 0x38, 0x3b, 0x9e, 0x02, 0x79, 0xec, 0xb6, 0x71, 0x84, 0x77, 0x73, 0x8f, 0xcf,
+	0x39, 0xdf, 0xc8, 0x73, 0xc9, 0xb3, 0x78, 0xae, 0x2f, 0x7c, 0xa5, 0xd3, 0x08, 0x62, 0x80, 0x53,
+	0xff, 0xfc, 0x20, 0x04, 0x23, 0x0e, 0xfc, 0x04, 0x0a, 0xc0, 0x14, 0x3d, 0xa5, 0xa5, 0x91, 0x74,
+	0xa7, 0x72, 0x79, 0xb7, 0x2e, 0xaf, 0x71, 0xed, 0x4e, 0x3a, 0xd2, 0x68, 0xa4, 0x86, 0x3a, 0xbb,
+	0xbb, 0x9d, 0xc8, 0x44, 0xda, 0xa3, 0x5f, 0x9d, 0x6a, 0x75, 0xf2, 0xb7, 0x4f, 0x46, 0x6f, 0x6b,
+	0xc6, 0xb1, 0x11, 0x06, 0xe8, 0x6b, 0x32, 0x50, 0x42, 0x8b, 0x1c, 0x5d, 0x67, 0xdf, 0x99, 0x0e,
+	0x0f, 0x99, 0xf7, 0x7f, 0xa6, 0x77, 0x64, 0x5d, 0x41, 0xff, 0xf2, 0xd7, 0x5e, 0x8f, 0x37, 0x19,
+	0xfa, 0x89, 0x3c, 0x54, 0x12, 0x0d, 0x9c, 0xce, 0x6c, 0x00, 0xdd, 0x7b, 0xfb, 0x6b, 0xd3, 0xe1,
+	0xe1, 0xd3, 0xce, 0x12, 0x6b, 0x3e, 0xaa, 0xf4, 0x60, 0xbb, 0x6a, 0xfa, 0xf1, 0x7b, 0x6f, 0xb4,
+	0x24, 0x22, 0x1f, 0xa9, 0xa5, 0x89, 0x9e, 0x90, 0x51, 0x2e, 0xf4, 0x17, 0x30, 0xb3, 0x38, 0x13,
+	0x09, 0xba, 0x6b, 0xb6, 0x7e, 0xd2, 0x55, 0xff, 0xde, 0x7a, 0xdf, 0x64, 0x22, 0x09, 0x1e, 0x35,
+	0xed, 0xc3, 0x3b, 0x0d, 0xf9, 0x30, 0xbf, 0x1b, 0x68, 0x4c, 0xc6, 0xb6, 0x61, 0x86, 0x85, 0x50,
+	0x78, 0x26, 0x0d, 0xba, 0xeb, 0xb6, 0xfe, 0x79, 0xe7, 0xed, 0x2b, 0xe5, 0xb8, 0x71, 0x07, 0x3b,
+	0x0d, 0x61, 0xa3, 0x25, 0x23, 0xdf, 0x50, 0xad, 0x99, 0x6a, 0x42, 0xa5, 0x16, 0x51, 0x06, 0x33,
+	0x0d, 0x6a, 0x6e, 0x84, 0x49, 0x65, 0x81, 0xee, 0xc0, 0xa2, 0xa6, 0x5d, 0xa8, 0x0f, 0x36, 0xc1,
+	0x6f, 0x03, 0xc1, 0x93, 0x86, 0xb6, 0xb5, 0xfa, 0x05, 0xf9, 0x96, 0x5c, 0x95, 0xe8, 0x67, 0xb2,
+	0xa9, 0x21, 0x06, 0x0d, 0x45, 0x04, 0x37, 0x4f, 0x73, 0xdf, 0x12, 0x5f, 0x74, 0x11, 0xf9, 0x8d,
+	0xbf, 0x7e, 0x9d, 0xc7, 0x0d, 0x6f, 0xdc, 0xd6, 0x91, 0x8f, 0x75, 0x5b, 0x78, 0xd7, 0x7f, 0xd0,
+	0xdf, 0x5c, 0xe7, 0xb4, 0xfe, 0x97, 0x32, 0x44, 0xd0, 0xe7, 0xf5, 0x2d, 0x82, 0x8f, 0xd7, 0x7f,
+	0x98, 0xf3, 0xbd, 0x64, 0xce, 0x65, 0xc9, 0x9c, 0xab, 0x92, 0x39, 0xd7, 0x25, 0x73, 0xbe, 0x2d,
+	0x58, 0xef, 0x6a, 0xc1, 0x7a, 0x3f, 0x17, 0xac, 0x77, 0xe2, 0x27, 0xa9, 0x39, 0x9b, 0x87, 0x5e,
+	0x24, 0x73, 0x5f, 0x81, 0x8e, 0x24, 0xa6, 0xf8, 0x32, 0x13, 0x21, 0xfa, 0x76, 0xc3, 0xbf, 0x2e,
+	0xed, 0xb8, 0xb9, 0x50, 0x80, 0xe1, 0xc0, 0xae, 0xf1, 0xab, 0x7f, 0x03, 0x00, 0x83, 0xeb, 0x4f,
+	0x9e, 0x40, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +186,38 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.MarketFlags) != len(that1.MarketFlags) {
+		return fmt.Errorf("MarketFlags this(%v) Not Equal that(%v)", len(this.MarketFlags), len(that1.MarketFlags))
+	}
+	for i := range this.MarketFlags {
+		if !this.MarketFlags[i].Equal(&that1.MarketFlags[i]) {
+			return fmt.Errorf("MarketFlags this[%v](%v) Not Equal that[%v](%v)", i, this.MarketFlags[i], i, that1.MarketFlags[i])
+		}
+	}
+	if len(this.PriceSnapshots) != len(that1.PriceSnapshots) {
+		return fmt.Errorf("PriceSnapshots this(%v) Not Equal that(%v)", len(this.PriceSnapshots), len(that1.PriceSnapshots))
+	}
+	for i := range this.PriceSnapshots {
+		if !this.PriceSnapshots[i].Equal(&that1.PriceSnapshots[i]) {
+			return fmt.Errorf("PriceSnapshots this[%v](%v) Not Equal that[%v](%v)", i, this.PriceSnapshots[i], i, that1.PriceSnapshots[i])
+		}
+	}
+	if len(this.OracleReputations) != len(that1.OracleReputations) {
+		return fmt.Errorf("OracleReputations this(%v) Not Equal that(%v)", len(this.OracleReputations), len(that1.OracleReputations))
+	}
+	for i := range this.OracleReputations {
+		if !this.OracleReputations[i].Equal(&that1.OracleReputations[i]) {
+			return fmt.Errorf("OracleReputations this[%v](%v) Not Equal that[%v](%v)", i, this.OracleReputations[i], i, that1.OracleReputations[i])
+		}
+	}
+	if len(this.ReferencePrices) != len(that1.ReferencePrices) {
+		return fmt.Errorf("ReferencePrices this(%v) Not Equal that(%v)", len(this.ReferencePrices), len(that1.ReferencePrices))
+	}
+	for i := range this.ReferencePrices {
+		if !this.ReferencePrices[i].Equal(&that1.ReferencePrices[i]) {
+			return fmt.Errorf("ReferencePrices this[%v](%v) Not Equal that[%v](%v)", i, this.ReferencePrices[i], i, that1.ReferencePrices[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +250,38 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.MarketFlags) != len(that1.MarketFlags) {
+		return false
+	}
+	for i := range this.MarketFlags {
+		if !this.MarketFlags[i].Equal(&that1.MarketFlags[i]) {
+			return false
+		}
+	}
+	if len(this.PriceSnapshots) != len(that1.PriceSnapshots) {
+		return false
+	}
+	for i := range this.PriceSnapshots {
+		if !this.PriceSnapshots[i].Equal(&that1.PriceSnapshots[i]) {
+			return false
+		}
+	}
+	if len(this.OracleReputations) != len(that1.OracleReputations) {
+		return false
+	}
+	for i := range this.OracleReputations {
+		if !this.OracleReputations[i].Equal(&that1.OracleReputations[i]) {
+			return false
+		}
+	}
+	if len(this.ReferencePrices) != len(that1.ReferencePrices) {
+		return false
+	}
+	for i := range this.ReferencePrices {
+		if !this.ReferencePrices[i].Equal(&that1.ReferencePrices[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +304,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReferencePrices) > 0 {
+		for iNdEx := len(m.ReferencePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferencePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OracleReputations) > 0 {
+		for iNdEx := len(m.OracleReputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleReputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MarketFlags) > 0 {
+		for iNdEx := len(m.MarketFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +412,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MarketFlags) > 0 {
+		for _, e := range m.MarketFlags {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleReputations) > 0 {
+		for _, e := range m.OracleReputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferencePrices) > 0 {
+		for _, e := range m.ReferencePrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +541,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketFlags = append(m.MarketFlags, MarketFlag{})
+			if err := m.MarketFlags[len(m.MarketFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleReputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleReputations = append(m.OracleReputations, OracleReputation{})
+			if err := m.OracleReputations[len(m.OracleReputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferencePrices = append(m.ReferencePrices, ReferencePrice{})
+			if err := m.ReferencePrices[len(m.ReferencePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
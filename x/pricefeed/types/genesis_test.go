@@ -32,9 +32,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			expPass: true,
 		},
@@ -42,9 +46,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -52,10 +60,14 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -64,6 +76,10 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams([]Market{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -75,6 +91,146 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated market flag",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketFlag{
+					NewMarketFlag("xrp", sdk.NewDec(2), sdk.OneDec(), now),
+					NewMarketFlag("xrp", sdk.NewDec(3), sdk.OneDec(), now),
+				},
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid market flag",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]MarketFlag{NewMarketFlag("xrp", sdk.NewDec(2), sdk.ZeroDec(), now)},
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price snapshots",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				[]PriceSnapshot{
+					NewPriceSnapshot("xrp", 1, now, sdk.OneDec(), sdk.ZeroDec()),
+					NewPriceSnapshot("btc", 1, now, sdk.OneDec(), sdk.ZeroDec()),
+					NewPriceSnapshot("xrp", 2, now.Add(time.Second), sdk.OneDec(), sdk.OneDec()),
+				},
+				nil,
+				nil,
+			),
+			expPass: true,
+		},
+		{
+			msg: "price snapshots out of order",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				[]PriceSnapshot{
+					NewPriceSnapshot("xrp", 2, now.Add(time.Second), sdk.OneDec(), sdk.OneDec()),
+					NewPriceSnapshot("xrp", 1, now, sdk.OneDec(), sdk.ZeroDec()),
+				},
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle reputations",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				nil,
+				[]OracleReputation{
+					NewOracleReputation("xrp", addr),
+					NewOracleReputation("btc", addr),
+				},
+				nil,
+			),
+			expPass: true,
+		},
+		{
+			msg: "dup oracle reputations",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				nil,
+				[]OracleReputation{
+					NewOracleReputation("xrp", addr),
+					NewOracleReputation("xrp", addr),
+				},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "oracle reputation with more missed windows than windows",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				nil,
+				[]OracleReputation{
+					{
+						MarketID:       "xrp",
+						OracleAddress:  addr,
+						MissedWindows:  2,
+						Windows:        1,
+						TotalDeviation: sdk.ZeroDec(),
+						LastDeviation:  sdk.ZeroDec(),
+					},
+				},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated reference price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				nil,
+				nil,
+				[]ReferencePrice{
+					NewReferencePrice("xrp", sdk.OneDec(), now),
+					NewReferencePrice("xrp", sdk.NewDec(2), now),
+				},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid reference price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				nil,
+				nil,
+				[]ReferencePrice{NewReferencePrice("xrp", sdk.ZeroDec(), now)},
 			),
 			expPass: false,
 		},
@@ -1,6 +1,8 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName The name that will be used throughout the module
@@ -25,6 +27,21 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// MarketFlagPrefix prefix for the flags of markets whose price moved more than their max deviation
+	MarketFlagPrefix = []byte{0x02}
+
+	// PriceSnapshotPrefix prefix for the snapshots in the price history of a market
+	PriceSnapshotPrefix = []byte{0x04}
+
+	// PriceHistoryPrefix prefix for the bounds of the price history of a market
+	PriceHistoryPrefix = []byte{0x05}
+
+	// OracleReputationPrefix prefix for the posting records of oracles
+	OracleReputationPrefix = []byte{0x06}
+
+	// ReferencePricePrefix prefix for the last accepted price of markets
+	ReferencePricePrefix = []byte{0x07}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -48,6 +65,53 @@ func RawPriceKey(marketID string, oracleAddr sdk.AccAddress) []byte {
 	)
 }
 
+// MarketFlagKey returns the key for the flag of a market
+func MarketFlagKey(marketID string) []byte {
+	return append(MarketFlagPrefix, []byte(marketID)...)
+}
+
+// PriceSnapshotIteratorKey returns the prefix for the price snapshots of a single market
+func PriceSnapshotIteratorKey(marketID string) []byte {
+	return append(
+		PriceSnapshotPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceSnapshotKey returns the key for a price snapshot, ordered by sequence within the market
+func PriceSnapshotKey(marketID string, sequence uint64) []byte {
+	return append(
+		PriceSnapshotIteratorKey(marketID),
+		sdk.Uint64ToBigEndian(sequence)...,
+	)
+}
+
+// PriceHistoryKey returns the key for the bounds of the price history of a market
+func PriceHistoryKey(marketID string) []byte {
+	return append(PriceHistoryPrefix, []byte(marketID)...)
+}
+
+// OracleReputationIteratorKey returns the prefix for the oracle reputations of a single market
+func OracleReputationIteratorKey(marketID string) []byte {
+	return append(
+		OracleReputationPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleReputationKey returns the key for the reputation of an oracle in a market
+func OracleReputationKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleReputationIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
+// ReferencePriceKey returns the key for the reference price of a market
+func ReferencePriceKey(marketID string) []byte {
+	return append(ReferencePricePrefix, []byte(marketID)...)
+}
+
 // lengthPrefixWithByte returns the input bytes prefixes with one byte containing its length.
 // It panics if the input is greater than 255 in length.
 func lengthPrefixWithByte(bz []byte) []byte {
@@ -9,6 +9,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultFlagRecoveryRounds is the number of blocks of consistent rejected prices after which a flagged market recovers,
+// for markets that do not set it
+const DefaultFlagRecoveryRounds uint32 = 100
+
 // NewMarket returns a new Market
 func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Market {
 	return Market{
@@ -17,6 +21,13 @@ func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Ma
 		QuoteAsset: quote,
 		Oracles:    oracles,
 		Active:     active,
+		// aggregate the median of any unexpired prices, without a deviation check
+		AggregationMode:   AGGREGATION_MODE_UNSPECIFIED,
+		TrimFraction:      sdk.ZeroDec(),
+		MinOraclePosts:    0,
+		MaxPriceDeviation: sdk.ZeroDec(),
+		// track oracle reputations without excluding any oracles
+		OracleDeviationThreshold: sdk.ZeroDec(),
 	}
 }
 
@@ -41,12 +52,110 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if _, ok := AggregationMode_name[int32(m.AggregationMode)]; !ok {
+		return fmt.Errorf("invalid aggregation mode %d", m.AggregationMode)
+	}
+	if !m.TrimFraction.IsNil() && (m.TrimFraction.IsNegative() || m.TrimFraction.GTE(sdk.NewDecWithPrec(5, 1))) {
+		return fmt.Errorf("trim fraction must be at least 0 and less than 0.5, got %s", m.TrimFraction)
+	}
+	if m.TwapWindow < 0 {
+		return fmt.Errorf("twap window cannot be negative %s", m.TwapWindow)
+	}
+	if m.AggregationMode == AGGREGATION_MODE_TIME_WEIGHTED && m.TwapWindow == 0 {
+		return errors.New("twap window must be positive for time weighted aggregation")
+	}
+	if m.Derivation.Type == DERIVATION_TYPE_SWAP_TWAP && m.TwapWindow == 0 {
+		return fmt.Errorf("twap window must be positive for %s", DERIVATION_TYPE_SWAP_TWAP)
+	}
+	if !m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("max price deviation cannot be negative %s", m.MaxPriceDeviation)
+	}
+	if !m.OracleDeviationThreshold.IsNil() && m.OracleDeviationThreshold.IsNegative() {
+		return fmt.Errorf("oracle deviation threshold cannot be negative %s", m.OracleDeviationThreshold)
+	}
+	if m.MaxDeviantWindows > 0 && !m.oracleDeviationEnabled() {
+		return errors.New("oracle deviation threshold must be positive to exclude oracles for deviant prices")
+	}
+	if err := m.Derivation.Validate(); err != nil {
+		return fmt.Errorf("invalid derivation for market %s: %w", m.MarketID, err)
+	}
+	if m.IsDerived() && len(m.Oracles) > 0 {
+		return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+	}
+	for _, id := range m.Derivation.SourceMarketIDs {
+		if id == m.MarketID {
+			return fmt.Errorf("market %s cannot be derived from itself", m.MarketID)
+		}
+	}
 	return nil
 }
 
+// IsDerived returns true if the market's price is derived from other markets rather than posted by oracles.
+func (m Market) IsDerived() bool {
+	return m.Derivation.Type != DERIVATION_TYPE_UNSPECIFIED
+}
+
+// IsTimeWeighted returns true if the market's current price is the time-weighted average of its median oracle price.
+func (m Market) IsTimeWeighted() bool {
+	return m.AggregationMode == AGGREGATION_MODE_TIME_WEIGHTED && !m.IsDerived()
+}
+
+// MinPosts returns the number of unexpired oracle prices required to set the market's current price.
+func (m Market) MinPosts() int {
+	if m.MinOraclePosts == 0 {
+		return 1
+	}
+	return int(m.MinOraclePosts)
+}
+
+// DeviationCheckEnabled returns true if prices moving more than the max price deviation flag the market.
+func (m Market) DeviationCheckEnabled() bool {
+	return !m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsPositive()
+}
+
+// RecoveryRounds returns the number of consecutive rejected prices within the max price deviation of each other after
+// which a flagged market accepts the latest price.
+func (m Market) RecoveryRounds() uint32 {
+	if m.FlagRecoveryRounds == 0 {
+		return DefaultFlagRecoveryRounds
+	}
+	return m.FlagRecoveryRounds
+}
+
+// oracleDeviationEnabled returns true if oracle prices far enough from the median price count as deviant.
+func (m Market) oracleDeviationEnabled() bool {
+	return !m.OracleDeviationThreshold.IsNil() && m.OracleDeviationThreshold.IsPositive()
+}
+
+// IsDeviantOracleDeviation returns true if an oracle's fractional difference from the median price counts as deviant.
+func (m Market) IsDeviantOracleDeviation(deviation sdk.Dec) bool {
+	return m.oracleDeviationEnabled() && deviation.GT(m.OracleDeviationThreshold)
+}
+
+// ExcludesOracle returns true if an oracle's reputation crosses the market's thresholds for missed or deviant windows,
+// so its prices are left out of aggregation.
+func (m Market) ExcludesOracle(r OracleReputation) bool {
+	if m.MaxMissedWindows > 0 && r.ConsecutiveMissedWindows >= m.MaxMissedWindows {
+		return true
+	}
+	return m.MaxDeviantWindows > 0 && r.ConsecutiveDeviantWindows >= m.MaxDeviantWindows
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	mr := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	mr.AggregationMode = m.AggregationMode
+	mr.TrimFraction = m.TrimFraction
+	mr.TwapWindow = m.TwapWindow
+	mr.MinOraclePosts = m.MinOraclePosts
+	mr.MaxPriceDeviation = m.MaxPriceDeviation
+	mr.PriceHistoryLength = m.PriceHistoryLength
+	mr.OracleDeviationThreshold = m.OracleDeviationThreshold
+	mr.MaxMissedWindows = m.MaxMissedWindows
+	mr.MaxDeviantWindows = m.MaxDeviantWindows
+	mr.Derivation = m.Derivation
+	mr.FlagRecoveryRounds = m.FlagRecoveryRounds
+	return mr
 }
 
 // Markets is a slice of Market
@@ -65,6 +174,137 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
+	return ms.validateDerivations()
+}
+
+// validateDerivations checks that derived markets are derived from existing markets, without cycles.
+func (ms Markets) validateDerivations() error {
+	byID := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		byID[m.MarketID] = m
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(ms))
+	var visit func(m Market) error
+	visit = func(m Market) error {
+		switch state[m.MarketID] {
+		case visiting:
+			return fmt.Errorf("market %s is derived from itself", m.MarketID)
+		case visited:
+			return nil
+		}
+		state[m.MarketID] = visiting
+		for _, id := range m.Derivation.SourceMarketIDs {
+			source, found := byID[id]
+			if !found {
+				return fmt.Errorf("market %s is derived from unknown market %s", m.MarketID, id)
+			}
+			if err := visit(source); err != nil {
+				return err
+			}
+		}
+		state[m.MarketID] = visited
+		return nil
+	}
+	for _, m := range ms {
+		if err := visit(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SortedByDerivation returns the markets ordered so that each derived market comes after the markets it is derived
+// from, otherwise keeping their order. The markets must be valid.
+func (ms Markets) SortedByDerivation() Markets {
+	byID := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		byID[m.MarketID] = m
+	}
+
+	sorted := make(Markets, 0, len(ms))
+	seen := make(map[string]bool, len(ms))
+	var visit func(m Market)
+	visit = func(m Market) {
+		if seen[m.MarketID] {
+			return
+		}
+		seen[m.MarketID] = true
+		for _, id := range m.Derivation.SourceMarketIDs {
+			if source, found := byID[id]; found {
+				visit(source)
+			}
+		}
+		sorted = append(sorted, m)
+	}
+	for _, m := range ms {
+		visit(m)
+	}
+	return sorted
+}
+
+// Validate performs a basic check of a MarketDerivation, without checking its source markets exist.
+func (md MarketDerivation) Validate() error {
+	if _, ok := DerivationType_name[int32(md.Type)]; !ok {
+		return fmt.Errorf("invalid derivation type %d", md.Type)
+	}
+	for _, id := range md.SourceMarketIDs {
+		if strings.TrimSpace(id) == "" {
+			return errors.New("source market id cannot be blank")
+		}
+	}
+	if md.Type != DERIVATION_TYPE_EXCHANGE_RATE && md.DerivativeDenom != "" {
+		return fmt.Errorf("derivative denom is only used by %s", DERIVATION_TYPE_EXCHANGE_RATE)
+	}
+	if md.Type != DERIVATION_TYPE_SWAP_TWAP && (md.SwapPoolID != "" || md.SwapBaseDenom != "" || md.SwapQuoteDenom != "" ||
+		md.SwapBaseDecimals != 0 || md.SwapQuoteDecimals != 0) {
+		return fmt.Errorf("swap pool is only used by %s", DERIVATION_TYPE_SWAP_TWAP)
+	}
+
+	switch md.Type {
+	case DERIVATION_TYPE_UNSPECIFIED:
+		if len(md.SourceMarketIDs) > 0 {
+			return errors.New("oracle markets cannot have source markets")
+		}
+	case DERIVATION_TYPE_INVERSE:
+		if len(md.SourceMarketIDs) != 1 {
+			return fmt.Errorf("inverse derivation needs 1 source market, got %d", len(md.SourceMarketIDs))
+		}
+	case DERIVATION_TYPE_PRODUCT:
+		if len(md.SourceMarketIDs) < 2 {
+			return fmt.Errorf("product derivation needs at least 2 source markets, got %d", len(md.SourceMarketIDs))
+		}
+	case DERIVATION_TYPE_EXCHANGE_RATE:
+		if len(md.SourceMarketIDs) != 1 {
+			return fmt.Errorf("exchange rate derivation needs 1 source market, got %d", len(md.SourceMarketIDs))
+		}
+		if err := sdk.ValidateDenom(md.DerivativeDenom); err != nil {
+			return fmt.Errorf("invalid derivative denom: %w", err)
+		}
+	case DERIVATION_TYPE_SWAP_TWAP:
+		if len(md.SourceMarketIDs) > 1 {
+			return fmt.Errorf("swap twap derivation needs at most 1 source market, got %d", len(md.SourceMarketIDs))
+		}
+		if strings.TrimSpace(md.SwapPoolID) == "" {
+			return errors.New("swap pool id cannot be blank")
+		}
+		if err := sdk.ValidateDenom(md.SwapBaseDenom); err != nil {
+			return fmt.Errorf("invalid swap base denom: %w", err)
+		}
+		if err := sdk.ValidateDenom(md.SwapQuoteDenom); err != nil {
+			return fmt.Errorf("invalid swap quote denom: %w", err)
+		}
+		if md.SwapBaseDenom == md.SwapQuoteDenom {
+			return errors.New("swap base and quote denoms must be different")
+		}
+		if md.SwapBaseDecimals > sdk.Precision || md.SwapQuoteDecimals > sdk.Precision {
+			return fmt.Errorf("swap base and quote decimals must be at most %d", sdk.Precision)
+		}
+	}
 	return nil
 }
 
@@ -164,6 +404,248 @@ func NewPostedPriceResponse(marketID string, oracle sdk.AccAddress, price sdk.De
 // PostedPriceResponses is a slice of PostedPriceResponse
 type PostedPriceResponses []PostedPriceResponse
 
+// NewMarketFlag returns a new MarketFlag
+func NewMarketFlag(marketID string, price, referencePrice sdk.Dec, flaggedAt time.Time) MarketFlag {
+	return MarketFlag{
+		MarketID:       marketID,
+		Price:          price,
+		ReferencePrice: referencePrice,
+		FlaggedAt:      flaggedAt,
+	}
+}
+
+// Validate performs a basic check of a MarketFlag.
+func (mf MarketFlag) Validate() error {
+	if strings.TrimSpace(mf.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if mf.Price.IsNil() || mf.Price.IsNegative() {
+		return fmt.Errorf("flagged price cannot be negative %s", mf.Price)
+	}
+	if mf.ReferencePrice.IsNil() || !mf.ReferencePrice.IsPositive() {
+		return fmt.Errorf("reference price must be positive %s", mf.ReferencePrice)
+	}
+	return nil
+}
+
+// MarketFlags is a slice of MarketFlag
+type MarketFlags []MarketFlag
+
+// Validate checks if all the market flags are valid and there are no duplicated entries.
+func (mfs MarketFlags) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, mf := range mfs {
+		if seenMarkets[mf.MarketID] {
+			return fmt.Errorf("duplicated market flag %s", mf.MarketID)
+		}
+		if err := mf.Validate(); err != nil {
+			return err
+		}
+		seenMarkets[mf.MarketID] = true
+	}
+	return nil
+}
+
+// NewReferencePrice returns a new ReferencePrice
+func NewReferencePrice(marketID string, price sdk.Dec, acceptedAt time.Time) ReferencePrice {
+	return ReferencePrice{
+		MarketID:   marketID,
+		Price:      price,
+		AcceptedAt: acceptedAt,
+	}
+}
+
+// Validate performs a basic check of a ReferencePrice.
+func (rp ReferencePrice) Validate() error {
+	if strings.TrimSpace(rp.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if rp.Price.IsNil() || !rp.Price.IsPositive() {
+		return fmt.Errorf("reference price must be positive %s", rp.Price)
+	}
+	return nil
+}
+
+// ReferencePrices is a slice of ReferencePrice
+type ReferencePrices []ReferencePrice
+
+// Validate checks if all the reference prices are valid and there are no duplicated entries.
+func (rps ReferencePrices) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, rp := range rps {
+		if seenMarkets[rp.MarketID] {
+			return fmt.Errorf("duplicated reference price %s", rp.MarketID)
+		}
+		if err := rp.Validate(); err != nil {
+			return err
+		}
+		seenMarkets[rp.MarketID] = true
+	}
+	return nil
+}
+
+// NewPriceSnapshot returns a new PriceSnapshot
+func NewPriceSnapshot(marketID string, height int64, t time.Time, price, cumulativePrice sdk.Dec) PriceSnapshot {
+	return PriceSnapshot{
+		MarketID:        marketID,
+		Height:          height,
+		Time:            t,
+		Price:           price,
+		CumulativePrice: cumulativePrice,
+	}
+}
+
+// Validate performs a basic check of a PriceSnapshot.
+func (ps PriceSnapshot) Validate() error {
+	if strings.TrimSpace(ps.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if ps.Height < 0 {
+		return fmt.Errorf("snapshot height cannot be negative %d", ps.Height)
+	}
+	if ps.Price.IsNil() || ps.Price.IsNegative() {
+		return fmt.Errorf("snapshot price cannot be negative %s", ps.Price)
+	}
+	if ps.CumulativePrice.IsNil() || ps.CumulativePrice.IsNegative() {
+		return fmt.Errorf("cumulative price cannot be negative %s", ps.CumulativePrice)
+	}
+	return nil
+}
+
+// CumulativePriceAt returns the cumulative price at a time at or after the snapshot, if the snapshot's price held until then
+func (ps PriceSnapshot) CumulativePriceAt(t time.Time) sdk.Dec {
+	return ps.CumulativePrice.Add(ps.Price.Mul(DurationSeconds(t.Sub(ps.Time))))
+}
+
+// DurationSeconds returns a duration in seconds, with nanosecond precision
+func DurationSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDec(d.Nanoseconds()).QuoInt64(int64(time.Second))
+}
+
+// PriceSnapshots is a slice of PriceSnapshot
+type PriceSnapshots []PriceSnapshot
+
+// Validate checks if all the price snapshots are valid, and in increasing height and time order within each market.
+func (pss PriceSnapshots) Validate() error {
+	latest := make(map[string]PriceSnapshot)
+	for _, ps := range pss {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+		if prev, found := latest[ps.MarketID]; found {
+			if ps.Height <= prev.Height || ps.Time.Before(prev.Time) {
+				return fmt.Errorf("price snapshot for market id %s at height %d is out of order", ps.MarketID, ps.Height)
+			}
+			if ps.CumulativePrice.LT(prev.CumulativePrice) {
+				return fmt.Errorf("cumulative price for market id %s decreases at height %d", ps.MarketID, ps.Height)
+			}
+		}
+		latest[ps.MarketID] = ps
+	}
+	return nil
+}
+
+// NewOracleReputation returns a new OracleReputation for an oracle that has not posted or missed any windows
+func NewOracleReputation(marketID string, oracle sdk.AccAddress) OracleReputation {
+	return OracleReputation{
+		MarketID:       marketID,
+		OracleAddress:  oracle,
+		TotalDeviation: sdk.ZeroDec(),
+		LastDeviation:  sdk.ZeroDec(),
+	}
+}
+
+// Validate performs a basic check of an OracleReputation.
+func (r OracleReputation) Validate() error {
+	if strings.TrimSpace(r.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(r.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if r.MissedWindows > r.Windows || r.DeviantWindows > r.Windows {
+		return fmt.Errorf("oracle %s has more missed or deviant windows than windows in market id %s", r.OracleAddress, r.MarketID)
+	}
+	if uint64(r.ConsecutiveMissedWindows) > r.MissedWindows || uint64(r.ConsecutiveDeviantWindows) > r.DeviantWindows {
+		return fmt.Errorf("oracle %s has more consecutive than total missed or deviant windows in market id %s", r.OracleAddress, r.MarketID)
+	}
+	if r.TotalDeviation.IsNil() || r.TotalDeviation.IsNegative() {
+		return fmt.Errorf("total deviation cannot be negative %s", r.TotalDeviation)
+	}
+	if r.LastDeviation.IsNil() || r.LastDeviation.IsNegative() {
+		return fmt.Errorf("last deviation cannot be negative %s", r.LastDeviation)
+	}
+	return nil
+}
+
+// MeanDeviation returns the mean fractional difference between the oracle's price and the median price, over the
+// windows the oracle did not miss.
+func (r OracleReputation) MeanDeviation() sdk.Dec {
+	priced := r.Windows - r.MissedWindows
+	if priced == 0 {
+		return sdk.ZeroDec()
+	}
+	return r.TotalDeviation.QuoInt64(int64(priced))
+}
+
+// ToOracleReputationResponse returns a new OracleReputationResponse from an OracleReputation
+func (r OracleReputation) ToOracleReputationResponse() OracleReputationResponse {
+	return OracleReputationResponse{
+		MarketID:                  r.MarketID,
+		OracleAddress:             r.OracleAddress.String(),
+		PostCount:                 r.PostCount,
+		FirstPostedAt:             r.FirstPostedAt,
+		LastPostedAt:              r.LastPostedAt,
+		Windows:                   r.Windows,
+		MissedWindows:             r.MissedWindows,
+		ConsecutiveMissedWindows:  r.ConsecutiveMissedWindows,
+		DeviantWindows:            r.DeviantWindows,
+		ConsecutiveDeviantWindows: r.ConsecutiveDeviantWindows,
+		MeanDeviation:             r.MeanDeviation(),
+		LastDeviation:             r.LastDeviation,
+		Excluded:                  r.Excluded,
+	}
+}
+
+// OracleReputations is a slice of OracleReputation
+type OracleReputations []OracleReputation
+
+// Validate checks if all the oracle reputations are valid and there are no duplicated entries.
+func (rs OracleReputations) Validate() error {
+	seen := make(map[string]bool)
+	for _, r := range rs {
+		if seen[r.MarketID+r.OracleAddress.String()] {
+			return fmt.Errorf("duplicated oracle reputation for market id %s and oracle address %s", r.MarketID, r.OracleAddress)
+		}
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		seen[r.MarketID+r.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// OracleReputationResponses is a slice of OracleReputationResponse
+type OracleReputationResponses []OracleReputationResponse
+
+// NewCandle returns a new Candle opening at a price
+func NewCandle(startTime time.Time, open sdk.Dec) Candle {
+	return Candle{
+		StartTime: startTime,
+		Open:      open,
+		High:      open,
+		Low:       open,
+		Close:     open,
+	}
+}
+
+// Update adds a later price to the candle
+func (c *Candle) Update(price sdk.Dec) {
+	c.High = sdk.MaxDec(c.High, price)
+	c.Low = sdk.MinDec(c.Low, price)
+	c.Close = price
+}
+
 // SortDecs provides the interface needed to sort sdk.Dec slices
 type SortDecs []sdk.Dec
 
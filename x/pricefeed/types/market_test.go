@@ -78,6 +78,272 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid trimmed mean",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				AggregationMode:   AGGREGATION_MODE_TRIMMED_MEAN,
+				TrimFraction:      sdk.MustNewDecFromStr("0.25"),
+				MinOraclePosts:    3,
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"),
+			},
+			true,
+		},
+		{
+			"invalid aggregation mode",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				AggregationMode: 10,
+			},
+			false,
+		},
+		{
+			"trim fraction of half",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				AggregationMode: AGGREGATION_MODE_TRIMMED_MEAN,
+				TrimFraction:    sdk.MustNewDecFromStr("0.5"),
+			},
+			false,
+		},
+		{
+			"time weighted without window",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				AggregationMode: AGGREGATION_MODE_TIME_WEIGHTED,
+			},
+			false,
+		},
+		{
+			"negative max price deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				MaxPriceDeviation: sdk.NewDec(-1),
+			},
+			false,
+		},
+		{
+			"negative oracle deviation threshold",
+			Market{
+				MarketID:                 "market",
+				BaseAsset:                "xrp",
+				QuoteAsset:               "bnb",
+				OracleDeviationThreshold: sdk.NewDec(-1),
+			},
+			false,
+		},
+		{
+			"max deviant windows without oracle deviation threshold",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				MaxDeviantWindows: 3,
+			},
+			false,
+		},
+		{
+			"valid derived market",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_PRODUCT,
+					SourceMarketIDs: []string{"xrp:bnb", "bnb:usd"},
+				},
+			},
+			true,
+		},
+		{
+			"derived market with oracles",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Oracles:    []sdk.AccAddress{addr},
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_INVERSE,
+					SourceMarketIDs: []string{"usd:xrp"},
+				},
+			},
+			false,
+		},
+		{
+			"derived market sourcing itself",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_INVERSE,
+					SourceMarketIDs: []string{"market"},
+				},
+			},
+			false,
+		},
+		{
+			"inverse market with two sources",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_INVERSE,
+					SourceMarketIDs: []string{"usd:xrp", "usd:bnb"},
+				},
+			},
+			false,
+		},
+		{
+			"exchange rate market without derivative denom",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "bxrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_EXCHANGE_RATE,
+					SourceMarketIDs: []string{"xrp:usd"},
+				},
+			},
+			false,
+		},
+		{
+			"derivative denom without exchange rate derivation",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "bxrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_PRODUCT,
+					SourceMarketIDs: []string{"xrp:bnb", "bnb:usd"},
+					DerivativeDenom: "bxrp",
+				},
+			},
+			false,
+		},
+		{
+			"valid swap twap market",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				TwapWindow: time.Hour,
+				Derivation: MarketDerivation{
+					Type:              DERIVATION_TYPE_SWAP_TWAP,
+					SwapPoolID:        "usdx:xrp",
+					SwapBaseDenom:     "xrp",
+					SwapQuoteDenom:    "usdx",
+					SwapBaseDecimals:  6,
+					SwapQuoteDecimals: 6,
+				},
+			},
+			true,
+		},
+		{
+			"swap twap market without twap window",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:           DERIVATION_TYPE_SWAP_TWAP,
+					SwapPoolID:     "usdx:xrp",
+					SwapBaseDenom:  "xrp",
+					SwapQuoteDenom: "usdx",
+				},
+			},
+			false,
+		},
+		{
+			"swap twap market with two sources",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				TwapWindow: time.Hour,
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_SWAP_TWAP,
+					SourceMarketIDs: []string{"usdx:usd", "usd:usdx"},
+					SwapPoolID:      "usdx:xrp",
+					SwapBaseDenom:   "xrp",
+					SwapQuoteDenom:  "usdx",
+				},
+			},
+			false,
+		},
+		{
+			"swap twap market with equal denoms",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				TwapWindow: time.Hour,
+				Derivation: MarketDerivation{
+					Type:           DERIVATION_TYPE_SWAP_TWAP,
+					SwapPoolID:     "usdx:xrp",
+					SwapBaseDenom:  "xrp",
+					SwapQuoteDenom: "xrp",
+				},
+			},
+			false,
+		},
+		{
+			"swap twap market with too many decimals",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				TwapWindow: time.Hour,
+				Derivation: MarketDerivation{
+					Type:              DERIVATION_TYPE_SWAP_TWAP,
+					SwapPoolID:        "usdx:xrp",
+					SwapBaseDenom:     "xrp",
+					SwapQuoteDenom:    "usdx",
+					SwapBaseDecimals:  19,
+					SwapQuoteDecimals: 6,
+				},
+			},
+			false,
+		},
+		{
+			"swap decimals without swap twap derivation",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:             DERIVATION_TYPE_INVERSE,
+					SourceMarketIDs:  []string{"usd:xrp"},
+					SwapBaseDecimals: 6,
+				},
+			},
+			false,
+		},
+		{
+			"swap pool without swap twap derivation",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "usd",
+				Derivation: MarketDerivation{
+					Type:            DERIVATION_TYPE_INVERSE,
+					SourceMarketIDs: []string{"usd:xrp"},
+					SwapPoolID:      "usdx:xrp",
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,6 +358,44 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketsDerivations(t *testing.T) {
+	derived := func(id string, derivationType DerivationType, sources ...string) Market {
+		return Market{
+			MarketID:   id,
+			BaseAsset:  "xrp",
+			QuoteAsset: "usd",
+			Derivation: MarketDerivation{Type: derivationType, SourceMarketIDs: sources},
+		}
+	}
+	base := func(id string) Market {
+		return Market{MarketID: id, BaseAsset: "xrp", QuoteAsset: "usd"}
+	}
+
+	markets := Markets{
+		derived("usd:xrp", DERIVATION_TYPE_INVERSE, "xrp:usd"),
+		derived("xrp:usd", DERIVATION_TYPE_PRODUCT, "xrp:bnb", "bnb:usd"),
+		base("xrp:bnb"),
+		base("bnb:usd"),
+	}
+	require.NoError(t, markets.Validate())
+
+	// sources are ordered before the markets derived from them
+	var ids []string
+	for _, m := range markets.SortedByDerivation() {
+		ids = append(ids, m.MarketID)
+	}
+	require.Equal(t, []string{"xrp:bnb", "bnb:usd", "xrp:usd", "usd:xrp"}, ids)
+
+	unknownSource := Markets{derived("usd:xrp", DERIVATION_TYPE_INVERSE, "xrp:usd")}
+	require.Error(t, unknownSource.Validate())
+
+	cycle := Markets{
+		derived("usd:xrp", DERIVATION_TYPE_INVERSE, "xrp:usd"),
+		derived("xrp:usd", DERIVATION_TYPE_INVERSE, "usd:xrp"),
+	}
+	require.Error(t, cycle.Validate())
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
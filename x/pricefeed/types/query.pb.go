@@ -15,6 +15,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -492,6 +493,423 @@ func (m *QueryMarketsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMarketsResponse proto.InternalMessageInfo
 
+// QueryFlaggedMarketsRequest is the request type for the Query/FlaggedMarkets RPC method.
+type QueryFlaggedMarketsRequest struct {
+}
+
+func (m *QueryFlaggedMarketsRequest) Reset()         { *m = QueryFlaggedMarketsRequest{} }
+func (m *QueryFlaggedMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlaggedMarketsRequest) ProtoMessage()    {}
+func (*QueryFlaggedMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{12}
+}
+func (m *QueryFlaggedMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlaggedMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlaggedMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlaggedMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlaggedMarketsRequest.Merge(m, src)
+}
+func (m *QueryFlaggedMarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlaggedMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlaggedMarketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlaggedMarketsRequest proto.InternalMessageInfo
+
+// QueryFlaggedMarketsResponse is the response type for the Query/FlaggedMarkets RPC method.
+type QueryFlaggedMarketsResponse struct {
+	MarketFlags MarketFlags `protobuf:"bytes,1,rep,name=market_flags,json=marketFlags,proto3,castrepeated=MarketFlags" json:"market_flags"`
+}
+
+func (m *QueryFlaggedMarketsResponse) Reset()         { *m = QueryFlaggedMarketsResponse{} }
+func (m *QueryFlaggedMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlaggedMarketsResponse) ProtoMessage()    {}
+func (*QueryFlaggedMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{13}
+}
+func (m *QueryFlaggedMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlaggedMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlaggedMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlaggedMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlaggedMarketsResponse.Merge(m, src)
+}
+func (m *QueryFlaggedMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlaggedMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlaggedMarketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlaggedMarketsResponse proto.InternalMessageInfo
+
+// QueryPriceAtHeightRequest is the request type for the Query/PriceAtHeight RPC method.
+type QueryPriceAtHeightRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Height   int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryPriceAtHeightRequest) Reset()         { *m = QueryPriceAtHeightRequest{} }
+func (m *QueryPriceAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtHeightRequest) ProtoMessage()    {}
+func (*QueryPriceAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{14}
+}
+func (m *QueryPriceAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtHeightRequest.Merge(m, src)
+}
+func (m *QueryPriceAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceAtHeightRequest proto.InternalMessageInfo
+
+// QueryPriceAtHeightResponse is the response type for the Query/PriceAtHeight RPC method.
+type QueryPriceAtHeightResponse struct {
+	// snapshot is the latest snapshot of the market's price at or before the height
+	Snapshot PriceSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *QueryPriceAtHeightResponse) Reset()         { *m = QueryPriceAtHeightResponse{} }
+func (m *QueryPriceAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtHeightResponse) ProtoMessage()    {}
+func (*QueryPriceAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{15}
+}
+func (m *QueryPriceAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtHeightResponse.Merge(m, src)
+}
+func (m *QueryPriceAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceAtHeightResponse proto.InternalMessageInfo
+
+// QueryTwapRequest is the request type for the Query/Twap RPC method.
+type QueryTwapRequest struct {
+	MarketId string        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Window   time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// end_time is the end of the window, the current block time if unset
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryTwapRequest) Reset()         { *m = QueryTwapRequest{} }
+func (m *QueryTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapRequest) ProtoMessage()    {}
+func (*QueryTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{16}
+}
+func (m *QueryTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapRequest.Merge(m, src)
+}
+func (m *QueryTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTwapRequest proto.InternalMessageInfo
+
+// QueryTwapResponse is the response type for the Query/Twap RPC method.
+type QueryTwapResponse struct {
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// start_time is the start of the window averaged over, later than requested if the price history is shorter than the window
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryTwapResponse) Reset()         { *m = QueryTwapResponse{} }
+func (m *QueryTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapResponse) ProtoMessage()    {}
+func (*QueryTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{17}
+}
+func (m *QueryTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapResponse.Merge(m, src)
+}
+func (m *QueryTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTwapResponse proto.InternalMessageInfo
+
+// QueryCandlesRequest is the request type for the Query/Candles RPC method.
+type QueryCandlesRequest struct {
+	MarketId string        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// start_time is the start of the first interval, the start of the price history if unset
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time excludes prices at or after it, all of the price history is included if unset
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{18}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+// QueryCandlesResponse is the response type for the Query/Candles RPC method.
+type QueryCandlesResponse struct {
+	// candles are the intervals with prices in the market's price history, in time order
+	Candles []Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+}
+
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{19}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+// QueryOracleReputationsRequest is the request type for the Query/OracleReputations RPC method.
+type QueryOracleReputationsRequest struct {
+	// market_id filters the reputations by market, all markets if unset
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// oracle_address filters the reputations by oracle, all oracles if unset
+	OracleAddress string `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
+}
+
+func (m *QueryOracleReputationsRequest) Reset()         { *m = QueryOracleReputationsRequest{} }
+func (m *QueryOracleReputationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleReputationsRequest) ProtoMessage()    {}
+func (*QueryOracleReputationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{20}
+}
+func (m *QueryOracleReputationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleReputationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleReputationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleReputationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleReputationsRequest.Merge(m, src)
+}
+func (m *QueryOracleReputationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleReputationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleReputationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleReputationsRequest proto.InternalMessageInfo
+
+func (m *QueryOracleReputationsRequest) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *QueryOracleReputationsRequest) GetOracleAddress() string {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return ""
+}
+
+// QueryOracleReputationsResponse is the response type for the Query/OracleReputations RPC method.
+type QueryOracleReputationsResponse struct {
+	OracleReputations OracleReputationResponses `protobuf:"bytes,1,rep,name=oracle_reputations,json=oracleReputations,proto3,castrepeated=OracleReputationResponses" json:"oracle_reputations"`
+}
+
+func (m *QueryOracleReputationsResponse) Reset()         { *m = QueryOracleReputationsResponse{} }
+func (m *QueryOracleReputationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleReputationsResponse) ProtoMessage()    {}
+func (*QueryOracleReputationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{21}
+}
+func (m *QueryOracleReputationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleReputationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleReputationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleReputationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleReputationsResponse.Merge(m, src)
+}
+func (m *QueryOracleReputationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleReputationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleReputationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleReputationsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleReputationsResponse) GetOracleReputations() OracleReputationResponses {
+	if m != nil {
+		return m.OracleReputations
+	}
+	return nil
+}
+
 // PostedPriceResponse defines a price for market posted by a specific oracle.
 type PostedPriceResponse struct {
 	MarketID      string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -504,7 +922,7 @@ func (m *PostedPriceResponse) Reset()         { *m = PostedPriceResponse{} }
 func (m *PostedPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*PostedPriceResponse) ProtoMessage()    {}
 func (*PostedPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cea923fef3729154, []int{12}
+	return fileDescriptor_cea923fef3729154, []int{22}
 }
 func (m *PostedPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +983,7 @@ func (m *CurrentPriceResponse) Reset()         { *m = CurrentPriceResponse{} }
 func (m *CurrentPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*CurrentPriceResponse) ProtoMessage()    {}
 func (*CurrentPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cea923fef3729154, []int{13}
+	return fileDescriptor_cea923fef3729154, []int{23}
 }
 func (m *CurrentPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,18 +1021,29 @@ func (m *CurrentPriceResponse) GetMarketID() string {
 
 // MarketResponse defines an asset in the pricefeed.
 type MarketResponse struct {
-	MarketID   string   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BaseAsset  string   `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
-	QuoteAsset string   `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
-	Oracles    []string `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
-	Active     bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	MarketID                 string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BaseAsset                string                                 `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset               string                                 `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Oracles                  []string                               `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	Active                   bool                                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	AggregationMode          AggregationMode                        `protobuf:"varint,6,opt,name=aggregation_mode,json=aggregationMode,proto3,enum=fury.pricefeed.v1beta1.AggregationMode" json:"aggregation_mode,omitempty"`
+	TrimFraction             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=trim_fraction,json=trimFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_fraction"`
+	TwapWindow               time.Duration                          `protobuf:"bytes,8,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+	MinOraclePosts           uint32                                 `protobuf:"varint,9,opt,name=min_oracle_posts,json=minOraclePosts,proto3" json:"min_oracle_posts,omitempty"`
+	MaxPriceDeviation        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	PriceHistoryLength       uint32                                 `protobuf:"varint,11,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	OracleDeviationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=oracle_deviation_threshold,json=oracleDeviationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_deviation_threshold"`
+	MaxMissedWindows         uint32                                 `protobuf:"varint,13,opt,name=max_missed_windows,json=maxMissedWindows,proto3" json:"max_missed_windows,omitempty"`
+	MaxDeviantWindows        uint32                                 `protobuf:"varint,14,opt,name=max_deviant_windows,json=maxDeviantWindows,proto3" json:"max_deviant_windows,omitempty"`
+	Derivation               MarketDerivation                       `protobuf:"bytes,15,opt,name=derivation,proto3" json:"derivation"`
+	FlagRecoveryRounds       uint32                                 `protobuf:"varint,16,opt,name=flag_recovery_rounds,json=flagRecoveryRounds,proto3" json:"flag_recovery_rounds,omitempty"`
 }
 
 func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
 func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketResponse) ProtoMessage()    {}
 func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cea923fef3729154, []int{14}
+	return fileDescriptor_cea923fef3729154, []int{24}
 }
 func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,86 +1107,340 @@ func (m *MarketResponse) GetActive() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "fury.pricefeed.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fury.pricefeed.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPriceRequest)(nil), "fury.pricefeed.v1beta1.QueryPriceRequest")
-	proto.RegisterType((*QueryPriceResponse)(nil), "fury.pricefeed.v1beta1.QueryPriceResponse")
-	proto.RegisterType((*QueryPricesRequest)(nil), "fury.pricefeed.v1beta1.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "fury.pricefeed.v1beta1.QueryPricesResponse")
-	proto.RegisterType((*QueryRawPricesRequest)(nil), "fury.pricefeed.v1beta1.QueryRawPricesRequest")
-	proto.RegisterType((*QueryRawPricesResponse)(nil), "fury.pricefeed.v1beta1.QueryRawPricesResponse")
-	proto.RegisterType((*QueryOraclesRequest)(nil), "fury.pricefeed.v1beta1.QueryOraclesRequest")
-	proto.RegisterType((*QueryOraclesResponse)(nil), "fury.pricefeed.v1beta1.QueryOraclesResponse")
-	proto.RegisterType((*QueryMarketsRequest)(nil), "fury.pricefeed.v1beta1.QueryMarketsRequest")
-	proto.RegisterType((*QueryMarketsResponse)(nil), "fury.pricefeed.v1beta1.QueryMarketsResponse")
-	proto.RegisterType((*PostedPriceResponse)(nil), "fury.pricefeed.v1beta1.PostedPriceResponse")
-	proto.RegisterType((*CurrentPriceResponse)(nil), "fury.pricefeed.v1beta1.CurrentPriceResponse")
-	proto.RegisterType((*MarketResponse)(nil), "fury.pricefeed.v1beta1.MarketResponse")
+func (m *MarketResponse) GetAggregationMode() AggregationMode {
+	if m != nil {
+		return m.AggregationMode
+	}
+	return AGGREGATION_MODE_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterFile("fury/pricefeed/v1beta1/query.proto", fileDescriptor_cea923fef3729154)
+func (m *MarketResponse) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMinOraclePosts() uint32 {
+	if m != nil {
+		return m.MinOraclePosts
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetPriceHistoryLength() uint32 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMaxMissedWindows() uint32 {
+	if m != nil {
+		return m.MaxMissedWindows
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMaxDeviantWindows() uint32 {
+	if m != nil {
+		return m.MaxDeviantWindows
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetDerivation() MarketDerivation {
+	if m != nil {
+		return m.Derivation
+	}
+	return MarketDerivation{}
+}
+
+func (m *MarketResponse) GetFlagRecoveryRounds() uint32 {
+	if m != nil {
+		return m.FlagRecoveryRounds
+	}
+	return 0
+}
+
+// OracleReputationResponse defines the posting record of an oracle in a market.
+type OracleReputationResponse struct {
+	MarketID                  string    `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress             string    `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
+	PostCount                 uint64    `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	FirstPostedAt             time.Time `protobuf:"bytes,4,opt,name=first_posted_at,json=firstPostedAt,proto3,stdtime" json:"first_posted_at"`
+	LastPostedAt              time.Time `protobuf:"bytes,5,opt,name=last_posted_at,json=lastPostedAt,proto3,stdtime" json:"last_posted_at"`
+	Windows                   uint64    `protobuf:"varint,6,opt,name=windows,proto3" json:"windows,omitempty"`
+	MissedWindows             uint64    `protobuf:"varint,7,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	ConsecutiveMissedWindows  uint32    `protobuf:"varint,8,opt,name=consecutive_missed_windows,json=consecutiveMissedWindows,proto3" json:"consecutive_missed_windows,omitempty"`
+	DeviantWindows            uint64    `protobuf:"varint,9,opt,name=deviant_windows,json=deviantWindows,proto3" json:"deviant_windows,omitempty"`
+	ConsecutiveDeviantWindows uint32    `protobuf:"varint,10,opt,name=consecutive_deviant_windows,json=consecutiveDeviantWindows,proto3" json:"consecutive_deviant_windows,omitempty"`
+	// mean_deviation is the mean fractional difference between the oracle's price and the median price, over the windows
+	// it did not miss
+	MeanDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=mean_deviation,json=meanDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mean_deviation"`
+	LastDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=last_deviation,json=lastDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_deviation"`
+	Excluded      bool                                   `protobuf:"varint,13,opt,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (m *OracleReputationResponse) Reset()         { *m = OracleReputationResponse{} }
+func (m *OracleReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*OracleReputationResponse) ProtoMessage()    {}
+func (*OracleReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cea923fef3729154, []int{25}
+}
+func (m *OracleReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleReputationResponse.Merge(m, src)
+}
+func (m *OracleReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleReputationResponse proto.InternalMessageInfo
+
+func (m *OracleReputationResponse) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleReputationResponse) GetOracleAddress() string {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return ""
+}
+
+func (m *OracleReputationResponse) GetPostCount() uint64 {
+	if m != nil {
+		return m.PostCount
+	}
+	return 0
+}
+
+func (m *OracleReputationResponse) GetFirstPostedAt() time.Time {
+	if m != nil {
+		return m.FirstPostedAt
+	}
+	return time.Time{}
+}
+
+func (m *OracleReputationResponse) GetLastPostedAt() time.Time {
+	if m != nil {
+		return m.LastPostedAt
+	}
+	return time.Time{}
+}
+
+func (m *OracleReputationResponse) GetWindows() uint64 {
+	if m != nil {
+		return m.Windows
+	}
+	return 0
+}
+
+func (m *OracleReputationResponse) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
+	}
+	return 0
+}
+
+func (m *OracleReputationResponse) GetConsecutiveMissedWindows() uint32 {
+	if m != nil {
+		return m.ConsecutiveMissedWindows
+	}
+	return 0
+}
+
+func (m *OracleReputationResponse) GetDeviantWindows() uint64 {
+	if m != nil {
+		return m.DeviantWindows
+	}
+	return 0
+}
+
+func (m *OracleReputationResponse) GetConsecutiveDeviantWindows() uint32 {
+	if m != nil {
+		return m.ConsecutiveDeviantWindows
+	}
+	return 0
+}
+
+func (m *OracleReputationResponse) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "fury.pricefeed.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fury.pricefeed.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPriceRequest)(nil), "fury.pricefeed.v1beta1.QueryPriceRequest")
+	proto.RegisterType((*QueryPriceResponse)(nil), "fury.pricefeed.v1beta1.QueryPriceResponse")
+	proto.RegisterType((*QueryPricesRequest)(nil), "fury.pricefeed.v1beta1.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "fury.pricefeed.v1beta1.QueryPricesResponse")
+	proto.RegisterType((*QueryRawPricesRequest)(nil), "fury.pricefeed.v1beta1.QueryRawPricesRequest")
+	proto.RegisterType((*QueryRawPricesResponse)(nil), "fury.pricefeed.v1beta1.QueryRawPricesResponse")
+	proto.RegisterType((*QueryOraclesRequest)(nil), "fury.pricefeed.v1beta1.QueryOraclesRequest")
+	proto.RegisterType((*QueryOraclesResponse)(nil), "fury.pricefeed.v1beta1.QueryOraclesResponse")
+	proto.RegisterType((*QueryMarketsRequest)(nil), "fury.pricefeed.v1beta1.QueryMarketsRequest")
+	proto.RegisterType((*QueryMarketsResponse)(nil), "fury.pricefeed.v1beta1.QueryMarketsResponse")
+	proto.RegisterType((*QueryFlaggedMarketsRequest)(nil), "fury.pricefeed.v1beta1.QueryFlaggedMarketsRequest")
+	proto.RegisterType((*QueryFlaggedMarketsResponse)(nil), "fury.pricefeed.v1beta1.QueryFlaggedMarketsResponse")
+	proto.RegisterType((*QueryPriceAtHeightRequest)(nil), "fury.pricefeed.v1beta1.QueryPriceAtHeightRequest")
+	proto.RegisterType((*QueryPriceAtHeightResponse)(nil), "fury.pricefeed.v1beta1.QueryPriceAtHeightResponse")
+	proto.RegisterType((*QueryTwapRequest)(nil), "fury.pricefeed.v1beta1.QueryTwapRequest")
+	proto.RegisterType((*QueryTwapResponse)(nil), "fury.pricefeed.v1beta1.QueryTwapResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "fury.pricefeed.v1beta1.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "fury.pricefeed.v1beta1.QueryCandlesResponse")
+	proto.RegisterType((*QueryOracleReputationsRequest)(nil), "fury.pricefeed.v1beta1.QueryOracleReputationsRequest")
+	proto.RegisterType((*QueryOracleReputationsResponse)(nil), "fury.pricefeed.v1beta1.QueryOracleReputationsResponse")
+	proto.RegisterType((*PostedPriceResponse)(nil), "fury.pricefeed.v1beta1.PostedPriceResponse")
+	proto.RegisterType((*CurrentPriceResponse)(nil), "fury.pricefeed.v1beta1.CurrentPriceResponse")
+	proto.RegisterType((*MarketResponse)(nil), "fury.pricefeed.v1beta1.MarketResponse")
+	proto.RegisterType((*OracleReputationResponse)(nil), "fury.pricefeed.v1beta1.OracleReputationResponse")
+}
+
+func init() {
+	proto.RegisterFile("fury/pricefeed/v1beta1/query.proto", fileDescriptor_cea923fef3729154)
 }
 
 var fileDescriptor_cea923fef3729154 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa4, 0x89, 0x7f, 0x4c, 0xa1, 0x88, 0xa9, 0x13, 0x2c, 0xd3, 0xee, 0x86, 0x95, 0x08,
-	0x6d, 0x12, 0xef, 0xaa, 0xa9, 0xa8, 0x50, 0xc5, 0xa5, 0x26, 0x07, 0x7a, 0x40, 0xd0, 0x15, 0x97,
-	0x72, 0xb1, 0xc6, 0xbb, 0x13, 0x77, 0xd5, 0xd8, 0xb3, 0x99, 0x19, 0xd7, 0xb5, 0x10, 0x12, 0x42,
-	0x48, 0x94, 0x03, 0x52, 0x05, 0x27, 0x6e, 0x70, 0x43, 0x48, 0xfc, 0x1f, 0x3d, 0x56, 0xe2, 0x82,
-	0x38, 0xa4, 0xc5, 0xe1, 0xc6, 0x3f, 0x81, 0x76, 0xe6, 0xed, 0xe2, 0x4d, 0xbd, 0xe9, 0x5a, 0x9c,
-	0xbc, 0xf3, 0xe6, 0x7d, 0xef, 0x7d, 0xef, 0x9b, 0x99, 0xcf, 0xd8, 0x39, 0x18, 0x8b, 0xa9, 0x17,
-	0x8b, 0x28, 0x60, 0x07, 0x8c, 0x85, 0xde, 0x83, 0x6b, 0x7d, 0xa6, 0xe8, 0x35, 0xef, 0x68, 0xcc,
-	0xc4, 0xd4, 0x8d, 0x05, 0x57, 0x9c, 0x6c, 0x24, 0x39, 0x6e, 0x96, 0xe3, 0x42, 0x4e, 0xbb, 0x08,
-	0x2b, 0x15, 0x17, 0xcc, 0x60, 0xdb, 0xcd, 0x01, 0x1f, 0x70, 0xfd, 0xe9, 0x25, 0x5f, 0x10, 0xbd,
-	0x34, 0xe0, 0x7c, 0x70, 0xc8, 0x3c, 0x1a, 0x47, 0x1e, 0x1d, 0x8d, 0xb8, 0xa2, 0x2a, 0xe2, 0x23,
-	0x09, 0xbb, 0x36, 0xec, 0xea, 0x55, 0x7f, 0x7c, 0xe0, 0xa9, 0x68, 0xc8, 0xa4, 0xa2, 0xc3, 0xd8,
-	0x24, 0x38, 0x4d, 0x4c, 0xee, 0x24, 0xfc, 0x3e, 0xa1, 0x82, 0x0e, 0xa5, 0xcf, 0x8e, 0xc6, 0x4c,
-	0x2a, 0xe7, 0x2e, 0xbe, 0x98, 0x8b, 0xca, 0x98, 0x8f, 0x24, 0x23, 0xef, 0xe3, 0x6a, 0xac, 0x23,
-	0x2d, 0xb4, 0x89, 0xae, 0x9c, 0xdf, 0xb3, 0xdc, 0xc5, 0xe3, 0xb8, 0x06, 0xd7, 0x5d, 0x7d, 0x72,
-	0x6c, 0x57, 0x7c, 0xc0, 0xdc, 0x5c, 0x7d, 0xf4, 0x93, 0x5d, 0x71, 0x6e, 0xe0, 0xd7, 0x4d, 0xe9,
-	0x04, 0x04, 0xfd, 0xc8, 0x9b, 0xb8, 0x31, 0xa4, 0xe2, 0x3e, 0x53, 0xbd, 0x28, 0xd4, 0xb5, 0x1b,
-	0x7e, 0xdd, 0x04, 0x6e, 0x87, 0x80, 0x0b, 0x53, 0xa2, 0x06, 0x07, 0x8c, 0x3e, 0xc4, 0x6b, 0xba,
-	0x3b, 0x10, 0xda, 0x2d, 0x22, 0xf4, 0xc1, 0x58, 0x08, 0x36, 0x52, 0x39, 0x30, 0xd0, 0x33, 0x05,
-	0xa0, 0x4b, 0x73, 0xbe, 0x4b, 0x26, 0xc7, 0x97, 0x28, 0xd5, 0x03, 0xc2, 0xd0, 0x3d, 0xc0, 0x55,
-	0x0d, 0x4e, 0xf4, 0x38, 0xb7, 0x74, 0xfb, 0xcb, 0x49, 0xfb, 0x5f, 0x9f, 0xd9, 0xeb, 0x8b, 0x76,
-	0xa5, 0x0f, 0xa5, 0x81, 0xd8, 0x4d, 0xbc, 0xae, 0x19, 0xf8, 0x74, 0x92, 0xe3, 0x56, 0x46, 0xba,
-	0x47, 0x08, 0x6f, 0x9c, 0x06, 0xc3, 0x04, 0xf7, 0x30, 0x16, 0x74, 0xd2, 0xcb, 0x4d, 0xb1, 0x53,
-	0x78, 0xaa, 0x5c, 0x2a, 0x16, 0xe6, 0x87, 0xb8, 0x04, 0x43, 0x34, 0x17, 0x6c, 0x4a, 0xbf, 0x21,
-	0xd2, 0x8e, 0x40, 0xe5, 0x3d, 0x10, 0xf2, 0x63, 0x41, 0x83, 0xc3, 0xa5, 0x86, 0xb8, 0x81, 0x9b,
-	0x79, 0x24, 0x4c, 0xd0, 0xc2, 0x35, 0x6e, 0x42, 0x9a, 0x7e, 0xc3, 0x4f, 0x97, 0x80, 0x5b, 0x87,
-	0x8e, 0x1f, 0xe9, 0x72, 0xd9, 0x91, 0x4e, 0xa0, 0x5c, 0x16, 0x86, 0x72, 0x77, 0x71, 0xcd, 0x34,
-	0x4e, 0xd5, 0xd8, 0x2a, 0x52, 0xc3, 0x20, 0x33, 0x21, 0xde, 0x00, 0x21, 0x5e, 0xcb, 0xc7, 0xa5,
-	0x9f, 0xd6, 0x03, 0x3e, 0xff, 0x20, 0x7c, 0x71, 0x81, 0x56, 0xe4, 0xea, 0x0b, 0x12, 0x74, 0x5f,
-	0x99, 0x1d, 0xdb, 0x75, 0x53, 0xee, 0xf6, 0xfe, 0x7f, 0x82, 0x90, 0xb7, 0xf1, 0x05, 0x33, 0x63,
-	0x8f, 0x86, 0xa1, 0x60, 0x52, 0xb6, 0x56, 0xb4, 0x64, 0xaf, 0x9a, 0xe8, 0x2d, 0x13, 0x24, 0xfb,
-	0xe9, 0xdb, 0x38, 0xa7, 0xab, 0xb9, 0x09, 0xc1, 0x3f, 0x8f, 0xed, 0xad, 0x41, 0xa4, 0xee, 0x8d,
-	0xfb, 0x6e, 0xc0, 0x87, 0x5e, 0xc0, 0xe5, 0x90, 0x4b, 0xf8, 0xe9, 0xc8, 0xf0, 0xbe, 0xa7, 0xa6,
-	0x31, 0x93, 0xee, 0x3e, 0x0b, 0xe0, 0x5d, 0x24, 0x6f, 0x9e, 0x3d, 0x8c, 0x23, 0x31, 0x6d, 0xad,
-	0xea, 0x27, 0xd6, 0x76, 0x8d, 0xa5, 0xb8, 0xa9, 0xa5, 0xb8, 0x9f, 0xa6, 0x96, 0xd2, 0xad, 0x27,
-	0x2d, 0x1e, 0x3f, 0xb3, 0x91, 0x0f, 0x18, 0xe7, 0x1b, 0x84, 0x9b, 0x8b, 0xae, 0xf7, 0x32, 0xe3,
-	0x66, 0x73, 0xac, 0xfc, 0x8f, 0x39, 0x9c, 0xdf, 0x10, 0xbe, 0x90, 0x3f, 0x9a, 0x65, 0x38, 0x5c,
-	0xc6, 0xb8, 0x4f, 0x25, 0xeb, 0x51, 0x29, 0x99, 0x02, 0xb9, 0x1b, 0x49, 0xe4, 0x56, 0x12, 0x20,
-	0x36, 0x3e, 0x7f, 0x34, 0xe6, 0x2a, 0xdd, 0xd7, 0x82, 0xfb, 0x58, 0x87, 0x4c, 0xc2, 0xdc, 0x2d,
-	0x5d, 0xcd, 0xdd, 0x52, 0xb2, 0x81, 0xab, 0x34, 0x50, 0xd1, 0x03, 0xd6, 0x5a, 0xdb, 0x44, 0x57,
-	0xea, 0x3e, 0xac, 0xf6, 0xbe, 0xae, 0xe1, 0x35, 0x7d, 0x43, 0xc9, 0xb7, 0x08, 0x57, 0x8d, 0xa1,
-	0x92, 0xed, 0xa2, 0xcb, 0xf8, 0xa2, 0x87, 0xb7, 0x77, 0x4a, 0xe5, 0x1a, 0x29, 0x9c, 0xad, 0xaf,
-	0x7e, 0xff, 0xfb, 0x87, 0x95, 0x4d, 0x62, 0x79, 0x05, 0x7f, 0x44, 0xc6, 0xc3, 0xc9, 0xf7, 0x08,
-	0xaf, 0xe9, 0x83, 0x24, 0x57, 0xcf, 0x2e, 0x3f, 0xe7, 0xee, 0xed, 0xed, 0x32, 0xa9, 0x40, 0x64,
-	0x4f, 0x13, 0xd9, 0x25, 0xdb, 0x85, 0x44, 0xb4, 0x9d, 0x78, 0x9f, 0x67, 0x27, 0xf7, 0x85, 0x11,
-	0x48, 0x87, 0x49, 0x89, 0x56, 0x65, 0x05, 0xca, 0x19, 0x65, 0x09, 0x81, 0x0c, 0x81, 0x9f, 0x11,
-	0x6e, 0x64, 0x36, 0x4b, 0x3a, 0x67, 0xb6, 0x38, 0xed, 0xe5, 0x6d, 0xb7, 0x6c, 0x3a, 0x90, 0x7a,
-	0x57, 0x93, 0xf2, 0x48, 0xa7, 0x88, 0x94, 0xa0, 0x93, 0x05, 0x7a, 0xfd, 0x88, 0x70, 0x0d, 0x6c,
-	0x94, 0x9c, 0x2d, 0x42, 0xde, 0xa6, 0xdb, 0xbb, 0xe5, 0x92, 0x81, 0xdd, 0x75, 0xcd, 0xae, 0x43,
-	0x76, 0x8a, 0xd8, 0xc1, 0x13, 0xc8, 0x71, 0xfb, 0x0e, 0xe1, 0x1a, 0x78, 0xf2, 0x4b, 0xb8, 0xe5,
-	0x0d, 0xfd, 0x25, 0xdc, 0x4e, 0xd9, 0xbc, 0xf3, 0x8e, 0xe6, 0xf6, 0x16, 0xb1, 0x8b, 0xb8, 0x81,
-	0x69, 0x77, 0xef, 0x3c, 0xff, 0xcb, 0x42, 0xbf, 0xcc, 0x2c, 0xf4, 0x64, 0x66, 0xa1, 0xa7, 0x33,
-	0x0b, 0x3d, 0x9f, 0x59, 0xe8, 0xf1, 0x89, 0x55, 0x79, 0x7a, 0x62, 0x55, 0xfe, 0x38, 0xb1, 0x2a,
-	0x9f, 0x79, 0x73, 0x3e, 0x14, 0x33, 0x11, 0x70, 0x19, 0xc9, 0xce, 0x21, 0xed, 0x4b, 0x53, 0xfa,
-	0xe1, 0x5c, 0x71, 0x6d, 0x4a, 0xfd, 0xaa, 0x76, 0xce, 0xeb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xb7, 0x97, 0x0d, 0x77, 0x30, 0x0a, 0x00, 0x00,
+	// 1810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x5a, 0x12, 0x3f, 0x9e, 0x44, 0x4a, 0x1a, 0xd1, 0xee, 0x7a, 0x6d, 0x93, 0x0a, 0x81,
+	0xd8, 0xb4, 0x2d, 0x91, 0xb6, 0x8c, 0x18, 0x85, 0x6b, 0xa4, 0x90, 0x2c, 0xa4, 0x4e, 0x11, 0xb7,
+	0xcd, 0xc6, 0x69, 0x91, 0xa0, 0xe8, 0x62, 0xc4, 0x1d, 0x91, 0x0b, 0x73, 0x77, 0xe9, 0x9d, 0xa1,
+	0x3e, 0x10, 0x14, 0x2d, 0x72, 0x69, 0x7a, 0x28, 0x10, 0xb4, 0x97, 0xf6, 0xd6, 0x1e, 0x5a, 0x14,
+	0xb9, 0x16, 0xe8, 0xa1, 0x7f, 0x41, 0x8e, 0x01, 0x72, 0x68, 0xd1, 0x83, 0xe3, 0xca, 0xed, 0xa9,
+	0x7f, 0x44, 0x8b, 0x99, 0x79, 0xfc, 0x58, 0x8a, 0x2b, 0x2f, 0xe5, 0xf6, 0x44, 0xee, 0xfb, 0xfc,
+	0xbd, 0x37, 0xef, 0xbd, 0x9d, 0x7d, 0x50, 0xdd, 0xeb, 0x45, 0x47, 0x8d, 0x6e, 0xe4, 0x35, 0xd9,
+	0x1e, 0x63, 0x6e, 0x63, 0xff, 0xf6, 0x2e, 0x13, 0xf4, 0x76, 0xe3, 0x69, 0x8f, 0x45, 0x47, 0xf5,
+	0x6e, 0x14, 0x8a, 0x90, 0x5c, 0x90, 0x32, 0xf5, 0x81, 0x4c, 0x1d, 0x65, 0xac, 0x24, 0x5d, 0x2e,
+	0xc2, 0x88, 0x69, 0x5d, 0xab, 0xd4, 0x0a, 0x5b, 0xa1, 0xfa, 0xdb, 0x90, 0xff, 0x90, 0x7a, 0xb9,
+	0x15, 0x86, 0xad, 0x0e, 0x6b, 0xd0, 0xae, 0xd7, 0xa0, 0x41, 0x10, 0x0a, 0x2a, 0xbc, 0x30, 0xe0,
+	0xc8, 0x2d, 0x23, 0x57, 0x3d, 0xed, 0xf6, 0xf6, 0x1a, 0x6e, 0x2f, 0x52, 0x02, 0xc8, 0xaf, 0x8c,
+	0xf3, 0x85, 0xe7, 0x33, 0x2e, 0xa8, 0xdf, 0xd5, 0x02, 0xd5, 0x12, 0x90, 0x77, 0x25, 0xfe, 0xef,
+	0xd1, 0x88, 0xfa, 0xdc, 0x66, 0x4f, 0x7b, 0x8c, 0x8b, 0xea, 0x07, 0xb0, 0x1a, 0xa3, 0xf2, 0x6e,
+	0x18, 0x70, 0x46, 0xee, 0x43, 0xa6, 0xab, 0x28, 0xa6, 0xb1, 0x66, 0xd4, 0x16, 0x36, 0xcb, 0xf5,
+	0xc9, 0xe1, 0xd6, 0xb5, 0xde, 0xf6, 0xdc, 0xe7, 0xcf, 0x2a, 0x33, 0x36, 0xea, 0xdc, 0x9b, 0xfb,
+	0xe4, 0xb7, 0x95, 0x99, 0xea, 0x5d, 0x58, 0xd1, 0xa6, 0xa5, 0x12, 0xfa, 0x23, 0x97, 0x20, 0xef,
+	0xd3, 0xe8, 0x09, 0x13, 0x8e, 0xe7, 0x2a, 0xdb, 0x79, 0x3b, 0xa7, 0x09, 0x6f, 0xbb, 0xa8, 0xe7,
+	0x02, 0x19, 0xd5, 0x43, 0x44, 0x0f, 0x61, 0x5e, 0x79, 0x47, 0x40, 0xeb, 0x49, 0x80, 0x1e, 0xf4,
+	0xa2, 0x88, 0x05, 0x22, 0xa6, 0x8c, 0xf0, 0xb4, 0x01, 0xf4, 0x52, 0x1a, 0xf5, 0x32, 0x48, 0xc7,
+	0x4f, 0x0d, 0x58, 0x8d, 0x91, 0xd1, 0x7b, 0x13, 0x32, 0x4a, 0x59, 0xe6, 0x63, 0x76, 0x6a, 0xf7,
+	0x57, 0xa4, 0xfb, 0xcf, 0xbe, 0xaa, 0x9c, 0x9f, 0xc4, 0xe5, 0x36, 0x9a, 0x46, 0x60, 0xf7, 0xe0,
+	0xbc, 0x42, 0x60, 0xd3, 0x83, 0x18, 0xb6, 0x34, 0xa9, 0xfb, 0xc4, 0x80, 0x0b, 0xe3, 0xca, 0x18,
+	0x41, 0x1b, 0x20, 0xa2, 0x07, 0x4e, 0x2c, 0x8a, 0x9b, 0x89, 0xa7, 0x1a, 0x72, 0xc1, 0xdc, 0x78,
+	0x10, 0x97, 0x31, 0x88, 0xd2, 0x04, 0x26, 0xb7, 0xf3, 0x51, 0xdf, 0x23, 0x42, 0xf9, 0x3a, 0x26,
+	0xf2, 0xbb, 0x11, 0x6d, 0x76, 0xa6, 0x0a, 0xe2, 0x2e, 0x94, 0xe2, 0x9a, 0x18, 0x81, 0x09, 0xd9,
+	0x50, 0x93, 0x14, 0xfc, 0xbc, 0xdd, 0x7f, 0x44, 0xbd, 0xf3, 0xe8, 0xf1, 0x91, 0x32, 0x37, 0x38,
+	0xd2, 0x03, 0x28, 0xc5, 0xc9, 0x68, 0xee, 0x03, 0xc8, 0x6a, 0xc7, 0xfd, 0x6c, 0x5c, 0x4d, 0xca,
+	0x86, 0xd6, 0x1c, 0x24, 0xe2, 0x6b, 0x98, 0x88, 0xa5, 0x38, 0x9d, 0xdb, 0x7d, 0x7b, 0x88, 0xe7,
+	0x32, 0x58, 0xca, 0xf1, 0x5b, 0x1d, 0xda, 0x6a, 0x31, 0x77, 0x0c, 0xd6, 0x4f, 0xe0, 0xd2, 0x44,
+	0x2e, 0xa2, 0xfb, 0x10, 0x16, 0x31, 0x4f, 0x7b, 0x1d, 0xda, 0xea, 0x43, 0xac, 0x9e, 0x0e, 0x51,
+	0xda, 0xda, 0x5e, 0x45, 0x78, 0x0b, 0x43, 0x1a, 0xb7, 0x17, 0xfc, 0xe1, 0x03, 0xc2, 0xfb, 0x3e,
+	0x5c, 0x1c, 0x56, 0xfa, 0x96, 0x78, 0xc8, 0xbc, 0x56, 0x5b, 0xa4, 0x39, 0x26, 0x72, 0x01, 0x32,
+	0x6d, 0x25, 0x6d, 0x9e, 0x5b, 0x33, 0x6a, 0xb3, 0x36, 0x3e, 0xa1, 0xdd, 0x27, 0x60, 0x4d, 0xb2,
+	0x8b, 0x71, 0x7d, 0x0b, 0x72, 0x3c, 0xa0, 0x5d, 0xde, 0x0e, 0x05, 0x76, 0xf2, 0xeb, 0x89, 0x45,
+	0x28, 0x29, 0xef, 0xa1, 0x30, 0xb6, 0xf0, 0x40, 0x19, 0x9d, 0xfd, 0xc9, 0x80, 0x65, 0xe5, 0xed,
+	0xf1, 0x01, 0xed, 0xa6, 0x02, 0xff, 0x0d, 0xc8, 0x1c, 0x78, 0x81, 0x1b, 0x1e, 0x28, 0xf0, 0x0b,
+	0x9b, 0x17, 0xeb, 0x7a, 0x70, 0xd6, 0xfb, 0x83, 0xb3, 0xbe, 0x83, 0x83, 0x75, 0x3b, 0x27, 0x5d,
+	0xfe, 0xfa, 0xab, 0x8a, 0x61, 0xa3, 0x0a, 0xf9, 0x26, 0xe4, 0x58, 0xe0, 0x3a, 0x72, 0xb4, 0x9a,
+	0xb3, 0x4a, 0xdd, 0x3a, 0xa1, 0xfe, 0xb8, 0x3f, 0x77, 0xb5, 0xfe, 0xa7, 0x52, 0x3f, 0xcb, 0x02,
+	0x57, 0xd2, 0x11, 0xf5, 0xbf, 0x0c, 0x58, 0x19, 0x41, 0x8d, 0xa9, 0xd9, 0x19, 0x9d, 0x70, 0xf9,
+	0xed, 0xba, 0xd4, 0xfe, 0xfb, 0xb3, 0xca, 0xd5, 0x96, 0x27, 0xda, 0xbd, 0xdd, 0x7a, 0x33, 0xf4,
+	0x1b, 0xcd, 0x90, 0xfb, 0x21, 0xc7, 0x9f, 0x0d, 0xee, 0x3e, 0x69, 0x88, 0xa3, 0x2e, 0xe3, 0xf5,
+	0x1d, 0xd6, 0xc4, 0xe9, 0x46, 0x1e, 0x00, 0x70, 0x41, 0x23, 0xa1, 0x41, 0x9e, 0x9b, 0x02, 0x64,
+	0x5e, 0xe9, 0x49, 0xce, 0xff, 0x2a, 0xce, 0xff, 0xf4, 0xa7, 0xe9, 0x03, 0x1a, 0xb8, 0x29, 0x87,
+	0x80, 0xf4, 0xed, 0x05, 0x82, 0x45, 0xfb, 0xb4, 0x33, 0xcd, 0x11, 0x0d, 0x94, 0xc6, 0x32, 0x30,
+	0xfb, 0xea, 0x19, 0x98, 0x3b, 0x7b, 0x06, 0x7e, 0x08, 0xa5, 0x78, 0x02, 0xf0, 0xac, 0xdf, 0x84,
+	0x6c, 0x53, 0x93, 0xb0, 0xb3, 0x13, 0x5f, 0xb0, 0x5a, 0x13, 0xcb, 0xbf, 0xaf, 0x84, 0xd6, 0x9b,
+	0x70, 0x65, 0x64, 0x52, 0xda, 0xac, 0xdb, 0xc3, 0x3b, 0x43, 0xaa, 0x44, 0xbf, 0x0e, 0x45, 0x3d,
+	0x40, 0x1d, 0xea, 0xba, 0x11, 0xe3, 0x5c, 0xa5, 0x3b, 0x6f, 0x17, 0x34, 0x75, 0x4b, 0x13, 0xab,
+	0xbf, 0x37, 0xa0, 0x9c, 0xe4, 0x05, 0xa3, 0xf9, 0xd8, 0x00, 0x82, 0xa6, 0xa2, 0x21, 0x1b, 0x23,
+	0xbb, 0x95, 0x14, 0xd9, 0xb8, 0xbd, 0xc1, 0x80, 0x7d, 0x0d, 0x27, 0xd8, 0xc5, 0x24, 0x09, 0x6e,
+	0xaf, 0x84, 0xe3, 0x60, 0xaa, 0xff, 0x36, 0x60, 0x75, 0xc2, 0xab, 0x89, 0x5c, 0x3f, 0x91, 0x83,
+	0xed, 0xc5, 0xe3, 0x67, 0x95, 0x9c, 0x1e, 0x8f, 0x6f, 0xef, 0x4c, 0x9d, 0x91, 0x61, 0xa3, 0xce,
+	0xbe, 0x4a, 0xa3, 0xde, 0x87, 0x0c, 0x3b, 0xec, 0x7a, 0xd1, 0xd1, 0x54, 0xf5, 0x85, 0x3a, 0xd5,
+	0x9f, 0x19, 0x50, 0x9a, 0x74, 0x9b, 0x98, 0x26, 0xdc, 0x41, 0x1c, 0xe7, 0x5e, 0x21, 0x8e, 0xea,
+	0x1f, 0xb2, 0x50, 0x8c, 0xbf, 0x09, 0xa7, 0xc1, 0x70, 0x05, 0x60, 0x97, 0x72, 0xe6, 0x50, 0xce,
+	0x99, 0xc0, 0x74, 0xe7, 0x25, 0x65, 0x4b, 0x12, 0x48, 0x05, 0x16, 0x9e, 0xf6, 0x42, 0xd1, 0xe7,
+	0xab, 0x84, 0xdb, 0xa0, 0x48, 0x5a, 0x60, 0xe4, 0x52, 0x30, 0x17, 0xbb, 0x14, 0xc8, 0xb7, 0x14,
+	0x6d, 0x0a, 0x6f, 0x9f, 0x99, 0xf3, 0x6b, 0x46, 0x2d, 0x67, 0xe3, 0x13, 0xb1, 0x61, 0x99, 0xb6,
+	0x5a, 0x11, 0x6b, 0xa9, 0xba, 0x71, 0xfc, 0xd0, 0x65, 0x66, 0x66, 0xcd, 0xa8, 0x15, 0x37, 0xaf,
+	0x25, 0x55, 0xea, 0xd6, 0x50, 0xfe, 0x51, 0xe8, 0x32, 0x7b, 0x89, 0xc6, 0x09, 0xe4, 0x3d, 0x28,
+	0x88, 0xc8, 0xf3, 0x9d, 0xbd, 0x48, 0x3a, 0x09, 0x03, 0x33, 0x7b, 0xa6, 0x8c, 0x2e, 0x4a, 0x23,
+	0x6f, 0xa1, 0x0d, 0xb2, 0x03, 0x0b, 0xe2, 0x80, 0x76, 0x1d, 0x7c, 0x5d, 0xe5, 0xd2, 0xcf, 0x42,
+	0x90, 0x7a, 0x3f, 0xd0, 0xaf, 0xac, 0x1a, 0x2c, 0xfb, 0x5e, 0xe0, 0x60, 0x5d, 0x77, 0x43, 0x2e,
+	0xb8, 0x99, 0x5f, 0x33, 0x6a, 0x05, 0xbb, 0xe8, 0x7b, 0x81, 0xee, 0x30, 0xd9, 0x36, 0x9c, 0xfc,
+	0x08, 0x56, 0x7d, 0x7a, 0xa8, 0x6f, 0x88, 0x8e, 0xcb, 0xf6, 0x3d, 0x65, 0xd6, 0x84, 0x33, 0x85,
+	0xb2, 0xe2, 0xd3, 0x43, 0x55, 0x98, 0x3b, 0x7d, 0x43, 0xe4, 0x16, 0x94, 0xb4, 0xed, 0xb6, 0xc7,
+	0x45, 0x18, 0x1d, 0x39, 0x1d, 0x16, 0xb4, 0x44, 0xdb, 0x5c, 0x50, 0x68, 0x88, 0xe2, 0x3d, 0xd4,
+	0xac, 0x77, 0x14, 0x87, 0x74, 0xc0, 0x42, 0xdc, 0x03, 0x38, 0x8e, 0x68, 0x47, 0x8c, 0xb7, 0xc3,
+	0x8e, 0x6b, 0x2e, 0x9e, 0x09, 0x98, 0xa9, 0x2d, 0x0e, 0x60, 0x3d, 0xee, 0xdb, 0x23, 0xeb, 0x40,
+	0x64, 0xfc, 0xbe, 0xc7, 0x39, 0x73, 0x31, 0xeb, 0xdc, 0x2c, 0x28, 0x74, 0xcb, 0x3e, 0x3d, 0x7c,
+	0xa4, 0x18, 0x3a, 0xad, 0x9c, 0xd4, 0x75, 0xb6, 0x14, 0xb0, 0x40, 0x0c, 0xc4, 0x8b, 0x4a, 0x5c,
+	0x46, 0xbf, 0xa3, 0x39, 0x7d, 0xf9, 0xef, 0x00, 0xb8, 0x2c, 0xf2, 0xf6, 0x75, 0x52, 0x97, 0xd4,
+	0x61, 0xd6, 0x4e, 0xbf, 0xce, 0xed, 0x0c, 0xe4, 0x71, 0xfc, 0x8f, 0x58, 0x90, 0xd9, 0x94, 0x37,
+	0x43, 0x27, 0x62, 0xcd, 0x70, 0x9f, 0x45, 0x47, 0x4e, 0x14, 0xf6, 0x02, 0x97, 0x9b, 0xcb, 0x3a,
+	0x9b, 0x92, 0x67, 0x23, 0xcb, 0x56, 0x9c, 0xea, 0x5f, 0xe7, 0xc1, 0x4c, 0x9a, 0xa8, 0xff, 0x87,
+	0x29, 0x79, 0x05, 0x40, 0x56, 0x9b, 0xd3, 0x0c, 0x7b, 0x81, 0xee, 0xdc, 0x39, 0x3b, 0x2f, 0x29,
+	0x0f, 0x24, 0x81, 0xbc, 0x03, 0x4b, 0x7b, 0x5e, 0xc4, 0x85, 0x2a, 0x49, 0xe6, 0x3a, 0x54, 0x4c,
+	0x35, 0x07, 0x0b, 0x4a, 0x59, 0xcf, 0xfb, 0x2d, 0x41, 0xbe, 0x0d, 0xc5, 0x0e, 0x8d, 0x19, 0x9b,
+	0x9f, 0xc2, 0xd8, 0x62, 0x87, 0x8e, 0xd8, 0x32, 0x21, 0xdb, 0x3f, 0xcd, 0x8c, 0x42, 0xdd, 0x7f,
+	0x94, 0x91, 0x8f, 0x55, 0x47, 0x56, 0x09, 0x14, 0xfc, 0x58, 0x69, 0xdc, 0x07, 0xab, 0x29, 0x93,
+	0xda, 0xec, 0xc9, 0x81, 0x33, 0x5e, 0x50, 0x39, 0x75, 0x40, 0xe6, 0x88, 0x44, 0xbc, 0xb0, 0xae,
+	0xc1, 0xd2, 0x78, 0x51, 0xe5, 0x95, 0x97, 0xa2, 0x1b, 0xaf, 0xa8, 0x37, 0xe1, 0xd2, 0xa8, 0x9b,
+	0x71, 0x25, 0x50, 0x7e, 0x2e, 0x8e, 0x88, 0x8c, 0x55, 0xe4, 0xfb, 0x50, 0xf4, 0x19, 0x0d, 0x46,
+	0x5a, 0x7d, 0xe1, 0x4c, 0x1d, 0x55, 0x90, 0x56, 0x86, 0x6d, 0xfe, 0x3e, 0x1e, 0xc5, 0xd0, 0xec,
+	0xd9, 0x1a, 0xb5, 0x20, 0xad, 0x0c, 0xcd, 0x5a, 0x90, 0x63, 0x87, 0xcd, 0x4e, 0xcf, 0x65, 0xae,
+	0xea, 0xc9, 0x9c, 0x3d, 0x78, 0xde, 0xfc, 0xb2, 0x00, 0xf3, 0xea, 0x8a, 0x42, 0x7e, 0x6e, 0x40,
+	0x46, 0xaf, 0x24, 0xc8, 0x8d, 0xa4, 0xe6, 0x3a, 0xb9, 0x05, 0xb1, 0x6e, 0xa6, 0x92, 0xd5, 0xad,
+	0x52, 0xbd, 0xfa, 0xf1, 0x97, 0xff, 0xfc, 0xd5, 0xb9, 0x35, 0x52, 0x6e, 0x24, 0xac, 0x7a, 0xf4,
+	0x16, 0x84, 0xfc, 0xd2, 0x80, 0x79, 0x35, 0x02, 0xc9, 0xf5, 0xd3, 0xcd, 0x8f, 0xec, 0x47, 0xac,
+	0x1b, 0x69, 0x44, 0x11, 0xc8, 0xa6, 0x02, 0xb2, 0x4e, 0x6e, 0x24, 0x02, 0x91, 0x14, 0xde, 0xf8,
+	0x68, 0xd0, 0xd9, 0x3f, 0xd6, 0x09, 0x52, 0x64, 0x92, 0xc2, 0x55, 0xda, 0x04, 0xc5, 0x56, 0x0d,
+	0x29, 0x12, 0xa4, 0x01, 0xfc, 0xce, 0x80, 0xfc, 0x60, 0x51, 0x41, 0x36, 0x4e, 0x75, 0x31, 0xbe,
+	0x0d, 0xb1, 0xea, 0x69, 0xc5, 0x11, 0xd4, 0x1b, 0x0a, 0x54, 0x83, 0x6c, 0x24, 0x81, 0x8a, 0xe8,
+	0xc1, 0x84, 0x7c, 0xfd, 0xc6, 0x80, 0x2c, 0x2e, 0x22, 0xc8, 0xe9, 0x49, 0x88, 0x2f, 0x3a, 0xac,
+	0xf5, 0x74, 0xc2, 0x88, 0xee, 0x8e, 0x42, 0xb7, 0x41, 0x6e, 0x26, 0xa1, 0xc3, 0x5b, 0x4d, 0x0c,
+	0xdb, 0x2f, 0x0c, 0xc8, 0xe2, 0xde, 0xe0, 0x25, 0xd8, 0xe2, 0xbb, 0x07, 0x6b, 0x3d, 0x9d, 0x30,
+	0x62, 0xbb, 0xa6, 0xb0, 0xbd, 0x46, 0x2a, 0x49, 0xd8, 0x7c, 0xc4, 0xf0, 0x99, 0x01, 0xc5, 0xf8,
+	0x3a, 0x83, 0x6c, 0x9e, 0xea, 0x69, 0xe2, 0x66, 0xc4, 0xba, 0x33, 0x95, 0x0e, 0x82, 0x6c, 0x28,
+	0x90, 0xd7, 0xc9, 0xb5, 0x24, 0x90, 0x7b, 0x5a, 0xcf, 0xe9, 0x83, 0xfd, 0x8b, 0x01, 0x85, 0xd8,
+	0x8a, 0x82, 0xdc, 0x7e, 0x79, 0x8d, 0x8f, 0xad, 0x49, 0xac, 0xcd, 0x69, 0x54, 0x10, 0xe9, 0xb6,
+	0x42, 0x7a, 0x9f, 0xdc, 0x4b, 0xdf, 0xb5, 0x0d, 0xbd, 0x60, 0x69, 0x7c, 0xa4, 0x7f, 0xd5, 0xc9,
+	0xcf, 0xc9, 0xdd, 0x01, 0xa9, 0x9d, 0x0a, 0x60, 0x64, 0x29, 0x62, 0x5d, 0x4f, 0x21, 0x89, 0x08,
+	0x6f, 0x29, 0x84, 0x37, 0x48, 0x2d, 0x09, 0xa1, 0xbc, 0x5e, 0x9e, 0xe8, 0x12, 0xfc, 0xc4, 0x7d,
+	0x49, 0x25, 0xc6, 0x37, 0x01, 0xd6, 0x7a, 0x3a, 0xe1, 0xb4, 0x5d, 0x82, 0x9f, 0xc7, 0x31, 0x6c,
+	0x7f, 0x36, 0x60, 0xe5, 0xc4, 0xa7, 0x2b, 0x79, 0x23, 0x45, 0x7b, 0x9e, 0xfc, 0xa0, 0xb6, 0xee,
+	0x4e, 0xab, 0x96, 0x76, 0x54, 0x9f, 0xfc, 0x7c, 0xde, 0x7e, 0xf7, 0xf9, 0x3f, 0xca, 0xc6, 0x1f,
+	0x8f, 0xcb, 0xc6, 0xe7, 0xc7, 0x65, 0xe3, 0x8b, 0xe3, 0xb2, 0xf1, 0xfc, 0xb8, 0x6c, 0x7c, 0xfa,
+	0xa2, 0x3c, 0xf3, 0xc5, 0x8b, 0xf2, 0xcc, 0xdf, 0x5e, 0x94, 0x67, 0x3e, 0x6c, 0x8c, 0xbc, 0x4a,
+	0xbb, 0x2c, 0x6a, 0x86, 0xdc, 0xe3, 0x1b, 0x1d, 0xba, 0xcb, 0xb5, 0x97, 0xc3, 0x11, 0x3f, 0xea,
+	0xbd, 0xba, 0x9b, 0x51, 0xd7, 0xa0, 0x3b, 0xff, 0x1d, 0x00, 0x4c, 0x9f, 0xe4, 0xe8, 0xe1, 0x18,
+	0x00, 0x00,
 }
 
 func (this *QueryParamsRequest) VerboseEqual(that interface{}) error {
@@ -1430,7 +2113,7 @@ func (this *QueryMarketsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
+func (this *QueryFlaggedMarketsRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1438,45 +2121,33 @@ func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PostedPriceResponse)
+	that1, ok := that.(*QueryFlaggedMarketsRequest)
 	if !ok {
-		that2, ok := that.(PostedPriceResponse)
+		that2, ok := that.(QueryFlaggedMarketsRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *PostedPriceResponse")
+			return fmt.Errorf("that is not of type *QueryFlaggedMarketsRequest")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *PostedPriceResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *QueryFlaggedMarketsRequest but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPriceResponse but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if this.OracleAddress != that1.OracleAddress {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
-	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+		return fmt.Errorf("that is type *QueryFlaggedMarketsRequest but is not nil && this == nil")
 	}
 	return nil
 }
-func (this *PostedPriceResponse) Equal(that interface{}) bool {
+func (this *QueryFlaggedMarketsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PostedPriceResponse)
+	that1, ok := that.(*QueryFlaggedMarketsRequest)
 	if !ok {
-		that2, ok := that.(PostedPriceResponse)
+		that2, ok := that.(QueryFlaggedMarketsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1488,21 +2159,9 @@ func (this *PostedPriceResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MarketID != that1.MarketID {
-		return false
-	}
-	if this.OracleAddress != that1.OracleAddress {
-		return false
-	}
-	if !this.Price.Equal(that1.Price) {
-		return false
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return false
-	}
 	return true
 }
-func (this *CurrentPriceResponse) VerboseEqual(that interface{}) error {
+func (this *QueryFlaggedMarketsResponse) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1510,39 +2169,41 @@ func (this *CurrentPriceResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*CurrentPriceResponse)
+	that1, ok := that.(*QueryFlaggedMarketsResponse)
 	if !ok {
-		that2, ok := that.(CurrentPriceResponse)
+		that2, ok := that.(QueryFlaggedMarketsResponse)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *CurrentPriceResponse")
+			return fmt.Errorf("that is not of type *QueryFlaggedMarketsResponse")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *CurrentPriceResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *QueryFlaggedMarketsResponse but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *CurrentPriceResponse but is not nil && this == nil")
+		return fmt.Errorf("that is type *QueryFlaggedMarketsResponse but is not nil && this == nil")
 	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	if len(this.MarketFlags) != len(that1.MarketFlags) {
+		return fmt.Errorf("MarketFlags this(%v) Not Equal that(%v)", len(this.MarketFlags), len(that1.MarketFlags))
 	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	for i := range this.MarketFlags {
+		if !this.MarketFlags[i].Equal(&that1.MarketFlags[i]) {
+			return fmt.Errorf("MarketFlags this[%v](%v) Not Equal that[%v](%v)", i, this.MarketFlags[i], i, that1.MarketFlags[i])
+		}
 	}
 	return nil
 }
-func (this *CurrentPriceResponse) Equal(that interface{}) bool {
+func (this *QueryFlaggedMarketsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CurrentPriceResponse)
+	that1, ok := that.(*QueryFlaggedMarketsResponse)
 	if !ok {
-		that2, ok := that.(CurrentPriceResponse)
+		that2, ok := that.(QueryFlaggedMarketsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1554,15 +2215,17 @@ func (this *CurrentPriceResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MarketID != that1.MarketID {
+	if len(this.MarketFlags) != len(that1.MarketFlags) {
 		return false
 	}
-	if !this.Price.Equal(that1.Price) {
-		return false
+	for i := range this.MarketFlags {
+		if !this.MarketFlags[i].Equal(&that1.MarketFlags[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *MarketResponse) VerboseEqual(that interface{}) error {
+func (this *QueryPriceAtHeightRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1570,53 +2233,39 @@ func (this *MarketResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*MarketResponse)
+	that1, ok := that.(*QueryPriceAtHeightRequest)
 	if !ok {
-		that2, ok := that.(MarketResponse)
+		that2, ok := that.(QueryPriceAtHeightRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *MarketResponse")
+			return fmt.Errorf("that is not of type *QueryPriceAtHeightRequest")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *MarketResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *QueryPriceAtHeightRequest but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *MarketResponse but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if this.BaseAsset != that1.BaseAsset {
-		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
+		return fmt.Errorf("that is type *QueryPriceAtHeightRequest but is not nil && this == nil")
 	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
-	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
-	}
-	for i := range this.Oracles {
-		if this.Oracles[i] != that1.Oracles[i] {
-			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
-		}
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
 	}
-	if this.Active != that1.Active {
-		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	if this.Height != that1.Height {
+		return fmt.Errorf("Height this(%v) Not Equal that(%v)", this.Height, that1.Height)
 	}
 	return nil
 }
-func (this *MarketResponse) Equal(that interface{}) bool {
+func (this *QueryPriceAtHeightRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MarketResponse)
+	that1, ok := that.(*QueryPriceAtHeightRequest)
 	if !ok {
-		that2, ok := that.(MarketResponse)
+		that2, ok := that.(QueryPriceAtHeightRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1628,1059 +2277,4409 @@ func (this *MarketResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MarketID != that1.MarketID {
+	if this.MarketId != that1.MarketId {
 		return false
 	}
-	if this.BaseAsset != that1.BaseAsset {
+	if this.Height != that1.Height {
 		return false
 	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return false
+	return true
+}
+func (this *QueryPriceAtHeightResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return false
+
+	that1, ok := that.(*QueryPriceAtHeightResponse)
+	if !ok {
+		that2, ok := that.(QueryPriceAtHeightResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryPriceAtHeightResponse")
+		}
 	}
-	for i := range this.Oracles {
-		if this.Oracles[i] != that1.Oracles[i] {
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryPriceAtHeightResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryPriceAtHeightResponse but is not nil && this == nil")
+	}
+	if !this.Snapshot.Equal(&that1.Snapshot) {
+		return fmt.Errorf("Snapshot this(%v) Not Equal that(%v)", this.Snapshot, that1.Snapshot)
+	}
+	return nil
+}
+func (this *QueryPriceAtHeightResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryPriceAtHeightResponse)
+	if !ok {
+		that2, ok := that.(QueryPriceAtHeightResponse)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.Active != that1.Active {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Snapshot.Equal(&that1.Snapshot) {
 		return false
 	}
 	return true
 }
+func (this *QueryTwapRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the pricefeed module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Price queries price details based on a market
-	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
-	// Prices queries all prices
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
-	// RawPrices queries all raw prices based on a market
-	RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error)
-	// Oracles queries all oracles based on a market
-	Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error)
-	// Markets queries all markets
-	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryTwapRequest)
+	if !ok {
+		that2, ok := that.(QueryTwapRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryTwapRequest")
+		}
 	}
-	return out, nil
-}
-
-func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
-	out := new(QueryPriceResponse)
-	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Price", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryTwapRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryTwapRequest but is not nil && this == nil")
 	}
-	return out, nil
-}
-
-func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
-	out := new(QueryPricesResponse)
-	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Prices", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
 	}
-	return out, nil
-}
-
-func (c *queryClient) RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error) {
-	out := new(QueryRawPricesResponse)
-	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/RawPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
 	}
-	return out, nil
-}
-
-func (c *queryClient) Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error) {
-	out := new(QueryOraclesResponse)
-	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Oracles", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.EndTime.Equal(that1.EndTime) {
+		return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", this.EndTime, that1.EndTime)
 	}
-	return out, nil
+	return nil
 }
-
-func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
-	out := new(QueryMarketsResponse)
-	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Markets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *QueryTwapRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the pricefeed module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Price queries price details based on a market
-	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
-	// Prices queries all prices
-	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
-	// RawPrices queries all raw prices based on a market
-	RawPrices(context.Context, *QueryRawPricesRequest) (*QueryRawPricesResponse, error)
-	// Oracles queries all oracles based on a market
-	Oracles(context.Context, *QueryOraclesRequest) (*QueryOraclesResponse, error)
-	// Markets queries all markets
-	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
-}
-func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
-}
-func (*UnimplementedQueryServer) RawPrices(ctx context.Context, req *QueryRawPricesRequest) (*QueryRawPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RawPrices not implemented")
-}
-func (*UnimplementedQueryServer) Oracles(ctx context.Context, req *QueryOraclesRequest) (*QueryOraclesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Oracles not implemented")
-}
-func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
-}
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryTwapRequest)
+	if !ok {
+		that2, ok := that.(QueryTwapRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.pricefeed.v1beta1.Query/Params",
+	if this.MarketId != that1.MarketId {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	if this.Window != that1.Window {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
 }
+func (this *QueryTwapResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryTwapResponse)
+	if !ok {
+		that2, ok := that.(QueryTwapResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryTwapResponse")
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Price(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryTwapResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryTwapResponse but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.pricefeed.v1beta1.Query/Price",
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+	if !this.StartTime.Equal(that1.StartTime) {
+		return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", this.StartTime, that1.StartTime)
 	}
-	return interceptor(ctx, in, info, handler)
+	if !this.EndTime.Equal(that1.EndTime) {
+		return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", this.EndTime, that1.EndTime)
+	}
+	return nil
 }
+func (this *QueryTwapResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryTwapResponse)
+	if !ok {
+		that2, ok := that.(QueryTwapResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Prices(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.pricefeed.v1beta1.Query/Prices",
+	if !this.Price.Equal(that1.Price) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
 }
+func (this *QueryCandlesRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Query_RawPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRawPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryCandlesRequest)
+	if !ok {
+		that2, ok := that.(QueryCandlesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryCandlesRequest")
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RawPrices(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryCandlesRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryCandlesRequest but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.pricefeed.v1beta1.Query/RawPrices",
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RawPrices(ctx, req.(*QueryRawPricesRequest))
+	if this.Interval != that1.Interval {
+		return fmt.Errorf("Interval this(%v) Not Equal that(%v)", this.Interval, that1.Interval)
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Query_Oracles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOraclesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if !this.StartTime.Equal(that1.StartTime) {
+		return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", this.StartTime, that1.StartTime)
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Oracles(ctx, in)
+	if !this.EndTime.Equal(that1.EndTime) {
+		return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", this.EndTime, that1.EndTime)
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.pricefeed.v1beta1.Query/Oracles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Oracles(ctx, req.(*QueryOraclesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
+func (this *QueryCandlesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryCandlesRequest)
+	if !ok {
+		that2, ok := that.(QueryCandlesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Markets(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fury.pricefeed.v1beta1.Query/Markets",
+	if this.MarketId != that1.MarketId {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
+	if this.Interval != that1.Interval {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.pricefeed.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Price",
-			Handler:    _Query_Price_Handler,
-		},
-		{
-			MethodName: "Prices",
-			Handler:    _Query_Prices_Handler,
-		},
-		{
-			MethodName: "RawPrices",
-			Handler:    _Query_RawPrices_Handler,
-		},
-		{
-			MethodName: "Oracles",
-			Handler:    _Query_Oracles_Handler,
-		},
-		{
-			MethodName: "Markets",
-			Handler:    _Query_Markets_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/pricefeed/v1beta1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (this *QueryCandlesResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryCandlesResponse)
+	if !ok {
+		that2, ok := that.(QueryCandlesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryCandlesResponse")
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryCandlesResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryCandlesResponse but is not nil && this == nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Candles) != len(that1.Candles) {
+		return fmt.Errorf("Candles this(%v) Not Equal that(%v)", len(this.Candles), len(that1.Candles))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i := range this.Candles {
+		if !this.Candles[i].Equal(&that1.Candles[i]) {
+			return fmt.Errorf("Candles this[%v](%v) Not Equal that[%v](%v)", i, this.Candles[i], i, that1.Candles[i])
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
+func (this *QueryCandlesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryCandlesResponse)
+	if !ok {
+		that2, ok := that.(QueryCandlesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Candles) != len(that1.Candles) {
+		return false
+	}
+	for i := range this.Candles {
+		if !this.Candles[i].Equal(&that1.Candles[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *QueryOracleReputationsRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryOracleReputationsRequest)
+	if !ok {
+		that2, ok := that.(QueryOracleReputationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryOracleReputationsRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryOracleReputationsRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryOracleReputationsRequest but is not nil && this == nil")
+	}
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	return nil
 }
+func (this *QueryOracleReputationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryOracleReputationsRequest)
+	if !ok {
+		that2, ok := that.(QueryOracleReputationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return false
+	}
+	return true
 }
-
-func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (this *QueryOracleReputationsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryRawPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryOracleReputationsResponse)
+	if !ok {
+		that2, ok := that.(QueryOracleReputationsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryOracleReputationsResponse")
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRawPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRawPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryOracleReputationsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryOracleReputationsResponse but is not nil && this == nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryRawPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.OracleReputations) != len(that1.OracleReputations) {
+		return fmt.Errorf("OracleReputations this(%v) Not Equal that(%v)", len(this.OracleReputations), len(that1.OracleReputations))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRawPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRawPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RawPrices) > 0 {
-		for iNdEx := len(m.RawPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	for i := range this.OracleReputations {
+		if !this.OracleReputations[i].Equal(&that1.OracleReputations[i]) {
+			return fmt.Errorf("OracleReputations this[%v](%v) Not Equal that[%v](%v)", i, this.OracleReputations[i], i, that1.OracleReputations[i])
 		}
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-
-func (m *QueryOraclesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *QueryOracleReputationsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOraclesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *QueryOraclesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*QueryOracleReputationsResponse)
+	if !ok {
+		that2, ok := that.(QueryOracleReputationsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryOraclesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOraclesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOraclesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if len(this.OracleReputations) != len(that1.OracleReputations) {
+		return false
+	}
+	for i := range this.OracleReputations {
+		if !this.OracleReputations[i].Equal(&that1.OracleReputations[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
 
-func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*PostedPriceResponse)
+	if !ok {
+		that2, ok := that.(PostedPriceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PostedPriceResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PostedPriceResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PostedPriceResponse but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	return nil
 }
+func (this *PostedPriceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*PostedPriceResponse)
+	if !ok {
+		that2, ok := that.(PostedPriceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PostedPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PostedPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
 }
+func (this *CurrentPriceResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *PostedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err3 != nil {
-		return 0, err3
+	that1, ok := that.(*CurrentPriceResponse)
+	if !ok {
+		that2, ok := that.(CurrentPriceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CurrentPriceResponse")
+		}
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return fmt.Errorf("that is type *CurrentPriceResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CurrentPriceResponse but is not nil && this == nil")
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-
-func (m *CurrentPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CurrentPriceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CurrentPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *CurrentPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*CurrentPriceResponse)
+	if !ok {
+		that2, ok := that.(CurrentPriceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MarketID != that1.MarketID {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
 }
+func (this *MarketResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
+	that1, ok := that.(*MarketResponse)
+	if !ok {
+		that2, ok := that.(MarketResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return fmt.Errorf("that is not of type *MarketResponse")
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *MarketResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MarketResponse but is not nil && this == nil")
 	}
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+	if this.BaseAsset != that1.BaseAsset {
+		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if this.QuoteAsset != that1.QuoteAsset {
+		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(this.Oracles) != len(that1.Oracles) {
+		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Oracles {
+		if this.Oracles[i] != that1.Oracles[i] {
+			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Active != that1.Active {
+		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.AggregationMode != that1.AggregationMode {
+		return fmt.Errorf("AggregationMode this(%v) Not Equal that(%v)", this.AggregationMode, that1.AggregationMode)
 	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return fmt.Errorf("TrimFraction this(%v) Not Equal that(%v)", this.TrimFraction, that1.TrimFraction)
 	}
-	return n
-}
-
-func (m *QueryPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.TwapWindow != that1.TwapWindow {
+		return fmt.Errorf("TwapWindow this(%v) Not Equal that(%v)", this.TwapWindow, that1.TwapWindow)
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.MinOraclePosts != that1.MinOraclePosts {
+		return fmt.Errorf("MinOraclePosts this(%v) Not Equal that(%v)", this.MinOraclePosts, that1.MinOraclePosts)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return fmt.Errorf("MaxPriceDeviation this(%v) Not Equal that(%v)", this.MaxPriceDeviation, that1.MaxPriceDeviation)
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if this.PriceHistoryLength != that1.PriceHistoryLength {
+		return fmt.Errorf("PriceHistoryLength this(%v) Not Equal that(%v)", this.PriceHistoryLength, that1.PriceHistoryLength)
 	}
-	return n
-}
-
-func (m *QueryRawPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.OracleDeviationThreshold.Equal(that1.OracleDeviationThreshold) {
+		return fmt.Errorf("OracleDeviationThreshold this(%v) Not Equal that(%v)", this.OracleDeviationThreshold, that1.OracleDeviationThreshold)
 	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return fmt.Errorf("MaxMissedWindows this(%v) Not Equal that(%v)", this.MaxMissedWindows, that1.MaxMissedWindows)
 	}
-	return n
-}
-
-func (m *QueryRawPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.MaxDeviantWindows != that1.MaxDeviantWindows {
+		return fmt.Errorf("MaxDeviantWindows this(%v) Not Equal that(%v)", this.MaxDeviantWindows, that1.MaxDeviantWindows)
 	}
-	var l int
-	_ = l
-	if len(m.RawPrices) > 0 {
-		for _, e := range m.RawPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if !this.Derivation.Equal(&that1.Derivation) {
+		return fmt.Errorf("Derivation this(%v) Not Equal that(%v)", this.Derivation, that1.Derivation)
 	}
-	return n
+	if this.FlagRecoveryRounds != that1.FlagRecoveryRounds {
+		return fmt.Errorf("FlagRecoveryRounds this(%v) Not Equal that(%v)", this.FlagRecoveryRounds, that1.FlagRecoveryRounds)
+	}
+	return nil
 }
+func (this *MarketResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryOraclesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MarketResponse)
+	if !ok {
+		that2, ok := that.(MarketResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *QueryOraclesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.MarketID != that1.MarketID {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Oracles) > 0 {
-		for _, s := range m.Oracles {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if this.BaseAsset != that1.BaseAsset {
+		return false
 	}
-	return n
-}
-
-func (m *QueryMarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.QuoteAsset != that1.QuoteAsset {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Oracles) != len(that1.Oracles) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	for i := range this.Oracles {
+		if this.Oracles[i] != that1.Oracles[i] {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *PostedPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Active != that1.Active {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if this.AggregationMode != that1.AggregationMode {
+		return false
 	}
-	l = len(m.OracleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return false
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *CurrentPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.TwapWindow != that1.TwapWindow {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if this.MinOraclePosts != that1.MinOraclePosts {
+		return false
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *MarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if this.PriceHistoryLength != that1.PriceHistoryLength {
+		return false
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if !this.OracleDeviationThreshold.Equal(that1.OracleDeviationThreshold) {
+		return false
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return false
 	}
-	if len(m.Oracles) > 0 {
-		for _, s := range m.Oracles {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if this.MaxDeviantWindows != that1.MaxDeviantWindows {
+		return false
 	}
-	if m.Active {
-		n += 2
+	if !this.Derivation.Equal(&that1.Derivation) {
+		return false
 	}
-	return n
+	if this.FlagRecoveryRounds != that1.FlagRecoveryRounds {
+		return false
+	}
+	return true
 }
+func (this *OracleReputationResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	that1, ok := that.(*OracleReputationResponse)
+	if !ok {
+		that2, ok := that.(OracleReputationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleReputationResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleReputationResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleReputationResponse but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.PostCount != that1.PostCount {
+		return fmt.Errorf("PostCount this(%v) Not Equal that(%v)", this.PostCount, that1.PostCount)
+	}
+	if !this.FirstPostedAt.Equal(that1.FirstPostedAt) {
+		return fmt.Errorf("FirstPostedAt this(%v) Not Equal that(%v)", this.FirstPostedAt, that1.FirstPostedAt)
+	}
+	if !this.LastPostedAt.Equal(that1.LastPostedAt) {
+		return fmt.Errorf("LastPostedAt this(%v) Not Equal that(%v)", this.LastPostedAt, that1.LastPostedAt)
+	}
+	if this.Windows != that1.Windows {
+		return fmt.Errorf("Windows this(%v) Not Equal that(%v)", this.Windows, that1.Windows)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.ConsecutiveMissedWindows != that1.ConsecutiveMissedWindows {
+		return fmt.Errorf("ConsecutiveMissedWindows this(%v) Not Equal that(%v)", this.ConsecutiveMissedWindows, that1.ConsecutiveMissedWindows)
+	}
+	if this.DeviantWindows != that1.DeviantWindows {
+		return fmt.Errorf("DeviantWindows this(%v) Not Equal that(%v)", this.DeviantWindows, that1.DeviantWindows)
+	}
+	if this.ConsecutiveDeviantWindows != that1.ConsecutiveDeviantWindows {
+		return fmt.Errorf("ConsecutiveDeviantWindows this(%v) Not Equal that(%v)", this.ConsecutiveDeviantWindows, that1.ConsecutiveDeviantWindows)
+	}
+	if !this.MeanDeviation.Equal(that1.MeanDeviation) {
+		return fmt.Errorf("MeanDeviation this(%v) Not Equal that(%v)", this.MeanDeviation, that1.MeanDeviation)
+	}
+	if !this.LastDeviation.Equal(that1.LastDeviation) {
+		return fmt.Errorf("LastDeviation this(%v) Not Equal that(%v)", this.LastDeviation, that1.LastDeviation)
+	}
+	if this.Excluded != that1.Excluded {
+		return fmt.Errorf("Excluded this(%v) Not Equal that(%v)", this.Excluded, that1.Excluded)
+	}
+	return nil
+}
+func (this *OracleReputationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleReputationResponse)
+	if !ok {
+		that2, ok := that.(OracleReputationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return false
+	}
+	if this.PostCount != that1.PostCount {
+		return false
+	}
+	if !this.FirstPostedAt.Equal(that1.FirstPostedAt) {
+		return false
+	}
+	if !this.LastPostedAt.Equal(that1.LastPostedAt) {
+		return false
+	}
+	if this.Windows != that1.Windows {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.ConsecutiveMissedWindows != that1.ConsecutiveMissedWindows {
+		return false
+	}
+	if this.DeviantWindows != that1.DeviantWindows {
+		return false
+	}
+	if this.ConsecutiveDeviantWindows != that1.ConsecutiveDeviantWindows {
+		return false
+	}
+	if !this.MeanDeviation.Equal(that1.MeanDeviation) {
+		return false
+	}
+	if !this.LastDeviation.Equal(that1.LastDeviation) {
+		return false
+	}
+	if this.Excluded != that1.Excluded {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the pricefeed module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Price queries price details based on a market
+	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// Prices queries all prices
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// RawPrices queries all raw prices based on a market
+	RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error)
+	// Oracles queries all oracles based on a market
+	Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error)
+	// Markets queries all markets
+	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// FlaggedMarkets queries the markets flagged for a price moving more than their max price deviation
+	FlaggedMarkets(ctx context.Context, in *QueryFlaggedMarketsRequest, opts ...grpc.CallOption) (*QueryFlaggedMarketsResponse, error)
+	// PriceAtHeight queries the current price of a market at a past block, from the market's price history
+	PriceAtHeight(ctx context.Context, in *QueryPriceAtHeightRequest, opts ...grpc.CallOption) (*QueryPriceAtHeightResponse, error)
+	// Twap queries the time-weighted average price of a market over a window, from the market's price history
+	Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error)
+	// Candles queries the open, high, low and close prices of a market over intervals, from the market's price history
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
+	// OracleReputations queries the posting records of oracles, and whether they are excluded from aggregation
+	OracleReputations(ctx context.Context, in *QueryOracleReputationsRequest, opts ...grpc.CallOption) (*QueryOracleReputationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Prices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error) {
+	out := new(QueryRawPricesResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/RawPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error) {
+	out := new(QueryOraclesResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Oracles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
+	out := new(QueryMarketsResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlaggedMarkets(ctx context.Context, in *QueryFlaggedMarketsRequest, opts ...grpc.CallOption) (*QueryFlaggedMarketsResponse, error) {
+	out := new(QueryFlaggedMarketsResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/FlaggedMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceAtHeight(ctx context.Context, in *QueryPriceAtHeightRequest, opts ...grpc.CallOption) (*QueryPriceAtHeightResponse, error) {
+	out := new(QueryPriceAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/PriceAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error) {
+	out := new(QueryTwapResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Twap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/Candles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleReputations(ctx context.Context, in *QueryOracleReputationsRequest, opts ...grpc.CallOption) (*QueryOracleReputationsResponse, error) {
+	out := new(QueryOracleReputationsResponse)
+	err := c.cc.Invoke(ctx, "/fury.pricefeed.v1beta1.Query/OracleReputations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the pricefeed module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Price queries price details based on a market
+	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// Prices queries all prices
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// RawPrices queries all raw prices based on a market
+	RawPrices(context.Context, *QueryRawPricesRequest) (*QueryRawPricesResponse, error)
+	// Oracles queries all oracles based on a market
+	Oracles(context.Context, *QueryOraclesRequest) (*QueryOraclesResponse, error)
+	// Markets queries all markets
+	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// FlaggedMarkets queries the markets flagged for a price moving more than their max price deviation
+	FlaggedMarkets(context.Context, *QueryFlaggedMarketsRequest) (*QueryFlaggedMarketsResponse, error)
+	// PriceAtHeight queries the current price of a market at a past block, from the market's price history
+	PriceAtHeight(context.Context, *QueryPriceAtHeightRequest) (*QueryPriceAtHeightResponse, error)
+	// Twap queries the time-weighted average price of a market over a window, from the market's price history
+	Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error)
+	// Candles queries the open, high, low and close prices of a market over intervals, from the market's price history
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
+	// OracleReputations queries the posting records of oracles, and whether they are excluded from aggregation
+	OracleReputations(context.Context, *QueryOracleReputationsRequest) (*QueryOracleReputationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
+func (*UnimplementedQueryServer) RawPrices(ctx context.Context, req *QueryRawPricesRequest) (*QueryRawPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawPrices not implemented")
+}
+func (*UnimplementedQueryServer) Oracles(ctx context.Context, req *QueryOraclesRequest) (*QueryOraclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Oracles not implemented")
+}
+func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (*UnimplementedQueryServer) FlaggedMarkets(ctx context.Context, req *QueryFlaggedMarketsRequest) (*QueryFlaggedMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlaggedMarkets not implemented")
+}
+func (*UnimplementedQueryServer) PriceAtHeight(ctx context.Context, req *QueryPriceAtHeightRequest) (*QueryPriceAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) Twap(ctx context.Context, req *QueryTwapRequest) (*QueryTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twap not implemented")
+}
+func (*UnimplementedQueryServer) Candles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
+}
+func (*UnimplementedQueryServer) OracleReputations(ctx context.Context, req *QueryOracleReputationsRequest) (*QueryOracleReputationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleReputations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Price",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Prices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Prices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RawPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRawPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RawPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/RawPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RawPrices(ctx, req.(*QueryRawPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Oracles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOraclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Oracles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Oracles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Oracles(ctx, req.(*QueryOraclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlaggedMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlaggedMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlaggedMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/FlaggedMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlaggedMarkets(ctx, req.(*QueryFlaggedMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/PriceAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAtHeight(ctx, req.(*QueryPriceAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Twap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Twap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Twap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twap(ctx, req.(*QueryTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/Candles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleReputationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.pricefeed.v1beta1.Query/OracleReputations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleReputations(ctx, req.(*QueryOracleReputationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.pricefeed.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Prices",
+			Handler:    _Query_Prices_Handler,
+		},
+		{
+			MethodName: "RawPrices",
+			Handler:    _Query_RawPrices_Handler,
+		},
+		{
+			MethodName: "Oracles",
+			Handler:    _Query_Oracles_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "FlaggedMarkets",
+			Handler:    _Query_FlaggedMarkets_Handler,
+		},
+		{
+			MethodName: "PriceAtHeight",
+			Handler:    _Query_PriceAtHeight_Handler,
+		},
+		{
+			MethodName: "Twap",
+			Handler:    _Query_Twap_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Query_Candles_Handler,
+		},
+		{
+			MethodName: "OracleReputations",
+			Handler:    _Query_OracleReputations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/pricefeed/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawPrices) > 0 {
+		for iNdEx := len(m.RawPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOraclesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOraclesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOraclesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOraclesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlaggedMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlaggedMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlaggedMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlaggedMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlaggedMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlaggedMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketFlags) > 0 {
+		for iNdEx := len(m.MarketFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleReputationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleReputationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleReputationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleReputationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleReputationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleReputationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleReputations) > 0 {
+		for iNdEx := len(m.OracleReputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleReputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostedPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostedPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlagRecoveryRounds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FlagRecoveryRounds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.Derivation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.MaxDeviantWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDeviantWindows))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxMissedWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMissedWindows))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.OracleDeviationThreshold.Size()
+		i -= size
+		if _, err := m.OracleDeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinOraclePosts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinOraclePosts))
+		i--
+		dAtA[i] = 0x48
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TrimFraction.Size()
+		i -= size
+		if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.AggregationMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AggregationMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Excluded {
+		i--
+		if m.Excluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.LastDeviation.Size()
+		i -= size
+		if _, err := m.LastDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MeanDeviation.Size()
+		i -= size
+		if _, err := m.MeanDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.ConsecutiveDeviantWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsecutiveDeviantWindows))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DeviantWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeviantWindows))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ConsecutiveMissedWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsecutiveMissedWindows))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Windows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Windows))
+		i--
+		dAtA[i] = 0x30
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastPostedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastPostedAt):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FirstPostedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstPostedAt):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.PostCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRawPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRawPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RawPrices) > 0 {
+		for _, e := range m.RawPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOraclesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOraclesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for _, s := range m.Oracles {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFlaggedMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFlaggedMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketFlags) > 0 {
+		for _, e := range m.MarketFlags {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryPriceAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleReputationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleReputationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleReputations) > 0 {
+		for _, e := range m.OracleReputations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PostedPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CurrentPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, s := range m.Oracles {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.AggregationMode != 0 {
+		n += 1 + sovQuery(uint64(m.AggregationMode))
+	}
+	l = m.TrimFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MinOraclePosts != 0 {
+		n += 1 + sovQuery(uint64(m.MinOraclePosts))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovQuery(uint64(m.PriceHistoryLength))
+	}
+	l = m.OracleDeviationThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxMissedWindows != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMissedWindows))
+	}
+	if m.MaxDeviantWindows != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDeviantWindows))
+	}
+	l = m.Derivation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FlagRecoveryRounds != 0 {
+		n += 2 + sovQuery(uint64(m.FlagRecoveryRounds))
+	}
+	return n
+}
+
+func (m *OracleReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PostCount != 0 {
+		n += 1 + sovQuery(uint64(m.PostCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstPostedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastPostedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Windows != 0 {
+		n += 1 + sovQuery(uint64(m.Windows))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovQuery(uint64(m.MissedWindows))
+	}
+	if m.ConsecutiveMissedWindows != 0 {
+		n += 1 + sovQuery(uint64(m.ConsecutiveMissedWindows))
+	}
+	if m.DeviantWindows != 0 {
+		n += 1 + sovQuery(uint64(m.DeviantWindows))
+	}
+	if m.ConsecutiveDeviantWindows != 0 {
+		n += 1 + sovQuery(uint64(m.ConsecutiveDeviantWindows))
+	}
+	l = m.MeanDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LastDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Excluded {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, CurrentPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawPrices = append(m.RawPrices, PostedPriceResponse{})
+			if err := m.RawPrices[len(m.RawPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, MarketResponse{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlaggedMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlaggedMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlaggedMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlaggedMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlaggedMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlaggedMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketFlags = append(m.MarketFlags, MarketFlag{})
+			if err := m.MarketFlags[len(m.MarketFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,25 +6689,156 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2730,7 +6860,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2753,15 +6883,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2788,7 +6918,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2813,7 +6944,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleReputationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,10 +6967,10 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleReputationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleReputationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2874,6 +7005,38 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2895,7 +7058,7 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleReputationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,15 +7081,15 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleReputationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleReputationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleReputations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2953,7 +7116,8 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleReputations = append(m.OracleReputations, OracleReputationResponse{})
+			if err := m.OracleReputations[len(m.OracleReputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2978,7 +7142,7 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3001,12 +7165,143 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostedPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3028,7 +7323,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3051,17 +7346,17 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3071,23 +7366,55 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, CurrentPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3112,7 +7439,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3135,15 +7462,15 @@ func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3171,61 +7498,180 @@ func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawPricesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMode", wireType)
+			}
+			m.AggregationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMode |= AggregationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,64 +7698,32 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawPrices = append(m.RawPrices, PostedPriceResponse{})
-			if err := m.RawPrices[len(m.RawPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOraclePosts", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MinOraclePosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOraclePosts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3337,61 +7751,32 @@ func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDeviationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3419,111 +7804,51 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.OracleDeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedWindows", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.MaxMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviantWindows", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MaxDeviantWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviantWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3550,11 +7875,29 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, MarketResponse{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Derivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlagRecoveryRounds", wireType)
+			}
+			m.FlagRecoveryRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlagRecoveryRounds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3576,7 +7919,7 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *OracleReputationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3599,10 +7942,10 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleReputationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3670,10 +8013,10 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			m.OracleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
 			}
-			var stringLen uint64
+			m.PostCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3683,29 +8026,14 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PostCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstPostedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3732,65 +8060,15 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FirstPostedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPostedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3800,29 +8078,30 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastPostedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			m.Windows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3832,81 +8111,16 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Windows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
 			}
-			var stringLen uint64
+			m.MissedWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3916,29 +8130,35 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedWindows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedWindows", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ConsecutiveMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviantWindows", wireType)
 			}
-			var stringLen uint64
+			m.DeviantWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3948,27 +8168,33 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DeviantWindows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveDeviantWindows", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ConsecutiveDeviantWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveDeviantWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3996,11 +8222,13 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.MeanDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4028,11 +8256,13 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			if err := m.LastDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4049,7 +8279,7 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			m.Excluded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
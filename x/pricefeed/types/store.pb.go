@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +31,84 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DerivationType defines the formula a derived market's price is computed by.
+type DerivationType int32
+
+const (
+	// DERIVATION_TYPE_UNSPECIFIED is not derived, the market is priced by its oracles.
+	DERIVATION_TYPE_UNSPECIFIED DerivationType = 0
+	// DERIVATION_TYPE_INVERSE takes the inverse of the source market's price.
+	DERIVATION_TYPE_INVERSE DerivationType = 1
+	// DERIVATION_TYPE_PRODUCT multiplies the source markets' prices.
+	DERIVATION_TYPE_PRODUCT DerivationType = 2
+	// DERIVATION_TYPE_EXCHANGE_RATE multiplies the source market's price by the exchange rate of a liquid staking
+	// derivative to its underlying.
+	DERIVATION_TYPE_EXCHANGE_RATE DerivationType = 3
+	// DERIVATION_TYPE_SWAP_TWAP takes the time-weighted average price of an x/swap pool over the market's twap window,
+	// multiplied by the source market's price if it has one.
+	DERIVATION_TYPE_SWAP_TWAP DerivationType = 4
+)
+
+var DerivationType_name = map[int32]string{
+	0: "DERIVATION_TYPE_UNSPECIFIED",
+	1: "DERIVATION_TYPE_INVERSE",
+	2: "DERIVATION_TYPE_PRODUCT",
+	3: "DERIVATION_TYPE_EXCHANGE_RATE",
+	4: "DERIVATION_TYPE_SWAP_TWAP",
+}
+
+var DerivationType_value = map[string]int32{
+	"DERIVATION_TYPE_UNSPECIFIED":   0,
+	"DERIVATION_TYPE_INVERSE":       1,
+	"DERIVATION_TYPE_PRODUCT":       2,
+	"DERIVATION_TYPE_EXCHANGE_RATE": 3,
+	"DERIVATION_TYPE_SWAP_TWAP":     4,
+}
+
+func (x DerivationType) String() string {
+	return proto.EnumName(DerivationType_name, int32(x))
+}
+
+func (DerivationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{0}
+}
+
+// AggregationMode defines how the valid oracle prices of a market are combined into its current price.
+type AggregationMode int32
+
+const (
+	// AGGREGATION_MODE_UNSPECIFIED takes the median of the prices, as AGGREGATION_MODE_MEDIAN.
+	AGGREGATION_MODE_UNSPECIFIED AggregationMode = 0
+	// AGGREGATION_MODE_MEDIAN takes the median of the prices.
+	AGGREGATION_MODE_MEDIAN AggregationMode = 1
+	// AGGREGATION_MODE_TRIMMED_MEAN takes the mean of the prices, after dropping the trim fraction of prices from each end.
+	AGGREGATION_MODE_TRIMMED_MEAN AggregationMode = 2
+	// AGGREGATION_MODE_TIME_WEIGHTED takes the time-weighted average of the median price over the twap window.
+	AGGREGATION_MODE_TIME_WEIGHTED AggregationMode = 3
+)
+
+var AggregationMode_name = map[int32]string{
+	0: "AGGREGATION_MODE_UNSPECIFIED",
+	1: "AGGREGATION_MODE_MEDIAN",
+	2: "AGGREGATION_MODE_TRIMMED_MEAN",
+	3: "AGGREGATION_MODE_TIME_WEIGHTED",
+}
+
+var AggregationMode_value = map[string]int32{
+	"AGGREGATION_MODE_UNSPECIFIED":   0,
+	"AGGREGATION_MODE_MEDIAN":        1,
+	"AGGREGATION_MODE_TRIMMED_MEAN":  2,
+	"AGGREGATION_MODE_TIME_WEIGHTED": 3,
+}
+
+func (x AggregationMode) String() string {
+	return proto.EnumName(AggregationMode_name, int32(x))
+}
+
+func (AggregationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{1}
+}
+
 // Params defines the parameters for the pricefeed module.
 type Params struct {
 	Markets Markets `protobuf:"bytes,1,rep,name=markets,proto3,castrepeated=Markets" json:"markets"`
@@ -82,6 +161,35 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// aggregation_mode is how the valid oracle prices are combined into the current price
+	AggregationMode AggregationMode `protobuf:"varint,6,opt,name=aggregation_mode,json=aggregationMode,proto3,enum=fury.pricefeed.v1beta1.AggregationMode" json:"aggregation_mode,omitempty"`
+	// trim_fraction is the fraction of prices dropped from each end before taking a trimmed mean
+	TrimFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=trim_fraction,json=trimFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_fraction"`
+	// twap_window is the window the median price is averaged over for time-weighted aggregation, read from the market's
+	// price history
+	TwapWindow time.Duration `protobuf:"bytes,8,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+	// min_oracle_posts is the number of unexpired oracle prices required to set the current price, zero requires one
+	MinOraclePosts uint32 `protobuf:"varint,9,opt,name=min_oracle_posts,json=minOraclePosts,proto3" json:"min_oracle_posts,omitempty"`
+	// max_price_deviation is the largest fractional change from the previous price accepted before the market is flagged,
+	// zero disables the check
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	// price_history_length is the number of blocks of current prices kept in the market's price history, zero keeps none.
+	// Time weighted markets keep median prices instead, and always keep those covering their twap window.
+	PriceHistoryLength uint32 `protobuf:"varint,11,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// oracle_deviation_threshold is the largest fractional difference between an oracle's price and the median of the
+	// market's prices before the oracle's price counts as deviant, zero counts no prices as deviant
+	OracleDeviationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=oracle_deviation_threshold,json=oracleDeviationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_deviation_threshold"`
+	// max_missed_windows is the number of consecutive windows an oracle can go without a valid price before it is
+	// excluded from aggregation, zero never excludes oracles for missed windows
+	MaxMissedWindows uint32 `protobuf:"varint,13,opt,name=max_missed_windows,json=maxMissedWindows,proto3" json:"max_missed_windows,omitempty"`
+	// max_deviant_windows is the number of consecutive windows an oracle's price can be deviant before it is excluded
+	// from aggregation, zero never excludes oracles for deviant prices
+	MaxDeviantWindows uint32 `protobuf:"varint,14,opt,name=max_deviant_windows,json=maxDeviantWindows,proto3" json:"max_deviant_windows,omitempty"`
+	// derivation computes the market's price from other markets' prices instead of oracle prices, unset for oracle markets
+	Derivation MarketDerivation `protobuf:"bytes,15,opt,name=derivation,proto3" json:"derivation"`
+	// flag_recovery_rounds is the number of consecutive rejected prices within the max price deviation of each other
+	// after which a flagged market accepts the latest price as its new reference, zero uses the default of 100
+	FlagRecoveryRounds uint32 `protobuf:"varint,16,opt,name=flag_recovery_rounds,json=flagRecoveryRounds,proto3" json:"flag_recovery_rounds,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,6 +260,172 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
+func (m *Market) GetAggregationMode() AggregationMode {
+	if m != nil {
+		return m.AggregationMode
+	}
+	return AGGREGATION_MODE_UNSPECIFIED
+}
+
+func (m *Market) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *Market) GetMinOraclePosts() uint32 {
+	if m != nil {
+		return m.MinOraclePosts
+	}
+	return 0
+}
+
+func (m *Market) GetPriceHistoryLength() uint32 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
+func (m *Market) GetMaxMissedWindows() uint32 {
+	if m != nil {
+		return m.MaxMissedWindows
+	}
+	return 0
+}
+
+func (m *Market) GetMaxDeviantWindows() uint32 {
+	if m != nil {
+		return m.MaxDeviantWindows
+	}
+	return 0
+}
+
+func (m *Market) GetDerivation() MarketDerivation {
+	if m != nil {
+		return m.Derivation
+	}
+	return MarketDerivation{}
+}
+
+func (m *Market) GetFlagRecoveryRounds() uint32 {
+	if m != nil {
+		return m.FlagRecoveryRounds
+	}
+	return 0
+}
+
+// MarketDerivation defines how the current price of a derived market is computed from the current prices of other
+// markets.
+type MarketDerivation struct {
+	Type            DerivationType `protobuf:"varint,1,opt,name=type,proto3,enum=fury.pricefeed.v1beta1.DerivationType" json:"type,omitempty"`
+	SourceMarketIDs []string       `protobuf:"bytes,2,rep,name=source_market_ids,json=sourceMarketIds,proto3" json:"source_market_ids,omitempty"`
+	// derivative_denom is the x/liquid derivative whose exchange rate to its underlying the source price is multiplied by
+	DerivativeDenom string `protobuf:"bytes,3,opt,name=derivative_denom,json=derivativeDenom,proto3" json:"derivative_denom,omitempty"`
+	// swap_pool_id is the x/swap pool whose time-weighted average price over the market's twap window is the source
+	// price
+	SwapPoolID string `protobuf:"bytes,4,opt,name=swap_pool_id,json=swapPoolId,proto3" json:"swap_pool_id,omitempty"`
+	// swap_base_denom is the denom of the swap pool that is priced
+	SwapBaseDenom string `protobuf:"bytes,5,opt,name=swap_base_denom,json=swapBaseDenom,proto3" json:"swap_base_denom,omitempty"`
+	// swap_quote_denom is the denom of the swap pool the price is in
+	SwapQuoteDenom string `protobuf:"bytes,6,opt,name=swap_quote_denom,json=swapQuoteDenom,proto3" json:"swap_quote_denom,omitempty"`
+	// swap_base_decimals is the number of decimals of the swap base denom, used to price whole units of the base asset
+	SwapBaseDecimals uint32 `protobuf:"varint,7,opt,name=swap_base_decimals,json=swapBaseDecimals,proto3" json:"swap_base_decimals,omitempty"`
+	// swap_quote_decimals is the number of decimals of the swap quote denom, used to price in whole units of the quote
+	// asset
+	SwapQuoteDecimals uint32 `protobuf:"varint,8,opt,name=swap_quote_decimals,json=swapQuoteDecimals,proto3" json:"swap_quote_decimals,omitempty"`
+}
+
+func (m *MarketDerivation) Reset()         { *m = MarketDerivation{} }
+func (m *MarketDerivation) String() string { return proto.CompactTextString(m) }
+func (*MarketDerivation) ProtoMessage()    {}
+func (*MarketDerivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{2}
+}
+func (m *MarketDerivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketDerivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketDerivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketDerivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDerivation.Merge(m, src)
+}
+func (m *MarketDerivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketDerivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDerivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDerivation proto.InternalMessageInfo
+
+func (m *MarketDerivation) GetType() DerivationType {
+	if m != nil {
+		return m.Type
+	}
+	return DERIVATION_TYPE_UNSPECIFIED
+}
+
+func (m *MarketDerivation) GetSourceMarketIDs() []string {
+	if m != nil {
+		return m.SourceMarketIDs
+	}
+	return nil
+}
+
+func (m *MarketDerivation) GetDerivativeDenom() string {
+	if m != nil {
+		return m.DerivativeDenom
+	}
+	return ""
+}
+
+func (m *MarketDerivation) GetSwapPoolID() string {
+	if m != nil {
+		return m.SwapPoolID
+	}
+	return ""
+}
+
+func (m *MarketDerivation) GetSwapBaseDenom() string {
+	if m != nil {
+		return m.SwapBaseDenom
+	}
+	return ""
+}
+
+func (m *MarketDerivation) GetSwapQuoteDenom() string {
+	if m != nil {
+		return m.SwapQuoteDenom
+	}
+	return ""
+}
+
+func (m *MarketDerivation) GetSwapBaseDecimals() uint32 {
+	if m != nil {
+		return m.SwapBaseDecimals
+	}
+	return 0
+}
+
+func (m *MarketDerivation) GetSwapQuoteDecimals() uint32 {
+	if m != nil {
+		return m.SwapQuoteDecimals
+	}
+	return 0
+}
+
 // PostedPrice defines a price for market posted by a specific oracle.
 type PostedPrice struct {
 	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -164,7 +438,7 @@ func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
 func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
 func (*PostedPrice) ProtoMessage()    {}
 func (*PostedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aebb3f355c88997e, []int{2}
+	return fileDescriptor_aebb3f355c88997e, []int{3}
 }
 func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +499,7 @@ func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
 func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
 func (*CurrentPrice) ProtoMessage()    {}
 func (*CurrentPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aebb3f355c88997e, []int{3}
+	return fileDescriptor_aebb3f355c88997e, []int{4}
 }
 func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,207 +535,556 @@ func (m *CurrentPrice) GetMarketID() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "fury.pricefeed.v1beta1.Params")
-	proto.RegisterType((*Market)(nil), "fury.pricefeed.v1beta1.Market")
-	proto.RegisterType((*PostedPrice)(nil), "fury.pricefeed.v1beta1.PostedPrice")
-	proto.RegisterType((*CurrentPrice)(nil), "fury.pricefeed.v1beta1.CurrentPrice")
+// MarketFlag records a market whose aggregated price moved further from its current price than the market's max
+// price deviation. The current price of a flagged market is not valid until a price within the deviation is aggregated.
+type MarketFlag struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// price is the most recent rejected price
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// reference_price is the last accepted price, which new prices are checked against
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price"`
+	FlaggedAt      time.Time                              `protobuf:"bytes,4,opt,name=flagged_at,json=flaggedAt,proto3,stdtime" json:"flagged_at"`
+	// consistent_rounds is the number of consecutive rejected prices, up to the latest, that were within the max price
+	// deviation of the rejected price before them
+	ConsistentRounds uint32 `protobuf:"varint,5,opt,name=consistent_rounds,json=consistentRounds,proto3" json:"consistent_rounds,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("fury/pricefeed/v1beta1/store.proto", fileDescriptor_aebb3f355c88997e)
+func (m *MarketFlag) Reset()         { *m = MarketFlag{} }
+func (m *MarketFlag) String() string { return proto.CompactTextString(m) }
+func (*MarketFlag) ProtoMessage()    {}
+func (*MarketFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{5}
 }
-
-var fileDescriptor_aebb3f355c88997e = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x8e, 0xd3, 0x4e,
-	0x10, 0xce, 0x26, 0x77, 0xf9, 0xb3, 0xc9, 0xef, 0x87, 0x64, 0xd0, 0xc9, 0x44, 0xc2, 0x8e, 0x52,
-	0xa0, 0x50, 0x64, 0xad, 0x3b, 0x5a, 0x9a, 0x98, 0x14, 0xa4, 0x40, 0x0a, 0x86, 0x8a, 0x26, 0x5a,
-	0xdb, 0x93, 0x60, 0x5d, 0xcc, 0x9a, 0x9d, 0xcd, 0xe9, 0x52, 0xf1, 0x0a, 0xf7, 0x18, 0x08, 0x89,
-	0x8e, 0x87, 0xb8, 0xf2, 0x44, 0x85, 0x28, 0x72, 0x47, 0xf2, 0x00, 0xf4, 0x54, 0xc8, 0xbb, 0xf6,
-	0xe9, 0x0a, 0x0a, 0x4e, 0x50, 0xd9, 0xf3, 0xcd, 0x37, 0xdf, 0xce, 0x7c, 0x3b, 0x4b, 0xfb, 0xf3,
-	0x95, 0x5c, 0x7b, 0x99, 0x4c, 0x22, 0x98, 0x03, 0xc4, 0xde, 0xc9, 0x61, 0x08, 0x8a, 0x1f, 0x7a,
-	0xa8, 0x84, 0x04, 0x96, 0x49, 0xa1, 0x84, 0x75, 0x90, 0x73, 0xd8, 0x35, 0x87, 0x15, 0x9c, 0xee,
-	0xfd, 0x48, 0x60, 0x2a, 0x70, 0xa6, 0x59, 0x9e, 0x09, 0x4c, 0x49, 0xf7, 0xde, 0x42, 0x2c, 0x84,
-	0xc1, 0xf3, 0xbf, 0x02, 0x75, 0x17, 0x42, 0x2c, 0x96, 0xe0, 0xe9, 0x28, 0x5c, 0xcd, 0x3d, 0x95,
-	0xa4, 0x80, 0x8a, 0xa7, 0x99, 0x21, 0xf4, 0x5f, 0xd2, 0xfa, 0x94, 0x4b, 0x9e, 0xa2, 0x35, 0xa1,
-	0x8d, 0x94, 0xcb, 0x63, 0x50, 0x68, 0x93, 0x5e, 0x6d, 0xd0, 0x3e, 0x72, 0xd8, 0xef, 0xbb, 0x60,
-	0xcf, 0x35, 0xcd, 0xbf, 0x73, 0xbe, 0x71, 0x2b, 0x1f, 0x2f, 0xdd, 0x86, 0x89, 0x31, 0x28, 0xeb,
-	0xfb, 0x3f, 0x08, 0xad, 0x1b, 0xd0, 0x7a, 0x44, 0x5b, 0x06, 0x9d, 0x25, 0xb1, 0x4d, 0x7a, 0x64,
-	0xd0, 0xf2, 0x3b, 0xdb, 0x8d, 0xdb, 0x34, 0xe9, 0xc9, 0x38, 0x68, 0x9a, 0xf4, 0x24, 0xb6, 0x1e,
-	0x50, 0x1a, 0x72, 0x84, 0x19, 0x47, 0x04, 0x65, 0x57, 0x73, 0x6e, 0xd0, 0xca, 0x91, 0x51, 0x0e,
-	0x58, 0x2e, 0x6d, 0xbf, 0x5b, 0x09, 0x55, 0xe6, 0x6b, 0x3a, 0x4f, 0x35, 0x64, 0x08, 0x21, 0x6d,
-	0x08, 0xc9, 0xa3, 0x25, 0xa0, 0xbd, 0xd7, 0xab, 0x0d, 0x3a, 0xfe, 0xb3, 0x9f, 0x1b, 0x77, 0xb8,
-	0x48, 0xd4, 0x9b, 0x55, 0xc8, 0x22, 0x91, 0x16, 0x7e, 0x15, 0x9f, 0x21, 0xc6, 0xc7, 0x9e, 0x5a,
-	0x67, 0x80, 0x6c, 0x14, 0x45, 0xa3, 0x38, 0x96, 0x80, 0xf8, 0xe5, 0xf3, 0xf0, 0x6e, 0xe1, 0x6a,
-	0x81, 0xf8, 0x6b, 0x05, 0x18, 0x94, 0xc2, 0xd6, 0x01, 0xad, 0xf3, 0x48, 0x25, 0x27, 0x60, 0xef,
-	0xf7, 0xc8, 0xa0, 0x19, 0x14, 0x51, 0xff, 0x53, 0x95, 0xb6, 0xa7, 0x02, 0x15, 0xc4, 0xd3, 0xdc,
-	0xae, 0xdb, 0x8c, 0x2d, 0xe8, 0xff, 0x46, 0x7d, 0xc6, 0xcd, 0x91, 0x7a, 0xf4, 0x7f, 0xd9, 0xfd,
-	0x7f, 0x46, 0xbf, 0xc0, 0xac, 0x31, 0xdd, 0xd7, 0x77, 0x6a, 0x2c, 0xf4, 0x59, 0x7e, 0x8d, 0xdf,
-	0x36, 0xee, 0xc3, 0x3f, 0x38, 0x6b, 0x0c, 0x51, 0x60, 0x8a, 0xad, 0x27, 0xb4, 0x0e, 0xa7, 0x59,
-	0x22, 0xd7, 0xf6, 0x5e, 0x8f, 0x0c, 0xda, 0x47, 0x5d, 0x66, 0x56, 0x8d, 0x95, 0xab, 0xc6, 0x5e,
-	0x95, 0xab, 0xe6, 0x37, 0xf3, 0x23, 0xce, 0x2e, 0x5d, 0x12, 0x14, 0x35, 0xfd, 0xf7, 0xb4, 0xf3,
-	0x74, 0x25, 0x25, 0xbc, 0x55, 0xb7, 0xf6, 0xeb, 0xba, 0xfd, 0xea, 0x5f, 0xb4, 0xef, 0xbf, 0xb8,
-	0xfa, 0xee, 0x90, 0x0f, 0x5b, 0x87, 0x9c, 0x6f, 0x1d, 0x72, 0xb1, 0x75, 0xc8, 0xd5, 0xd6, 0x21,
-	0x67, 0x3b, 0xa7, 0x72, 0xb1, 0x73, 0x2a, 0x5f, 0x77, 0x4e, 0xe5, 0xb5, 0x77, 0x43, 0x30, 0x03,
-	0x19, 0x09, 0x4c, 0x70, 0xb8, 0xe4, 0x21, 0x7a, 0xfa, 0x01, 0x9f, 0xde, 0x78, 0xc2, 0x5a, 0x3d,
-	0xac, 0xeb, 0xc9, 0x1f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x23, 0x0f, 0x2a, 0xf7, 0xe1, 0x03,
-	0x00, 0x00,
+func (m *MarketFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *Params) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *MarketFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *MarketFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketFlag.Merge(m, src)
+}
+func (m *MarketFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketFlag.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Params")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *Params but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Params but is not nil && this == nil")
-	}
-	if len(this.Markets) != len(that1.Markets) {
-		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
+var xxx_messageInfo_MarketFlag proto.InternalMessageInfo
+
+func (m *MarketFlag) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
-		}
+	return ""
+}
+
+func (m *MarketFlag) GetFlaggedAt() time.Time {
+	if m != nil {
+		return m.FlaggedAt
 	}
-	return nil
+	return time.Time{}
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MarketFlag) GetConsistentRounds() uint32 {
+	if m != nil {
+		return m.ConsistentRounds
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ReferencePrice defines the last accepted price of a market, which new prices are checked against for deviation. It is
+// kept separately from the current price, so it still applies after the current price is cleared.
+type ReferencePrice struct {
+	MarketID   string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	AcceptedAt time.Time                              `protobuf:"bytes,3,opt,name=accepted_at,json=acceptedAt,proto3,stdtime" json:"accepted_at"`
+}
+
+func (m *ReferencePrice) Reset()         { *m = ReferencePrice{} }
+func (m *ReferencePrice) String() string { return proto.CompactTextString(m) }
+func (*ReferencePrice) ProtoMessage()    {}
+func (*ReferencePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{6}
+}
+func (m *ReferencePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferencePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferencePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Markets) != len(that1.Markets) {
-		return false
+}
+func (m *ReferencePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencePrice.Merge(m, src)
+}
+func (m *ReferencePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferencePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencePrice proto.InternalMessageInfo
+
+func (m *ReferencePrice) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *ReferencePrice) GetAcceptedAt() time.Time {
+	if m != nil {
+		return m.AcceptedAt
 	}
-	return true
+	return time.Time{}
 }
-func (this *Market) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+
+// OracleReputation defines the posting record of an oracle in a market. Each aggregation of the market's current price
+// is a window, which the oracle misses if it has no unexpired price.
+type OracleReputation struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// post_count is the number of prices the oracle has posted
+	PostCount     uint64    `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	FirstPostedAt time.Time `protobuf:"bytes,4,opt,name=first_posted_at,json=firstPostedAt,proto3,stdtime" json:"first_posted_at"`
+	LastPostedAt  time.Time `protobuf:"bytes,5,opt,name=last_posted_at,json=lastPostedAt,proto3,stdtime" json:"last_posted_at"`
+	// windows is the number of windows the oracle has been a market oracle for
+	Windows                   uint64 `protobuf:"varint,6,opt,name=windows,proto3" json:"windows,omitempty"`
+	MissedWindows             uint64 `protobuf:"varint,7,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	ConsecutiveMissedWindows  uint32 `protobuf:"varint,8,opt,name=consecutive_missed_windows,json=consecutiveMissedWindows,proto3" json:"consecutive_missed_windows,omitempty"`
+	DeviantWindows            uint64 `protobuf:"varint,9,opt,name=deviant_windows,json=deviantWindows,proto3" json:"deviant_windows,omitempty"`
+	ConsecutiveDeviantWindows uint32 `protobuf:"varint,10,opt,name=consecutive_deviant_windows,json=consecutiveDeviantWindows,proto3" json:"consecutive_deviant_windows,omitempty"`
+	// total_deviation is the sum of the fractional differences between the oracle's price and the median price, over the
+	// windows it did not miss
+	TotalDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=total_deviation,json=totalDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_deviation"`
+	LastDeviation  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=last_deviation,json=lastDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_deviation"`
+	// excluded is whether the oracle's prices are left out of aggregation
+	Excluded bool `protobuf:"varint,13,opt,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (m *OracleReputation) Reset()         { *m = OracleReputation{} }
+func (m *OracleReputation) String() string { return proto.CompactTextString(m) }
+func (*OracleReputation) ProtoMessage()    {}
+func (*OracleReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{7}
+}
+func (m *OracleReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *OracleReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleReputation.Merge(m, src)
+}
+func (m *OracleReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleReputation.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Market)
-	if !ok {
-		that2, ok := that.(Market)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Market")
-		}
+var xxx_messageInfo_OracleReputation proto.InternalMessageInfo
+
+func (m *OracleReputation) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *Market but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Market but is not nil && this == nil")
+	return ""
+}
+
+func (m *OracleReputation) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
 	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	return nil
+}
+
+func (m *OracleReputation) GetPostCount() uint64 {
+	if m != nil {
+		return m.PostCount
 	}
-	if this.BaseAsset != that1.BaseAsset {
-		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
+	return 0
+}
+
+func (m *OracleReputation) GetFirstPostedAt() time.Time {
+	if m != nil {
+		return m.FirstPostedAt
 	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
+	return time.Time{}
+}
+
+func (m *OracleReputation) GetLastPostedAt() time.Time {
+	if m != nil {
+		return m.LastPostedAt
 	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
+	return time.Time{}
+}
+
+func (m *OracleReputation) GetWindows() uint64 {
+	if m != nil {
+		return m.Windows
 	}
-	for i := range this.Oracles {
-		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
-			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
-		}
+	return 0
+}
+
+func (m *OracleReputation) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
 	}
-	if this.Active != that1.Active {
-		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	return 0
+}
+
+func (m *OracleReputation) GetConsecutiveMissedWindows() uint32 {
+	if m != nil {
+		return m.ConsecutiveMissedWindows
 	}
-	return nil
+	return 0
 }
-func (this *Market) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *OracleReputation) GetDeviantWindows() uint64 {
+	if m != nil {
+		return m.DeviantWindows
 	}
+	return 0
+}
 
-	that1, ok := that.(*Market)
-	if !ok {
-		that2, ok := that.(Market)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *OracleReputation) GetConsecutiveDeviantWindows() uint32 {
+	if m != nil {
+		return m.ConsecutiveDeviantWindows
+	}
+	return 0
+}
+
+func (m *OracleReputation) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
+// PriceSnapshot defines the current price of a market at a block, kept in the market's price history.
+type PriceSnapshot struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Height   int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// cumulative_price is the sum of the market's previous prices, each multiplied by the seconds it was the current price
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{8}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	if this.MarketID != that1.MarketID {
-		return false
+	return ""
+}
+
+func (m *PriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if this.BaseAsset != that1.BaseAsset {
-		return false
+	return 0
+}
+
+func (m *PriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return false
+	return time.Time{}
+}
+
+// PriceHistory defines the bounds of a market's price history, as the sequence numbers of its snapshots.
+type PriceHistory struct {
+	// first is the sequence of the oldest snapshot kept
+	First uint64 `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	// next is the sequence of the next snapshot to be recorded
+	Next uint64 `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (m *PriceHistory) Reset()         { *m = PriceHistory{} }
+func (m *PriceHistory) String() string { return proto.CompactTextString(m) }
+func (*PriceHistory) ProtoMessage()    {}
+func (*PriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{9}
+}
+func (m *PriceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return false
+}
+func (m *PriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistory.Merge(m, src)
+}
+func (m *PriceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistory proto.InternalMessageInfo
+
+func (m *PriceHistory) GetFirst() uint64 {
+	if m != nil {
+		return m.First
 	}
-	for i := range this.Oracles {
-		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
-			return false
+	return 0
+}
+
+func (m *PriceHistory) GetNext() uint64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+// Candle defines the open, high, low and close prices of a market over an interval.
+type Candle struct {
+	StartTime time.Time                              `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	Open      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	High      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	Low       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	Close     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aebb3f355c88997e, []int{10}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Active != that1.Active {
-		return false
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return true
+	return time.Time{}
 }
-func (this *PostedPrice) VerboseEqual(that interface{}) error {
+
+func init() {
+	proto.RegisterEnum("fury.pricefeed.v1beta1.DerivationType", DerivationType_name, DerivationType_value)
+	proto.RegisterEnum("fury.pricefeed.v1beta1.AggregationMode", AggregationMode_name, AggregationMode_value)
+	proto.RegisterType((*Params)(nil), "fury.pricefeed.v1beta1.Params")
+	proto.RegisterType((*Market)(nil), "fury.pricefeed.v1beta1.Market")
+	proto.RegisterType((*MarketDerivation)(nil), "fury.pricefeed.v1beta1.MarketDerivation")
+	proto.RegisterType((*PostedPrice)(nil), "fury.pricefeed.v1beta1.PostedPrice")
+	proto.RegisterType((*CurrentPrice)(nil), "fury.pricefeed.v1beta1.CurrentPrice")
+	proto.RegisterType((*MarketFlag)(nil), "fury.pricefeed.v1beta1.MarketFlag")
+	proto.RegisterType((*ReferencePrice)(nil), "fury.pricefeed.v1beta1.ReferencePrice")
+	proto.RegisterType((*OracleReputation)(nil), "fury.pricefeed.v1beta1.OracleReputation")
+	proto.RegisterType((*PriceSnapshot)(nil), "fury.pricefeed.v1beta1.PriceSnapshot")
+	proto.RegisterType((*PriceHistory)(nil), "fury.pricefeed.v1beta1.PriceHistory")
+	proto.RegisterType((*Candle)(nil), "fury.pricefeed.v1beta1.Candle")
+}
+
+func init() {
+	proto.RegisterFile("fury/pricefeed/v1beta1/store.proto", fileDescriptor_aebb3f355c88997e)
+}
+
+var fileDescriptor_aebb3f355c88997e = []byte{
+	// 1613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x8e, 0x63, 0x3f, 0x7f, 0xf5, 0xd4, 0x8c, 0x86, 0x9e, 0x0c, 0x63, 0x1b, 0x4b,
+	0xcc, 0x7a, 0x97, 0x1d, 0x7b, 0x37, 0x5c, 0x56, 0x68, 0x05, 0xd8, 0xe9, 0x9e, 0xc4, 0x68, 0x9d,
+	0x78, 0xdb, 0x9e, 0x0d, 0xcb, 0x81, 0x56, 0xa7, 0xbb, 0x62, 0xb7, 0xb6, 0xbb, 0xcb, 0x74, 0x95,
+	0xf3, 0x71, 0xe2, 0xca, 0x71, 0x8f, 0x48, 0xc0, 0x89, 0x0b, 0x42, 0xe2, 0x04, 0x7f, 0x44, 0x8e,
+	0x2b, 0x4e, 0x08, 0x89, 0xec, 0x92, 0xb9, 0xf0, 0x37, 0x70, 0x42, 0x55, 0xd5, 0xfe, 0xcc, 0x2c,
+	0xc2, 0x1e, 0x34, 0xda, 0x53, 0x5c, 0xef, 0xfd, 0xde, 0xaf, 0x5e, 0xbd, 0xaa, 0xf7, 0xab, 0xea,
+	0x40, 0xed, 0x6c, 0x12, 0x5d, 0x35, 0xc7, 0x91, 0xe7, 0xe0, 0x33, 0x8c, 0xdd, 0xe6, 0xf9, 0xfb,
+	0xa7, 0x98, 0xd9, 0xef, 0x37, 0x29, 0x23, 0x11, 0x6e, 0x8c, 0x23, 0xc2, 0x08, 0x7a, 0xc8, 0x31,
+	0x8d, 0x19, 0xa6, 0x11, 0x63, 0x76, 0x1f, 0x39, 0x84, 0x06, 0x84, 0x5a, 0x02, 0xd5, 0x94, 0x03,
+	0x19, 0xb2, 0xfb, 0x60, 0x48, 0x86, 0x44, 0xda, 0xf9, 0xaf, 0xd8, 0x5a, 0x1e, 0x12, 0x32, 0xf4,
+	0x71, 0x53, 0x8c, 0x4e, 0x27, 0x67, 0x4d, 0x77, 0x12, 0xd9, 0xcc, 0x23, 0x61, 0xec, 0xaf, 0xac,
+	0xfa, 0x99, 0x17, 0x60, 0xca, 0xec, 0x60, 0x2c, 0x01, 0xb5, 0x3e, 0xa4, 0x7b, 0x76, 0x64, 0x07,
+	0x14, 0x75, 0x60, 0x27, 0xb0, 0xa3, 0xcf, 0x30, 0xa3, 0x9a, 0x52, 0x4d, 0xd6, 0x73, 0x7b, 0xe5,
+	0xc6, 0xab, 0xb3, 0x6c, 0x74, 0x05, 0xac, 0x5d, 0xba, 0xbe, 0xa9, 0x6c, 0xfd, 0xf1, 0xcb, 0xca,
+	0x8e, 0x1c, 0x53, 0x73, 0x1a, 0x5f, 0xfb, 0xd7, 0x0e, 0xa4, 0xa5, 0x11, 0xbd, 0x0d, 0x59, 0x69,
+	0xb5, 0x3c, 0x57, 0x53, 0xaa, 0x4a, 0x3d, 0xdb, 0xce, 0xdf, 0xde, 0x54, 0x32, 0xd2, 0xdd, 0xd1,
+	0xcd, 0x8c, 0x74, 0x77, 0x5c, 0xf4, 0x04, 0xe0, 0xd4, 0xa6, 0xd8, 0xb2, 0x29, 0xc5, 0x4c, 0x4b,
+	0x70, 0xac, 0x99, 0xe5, 0x96, 0x16, 0x37, 0xa0, 0x0a, 0xe4, 0x7e, 0x31, 0x21, 0x6c, 0xea, 0x4f,
+	0x0a, 0x3f, 0x08, 0x93, 0x04, 0x9c, 0xc2, 0x0e, 0x89, 0x6c, 0xc7, 0xc7, 0x54, 0x4b, 0x55, 0x93,
+	0xf5, 0x7c, 0xfb, 0xf0, 0xdf, 0x37, 0x95, 0x67, 0x43, 0x8f, 0x8d, 0x26, 0xa7, 0x0d, 0x87, 0x04,
+	0x71, 0x3d, 0xe3, 0x3f, 0xcf, 0xa8, 0xfb, 0x59, 0x93, 0x5d, 0x8d, 0x31, 0x6d, 0xb4, 0x1c, 0xa7,
+	0xe5, 0xba, 0x11, 0xa6, 0xf4, 0xaf, 0x7f, 0x79, 0x76, 0x3f, 0xae, 0x7a, 0x6c, 0x69, 0x5f, 0x31,
+	0x4c, 0xcd, 0x29, 0x31, 0x7a, 0x08, 0x69, 0xdb, 0x61, 0xde, 0x39, 0xd6, 0xb6, 0xab, 0x4a, 0x3d,
+	0x63, 0xc6, 0x23, 0x64, 0x82, 0x6a, 0x0f, 0x87, 0x11, 0x1e, 0x8a, 0xe2, 0x5b, 0x01, 0x71, 0xb1,
+	0x96, 0xae, 0x2a, 0xf5, 0xe2, 0xde, 0x5b, 0x5f, 0x57, 0xc5, 0xd6, 0x1c, 0xdf, 0x25, 0x2e, 0x36,
+	0x4b, 0xf6, 0xb2, 0x01, 0xf5, 0xa1, 0xc0, 0x22, 0x2f, 0xb0, 0xce, 0x22, 0x3e, 0x09, 0x09, 0xb5,
+	0x1d, 0x51, 0xbe, 0x06, 0x2f, 0xfb, 0xdf, 0x6f, 0x2a, 0x4f, 0xff, 0x87, 0x95, 0xe9, 0xd8, 0x31,
+	0xf3, 0x9c, 0xe4, 0x79, 0xcc, 0x81, 0x74, 0xc8, 0xb1, 0x0b, 0x7b, 0x6c, 0x5d, 0x78, 0xa1, 0x4b,
+	0x2e, 0xb4, 0x4c, 0x55, 0xa9, 0xe7, 0xf6, 0x1e, 0x35, 0xe4, 0x31, 0x69, 0x4c, 0x8f, 0x49, 0x43,
+	0x8f, 0x8f, 0x51, 0x3b, 0xc3, 0x67, 0xfb, 0xf5, 0x97, 0x15, 0xc5, 0x04, 0x1e, 0x77, 0x22, 0xc2,
+	0x50, 0x1d, 0xd4, 0xc0, 0x0b, 0x2d, 0x59, 0x15, 0x6b, 0x4c, 0x28, 0xa3, 0x5a, 0xb6, 0xaa, 0xd4,
+	0x0b, 0x66, 0x31, 0xf0, 0xc2, 0x63, 0x61, 0xee, 0x71, 0x2b, 0xfa, 0x39, 0xdc, 0x0f, 0xec, 0x4b,
+	0x4b, 0x2c, 0xdf, 0x72, 0xf1, 0xb9, 0x27, 0x68, 0x35, 0xd8, 0x68, 0x29, 0xf7, 0x02, 0xfb, 0xb2,
+	0xc7, 0x99, 0xf4, 0x29, 0x11, 0x7a, 0x0f, 0x1e, 0x48, 0xee, 0x91, 0xc7, 0x1b, 0xec, 0xca, 0xf2,
+	0x71, 0x38, 0x64, 0x23, 0x2d, 0x27, 0xb2, 0x41, 0xc2, 0x77, 0x28, 0x5d, 0x1f, 0x09, 0x0f, 0xf2,
+	0x61, 0x37, 0xce, 0x7b, 0x96, 0x8e, 0xc5, 0x46, 0x11, 0xa6, 0x23, 0xe2, 0xbb, 0x5a, 0x7e, 0xa3,
+	0xc4, 0x34, 0xc9, 0x38, 0x4b, 0x6b, 0x30, 0xe5, 0x43, 0xef, 0x02, 0xe2, 0xeb, 0x0f, 0x3c, 0x4a,
+	0xb1, 0x1b, 0x57, 0x9d, 0x6a, 0x05, 0x91, 0x9d, 0x1a, 0xd8, 0x97, 0x5d, 0xe1, 0x90, 0x65, 0xa5,
+	0xa8, 0x21, 0xab, 0x25, 0x12, 0x0b, 0xd9, 0x0c, 0x5e, 0x14, 0x70, 0xbe, 0x7a, 0x5d, 0x7a, 0xa6,
+	0xf8, 0x23, 0x00, 0x17, 0x47, 0xde, 0xb9, 0x2c, 0x6a, 0x49, 0x6c, 0x66, 0xfd, 0xbf, 0xb7, 0xad,
+	0x3e, 0xc3, 0xb7, 0x53, 0x7c, 0x95, 0xe6, 0x02, 0x03, 0xaf, 0xe6, 0x99, 0x6f, 0x0f, 0xad, 0x08,
+	0x3b, 0xe4, 0x1c, 0x47, 0x57, 0x56, 0x44, 0x26, 0xa1, 0x4b, 0x35, 0x55, 0x56, 0x93, 0xfb, 0xcc,
+	0xd8, 0x65, 0x0a, 0x4f, 0xed, 0xb7, 0x49, 0x50, 0x57, 0x89, 0xd1, 0x0f, 0x20, 0xc5, 0x6b, 0x23,
+	0xfa, 0xbd, 0xb8, 0xf7, 0xf4, 0xeb, 0x12, 0x9a, 0x47, 0x0c, 0xae, 0xc6, 0xd8, 0x14, 0x31, 0xe8,
+	0x47, 0x70, 0x8f, 0x92, 0x49, 0xe4, 0x60, 0x6b, 0xa6, 0x1b, 0x54, 0x4b, 0x54, 0x93, 0xf5, 0x6c,
+	0xfb, 0xfe, 0xed, 0x4d, 0xa5, 0xd4, 0x17, 0xce, 0xa9, 0x7c, 0x50, 0xb3, 0x44, 0x17, 0x0d, 0x2e,
+	0x45, 0x6f, 0x83, 0x3a, 0x5d, 0xd1, 0x39, 0xdf, 0xe3, 0x90, 0x04, 0xb1, 0x58, 0x94, 0xe6, 0x76,
+	0x9d, 0x9b, 0xd1, 0x7b, 0x90, 0xa7, 0xbc, 0x19, 0xc6, 0x84, 0xf8, 0x5c, 0x9f, 0x52, 0x62, 0xf3,
+	0x8b, 0xb7, 0x37, 0x15, 0xe8, 0x5f, 0xd8, 0xe3, 0x1e, 0x21, 0x7e, 0x47, 0x37, 0x81, 0x4e, 0x7f,
+	0xbb, 0xe8, 0x29, 0x94, 0x44, 0x84, 0x10, 0x2a, 0xc9, 0xbd, 0x2d, 0xb8, 0x0b, 0xdc, 0xdc, 0xb6,
+	0x69, 0xcc, 0x5c, 0x07, 0x55, 0xe0, 0xa4, 0x62, 0x49, 0x60, 0x5a, 0x00, 0x8b, 0xdc, 0xfe, 0x31,
+	0x37, 0x4b, 0xe4, 0xbb, 0x80, 0x16, 0x19, 0x1d, 0x2f, 0xb0, 0x7d, 0x2a, 0x5a, 0xbd, 0x60, 0xaa,
+	0x73, 0x52, 0x69, 0xe7, 0x07, 0x64, 0x89, 0x37, 0x86, 0x67, 0xe4, 0x01, 0x59, 0xa0, 0x96, 0x8e,
+	0xda, 0x9f, 0x12, 0x90, 0xe3, 0x8d, 0x88, 0x5d, 0xd1, 0x37, 0xeb, 0xc8, 0x31, 0x81, 0x62, 0xdc,
+	0x27, 0xb6, 0x94, 0x42, 0x21, 0xc9, 0xff, 0x4f, 0x55, 0x2d, 0x48, 0xfe, 0xd8, 0x86, 0x74, 0xd8,
+	0x16, 0x67, 0x44, 0x4b, 0x6e, 0xd4, 0x83, 0x32, 0x18, 0x7d, 0x08, 0x69, 0x7c, 0x39, 0xf6, 0xa2,
+	0x2b, 0xb1, 0x9b, 0xb9, 0xbd, 0xdd, 0x3b, 0xda, 0x36, 0x98, 0x5e, 0x81, 0x52, 0xdc, 0x3e, 0xe7,
+	0xe2, 0x16, 0xc7, 0xd4, 0x7e, 0x09, 0xf9, 0xfd, 0x49, 0x14, 0xe1, 0x90, 0xad, 0x5d, 0xaf, 0x59,
+	0xfa, 0x89, 0xd7, 0x48, 0xbf, 0x76, 0x9d, 0x00, 0x90, 0xe4, 0xcf, 0x7d, 0x7b, 0xf8, 0xc6, 0xe7,
+	0x47, 0x27, 0x50, 0x8a, 0xf0, 0x19, 0x8e, 0x70, 0xe8, 0x60, 0xeb, 0x75, 0xb6, 0xa3, 0x38, 0xa3,
+	0x91, 0x95, 0xdc, 0x07, 0xe0, 0xf2, 0x31, 0xc4, 0xae, 0x65, 0xb3, 0xb5, 0xf6, 0x26, 0x1b, 0xc7,
+	0xb5, 0x18, 0xfa, 0x1e, 0xdc, 0x73, 0x48, 0x48, 0x3d, 0xca, 0x70, 0xc8, 0xa6, 0xe2, 0xb4, 0x2d,
+	0x7b, 0x65, 0xee, 0x88, 0xa5, 0xe9, 0x5a, 0x81, 0xa2, 0xb9, 0x9c, 0xc4, 0x1b, 0x2f, 0xa7, 0x01,
+	0x39, 0xdb, 0x71, 0xf0, 0x98, 0xc9, 0x65, 0x27, 0xd7, 0x58, 0x36, 0x4c, 0x03, 0x5b, 0xac, 0xf6,
+	0x9b, 0x34, 0xa8, 0xf2, 0x56, 0x35, 0xf1, 0x78, 0xc2, 0xa4, 0xca, 0x7e, 0x93, 0x7b, 0xf9, 0x09,
+	0x00, 0x7f, 0x15, 0x58, 0x0e, 0x99, 0x84, 0x72, 0xd9, 0x29, 0x33, 0xcb, 0x2d, 0xfb, 0xdc, 0x80,
+	0x3e, 0x82, 0xd2, 0x99, 0x17, 0x51, 0x26, 0x9e, 0x0e, 0xeb, 0x9f, 0x88, 0x82, 0x08, 0x96, 0xba,
+	0xd6, 0x62, 0xe8, 0x27, 0x50, 0xf4, 0xed, 0x25, 0xb2, 0xed, 0x35, 0xc8, 0xf2, 0xbe, 0xbd, 0xc0,
+	0xa5, 0xc1, 0xce, 0xf4, 0xd6, 0x4d, 0x8b, 0xac, 0xa7, 0x43, 0xf4, 0x5d, 0x28, 0xae, 0xdc, 0xe2,
+	0x3b, 0x02, 0x50, 0x08, 0x96, 0xae, 0xf0, 0x0f, 0x61, 0x97, 0x9f, 0x44, 0xec, 0x4c, 0xc4, 0xfd,
+	0xb3, 0x12, 0x22, 0x85, 0x5a, 0x5b, 0x40, 0x2c, 0x3f, 0x00, 0xde, 0x82, 0xd2, 0xea, 0xe5, 0x9f,
+	0x15, 0xb3, 0x14, 0xdd, 0xe5, 0x9b, 0xff, 0x87, 0xf0, 0x78, 0x71, 0x9a, 0xd5, 0x20, 0x10, 0xf3,
+	0x3c, 0x5a, 0x80, 0xac, 0xbc, 0x1c, 0x4e, 0xa0, 0xc4, 0x08, 0xb3, 0xfd, 0x85, 0x37, 0x59, 0x6e,
+	0xb3, 0x3e, 0x17, 0x34, 0xf3, 0x07, 0xd9, 0x8b, 0x78, 0x33, 0xe6, 0xbc, 0x9b, 0x3d, 0xa9, 0x0a,
+	0x9c, 0x65, 0x4e, 0xbb, 0x0b, 0x19, 0x7c, 0xe9, 0xf8, 0x13, 0x17, 0xbb, 0xe2, 0xf5, 0x94, 0x31,
+	0x67, 0x63, 0x7e, 0xc9, 0x15, 0x44, 0x7f, 0xf7, 0x43, 0x7b, 0x4c, 0x47, 0x64, 0xad, 0xaf, 0x8e,
+	0x87, 0x90, 0x1e, 0x61, 0x6f, 0x38, 0x92, 0x5f, 0x1c, 0x49, 0x33, 0x1e, 0xa1, 0x0f, 0x20, 0xc5,
+	0xbf, 0x95, 0xd6, 0x6a, 0x59, 0x11, 0x31, 0x57, 0x8e, 0xd4, 0xeb, 0x28, 0xc7, 0xa7, 0xa0, 0x3a,
+	0x93, 0x60, 0xe2, 0xcb, 0x67, 0x8c, 0x24, 0xdc, 0xde, 0x88, 0xb0, 0x34, 0xe7, 0x11, 0x55, 0xaa,
+	0x7d, 0x00, 0xf9, 0xde, 0xc2, 0xbb, 0x18, 0x3d, 0x80, 0x6d, 0xd1, 0x50, 0xa2, 0x52, 0x29, 0x53,
+	0x0e, 0x10, 0x82, 0x54, 0x88, 0x2f, 0x65, 0x59, 0x52, 0xa6, 0xf8, 0x5d, 0xfb, 0x47, 0x02, 0xd2,
+	0xfb, 0x76, 0xe8, 0xfa, 0x42, 0xcf, 0x29, 0xb3, 0x23, 0x66, 0x89, 0x2a, 0x29, 0xeb, 0xe8, 0xb9,
+	0x88, 0xe3, 0x1e, 0xd4, 0x86, 0x14, 0x19, 0xe3, 0x70, 0x43, 0x8d, 0x15, 0xb1, 0x9c, 0x63, 0xe4,
+	0x0d, 0x47, 0x1b, 0x5e, 0x53, 0x22, 0x16, 0xfd, 0x18, 0x92, 0x3e, 0xb9, 0xd8, 0x70, 0xc3, 0x78,
+	0x28, 0xdf, 0x74, 0xc7, 0x27, 0x74, 0xd3, 0x3d, 0x92, 0xc1, 0xef, 0xfc, 0x59, 0x81, 0xe2, 0xf2,
+	0xab, 0x18, 0x55, 0xe0, 0xb1, 0x6e, 0x98, 0x9d, 0x4f, 0x5a, 0x83, 0xce, 0xf1, 0x91, 0x35, 0xf8,
+	0xb4, 0x67, 0x58, 0x2f, 0x8e, 0xfa, 0x3d, 0x63, 0xbf, 0xf3, 0xbc, 0x63, 0xe8, 0xea, 0x16, 0x7a,
+	0x0c, 0xdf, 0x5a, 0x05, 0x74, 0x8e, 0x3e, 0x31, 0xcc, 0xbe, 0xa1, 0x2a, 0xaf, 0x72, 0xf6, 0xcc,
+	0x63, 0xfd, 0xc5, 0xfe, 0x40, 0x4d, 0xa0, 0xef, 0xc0, 0x93, 0x55, 0xa7, 0xf1, 0xd3, 0xfd, 0xc3,
+	0xd6, 0xd1, 0x81, 0x61, 0x99, 0xad, 0x81, 0xa1, 0x26, 0xd1, 0x13, 0x78, 0xb4, 0x0a, 0xe9, 0x9f,
+	0xb4, 0x7a, 0xd6, 0xe0, 0xa4, 0xd5, 0x53, 0x53, 0xbb, 0xa9, 0x5f, 0xfd, 0xbe, 0xbc, 0xf5, 0xce,
+	0xef, 0x14, 0x28, 0xad, 0x7c, 0xcd, 0xa2, 0x2a, 0x7c, 0xbb, 0x75, 0x70, 0x60, 0x1a, 0x07, 0x32,
+	0xb2, 0x7b, 0xac, 0xbf, 0x22, 0xef, 0x3b, 0x88, 0xae, 0xa1, 0x77, 0x5a, 0x47, 0xaa, 0xc2, 0x53,
+	0xbb, 0xe3, 0x1c, 0x98, 0x9d, 0x6e, 0xd7, 0xd0, 0xad, 0xae, 0xd1, 0x3a, 0x52, 0x13, 0xa8, 0x06,
+	0xe5, 0xbb, 0x90, 0x4e, 0xd7, 0xb0, 0x4e, 0x8c, 0xce, 0xc1, 0xe1, 0xc0, 0xd0, 0xd5, 0xa4, 0xcc,
+	0xaf, 0xfd, 0xf1, 0x57, 0xff, 0x2c, 0x2b, 0x7f, 0xb8, 0x2d, 0x2b, 0xd7, 0xb7, 0x65, 0xe5, 0x8b,
+	0xdb, 0xb2, 0xf2, 0xd5, 0x6d, 0x59, 0xf9, 0xfc, 0x65, 0x79, 0xeb, 0x8b, 0x97, 0xe5, 0xad, 0xbf,
+	0xbd, 0x2c, 0x6f, 0xfd, 0xac, 0xb9, 0xb0, 0x4d, 0x63, 0x1c, 0x39, 0x84, 0x7a, 0xf4, 0x99, 0x6f,
+	0x9f, 0xd2, 0xa6, 0xf8, 0x67, 0xce, 0xe5, 0xc2, 0xbf, 0x73, 0xc4, 0x9e, 0x9d, 0xa6, 0xc5, 0x09,
+	0xff, 0xfe, 0x7f, 0x06, 0x00, 0xe1, 0x74, 0xbc, 0x07, 0xed, 0x11, 0x00, 0x00,
+}
+
+func (this *Params) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -469,45 +1092,41 @@ func (this *PostedPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PostedPrice)
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(PostedPrice)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *PostedPrice")
+			return fmt.Errorf("that is not of type *Params")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+		return fmt.Errorf("that is type *Params but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+		return fmt.Errorf("that is type *Params but is not nil && this == nil")
 	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	if len(this.Markets) != len(that1.Markets) {
+		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
 	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
+		}
 	}
 	return nil
 }
-func (this *PostedPrice) Equal(that interface{}) bool {
+func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PostedPrice)
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(PostedPrice)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -519,21 +1138,17 @@ func (this *PostedPrice) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MarketID != that1.MarketID {
-		return false
-	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return false
-	}
-	if !this.Price.Equal(that1.Price) {
+	if len(this.Markets) != len(that1.Markets) {
 		return false
 	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return false
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+func (this *Market) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -541,39 +1156,86 @@ func (this *CurrentPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*Market)
 	if !ok {
-		that2, ok := that.(CurrentPrice)
+		that2, ok := that.(Market)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *CurrentPrice")
+			return fmt.Errorf("that is not of type *Market")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+		return fmt.Errorf("that is type *Market but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
+		return fmt.Errorf("that is type *Market but is not nil && this == nil")
 	}
 	if this.MarketID != that1.MarketID {
 		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	if this.BaseAsset != that1.BaseAsset {
+		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
+	}
+	if this.QuoteAsset != that1.QuoteAsset {
+		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
+	}
+	if len(this.Oracles) != len(that1.Oracles) {
+		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
+	}
+	for i := range this.Oracles {
+		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
+			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
+		}
+	}
+	if this.Active != that1.Active {
+		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	}
+	if this.AggregationMode != that1.AggregationMode {
+		return fmt.Errorf("AggregationMode this(%v) Not Equal that(%v)", this.AggregationMode, that1.AggregationMode)
+	}
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return fmt.Errorf("TrimFraction this(%v) Not Equal that(%v)", this.TrimFraction, that1.TrimFraction)
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return fmt.Errorf("TwapWindow this(%v) Not Equal that(%v)", this.TwapWindow, that1.TwapWindow)
+	}
+	if this.MinOraclePosts != that1.MinOraclePosts {
+		return fmt.Errorf("MinOraclePosts this(%v) Not Equal that(%v)", this.MinOraclePosts, that1.MinOraclePosts)
+	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return fmt.Errorf("MaxPriceDeviation this(%v) Not Equal that(%v)", this.MaxPriceDeviation, that1.MaxPriceDeviation)
+	}
+	if this.PriceHistoryLength != that1.PriceHistoryLength {
+		return fmt.Errorf("PriceHistoryLength this(%v) Not Equal that(%v)", this.PriceHistoryLength, that1.PriceHistoryLength)
+	}
+	if !this.OracleDeviationThreshold.Equal(that1.OracleDeviationThreshold) {
+		return fmt.Errorf("OracleDeviationThreshold this(%v) Not Equal that(%v)", this.OracleDeviationThreshold, that1.OracleDeviationThreshold)
+	}
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return fmt.Errorf("MaxMissedWindows this(%v) Not Equal that(%v)", this.MaxMissedWindows, that1.MaxMissedWindows)
+	}
+	if this.MaxDeviantWindows != that1.MaxDeviantWindows {
+		return fmt.Errorf("MaxDeviantWindows this(%v) Not Equal that(%v)", this.MaxDeviantWindows, that1.MaxDeviantWindows)
+	}
+	if !this.Derivation.Equal(&that1.Derivation) {
+		return fmt.Errorf("Derivation this(%v) Not Equal that(%v)", this.Derivation, that1.Derivation)
+	}
+	if this.FlagRecoveryRounds != that1.FlagRecoveryRounds {
+		return fmt.Errorf("FlagRecoveryRounds this(%v) Not Equal that(%v)", this.FlagRecoveryRounds, that1.FlagRecoveryRounds)
 	}
 	return nil
 }
-func (this *CurrentPrice) Equal(that interface{}) bool {
+func (this *Market) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*Market)
 	if !ok {
-		that2, ok := that.(CurrentPrice)
+		that2, ok := that.(Market)
 		if ok {
 			that1 = &that2
 		} else {
@@ -588,338 +1250,3659 @@ func (this *CurrentPrice) Equal(that interface{}) bool {
 	if this.MarketID != that1.MarketID {
 		return false
 	}
-	if !this.Price.Equal(that1.Price) {
+	if this.BaseAsset != that1.BaseAsset {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.QuoteAsset != that1.QuoteAsset {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(this.Oracles) != len(that1.Oracles) {
+		return false
+	}
+	for i := range this.Oracles {
+		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Active != that1.Active {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.AggregationMode != that1.AggregationMode {
+		return false
+	}
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return false
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if this.MinOraclePosts != that1.MinOraclePosts {
+		return false
+	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return false
+	}
+	if this.PriceHistoryLength != that1.PriceHistoryLength {
+		return false
+	}
+	if !this.OracleDeviationThreshold.Equal(that1.OracleDeviationThreshold) {
+		return false
+	}
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return false
+	}
+	if this.MaxDeviantWindows != that1.MaxDeviantWindows {
+		return false
+	}
+	if !this.Derivation.Equal(&that1.Derivation) {
+		return false
+	}
+	if this.FlagRecoveryRounds != that1.FlagRecoveryRounds {
+		return false
+	}
+	return true
 }
+func (this *MarketDerivation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
+	that1, ok := that.(*MarketDerivation)
+	if !ok {
+		that2, ok := that.(MarketDerivation)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return fmt.Errorf("that is not of type *MarketDerivation")
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *MarketDerivation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MarketDerivation but is not nil && this == nil")
 	}
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Type != that1.Type {
+		return fmt.Errorf("Type this(%v) Not Equal that(%v)", this.Type, that1.Type)
 	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.SourceMarketIDs) != len(that1.SourceMarketIDs) {
+		return fmt.Errorf("SourceMarketIDs this(%v) Not Equal that(%v)", len(this.SourceMarketIDs), len(that1.SourceMarketIDs))
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.SourceMarketIDs {
+		if this.SourceMarketIDs[i] != that1.SourceMarketIDs[i] {
+			return fmt.Errorf("SourceMarketIDs this[%v](%v) Not Equal that[%v](%v)", i, this.SourceMarketIDs[i], i, that1.SourceMarketIDs[i])
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DerivativeDenom != that1.DerivativeDenom {
+		return fmt.Errorf("DerivativeDenom this(%v) Not Equal that(%v)", this.DerivativeDenom, that1.DerivativeDenom)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err1 != nil {
-		return 0, err1
+	if this.SwapPoolID != that1.SwapPoolID {
+		return fmt.Errorf("SwapPoolID this(%v) Not Equal that(%v)", this.SwapPoolID, that1.SwapPoolID)
 	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
+	if this.SwapBaseDenom != that1.SwapBaseDenom {
+		return fmt.Errorf("SwapBaseDenom this(%v) Not Equal that(%v)", this.SwapBaseDenom, that1.SwapBaseDenom)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.SwapQuoteDenom != that1.SwapQuoteDenom {
+		return fmt.Errorf("SwapQuoteDenom this(%v) Not Equal that(%v)", this.SwapQuoteDenom, that1.SwapQuoteDenom)
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if this.SwapBaseDecimals != that1.SwapBaseDecimals {
+		return fmt.Errorf("SwapBaseDecimals this(%v) Not Equal that(%v)", this.SwapBaseDecimals, that1.SwapBaseDecimals)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SwapQuoteDecimals != that1.SwapQuoteDecimals {
+		return fmt.Errorf("SwapQuoteDecimals this(%v) Not Equal that(%v)", this.SwapQuoteDecimals, that1.SwapQuoteDecimals)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
+func (this *MarketDerivation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MarketDerivation)
+	if !ok {
+		that2, ok := that.(MarketDerivation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Type != that1.Type {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
+	if len(this.SourceMarketIDs) != len(that1.SourceMarketIDs) {
+		return false
+	}
+	for i := range this.SourceMarketIDs {
+		if this.SourceMarketIDs[i] != that1.SourceMarketIDs[i] {
+			return false
 		}
 	}
-	return n
+	if this.DerivativeDenom != that1.DerivativeDenom {
+		return false
+	}
+	if this.SwapPoolID != that1.SwapPoolID {
+		return false
+	}
+	if this.SwapBaseDenom != that1.SwapBaseDenom {
+		return false
+	}
+	if this.SwapQuoteDenom != that1.SwapQuoteDenom {
+		return false
+	}
+	if this.SwapBaseDecimals != that1.SwapBaseDecimals {
+		return false
+	}
+	if this.SwapQuoteDecimals != that1.SwapQuoteDecimals {
+		return false
+	}
+	return true
 }
+func (this *PostedPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PostedPrice")
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
 	}
-	if len(m.Oracles) > 0 {
-		for _, b := range m.Oracles {
-			l = len(b)
-			n += 1 + l + sovStore(uint64(l))
-		}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
 	}
-	if m.Active {
-		n += 2
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
 	}
-	return n
+	return nil
 }
+func (this *PostedPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostedPrice) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.OracleAddress)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if this.MarketID != that1.MarketID {
+		return false
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
 }
+func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *CurrentPrice) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CurrentPrice")
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	return nil
 }
+func (this *CurrentPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStore
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (this *MarketFlag) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MarketFlag)
+	if !ok {
+		that2, ok := that.(MarketFlag)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MarketFlag")
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
-			}
-			var msglen int
+		return fmt.Errorf("that is type *MarketFlag but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MarketFlag but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.ReferencePrice.Equal(that1.ReferencePrice) {
+		return fmt.Errorf("ReferencePrice this(%v) Not Equal that(%v)", this.ReferencePrice, that1.ReferencePrice)
+	}
+	if !this.FlaggedAt.Equal(that1.FlaggedAt) {
+		return fmt.Errorf("FlaggedAt this(%v) Not Equal that(%v)", this.FlaggedAt, that1.FlaggedAt)
+	}
+	if this.ConsistentRounds != that1.ConsistentRounds {
+		return fmt.Errorf("ConsistentRounds this(%v) Not Equal that(%v)", this.ConsistentRounds, that1.ConsistentRounds)
+	}
+	return nil
+}
+func (this *MarketFlag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MarketFlag)
+	if !ok {
+		that2, ok := that.(MarketFlag)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.ReferencePrice.Equal(that1.ReferencePrice) {
+		return false
+	}
+	if !this.FlaggedAt.Equal(that1.FlaggedAt) {
+		return false
+	}
+	if this.ConsistentRounds != that1.ConsistentRounds {
+		return false
+	}
+	return true
+}
+func (this *ReferencePrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ReferencePrice)
+	if !ok {
+		that2, ok := that.(ReferencePrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ReferencePrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ReferencePrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ReferencePrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.AcceptedAt.Equal(that1.AcceptedAt) {
+		return fmt.Errorf("AcceptedAt this(%v) Not Equal that(%v)", this.AcceptedAt, that1.AcceptedAt)
+	}
+	return nil
+}
+func (this *ReferencePrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferencePrice)
+	if !ok {
+		that2, ok := that.(ReferencePrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.AcceptedAt.Equal(that1.AcceptedAt) {
+		return false
+	}
+	return true
+}
+func (this *OracleReputation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleReputation)
+	if !ok {
+		that2, ok := that.(OracleReputation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleReputation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleReputation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleReputation but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.PostCount != that1.PostCount {
+		return fmt.Errorf("PostCount this(%v) Not Equal that(%v)", this.PostCount, that1.PostCount)
+	}
+	if !this.FirstPostedAt.Equal(that1.FirstPostedAt) {
+		return fmt.Errorf("FirstPostedAt this(%v) Not Equal that(%v)", this.FirstPostedAt, that1.FirstPostedAt)
+	}
+	if !this.LastPostedAt.Equal(that1.LastPostedAt) {
+		return fmt.Errorf("LastPostedAt this(%v) Not Equal that(%v)", this.LastPostedAt, that1.LastPostedAt)
+	}
+	if this.Windows != that1.Windows {
+		return fmt.Errorf("Windows this(%v) Not Equal that(%v)", this.Windows, that1.Windows)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.ConsecutiveMissedWindows != that1.ConsecutiveMissedWindows {
+		return fmt.Errorf("ConsecutiveMissedWindows this(%v) Not Equal that(%v)", this.ConsecutiveMissedWindows, that1.ConsecutiveMissedWindows)
+	}
+	if this.DeviantWindows != that1.DeviantWindows {
+		return fmt.Errorf("DeviantWindows this(%v) Not Equal that(%v)", this.DeviantWindows, that1.DeviantWindows)
+	}
+	if this.ConsecutiveDeviantWindows != that1.ConsecutiveDeviantWindows {
+		return fmt.Errorf("ConsecutiveDeviantWindows this(%v) Not Equal that(%v)", this.ConsecutiveDeviantWindows, that1.ConsecutiveDeviantWindows)
+	}
+	if !this.TotalDeviation.Equal(that1.TotalDeviation) {
+		return fmt.Errorf("TotalDeviation this(%v) Not Equal that(%v)", this.TotalDeviation, that1.TotalDeviation)
+	}
+	if !this.LastDeviation.Equal(that1.LastDeviation) {
+		return fmt.Errorf("LastDeviation this(%v) Not Equal that(%v)", this.LastDeviation, that1.LastDeviation)
+	}
+	if this.Excluded != that1.Excluded {
+		return fmt.Errorf("Excluded this(%v) Not Equal that(%v)", this.Excluded, that1.Excluded)
+	}
+	return nil
+}
+func (this *OracleReputation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleReputation)
+	if !ok {
+		that2, ok := that.(OracleReputation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.PostCount != that1.PostCount {
+		return false
+	}
+	if !this.FirstPostedAt.Equal(that1.FirstPostedAt) {
+		return false
+	}
+	if !this.LastPostedAt.Equal(that1.LastPostedAt) {
+		return false
+	}
+	if this.Windows != that1.Windows {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.ConsecutiveMissedWindows != that1.ConsecutiveMissedWindows {
+		return false
+	}
+	if this.DeviantWindows != that1.DeviantWindows {
+		return false
+	}
+	if this.ConsecutiveDeviantWindows != that1.ConsecutiveDeviantWindows {
+		return false
+	}
+	if !this.TotalDeviation.Equal(that1.TotalDeviation) {
+		return false
+	}
+	if !this.LastDeviation.Equal(that1.LastDeviation) {
+		return false
+	}
+	if this.Excluded != that1.Excluded {
+		return false
+	}
+	return true
+}
+func (this *PriceSnapshot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceSnapshot")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceSnapshot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceSnapshot but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.Height != that1.Height {
+		return fmt.Errorf("Height this(%v) Not Equal that(%v)", this.Height, that1.Height)
+	}
+	if !this.Time.Equal(that1.Time) {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return fmt.Errorf("CumulativePrice this(%v) Not Equal that(%v)", this.CumulativePrice, that1.CumulativePrice)
+	}
+	return nil
+}
+func (this *PriceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return false
+	}
+	return true
+}
+func (this *PriceHistory) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceHistory)
+	if !ok {
+		that2, ok := that.(PriceHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceHistory")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceHistory but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceHistory but is not nil && this == nil")
+	}
+	if this.First != that1.First {
+		return fmt.Errorf("First this(%v) Not Equal that(%v)", this.First, that1.First)
+	}
+	if this.Next != that1.Next {
+		return fmt.Errorf("Next this(%v) Not Equal that(%v)", this.Next, that1.Next)
+	}
+	return nil
+}
+func (this *PriceHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceHistory)
+	if !ok {
+		that2, ok := that.(PriceHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.First != that1.First {
+		return false
+	}
+	if this.Next != that1.Next {
+		return false
+	}
+	return true
+}
+func (this *Candle) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Candle)
+	if !ok {
+		that2, ok := that.(Candle)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Candle")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Candle but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Candle but is not nil && this == nil")
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", this.StartTime, that1.StartTime)
+	}
+	if !this.Open.Equal(that1.Open) {
+		return fmt.Errorf("Open this(%v) Not Equal that(%v)", this.Open, that1.Open)
+	}
+	if !this.High.Equal(that1.High) {
+		return fmt.Errorf("High this(%v) Not Equal that(%v)", this.High, that1.High)
+	}
+	if !this.Low.Equal(that1.Low) {
+		return fmt.Errorf("Low this(%v) Not Equal that(%v)", this.Low, that1.Low)
+	}
+	if !this.Close.Equal(that1.Close) {
+		return fmt.Errorf("Close this(%v) Not Equal that(%v)", this.Close, that1.Close)
+	}
+	return nil
+}
+func (this *Candle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Candle)
+	if !ok {
+		that2, ok := that.(Candle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.Open.Equal(that1.Open) {
+		return false
+	}
+	if !this.High.Equal(that1.High) {
+		return false
+	}
+	if !this.Low.Equal(that1.Low) {
+		return false
+	}
+	if !this.Close.Equal(that1.Close) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlagRecoveryRounds != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.FlagRecoveryRounds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.Derivation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.MaxDeviantWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxDeviantWindows))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxMissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxMissedWindows))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.OracleDeviationThreshold.Size()
+		i -= size
+		if _, err := m.OracleDeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinOraclePosts != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOraclePosts))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TrimFraction.Size()
+		i -= size
+		if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.AggregationMode != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.AggregationMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketDerivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketDerivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketDerivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapQuoteDecimals != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.SwapQuoteDecimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SwapBaseDecimals != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.SwapBaseDecimals))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SwapQuoteDenom) > 0 {
+		i -= len(m.SwapQuoteDenom)
+		copy(dAtA[i:], m.SwapQuoteDenom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.SwapQuoteDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SwapBaseDenom) > 0 {
+		i -= len(m.SwapBaseDenom)
+		copy(dAtA[i:], m.SwapBaseDenom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.SwapBaseDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SwapPoolID) > 0 {
+		i -= len(m.SwapPoolID)
+		copy(dAtA[i:], m.SwapPoolID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.SwapPoolID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DerivativeDenom) > 0 {
+		i -= len(m.DerivativeDenom)
+		copy(dAtA[i:], m.DerivativeDenom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.DerivativeDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceMarketIDs) > 0 {
+		for iNdEx := len(m.SourceMarketIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceMarketIDs[iNdEx])
+			copy(dAtA[i:], m.SourceMarketIDs[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.SourceMarketIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsistentRounds != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ConsistentRounds))
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FlaggedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FlaggedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStore(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferencePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferencePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferencePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AcceptedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStore(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Excluded {
+		i--
+		if m.Excluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.LastDeviation.Size()
+		i -= size
+		if _, err := m.LastDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TotalDeviation.Size()
+		i -= size
+		if _, err := m.TotalDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.ConsecutiveDeviantWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ConsecutiveDeviantWindows))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DeviantWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.DeviantWindows))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ConsecutiveMissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ConsecutiveMissedWindows))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Windows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Windows))
+		i--
+		dAtA[i] = 0x30
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastPostedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastPostedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStore(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FirstPostedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstPostedAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStore(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.PostCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PostCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStore(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Next != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Next))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.First != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.First))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStore(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, b := range m.Oracles {
+			l = len(b)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.AggregationMode != 0 {
+		n += 1 + sovStore(uint64(m.AggregationMode))
+	}
+	l = m.TrimFraction.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovStore(uint64(l))
+	if m.MinOraclePosts != 0 {
+		n += 1 + sovStore(uint64(m.MinOraclePosts))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovStore(uint64(m.PriceHistoryLength))
+	}
+	l = m.OracleDeviationThreshold.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.MaxMissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MaxMissedWindows))
+	}
+	if m.MaxDeviantWindows != 0 {
+		n += 1 + sovStore(uint64(m.MaxDeviantWindows))
+	}
+	l = m.Derivation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.FlagRecoveryRounds != 0 {
+		n += 2 + sovStore(uint64(m.FlagRecoveryRounds))
+	}
+	return n
+}
+
+func (m *MarketDerivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovStore(uint64(m.Type))
+	}
+	if len(m.SourceMarketIDs) > 0 {
+		for _, s := range m.SourceMarketIDs {
+			l = len(s)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	l = len(m.DerivativeDenom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.SwapPoolID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.SwapBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.SwapQuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.SwapBaseDecimals != 0 {
+		n += 1 + sovStore(uint64(m.SwapBaseDecimals))
+	}
+	if m.SwapQuoteDecimals != 0 {
+		n += 1 + sovStore(uint64(m.SwapQuoteDecimals))
+	}
+	return n
+}
+
+func (m *PostedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CurrentPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *MarketFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FlaggedAt)
+	n += 1 + l + sovStore(uint64(l))
+	if m.ConsistentRounds != 0 {
+		n += 1 + sovStore(uint64(m.ConsistentRounds))
+	}
+	return n
+}
+
+func (m *ReferencePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AcceptedAt)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *OracleReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.PostCount != 0 {
+		n += 1 + sovStore(uint64(m.PostCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstPostedAt)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastPostedAt)
+	n += 1 + l + sovStore(uint64(l))
+	if m.Windows != 0 {
+		n += 1 + sovStore(uint64(m.Windows))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MissedWindows))
+	}
+	if m.ConsecutiveMissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.ConsecutiveMissedWindows))
+	}
+	if m.DeviantWindows != 0 {
+		n += 1 + sovStore(uint64(m.DeviantWindows))
+	}
+	if m.ConsecutiveDeviantWindows != 0 {
+		n += 1 + sovStore(uint64(m.ConsecutiveDeviantWindows))
+	}
+	l = m.TotalDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.LastDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.Excluded {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStore(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PriceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.First != 0 {
+		n += 1 + sovStore(uint64(m.First))
+	}
+	if m.Next != 0 {
+		n += 1 + sovStore(uint64(m.Next))
+	}
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMode", wireType)
+			}
+			m.AggregationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMode |= AggregationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOraclePosts", wireType)
+			}
+			m.MinOraclePosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOraclePosts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDeviationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleDeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedWindows", wireType)
+			}
+			m.MaxMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviantWindows", wireType)
+			}
+			m.MaxDeviantWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviantWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Derivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlagRecoveryRounds", wireType)
+			}
+			m.FlagRecoveryRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlagRecoveryRounds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketDerivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketDerivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketDerivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= DerivationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMarketIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceMarketIDs = append(m.SourceMarketIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapPoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapBaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapQuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapQuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBaseDecimals", wireType)
+			}
+			m.SwapBaseDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapBaseDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapQuoteDecimals", wireType)
+			}
+			m.SwapQuoteDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapQuoteDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlaggedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FlaggedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentRounds", wireType)
+			}
+			m.ConsistentRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsistentRounds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferencePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferencePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferencePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AcceptedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+			}
+			m.PostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstPostedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FirstPostedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPostedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastPostedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			m.Windows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Windows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
+			}
+			m.MissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedWindows", wireType)
+			}
+			m.ConsecutiveMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviantWindows", wireType)
+			}
+			m.DeviantWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviantWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveDeviantWindows", wireType)
+			}
+			m.ConsecutiveDeviantWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveDeviantWindows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -929,26 +4912,12 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Excluded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -970,7 +4939,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -993,10 +4962,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,10 +5001,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1045,29 +5014,16 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1077,29 +5033,30 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1109,29 +5066,31 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1141,12 +5100,26 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1168,7 +5141,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+func (m *PriceHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1191,17 +5164,17 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
 			}
-			var stringLen uint64
+			m.First = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1211,29 +5184,85 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.First |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStore
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStore
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1243,29 +5272,28 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OracleAddress == nil {
-				m.OracleAddress = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1293,15 +5321,15 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1311,78 +5339,29 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStore(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStore
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStore
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1410,11 +5389,13 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1442,7 +5423,7 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,20 +2,24 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/percosis-labs/fury/x/swap/types"
 )
 
 // flags for cli queries
 const (
-	flagOwner = "owner"
-	flagPool  = "pool"
+	flagOwner   = "owner"
+	flagPool    = "pool"
+	flagEndTime = "end-time"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -32,6 +36,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		queryParamsCmd(queryRoute),
 		queryDepositsCmd(queryRoute),
 		queryPoolsCmd(queryRoute),
+		queryQuoteCmd(queryRoute),
+		queryTwapCmd(queryRoute),
 	}
 
 	for _, cmd := range cmds {
@@ -152,3 +158,106 @@ func queryPoolsCmd(queryRoute string) *cobra.Command {
 	}
 	return cmd
 }
+
+func queryQuoteCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [exactCoinIn] [denomOut]",
+		Short: "get the expected output of a swap",
+		Long: strings.TrimSpace(`get the route with the largest output for an exact input and its expected output:
+ 		Example:
+ 		$ fucli q swap quote 1000000ubnb usdf
+ 		$ fucli q swap quote 1000000ubnb usdf --pools bnb:ufury,ufury:usdf`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			pools, err := readPoolsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryQuoteRequest{
+				TokenIn:  tokenIn,
+				DenomOut: args[1],
+				Pools:    pools,
+			}
+			res, err := queryClient.Quote(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPools, "", "comma separated pool ids to trade through in order")
+
+	return cmd
+}
+
+func queryTwapCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [poolID] [baseDenom] [quoteDenom] [window]",
+		Short: "get the time-weighted average price of a pool",
+		Long: strings.TrimSpace(`get the time-weighted average price of a pool's base denom in its quote denom over a window, ending at the current block time unless an end time is given:
+ 		Example:
+ 		$ fucli q swap twap ufury:usdf ufury usdf 1h
+ 		$ fucli q swap twap ufury:usdf usdf ufury 30m --end-time 2022-01-01T00:00:00Z`,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			window, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid window %s: %w", args[3], err)
+			}
+
+			var endTime time.Time
+			endTimeStr, err := cmd.Flags().GetString(flagEndTime)
+			if err != nil {
+				return err
+			}
+			if endTimeStr != "" {
+				endTime, err = time.Parse(time.RFC3339, endTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid %s %s: %w", flagEndTime, endTimeStr, err)
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryTwapRequest{
+				PoolId:     args[0],
+				BaseDenom:  args[1],
+				QuoteDenom: args[2],
+				Window:     window,
+				EndTime:    endTime,
+			}
+			res, err := queryClient.Twap(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagEndTime, "", "(optional) end of the window, in RFC3339 format")
+
+	return cmd
+}
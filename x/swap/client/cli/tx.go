@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +17,13 @@ import (
 	"github.com/percosis-labs/fury/x/swap/types"
 )
 
+// flags for cli transactions
+const (
+	flagPools     = "pools"
+	flagMinTokens = "min-tokens"
+	flagDenomOut  = "denom-out"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	swapTxCmd := &cobra.Command{
@@ -31,6 +39,10 @@ func GetTxCmd() *cobra.Command {
 		getCmdWithdraw(),
 		getCmdSwapExactForTokens(),
 		getCmdSwapForExactTokens(),
+		getCmdSwapExactForTokensMultiHop(),
+		getCmdSwapForExactTokensMultiHop(),
+		getCmdDepositToPool(),
+		getCmdWithdrawFromPool(),
 	}
 
 	for _, cmd := range cmds {
@@ -226,3 +238,226 @@ func getCmdSwapForExactTokens() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSwapExactForTokensMultiHop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-for-tokens-multi-hop [exactCoinA] [coinB] [slippage] [deadline]",
+		Short: "swap an exact amount of token a for token b through a route of pools",
+		Long:  "Swap an exact amount of token a for token b through a route of pools. If no pools are given, the route with the largest output is used.",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-exact-for-tokens-multi-hop 1000000ubnb 5000000usdf 0.01 1624224736 --pools bnb:ufury,ufury:usdf --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pools, err := readPoolsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapExactForTokensMultiHop(fromAddr.String(), exactTokenA, tokenB, pools, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPools, "", "comma separated pool ids to trade through in order")
+
+	return cmd
+}
+
+func getCmdSwapForExactTokensMultiHop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-for-exact-tokens-multi-hop [coinA] [exactCoinB] [slippage] [deadline]",
+		Short: "swap token a for exact amount of token b through a route of pools",
+		Long:  "Swap token a for an exact amount of token b through a route of pools. If no pools are given, the route with the smallest input is used.",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-for-exact-tokens-multi-hop 1000000ubnb 5000000usdf 0.01 1624224736 --pools bnb:ufury,ufury:usdf --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			exactTokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pools, err := readPoolsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapForExactTokensMultiHop(fromAddr.String(), tokenA, exactTokenB, pools, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPools, "", "comma separated pool ids to trade through in order")
+
+	return cmd
+}
+
+func getCmdDepositToPool() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-to-pool [poolID] [coins] [minShares] [deadline]",
+		Short: "deposit coins to a swap liquidity pool by pool id",
+		Long:  "Deposit coins to a swap liquidity pool by pool id. Weighted pools also accept a deposit of a single coin, which is charged the swap fee on the implicitly swapped portion.",
+		Example: fmt.Sprintf(
+			`%s tx %s deposit-to-pool jinx:ufury:usdf 10000000jinx,10000000ufury,50000000usdf 1000000 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokens, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			minShares, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid min shares: %s", args[2])
+			}
+
+			deadline, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgDepositToPool(fromAddr.String(), args[0], tokens, minShares, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdWithdrawFromPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-from-pool [poolID] [shares] [deadline]",
+		Short: "withdraw coins from a swap liquidity pool by pool id",
+		Long:  "Withdraw coins from a swap liquidity pool by pool id. Weighted pools also support a withdraw of a single coin with --denom-out, which is charged the swap fee on the implicitly swapped portion.",
+		Example: fmt.Sprintf(
+			`%s tx %s withdraw-from-pool ufury:usdf@80:20 153000 1624224736 --min-tokens 10000000ufury --denom-out ufury --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			shares, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid shares: %s", args[1])
+			}
+
+			deadline, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minTokensFlag, err := cmd.Flags().GetString(flagMinTokens)
+			if err != nil {
+				return err
+			}
+			minTokens, err := sdk.ParseCoinsNormalized(minTokensFlag)
+			if err != nil {
+				return err
+			}
+
+			denomOut, err := cmd.Flags().GetString(flagDenomOut)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgWithdrawFromPool(fromAddr.String(), args[0], shares, minTokens, denomOut, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMinTokens, "", "comma separated minimum coins to withdraw")
+	cmd.Flags().String(flagDenomOut, "", "single denom to withdraw from a weighted pool, or every pool denom if empty")
+
+	return cmd
+}
+
+// readPoolsFlag returns the pool ids of the pools flag, or nil if it is not set
+func readPoolsFlag(cmd *cobra.Command) ([]string, error) {
+	pools, err := cmd.Flags().GetString(flagPools)
+	if err != nil {
+		return nil, err
+	}
+	if pools == "" {
+		return nil, nil
+	}
+
+	return strings.Split(pools, ","), nil
+}
@@ -22,6 +22,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, sh := range gs.ShareRecords {
 		k.SetDepositorShares(ctx, sh)
 	}
+	for _, ps := range gs.PoolPriceSnapshots {
+		k.AppendPoolPriceSnapshot(ctx, ps)
+	}
 }
 
 // ExportGenesis exports the genesis state
@@ -29,6 +32,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	pools := k.GetAllPools(ctx)
 	shares := k.GetAllDepositorShares(ctx)
+	snapshots := k.GetAllPoolPriceSnapshots(ctx)
 
-	return types.NewGenesisState(params, pools, shares)
+	return types.NewGenesisState(params, pools, shares, snapshots)
 }
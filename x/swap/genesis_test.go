@@ -2,6 +2,7 @@ package swap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/percosis-labs/fury/app"
 	"github.com/percosis-labs/fury/x/swap"
@@ -24,6 +25,7 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 		},
 		types.PoolRecords{},
 		types.ShareRecords{},
+		types.PoolPriceSnapshots{},
 	)
 
 	suite.Panics(func() {
@@ -51,6 +53,16 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 			types.NewShareRecord(depositor_2, types.PoolID("jinx", "usdf"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ufury", "usdf"), sdkmath.NewInt(3e6)),
 		},
+		types.PoolPriceSnapshots{
+			types.NewPoolPriceSnapshot(types.PoolID("ufury", "usdf"), 1, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				[]sdk.Dec{sdk.MustNewDecFromStr("5.0"), sdk.MustNewDecFromStr("0.2")},
+				[]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			),
+			types.NewPoolPriceSnapshot(types.PoolID("ufury", "usdf"), 2, time.Date(2022, 1, 1, 0, 0, 6, 0, time.UTC),
+				[]sdk.Dec{sdk.MustNewDecFromStr("4.0"), sdk.MustNewDecFromStr("0.25")},
+				[]sdk.Dec{sdk.MustNewDecFromStr("30.0"), sdk.MustNewDecFromStr("1.2")},
+			),
+		},
 	)
 
 	swap.InitGenesis(suite.Ctx, suite.Keeper, state)
@@ -66,6 +78,13 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	shareRecord2, _ := suite.Keeper.GetDepositorShares(suite.Ctx, depositor_1, types.PoolID("ufury", "usdf"))
 	suite.Equal(state.ShareRecords[1], shareRecord2)
 
+	snapshots := []types.PoolPriceSnapshot{}
+	suite.Keeper.IteratePoolPriceSnapshots(suite.Ctx, types.PoolID("ufury", "usdf"), func(ps types.PoolPriceSnapshot) bool {
+		snapshots = append(snapshots, ps)
+		return false
+	})
+	suite.Equal([]types.PoolPriceSnapshot(state.PoolPriceSnapshots), snapshots)
+
 	exportedState := swap.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -90,6 +109,7 @@ func (suite *genesisTestSuite) Test_Marshall() {
 			types.NewShareRecord(depositor_2, types.PoolID("jinx", "usdf"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ufury", "usdf"), sdkmath.NewInt(3e6)),
 		},
+		types.PoolPriceSnapshots{},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -125,6 +145,7 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 			types.NewShareRecord(depositor_2, types.PoolID("jinx", "usdf"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ufury", "usdf"), sdkmath.NewInt(3e6)),
 		},
+		types.PoolPriceSnapshots{},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -45,7 +45,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	poolRecord, found := k.GetPool(ctx, poolID)
 
 	var (
-		pool          *types.DenominatedPool
+		pool          types.Pool
 		depositAmount sdk.Coins
 		shares        sdkmath.Int
 		err           error
@@ -77,6 +77,58 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 		return errorsmod.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
 	}
 
+	return k.commitDeposit(ctx, depositor, poolID, pool, depositAmount, shares)
+}
+
+// DepositToPool creates a new pool or adds liquidity to an existing pool by pool id, which supports pools of
+// any type and number of assets.  For a pool to be created, the deposit must include every asset of the pool,
+// and the pool must be allowed by the swap module parameters.
+//
+// When the deposit includes every asset of an existing pool, liquidity is added in proportion to the pool
+// reserves, and the actual deposited coins may be less than or equal to the provided coins.
+//
+// A weighted pool also accepts a deposit of a single asset.  Since this is equal to a proportional deposit and
+// a swap of the single asset for the other assets, the swap fee is charged on the implicitly swapped portion of
+// the deposit and remains in the pool reserves.
+//
+// An error is returned when the shares created are less than minShares.
+func (k Keeper) DepositToPool(ctx sdk.Context, depositor sdk.AccAddress, poolID string, coins sdk.Coins, minShares sdkmath.Int) error {
+	poolRecord, found := k.GetPool(ctx, poolID)
+
+	var (
+		pool          types.Pool
+		depositAmount sdk.Coins
+		shares        sdkmath.Int
+		err           error
+	)
+	if !found {
+		pool, depositAmount, shares, err = k.initializePool(ctx, poolID, depositor, coins)
+		if err == nil && types.NewPoolRecordFromPool(pool).PoolID != poolID {
+			err = errorsmod.Wrapf(types.ErrInvalidPool, "deposit %s must include every asset of pool %s", coins, poolID)
+		}
+	} else if len(coins) == 1 {
+		pool, depositAmount, shares, err = k.addSingleAssetLiquidityToPool(ctx, poolRecord, coins[0])
+	} else {
+		pool, depositAmount, shares, err = k.addLiquidityToPool(ctx, poolRecord, depositor, coins)
+	}
+	if err != nil {
+		return err
+	}
+
+	if depositAmount.Empty() || shares.IsZero() {
+		return errorsmod.Wrap(types.ErrInsufficientLiquidity, "deposit must be increased")
+	}
+
+	if shares.LT(minShares) {
+		return errorsmod.Wrapf(types.ErrSlippageExceeded, "shares %s < min shares %s", shares, minShares)
+	}
+
+	return k.commitDeposit(ctx, depositor, poolID, pool, depositAmount, shares)
+}
+
+// commitDeposit saves the pool and the depositor shares, calling the deposit hooks, and transfers the deposit
+// from the depositor
+func (k Keeper) commitDeposit(ctx sdk.Context, depositor sdk.AccAddress, poolID string, pool types.Pool, depositAmount sdk.Coins, shares sdkmath.Int) error {
 	k.updatePool(ctx, poolID, pool)
 	if shareRecord, hasExistingShares := k.GetDepositorShares(ctx, depositor, poolID); hasExistingShares {
 		k.BeforePoolDepositModified(ctx, poolID, depositor, shareRecord.SharesOwned)
@@ -86,7 +138,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 		k.AfterPoolDepositCreated(ctx, poolID, depositor, shares)
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, depositAmount)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, depositAmount)
 	if err != nil {
 		return err
 	}
@@ -104,22 +156,24 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	return nil
 }
 
-func (k Keeper) depositAllowed(ctx sdk.Context, poolID string) bool {
+// getAllowedPool returns the allowed pool for a pool id and false if the pool is not allowed
+func (k Keeper) getAllowedPool(ctx sdk.Context, poolID string) (types.AllowedPool, bool) {
 	params := k.GetParams(ctx)
 	for _, p := range params.AllowedPools {
-		if poolID == types.PoolID(p.TokenA, p.TokenB) {
-			return true
+		if poolID == p.Name() {
+			return p, true
 		}
 	}
-	return false
+	return types.AllowedPool{}, false
 }
 
-func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	if allowed := k.depositAllowed(ctx, poolID); !allowed {
+func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (types.Pool, sdk.Coins, sdkmath.Int, error) {
+	allowedPool, allowed := k.getAllowedPool(ctx, poolID)
+	if !allowed {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
 	}
 
-	pool, err := types.NewDenominatedPool(reserves)
+	pool, err := types.NewPoolForAllowedPool(allowedPool, reserves)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -127,8 +181,8 @@ func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.Acc
 	return pool, pool.Reserves(), pool.TotalShares(), nil
 }
 
-func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	pool, err := types.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (types.Pool, sdk.Coins, sdkmath.Int, error) {
+	pool, err := types.NewPoolFromRecord(record)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -137,3 +191,19 @@ func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, dep
 
 	return pool, depositAmount, shares, nil
 }
+
+func (k Keeper) addSingleAssetLiquidityToPool(ctx sdk.Context, record types.PoolRecord, deposit sdk.Coin) (types.Pool, sdk.Coins, sdkmath.Int, error) {
+	pool, err := types.NewPoolFromRecord(record)
+	if err != nil {
+		return nil, sdk.Coins{}, sdk.ZeroInt(), err
+	}
+
+	weightedPool, ok := pool.(*types.WeightedPool)
+	if !ok {
+		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidPool, "pool %s does not support single asset deposits", record.PoolID)
+	}
+
+	shares, _ := weightedPool.AddSingleAssetLiquidity(deposit, k.GetPoolSwapFee(ctx, record.PoolID))
+
+	return weightedPool, sdk.NewCoins(deposit), shares, nil
+}
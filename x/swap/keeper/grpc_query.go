@@ -59,15 +59,15 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 		}
 
 		if shouldAccumulate {
-			denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+			pool, err := types.NewPoolFromRecord(poolRecord)
 			if err != nil {
 				return true, types.ErrInvalidPool
 			}
-			totalCoins := denominatedPool.ShareValue(denominatedPool.TotalShares())
+			totalCoins := pool.ShareValue(pool.TotalShares())
 			queryResult := types.PoolResponse{
 				Name:        poolRecord.PoolID,
 				Coins:       totalCoins,
-				TotalShares: denominatedPool.TotalShares(),
+				TotalShares: pool.TotalShares(),
 			}
 			queryResults = append(queryResults, queryResult)
 		}
@@ -129,7 +129,7 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 
 	var queryResults []types.DepositResponse
 	for _, record := range records {
-		pool, err := s.keeper.loadDenominatedPool(ctx, record.PoolID)
+		pool, err := s.keeper.loadPoolByID(ctx, record.PoolID)
 		if err != nil {
 			return nil, err
 		}
@@ -148,3 +148,62 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		Pagination: pageRes,
 	}, nil
 }
+
+// Quote implements the Query/Quote gRPC method
+func (s queryServer) Quote(c context.Context, req *types.QueryQuoteRequest) (*types.QueryQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.TokenIn.IsValid() || !req.TokenIn.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in %s", req.TokenIn)
+	}
+
+	if err := sdk.ValidateDenom(req.DenomOut); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pools, tokenOut, feesPaid, err := s.keeper.QuoteWithExactInput(ctx, req.TokenIn, req.DenomOut, req.Pools)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuoteResponse{
+		Pools:    pools,
+		TokenOut: tokenOut,
+		FeesPaid: feesPaid,
+	}, nil
+}
+
+// Twap implements the Query/Twap gRPC method
+func (s queryServer) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := s.keeper.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Error(codes.NotFound, "invalid pool ID")
+	}
+	if req.Window < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window cannot be negative")
+	}
+	endTime := req.EndTime
+	if endTime.IsZero() || endTime.After(ctx.BlockTime()) {
+		endTime = ctx.BlockTime()
+	}
+
+	price, startTime, err := s.keeper.GetTWAPBetween(ctx, req.PoolId, req.BaseDenom, req.QuoteDenom, endTime.Add(-req.Window), endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{
+		Price:     price,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -71,11 +72,22 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
+// GetPriceHistoryWindow returns the window pool price histories are kept for
+func (k Keeper) GetPriceHistoryWindow(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).PriceHistoryWindow
+}
+
 // GetSwapFee returns the swap fee set in the module parameters
 func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SwapFee
 }
 
+// GetPoolSwapFee returns the swap fee of a pool, which overrides the global swap fee when set on its allowed pool
+func (k Keeper) GetPoolSwapFee(ctx sdk.Context, poolID string) sdk.Dec {
+	params := k.GetParams(ctx)
+	return params.AllowedPools.SwapFee(poolID, params.SwapFee)
+}
+
 // GetSwapModuleAccount returns the swap ModuleAccount
 func (k Keeper) GetSwapModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -239,12 +251,16 @@ func (k Keeper) GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddre
 	return record.SharesOwned, true
 }
 
-// updatePool updates a pool, deleting the pool record if the shares are zero
-func (k Keeper) updatePool(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
+// updatePool updates a pool and records its prices, deleting the pool record and its price history if the shares
+// are zero
+func (k Keeper) updatePool(ctx sdk.Context, poolID string, pool types.Pool) {
 	if pool.TotalShares().IsZero() {
 		k.DeletePool(ctx, poolID)
+		k.deletePoolPriceHistory(ctx, poolID)
 	} else {
-		k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+		record := types.NewPoolRecordFromPool(pool)
+		k.SetPool(ctx, record)
+		k.recordPoolPriceSnapshot(ctx, record)
 	}
 }
 
@@ -258,14 +274,14 @@ func (k Keeper) updateDepositorShares(ctx sdk.Context, owner sdk.AccAddress, poo
 	}
 }
 
-func (k Keeper) loadDenominatedPool(ctx sdk.Context, poolID string) (*types.DenominatedPool, error) {
+func (k Keeper) loadPoolByID(ctx sdk.Context, poolID string) (types.Pool, error) {
 	poolRecord, found := k.GetPool(ctx, poolID)
 	if !found {
-		return &types.DenominatedPool{}, types.ErrInvalidPool
+		return nil, types.ErrInvalidPool
 	}
-	denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewPoolFromRecord(poolRecord)
 	if err != nil {
-		return &types.DenominatedPool{}, types.ErrInvalidPool
+		return nil, types.ErrInvalidPool
 	}
-	return denominatedPool, nil
+	return pool, nil
 }
@@ -63,7 +63,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("ufury", "usdf"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.03"),
+		SwapFee:              sdk.MustNewDecFromStr("0.03"),
+		ProtocolFeeShare:     sdk.MustNewDecFromStr("0.1"),
+		ProtocolFeeRecipient: "community",
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.Equal(keeper.GetParams(suite.Ctx), params)
@@ -73,7 +75,8 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("jinx", "ufury"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:          sdk.MustNewDecFromStr("0.01"),
+		ProtocolFeeShare: sdk.ZeroDec(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.NotEqual(keeper.GetParams(suite.Ctx), oldParams)
@@ -91,6 +94,24 @@ func (suite keeperTestSuite) TestParams_GetSwapFee() {
 	suite.Equal(keeper.GetSwapFee(suite.Ctx), params.SwapFee)
 }
 
+func (suite *keeperTestSuite) TestParams_GetPoolSwapFee() {
+	params := types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ufury", "usdf"),
+			types.NewStableSwapAllowedPool("usdc", "usdf", 100).WithSwapFee(sdk.MustNewDecFromStr("0.0004")),
+			types.NewAllowedPool("jinx", "ufury").WithSwapFee(sdk.ZeroDec()),
+		),
+		sdk.MustNewDecFromStr("0.003"),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	suite.Equal(sdk.MustNewDecFromStr("0.003"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "ufury:usdf"))
+	suite.Equal(sdk.MustNewDecFromStr("0.0004"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "usdc:usdf"))
+	suite.Equal(sdk.ZeroDec(), suite.Keeper.GetPoolSwapFee(suite.Ctx, "jinx:ufury"))
+	// pools that are no longer allowed use the global swap fee
+	suite.Equal(sdk.MustNewDecFromStr("0.003"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "bnb:ufury"))
+}
+
 func (suite *keeperTestSuite) TestPool_Persistance() {
 	reserves := sdk.NewCoins(
 		sdk.NewCoin("ufury", sdkmath.NewInt(10e6)),
@@ -133,6 +133,118 @@ func (m msgServer) SwapForExactTokens(goCtx context.Context, msg *types.MsgSwapF
 	return &types.MsgSwapForExactTokensResponse{}, nil
 }
 
+// SwapExactForTokensMultiHop handles MsgSwapExactForTokensMultiHop messages
+func (m msgServer) SwapExactForTokensMultiHop(goCtx context.Context, msg *types.MsgSwapExactForTokensMultiHop) (*types.MsgSwapExactForTokensMultiHopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapExactForTokensMultiHop(ctx, requester, msg.ExactTokenA, msg.TokenB, msg.Pools, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapExactForTokensMultiHopResponse{}, nil
+}
+
+// SwapForExactTokensMultiHop handles MsgSwapForExactTokensMultiHop messages
+func (m msgServer) SwapForExactTokensMultiHop(goCtx context.Context, msg *types.MsgSwapForExactTokensMultiHop) (*types.MsgSwapForExactTokensMultiHopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapForExactTokensMultiHop(ctx, requester, msg.TokenA, msg.ExactTokenB, msg.Pools, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapForExactTokensMultiHopResponse{}, nil
+}
+
+// DepositToPool handles MsgDepositToPool messages
+func (m msgServer) DepositToPool(goCtx context.Context, msg *types.MsgDepositToPool) (*types.MsgDepositToPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.DepositToPool(ctx, depositor, msg.PoolID, msg.Tokens, msg.MinShares); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+
+	return &types.MsgDepositToPoolResponse{}, nil
+}
+
+// WithdrawFromPool handles MsgWithdrawFromPool messages
+func (m msgServer) WithdrawFromPool(goCtx context.Context, msg *types.MsgWithdrawFromPool) (*types.MsgWithdrawFromPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.WithdrawFromPool(ctx, from, msg.PoolID, msg.Shares, msg.MinTokens, msg.DenomOut); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgWithdrawFromPoolResponse{}, nil
+}
+
 // checkDeadline returns an error if block time exceeds an included deadline
 func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 	deadlineMsg, ok := msg.(types.MsgWithDeadline)
@@ -586,6 +586,86 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens_DeadlineExceeded() {
 	suite.Nil(res)
 }
 
+func (suite *msgServerTestSuite) TestSwapExactForTokensMultiHop() {
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("bnb", sdkmath.NewInt(100e6)),
+		sdk.NewCoin("ufury", sdkmath.NewInt(1000e6)),
+	)))
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ufury", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdf", sdkmath.NewInt(5000e6)),
+	)))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("bnb", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapInput := sdk.NewCoin("bnb", sdkmath.NewInt(1e6))
+	swapMsg := types.NewMsgSwapExactForTokensMultiHop(
+		requester.GetAddress().String(),
+		swapInput,
+		sdk.NewCoin("usdf", sdkmath.NewInt(49e6)),
+		[]string{"bnb:ufury", "ufury:usdf"},
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapExactForTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapExactForTokensMultiHopResponse{}, res)
+	suite.Require().NoError(err)
+
+	// intermediate ufury never leaves the module account
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(swapInput).Add(sdk.NewCoin("usdf", sdkmath.NewInt(48730223))))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensMultiHop_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("bnb", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapExactForTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("bnb", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("usdf", sdkmath.NewInt(49e6)),
+		nil,
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapExactForTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensMultiHop_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("bnb", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapForExactTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("bnb", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("usdf", sdkmath.NewInt(48e6)),
+		nil,
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapForExactTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(msgServerTestSuite))
 }
@@ -63,7 +63,7 @@ func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQu
 	// Augment each deposit result with the actual share value of depositor's shares
 	var queryResults types.DepositsQueryResults
 	for _, record := range records {
-		pool, err := k.loadDenominatedPool(ctx, record.PoolID)
+		pool, err := k.loadPoolByID(ctx, record.PoolID)
 		if err != nil {
 			return nil, err
 		}
@@ -92,7 +92,7 @@ func queryGetPool(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerie
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "must specify pool param")
 	}
 
-	pool, err := k.loadDenominatedPool(ctx, params.Pool)
+	pool, err := k.loadPoolByID(ctx, params.Pool)
 	if err != nil {
 		return nil, err
 	}
@@ -112,12 +112,12 @@ func queryGetPools(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQueri
 
 	var queryResults types.PoolStatsQueryResults
 	for _, pool := range pools {
-		denomPool, err := k.loadDenominatedPool(ctx, pool.PoolID)
+		loadedPool, err := k.loadPoolByID(ctx, pool.PoolID)
 		if err != nil {
 			return nil, err
 		}
-		totalCoins := denomPool.ShareValue(denomPool.TotalShares())
-		queryResult := types.NewPoolStatsQueryResult(pool.PoolID, totalCoins, denomPool.TotalShares())
+		totalCoins := loadedPool.ShareValue(loadedPool.TotalShares())
+		queryResult := types.NewPoolStatsQueryResult(pool.PoolID, totalCoins, loadedPool.TotalShares())
 		queryResults = append(queryResults, queryResult)
 	}
 
@@ -0,0 +1,331 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/swap/types"
+)
+
+// routeSwap is a single trade through one pool of a route
+type routeSwap struct {
+	poolID  string
+	pool    types.Pool
+	input   sdk.Coin
+	output  sdk.Coin
+	feePaid sdk.Coin
+}
+
+// SwapExactForTokensMultiHop swaps an exact coin a input for a coin b output through a route of pools. If no
+// pools are given the route with the largest output is used.
+func (k Keeper) SwapExactForTokensMultiHop(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, pools []string, slippageLimit sdk.Dec) error {
+	if len(pools) == 0 {
+		var err error
+		pools, _, err = k.FindRouteWithExactInput(ctx, exactCoinA, coinB.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	swaps, err := k.swapRouteWithExactInput(ctx, pools, exactCoinA, coinB.Denom)
+	if err != nil {
+		return err
+	}
+
+	swapOutput := swaps[len(swaps)-1].output
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitRouteSwap(ctx, requester, swaps, "input")
+}
+
+// SwapForExactTokensMultiHop swaps a coin a input for an exact coin b output through a route of pools. If no
+// pools are given the route with the smallest input is used.
+func (k Keeper) SwapForExactTokensMultiHop(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, pools []string, slippageLimit sdk.Dec) error {
+	if len(pools) == 0 {
+		var err error
+		pools, _, err = k.FindRouteWithExactOutput(ctx, coinA.Denom, exactCoinB)
+		if err != nil {
+			return err
+		}
+	}
+
+	swaps, err := k.swapRouteWithExactOutput(ctx, pools, coinA.Denom, exactCoinB)
+	if err != nil {
+		return err
+	}
+
+	// unlike a single pool swap, the input includes the fees paid to every pool of the route
+	swapInput := swaps[0].input
+	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitRouteSwap(ctx, requester, swaps, "output")
+}
+
+// QuoteWithExactInput returns the output and the fees paid to each pool for swapping an exact input through a route
+// of pools, without changing any pool. If no pools are given the route with the largest output is used.
+func (k Keeper) QuoteWithExactInput(ctx sdk.Context, exactInput sdk.Coin, denomOut string, pools []string) ([]string, sdk.Coin, []sdk.Coin, error) {
+	if len(pools) == 0 {
+		var err error
+		pools, _, err = k.FindRouteWithExactInput(ctx, exactInput, denomOut)
+		if err != nil {
+			return nil, sdk.Coin{}, nil, err
+		}
+	}
+
+	swaps, err := k.swapRouteWithExactInput(ctx, pools, exactInput, denomOut)
+	if err != nil {
+		return nil, sdk.Coin{}, nil, err
+	}
+
+	feesPaid := make([]sdk.Coin, len(swaps))
+	for i, swap := range swaps {
+		feesPaid[i] = swap.feePaid
+	}
+
+	return pools, swaps[len(swaps)-1].output, feesPaid, nil
+}
+
+// FindRouteWithExactInput returns the route of at most types.MaxRouteLength pools with the largest output for an
+// exact input, preferring shorter routes when outputs are equal
+func (k Keeper) FindRouteWithExactInput(ctx sdk.Context, exactInput sdk.Coin, denomOut string) ([]string, sdk.Coin, error) {
+	var (
+		bestRoute  []string
+		bestOutput sdk.Coin
+	)
+	for _, route := range k.findRoutes(ctx, exactInput.Denom, denomOut) {
+		swaps, err := k.swapRouteWithExactInput(ctx, route, exactInput, denomOut)
+		if err != nil {
+			continue
+		}
+
+		output := swaps[len(swaps)-1].output
+		if bestRoute == nil || output.Amount.GT(bestOutput.Amount) ||
+			(output.Amount.Equal(bestOutput.Amount) && len(route) < len(bestRoute)) {
+			bestRoute, bestOutput = route, output
+		}
+	}
+
+	if bestRoute == nil {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidRoute, "no route from %s to %s", exactInput, denomOut)
+	}
+
+	return bestRoute, bestOutput, nil
+}
+
+// FindRouteWithExactOutput returns the route of at most types.MaxRouteLength pools with the smallest input for an
+// exact output, preferring shorter routes when inputs are equal
+func (k Keeper) FindRouteWithExactOutput(ctx sdk.Context, denomIn string, exactOutput sdk.Coin) ([]string, sdk.Coin, error) {
+	var (
+		bestRoute []string
+		bestInput sdk.Coin
+	)
+	for _, route := range k.findRoutes(ctx, denomIn, exactOutput.Denom) {
+		swaps, err := k.swapRouteWithExactOutput(ctx, route, denomIn, exactOutput)
+		if err != nil {
+			continue
+		}
+
+		input := swaps[0].input
+		if bestRoute == nil || input.Amount.LT(bestInput.Amount) ||
+			(input.Amount.Equal(bestInput.Amount) && len(route) < len(bestRoute)) {
+			bestRoute, bestInput = route, input
+		}
+	}
+
+	if bestRoute == nil {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidRoute, "no route from %s to %s", denomIn, exactOutput)
+	}
+
+	return bestRoute, bestInput, nil
+}
+
+// findRoutes returns every route of at most types.MaxRouteLength pools from denomIn to denomOut that doesn't pass
+// through a denom more than once, in pool store order. Pools of more than two denoms are only used as the last
+// pool of a route.
+func (k Keeper) findRoutes(ctx sdk.Context, denomIn, denomOut string) [][]string {
+	poolsByDenom := make(map[string][]types.PoolRecord)
+	k.IteratePools(ctx, func(record types.PoolRecord) bool {
+		for _, coin := range record.Reserves() {
+			poolsByDenom[coin.Denom] = append(poolsByDenom[coin.Denom], record)
+		}
+		return false
+	})
+
+	var routes [][]string
+	visited := map[string]bool{denomIn: true}
+	var search func(denom string, route []string)
+	search = func(denom string, route []string) {
+		if len(route) == types.MaxRouteLength {
+			return
+		}
+		for _, record := range poolsByDenom[denom] {
+			reserves := record.Reserves()
+			next := reserves[0].Denom
+			if next == denom {
+				next = reserves[1].Denom
+			}
+			if len(reserves) > 2 {
+				if reserves.AmountOf(denomOut).IsZero() {
+					continue
+				}
+				next = denomOut
+			}
+			if visited[next] {
+				continue
+			}
+
+			nextRoute := append(append([]string{}, route...), record.PoolID)
+			if next == denomOut {
+				routes = append(routes, nextRoute)
+				continue
+			}
+
+			visited[next] = true
+			search(next, nextRoute)
+			visited[next] = false
+		}
+	}
+	search(denomIn, nil)
+
+	return routes
+}
+
+// swapRouteWithExactInput calculates the trades of an exact input through each pool of a route in order,
+// updating the loaded pools but not the store
+func (k Keeper) swapRouteWithExactInput(ctx sdk.Context, pools []string, exactInput sdk.Coin, denomOut string) ([]routeSwap, error) {
+	denomsOut, err := types.RouteDenoms(pools, exactInput.Denom, denomOut)
+	if err != nil {
+		return nil, err
+	}
+
+	swaps, err := k.loadRoute(ctx, pools)
+	if err != nil {
+		return nil, err
+	}
+
+	input := exactInput
+	for i := range swaps {
+		fee := k.GetPoolSwapFee(ctx, swaps[i].poolID)
+		output, feePaid := swaps[i].pool.SwapWithExactInputTo(input, denomsOut[i], fee)
+		if output.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output of pool %s rounds to zero, increase input amount", swaps[i].poolID)
+		}
+
+		swaps[i].input, swaps[i].output, swaps[i].feePaid = input, output, feePaid
+		input = output
+	}
+
+	return swaps, nil
+}
+
+// swapRouteWithExactOutput calculates the trades for an exact output through each pool of a route in reverse
+// order, updating the loaded pools but not the store
+func (k Keeper) swapRouteWithExactOutput(ctx sdk.Context, pools []string, denomIn string, exactOutput sdk.Coin) ([]routeSwap, error) {
+	denomsOut, err := types.RouteDenoms(pools, denomIn, exactOutput.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	swaps, err := k.loadRoute(ctx, pools)
+	if err != nil {
+		return nil, err
+	}
+
+	output := exactOutput
+	for i := len(swaps) - 1; i >= 0; i-- {
+		reserves := swaps[i].pool.Reserves().AmountOf(output.Denom)
+		if output.Amount.GTE(reserves) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInsufficientLiquidity,
+				"output %s >= pool %s reserves %s", output.Amount.String(), swaps[i].poolID, reserves.String(),
+			)
+		}
+
+		hopDenomIn := denomIn
+		if i > 0 {
+			hopDenomIn = denomsOut[i-1]
+		}
+		fee := k.GetPoolSwapFee(ctx, swaps[i].poolID)
+		input, feePaid := swaps[i].pool.SwapWithExactOutputFrom(hopDenomIn, output, fee)
+
+		swaps[i].input, swaps[i].output, swaps[i].feePaid = input, output, feePaid
+		output = input
+	}
+
+	return swaps, nil
+}
+
+// loadRoute loads the pool of each pool id in a route
+func (k Keeper) loadRoute(ctx sdk.Context, pools []string) ([]routeSwap, error) {
+	if len(pools) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRoute, "route must contain at least one pool")
+	}
+
+	swaps := make([]routeSwap, len(pools))
+	for i, poolID := range pools {
+		poolRecord, found := k.GetPool(ctx, poolID)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+		}
+
+		pool, err := types.NewPoolFromRecord(poolRecord)
+		if err != nil {
+			panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
+		}
+		swaps[i] = routeSwap{poolID: poolID, pool: pool}
+	}
+
+	return swaps, nil
+}
+
+// commitRouteSwap saves the pools of a route and transfers the route's input from and final output to the
+// requester. Intermediate coins never leave the module account.
+func (k Keeper) commitRouteSwap(ctx sdk.Context, requester sdk.AccAddress, swaps []routeSwap, exactDirection string) error {
+	protocolFees := make([]sdk.Coin, len(swaps))
+	for i, swap := range swaps {
+		protocolFees[i] = k.protocolFee(ctx, swap.feePaid)
+		record := types.NewPoolRecordFromPool(swap.pool).SubReserves(protocolFees[i])
+		k.SetPool(ctx, record)
+		k.recordPoolPriceSnapshot(ctx, record)
+	}
+
+	swapInput := swaps[0].input
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
+		return err
+	}
+
+	swapOutput := swaps[len(swaps)-1].output
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	for i, swap := range swaps {
+		if err := k.payProtocolFee(ctx, swap.poolID, protocolFees[i]); err != nil {
+			return err
+		}
+	}
+
+	for _, swap := range swaps {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapTrade,
+				sdk.NewAttribute(types.AttributeKeyPoolID, swap.poolID),
+				sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+				sdk.NewAttribute(types.AttributeKeySwapInput, swap.input.String()),
+				sdk.NewAttribute(types.AttributeKeySwapOutput, swap.output.String()),
+				sdk.NewAttribute(types.AttributeKeyFeePaid, swap.feePaid.String()),
+				sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
+			),
+		)
+	}
+
+	return nil
+}
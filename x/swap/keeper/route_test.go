@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	savingstypes "github.com/percosis-labs/fury/x/savings/types"
+	"github.com/percosis-labs/fury/x/swap/keeper"
+	"github.com/percosis-labs/fury/x/swap/types"
+)
+
+// setupRoutePools creates a bnb:ufury and a ufury:usdf pool, so bnb can only reach usdf through ufury
+func (suite *keeperTestSuite) setupRoutePools() (sdk.Coins, sdk.Coins) {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+
+	bnbReserves := cs(c("bnb", 100e6), c("ufury", 1000e6))
+	suite.setupPool(bnbReserves, i(30e6), owner.GetAddress())
+	usdfReserves := cs(c("ufury", 1000e6), c("usdf", 5000e6))
+	suite.setupPool(usdfReserves, i(30e6), owner.GetAddress())
+
+	return bnbReserves, usdfReserves
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop() {
+	bnbReserves, usdfReserves := suite.setupRoutePools()
+
+	balance := cs(c("bnb", 10e6))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := c("bnb", 1e6)
+	coinB := c("usdf", 49e6)
+
+	// the route trades the output of the first pool in the second pool
+	fee := suite.Keeper.GetSwapFee(suite.Ctx)
+	bnbPool, err := types.NewDenominatedPool(bnbReserves)
+	suite.Require().NoError(err)
+	furyOutput, furyFee := bnbPool.SwapWithExactInput(coinA, fee)
+	usdfPool, err := types.NewDenominatedPool(usdfReserves)
+	suite.Require().NoError(err)
+	usdfOutput, usdfFee := usdfPool.SwapWithExactInput(furyOutput, fee)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{"bnb:ufury", "ufury:usdf"}, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(usdfOutput))
+	suite.ModuleAccountBalanceEqual(bnbReserves.Add(usdfReserves...).Add(coinA).Sub(usdfOutput))
+	suite.PoolLiquidityEqual(bnbReserves.Add(coinA).Sub(furyOutput))
+	suite.PoolLiquidityEqual(usdfReserves.Add(furyOutput).Sub(usdfOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, "bnb:ufury"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, furyOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, furyFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ufury:usdf"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, furyOutput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, usdfOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, usdfFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop_Errors() {
+	suite.setupRoutePools()
+
+	balance := cs(c("bnb", 10e6))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	route := []string{"bnb:ufury", "ufury:usdf"}
+
+	// the end to end output is about 48.6 usdf
+	err := suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1e6), c("usdf", 50e6), route, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1), c("usdf", 1), route, sdk.MustNewDecFromStr("1"))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1e6), c("btcb", 1), []string{"bnb:ufury", "btcb:ufury"}, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrInvalidPool)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1e6), c("btcb", 1), nil, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrInvalidRoute)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 20e6), c("usdf", 1), route, sdk.MustNewDecFromStr("1"))
+	suite.Require().Error(err)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop_PoolFees() {
+	bnbReserves, usdfReserves := suite.setupRoutePools()
+
+	// each pool of the route charges its own fee, and half of every fee is paid to the savings module
+	bnbFee, usdfFee := sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.0025")
+	suite.Keeper.SetParams(suite.Ctx, types.NewParamsWithProtocolFee(
+		types.NewAllowedPools(
+			types.NewAllowedPool("bnb", "ufury").WithSwapFee(bnbFee),
+			types.NewAllowedPool("ufury", "usdf"),
+		),
+		usdfFee,
+		sdk.MustNewDecFromStr("0.5"),
+		savingstypes.ModuleAccountName,
+	))
+
+	balance := cs(c("bnb", 10e6))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := c("bnb", 1e6)
+
+	bnbPool, err := types.NewDenominatedPool(bnbReserves)
+	suite.Require().NoError(err)
+	furyOutput, furyFee := bnbPool.SwapWithExactInput(coinA, bnbFee)
+	usdfPool, err := types.NewDenominatedPool(usdfReserves)
+	suite.Require().NoError(err)
+	usdfOutput, usdfFeePaid := usdfPool.SwapWithExactInput(furyOutput, usdfFee)
+	suite.Equal(c("bnb", 10000), furyFee)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, usdfOutput, nil, sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	bnbProtocolFee := c("bnb", 5000)
+	usdfProtocolFee := c("ufury", usdfFeePaid.Amount.QuoRaw(2).Int64())
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(usdfOutput))
+	suite.PoolLiquidityEqual(bnbReserves.Add(coinA).Sub(furyOutput).Sub(bnbProtocolFee))
+	suite.PoolLiquidityEqual(usdfReserves.Add(furyOutput).Sub(usdfOutput).Sub(usdfProtocolFee))
+	suite.ModuleAccountBalanceEqual(
+		bnbReserves.Add(usdfReserves...).Add(coinA).Sub(usdfOutput).Sub(bnbProtocolFee, usdfProtocolFee),
+	)
+	suite.Equal(
+		cs(bnbProtocolFee, usdfProtocolFee),
+		suite.BankKeeper.GetAllBalances(suite.Ctx, suite.AccountKeeper.GetModuleAddress(savingstypes.ModuleAccountName)),
+	)
+
+	_, stop := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(stop)
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop() {
+	bnbReserves, usdfReserves := suite.setupRoutePools()
+
+	balance := cs(c("bnb", 10e6))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := c("bnb", 1e6)
+	coinB := c("usdf", 48e6)
+
+	// the input of the second pool is the output of the first
+	fee := suite.Keeper.GetSwapFee(suite.Ctx)
+	usdfPool, err := types.NewDenominatedPool(usdfReserves)
+	suite.Require().NoError(err)
+	furyInput, usdfFee := usdfPool.SwapWithExactOutput(coinB, fee)
+	bnbPool, err := types.NewDenominatedPool(bnbReserves)
+	suite.Require().NoError(err)
+	bnbInput, furyFee := bnbPool.SwapWithExactOutput(furyInput, fee)
+
+	err = suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, coinB, nil, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(bnbInput).Add(coinB))
+	suite.ModuleAccountBalanceEqual(bnbReserves.Add(usdfReserves...).Add(bnbInput).Sub(coinB))
+	suite.PoolLiquidityEqual(bnbReserves.Add(bnbInput).Sub(furyInput))
+	suite.PoolLiquidityEqual(usdfReserves.Add(furyInput).Sub(coinB))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, "bnb:ufury"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, bnbInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, furyInput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, furyFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ufury:usdf"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, furyInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, coinB.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, usdfFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop_Errors() {
+	suite.setupRoutePools()
+
+	balance := cs(c("bnb", 10e6))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	route := []string{"bnb:ufury", "ufury:usdf"}
+
+	// 50 usdf requires about 1.03 bnb
+	err := suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1e6), c("usdf", 50e6), route, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+
+	err = suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1e6), c("usdf", 5000e6), route, sdk.MustNewDecFromStr("1"))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+
+	err = suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), c("btcb", 1e6), c("usdf", 1e6), nil, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrInvalidRoute)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+}
+
+func (suite *keeperTestSuite) TestFindRoute() {
+	suite.setupRoutePools()
+	owner := suite.CreateAccount(sdk.Coins{})
+
+	// a direct pool with a worse price than the route through ufury
+	suite.setupPool(cs(c("bnb", 100e6), c("usdf", 1000e6)), i(30e6), owner.GetAddress())
+
+	route, output, err := suite.Keeper.FindRouteWithExactInput(suite.Ctx, c("bnb", 1e6), "usdf")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"bnb:ufury", "ufury:usdf"}, route)
+	suite.True(output.Amount.GT(i(48e6)))
+
+	route, input, err := suite.Keeper.FindRouteWithExactOutput(suite.Ctx, "bnb", c("usdf", 48e6))
+	suite.Require().NoError(err)
+	suite.Equal([]string{"bnb:ufury", "ufury:usdf"}, route)
+	suite.True(input.Amount.LT(i(1e6)))
+
+	// once the direct pool has a better price it is used instead
+	suite.setupPool(cs(c("bnb", 100e6), c("usdf", 6000e6)), i(30e6), owner.GetAddress())
+
+	route, _, err = suite.Keeper.FindRouteWithExactInput(suite.Ctx, c("bnb", 1e6), "usdf")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"bnb:usdf"}, route)
+
+	route, _, err = suite.Keeper.FindRouteWithExactOutput(suite.Ctx, "bnb", c("usdf", 48e6))
+	suite.Require().NoError(err)
+	suite.Equal([]string{"bnb:usdf"}, route)
+
+	_, _, err = suite.Keeper.FindRouteWithExactInput(suite.Ctx, c("bnb", 1e6), "btcb")
+	suite.Require().ErrorIs(err, types.ErrInvalidRoute)
+}
+
+func (suite *keeperTestSuite) TestGrpcQueryQuote() {
+	bnbReserves, usdfReserves := suite.setupRoutePools()
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	fee := suite.Keeper.GetSwapFee(suite.Ctx)
+	bnbPool, err := types.NewDenominatedPool(bnbReserves)
+	suite.Require().NoError(err)
+	furyOutput, furyFee := bnbPool.SwapWithExactInput(c("bnb", 1e6), fee)
+	usdfPool, err := types.NewDenominatedPool(usdfReserves)
+	suite.Require().NoError(err)
+	usdfOutput, usdfFee := usdfPool.SwapWithExactInput(furyOutput, fee)
+
+	res, err := queryServer.Quote(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteRequest{
+		TokenIn:  c("bnb", 1e6),
+		DenomOut: "usdf",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryQuoteResponse{
+		Pools:    []string{"bnb:ufury", "ufury:usdf"},
+		TokenOut: usdfOutput,
+		FeesPaid: []sdk.Coin{furyFee, usdfFee},
+	}, res)
+
+	res, err = queryServer.Quote(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteRequest{
+		TokenIn:  c("bnb", 1e6),
+		DenomOut: "ufury",
+		Pools:    []string{"bnb:ufury"},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(furyOutput, res.TokenOut)
+
+	// quotes don't change the pools
+	suite.PoolLiquidityEqual(bnbReserves)
+	suite.PoolLiquidityEqual(usdfReserves)
+
+	_, err = queryServer.Quote(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuoteRequest{
+		TokenIn:  sdk.Coin{Denom: "bnb", Amount: sdkmath.ZeroInt()},
+		DenomOut: "usdf",
+	})
+	suite.Require().Error(err)
+}
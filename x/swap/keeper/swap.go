@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	communitytypes "github.com/percosis-labs/fury/x/community/types"
 	"github.com/percosis-labs/fury/x/swap/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -16,7 +17,7 @@ func (k *Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, e
 		return err
 	}
 
-	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
+	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetPoolSwapFee(ctx, poolID))
 	if swapOutput.IsZero() {
 		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
 	}
@@ -47,7 +48,7 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 		)
 	}
 
-	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetSwapFee(ctx))
+	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetPoolSwapFee(ctx, poolID))
 
 	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Sub(feePaid).Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
@@ -69,7 +70,7 @@ func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string,
 		return poolID, nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
@@ -96,7 +97,10 @@ func (k Keeper) commitSwap(
 	feePaid sdk.Coin,
 	exactDirection string,
 ) error {
-	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+	protocolFee := k.protocolFee(ctx, feePaid)
+	record := types.NewPoolRecordFromPool(pool).SubReserves(protocolFee)
+	k.SetPool(ctx, record)
+	k.recordPoolPriceSnapshot(ctx, record)
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
 		return err
@@ -106,6 +110,10 @@ func (k Keeper) commitSwap(
 		panic(err)
 	}
 
+	if err := k.payProtocolFee(ctx, poolID, protocolFee); err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSwapTrade,
@@ -120,3 +128,45 @@ func (k Keeper) commitSwap(
 
 	return nil
 }
+
+// protocolFee returns the share of a swap fee that is paid to the protocol fee recipient instead of the pool's
+// liquidity providers, rounded down in favor of the pool
+func (k Keeper) protocolFee(ctx sdk.Context, feePaid sdk.Coin) sdk.Coin {
+	params := k.GetParams(ctx)
+	if !params.ProtocolFeeEnabled() {
+		return sdk.NewCoin(feePaid.Denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(feePaid.Denom, sdk.NewDecFromInt(feePaid.Amount).Mul(params.ProtocolFeeShare).TruncateInt())
+}
+
+// payProtocolFee sends a protocol fee, which has already been removed from the reserves of its pool, from the
+// swap module account to the protocol fee recipient
+func (k Keeper) payProtocolFee(ctx sdk.Context, poolID string, protocolFee sdk.Coin) error {
+	if protocolFee.IsZero() {
+		return nil
+	}
+
+	recipient := k.GetParams(ctx).ProtocolFeeRecipient
+	if recipient == "" {
+		recipient = communitytypes.ModuleAccountName
+	}
+	if k.accountKeeper.GetModuleAddress(recipient) == nil {
+		return errorsmod.Wrapf(types.ErrInvalidProtocolFee, "recipient %s is not a module account", recipient)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, recipient, sdk.NewCoins(protocolFee)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapProtocolFee,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, protocolFee.String()),
+		),
+	)
+
+	return nil
+}
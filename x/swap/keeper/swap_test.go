@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	communitytypes "github.com/percosis-labs/fury/x/community/types"
 	"github.com/percosis-labs/fury/x/swap/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -51,6 +52,69 @@ func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	))
 }
 
+func (suite *keeperTestSuite) TestSwapExactForTokens_ProtocolFee() {
+	// the pool's own fee overrides the global swap fee, and a fifth of it is paid to the community pool
+	suite.Keeper.SetParams(suite.Ctx, types.NewParamsWithProtocolFee(
+		types.NewAllowedPools(types.NewAllowedPool("ufury", "usdf").WithSwapFee(sdk.MustNewDecFromStr("0.0025"))),
+		sdk.MustNewDecFromStr("0.01"),
+		sdk.MustNewDecFromStr("0.2"),
+		"",
+	))
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ufury", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdf", sdkmath.NewInt(5000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ufury", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ufury", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdf", sdkmath.NewInt(5e6))
+
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// the protocol fee does not change the trade, only who receives the fee
+	expectedOutput := sdk.NewCoin("usdf", sdkmath.NewInt(4982529))
+	protocolFee := sdk.NewCoin("ufury", sdkmath.NewInt(500))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+	suite.Equal(
+		sdk.NewCoins(protocolFee),
+		suite.BankKeeper.GetAllBalances(suite.Ctx, suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)),
+	)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ufury"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapProtocolFee,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRecipient, communitytypes.ModuleAccountName),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, protocolFee.String()),
+	))
+
+	// protocol fees can not be paid to an account that is not a module account
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ProtocolFeeRecipient = "unknown"
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "recipient unknown is not a module account: invalid protocol fee")
+}
+
 func (suite *keeperTestSuite) TestSwapExactForTokens_OutputGreaterThanZero() {
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -629,3 +693,49 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwap_StableSwapPool() {
+	allowedPool := types.NewStableSwapAllowedPool("usdc", "usdf", 100)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(allowedPool),
+		sdk.MustNewDecFromStr("0.0025"),
+	))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("usdc", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdf", sdkmath.NewInt(1000e6)),
+	)
+	owner := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, owner.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	poolRecord, found := suite.Keeper.GetPool(suite.Ctx, allowedPool.Name())
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_STABLESWAP, poolRecord.PoolType)
+	suite.Equal(uint64(100), poolRecord.Amplification)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("usdc", sdkmath.NewInt(100e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	// a constant product pool would output 9876482usdf, and fail the slippage limit
+	coinA := sdk.NewCoin("usdc", sdkmath.NewInt(10e6))
+	coinB := sdk.NewCoin("usdf", sdkmath.NewInt(10e6))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.005"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdf", sdkmath.NewInt(9974504))
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+
+	exactCoinB := sdk.NewCoin("usdf", sdkmath.NewInt(10e6))
+	err = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, exactCoinB, sdk.MustNewDecFromStr("0.005"))
+	suite.Require().NoError(err)
+
+	expectedInput := sdk.NewCoin("usdc", sdkmath.NewInt(10026560))
+	totalInput := sdk.NewCoins(coinA).Add(expectedInput)
+	totalOutput := sdk.NewCoins(expectedOutput).Add(exactCoinB)
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(totalInput...).Add(totalOutput...))
+	suite.PoolLiquidityEqual(reserves.Add(totalInput...).Sub(totalOutput...))
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/swap/types"
+)
+
+// recordPoolPriceSnapshot appends the spot prices of a pool to its price history, dropping snapshots older than the
+// price history window that are no longer needed to average over it.  Reserves changed more than once in a block
+// replace the block's snapshot.
+func (k Keeper) recordPoolPriceSnapshot(ctx sdk.Context, record types.PoolRecord) {
+	pool, err := types.NewPoolFromRecord(record)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool record: %s", err))
+	}
+	prices := types.PoolSpotPrices(pool)
+
+	history, _ := k.getPoolPriceHistory(ctx, record.PoolID)
+	cumulativePrices := make([]sdk.Dec, len(prices))
+	for i := range cumulativePrices {
+		cumulativePrices[i] = sdk.ZeroDec()
+	}
+	if history.Next > history.First {
+		last := k.getPoolPriceSnapshot(ctx, record.PoolID, history.Next-1)
+		if last.Height == ctx.BlockHeight() {
+			last.Prices = prices
+			k.setPoolPriceSnapshot(ctx, history.Next-1, last)
+			return
+		}
+		cumulativePrices = last.CumulativePricesAt(ctx.BlockTime())
+	}
+
+	snapshot := types.NewPoolPriceSnapshot(record.PoolID, ctx.BlockHeight(), ctx.BlockTime(), prices, cumulativePrices)
+	k.setPoolPriceSnapshot(ctx, history.Next, snapshot)
+	history.Next++
+
+	// keep the latest snapshot at or before the start of the window, which is the price at the start of the window
+	windowStart := ctx.BlockTime().Add(-k.GetParams(ctx).PriceHistoryWindow)
+	for history.Next-history.First > 1 {
+		second := k.getPoolPriceSnapshot(ctx, record.PoolID, history.First+1)
+		if second.Time.After(windowStart) {
+			break
+		}
+		k.deletePoolPriceSnapshot(ctx, record.PoolID, history.First)
+		history.First++
+	}
+	k.setPoolPriceHistory(ctx, record.PoolID, history)
+}
+
+// AppendPoolPriceSnapshot adds a snapshot to the end of a pool's price history as it is, without recalculating its
+// cumulative prices.  It is used to restore price histories from genesis.
+func (k Keeper) AppendPoolPriceSnapshot(ctx sdk.Context, snapshot types.PoolPriceSnapshot) {
+	history, _ := k.getPoolPriceHistory(ctx, snapshot.PoolID)
+	k.setPoolPriceSnapshot(ctx, history.Next, snapshot)
+	history.Next++
+	k.setPoolPriceHistory(ctx, snapshot.PoolID, history)
+}
+
+// GetTWAP returns the time-weighted average price of base in quote in a pool over the window ending at the current
+// block time.  It returns an error if the pool's price history is shorter than the window.
+func (k Keeper) GetTWAP(ctx sdk.Context, poolID, base, quote string, window time.Duration) (sdk.Dec, error) {
+	windowStart := ctx.BlockTime().Add(-window)
+	price, start, err := k.GetTWAPBetween(ctx, poolID, base, quote, windowStart, ctx.BlockTime())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if start.After(windowStart) {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoPriceHistory, "price history of pool %s is shorter than %s", poolID, window)
+	}
+	return price, nil
+}
+
+// GetTWAPBetween returns the time-weighted average price of base in quote in a pool between two times, along with
+// the start time actually averaged from, which is the start of the pool's price history if that is later than the
+// start time.  Each spot price in the history is weighted by how long it was the pool's spot price.
+func (k Keeper) GetTWAPBetween(ctx sdk.Context, poolID, base, quote string, start, end time.Time) (sdk.Dec, time.Time, error) {
+	history, found := k.getPoolPriceHistory(ctx, poolID)
+	if !found || history.Next == history.First {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrapf(types.ErrNoPriceHistory, "pool %s", poolID)
+	}
+	first := k.getPoolPriceSnapshot(ctx, poolID, history.First)
+	pair, err := first.PairIndex(base, quote)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrap(types.ErrInvalidPool, err.Error())
+	}
+	if end.Before(first.Time) {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrapf(
+			types.ErrNoPriceHistory, "%s is before the price history of pool %s", end, poolID,
+		)
+	}
+	if end.After(ctx.BlockTime()) {
+		end = ctx.BlockTime()
+	}
+	if start.Before(first.Time) {
+		start = first.Time
+	}
+
+	endSnapshot := k.getPoolPriceSnapshotAt(ctx, poolID, history, end)
+	if !end.After(start) {
+		return endSnapshot.Prices[pair], start, nil
+	}
+	startSnapshot := k.getPoolPriceSnapshotAt(ctx, poolID, history, start)
+
+	sum := endSnapshot.CumulativePricesAt(end)[pair].Sub(startSnapshot.CumulativePricesAt(start)[pair])
+	return sum.Quo(types.DurationSeconds(end.Sub(start))), start, nil
+}
+
+// getPoolPriceSnapshotAt returns the latest snapshot at or before a time, which must not be before the price history
+func (k Keeper) getPoolPriceSnapshotAt(ctx sdk.Context, poolID string, history types.PoolPriceHistory, t time.Time) types.PoolPriceSnapshot {
+	n := sort.Search(int(history.Next-history.First), func(i int) bool {
+		return k.getPoolPriceSnapshot(ctx, poolID, history.First+uint64(i)).Time.After(t)
+	})
+	return k.getPoolPriceSnapshot(ctx, poolID, history.First+uint64(n)-1)
+}
+
+func (k Keeper) getPoolPriceHistory(ctx sdk.Context, poolID string) (types.PoolPriceHistory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceHistoryPrefix)
+	bz := store.Get(types.PoolPriceHistoryKey(poolID))
+	if bz == nil {
+		return types.PoolPriceHistory{}, false
+	}
+
+	var history types.PoolPriceHistory
+	k.cdc.MustUnmarshal(bz, &history)
+
+	return history, true
+}
+
+func (k Keeper) setPoolPriceHistory(ctx sdk.Context, poolID string, history types.PoolPriceHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceHistoryPrefix)
+	store.Set(types.PoolPriceHistoryKey(poolID), k.cdc.MustMarshal(&history))
+}
+
+// deletePoolPriceHistory removes the price history of a pool that has been deleted
+func (k Keeper) deletePoolPriceHistory(ctx sdk.Context, poolID string) {
+	history, found := k.getPoolPriceHistory(ctx, poolID)
+	if !found {
+		return
+	}
+	for seq := history.First; seq < history.Next; seq++ {
+		k.deletePoolPriceSnapshot(ctx, poolID, seq)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceHistoryPrefix)
+	store.Delete(types.PoolPriceHistoryKey(poolID))
+}
+
+func (k Keeper) getPoolPriceSnapshot(ctx sdk.Context, poolID string, sequence uint64) types.PoolPriceSnapshot {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	bz := store.Get(types.PoolPriceSnapshotKey(poolID, sequence))
+
+	var snapshot types.PoolPriceSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+
+	return snapshot
+}
+
+func (k Keeper) setPoolPriceSnapshot(ctx sdk.Context, sequence uint64, snapshot types.PoolPriceSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	store.Set(types.PoolPriceSnapshotKey(snapshot.PoolID, sequence), k.cdc.MustMarshal(&snapshot))
+}
+
+func (k Keeper) deletePoolPriceSnapshot(ctx sdk.Context, poolID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	store.Delete(types.PoolPriceSnapshotKey(poolID, sequence))
+}
+
+// IteratePoolPriceSnapshots iterates over the price history of a pool, oldest first, and performs a callback function
+func (k Keeper) IteratePoolPriceSnapshots(ctx sdk.Context, poolID string, cb func(ps types.PoolPriceSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolPriceSnapshotIteratorKey(poolID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ps types.PoolPriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &ps)
+		if cb(ps) {
+			break
+		}
+	}
+}
+
+// GetAllPoolPriceSnapshots returns the price histories of all pools, oldest first within each pool
+func (k Keeper) GetAllPoolPriceSnapshots(ctx sdk.Context) (snapshots types.PoolPriceSnapshots) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ps types.PoolPriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &ps)
+		snapshots = append(snapshots, ps)
+	}
+	return
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/percosis-labs/fury/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *keeperTestSuite) spotPrice(poolID, base, quote string) sdk.Dec {
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	pool, err := types.NewPoolFromRecord(record)
+	suite.Require().NoError(err)
+
+	return pool.SpotPrice(base, quote)
+}
+
+func (suite *keeperTestSuite) setBlock(height int64, t time.Time) {
+	suite.Ctx = suite.Ctx.WithBlockHeight(height).WithBlockTime(t)
+}
+
+func (suite *keeperTestSuite) TestTWAP() {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := types.NewParams(types.NewAllowedPools(types.NewAllowedPool("ufury", "usdf")), sdk.ZeroDec())
+	params.PriceHistoryWindow = time.Hour
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	balance := sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100e6)), sdk.NewCoin("usdf", sdkmath.NewInt(500e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("depositor-----------"), balance)
+	poolID := types.PoolID("ufury", "usdf")
+
+	_, _, err := suite.Keeper.GetTWAPBetween(suite.Ctx, poolID, "ufury", "usdf", start, start)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+
+	suite.setBlock(1, start)
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ufury", sdkmath.NewInt(10e6)), sdk.NewCoin("usdf", sdkmath.NewInt(50e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	suite.setBlock(2, start.Add(10*time.Second))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ufury", sdkmath.NewInt(1e6)), sdk.NewCoin("usdf", sdkmath.NewInt(4e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+	price := suite.spotPrice(poolID, "ufury", "usdf")
+	suite.True(price.LT(sdk.NewDec(5)), "expected selling ufury to lower its price")
+
+	suite.setBlock(3, start.Add(30*time.Second))
+	twap, err := suite.Keeper.GetTWAP(suite.Ctx, poolID, "ufury", "usdf", 30*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(50).Add(price.MulInt64(20)).Quo(sdk.NewDec(30)), twap)
+
+	// windows longer than the price history can't be averaged over in full
+	_, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, "ufury", "usdf", 31*time.Second)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+
+	// unless the time actually averaged from is returned with the price
+	twap, actualStart, err := suite.Keeper.GetTWAPBetween(suite.Ctx, poolID, "ufury", "usdf", start.Add(-time.Hour), start.Add(10*time.Second))
+	suite.Require().NoError(err)
+	suite.Equal(start, actualStart)
+	suite.Equal(sdk.NewDec(5), twap)
+
+	// the price of usdf in ufury is averaged separately
+	twap, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, "usdf", "ufury", 20*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(suite.spotPrice(poolID, "usdf", "ufury"), twap)
+
+	_, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, "jinx", "usdf", time.Minute)
+	suite.ErrorIs(err, types.ErrInvalidPool)
+	_, _, err = suite.Keeper.GetTWAPBetween(suite.Ctx, poolID, "ufury", "usdf", start.Add(-time.Hour), start.Add(-time.Second))
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+
+	// a second change to the reserves in a block replaces the block's snapshot
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ufury", sdkmath.NewInt(1e6)), sdk.NewCoin("usdf", sdkmath.NewInt(3e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("usdf", sdkmath.NewInt(1e6)), sdk.NewCoin("ufury", sdkmath.NewInt(1e5)), sdk.OneDec())
+	suite.Require().NoError(err)
+	snapshots := suite.Keeper.GetAllPoolPriceSnapshots(suite.Ctx)
+	suite.Require().Len(snapshots, 3)
+	suite.Equal(int64(3), snapshots[2].Height)
+	suite.Equal(suite.spotPrice(poolID, "ufury", "usdf"), snapshots[2].Prices[0])
+	suite.Equal(sdk.NewDec(50).Add(price.MulInt64(20)), snapshots[2].CumulativePrices[0])
+
+	// snapshots are pruned once a later snapshot is at or before the start of the price history window
+	suite.setBlock(4, start.Add(time.Hour+10*time.Second))
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ufury", sdkmath.NewInt(1e6)), sdk.NewCoin("usdf", sdkmath.NewInt(5e6)), sdk.OneDec())
+	suite.Require().NoError(err)
+	snapshots = suite.Keeper.GetAllPoolPriceSnapshots(suite.Ctx)
+	suite.Require().Len(snapshots, 3)
+	suite.Equal([]int64{2, 3, 4}, []int64{snapshots[0].Height, snapshots[1].Height, snapshots[2].Height})
+
+	// withdrawing all shares deletes the pool's price history
+	shares, found := suite.Keeper.GetDepositorSharesAmount(suite.Ctx, depositor.GetAddress(), poolID)
+	suite.Require().True(found)
+	err = suite.Keeper.Withdraw(suite.Ctx, depositor.GetAddress(), shares, sdk.NewCoin("ufury", sdkmath.NewInt(1)), sdk.NewCoin("usdf", sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	suite.Empty(suite.Keeper.GetAllPoolPriceSnapshots(suite.Ctx))
+	_, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, "ufury", "usdf", time.Minute)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+}
+
+func (suite *keeperTestSuite) TestTWAP_WeightedPool() {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.setBlock(1, start)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(types.NewWeightedAllowedPool([]string{"jinx", "ufury", "usdf"}, []uint64{1, 1, 1})),
+		sdk.ZeroDec(),
+	))
+
+	reserves := sdk.NewCoins(sdk.NewCoin("jinx", sdkmath.NewInt(10e6)), sdk.NewCoin("ufury", sdkmath.NewInt(20e6)), sdk.NewCoin("usdf", sdkmath.NewInt(40e6)))
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("depositor-----------"), reserves)
+	poolID := "jinx:ufury:usdf"
+	err := suite.Keeper.DepositToPool(suite.Ctx, depositor.GetAddress(), poolID, reserves, sdkmath.ZeroInt())
+	suite.Require().NoError(err)
+
+	suite.setBlock(2, start.Add(time.Minute))
+	twap, err := suite.Keeper.GetTWAP(suite.Ctx, poolID, "jinx", "usdf", time.Minute)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(4), twap)
+	twap, err = suite.Keeper.GetTWAP(suite.Ctx, poolID, "usdf", "ufury", time.Minute)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), twap)
+}
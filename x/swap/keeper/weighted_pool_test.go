@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/percosis-labs/fury/x/swap/types"
+)
+
+// setupWeightedPool creates an 80/20 ufury:usdf weighted pool from a deposit of 800e6 ufury and 200e6 usdf
+func (suite *keeperTestSuite) setupWeightedPool(allowedPools ...types.AllowedPool) (types.AllowedPool, sdk.AccAddress) {
+	pool := types.NewWeightedAllowedPool([]string{"ufury", "usdf"}, []uint64{80, 20})
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(append(allowedPools, pool), sdk.MustNewDecFromStr("0.003")))
+
+	reserves := cs(c("ufury", 800e6), c("usdf", 200e6))
+	owner := suite.CreateAccount(reserves)
+	err := suite.Keeper.DepositToPool(suite.Ctx, owner.GetAddress(), pool.Name(), reserves, i(0))
+	suite.Require().NoError(err)
+
+	return pool, owner.GetAddress()
+}
+
+func (suite *keeperTestSuite) TestDepositToPool_CreateWeightedPool() {
+	pool, owner := suite.setupWeightedPool()
+	reserves := cs(c("ufury", 800e6), c("usdf", 200e6))
+
+	suite.AccountBalanceEqual(owner, sdk.Coins{})
+	suite.ModuleAccountBalanceEqual(reserves)
+	suite.PoolReservesEqual("ufury:usdf@80:20", reserves)
+	suite.PoolShareTotalEqual("ufury:usdf@80:20", i(606286626))
+	suite.PoolDepositorSharesEqual(owner, "ufury:usdf@80:20", i(606286626))
+	suite.PoolShareValueEqual(suite.AccountKeeper.GetAccount(suite.Ctx, owner), pool, reserves)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, "ufury:usdf@80:20"),
+		sdk.NewAttribute(types.AttributeKeyDepositor, owner.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, reserves.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "606286626"),
+	))
+}
+
+func (suite *keeperTestSuite) TestDepositToPool_Errors() {
+	constantProduct := types.NewAllowedPool("jinx", "ufury")
+	pool, _ := suite.setupWeightedPool(constantProduct)
+	suite.setupPool(cs(c("jinx", 10e6), c("ufury", 50e6)), i(20e6), sdk.AccAddress("owner---------------"))
+
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("depositor-----------"), cs(c("jinx", 100e6), c("ufury", 100e6), c("usdf", 100e6)))
+
+	err := suite.Keeper.DepositToPool(suite.Ctx, depositor.GetAddress(), "jinx:usdf", cs(c("jinx", 1e6), c("usdf", 1e6)), i(0))
+	suite.ErrorIs(err, types.ErrNotAllowed)
+
+	err = suite.Keeper.DepositToPool(suite.Ctx, depositor.GetAddress(), "jinx:ufury", cs(c("jinx", 1e6)), i(0))
+	suite.EqualError(err, "pool jinx:ufury does not support single asset deposits: invalid pool")
+
+	err = suite.Keeper.DepositToPool(suite.Ctx, depositor.GetAddress(), pool.Name(), cs(c("usdf", 10e6)), i(5931130))
+	suite.EqualError(err, "shares 5931129 < min shares 5931130: slippage exceeded")
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(
+		types.NewWeightedAllowedPool([]string{"jinx", "ufury", "usdf"}, []uint64{1, 1, 1}),
+	), types.DefaultSwapFee))
+	err = suite.Keeper.DepositToPool(suite.Ctx, depositor.GetAddress(), "jinx:ufury:usdf", cs(c("jinx", 1e6), c("usdf", 1e6)), i(0))
+	suite.EqualError(err, "reserves 1000000jinx,1000000usdf do not match pool jinx:ufury:usdf: invalid pool")
+}
+
+func (suite *keeperTestSuite) TestDepositToPool_SingleAsset() {
+	pool, owner := suite.setupWeightedPool()
+
+	deposit := c("usdf", 10e6)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("depositor-----------"), cs(deposit))
+
+	// the fee is charged on the 80% of the deposit that is implicitly swapped for ufury
+	err := suite.Keeper.DepositToPool(suite.Ctx, depositor.GetAddress(), pool.Name(), cs(deposit), i(5931129))
+	suite.Require().NoError(err)
+
+	reserves := cs(c("ufury", 800e6), c("usdf", 210e6))
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.Coins{})
+	suite.ModuleAccountBalanceEqual(reserves)
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "5931129"),
+	))
+	suite.PoolReservesEqual(pool.Name(), reserves)
+	suite.PoolShareTotalEqual(pool.Name(), i(606286626+5931129))
+	shares, found := suite.Keeper.GetDepositorSharesAmount(suite.Ctx, depositor.GetAddress(), pool.Name())
+	suite.Require().True(found)
+	suite.Equal("5931129", shares.String())
+	shares, found = suite.Keeper.GetDepositorSharesAmount(suite.Ctx, owner, pool.Name())
+	suite.Require().True(found)
+	suite.Equal("606286626", shares.String())
+}
+
+func (suite *keeperTestSuite) TestWithdrawFromPool() {
+	pool, owner := suite.setupWeightedPool()
+
+	err := suite.Keeper.WithdrawFromPool(suite.Ctx, owner, pool.Name(), i(1e6), cs(c("usdf", 1640006)), "usdf")
+	suite.Require().NoError(err)
+
+	withdraw := c("usdf", 1640006)
+	suite.AccountBalanceEqual(owner, cs(withdraw))
+	suite.PoolReservesEqual(pool.Name(), cs(c("ufury", 800e6), c("usdf", 200e6)).Sub(withdraw))
+	suite.PoolDepositorSharesEqual(owner, pool.Name(), i(606286626-1e6))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapWithdraw,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, withdraw.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "1000000"),
+	))
+
+	err = suite.Keeper.WithdrawFromPool(suite.Ctx, owner, pool.Name(), i(606286626-1e6), cs(c("usdf", 1e12)), "")
+	suite.EqualError(err, "minimum withdraw not met: slippage exceeded")
+
+	err = suite.Keeper.WithdrawFromPool(suite.Ctx, owner, pool.Name(), i(606286626-1e6), nil, "usdf")
+	suite.EqualError(err, "the last shares of a pool must be withdrawn proportionally: invalid shares")
+
+	err = suite.Keeper.WithdrawFromPool(suite.Ctx, owner, pool.Name(), i(606286626-1e6), nil, "")
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(owner, cs(c("ufury", 800e6), c("usdf", 200e6)))
+	suite.ModuleAccountBalanceEqual(sdk.Coins{})
+	_, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.False(found)
+	_, found = suite.Keeper.GetDepositorShares(suite.Ctx, owner, pool.Name())
+	suite.False(found)
+}
+
+func (suite *keeperTestSuite) TestSwapMultiHop_MultiAssetPool() {
+	multiAssetPool := types.NewWeightedAllowedPool([]string{"jinx", "ufury", "usdf"}, []uint64{1, 1, 1})
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(multiAssetPool), sdk.MustNewDecFromStr("0.003")))
+
+	multiAssetReserves := cs(c("jinx", 100e6), c("ufury", 100e6), c("usdf", 400e6))
+	owner := suite.CreateAccount(multiAssetReserves)
+	err := suite.Keeper.DepositToPool(suite.Ctx, owner.GetAddress(), multiAssetPool.Name(), multiAssetReserves, i(0))
+	suite.Require().NoError(err)
+
+	bnbReserves := cs(c("bnb", 10e6), c("ufury", 100e6))
+	suite.setupPool(bnbReserves, i(30e6), owner.GetAddress())
+
+	balance := cs(c("bnb", 10e6))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := c("bnb", 1e6)
+
+	// the multi-asset pool is the last pool of the route, trading ufury directly for usdf
+	fee := suite.Keeper.GetSwapFee(suite.Ctx)
+	bnbPool, err := types.NewDenominatedPool(bnbReserves)
+	suite.Require().NoError(err)
+	furyOutput, _ := bnbPool.SwapWithExactInput(coinA, fee)
+	weightedPool, err := types.NewWeightedPool(multiAssetReserves, []uint64{1, 1, 1})
+	suite.Require().NoError(err)
+	usdfOutput, _ := weightedPool.SwapWithExactInputTo(furyOutput, "usdf", fee)
+
+	route, output, err := suite.Keeper.FindRouteWithExactInput(suite.Ctx, coinA, "usdf")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"bnb:ufury", "jinx:ufury:usdf"}, route)
+	suite.Equal(usdfOutput, output)
+
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, usdfOutput, nil, sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(usdfOutput))
+	suite.PoolReservesEqual("bnb:ufury", bnbReserves.Add(coinA).Sub(furyOutput))
+	suite.PoolReservesEqual(multiAssetPool.Name(), multiAssetReserves.Add(furyOutput).Sub(usdfOutput))
+
+	// an exact output is traded through the same route in reverse
+	exactOutput := c("usdf", 1e6)
+	err = suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), c("bnb", 1e6), exactOutput, []string{"bnb:ufury", "jinx:ufury:usdf"}, sdk.MustNewDecFromStr("0.5"))
+	suite.Require().NoError(err)
+	suite.Equal(usdfOutput.Add(exactOutput), suite.BankKeeper.GetBalance(suite.Ctx, requester.GetAddress(), "usdf"))
+	suite.True(suite.BankKeeper.GetBalance(suite.Ctx, requester.GetAddress(), "bnb").IsLT(c("bnb", 9e6)))
+}
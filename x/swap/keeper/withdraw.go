@@ -39,7 +39,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.I
 		panic(fmt.Sprintf("pool %s not found", poolID))
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
@@ -73,3 +73,83 @@ func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.I
 
 	return nil
 }
+
+// WithdrawFromPool removes liquidity from an existing pool by pool id, which supports pools of any type and number
+// of assets, converting the provided shares of an owners deposit for the returned pool liquidity.
+//
+// When denomOut is empty, liquidity is withdrawn in proportion to the pool reserves.  A weighted pool also
+// supports a withdraw of a single asset by denomOut.  Since this is equal to a proportional withdraw and a swap of
+// the other assets for the single asset, the swap fee is charged on the implicitly swapped portion of the withdraw
+// and remains in the pool reserves.  The last shares of a pool must be withdrawn proportionally.
+//
+// An error is returned if the withdrawn liquidity for each of the provided minimum coins is below the minimum.
+func (k Keeper) WithdrawFromPool(ctx sdk.Context, owner sdk.AccAddress, poolID string, shares sdkmath.Int, minCoins sdk.Coins, denomOut string) error {
+	shareRecord, found := k.GetDepositorShares(ctx, owner, poolID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit for account %s and pool %s", owner, poolID)
+	}
+
+	if shares.GT(shareRecord.SharesOwned) {
+		return errorsmod.Wrapf(types.ErrInvalidShares, "withdraw of %s shares greater than %s shares owned", shares, shareRecord.SharesOwned)
+	}
+
+	poolRecord, found := k.GetPool(ctx, poolID)
+	if !found {
+		panic(fmt.Sprintf("pool %s not found", poolID))
+	}
+
+	pool, err := types.NewPoolFromRecord(poolRecord)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
+	}
+
+	var withdrawnAmount sdk.Coins
+	if denomOut == "" {
+		withdrawnAmount = pool.RemoveLiquidity(shares)
+		if len(withdrawnAmount) != len(poolRecord.Reserves()) {
+			return errorsmod.Wrap(types.ErrInsufficientLiquidity, "shares must be increased")
+		}
+	} else {
+		weightedPool, ok := pool.(*types.WeightedPool)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidPool, "pool %s does not support single asset withdraws", poolID)
+		}
+		if weightedPool.Reserves().AmountOf(denomOut).IsZero() {
+			return errorsmod.Wrapf(types.ErrInvalidPool, "pool %s does not contain %s", poolID, denomOut)
+		}
+		if shares.GTE(weightedPool.TotalShares()) {
+			return errorsmod.Wrap(types.ErrInvalidShares, "the last shares of a pool must be withdrawn proportionally")
+		}
+
+		withdrawn, _ := weightedPool.RemoveSingleAssetLiquidity(shares, denomOut, k.GetPoolSwapFee(ctx, poolID))
+		if withdrawn.IsZero() {
+			return errorsmod.Wrap(types.ErrInsufficientLiquidity, "shares must be increased")
+		}
+		withdrawnAmount = sdk.NewCoins(withdrawn)
+	}
+
+	if !withdrawnAmount.IsAllGTE(minCoins) {
+		return errorsmod.Wrap(types.ErrSlippageExceeded, "minimum withdraw not met")
+	}
+
+	k.updatePool(ctx, poolID, pool)
+	k.BeforePoolDepositModified(ctx, poolID, owner, shareRecord.SharesOwned)
+	k.updateDepositorShares(ctx, owner, poolID, shareRecord.SharesOwned.Sub(shares))
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, withdrawnAmount)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapWithdraw,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawnAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return nil
+}
@@ -177,7 +177,7 @@ func (suite *Suite) PoolShareValueEqual(depositor authtypes.AccountI, pool types
 	shares, ok := suite.Keeper.GetDepositorShares(suite.Ctx, depositor.GetAddress(), poolRecord.PoolID)
 	suite.Require().True(ok, fmt.Sprintf("expected shares to exist for depositor %s", depositor.GetAddress()))
 
-	storedPool, err := types.NewDenominatedPoolWithExistingShares(sdk.NewCoins(poolRecord.ReservesA, poolRecord.ReservesB), poolRecord.TotalShares)
+	storedPool, err := types.NewPoolFromRecord(poolRecord)
 	suite.Nil(err)
 	value := storedPool.ShareValue(shares.SharesOwned)
 	suite.Equal(coins, value, fmt.Sprintf("expected shares to equal %s, but got %s", coins, value))
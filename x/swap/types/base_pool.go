@@ -335,6 +335,11 @@ func (p *BasePool) ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int) {
 	return sdkmath.NewIntFromBigInt(&resultA), sdkmath.NewIntFromBigInt(&resultB)
 }
 
+// spotPriceRatio returns the marginal price of a in units of b as the ratio reservesB / reservesA
+func (p *BasePool) spotPriceRatio() (*big.Int, *big.Int) {
+	return p.reservesB.BigInt(), p.reservesA.BigInt()
+}
+
 // assertInvariantAndUpdateRerserves asserts the constant product invariant is not violated, subtracting
 // any fees first, then updates the pool reserves.  Panics if invariant is violated.
 func (p *BasePool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
@@ -16,6 +16,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdraw{}, "swap/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgSwapExactForTokens{}, "swap/MsgSwapExactForTokens", nil)
 	cdc.RegisterConcrete(&MsgSwapForExactTokens{}, "swap/MsgSwapForExactTokens", nil)
+	cdc.RegisterConcrete(&MsgSwapExactForTokensMultiHop{}, "swap/MsgSwapExactForTokensMultiHop", nil)
+	cdc.RegisterConcrete(&MsgSwapForExactTokensMultiHop{}, "swap/MsgSwapForExactTokensMultiHop", nil)
+	cdc.RegisterConcrete(&MsgDepositToPool{}, "swap/MsgDepositToPool", nil)
+	cdc.RegisterConcrete(&MsgWithdrawFromPool{}, "swap/MsgWithdrawFromPool", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -26,6 +30,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdraw{},
 		&MsgSwapExactForTokens{},
 		&MsgSwapForExactTokens{},
+		&MsgSwapExactForTokensMultiHop{},
+		&MsgSwapForExactTokensMultiHop{},
+		&MsgDepositToPool{},
+		&MsgWithdrawFromPool{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,22 +2,43 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DenominatedPool implements a denominated constant-product liquidity pool
+// unitlessPool is a unitless liquidity pool with a pricing curve, such as a BasePool or StableSwapPool
+type unitlessPool interface {
+	ReservesA() sdkmath.Int
+	ReservesB() sdkmath.Int
+	TotalShares() sdkmath.Int
+	IsEmpty() bool
+	AddLiquidity(desiredA sdkmath.Int, desiredB sdkmath.Int) (sdkmath.Int, sdkmath.Int, sdkmath.Int)
+	RemoveLiquidity(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	spotPriceRatio() (*big.Int, *big.Int)
+}
+
+// DenominatedPool implements a denominated liquidity pool
 type DenominatedPool struct {
-	// all pool operations are implemented in a unitless base pool
-	pool *BasePool
+	// all pool operations are implemented in a unitless pool
+	pool unitlessPool
+	// poolType is the pricing curve of the unitless pool, and is unspecified for constant-product pools
+	poolType PoolType
+	// amplification is the amplification coefficient of a stableswap pool
+	amplification uint64
 	// track units of the reserveA and reserveB in base pool
 	denomA string
 	denomB string
 }
 
-// NewDenominatedPool creates a new denominated pool from reserve coins
+// NewDenominatedPool creates a new denominated constant-product pool from reserve coins
 func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
 	if len(reserves) != 2 {
 		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
@@ -40,7 +61,7 @@ func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
 	}, nil
 }
 
-// NewDenominatedPoolWithExistingShares creates a new denominated pool from reserve coins
+// NewDenominatedPoolWithExistingShares creates a new denominated constant-product pool from reserve coins
 func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmath.Int) (*DenominatedPool, error) {
 	if len(reserves) != 2 {
 		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
@@ -63,6 +84,87 @@ func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmat
 	}, nil
 }
 
+// NewDenominatedStableSwapPool creates a new denominated stableswap pool from reserve coins
+func NewDenominatedStableSwapPool(reserves sdk.Coins, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPool(reservesA.Amount, reservesB.Amount, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		poolType:      POOL_TYPE_STABLESWAP,
+		amplification: amplification,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
+	}, nil
+}
+
+// NewDenominatedStableSwapPoolWithExistingShares creates a new denominated stableswap pool from reserve coins
+func NewDenominatedStableSwapPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmath.Int, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		poolType:      POOL_TYPE_STABLESWAP,
+		amplification: amplification,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
+	}, nil
+}
+
+// NewDenominatedPoolForAllowedPool creates a new denominated pool from reserve coins, using the
+// pricing curve of an allowed pool
+func NewDenominatedPoolForAllowedPool(allowedPool AllowedPool, reserves sdk.Coins) (*DenominatedPool, error) {
+	switch allowedPool.PoolType {
+	case POOL_TYPE_UNSPECIFIED, POOL_TYPE_CONSTANT_PRODUCT:
+		return NewDenominatedPool(reserves)
+	case POOL_TYPE_STABLESWAP:
+		return NewDenominatedStableSwapPool(reserves, allowedPool.Amplification)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "unknown pool type %s", allowedPool.PoolType)
+	}
+}
+
+// NewDenominatedPoolFromRecord creates a denominated pool from the state of a pool record
+func NewDenominatedPoolFromRecord(record PoolRecord) (*DenominatedPool, error) {
+	switch record.PoolType {
+	case POOL_TYPE_UNSPECIFIED, POOL_TYPE_CONSTANT_PRODUCT:
+		return NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	case POOL_TYPE_STABLESWAP:
+		return NewDenominatedStableSwapPoolWithExistingShares(record.Reserves(), record.TotalShares, record.Amplification)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "unknown pool type %s", record.PoolType)
+	}
+}
+
+// PoolType returns the pricing curve of the pool, which is POOL_TYPE_UNSPECIFIED for constant-product pools
+func (p *DenominatedPool) PoolType() PoolType {
+	return p.poolType
+}
+
+// Amplification returns the amplification coefficient of a stableswap pool, or zero for other pool types
+func (p *DenominatedPool) Amplification() uint64 {
+	return p.amplification
+}
+
 // Reserves returns the reserves held in the pool
 func (p *DenominatedPool) Reserves() sdk.Coins {
 	return p.coins(p.pool.ReservesA(), p.pool.ReservesB())
@@ -144,6 +246,45 @@ func (p *DenominatedPool) SwapWithExactOutput(swapOutput sdk.Coin, fee sdk.Dec)
 	}
 }
 
+// SwapWithExactInputTo trades an exact input coin for denomOut, which must be the other denom of the pool.
+// Panics if either denom does not match the pool reserves.
+func (p *DenominatedPool) SwapWithExactInputTo(swapInput sdk.Coin, denomOut string, fee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	p.assertOtherDenom(swapInput.Denom, denomOut)
+
+	return p.SwapWithExactInput(swapInput, fee)
+}
+
+// SwapWithExactOutputFrom trades denomIn, which must be the other denom of the pool, for an exact output coin.
+// Panics if either denom does not match the pool reserves.
+func (p *DenominatedPool) SwapWithExactOutputFrom(denomIn string, swapOutput sdk.Coin, fee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	p.assertOtherDenom(swapOutput.Denom, denomIn)
+
+	return p.SwapWithExactOutput(swapOutput, fee)
+}
+
+// SpotPrice returns the marginal price of the base denom in units of the quote denom, which must be the
+// other denom of the pool.  The price does not include the swap fee.  Panics if either denom does not
+// match the pool reserves.
+func (p *DenominatedPool) SpotPrice(base, quote string) sdk.Dec {
+	p.assertOtherDenom(base, quote)
+
+	num, den := p.pool.spotPriceRatio()
+	if base == p.denomB {
+		num, den = den, num
+	}
+
+	return priceFromRatio(num, den)
+}
+
+// assertOtherDenom panics unless denom and other are the two different denoms of the pool
+func (p *DenominatedPool) assertOtherDenom(denom, other string) {
+	if (denom == p.denomA && other == p.denomB) || (denom == p.denomB && other == p.denomA) {
+		return
+	}
+
+	panic(fmt.Sprintf("invalid denomination: denoms '%s' and '%s' do not match pool reserves", denom, other))
+}
+
 // coins returns a new coins slice with correct reserve denoms from ordered sdk.Ints
 func (p *DenominatedPool) coins(amountA, amountB sdkmath.Int) sdk.Coins {
 	return sdk.NewCoins(p.coinA(amountA), p.coinB(amountB))
@@ -181,3 +181,59 @@ func TestDenominatedPool_SwapWithExactOuput(t *testing.T) {
 
 	assert.Panics(t, func() { pool.SwapWithExactOutput(jinx(1e6), d("0.003")) }, "SwapWithExactOutput did not panic on invalid denomination")
 }
+
+func TestDenominatedPool_NewDenominatedPoolForAllowedPool(t *testing.T) {
+	reserves := sdk.NewCoins(usdf(10e6), jinx(10e6))
+
+	pool, err := types.NewDenominatedPoolForAllowedPool(types.NewAllowedPool("jinx", "usdf"), reserves)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_UNSPECIFIED, pool.PoolType())
+	assert.Equal(t, uint64(0), pool.Amplification())
+
+	output, _ := pool.SwapWithExactInput(jinx(1e6), d("0.003"))
+	assert.Equal(t, usdf(906610), output)
+
+	pool, err = types.NewDenominatedPoolForAllowedPool(types.NewStableSwapAllowedPool("jinx", "usdf", 100), reserves)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, pool.PoolType())
+	assert.Equal(t, uint64(100), pool.Amplification())
+
+	output, _ = pool.SwapWithExactInput(jinx(1e6), d("0.003"))
+	assert.Equal(t, usdf(996500), output)
+
+	_, err = types.NewDenominatedPoolForAllowedPool(types.AllowedPool{TokenA: "jinx", TokenB: "usdf", PoolType: 4}, reserves)
+	require.EqualError(t, err, "unknown pool type 4: invalid pool")
+}
+
+func TestDenominatedPool_SpotPrice(t *testing.T) {
+	pool, err := types.NewDenominatedPool(sdk.NewCoins(ufury(10e6), usdf(50e6)))
+	require.NoError(t, err)
+
+	assert.Equal(t, sdk.MustNewDecFromStr("5"), pool.SpotPrice("ufury", "usdf"))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.2"), pool.SpotPrice("usdf", "ufury"))
+
+	assert.Panics(t, func() { pool.SpotPrice("ufury", "ufury") }, "expected panic for equal denoms")
+	assert.Panics(t, func() { pool.SpotPrice("jinx", "usdf") }, "expected panic for denom not in pool")
+}
+
+func TestDenominatedPool_SpotPrice_StableSwap(t *testing.T) {
+	balanced, err := types.NewDenominatedStableSwapPool(sdk.NewCoins(usdf(1e6), sdk.NewCoin("usdc", i(1e6))), 100)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec(), balanced.SpotPrice("usdc", "usdf"))
+
+	for _, amplification := range []uint64{1, 10, 100, 1000} {
+		reserves := sdk.NewCoins(usdf(2e6), sdk.NewCoin("usdc", i(1e6)))
+		pool, err := types.NewDenominatedStableSwapPool(reserves, amplification)
+		require.NoError(t, err)
+
+		// usdc is scarce, so it is priced above 1 but below the constant product price of 2
+		price := pool.SpotPrice("usdc", "usdf")
+		assert.Truef(t, price.GT(sdk.OneDec()) && price.LT(sdk.NewDec(2)), "expected price %s between 1 and 2", price)
+		assert.True(t, price.Mul(pool.SpotPrice("usdf", "usdc")).Sub(sdk.OneDec()).Abs().LT(d("0.000000000001")))
+
+		// the output of a small swap is close to the spot price
+		output, _ := pool.SwapWithExactInput(sdk.NewCoin("usdc", i(1000)), sdk.ZeroDec())
+		assert.Truef(t, sdk.NewDecFromInt(output.Amount).QuoInt64(1000).Sub(price).Abs().LT(d("0.01")),
+			"expected swap price %s close to spot price %s", output.Amount, price)
+	}
+}
@@ -15,4 +15,7 @@ var (
 	ErrDepositNotFound       = errorsmod.Register(ModuleName, 10, "deposit not found")
 	ErrInvalidCoin           = errorsmod.Register(ModuleName, 11, "invalid coin")
 	ErrNotImplemented        = errorsmod.Register(ModuleName, 12, "not implemented")
+	ErrInvalidRoute          = errorsmod.Register(ModuleName, 13, "invalid route")
+	ErrInvalidProtocolFee    = errorsmod.Register(ModuleName, 14, "invalid protocol fee")
+	ErrNoPriceHistory        = errorsmod.Register(ModuleName, 15, "price history not found")
 )
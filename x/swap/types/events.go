@@ -6,6 +6,7 @@ const (
 	EventTypeSwapDeposit       = "swap_deposit"
 	EventTypeSwapWithdraw      = "swap_withdraw"
 	EventTypeSwapTrade         = "swap_trade"
+	EventTypeSwapProtocolFee   = "swap_protocol_fee"
 	AttributeKeyPoolID         = "pool_id"
 	AttributeKeyDepositor      = "depositor"
 	AttributeKeyShares         = "shares"
@@ -15,4 +16,5 @@ const (
 	AttributeKeySwapOutput     = "output"
 	AttributeKeyFeePaid        = "fee"
 	AttributeKeyExactDirection = "exact"
+	AttributeKeyRecipient      = "recipient"
 )
@@ -17,14 +17,17 @@ var (
 	DefaultPoolRecords = PoolRecords{}
 	// DefaultShareRecords is used to set default records in default genesis state
 	DefaultShareRecords = ShareRecords{}
+	// DefaultPoolPriceSnapshots is used to set default price snapshots in default genesis state
+	DefaultPoolPriceSnapshots = PoolPriceSnapshots{}
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords) GenesisState {
+func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords, poolPriceSnapshots PoolPriceSnapshots) GenesisState {
 	return GenesisState{
-		Params:       params,
-		PoolRecords:  poolRecords,
-		ShareRecords: shareRecords,
+		Params:             params,
+		PoolRecords:        poolRecords,
+		ShareRecords:       shareRecords,
+		PoolPriceSnapshots: poolPriceSnapshots,
 	}
 }
 
@@ -39,6 +42,9 @@ func (gs GenesisState) Validate() error {
 	if err := gs.ShareRecords.Validate(); err != nil {
 		return err
 	}
+	if err := gs.PoolPriceSnapshots.Validate(); err != nil {
+		return err
+	}
 
 	totalShares := make(map[string]poolShares)
 	for _, pr := range gs.PoolRecords {
@@ -65,6 +71,12 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, ps := range gs.PoolPriceSnapshots {
+		if _, found := totalShares[ps.PoolID]; !found {
+			return fmt.Errorf("price snapshot for pool '%s' has no pool record", ps.PoolID)
+		}
+	}
+
 	return nil
 }
 
@@ -74,5 +86,6 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		DefaultPoolRecords,
 		DefaultShareRecords,
+		DefaultPoolPriceSnapshots,
 	)
 }
@@ -31,6 +31,8 @@ type GenesisState struct {
 	PoolRecords PoolRecords `protobuf:"bytes,2,rep,name=pool_records,json=poolRecords,proto3,castrepeated=PoolRecords" json:"pool_records"`
 	// share_records defines the owned shares of each pool
 	ShareRecords ShareRecords `protobuf:"bytes,3,rep,name=share_records,json=shareRecords,proto3,castrepeated=ShareRecords" json:"share_records"`
+	// pool_price_snapshots defines the price history of each pool, oldest first within each pool
+	PoolPriceSnapshots PoolPriceSnapshots `protobuf:"bytes,4,rep,name=pool_price_snapshots,json=poolPriceSnapshots,proto3,castrepeated=PoolPriceSnapshots" json:"pool_price_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +89,13 @@ func (m *GenesisState) GetShareRecords() ShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPoolPriceSnapshots() PoolPriceSnapshots {
+	if m != nil {
+		return m.PoolPriceSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.swap.v1beta1.GenesisState")
 }
@@ -94,26 +103,28 @@ func init() {
 func init() { proto.RegisterFile("fury/swap/v1beta1/genesis.proto", fileDescriptor_9af4e629d5ab98a1) }
 
 var fileDescriptor_9af4e629d5ab98a1 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2b, 0x2d, 0xaa,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x92, 0xc1, 0xd4, 0x03, 0x96, 0x07, 0x6b, 0x90, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0xd2, 0x4f, 0x46, 0x2e, 0x1e, 0x77,
-	0x88, 0xc1, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xe6, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9,
-	0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x18, 0x16, 0xe9, 0x05, 0x80, 0x15,
-	0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0x2e, 0x14, 0xca, 0xc5, 0x53, 0x90, 0x9f,
-	0x9f, 0x13, 0x5f, 0x94, 0x9a, 0x9c, 0x5f, 0x94, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d,
-	0x24, 0x8b, 0x4d, 0x7b, 0x7e, 0x7e, 0x4e, 0x10, 0x58, 0x95, 0x93, 0x30, 0xc8, 0x88, 0x55, 0xf7,
-	0xe5, 0xb9, 0x11, 0x62, 0xc5, 0x41, 0xdc, 0x05, 0x08, 0x8e, 0x50, 0x24, 0x17, 0x6f, 0x71, 0x46,
-	0x62, 0x51, 0x2a, 0xdc, 0x5c, 0x66, 0xb0, 0xb9, 0x72, 0x58, 0xcc, 0x0d, 0x06, 0xa9, 0x83, 0x1a,
-	0x2c, 0x02, 0x35, 0x98, 0x07, 0x49, 0xb0, 0x38, 0x88, 0xa7, 0x18, 0x89, 0xe7, 0xe4, 0x72, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x5a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x05, 0xa9, 0x45, 0xc9, 0xf9, 0xc5, 0x99, 0xc5, 0xba, 0x39, 0x89,
-	0x49, 0xc5, 0xfa, 0xe0, 0x20, 0xae, 0x80, 0x04, 0x72, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0x38, 0x20, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xc6, 0xee, 0x39, 0xb2, 0x01, 0x00,
-	0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x20, 0x0c, 0x6d, 0x1d, 0x3c, 0x19, 0x90, 0xe8, 0x41, 0x8c, 0x03, 0x31, 0xb1,
+	0x0d, 0x38, 0xb8, 0x37, 0x26, 0xae, 0xa4, 0xc4, 0x41, 0x17, 0x72, 0xad, 0x67, 0x69, 0x02, 0xbc,
+	0xcb, 0xbd, 0x43, 0xe5, 0x5b, 0xf8, 0x39, 0xfc, 0x24, 0x8c, 0x8c, 0x4e, 0x6a, 0x60, 0xf6, 0x3b,
+	0x98, 0x5e, 0x2f, 0x42, 0x52, 0xdc, 0xee, 0xfe, 0xef, 0xf7, 0x7e, 0xef, 0x25, 0xcf, 0x69, 0x3f,
+	0xcd, 0xe5, 0x22, 0xc0, 0x17, 0x26, 0x82, 0xe7, 0x5e, 0xcc, 0x15, 0xeb, 0x05, 0x29, 0x9f, 0x71,
+	0xcc, 0xd0, 0x17, 0x12, 0x14, 0x90, 0xc3, 0x1c, 0xf0, 0x73, 0xc0, 0x37, 0x40, 0xeb, 0xa4, 0xdc,
+	0xa3, 0xeb, 0xba, 0xa1, 0xd5, 0x48, 0x21, 0x05, 0xfd, 0x0c, 0xf2, 0x57, 0x91, 0x9e, 0xfd, 0x54,
+	0x1c, 0xef, 0xb6, 0x10, 0x0f, 0x15, 0x53, 0x9c, 0x5c, 0x3b, 0x75, 0xc1, 0x24, 0x9b, 0x62, 0xd3,
+	0xee, 0xd8, 0x5d, 0xb7, 0x7f, 0xec, 0x97, 0x06, 0xf9, 0x03, 0x0d, 0x84, 0xb5, 0xe5, 0x67, 0xdb,
+	0x8a, 0x0c, 0x4e, 0xee, 0x1c, 0x4f, 0x00, 0x4c, 0x46, 0x92, 0x27, 0x20, 0x1f, 0xb1, 0x59, 0xe9,
+	0x54, 0xbb, 0x6e, 0xff, 0x74, 0x5f, 0x3b, 0xc0, 0x24, 0xd2, 0x54, 0x78, 0x94, 0x2b, 0xde, 0xbf,
+	0xda, 0xee, 0x36, 0xc3, 0xc8, 0x15, 0xdb, 0x0f, 0xb9, 0x77, 0x0e, 0x70, 0xcc, 0x24, 0xff, 0xf3,
+	0x56, 0xb5, 0x97, 0xee, 0xf1, 0x0e, 0x73, 0xce, 0x88, 0x1b, 0x46, 0xec, 0xed, 0x84, 0x18, 0x79,
+	0xb8, 0xf3, 0x23, 0xd2, 0x69, 0xe8, 0x8d, 0x85, 0xcc, 0x12, 0x3e, 0xc2, 0x19, 0x13, 0x38, 0x06,
+	0x85, 0xcd, 0x9a, 0x9e, 0x70, 0xfe, 0xcf, 0xe6, 0x83, 0x9c, 0x1e, 0x1a, 0x38, 0x6c, 0x99, 0x39,
+	0xa4, 0x54, 0xc2, 0x88, 0x88, 0x52, 0x16, 0xde, 0x2c, 0xd7, 0xd4, 0x5e, 0xad, 0xa9, 0xfd, 0xbd,
+	0xa6, 0xf6, 0xdb, 0x86, 0x5a, 0xab, 0x0d, 0xb5, 0x3e, 0x36, 0xd4, 0x7a, 0xb8, 0x48, 0x33, 0x35,
+	0x9e, 0xc7, 0x7e, 0x02, 0xd3, 0x40, 0x70, 0x99, 0x00, 0x66, 0x78, 0x39, 0x61, 0x31, 0x06, 0xfa,
+	0xac, 0xaf, 0xc5, 0x61, 0xd5, 0x42, 0x70, 0x8c, 0xeb, 0xfa, 0x78, 0x57, 0xbf, 0x03, 0x00, 0xaa,
+	0x24, 0xb3, 0xae, 0x26, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +147,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolPriceSnapshots) > 0 {
+		for iNdEx := len(m.PoolPriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolPriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ShareRecords) > 0 {
 		for iNdEx := len(m.ShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +233,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolPriceSnapshots) > 0 {
+		for _, e := range m.PoolPriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +378,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolPriceSnapshots = append(m.PoolPriceSnapshots, PoolPriceSnapshot{})
+			if err := m.PoolPriceSnapshots[len(m.PoolPriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -217,6 +217,7 @@ share_records:
 			types.NewShareRecord(depositor_1, types.PoolID("ufury", "usdf"), i(1e5)),
 			types.NewShareRecord(depositor_2, types.PoolID("jinx", "usdf"), i(2e5)),
 		},
+		types.PoolPriceSnapshots{},
 	)
 
 	data, err := yaml.Marshal(state)
@@ -232,6 +233,7 @@ func TestGenesis_ValidatePoolRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{invalidPoolRecord},
 		types.ShareRecords{},
+		types.PoolPriceSnapshots{},
 	)
 
 	assert.Error(t, state.Validate())
@@ -247,6 +249,7 @@ func TestGenesis_ValidateShareRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{},
 		types.ShareRecords{invalidShareRecord},
+		types.PoolPriceSnapshots{},
 	)
 
 	assert.Error(t, state.Validate())
@@ -323,7 +326,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords)
+			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords, types.PoolPriceSnapshots{})
 			err := state.Validate()
 
 			if tc.expectedErr == "" {
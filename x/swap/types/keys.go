@@ -28,6 +28,8 @@ const (
 var (
 	PoolKeyPrefix             = []byte{0x01}
 	DepositorPoolSharesPrefix = []byte{0x02}
+	PoolPriceSnapshotPrefix   = []byte{0x03}
+	PoolPriceHistoryPrefix    = []byte{0x04}
 
 	sep = []byte("|")
 )
@@ -42,6 +44,21 @@ func DepositorPoolSharesKey(depositor sdk.AccAddress, poolID string) []byte {
 	return createKey(depositor, sep, []byte(poolID))
 }
 
+// PoolPriceSnapshotIteratorKey returns a key prefix for iterating over the price snapshots of a pool
+func PoolPriceSnapshotIteratorKey(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// PoolPriceSnapshotKey returns a key from a poolID and the sequence of a price snapshot
+func PoolPriceSnapshotKey(poolID string, sequence uint64) []byte {
+	return createKey([]byte(poolID), sep, sdk.Uint64ToBigEndian(sequence))
+}
+
+// PoolPriceHistoryKey returns a key generated from a poolID
+func PoolPriceHistoryKey(poolID string) []byte {
+	return []byte(poolID)
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
@@ -18,6 +18,14 @@ const (
 	TypeSwapExactForTokens = "swap_exact_for_tokens"
 	// TypeSwapForExactTokens represents the type string for MsgSwapForExactTokens
 	TypeSwapForExactTokens = "swap_for_exact_tokens"
+	// TypeSwapExactForTokensMultiHop represents the type string for MsgSwapExactForTokensMultiHop
+	TypeSwapExactForTokensMultiHop = "swap_exact_for_tokens_multi_hop"
+	// TypeSwapForExactTokensMultiHop represents the type string for MsgSwapForExactTokensMultiHop
+	TypeSwapForExactTokensMultiHop = "swap_for_exact_tokens_multi_hop"
+	// TypeMsgDepositToPool represents the type string for MsgDepositToPool
+	TypeMsgDepositToPool = "swap_deposit_to_pool"
+	// TypeMsgWithdrawFromPool represents the type string for MsgWithdrawFromPool
+	TypeMsgWithdrawFromPool = "swap_withdraw_from_pool"
 )
 
 var (
@@ -29,6 +37,14 @@ var (
 	_ MsgWithDeadline = &MsgSwapExactForTokens{}
 	_ sdk.Msg         = &MsgSwapForExactTokens{}
 	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+	_ sdk.Msg         = &MsgSwapExactForTokensMultiHop{}
+	_ MsgWithDeadline = &MsgSwapExactForTokensMultiHop{}
+	_ sdk.Msg         = &MsgSwapForExactTokensMultiHop{}
+	_ MsgWithDeadline = &MsgSwapForExactTokensMultiHop{}
+	_ sdk.Msg         = &MsgDepositToPool{}
+	_ MsgWithDeadline = &MsgDepositToPool{}
+	_ sdk.Msg         = &MsgWithdrawFromPool{}
+	_ MsgWithDeadline = &MsgWithdrawFromPool{}
 )
 
 // MsgWithDeadline allows messages to define a deadline of when they are considered invalid
@@ -340,3 +356,264 @@ func (msg MsgSwapForExactTokens) GetDeadline() time.Time {
 func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
+
+// NewMsgSwapExactForTokensMultiHop returns a new MsgSwapExactForTokensMultiHop
+func NewMsgSwapExactForTokensMultiHop(requester string, exactTokenA sdk.Coin, tokenB sdk.Coin, pools []string, slippage sdk.Dec, deadline int64) *MsgSwapExactForTokensMultiHop {
+	return &MsgSwapExactForTokensMultiHop{
+		Requester:   requester,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		Pools:       pools,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokensMultiHop) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokensMultiHop) Type() string { return TypeSwapExactForTokensMultiHop }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokensMultiHop) ValidateBasic() error {
+	if err := NewMsgSwapExactForTokens(msg.Requester, msg.ExactTokenA, msg.TokenB, msg.Slippage, msg.Deadline).ValidateBasic(); err != nil {
+		return err
+	}
+
+	return ValidateRoute(msg.Pools, msg.ExactTokenA.Denom, msg.TokenB.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokensMultiHop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokensMultiHop) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapExactForTokensMultiHop) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapExactForTokensMultiHop) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgSwapForExactTokensMultiHop returns a new MsgSwapForExactTokensMultiHop
+func NewMsgSwapForExactTokensMultiHop(requester string, tokenA sdk.Coin, exactTokenB sdk.Coin, pools []string, slippage sdk.Dec, deadline int64) *MsgSwapForExactTokensMultiHop {
+	return &MsgSwapForExactTokensMultiHop{
+		Requester:   requester,
+		TokenA:      tokenA,
+		ExactTokenB: exactTokenB,
+		Pools:       pools,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapForExactTokensMultiHop) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapForExactTokensMultiHop) Type() string { return TypeSwapForExactTokensMultiHop }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapForExactTokensMultiHop) ValidateBasic() error {
+	if err := NewMsgSwapForExactTokens(msg.Requester, msg.TokenA, msg.ExactTokenB, msg.Slippage, msg.Deadline).ValidateBasic(); err != nil {
+		return err
+	}
+
+	return ValidateRoute(msg.Pools, msg.TokenA.Denom, msg.ExactTokenB.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapForExactTokensMultiHop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapForExactTokensMultiHop) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapForExactTokensMultiHop) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapForExactTokensMultiHop) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgDepositToPool returns a new MsgDepositToPool
+func NewMsgDepositToPool(depositor string, poolID string, tokens sdk.Coins, minShares sdkmath.Int, deadline int64) *MsgDepositToPool {
+	return &MsgDepositToPool{
+		Depositor: depositor,
+		PoolID:    poolID,
+		Tokens:    tokens,
+		MinShares: minShares,
+		Deadline:  deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositToPool) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositToPool) Type() string { return TypeMsgDepositToPool }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositToPool) ValidateBasic() error {
+	if msg.Depositor == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	denoms, _, err := ParsePoolID(msg.PoolID)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	if msg.Tokens.Empty() || !msg.Tokens.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Tokens)
+	}
+
+	for _, token := range msg.Tokens {
+		if !containsDenom(denoms, token.Denom) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s is not in pool %s", token.Denom, msg.PoolID)
+		}
+	}
+
+	if msg.MinShares.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidShares, "min shares must be set")
+	}
+
+	if msg.MinShares.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidShares, "min shares can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositToPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositToPool) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgDepositToPool) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgDepositToPool) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgWithdrawFromPool returns a new MsgWithdrawFromPool
+func NewMsgWithdrawFromPool(from string, poolID string, shares sdkmath.Int, minTokens sdk.Coins, denomOut string, deadline int64) *MsgWithdrawFromPool {
+	return &MsgWithdrawFromPool{
+		From:      from,
+		PoolID:    poolID,
+		Shares:    shares,
+		MinTokens: minTokens,
+		DenomOut:  denomOut,
+		Deadline:  deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawFromPool) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawFromPool) Type() string { return TypeMsgWithdrawFromPool }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawFromPool) ValidateBasic() error {
+	if msg.From == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "from address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address: %s", err)
+	}
+
+	denoms, _, err := ParsePoolID(msg.PoolID)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	if msg.Shares.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidShares, "shares must be set")
+	}
+
+	if msg.Shares.IsZero() || msg.Shares.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidShares, msg.Shares.String())
+	}
+
+	if msg.DenomOut != "" && !containsDenom(denoms, msg.DenomOut) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "denom out %s is not in pool %s", msg.DenomOut, msg.PoolID)
+	}
+
+	if !msg.MinTokens.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "min tokens amount %s", msg.MinTokens)
+	}
+
+	for _, token := range msg.MinTokens {
+		if !containsDenom(denoms, token.Denom) || (msg.DenomOut != "" && token.Denom != msg.DenomOut) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "min token denom %s is not withdrawn from pool %s", token.Denom, msg.PoolID)
+		}
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawFromPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawFromPool) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.From)
+	return []sdk.AccAddress{from}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgWithdrawFromPool) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgWithdrawFromPool) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
@@ -764,3 +764,278 @@ func TestMsgSwapForExactTokens_Deadline(t *testing.T) {
 		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
 	}
 }
+
+func TestMsgSwapExactForTokensMultiHop_Attributes(t *testing.T) {
+	msg := types.MsgSwapExactForTokensMultiHop{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_exact_for_tokens_multi_hop", msg.Type())
+}
+
+func TestMsgSwapExactForTokensMultiHop_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		exactTokenA sdk.Coin
+		pools       []string
+		expectedErr string
+	}{
+		{
+			name:        "route",
+			exactTokenA: sdk.NewCoin("bnb", sdkmath.NewInt(1e6)),
+			pools:       []string{"bnb:ufury", "ufury:usdf"},
+		},
+		{
+			name:        "no route",
+			exactTokenA: sdk.NewCoin("bnb", sdkmath.NewInt(1e6)),
+		},
+		{
+			name:        "invalid route",
+			exactTokenA: sdk.NewCoin("bnb", sdkmath.NewInt(1e6)),
+			pools:       []string{"bnb:ufury", "bnb:usdf"},
+			expectedErr: "pool bnb:usdf does not trade ufury: invalid route",
+		},
+		{
+			name:        "invalid tokens",
+			exactTokenA: sdk.NewCoin("usdf", sdkmath.NewInt(1e6)),
+			pools:       []string{"bnb:ufury", "ufury:usdf"},
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactForTokensMultiHop(
+				sdk.AccAddress("test1").String(),
+				tc.exactTokenA,
+				sdk.NewCoin("usdf", sdkmath.NewInt(5e6)),
+				tc.pools,
+				sdk.MustNewDecFromStr("0.01"),
+				1623606299,
+			)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgSwapForExactTokensMultiHop_Attributes(t *testing.T) {
+	msg := types.MsgSwapForExactTokensMultiHop{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_for_exact_tokens_multi_hop", msg.Type())
+}
+
+func TestMsgSwapForExactTokensMultiHop_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		exactTokenB sdk.Coin
+		pools       []string
+		expectedErr string
+	}{
+		{
+			name:        "route",
+			exactTokenB: sdk.NewCoin("usdf", sdkmath.NewInt(5e6)),
+			pools:       []string{"bnb:ufury", "ufury:usdf"},
+		},
+		{
+			name:        "no route",
+			exactTokenB: sdk.NewCoin("usdf", sdkmath.NewInt(5e6)),
+		},
+		{
+			name:        "route ends in wrong denom",
+			exactTokenB: sdk.NewCoin("usdf", sdkmath.NewInt(5e6)),
+			pools:       []string{"bnb:ufury"},
+			expectedErr: "route ends in ufury, not usdf: invalid route",
+		},
+		{
+			name:        "invalid exact token b",
+			exactTokenB: sdk.NewCoin("usdf", sdkmath.ZeroInt()),
+			pools:       []string{"bnb:ufury", "ufury:usdf"},
+			expectedErr: "exact token b deposit amount 0usdf: invalid coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapForExactTokensMultiHop(
+				sdk.AccAddress("test1").String(),
+				sdk.NewCoin("bnb", sdkmath.NewInt(1e6)),
+				tc.exactTokenB,
+				tc.pools,
+				sdk.MustNewDecFromStr("0.01"),
+				1623606299,
+			)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDepositToPool_Attributes(t *testing.T) {
+	msg := types.MsgDepositToPool{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_deposit_to_pool", msg.Type())
+}
+
+func TestMsgDepositToPool_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		poolID      string
+		tokens      sdk.Coins
+		minShares   sdkmath.Int
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:      "all tokens",
+			poolID:    "jinx:ufury:usdf",
+			tokens:    sdk.NewCoins(sdk.NewInt64Coin("jinx", 1e6), sdk.NewInt64Coin("ufury", 1e6), sdk.NewInt64Coin("usdf", 5e6)),
+			minShares: sdkmath.NewInt(1e6),
+		},
+		{
+			name:      "single token",
+			poolID:    "ufury:usdf@80:20",
+			tokens:    sdk.NewCoins(sdk.NewInt64Coin("usdf", 5e6)),
+			minShares: sdkmath.ZeroInt(),
+		},
+		{
+			name:        "invalid pool id",
+			poolID:      "usdf:ufury",
+			tokens:      sdk.NewCoins(sdk.NewInt64Coin("usdf", 5e6)),
+			minShares:   sdkmath.ZeroInt(),
+			expectedErr: "poolID 'usdf:ufury' is invalid: invalid pool",
+		},
+		{
+			name:        "empty tokens",
+			poolID:      "ufury:usdf@80:20",
+			minShares:   sdkmath.ZeroInt(),
+			expectedErr: "deposit amount : invalid coins",
+		},
+		{
+			name:        "token not in pool",
+			poolID:      "ufury:usdf@80:20",
+			tokens:      sdk.NewCoins(sdk.NewInt64Coin("jinx", 5e6)),
+			minShares:   sdkmath.ZeroInt(),
+			expectedErr: "denom jinx is not in pool ufury:usdf@80:20: invalid coins",
+		},
+		{
+			name:        "nil min shares",
+			poolID:      "ufury:usdf@80:20",
+			tokens:      sdk.NewCoins(sdk.NewInt64Coin("usdf", 5e6)),
+			expectedErr: "min shares must be set: invalid shares",
+		},
+		{
+			name:        "negative min shares",
+			poolID:      "ufury:usdf@80:20",
+			tokens:      sdk.NewCoins(sdk.NewInt64Coin("usdf", 5e6)),
+			minShares:   sdkmath.NewInt(-1),
+			expectedErr: "min shares can not be negative: invalid shares",
+		},
+		{
+			name:        "zero deadline",
+			poolID:      "ufury:usdf@80:20",
+			tokens:      sdk.NewCoins(sdk.NewInt64Coin("usdf", 5e6)),
+			minShares:   sdkmath.ZeroInt(),
+			deadline:    -1623606299,
+			expectedErr: "deadline -1623606299: invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deadline := tc.deadline
+			if deadline == 0 {
+				deadline = 1623606299
+			}
+			msg := types.NewMsgDepositToPool(sdk.AccAddress("test1").String(), tc.poolID, tc.tokens, tc.minShares, deadline)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawFromPool_Attributes(t *testing.T) {
+	msg := types.MsgWithdrawFromPool{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_withdraw_from_pool", msg.Type())
+}
+
+func TestMsgWithdrawFromPool_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		poolID      string
+		shares      sdkmath.Int
+		minTokens   sdk.Coins
+		denomOut    string
+		expectedErr string
+	}{
+		{
+			name:      "all tokens",
+			poolID:    "jinx:ufury:usdf",
+			shares:    sdkmath.NewInt(1e6),
+			minTokens: sdk.NewCoins(sdk.NewInt64Coin("jinx", 1e6), sdk.NewInt64Coin("usdf", 5e6)),
+		},
+		{
+			name:      "single token",
+			poolID:    "ufury:usdf@80:20",
+			shares:    sdkmath.NewInt(1e6),
+			minTokens: sdk.NewCoins(sdk.NewInt64Coin("usdf", 5e6)),
+			denomOut:  "usdf",
+		},
+		{
+			name:     "no min tokens",
+			poolID:   "ufury:usdf@80:20",
+			shares:   sdkmath.NewInt(1e6),
+			denomOut: "usdf",
+		},
+		{
+			name:        "invalid pool id",
+			poolID:      "ufury:usdf@50:50",
+			shares:      sdkmath.NewInt(1e6),
+			expectedErr: "poolID 'ufury:usdf@50:50' is invalid: invalid pool",
+		},
+		{
+			name:        "zero shares",
+			poolID:      "ufury:usdf@80:20",
+			shares:      sdkmath.ZeroInt(),
+			expectedErr: "0: invalid shares",
+		},
+		{
+			name:        "denom out not in pool",
+			poolID:      "ufury:usdf@80:20",
+			shares:      sdkmath.NewInt(1e6),
+			denomOut:    "jinx",
+			expectedErr: "denom out jinx is not in pool ufury:usdf@80:20: invalid coins",
+		},
+		{
+			name:        "min token not withdrawn",
+			poolID:      "ufury:usdf@80:20",
+			shares:      sdkmath.NewInt(1e6),
+			minTokens:   sdk.NewCoins(sdk.NewInt64Coin("ufury", 1e6)),
+			denomOut:    "usdf",
+			expectedErr: "min token denom ufury is not withdrawn from pool ufury:usdf@80:20: invalid coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWithdrawFromPool(sdk.AccAddress("test1").String(), tc.poolID, tc.shares, tc.minTokens, tc.denomOut, 1623606299)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -10,18 +11,33 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyAllowedPools     = []byte("AllowedPools")
-	KeySwapFee          = []byte("SwapFee")
-	DefaultAllowedPools = AllowedPools{}
-	DefaultSwapFee      = sdk.ZeroDec()
-	MaxSwapFee          = sdk.OneDec()
+	KeyAllowedPools             = []byte("AllowedPools")
+	KeySwapFee                  = []byte("SwapFee")
+	KeyProtocolFeeShare         = []byte("ProtocolFeeShare")
+	KeyProtocolFeeRecipient     = []byte("ProtocolFeeRecipient")
+	KeyPriceHistoryWindow       = []byte("PriceHistoryWindow")
+	DefaultAllowedPools         = AllowedPools{}
+	DefaultSwapFee              = sdk.ZeroDec()
+	DefaultProtocolFeeShare     = sdk.ZeroDec()
+	DefaultProtocolFeeRecipient = ""
+	DefaultPriceHistoryWindow   = 24 * time.Hour
+	MaxSwapFee                  = sdk.OneDec()
+	MaxProtocolFeeShare         = sdk.OneDec()
 )
 
-// NewParams returns a new params object
+// NewParams returns a new params object without a protocol fee
 func NewParams(pairs AllowedPools, swapFee sdk.Dec) Params {
+	return NewParamsWithProtocolFee(pairs, swapFee, DefaultProtocolFeeShare, DefaultProtocolFeeRecipient)
+}
+
+// NewParamsWithProtocolFee returns a new params object that pays a share of each swap fee to a protocol fee recipient
+func NewParamsWithProtocolFee(pairs AllowedPools, swapFee, protocolFeeShare sdk.Dec, protocolFeeRecipient string) Params {
 	return Params{
-		AllowedPools: pairs,
-		SwapFee:      swapFee,
+		AllowedPools:         pairs,
+		SwapFee:              swapFee,
+		ProtocolFeeShare:     protocolFeeShare,
+		ProtocolFeeRecipient: protocolFeeRecipient,
+		PriceHistoryWindow:   DefaultPriceHistoryWindow,
 	}
 }
 
@@ -37,8 +53,16 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	AllowedPools: %s
-	SwapFee: %s`,
-		p.AllowedPools, p.SwapFee)
+	SwapFee: %s
+	ProtocolFeeShare: %s
+	ProtocolFeeRecipient: %s
+	PriceHistoryWindow: %s`,
+		p.AllowedPools, p.SwapFee, p.ProtocolFeeShare, p.ProtocolFeeRecipient, p.PriceHistoryWindow)
+}
+
+// ProtocolFeeEnabled returns true if a share of each swap fee is paid to the protocol fee recipient
+func (p Params) ProtocolFeeEnabled() bool {
+	return !p.ProtocolFeeShare.IsNil() && p.ProtocolFeeShare.IsPositive()
 }
 
 // ParamKeyTable for swap module.
@@ -51,6 +75,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
 		paramtypes.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyProtocolFeeRecipient, &p.ProtocolFeeRecipient, validateProtocolFeeRecipient),
+		paramtypes.NewParamSetPair(KeyPriceHistoryWindow, &p.PriceHistoryWindow, validatePriceHistoryWindow),
 	}
 }
 
@@ -60,7 +87,19 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateSwapFee(p.SwapFee)
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeRecipient(p.ProtocolFeeRecipient); err != nil {
+		return err
+	}
+
+	return validatePriceHistoryWindow(p.PriceHistoryWindow)
 }
 
 func validateAllowedPoolsParams(i interface{}) error {
@@ -85,6 +124,51 @@ func validateSwapFee(i interface{}) error {
 	return nil
 }
 
+func validateProtocolFeeShare(i interface{}) error {
+	share, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset share disables the protocol fee
+	if !share.IsNil() && (share.IsNegative() || share.GT(MaxProtocolFeeShare)) {
+		return fmt.Errorf("invalid protocol fee share: %s", share)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeRecipient(i interface{}) error {
+	recipient, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(recipient) != recipient {
+		return fmt.Errorf("invalid protocol fee recipient: '%s'", recipient)
+	}
+
+	// protocol fees held by the swap module would be indistinguishable from pool reserves
+	if recipient == ModuleAccountName {
+		return fmt.Errorf("protocol fee recipient can not be the %s module account", ModuleAccountName)
+	}
+
+	return nil
+}
+
+func validatePriceHistoryWindow(i interface{}) error {
+	window, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if window < 0 {
+		return fmt.Errorf("price history window cannot be negative: %s", window)
+	}
+
+	return nil
+}
+
 // NewAllowedPool returns a new AllowedPool object
 func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	return AllowedPool{
@@ -95,6 +179,28 @@ func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 
 // Validate validates allowedPool attributes and returns an error if invalid
 func (p AllowedPool) Validate() error {
+	if p.SwapFee != nil {
+		if err := validateSwapFee(*p.SwapFee); err != nil {
+			return fmt.Errorf("pool %s: %w", p.Name(), err)
+		}
+	}
+
+	if p.PoolType == POOL_TYPE_WEIGHTED {
+		if p.TokenA != "" || p.TokenB != "" {
+			return fmt.Errorf("tokenA and tokenB must be empty for %s, use tokens", p.PoolType)
+		}
+
+		if err := validateWeightedTokens(p.Tokens, p.Weights); err != nil {
+			return err
+		}
+
+		return validatePoolType(p.PoolType, p.Amplification)
+	}
+
+	if len(p.Tokens) != 0 || len(p.Weights) != 0 {
+		return fmt.Errorf("tokens and weights must be empty for %s", p.PoolType)
+	}
+
 	err := sdk.ValidateDenom(p.TokenA)
 	if err != nil {
 		return err
@@ -129,21 +235,133 @@ func (p AllowedPool) Validate() error {
 		)
 	}
 
+	return validatePoolType(p.PoolType, p.Amplification)
+}
+
+// validatePoolType returns an error if a pool type is unknown or its amplification is invalid
+func validatePoolType(poolType PoolType, amplification uint64) error {
+	switch poolType {
+	case POOL_TYPE_UNSPECIFIED, POOL_TYPE_CONSTANT_PRODUCT, POOL_TYPE_WEIGHTED:
+		if amplification != 0 {
+			return fmt.Errorf("amplification must be zero for %s", poolType)
+		}
+	case POOL_TYPE_STABLESWAP:
+		if amplification == 0 || amplification > MaxAmplification {
+			return fmt.Errorf("amplification must be between 1 and %d for %s", MaxAmplification, poolType)
+		}
+	default:
+		return fmt.Errorf("unknown pool type %s", poolType)
+	}
+
+	return nil
+}
+
+// validateWeightedTokens returns an error if the denoms of a weighted pool are invalid or not sorted, or
+// if the weights do not match the denoms
+func validateWeightedTokens(tokens []string, weights []uint64) error {
+	if len(tokens) < 2 || len(tokens) > MaxPoolAssets {
+		return fmt.Errorf("weighted pool must have between 2 and %d tokens, received %d", MaxPoolAssets, len(tokens))
+	}
+
+	if len(weights) != len(tokens) {
+		return fmt.Errorf("weighted pool must have one weight per token, received %d tokens and %d weights", len(tokens), len(weights))
+	}
+
+	var totalWeight uint64
+	for i, token := range tokens {
+		if err := sdk.ValidateDenom(token); err != nil {
+			return err
+		}
+
+		if strings.Contains(token, ":") {
+			return fmt.Errorf("token cannot have colons in the denom: %s", token)
+		}
+
+		if i > 0 && tokens[i-1] >= token {
+			return fmt.Errorf("invalid token order: tokens must be sorted and unique, received '%s' before '%s'", tokens[i-1], token)
+		}
+
+		if weights[i] == 0 {
+			return fmt.Errorf("weight of token '%s' must be positive", token)
+		}
+
+		totalWeight += weights[i]
+		if totalWeight > MaxTotalWeight {
+			return fmt.Errorf("total weight must not exceed %d", MaxTotalWeight)
+		}
+	}
+
+	if len(tokens) == 2 && weights[0] == weights[1] {
+		return fmt.Errorf("two token pool with equal weights must use %s", POOL_TYPE_CONSTANT_PRODUCT)
+	}
+
 	return nil
 }
 
 // Name returns the name for the allowed pool
 func (p AllowedPool) Name() string {
+	if p.PoolType == POOL_TYPE_WEIGHTED {
+		return WeightedPoolID(p.Tokens, p.Weights)
+	}
+
 	return PoolID(p.TokenA, p.TokenB)
 }
 
+// WithSwapFee returns a copy of the allowed pool that overrides the global swap fee
+func (p AllowedPool) WithSwapFee(swapFee sdk.Dec) AllowedPool {
+	p.SwapFee = &swapFee
+	return p
+}
+
+// NewWeightedAllowedPool returns a new AllowedPool object for a weighted pool of sorted tokens
+func NewWeightedAllowedPool(tokens []string, weights []uint64) AllowedPool {
+	return AllowedPool{
+		Tokens:   tokens,
+		Weights:  weights,
+		PoolType: POOL_TYPE_WEIGHTED,
+	}
+}
+
+// NewStableSwapAllowedPool returns a new AllowedPool object for a stableswap pool
+func NewStableSwapAllowedPool(tokenA, tokenB string, amplification uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:        tokenA,
+		TokenB:        tokenB,
+		PoolType:      POOL_TYPE_STABLESWAP,
+		Amplification: amplification,
+	}
+}
+
 // String pretty prints the allowedPool
 func (p AllowedPool) String() string {
-	return fmt.Sprintf(`AllowedPool:
+	var out string
+	if p.PoolType == POOL_TYPE_WEIGHTED {
+		out = fmt.Sprintf(`AllowedPool:
+  Name: %s
+	Tokens: %s
+	Weights: %v
+	Pool Type: %s
+`, p.Name(), strings.Join(p.Tokens, ", "), p.Weights, p.PoolType)
+	} else {
+		out = fmt.Sprintf(`AllowedPool:
   Name: %s
 	Token A: %s
 	Token B: %s
 `, p.Name(), p.TokenA, p.TokenB)
+
+		if p.PoolType == POOL_TYPE_STABLESWAP {
+			out += fmt.Sprintf(`	Pool Type: %s
+	Amplification: %d
+`, p.PoolType, p.Amplification)
+		}
+	}
+
+	if p.SwapFee != nil {
+		out += fmt.Sprintf(`	Swap Fee: %s
+`, p.SwapFee)
+	}
+
+	return out
 }
 
 // AllowedPools is a slice of AllowedPool
@@ -154,6 +372,17 @@ func NewAllowedPools(allowedPools ...AllowedPool) AllowedPools {
 	return AllowedPools(allowedPools)
 }
 
+// SwapFee returns the swap fee of a pool, which is the global swap fee unless the pool is allowed with its own fee
+func (p AllowedPools) SwapFee(poolID string, globalSwapFee sdk.Dec) sdk.Dec {
+	for _, allowedPool := range p {
+		if allowedPool.Name() == poolID && allowedPool.SwapFee != nil {
+			return *allowedPool.SwapFee
+		}
+	}
+
+	return globalSwapFee
+}
+
 // Validate validates each allowedPool and returns an error if there are any duplicates
 func (p AllowedPools) Validate() error {
 	seenAllowedPools := make(map[string]bool)
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/percosis-labs/fury/x/swap/types"
 
@@ -76,6 +77,7 @@ func TestParams_Default(t *testing.T) {
 
 	assert.Equal(t, types.DefaultAllowedPools, defaultParams.AllowedPools)
 	assert.Equal(t, types.DefaultSwapFee, defaultParams.SwapFee)
+	assert.Equal(t, types.DefaultPriceHistoryWindow, defaultParams.PriceHistoryWindow)
 
 	assert.Equal(t, 0, len(defaultParams.AllowedPools))
 	assert.Equal(t, sdk.ZeroDec(), defaultParams.SwapFee)
@@ -178,6 +180,86 @@ func TestParams_Validation(t *testing.T) {
 			},
 			expectedErr: "invalid swap fee: 1.000000000000000000",
 		},
+		{
+			name: "invalid pool swap fee",
+			key:  types.KeyAllowedPools,
+			testFn: func(params *types.Params) {
+				params.AllowedPools = types.NewAllowedPools(types.NewAllowedPool("ufury", "usdf").WithSwapFee(sdk.OneDec()))
+			},
+			expectedErr: "pool ufury:usdf: invalid swap fee: 1.000000000000000000",
+		},
+		{
+			name: "nil protocol fee share",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.Dec{}
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative protocol fee share",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.NewDec(-1)
+			},
+			expectedErr: "invalid protocol fee share: -1.000000000000000000",
+		},
+		{
+			name: "1 protocol fee share",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.OneDec()
+			},
+			expectedErr: "",
+		},
+		{
+			name: "protocol fee share greater than 1",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.MustNewDecFromStr("1.000000000000000001")
+			},
+			expectedErr: "invalid protocol fee share: 1.000000000000000001",
+		},
+		{
+			name: "module account protocol fee recipient",
+			key:  types.KeyProtocolFeeRecipient,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeRecipient = "community"
+			},
+			expectedErr: "",
+		},
+		{
+			name: "swap module protocol fee recipient",
+			key:  types.KeyProtocolFeeRecipient,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeRecipient = types.ModuleAccountName
+			},
+			expectedErr: "protocol fee recipient can not be the swap module account",
+		},
+		{
+			name: "protocol fee recipient with whitespace",
+			key:  types.KeyProtocolFeeRecipient,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeRecipient = " community"
+			},
+			expectedErr: "invalid protocol fee recipient: ' community'",
+		},
+		{
+			name: "zero price history window",
+			key:  types.KeyPriceHistoryWindow,
+			testFn: func(params *types.Params) {
+				params.PriceHistoryWindow = 0
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative price history window",
+			key:  types.KeyPriceHistoryWindow,
+			testFn: func(params *types.Params) {
+				params.PriceHistoryWindow = -time.Second
+			},
+			expectedErr: "price history window cannot be negative: -1s",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -224,6 +306,19 @@ func TestParams_String(t *testing.T) {
 	assert.Contains(t, output, types.PoolID("jinx", "ufury"))
 	assert.Contains(t, output, types.PoolID("ufury", "usdf"))
 	assert.Contains(t, output, "0.5")
+	assert.Contains(t, output, "ProtocolFeeShare: 0.000000000000000000")
+	assert.Contains(t, output, "PriceHistoryWindow: 24h0m0s")
+}
+
+func TestParams_ProtocolFeeEnabled(t *testing.T) {
+	params := types.DefaultParams()
+	assert.False(t, params.ProtocolFeeEnabled())
+
+	params.ProtocolFeeShare = sdk.Dec{}
+	assert.False(t, params.ProtocolFeeEnabled())
+
+	params = types.NewParamsWithProtocolFee(types.DefaultAllowedPools, types.DefaultSwapFee, sdk.MustNewDecFromStr("0.1"), "")
+	assert.True(t, params.ProtocolFeeEnabled())
 }
 
 func TestAllowedPool_Validation(t *testing.T) {
@@ -277,6 +372,91 @@ func TestAllowedPool_Validation(t *testing.T) {
 			allowedPool: types.NewAllowedPool("ufury", "u:fury"),
 			expectedErr: "tokenB cannot have colons in the denom: u:fury",
 		},
+		{
+			name:        "unknown pool type",
+			allowedPool: types.AllowedPool{TokenA: "ufury", TokenB: "usdf", PoolType: 4},
+			expectedErr: "unknown pool type 4",
+		},
+		{
+			name:        "constant product with amplification",
+			allowedPool: types.AllowedPool{TokenA: "ufury", TokenB: "usdf", PoolType: types.POOL_TYPE_CONSTANT_PRODUCT, Amplification: 100},
+			expectedErr: "amplification must be zero for POOL_TYPE_CONSTANT_PRODUCT",
+		},
+		{
+			name:        "stableswap without amplification",
+			allowedPool: types.NewStableSwapAllowedPool("usdc", "usdf", 0),
+			expectedErr: "amplification must be between 1 and 1000000 for POOL_TYPE_STABLESWAP",
+		},
+		{
+			name:        "stableswap amplification too large",
+			allowedPool: types.NewStableSwapAllowedPool("usdc", "usdf", types.MaxAmplification+1),
+			expectedErr: "amplification must be between 1 and 1000000 for POOL_TYPE_STABLESWAP",
+		},
+		{
+			name:        "weighted with token a",
+			allowedPool: types.AllowedPool{TokenA: "ufury", Tokens: []string{"ufury", "usdf"}, Weights: []uint64{80, 20}, PoolType: types.POOL_TYPE_WEIGHTED},
+			expectedErr: "tokenA and tokenB must be empty for POOL_TYPE_WEIGHTED, use tokens",
+		},
+		{
+			name:        "weighted with amplification",
+			allowedPool: types.AllowedPool{Tokens: []string{"ufury", "usdf"}, Weights: []uint64{80, 20}, PoolType: types.POOL_TYPE_WEIGHTED, Amplification: 100},
+			expectedErr: "amplification must be zero for POOL_TYPE_WEIGHTED",
+		},
+		{
+			name:        "weighted with one token",
+			allowedPool: types.NewWeightedAllowedPool([]string{"ufury"}, []uint64{100}),
+			expectedErr: "weighted pool must have between 2 and 8 tokens, received 1",
+		},
+		{
+			name:        "weighted with too many tokens",
+			allowedPool: types.NewWeightedAllowedPool([]string{"a0", "a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8"}, []uint64{1, 1, 1, 1, 1, 1, 1, 1, 1}),
+			expectedErr: "weighted pool must have between 2 and 8 tokens, received 9",
+		},
+		{
+			name:        "weighted with missing weight",
+			allowedPool: types.NewWeightedAllowedPool([]string{"jinx", "ufury", "usdf"}, []uint64{50, 50}),
+			expectedErr: "weighted pool must have one weight per token, received 3 tokens and 2 weights",
+		},
+		{
+			name:        "weighted with invalid token",
+			allowedPool: types.NewWeightedAllowedPool([]string{"1ufury", "usdf"}, []uint64{80, 20}),
+			expectedErr: "invalid denom: 1ufury",
+		},
+		{
+			name:        "weighted with colon in token",
+			allowedPool: types.NewWeightedAllowedPool([]string{"u:fury", "usdf"}, []uint64{80, 20}),
+			expectedErr: "token cannot have colons in the denom: u:fury",
+		},
+		{
+			name:        "weighted with unsorted tokens",
+			allowedPool: types.NewWeightedAllowedPool([]string{"usdf", "ufury"}, []uint64{80, 20}),
+			expectedErr: "invalid token order: tokens must be sorted and unique, received 'usdf' before 'ufury'",
+		},
+		{
+			name:        "weighted with duplicate tokens",
+			allowedPool: types.NewWeightedAllowedPool([]string{"ufury", "ufury"}, []uint64{80, 20}),
+			expectedErr: "invalid token order: tokens must be sorted and unique, received 'ufury' before 'ufury'",
+		},
+		{
+			name:        "weighted with zero weight",
+			allowedPool: types.NewWeightedAllowedPool([]string{"ufury", "usdf"}, []uint64{100, 0}),
+			expectedErr: "weight of token 'usdf' must be positive",
+		},
+		{
+			name:        "weighted with total weight too large",
+			allowedPool: types.NewWeightedAllowedPool([]string{"jinx", "ufury", "usdf"}, []uint64{50, 50, 1}),
+			expectedErr: "total weight must not exceed 100",
+		},
+		{
+			name:        "weighted with two equal weights",
+			allowedPool: types.NewWeightedAllowedPool([]string{"ufury", "usdf"}, []uint64{50, 50}),
+			expectedErr: "two token pool with equal weights must use POOL_TYPE_CONSTANT_PRODUCT",
+		},
+		{
+			name:        "constant product with weights",
+			allowedPool: types.AllowedPool{TokenA: "ufury", TokenB: "usdf", Weights: []uint64{80, 20}},
+			expectedErr: "tokens and weights must be empty for POOL_TYPE_UNSPECIFIED",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -311,6 +491,54 @@ func TestAllowedPool_String(t *testing.T) {
 	Token B: ufury
 `
 	assert.Equal(t, output, allowedPool.String())
+
+	allowedPool = types.NewStableSwapAllowedPool("usdc", "usdf", 100)
+	require.NoError(t, allowedPool.Validate())
+
+	output = `AllowedPool:
+  Name: usdc:usdf
+	Token A: usdc
+	Token B: usdf
+	Pool Type: POOL_TYPE_STABLESWAP
+	Amplification: 100
+`
+	assert.Equal(t, output, allowedPool.String())
+
+	allowedPool = types.NewWeightedAllowedPool([]string{"ufury", "usdf"}, []uint64{80, 20})
+	require.NoError(t, allowedPool.Validate())
+
+	output = `AllowedPool:
+  Name: ufury:usdf@80:20
+	Tokens: ufury, usdf
+	Weights: [80 20]
+	Pool Type: POOL_TYPE_WEIGHTED
+`
+	assert.Equal(t, output, allowedPool.String())
+
+	allowedPool = types.NewAllowedPool("jinx", "ufury").WithSwapFee(sdk.MustNewDecFromStr("0.001"))
+	require.NoError(t, allowedPool.Validate())
+
+	output = `AllowedPool:
+  Name: jinx:ufury
+	Token A: jinx
+	Token B: ufury
+	Swap Fee: 0.001000000000000000
+`
+	assert.Equal(t, output, allowedPool.String())
+}
+
+func TestAllowedPools_SwapFee(t *testing.T) {
+	globalFee := sdk.MustNewDecFromStr("0.003")
+	pools := types.NewAllowedPools(
+		types.NewAllowedPool("jinx", "ufury"),
+		types.NewStableSwapAllowedPool("usdc", "usdf", 100).WithSwapFee(sdk.MustNewDecFromStr("0.0004")),
+		types.NewWeightedAllowedPool([]string{"ufury", "usdf"}, []uint64{80, 20}).WithSwapFee(sdk.ZeroDec()),
+	)
+
+	assert.Equal(t, globalFee, pools.SwapFee("jinx:ufury", globalFee))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.0004"), pools.SwapFee("usdc:usdf", globalFee))
+	assert.Equal(t, sdk.ZeroDec(), pools.SwapFee("ufury:usdf@80:20", globalFee))
+	assert.Equal(t, globalFee, pools.SwapFee("ufury:usdf", globalFee))
 }
 
 func TestAllowedPool_Name(t *testing.T) {
@@ -361,6 +589,16 @@ func TestAllowedPool_Name(t *testing.T) {
 	}
 }
 
+func TestAllowedPool_Name_Weighted(t *testing.T) {
+	allowedPool := types.NewWeightedAllowedPool([]string{"ufury", "usdf"}, []uint64{80, 20})
+	require.NoError(t, allowedPool.Validate())
+	assert.Equal(t, "ufury:usdf@80:20", allowedPool.Name())
+
+	allowedPool = types.NewWeightedAllowedPool([]string{"jinx", "ufury", "usdf"}, []uint64{30, 30, 30})
+	require.NoError(t, allowedPool.Validate())
+	assert.Equal(t, "jinx:ufury:usdf", allowedPool.Name())
+}
+
 func TestAllowedPools_Validate(t *testing.T) {
 	testCases := []struct {
 		name         string
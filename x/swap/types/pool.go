@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pool is a denominated liquidity pool of two or more assets, such as a DenominatedPool or WeightedPool
+type Pool interface {
+	Reserves() sdk.Coins
+	TotalShares() sdkmath.Int
+	IsEmpty() bool
+	AddLiquidity(deposit sdk.Coins) (sdk.Coins, sdkmath.Int)
+	RemoveLiquidity(shares sdkmath.Int) sdk.Coins
+	ShareValue(shares sdkmath.Int) sdk.Coins
+	SwapWithExactInputTo(swapInput sdk.Coin, denomOut string, fee sdk.Dec) (sdk.Coin, sdk.Coin)
+	SwapWithExactOutputFrom(denomIn string, swapOutput sdk.Coin, fee sdk.Dec) (sdk.Coin, sdk.Coin)
+	SpotPrice(base, quote string) sdk.Dec
+}
+
+var (
+	_ Pool = (*DenominatedPool)(nil)
+	_ Pool = (*WeightedPool)(nil)
+)
+
+// NewPoolForAllowedPool creates a new pool from reserve coins, using the pool type of an allowed pool
+func NewPoolForAllowedPool(allowedPool AllowedPool, reserves sdk.Coins) (Pool, error) {
+	if allowedPool.PoolType != POOL_TYPE_WEIGHTED {
+		return NewDenominatedPoolForAllowedPool(allowedPool, reserves)
+	}
+
+	if len(reserves) != len(allowedPool.Tokens) {
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "reserves %s do not match pool %s", reserves, allowedPool.Name())
+	}
+	for i, coin := range reserves {
+		if coin.Denom != allowedPool.Tokens[i] {
+			return nil, errorsmod.Wrapf(ErrInvalidPool, "reserves %s do not match pool %s", reserves, allowedPool.Name())
+		}
+	}
+
+	return NewWeightedPool(reserves, allowedPool.Weights)
+}
+
+// NewPoolFromRecord creates a pool from the state of a pool record
+func NewPoolFromRecord(record PoolRecord) (Pool, error) {
+	if record.PoolType == POOL_TYPE_WEIGHTED {
+		return NewWeightedPoolWithExistingShares(record.WeightedReserves, record.Weights, record.TotalShares)
+	}
+
+	return NewDenominatedPoolFromRecord(record)
+}
+
+// priceFromRatio returns the ratio num / den as a decimal, truncated to the decimal precision
+func priceFromRatio(num, den *big.Int) sdk.Dec {
+	var quo big.Int
+	quo.Mul(num, precisionMultiplier).Quo(&quo, den)
+
+	return sdk.NewDecFromBigIntWithPrec(&quo, sdk.Precision)
+}
+
+// precisionMultiplier is 10^sdk.Precision, which scales an integer ratio to a decimal
+var precisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
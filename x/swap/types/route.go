@@ -0,0 +1,72 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxRouteLength is the maximum number of pools a multi-hop swap can trade through
+const MaxRouteLength = 3
+
+// ValidateRoute checks that a route of pool ids trades from denomIn to denomOut, passing
+// through each pool at most once
+func ValidateRoute(route []string, denomIn, denomOut string) error {
+	_, err := RouteDenoms(route, denomIn, denomOut)
+	return err
+}
+
+// RouteDenoms returns the output denom of each pool of a route from denomIn to denomOut, returning an
+// error if the route is invalid. A pool of two denoms outputs the denom that is not its input, so a pool
+// of more than two denoms can only be the last pool of a route, where it outputs denomOut.
+func RouteDenoms(route []string, denomIn, denomOut string) ([]string, error) {
+	if len(route) > MaxRouteLength {
+		return nil, errorsmod.Wrapf(ErrInvalidRoute, "route length %d > max %d", len(route), MaxRouteLength)
+	}
+
+	seenPoolIDs := make(map[string]bool)
+	denoms := make([]string, len(route))
+	denom := denomIn
+	for i, poolID := range route {
+		if seenPoolIDs[poolID] {
+			return nil, errorsmod.Wrapf(ErrInvalidRoute, "duplicate pool %s", poolID)
+		}
+		seenPoolIDs[poolID] = true
+
+		tokens, _, err := ParsePoolID(poolID)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidRoute, "invalid pool id %s", poolID)
+		}
+
+		if !containsDenom(tokens, denom) {
+			return nil, errorsmod.Wrapf(ErrInvalidRoute, "pool %s does not trade %s", poolID, denom)
+		}
+
+		switch {
+		case len(tokens) == 2 && tokens[0] == denom:
+			denom = tokens[1]
+		case len(tokens) == 2:
+			denom = tokens[0]
+		case i == len(route)-1 && containsDenom(tokens, denomOut) && denomOut != denom:
+			denom = denomOut
+		default:
+			return nil, errorsmod.Wrapf(ErrInvalidRoute, "pool %s of more than two denoms must be the last pool and trade %s", poolID, denomOut)
+		}
+		denoms[i] = denom
+	}
+
+	if len(route) > 0 && denom != denomOut {
+		return nil, errorsmod.Wrapf(ErrInvalidRoute, "route ends in %s, not %s", denom, denomOut)
+	}
+
+	return denoms, nil
+}
+
+// containsDenom returns true if a denom is in a slice of denoms
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
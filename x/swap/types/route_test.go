@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percosis-labs/fury/x/swap/types"
+)
+
+func TestValidateRoute(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pools       []string
+		denomIn     string
+		denomOut    string
+		expectedErr string
+	}{
+		{
+			name:     "single pool",
+			pools:    []string{"ufury:usdf"},
+			denomIn:  "usdf",
+			denomOut: "ufury",
+		},
+		{
+			name:     "multiple pools",
+			pools:    []string{"bnb:ufury", "ufury:usdf", "btcb:usdf"},
+			denomIn:  "bnb",
+			denomOut: "btcb",
+		},
+		{
+			name:     "empty route",
+			denomIn:  "bnb",
+			denomOut: "usdf",
+		},
+		{
+			name:        "too many pools",
+			pools:       []string{"bnb:ufury", "ufury:usdf", "btcb:usdf", "btcb:hard"},
+			denomIn:     "bnb",
+			denomOut:    "hard",
+			expectedErr: "route length 4 > max 3: invalid route",
+		},
+		{
+			name:        "duplicate pool",
+			pools:       []string{"bnb:ufury", "bnb:ufury"},
+			denomIn:     "bnb",
+			denomOut:    "bnb",
+			expectedErr: "duplicate pool bnb:ufury: invalid route",
+		},
+		{
+			name:        "unsorted pool id",
+			pools:       []string{"ufury:bnb"},
+			denomIn:     "bnb",
+			denomOut:    "ufury",
+			expectedErr: "invalid pool id ufury:bnb: invalid route",
+		},
+		{
+			name:        "malformed pool id",
+			pools:       []string{"bnb"},
+			denomIn:     "bnb",
+			denomOut:    "ufury",
+			expectedErr: "invalid pool id bnb: invalid route",
+		},
+		{
+			name:        "disconnected pools",
+			pools:       []string{"bnb:ufury", "btcb:usdf"},
+			denomIn:     "bnb",
+			denomOut:    "usdf",
+			expectedErr: "pool btcb:usdf does not trade ufury: invalid route",
+		},
+		{
+			name:        "wrong output",
+			pools:       []string{"bnb:ufury"},
+			denomIn:     "bnb",
+			denomOut:    "usdf",
+			expectedErr: "route ends in ufury, not usdf: invalid route",
+		},
+		{
+			name:     "weighted pool",
+			pools:    []string{"ufury:usdf@80:20"},
+			denomIn:  "usdf",
+			denomOut: "ufury",
+		},
+		{
+			name:     "multi-asset pool as last pool",
+			pools:    []string{"bnb:ufury", "jinx:ufury:usdf"},
+			denomIn:  "bnb",
+			denomOut: "usdf",
+		},
+		{
+			name:        "multi-asset pool before last pool",
+			pools:       []string{"jinx:ufury:usdf", "bnb:usdf"},
+			denomIn:     "jinx",
+			denomOut:    "bnb",
+			expectedErr: "pool jinx:ufury:usdf of more than two denoms must be the last pool and trade bnb: invalid route",
+		},
+		{
+			name:        "multi-asset pool without output",
+			pools:       []string{"jinx:ufury:usdf"},
+			denomIn:     "jinx",
+			denomOut:    "bnb",
+			expectedErr: "pool jinx:ufury:usdf of more than two denoms must be the last pool and trade bnb: invalid route",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateRoute(tc.pools, tc.denomIn, tc.denomOut)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRouteDenoms(t *testing.T) {
+	denoms, err := types.RouteDenoms([]string{"bnb:ufury", "ufury:usdf@80:20", "jinx:usdf:xrpb"}, "bnb", "xrpb")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ufury", "usdf", "xrpb"}, denoms)
+}
@@ -0,0 +1,323 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAmplification is the largest amplification coefficient a stableswap pool can use
+const MaxAmplification uint64 = 1_000_000
+
+// StableSwapPool implements a unitless two asset stableswap liquidity pool.
+//
+// Trades are priced along the curve-style stableswap invariant D, which for two assets x and y
+// and an amplification coefficient A satisfies:
+//
+//	4A(x + y) + D = 4AD + D^3 / 4xy
+//
+// The curve behaves like a constant sum for balanced reserves, giving trades close to a 1:1 price,
+// and approaches a constant product as the reserves become imbalanced.  Larger amplification
+// coefficients keep prices close to 1:1 over a wider range of reserves.
+//
+// Deposits, withdraws and share accounting are proportional to the reserves and are shared
+// with the base pool.
+type StableSwapPool struct {
+	*BasePool
+	// ann is the amplification coefficient multiplied by n^n, where n = 2 is the number of assets
+	ann *big.Int
+}
+
+// NewStableSwapPool returns a pointer to a stableswap pool with reserves and total shares initialized
+func NewStableSwapPool(reservesA, reservesB sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, err
+	}
+
+	basePool, err := NewBasePool(reservesA, reservesB)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStableSwapPool(basePool, amplification), nil
+}
+
+// NewStableSwapPoolWithExistingShares returns a pointer to a stableswap pool with existing shares
+func NewStableSwapPoolWithExistingShares(reservesA, reservesB, totalShares sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, err
+	}
+
+	basePool, err := NewBasePoolWithExistingShares(reservesA, reservesB, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStableSwapPool(basePool, amplification), nil
+}
+
+func newStableSwapPool(basePool *BasePool, amplification uint64) *StableSwapPool {
+	var ann big.Int
+	ann.SetUint64(amplification).Mul(&ann, big.NewInt(4))
+
+	return &StableSwapPool{
+		BasePool: basePool,
+		ann:      &ann,
+	}
+}
+
+// validateAmplification returns an error if a stableswap amplification coefficient is out of bounds
+func validateAmplification(amplification uint64) error {
+	if amplification == 0 || amplification > MaxAmplification {
+		return errorsmod.Wrapf(ErrInvalidPool, "amplification must be between 1 and %d", MaxAmplification)
+	}
+
+	return nil
+}
+
+// SwapExactAForB trades an exact value of a for b.  Returns the positive amount b
+// that is removed from the pool and the portion of a that is used for paying the fee.
+func (p *StableSwapPool) SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateOutputForExactInput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return b, feeValue
+}
+
+// SwapExactBForA trades an exact value of b for a.  Returns the positive amount a
+// that is removed from the pool and the portion of b that is used for paying the fee.
+func (p *StableSwapPool) SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateOutputForExactInput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return a, feeValue
+}
+
+// calculateOutputForExactInput calculates the output amount of a swap using a fixed input, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is calculated the same as a constant product pool.  The new output reserves are the smallest
+// reserves that keep the invariant at or above the ceiled previous invariant, so the output is always
+// rounded in favor of the pool.  An output that rounds below zero is returned as zero.
+func (p *StableSwapPool) calculateOutputForExactInput(in, inReserves, outReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapInputIsValid(in)
+	p.assertFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(in).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+
+	invariant := p.ceilInvariant(inReserves.BigInt(), outReserves.BigInt())
+	newOutReserves := p.solveReserves(inReserves.Add(inAfterFee).BigInt(), invariant)
+
+	out := outReserves.Sub(sdkmath.NewIntFromBigInt(newOutReserves))
+	if out.IsNegative() {
+		out = sdk.ZeroInt()
+	}
+	feeValue := in.Sub(inAfterFee)
+
+	return out, feeValue
+}
+
+// SwapAForExactB trades a for an exact b.  Returns the positive amount a
+// that is added to the pool, and the portion of a that is used to pay the fee.
+func (p *StableSwapPool) SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateInputForExactOutput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return a, feeValue
+}
+
+// SwapBForExactA trades b for an exact a.  Returns the positive amount b
+// that is added to the pool, and the portion of b that is used to pay the fee.
+func (p *StableSwapPool) SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateInputForExactOutput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return b, feeValue
+}
+
+// calculateInputForExactOutput calculates the input amount of a swap using a fixed output, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The new input reserves are the smallest reserves that keep the invariant at or above the ceiled
+// previous invariant, so the input is always rounded in favor of the pool.  The fee is calculated the
+// same as a constant product pool.
+func (p *StableSwapPool) calculateInputForExactOutput(out, outReserves, inReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapOutputIsValid(out, outReserves)
+	p.assertFeeIsValid(fee)
+
+	invariant := p.ceilInvariant(inReserves.BigInt(), outReserves.BigInt())
+	newInReserves := p.solveReserves(outReserves.Sub(out).BigInt(), invariant)
+
+	inWithoutFee := sdkmath.NewIntFromBigInt(newInReserves).Sub(inReserves)
+
+	in := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := in.Sub(inWithoutFee)
+
+	return in, feeValue
+}
+
+// assertInvariantAndUpdateReserves asserts the stableswap invariant is not violated, subtracting
+// any fees first, then updates the pool reserves.  Panics if invariant is violated.
+func (p *StableSwapPool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
+	invariant := p.invariant(p.reservesA.BigInt(), p.reservesB.BigInt())
+	newInvariant := p.invariant(newReservesA.Sub(feeA).BigInt(), newReservesB.Sub(feeB).BigInt())
+
+	p.assertInvariant(invariant, newInvariant)
+
+	p.reservesA = newReservesA
+	p.reservesB = newReservesB
+}
+
+// spotPriceRatio returns the marginal price of a in units of b, which is the ratio of the partial
+// derivatives of the invariant equation.  For reserves x = a and y = b,
+//
+//	price = (4xy*Ann*xy + D^3 * y) / (4xy*Ann*xy + D^3 * x)
+//
+// which approaches y / x, the constant product price, as the amplification approaches zero.
+func (p *StableSwapPool) spotPriceRatio() (*big.Int, *big.Int) {
+	x, y := p.reservesA.BigInt(), p.reservesB.BigInt()
+	d := p.invariant(x, y)
+
+	var product, dCubed big.Int
+	product.Mul(x, y).Mul(&product, &product).Mul(&product, p.ann).Lsh(&product, 2)
+	dCubed.Mul(d, d).Mul(&dCubed, d)
+
+	num := new(big.Int).Mul(&dCubed, y)
+	num.Add(num, &product)
+	den := new(big.Int).Mul(&dCubed, x)
+	den.Add(den, &product)
+
+	return num, den
+}
+
+// invariant returns the stableswap invariant D of reserves x and y, truncated to an integer.
+//
+// Clearing the fraction from the invariant equation gives the cubic
+//
+//	f(D) = D^3 + 4xy(Ann - 1)D - 4xy*Ann(x + y) = 0
+//
+// where Ann = 4A.  f is increasing for positive D and f(x + y) >= 0, so newton's method started
+// from x + y converges to D from above.  The result is then corrected using the exact sign of f.
+func (p *StableSwapPool) invariant(x, y *big.Int) *big.Int {
+	if x.Sign() <= 0 || y.Sign() <= 0 {
+		panic("invalid state: stableswap reserves must be positive")
+	}
+
+	d := new(big.Int).Add(x, y)
+	for i := 0; i < 255; i++ {
+		// next = D - f(D)/f'(D) = (2D^3 + 4xy*Ann(x + y)) / (3D^2 + 4xy(Ann - 1))
+		var dSquared, numerator, denominator big.Int
+		dSquared.Mul(d, d)
+		numerator.Mul(&dSquared, d).Lsh(&numerator, 1).Add(&numerator, p.constantTerm(x, y))
+		denominator.Mul(&dSquared, big.NewInt(3)).Add(&denominator, p.linearCoefficient(x, y))
+
+		next := numerator.Quo(&numerator, &denominator)
+		if next.Cmp(d) >= 0 {
+			break
+		}
+		d = next
+	}
+
+	// correct any truncation error so D is the largest integer where f(D) <= 0
+	one := big.NewInt(1)
+	for d.Sign() > 0 && p.invariantError(x, y, d).Sign() > 0 {
+		d.Sub(d, one)
+	}
+	for p.invariantError(x, y, new(big.Int).Add(d, one)).Sign() <= 0 {
+		d.Add(d, one)
+	}
+
+	return d
+}
+
+// ceilInvariant returns the stableswap invariant D of reserves x and y, ceiled to an integer
+func (p *StableSwapPool) ceilInvariant(x, y *big.Int) *big.Int {
+	d := p.invariant(x, y)
+	if p.invariantError(x, y, d).Sign() != 0 {
+		d.Add(d, big.NewInt(1))
+	}
+
+	return d
+}
+
+// solveReserves returns the smallest reserves y that, paired with reserves x, have an invariant
+// greater than or equal to d.
+//
+// For a fixed x and D, the invariant equation is the quadratic
+//
+//	4x*Ann*y^2 + 4x(Ann*x + D(1 - Ann))y - D^3 = 0
+//
+// which is solved using an integer square root and then corrected using the exact sign of f.
+func (p *StableSwapPool) solveReserves(x, d *big.Int) *big.Int {
+	if x.Sign() <= 0 {
+		panic("invalid state: stableswap reserves must be positive")
+	}
+
+	// a = 4x*Ann, b = 4x(Ann*x + D - Ann*D), c = -D^3
+	var a, b, dCubed big.Int
+	a.Mul(x, p.ann).Lsh(&a, 2)
+	b.Sub(x, d).Mul(&b, p.ann).Add(&b, d).Mul(&b, x).Lsh(&b, 2)
+	dCubed.Mul(d, d).Mul(&dCubed, d)
+
+	// y = (sqrt(b^2 + 4a*D^3) - b) / 2a
+	var discriminant, fourAC big.Int
+	discriminant.Mul(&b, &b)
+	fourAC.Mul(&a, &dCubed).Lsh(&fourAC, 2)
+	discriminant.Add(&discriminant, &fourAC).Sqrt(&discriminant)
+
+	y := new(big.Int).Sub(&discriminant, &b)
+	y.Quo(y, new(big.Int).Lsh(&a, 1))
+
+	one := big.NewInt(1)
+	if y.Sign() <= 0 {
+		y.SetInt64(1)
+	}
+	for p.invariantError(x, y, d).Sign() > 0 {
+		y.Add(y, one)
+	}
+	for y.Cmp(one) > 0 && p.invariantError(x, new(big.Int).Sub(y, one), d).Sign() <= 0 {
+		y.Sub(y, one)
+	}
+
+	return y
+}
+
+// invariantError returns f(D) for reserves x and y, which is positive when d is greater than the
+// invariant of the reserves, zero when equal, and negative when less
+func (p *StableSwapPool) invariantError(x, y, d *big.Int) *big.Int {
+	var result, linear big.Int
+	result.Mul(d, d).Mul(&result, d)
+	linear.Mul(p.linearCoefficient(x, y), d)
+
+	return result.Add(&result, &linear).Sub(&result, p.constantTerm(x, y))
+}
+
+// linearCoefficient returns 4xy(Ann - 1)
+func (p *StableSwapPool) linearCoefficient(x, y *big.Int) *big.Int {
+	var result big.Int
+	result.Sub(p.ann, big.NewInt(1)).Mul(&result, x).Mul(&result, y).Lsh(&result, 2)
+
+	return &result
+}
+
+// constantTerm returns 4xy*Ann(x + y)
+func (p *StableSwapPool) constantTerm(x, y *big.Int) *big.Int {
+	var result big.Int
+	result.Add(x, y).Mul(&result, p.ann).Mul(&result, x).Mul(&result, y).Lsh(&result, 2)
+
+	return &result
+}
@@ -0,0 +1,183 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/percosis-labs/fury/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableSwapPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(1e6), i(1e6), 0, "amplification must be between 1 and 1000000: invalid pool"},
+		{i(1e6), i(1e6), types.MaxAmplification + 1, "amplification must be between 1 and 1000000: invalid pool"},
+		{i(0), i(1e6), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 100, "reserves must be greater than zero: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+
+			pool, err = types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+
+	pool, err := types.NewStableSwapPool(i(1e6), i(2e6), types.MaxAmplification)
+	require.NoError(t, err)
+	assert.Equal(t, i(1e6), pool.ReservesA())
+	assert.Equal(t, i(2e6), pool.ReservesB())
+	assert.Equal(t, i(1414213), pool.TotalShares())
+}
+
+func TestStableSwapPool_Swap_ExactInput(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdkmath.Int
+		reservesB      sdkmath.Int
+		amplification  uint64
+		exactInput     sdkmath.Int
+		fee            sdk.Dec
+		expectedOutput sdkmath.Int
+		expectedFee    sdkmath.Int
+	}{
+		// test small pools
+		{i(10), i(10), 100, i(1), d("0.003"), i(0), i(1)},
+		{i(10), i(10), 100, i(3), d("0.003"), i(1), i(1)},
+		{i(10), i(10), 100, i(10), d("0.003"), i(8), i(1)},
+		{i(10), i(10), 100, i(91), d("0.003"), i(9), i(1)},
+		// test fee values and rounding in favor of the pool
+		{i(1e6), i(1e6), 100, i(1000), d("0.003"), i(996), i(3)},
+		{i(1e6), i(1e6), 100, i(1000), d("0"), i(999), i(0)},
+		// test amplification
+		{i(1e6), i(1e6), 100, i(100000), d("0.003"), i(99650), i(300)},
+		{i(1e6), i(1e6), 1, i(500000), d("0.003"), i(421610), i(1500)},
+		{i(1e6), i(1e6), 100, i(500000), d("0.003"), i(496868), i(1500)},
+		{i(1e6), i(1e6), types.MaxAmplification, i(500000), d("0.003"), i(498499), i(1500)},
+		// test imbalanced pools
+		{i(10e6), i(500e6), 100, i(1e6), d("0.0025"), i(2316335), i(2500)},
+		{i(1e12), i(2e12), 85, i(5e11), d("0.0004"), i(500895293721), i(200000000)},
+		// test very large pools and swaps
+		{exp(i(2), 250), exp(i(2), 250), 100, exp(i(2), 249), d("0.003"), s("898959481761244628516729075460511301233225499822538655909745704178971781869"), s("2713877091499598330239944961141122840311015265600950719674787125185463976")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactInput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactInput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			swapA, feeA := poolA.SwapExactAForB(tc.exactInput, tc.fee)
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			swapB, feeB := poolB.SwapExactBForA(tc.exactInput, tc.fee)
+
+			// pool must be symmetric - if we swap reserves, then swap opposite direction
+			// then the results should be equal
+			require.Equal(t, swapA, swapB, "expected swap methods to have equal swap results")
+			require.Equal(t, feeA, feeB, "expected swap methods to have equal fee results")
+			require.Equal(t, poolA.ReservesA(), poolB.ReservesB(), "expected reserves A to be equal")
+			require.Equal(t, poolA.ReservesB(), poolB.ReservesA(), "expected reserves B to be equal")
+
+			assert.Equal(t, tc.expectedOutput.String(), swapA.String(), "returned swap not equal")
+			assert.Equal(t, tc.expectedFee.String(), feeA.String(), "returned fee not equal")
+
+			expectedReservesA := tc.reservesA.Add(tc.exactInput)
+			expectedReservesB := tc.reservesB.Sub(tc.expectedOutput)
+
+			assert.Equal(t, expectedReservesA, poolA.ReservesA(), "expected new reserves A not equal")
+			assert.Equal(t, expectedReservesB, poolA.ReservesB(), "expected new reserves B not equal")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactOutput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		exactOutput   sdkmath.Int
+		fee           sdk.Dec
+		expectedInput sdkmath.Int
+		expectedFee   sdkmath.Int
+	}{
+		// test small pools
+		{i(10), i(10), 100, i(1), d("0.003"), i(3), i(1)},
+		{i(10), i(10), 100, i(8), d("0.003"), i(10), i(1)},
+		{i(10), i(10), 100, i(9), d("0.003"), i(11), i(1)},
+		// test fee values and rounding in favor of the pool
+		{i(1e6), i(1e6), 100, i(996), d("0.003"), i(1000), i(3)},
+		{i(1e6), i(1e6), 100, i(999), d("0"), i(1000), i(0)},
+		// test amplification
+		{i(1e6), i(1e6), 100, i(99650), d("0.003"), i(100000), i(300)},
+		{i(1e6), i(1e6), 1, i(421610), d("0.003"), i(500000), i(1500)},
+		{i(1e6), i(1e6), 100, i(496868), d("0.003"), i(500000), i(1500)},
+		{i(1e6), i(1e6), types.MaxAmplification, i(498499), d("0.003"), i(500000), i(1500)},
+		// test imbalanced pools
+		{i(10e6), i(500e6), 100, i(2316335), d("0.0025"), i(1e6), i(2500)},
+		{i(1e12), i(2e12), 85, i(500895293721), d("0.0004"), i(5e11), i(200000000)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactOutput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactOutput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			swapA, feeA := poolA.SwapAForExactB(tc.exactOutput, tc.fee)
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			swapB, feeB := poolB.SwapBForExactA(tc.exactOutput, tc.fee)
+
+			// pool must be symmetric - if we swap reserves, then swap opposite direction
+			// then the results should be equal
+			require.Equal(t, swapA, swapB, "expected swap methods to have equal swap results")
+			require.Equal(t, feeA, feeB, "expected swap methods to have equal fee results")
+			require.Equal(t, poolA.ReservesA(), poolB.ReservesB(), "expected reserves A to be equal")
+			require.Equal(t, poolA.ReservesB(), poolB.ReservesA(), "expected reserves B to be equal")
+
+			assert.Equal(t, tc.expectedInput.String(), swapA.String(), "returned swap not equal")
+			assert.Equal(t, tc.expectedFee.String(), feeA.String(), "returned fee not equal")
+
+			expectedReservesA := tc.reservesA.Add(tc.expectedInput)
+			expectedReservesB := tc.reservesB.Sub(tc.exactOutput)
+
+			assert.Equal(t, expectedReservesA, poolA.ReservesA(), "expected new reserves A not equal")
+			assert.Equal(t, expectedReservesB, poolA.ReservesB(), "expected new reserves B not equal")
+		})
+	}
+}
+
+func TestStableSwapPool_LessSlippageThanConstantProduct(t *testing.T) {
+	for _, amplification := range []uint64{1, 10, 100, 1000} {
+		stablePool, err := types.NewStableSwapPool(i(1e6), i(1e6), amplification)
+		require.NoError(t, err)
+		basePool, err := types.NewBasePool(i(1e6), i(1e6))
+		require.NoError(t, err)
+
+		stableOutput, _ := stablePool.SwapExactAForB(i(250000), d("0.003"))
+		baseOutput, _ := basePool.SwapExactAForB(i(250000), d("0.003"))
+		assert.Truef(t, stableOutput.GT(baseOutput), "expected stableswap output %s > constant product output %s", stableOutput, baseOutput)
+	}
+}
+
+func TestStableSwapPool_Panics_Swap(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+
+	assert.Panics(t, func() { pool.SwapExactAForB(i(0), d("0.003")) }, "expected panic for zero input")
+	assert.Panics(t, func() { pool.SwapExactBForA(i(1000), d("1")) }, "expected panic for fee of 1")
+	assert.Panics(t, func() { pool.SwapAForExactB(i(1e6), d("0.003")) }, "expected panic for output equal to reserves")
+	assert.Panics(t, func() { pool.SwapBForExactA(i(-1), d("0.003")) }, "expected panic for negative output")
+}
@@ -3,14 +3,21 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// PoolIDSep represents the separator used in pool ids to separate two denominations
-const PoolIDSep = ":"
+const (
+	// PoolIDSep represents the separator used in pool ids to separate denominations
+	PoolIDSep = ":"
+	// PoolIDWeightSep represents the separator used in weighted pool ids to separate the denominations
+	// from the weights
+	PoolIDWeightSep = "@"
+)
 
 // PoolIDFromCoins returns a poolID from a coins object
 func PoolIDFromCoins(coins sdk.Coins) string {
@@ -27,6 +34,69 @@ func PoolID(denomA string, denomB string) string {
 	return fmt.Sprintf("%s%s%s", denomA, PoolIDSep, denomB)
 }
 
+// WeightedPoolID returns the pool name of a weighted pool from sorted denoms and their weights.  The denoms
+// are joined the same as a two denom pool, and the weights are appended only when they are not all equal,
+// for example "ufury:usdf@80:20" or "jinx:ufury:usdf".
+func WeightedPoolID(denoms []string, weights []uint64) string {
+	poolID := strings.Join(denoms, PoolIDSep)
+
+	for _, weight := range weights {
+		if weight != weights[0] {
+			formatted := make([]string, len(weights))
+			for i, w := range weights {
+				formatted[i] = strconv.FormatUint(w, 10)
+			}
+			return poolID + PoolIDWeightSep + strings.Join(formatted, PoolIDSep)
+		}
+	}
+
+	return poolID
+}
+
+// ParsePoolID returns the sorted denoms and the weights of a pool id.  The weights are nil for pools
+// with equal weights.
+func ParsePoolID(poolID string) ([]string, []uint64, error) {
+	invalid := fmt.Errorf("poolID '%s' is invalid", poolID)
+
+	parts := strings.Split(poolID, PoolIDWeightSep)
+	if len(parts) > 2 {
+		return nil, nil, invalid
+	}
+
+	denoms := strings.Split(parts[0], PoolIDSep)
+	if len(denoms) < 2 || len(denoms) > MaxPoolAssets {
+		return nil, nil, invalid
+	}
+	for i, denom := range denoms {
+		if sdk.ValidateDenom(denom) != nil || (i > 0 && denoms[i-1] >= denom) {
+			return nil, nil, invalid
+		}
+	}
+
+	if len(parts) == 1 {
+		return denoms, nil, nil
+	}
+
+	formatted := strings.Split(parts[1], PoolIDSep)
+	if len(formatted) != len(denoms) {
+		return nil, nil, invalid
+	}
+	weights := make([]uint64, len(formatted))
+	for i, w := range formatted {
+		weight, err := strconv.ParseUint(w, 10, 64)
+		if err != nil || weight == 0 {
+			return nil, nil, invalid
+		}
+		weights[i] = weight
+	}
+	// equal weights are never included, so each pool has a single id
+	if WeightedPoolID(denoms, weights) != poolID {
+		return nil, nil, invalid
+	}
+
+	return denoms, weights, nil
+}
+
 // NewPoolRecord takes reserve coins and total shares, returning
 // a new pool record with a id
 func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
@@ -44,17 +114,30 @@ func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
 	}
 }
 
-// NewPoolRecordFromPool takes a pointer to a denominated pool and returns a
-// pool record for storage in state.
-func NewPoolRecordFromPool(pool *DenominatedPool) PoolRecord {
+// NewPoolRecordFromPool takes a pool and returns a pool record for storage in state.
+func NewPoolRecordFromPool(pool Pool) PoolRecord {
 	reserves := pool.Reserves()
-	poolID := PoolIDFromCoins(reserves)
 
-	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: pool.TotalShares(),
+	switch pool := pool.(type) {
+	case *DenominatedPool:
+		return PoolRecord{
+			PoolID:        PoolIDFromCoins(reserves),
+			ReservesA:     reserves[0],
+			ReservesB:     reserves[1],
+			TotalShares:   pool.TotalShares(),
+			PoolType:      pool.PoolType(),
+			Amplification: pool.Amplification(),
+		}
+	case *WeightedPool:
+		return PoolRecord{
+			PoolID:           WeightedPoolID(pool.denoms, pool.weights),
+			TotalShares:      pool.TotalShares(),
+			PoolType:         POOL_TYPE_WEIGHTED,
+			WeightedReserves: reserves,
+			Weights:          pool.Weights(),
+		}
+	default:
+		panic(fmt.Sprintf("unknown pool %T", pool))
 	}
 }
 
@@ -64,6 +147,14 @@ func (p PoolRecord) Validate() error {
 		return errors.New("poolID must be set")
 	}
 
+	if p.PoolType == POOL_TYPE_WEIGHTED {
+		return p.validateWeighted()
+	}
+
+	if len(p.WeightedReserves) != 0 || len(p.Weights) != 0 {
+		return fmt.Errorf("pool '%s' must not have weighted reserves or weights", p.PoolID)
+	}
+
 	tokens := strings.Split(p.PoolID, PoolIDSep)
 	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
 		return fmt.Errorf("poolID '%s' is invalid", p.PoolID)
@@ -87,14 +178,67 @@ func (p PoolRecord) Validate() error {
 		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
 	}
 
+	if err := validatePoolType(p.PoolType, p.Amplification); err != nil {
+		return fmt.Errorf("pool '%s' has invalid pool type: %w", p.PoolID, err)
+	}
+
+	return nil
+}
+
+// validateWeighted performs basic validation checks of the record data of a weighted pool
+func (p PoolRecord) validateWeighted() error {
+	if p.ReservesA.Denom != "" || p.ReservesB.Denom != "" || p.Amplification != 0 {
+		return fmt.Errorf("pool '%s' must only have weighted reserves", p.PoolID)
+	}
+
+	if !p.WeightedReserves.IsValid() {
+		return fmt.Errorf("pool '%s' has invalid reserves: %s", p.PoolID, p.WeightedReserves)
+	}
+
+	denoms := make([]string, len(p.WeightedReserves))
+	for i, coin := range p.WeightedReserves {
+		denoms[i] = coin.Denom
+	}
+	if err := validateWeightedTokens(denoms, p.Weights); err != nil {
+		return fmt.Errorf("pool '%s' has invalid weights: %w", p.PoolID, err)
+	}
+
+	if WeightedPoolID(denoms, p.Weights) != p.PoolID {
+		return fmt.Errorf("poolID '%s' does not match reserves", p.PoolID)
+	}
+
+	if !p.TotalShares.IsPositive() {
+		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
+	}
+
 	return nil
 }
 
 // Reserves returns the total reserves for a pool
 func (p PoolRecord) Reserves() sdk.Coins {
+	if p.PoolType == POOL_TYPE_WEIGHTED {
+		return p.WeightedReserves
+	}
+
 	return sdk.NewCoins(p.ReservesA, p.ReservesB)
 }
 
+// SubReserves returns a copy of the pool record with a coin removed from its reserves
+func (p PoolRecord) SubReserves(coin sdk.Coin) PoolRecord {
+	switch {
+	case p.PoolType == POOL_TYPE_WEIGHTED:
+		p.WeightedReserves = p.WeightedReserves.Sub(coin)
+	case coin.Denom == p.ReservesA.Denom:
+		p.ReservesA = p.ReservesA.Sub(coin)
+	case coin.Denom == p.ReservesB.Denom:
+		p.ReservesB = p.ReservesB.Sub(coin)
+	default:
+		panic(fmt.Sprintf("denom %s is not in pool %s", coin.Denom, p.PoolID))
+	}
+
+	return p
+}
+
 // PoolRecords is a slice of PoolRecord
 type PoolRecords []PoolRecord
 
@@ -133,12 +277,8 @@ func (sr ShareRecord) Validate() error {
 		return errors.New("poolID must be set")
 	}
 
-	tokens := strings.Split(sr.PoolID, PoolIDSep)
-	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
-		return fmt.Errorf("poolID '%s' is invalid", sr.PoolID)
-	}
-	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
-		return fmt.Errorf("poolID '%s' is invalid", sr.PoolID)
+	if _, _, err := ParsePoolID(sr.PoolID); err != nil {
+		return err
 	}
 
 	if sr.Depositor.Empty() {
@@ -178,3 +318,138 @@ func (srs ShareRecords) Validate() error {
 
 	return nil
 }
+
+// NewPoolPriceSnapshot returns a new PoolPriceSnapshot
+func NewPoolPriceSnapshot(poolID string, height int64, t time.Time, prices, cumulativePrices []sdk.Dec) PoolPriceSnapshot {
+	return PoolPriceSnapshot{
+		PoolID:           poolID,
+		Height:           height,
+		Time:             t,
+		Prices:           prices,
+		CumulativePrices: cumulativePrices,
+	}
+}
+
+// PoolSpotPrices returns the spot prices of each ordered pair of a pool's sorted denoms, in the order of a
+// PoolPriceSnapshot
+func PoolSpotPrices(pool Pool) []sdk.Dec {
+	reserves := pool.Reserves()
+
+	prices := make([]sdk.Dec, 0, len(reserves)*(len(reserves)-1))
+	for _, base := range reserves {
+		for _, quote := range reserves {
+			if base.Denom != quote.Denom {
+				prices = append(prices, pool.SpotPrice(base.Denom, quote.Denom))
+			}
+		}
+	}
+
+	return prices
+}
+
+// PairIndex returns the index of the price of base in quote within the snapshot's prices
+func (ps PoolPriceSnapshot) PairIndex(base, quote string) (int, error) {
+	denoms, _, err := ParsePoolID(ps.PoolID)
+	if err != nil {
+		return 0, err
+	}
+	if base == quote {
+		return 0, fmt.Errorf("base and quote denom must be different: %s", base)
+	}
+
+	baseIndex, quoteIndex := -1, -1
+	for i, denom := range denoms {
+		switch denom {
+		case base:
+			baseIndex = i
+		case quote:
+			quoteIndex = i
+		}
+	}
+	if baseIndex == -1 {
+		return 0, fmt.Errorf("denom %s is not in pool %s", base, ps.PoolID)
+	}
+	if quoteIndex == -1 {
+		return 0, fmt.Errorf("denom %s is not in pool %s", quote, ps.PoolID)
+	}
+
+	// the pair of a denom with itself is skipped
+	if quoteIndex > baseIndex {
+		quoteIndex--
+	}
+
+	return baseIndex*(len(denoms)-1) + quoteIndex, nil
+}
+
+// Validate performs a basic check of a PoolPriceSnapshot.
+func (ps PoolPriceSnapshot) Validate() error {
+	if strings.TrimSpace(ps.PoolID) == "" {
+		return errors.New("poolID must be set")
+	}
+	denoms, _, err := ParsePoolID(ps.PoolID)
+	if err != nil {
+		return err
+	}
+	if ps.Height < 0 {
+		return fmt.Errorf("snapshot height cannot be negative %d", ps.Height)
+	}
+
+	pairs := len(denoms) * (len(denoms) - 1)
+	if len(ps.Prices) != pairs || len(ps.CumulativePrices) != pairs {
+		return fmt.Errorf("price snapshot for pool %s must have %d prices", ps.PoolID, pairs)
+	}
+	for i := range ps.Prices {
+		if ps.Prices[i].IsNil() || ps.Prices[i].IsNegative() {
+			return fmt.Errorf("snapshot price cannot be negative %s", ps.Prices[i])
+		}
+		if ps.CumulativePrices[i].IsNil() || ps.CumulativePrices[i].IsNegative() {
+			return fmt.Errorf("cumulative price cannot be negative %s", ps.CumulativePrices[i])
+		}
+	}
+
+	return nil
+}
+
+// CumulativePricesAt returns the cumulative prices at a time at or after the snapshot, if the snapshot's prices held
+// until then
+func (ps PoolPriceSnapshot) CumulativePricesAt(t time.Time) []sdk.Dec {
+	elapsed := DurationSeconds(t.Sub(ps.Time))
+
+	cumulativePrices := make([]sdk.Dec, len(ps.Prices))
+	for i, price := range ps.Prices {
+		cumulativePrices[i] = ps.CumulativePrices[i].Add(price.Mul(elapsed))
+	}
+
+	return cumulativePrices
+}
+
+// DurationSeconds returns a duration in seconds, with nanosecond precision
+func DurationSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDec(d.Nanoseconds()).QuoInt64(int64(time.Second))
+}
+
+// PoolPriceSnapshots is a slice of PoolPriceSnapshot
+type PoolPriceSnapshots []PoolPriceSnapshot
+
+// Validate checks if all the price snapshots are valid, and in increasing height and time order within each pool.
+func (pss PoolPriceSnapshots) Validate() error {
+	latest := make(map[string]PoolPriceSnapshot)
+	for _, ps := range pss {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+		if prev, found := latest[ps.PoolID]; found {
+			if ps.Height <= prev.Height || ps.Time.Before(prev.Time) {
+				return fmt.Errorf("price snapshot for pool %s at height %d is out of order", ps.PoolID, ps.Height)
+			}
+			for i := range ps.CumulativePrices {
+				if ps.CumulativePrices[i].LT(prev.CumulativePrices[i]) {
+					return fmt.Errorf("cumulative price for pool %s decreases at height %d", ps.PoolID, ps.Height)
+				}
+			}
+		}
+		latest[ps.PoolID] = ps
+	}
+
+	return nil
+}
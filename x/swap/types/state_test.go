@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	types "github.com/percosis-labs/fury/x/swap/types"
 
@@ -75,9 +77,174 @@ func TestState_NewPoolRecordFromPool(t *testing.T) {
 	assert.Equal(t, record.ReservesB, usdf(50e6))
 	assert.Equal(t, pool.TotalShares(), record.TotalShares)
 	assert.Equal(t, sdk.NewCoins(ufury(10e6), usdf(50e6)), record.Reserves())
+	assert.Equal(t, types.POOL_TYPE_UNSPECIFIED, record.PoolType)
+	assert.Equal(t, uint64(0), record.Amplification)
 	assert.Nil(t, record.Validate())
 }
 
+func TestState_NewPoolRecordFromPool_StableSwap(t *testing.T) {
+	reserves := sdk.NewCoins(usdf(50e6), ufury(10e6))
+
+	pool, err := types.NewDenominatedStableSwapPool(reserves, 100)
+	require.NoError(t, err)
+
+	record := types.NewPoolRecordFromPool(pool)
+
+	assert.Equal(t, types.PoolID("ufury", "usdf"), record.PoolID)
+	assert.Equal(t, sdk.NewCoins(ufury(10e6), usdf(50e6)), record.Reserves())
+	assert.Equal(t, pool.TotalShares(), record.TotalShares)
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, record.PoolType)
+	assert.Equal(t, uint64(100), record.Amplification)
+	assert.Nil(t, record.Validate())
+
+	loadedPool, err := types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, pool, loadedPool)
+}
+
+func TestState_WeightedPoolID(t *testing.T) {
+	testCases := []struct {
+		denoms     []string
+		weights    []uint64
+		expectedID string
+	}{
+		{[]string{"ufury", "usdf"}, []uint64{80, 20}, "ufury:usdf@80:20"},
+		{[]string{"ufury", "usdf"}, []uint64{20, 80}, "ufury:usdf@20:80"},
+		{[]string{"jinx", "ufury", "usdf"}, []uint64{1, 1, 1}, "jinx:ufury:usdf"},
+		{[]string{"jinx", "ufury", "usdf"}, []uint64{30, 30, 30}, "jinx:ufury:usdf"},
+		{[]string{"jinx", "ufury", "usdf"}, []uint64{50, 25, 25}, "jinx:ufury:usdf@50:25:25"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expectedID, func(t *testing.T) {
+			assert.Equal(t, tc.expectedID, types.WeightedPoolID(tc.denoms, tc.weights))
+
+			denoms, weights, err := types.ParsePoolID(tc.expectedID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.denoms, denoms)
+			assert.Equal(t, tc.expectedID, types.WeightedPoolID(denoms, weights))
+		})
+	}
+}
+
+func TestState_ParsePoolID(t *testing.T) {
+	denoms, weights, err := types.ParsePoolID("ufury:usdf")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ufury", "usdf"}, denoms)
+	assert.Nil(t, weights)
+
+	denoms, weights, err = types.ParsePoolID("jinx:ufury:usdf@50:25:25")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jinx", "ufury", "usdf"}, denoms)
+	assert.Equal(t, []uint64{50, 25, 25}, weights)
+
+	invalidPoolIDs := []string{
+		"",
+		"ufury",
+		"usdf:ufury",
+		"ufury:ufury",
+		"ufury::usdf",
+		"ufury:usdf@",
+		"ufury:usdf@80",
+		"ufury:usdf@80:0",
+		"ufury:usdf@80:-20",
+		"ufury:usdf@50:50",
+		"ufury:usdf@80:20@80:20",
+		"a0:a1:a2:a3:a4:a5:a6:a7:a8",
+	}
+	for _, poolID := range invalidPoolIDs {
+		t.Run(poolID, func(t *testing.T) {
+			_, _, err := types.ParsePoolID(poolID)
+			assert.EqualError(t, err, fmt.Sprintf("poolID '%s' is invalid", poolID))
+		})
+	}
+}
+
+func TestState_NewPoolRecordFromPool_Weighted(t *testing.T) {
+	reserves := sdk.NewCoins(jinx(10e6), ufury(10e6), usdf(50e6))
+
+	pool, err := types.NewWeightedPool(reserves, []uint64{50, 25, 25})
+	require.NoError(t, err)
+
+	record := types.NewPoolRecordFromPool(pool)
+
+	assert.Equal(t, "jinx:ufury:usdf@50:25:25", record.PoolID)
+	assert.Equal(t, reserves, record.Reserves())
+	assert.Equal(t, pool.TotalShares(), record.TotalShares)
+	assert.Equal(t, types.POOL_TYPE_WEIGHTED, record.PoolType)
+	assert.Equal(t, []uint64{50, 25, 25}, record.Weights)
+	assert.Nil(t, record.Validate())
+
+	loadedPool, err := types.NewPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, pool, loadedPool)
+}
+
+func TestState_PoolRecord_Weighted_Validations(t *testing.T) {
+	valid := types.PoolRecord{
+		PoolID:           "jinx:ufury:usdf@50:25:25",
+		TotalShares:      i(10e6),
+		PoolType:         types.POOL_TYPE_WEIGHTED,
+		WeightedReserves: sdk.NewCoins(jinx(10e6), ufury(10e6), usdf(50e6)),
+		Weights:          []uint64{50, 25, 25},
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name        string
+		modify      func(record *types.PoolRecord)
+		expectedErr string
+	}{
+		{
+			name:        "reserves a set",
+			modify:      func(record *types.PoolRecord) { record.ReservesA = jinx(10e6) },
+			expectedErr: "pool 'jinx:ufury:usdf@50:25:25' must only have weighted reserves",
+		},
+		{
+			name:        "amplification set",
+			modify:      func(record *types.PoolRecord) { record.Amplification = 100 },
+			expectedErr: "pool 'jinx:ufury:usdf@50:25:25' must only have weighted reserves",
+		},
+		{
+			name:        "zero reserves",
+			modify:      func(record *types.PoolRecord) { record.WeightedReserves = sdk.Coins{jinx(0), ufury(10e6), usdf(50e6)} },
+			expectedErr: "pool 'jinx:ufury:usdf@50:25:25' has invalid reserves: 0jinx,10000000ufury,50000000usdf",
+		},
+		{
+			name:        "missing weight",
+			modify:      func(record *types.PoolRecord) { record.Weights = []uint64{50, 25} },
+			expectedErr: "pool 'jinx:ufury:usdf@50:25:25' has invalid weights: weighted pool must have one weight per token, received 3 tokens and 2 weights",
+		},
+		{
+			name:        "pool id does not match weights",
+			modify:      func(record *types.PoolRecord) { record.Weights = []uint64{25, 50, 25} },
+			expectedErr: "poolID 'jinx:ufury:usdf@50:25:25' does not match reserves",
+		},
+		{
+			name:        "zero total shares",
+			modify:      func(record *types.PoolRecord) { record.TotalShares = i(0) },
+			expectedErr: "pool 'jinx:ufury:usdf@50:25:25' has invalid total shares: 0",
+		},
+		{
+			name: "constant product pool with weights",
+			modify: func(record *types.PoolRecord) {
+				*record = types.NewPoolRecord(sdk.NewCoins(ufury(10e6), usdf(50e6)), i(10e6))
+				record.Weights = []uint64{80, 20}
+			},
+			expectedErr: "pool 'ufury:usdf' must not have weighted reserves or weights",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			record := valid
+			record.WeightedReserves = append(sdk.Coins{}, valid.WeightedReserves...)
+			tc.modify(&record)
+			assert.EqualError(t, record.Validate(), tc.expectedErr)
+		})
+	}
+}
+
 func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 	raw := `{
 		"pool_id": "ufury:usdf",
@@ -119,12 +286,14 @@ func TestState_PoolRecord_Validations(t *testing.T) {
 		i(300e6),
 	)
 	testCases := []struct {
-		name        string
-		poolID      string
-		reservesA   sdk.Coin
-		reservesB   sdk.Coin
-		totalShares sdkmath.Int
-		expectedErr string
+		name          string
+		poolID        string
+		reservesA     sdk.Coin
+		reservesB     sdk.Coin
+		totalShares   sdkmath.Int
+		poolType      types.PoolType
+		amplification uint64
+		expectedErr   string
 	}{
 		{
 			name:        "empty pool id",
@@ -246,15 +415,36 @@ func TestState_PoolRecord_Validations(t *testing.T) {
 			totalShares: sdk.ZeroInt(),
 			expectedErr: "pool 'ufury:usdf' has invalid total shares: 0",
 		},
+		{
+			name:          "unknown pool type",
+			poolID:        validRecord.PoolID,
+			reservesA:     validRecord.ReservesA,
+			reservesB:     validRecord.ReservesB,
+			totalShares:   validRecord.TotalShares,
+			poolType:      4,
+			amplification: 100,
+			expectedErr:   "pool 'ufury:usdf' has invalid pool type: unknown pool type 4",
+		},
+		{
+			name:        "stableswap without amplification",
+			poolID:      validRecord.PoolID,
+			reservesA:   validRecord.ReservesA,
+			reservesB:   validRecord.ReservesB,
+			totalShares: validRecord.TotalShares,
+			poolType:    types.POOL_TYPE_STABLESWAP,
+			expectedErr: "pool 'ufury:usdf' has invalid pool type: amplification must be between 1 and 1000000 for POOL_TYPE_STABLESWAP",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			record := types.PoolRecord{
-				PoolID:      tc.poolID,
-				ReservesA:   tc.reservesA,
-				ReservesB:   tc.reservesB,
-				TotalShares: tc.totalShares,
+				PoolID:        tc.poolID,
+				ReservesA:     tc.reservesA,
+				ReservesB:     tc.reservesB,
+				TotalShares:   tc.totalShares,
+				PoolType:      tc.poolType,
+				Amplification: tc.amplification,
 			}
 			err := record.Validate()
 			assert.EqualError(t, err, tc.expectedErr)
@@ -529,3 +719,136 @@ func TestState_ShareRecords_ValidateUniqueShareRecords(t *testing.T) {
 	invalidRecords := types.ShareRecords{record_1, record_3, record_2, record_4}
 	assert.EqualError(t, invalidRecords.Validate(), "duplicate depositor 'fury1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w' and poolID 'ufury:usdf'")
 }
+
+func TestState_PoolRecord_SubReserves(t *testing.T) {
+	record := types.NewPoolRecord(sdk.NewCoins(sdk.NewInt64Coin("ufury", 100), sdk.NewInt64Coin("usdf", 200)), sdkmath.NewInt(50))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufury", 99), sdk.NewInt64Coin("usdf", 200)), record.SubReserves(sdk.NewInt64Coin("ufury", 1)).Reserves())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufury", 100), sdk.NewInt64Coin("usdf", 190)), record.SubReserves(sdk.NewInt64Coin("usdf", 10)).Reserves())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufury", 100), sdk.NewInt64Coin("usdf", 200)), record.Reserves())
+	assert.PanicsWithValue(t, "denom jinx is not in pool ufury:usdf", func() { record.SubReserves(sdk.NewInt64Coin("jinx", 1)) })
+
+	pool, err := types.NewWeightedPool(sdk.NewCoins(sdk.NewInt64Coin("jinx", 100e6), sdk.NewInt64Coin("ufury", 100e6), sdk.NewInt64Coin("usdf", 100e6)), []uint64{1, 1, 1})
+	require.NoError(t, err)
+	weighted := types.NewPoolRecordFromPool(pool)
+	assert.Equal(t, int64(99999000), weighted.SubReserves(sdk.NewInt64Coin("ufury", 1000)).Reserves().AmountOf("ufury").Int64())
+	assert.Equal(t, int64(100e6), weighted.Reserves().AmountOf("ufury").Int64())
+}
+
+func TestState_PoolSpotPrices(t *testing.T) {
+	pool, err := types.NewWeightedPool(sdk.NewCoins(sdk.NewInt64Coin("jinx", 100e6), sdk.NewInt64Coin("ufury", 200e6), sdk.NewInt64Coin("usdf", 400e6)), []uint64{1, 1, 1})
+	require.NoError(t, err)
+
+	prices := types.PoolSpotPrices(pool)
+	snapshot := types.NewPoolPriceSnapshot(types.NewPoolRecordFromPool(pool).PoolID, 1, time.Now(), prices, prices)
+	require.NoError(t, snapshot.Validate())
+
+	pairs := []struct {
+		base  string
+		quote string
+		price sdk.Dec
+	}{
+		{"jinx", "ufury", sdk.NewDec(2)},
+		{"jinx", "usdf", sdk.NewDec(4)},
+		{"ufury", "jinx", sdk.MustNewDecFromStr("0.5")},
+		{"ufury", "usdf", sdk.NewDec(2)},
+		{"usdf", "jinx", sdk.MustNewDecFromStr("0.25")},
+		{"usdf", "ufury", sdk.MustNewDecFromStr("0.5")},
+	}
+	require.Len(t, prices, len(pairs))
+	for i, pair := range pairs {
+		index, err := snapshot.PairIndex(pair.base, pair.quote)
+		require.NoError(t, err)
+		assert.Equal(t, i, index)
+		assert.Equal(t, pair.price, prices[index])
+	}
+
+	_, err = snapshot.PairIndex("jinx", "jinx")
+	assert.Error(t, err)
+	_, err = snapshot.PairIndex("bnb", "usdf")
+	assert.EqualError(t, err, "denom bnb is not in pool jinx:ufury:usdf")
+}
+
+func TestState_PoolPriceSnapshot_CumulativePricesAt(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := types.NewPoolPriceSnapshot("ufury:usdf", 1, start,
+		[]sdk.Dec{sdk.NewDec(4), sdk.MustNewDecFromStr("0.25")},
+		[]sdk.Dec{sdk.NewDec(10), sdk.NewDec(1)},
+	)
+
+	assert.Equal(t, snapshot.CumulativePrices, snapshot.CumulativePricesAt(start))
+	assert.Equal(t,
+		[]sdk.Dec{sdk.NewDec(50), sdk.MustNewDecFromStr("3.5")},
+		snapshot.CumulativePricesAt(start.Add(10*time.Second)),
+	)
+}
+
+func TestState_PoolPriceSnapshots_Validation(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func(poolID string, height int64, t time.Time, prices []string, cumulativePrices []string) types.PoolPriceSnapshot {
+		decs := func(strs []string) []sdk.Dec {
+			result := make([]sdk.Dec, len(strs))
+			for i, str := range strs {
+				result[i] = sdk.MustNewDecFromStr(str)
+			}
+			return result
+		}
+		return types.NewPoolPriceSnapshot(poolID, height, t, decs(prices), decs(cumulativePrices))
+	}
+
+	testCases := []struct {
+		name        string
+		snapshots   types.PoolPriceSnapshots
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			snapshots: types.PoolPriceSnapshots{
+				snapshot("ufury:usdf", 1, start, []string{"4", "0.25"}, []string{"0", "0"}),
+				snapshot("ufury:usdf", 2, start.Add(time.Second), []string{"5", "0.2"}, []string{"4", "0.25"}),
+				snapshot("jinx:usdf", 1, start, []string{"2", "0.5"}, []string{"0", "0"}),
+			},
+		},
+		{
+			name:        "invalid pool id",
+			snapshots:   types.PoolPriceSnapshots{snapshot("usdf:ufury", 1, start, []string{"4", "0.25"}, []string{"0", "0"})},
+			expectedErr: "poolID 'usdf:ufury' is invalid",
+		},
+		{
+			name:        "missing prices",
+			snapshots:   types.PoolPriceSnapshots{snapshot("jinx:ufury:usdf", 1, start, []string{"4", "0.25"}, []string{"0", "0"})},
+			expectedErr: "price snapshot for pool jinx:ufury:usdf must have 6 prices",
+		},
+		{
+			name:        "negative price",
+			snapshots:   types.PoolPriceSnapshots{snapshot("ufury:usdf", 1, start, []string{"-4", "0.25"}, []string{"0", "0"})},
+			expectedErr: "snapshot price cannot be negative -4.000000000000000000",
+		},
+		{
+			name: "out of order",
+			snapshots: types.PoolPriceSnapshots{
+				snapshot("ufury:usdf", 2, start, []string{"4", "0.25"}, []string{"0", "0"}),
+				snapshot("ufury:usdf", 1, start.Add(time.Second), []string{"5", "0.2"}, []string{"4", "0.25"}),
+			},
+			expectedErr: "price snapshot for pool ufury:usdf at height 1 is out of order",
+		},
+		{
+			name: "decreasing cumulative price",
+			snapshots: types.PoolPriceSnapshots{
+				snapshot("ufury:usdf", 1, start, []string{"4", "0.25"}, []string{"0", "1"}),
+				snapshot("ufury:usdf", 2, start.Add(time.Second), []string{"5", "0.2"}, []string{"4", "0.25"}),
+			},
+			expectedErr: "cumulative price for pool ufury:usdf decreases at height 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.snapshots.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
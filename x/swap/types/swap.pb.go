@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,12 +31,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolType is the pricing curve used by a pool
+type PoolType int32
+
+const (
+	// POOL_TYPE_UNSPECIFIED is a constant product pool, as POOL_TYPE_CONSTANT_PRODUCT
+	POOL_TYPE_UNSPECIFIED PoolType = 0
+	// POOL_TYPE_CONSTANT_PRODUCT prices trades along the curve x * y = k
+	POOL_TYPE_CONSTANT_PRODUCT PoolType = 1
+	// POOL_TYPE_STABLESWAP prices trades along the curve-style stableswap invariant, keeping prices
+	// close to 1:1 until the reserves become imbalanced
+	POOL_TYPE_STABLESWAP PoolType = 2
+	// POOL_TYPE_WEIGHTED prices trades along the weighted constant mean curve prod(x_i ^ w_i) = k, and
+	// supports pools of more than two assets and single-sided deposits and withdraws
+	POOL_TYPE_WEIGHTED PoolType = 3
+)
+
+var PoolType_name = map[int32]string{
+	0: "POOL_TYPE_UNSPECIFIED",
+	1: "POOL_TYPE_CONSTANT_PRODUCT",
+	2: "POOL_TYPE_STABLESWAP",
+	3: "POOL_TYPE_WEIGHTED",
+}
+
+var PoolType_value = map[string]int32{
+	"POOL_TYPE_UNSPECIFIED":      0,
+	"POOL_TYPE_CONSTANT_PRODUCT": 1,
+	"POOL_TYPE_STABLESWAP":       2,
+	"POOL_TYPE_WEIGHTED":         3,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_099ed5241d4c600f, []int{0}
+}
+
 // Params defines the parameters for the swap module.
 type Params struct {
 	// allowed_pools defines that pools that are allowed to be created
 	AllowedPools AllowedPools `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3,castrepeated=AllowedPools" json:"allowed_pools"`
-	// swap_fee defines the swap fee for all pools
+	// swap_fee defines the swap fee for all pools that do not set their own swap fee
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// protocol_fee_share is the fraction of each swap fee that is paid to the protocol fee recipient instead of
+	// the pool's liquidity providers. Zero disables the protocol fee.
+	ProtocolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_share"`
+	// protocol_fee_recipient is the name of the module account that receives protocol fees, and defaults to the
+	// x/community pool when empty
+	ProtocolFeeRecipient string `protobuf:"bytes,4,opt,name=protocol_fee_recipient,json=protocolFeeRecipient,proto3" json:"protocol_fee_recipient,omitempty"`
+	// price_history_window is how long the price snapshots of each pool are kept for time-weighted average prices.
+	// The latest snapshot of each pool is always kept.
+	PriceHistoryWindow time.Duration `protobuf:"bytes,5,opt,name=price_history_window,json=priceHistoryWindow,proto3,stdduration" json:"price_history_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,12 +125,36 @@ func (m *Params) GetAllowedPools() AllowedPools {
 	return nil
 }
 
+func (m *Params) GetProtocolFeeRecipient() string {
+	if m != nil {
+		return m.ProtocolFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetPriceHistoryWindow() time.Duration {
+	if m != nil {
+		return m.PriceHistoryWindow
+	}
+	return 0
+}
+
 // AllowedPool defines a pool that is allowed to be created
 type AllowedPool struct {
 	// token_a represents the a token allowed
 	TokenA string `protobuf:"bytes,1,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
 	// token_b represents the b token allowed
 	TokenB string `protobuf:"bytes,2,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// pool_type is the pricing curve of the pool
+	PoolType PoolType `protobuf:"varint,3,opt,name=pool_type,json=poolType,proto3,enum=fury.swap.v1beta1.PoolType" json:"pool_type,omitempty"`
+	// amplification is the amplification coefficient of a stableswap pool, and must be zero for other pool types
+	Amplification uint64 `protobuf:"varint,4,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// tokens are the sorted denoms of a weighted pool, which leaves token_a and token_b empty
+	Tokens []string `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// weights are the weights of each token of a weighted pool
+	Weights []uint64 `protobuf:"varint,6,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	// swap_fee overrides the global swap fee for this pool when set
+	SwapFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee,omitempty"`
 }
 
 func (m *AllowedPool) Reset()      { *m = AllowedPool{} }
@@ -127,6 +203,34 @@ func (m *AllowedPool) GetTokenB() string {
 	return ""
 }
 
+func (m *AllowedPool) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_UNSPECIFIED
+}
+
+func (m *AllowedPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+func (m *AllowedPool) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *AllowedPool) GetWeights() []uint64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 // PoolRecord represents the state of a liquidity pool
 // and is used to store the state of a denominated pool
 type PoolRecord struct {
@@ -138,6 +242,15 @@ type PoolRecord struct {
 	ReservesB types.Coin `protobuf:"bytes,3,opt,name=reserves_b,json=reservesB,proto3" json:"reserves_b"`
 	// total_shares is the total distrubuted shares of the pool
 	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+	// pool_type is the pricing curve of the pool, set from the allowed pool when the pool is created.
+	// Constant product pools leave the pool type unspecified.
+	PoolType PoolType `protobuf:"varint,5,opt,name=pool_type,json=poolType,proto3,enum=fury.swap.v1beta1.PoolType" json:"pool_type,omitempty"`
+	// amplification is the amplification coefficient of a stableswap pool
+	Amplification uint64 `protobuf:"varint,6,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// weighted_reserves are the sorted reserves of a weighted pool, which leaves reserves_a and reserves_b empty
+	WeightedReserves github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=weighted_reserves,json=weightedReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"weighted_reserves,omitempty"`
+	// weights are the weights of each reserve of a weighted pool
+	Weights []uint64 `protobuf:"varint,8,rep,packed,name=weights,proto3" json:"weights,omitempty"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -194,6 +307,34 @@ func (m *PoolRecord) GetReservesB() types.Coin {
 	return types.Coin{}
 }
 
+func (m *PoolRecord) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_UNSPECIFIED
+}
+
+func (m *PoolRecord) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+func (m *PoolRecord) GetWeightedReserves() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WeightedReserves
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetWeights() []uint64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 // ShareRecord stores the shares owned for a depositor and pool
 type ShareRecord struct {
 	// depositor represents the owner of the shares
@@ -251,50 +392,208 @@ func (m *ShareRecord) GetPoolID() string {
 	return ""
 }
 
+// PoolPriceSnapshot records the spot prices of a pool after the last change to its reserves in a block, along with
+// its cumulative prices. Prices are listed for each ordered pair of the pool's sorted denoms, pricing the first denom
+// of the pair in the second.
+type PoolPriceSnapshot struct {
+	// pool_id is the pool the snapshot belongs to
+	PoolID string    `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// prices are the spot prices of each pair of the pool's denoms
+	Prices []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,rep,name=prices,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"prices"`
+	// cumulative_prices are the sums of the previous spot prices of each pair, each multiplied by the seconds it was
+	// the pool's spot price
+	CumulativePrices []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,rep,name=cumulative_prices,json=cumulativePrices,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_prices"`
+}
+
+func (m *PoolPriceSnapshot) Reset()         { *m = PoolPriceSnapshot{} }
+func (m *PoolPriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceSnapshot) ProtoMessage()    {}
+func (*PoolPriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_099ed5241d4c600f, []int{4}
+}
+func (m *PoolPriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceSnapshot.Merge(m, src)
+}
+func (m *PoolPriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceSnapshot proto.InternalMessageInfo
+
+func (m *PoolPriceSnapshot) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PoolPriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PoolPriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// PoolPriceHistory defines the bounds of a pool's price history, as the sequence numbers of its snapshots.
+type PoolPriceHistory struct {
+	// first is the sequence of the oldest snapshot kept
+	First uint64 `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	// next is the sequence of the next snapshot to be recorded
+	Next uint64 `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (m *PoolPriceHistory) Reset()         { *m = PoolPriceHistory{} }
+func (m *PoolPriceHistory) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceHistory) ProtoMessage()    {}
+func (*PoolPriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_099ed5241d4c600f, []int{5}
+}
+func (m *PoolPriceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceHistory.Merge(m, src)
+}
+func (m *PoolPriceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceHistory proto.InternalMessageInfo
+
+func (m *PoolPriceHistory) GetFirst() uint64 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *PoolPriceHistory) GetNext() uint64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("fury.swap.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*Params)(nil), "fury.swap.v1beta1.Params")
 	proto.RegisterType((*AllowedPool)(nil), "fury.swap.v1beta1.AllowedPool")
 	proto.RegisterType((*PoolRecord)(nil), "fury.swap.v1beta1.PoolRecord")
 	proto.RegisterType((*ShareRecord)(nil), "fury.swap.v1beta1.ShareRecord")
+	proto.RegisterType((*PoolPriceSnapshot)(nil), "fury.swap.v1beta1.PoolPriceSnapshot")
+	proto.RegisterType((*PoolPriceHistory)(nil), "fury.swap.v1beta1.PoolPriceHistory")
 }
 
 func init() { proto.RegisterFile("fury/swap/v1beta1/swap.proto", fileDescriptor_099ed5241d4c600f) }
 
 var fileDescriptor_099ed5241d4c600f = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xb6, 0x21, 0x31, 0x93, 0x78, 0x70, 0x2d, 0x98, 0x16, 0xd9, 0x2d, 0x11, 0xa4, 0x08,
-	0xd9, 0xa5, 0xf5, 0x26, 0x22, 0x64, 0x8d, 0x62, 0x4e, 0x96, 0xf5, 0x20, 0x7a, 0x59, 0x66, 0x77,
-	0x5f, 0xd2, 0xa5, 0x9b, 0x7d, 0xcb, 0xcc, 0xb4, 0x31, 0xff, 0x85, 0x78, 0xf2, 0xe8, 0xd9, 0x73,
-	0xff, 0x02, 0x4f, 0x3d, 0x96, 0x9e, 0xc4, 0x43, 0x94, 0xe4, 0xbf, 0xd0, 0x8b, 0xcc, 0x0f, 0xdb,
-	0x15, 0x11, 0x2c, 0x9e, 0x76, 0xde, 0xfb, 0xe6, 0xfb, 0xbe, 0xf7, 0xbe, 0x65, 0xc8, 0xed, 0xf1,
-	0x11, 0x9b, 0xfb, 0x7c, 0x46, 0x4b, 0xff, 0x78, 0x37, 0x06, 0x41, 0x77, 0x55, 0xe1, 0x95, 0x0c,
-	0x05, 0xda, 0x37, 0x24, 0xea, 0xa9, 0x86, 0x41, 0xb7, 0x9c, 0x04, 0xf9, 0x14, 0xb9, 0x1f, 0x53,
-	0x0e, 0x17, 0x94, 0x04, 0xb3, 0x42, 0x53, 0xb6, 0x36, 0x35, 0x1e, 0xa9, 0xca, 0xd7, 0x85, 0x81,
-	0x36, 0x26, 0x38, 0x41, 0xdd, 0x97, 0x27, 0xdd, 0xed, 0x7d, 0xb2, 0x48, 0x63, 0x9f, 0x32, 0x3a,
-	0xe5, 0xf6, 0x2b, 0x72, 0x9d, 0xe6, 0x39, 0xce, 0x20, 0x8d, 0x4a, 0xc4, 0x9c, 0x77, 0xad, 0xed,
-	0xf5, 0x9d, 0xf6, 0x9e, 0xe3, 0xfd, 0x31, 0x86, 0x37, 0xd0, 0xf7, 0xf6, 0x11, 0xf3, 0x60, 0xe3,
-	0x74, 0xe1, 0xd6, 0x3e, 0x7e, 0x75, 0x3b, 0x95, 0x26, 0x0f, 0x3b, 0xb4, 0x52, 0xd9, 0x2f, 0xc9,
-	0x35, 0xc9, 0x8f, 0xc6, 0x00, 0xdd, 0xb5, 0x6d, 0x6b, 0xa7, 0x15, 0x3c, 0x94, 0xac, 0x2f, 0x0b,
-	0xf7, 0xee, 0x24, 0x13, 0x07, 0x47, 0xb1, 0x97, 0xe0, 0xd4, 0x8c, 0x6b, 0x3e, 0x7d, 0x9e, 0x1e,
-	0xfa, 0x62, 0x5e, 0x02, 0xf7, 0x86, 0x90, 0x9c, 0x9f, 0xf4, 0x89, 0xd9, 0x66, 0x08, 0x49, 0xd8,
-	0x94, 0x6a, 0x4f, 0x01, 0x1e, 0xd4, 0xdf, 0x7f, 0x70, 0x6b, 0xbd, 0x27, 0xa4, 0x5d, 0x31, 0xb7,
-	0x6f, 0x91, 0xa6, 0xc0, 0x43, 0x28, 0x22, 0xda, 0xb5, 0xa4, 0x59, 0xd8, 0x50, 0xe5, 0xe0, 0x12,
-	0x88, 0xf5, 0x14, 0x06, 0x08, 0x8c, 0xcc, 0xbb, 0x35, 0x42, 0xa4, 0x40, 0x08, 0x09, 0xb2, 0xd4,
-	0xbe, 0x43, 0x9a, 0x32, 0x87, 0x28, 0x4b, 0xb5, 0x4c, 0x40, 0x96, 0x0b, 0xb7, 0x21, 0x2f, 0x8c,
-	0x86, 0x61, 0x43, 0x42, 0xa3, 0xd4, 0x7e, 0x44, 0x08, 0x03, 0x0e, 0xec, 0x18, 0x78, 0x44, 0x95,
-	0x6a, 0x7b, 0x6f, 0xd3, 0x33, 0xa3, 0xca, 0xbf, 0x74, 0x91, 0xd9, 0x63, 0xcc, 0x8a, 0xa0, 0x2e,
-	0xd7, 0x0e, 0x5b, 0xbf, 0x28, 0x83, 0xdf, 0xf8, 0x71, 0x77, 0xfd, 0x8a, 0xfc, 0xc0, 0x8e, 0x48,
-	0x47, 0xa0, 0xa0, 0x79, 0xc4, 0x0f, 0x28, 0x03, 0xde, 0xad, 0x5f, 0x39, 0xdd, 0x51, 0x21, 0x2a,
-	0xe9, 0x8e, 0x0a, 0x11, 0xb6, 0x95, 0xe2, 0x0b, 0x25, 0xd8, 0xfb, 0x61, 0x91, 0xb6, 0x3a, 0x9a,
-	0x54, 0xc6, 0xa4, 0x95, 0x42, 0x89, 0x3c, 0x13, 0xc8, 0x54, 0x2e, 0x9d, 0xe0, 0xd9, 0xf7, 0x85,
-	0xdb, 0xff, 0x07, 0xa7, 0x41, 0x92, 0x0c, 0xd2, 0x94, 0x01, 0xe7, 0xe7, 0x27, 0xfd, 0x9b, 0xc6,
-	0xd0, 0x74, 0x82, 0xb9, 0x00, 0x1e, 0x5e, 0x4a, 0x57, 0xd3, 0x5f, 0xfb, 0x6b, 0xfa, 0x11, 0xe9,
-	0xe8, 0xbd, 0x23, 0x9c, 0x15, 0x90, 0xaa, 0xfc, 0xfe, 0x7b, 0x7b, 0xad, 0xf8, 0x5c, 0x0a, 0x06,
-	0xc3, 0xd3, 0xa5, 0x63, 0x9d, 0x2d, 0x1d, 0xeb, 0xdb, 0xd2, 0xb1, 0xde, 0xae, 0x9c, 0xda, 0xd9,
-	0xca, 0xa9, 0x7d, 0x5e, 0x39, 0xb5, 0xd7, 0xf7, 0x2a, 0xe2, 0x25, 0xb0, 0x04, 0x79, 0xc6, 0xfb,
-	0x39, 0x8d, 0xb9, 0xaf, 0xde, 0xf4, 0x1b, 0xfd, 0xaa, 0x95, 0x49, 0xdc, 0x50, 0x6f, 0xed, 0xfe,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x20, 0xb4, 0xa4, 0xef, 0x03, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x45, 0x5a, 0xb2, 0xcf, 0x4e, 0x21, 0x5f, 0x55, 0x97, 0x76, 0x0a, 0x4a, 0x70, 0x8b,
+	0xc2, 0x08, 0x6a, 0x09, 0x71, 0x3b, 0x18, 0x45, 0x50, 0x40, 0xb4, 0xe4, 0x5a, 0x40, 0x60, 0x09,
+	0x94, 0x0c, 0x23, 0x5d, 0x08, 0x8a, 0x3c, 0x49, 0xd7, 0x50, 0x3c, 0x82, 0x77, 0xb2, 0xa2, 0xa1,
+	0x7b, 0xc7, 0x6c, 0xcd, 0x58, 0xa0, 0x40, 0x87, 0xa0, 0x63, 0xb6, 0xfe, 0x81, 0x00, 0x5d, 0x82,
+	0x4c, 0x45, 0x07, 0xbb, 0xb0, 0xb7, 0xfe, 0x84, 0x76, 0x29, 0xee, 0x78, 0xb4, 0xa8, 0x3a, 0x0d,
+	0x1c, 0xd8, 0x93, 0xf8, 0xde, 0xd3, 0xfb, 0xde, 0xbd, 0xef, 0x7d, 0xf7, 0x48, 0xf0, 0x51, 0x7f,
+	0x1c, 0x4d, 0xab, 0x74, 0xe2, 0x84, 0xd5, 0x93, 0xfb, 0x3d, 0xc4, 0x9c, 0xfb, 0xc2, 0xa8, 0x84,
+	0x11, 0x61, 0x04, 0xae, 0xf2, 0x68, 0x45, 0x38, 0x64, 0x74, 0xc3, 0x70, 0x09, 0x1d, 0x11, 0x5a,
+	0xed, 0x39, 0x14, 0x5d, 0xa6, 0xb8, 0x04, 0x07, 0x71, 0xca, 0xc6, 0x7a, 0x1c, 0xb7, 0x85, 0x55,
+	0x8d, 0x0d, 0x19, 0x2a, 0x0e, 0xc8, 0x80, 0xc4, 0x7e, 0xfe, 0x24, 0xbd, 0xc6, 0x80, 0x90, 0x81,
+	0x8f, 0xaa, 0xc2, 0xea, 0x8d, 0xfb, 0x55, 0x6f, 0x1c, 0x39, 0x0c, 0x93, 0x04, 0xb0, 0xf4, 0xdf,
+	0x38, 0xc3, 0x23, 0x44, 0x99, 0x33, 0x92, 0x87, 0xdc, 0xfc, 0x55, 0x05, 0xb9, 0xb6, 0x13, 0x39,
+	0x23, 0x0a, 0x1f, 0x81, 0x3b, 0x8e, 0xef, 0x93, 0x09, 0xf2, 0xec, 0x90, 0x10, 0x9f, 0xea, 0x4a,
+	0x59, 0xdd, 0x5a, 0xde, 0x31, 0x2a, 0x57, 0xfa, 0xa8, 0xd4, 0xe2, 0xff, 0xb5, 0x09, 0xf1, 0xcd,
+	0xe2, 0xcb, 0xd3, 0x52, 0xe6, 0xf9, 0x59, 0x69, 0x25, 0xe5, 0xa4, 0xd6, 0x8a, 0x93, 0xb2, 0xe0,
+	0x31, 0x58, 0xe4, 0xf9, 0x76, 0x1f, 0x21, 0x3d, 0x5b, 0x56, 0xb6, 0x96, 0xcc, 0x07, 0x3c, 0xeb,
+	0x8f, 0xd3, 0xd2, 0xa7, 0x03, 0xcc, 0x86, 0xe3, 0x5e, 0xc5, 0x25, 0x23, 0xd9, 0xaf, 0xfc, 0xd9,
+	0xa6, 0xde, 0xe3, 0x2a, 0x9b, 0x86, 0x88, 0x56, 0xea, 0xc8, 0x7d, 0xfd, 0x62, 0x1b, 0x48, 0x3a,
+	0xea, 0xc8, 0xb5, 0xf2, 0x1c, 0x6d, 0x1f, 0x21, 0xf8, 0x2d, 0x80, 0xa2, 0x0f, 0x97, 0xf8, 0x1c,
+	0xdc, 0xa6, 0x43, 0x27, 0x42, 0xba, 0x7a, 0x0b, 0x25, 0x0a, 0x09, 0xee, 0x3e, 0x42, 0x1d, 0x8e,
+	0x0a, 0xbf, 0x00, 0x6b, 0x73, 0xb5, 0x22, 0xe4, 0xe2, 0x10, 0xa3, 0x80, 0xe9, 0x1a, 0xaf, 0x67,
+	0x15, 0x53, 0x19, 0x56, 0x12, 0x83, 0x47, 0xa0, 0x18, 0x46, 0xd8, 0x45, 0xf6, 0x10, 0x53, 0x46,
+	0xa2, 0xa9, 0x3d, 0xc1, 0x81, 0x47, 0x26, 0xfa, 0x42, 0x59, 0xd9, 0x5a, 0xde, 0x59, 0xaf, 0xc4,
+	0x03, 0xaa, 0x24, 0x03, 0xaa, 0xd4, 0xe5, 0x00, 0xcd, 0x45, 0x7e, 0xfc, 0x67, 0x67, 0x25, 0xc5,
+	0x82, 0x02, 0xe0, 0x20, 0xce, 0x3f, 0x16, 0xe9, 0x5f, 0x6a, 0xcf, 0x7e, 0x2c, 0x65, 0x36, 0x7f,
+	0xc9, 0x82, 0xe5, 0x14, 0xed, 0xf0, 0x43, 0x90, 0x67, 0xe4, 0x31, 0x0a, 0x6c, 0x47, 0x57, 0xc4,
+	0x99, 0x72, 0xc2, 0xac, 0xcd, 0x02, 0x3d, 0x3d, 0x9b, 0x0a, 0x98, 0x70, 0x17, 0x2c, 0xf1, 0x61,
+	0xdb, 0x9c, 0x06, 0xc1, 0xdb, 0x7b, 0x3b, 0x77, 0xdf, 0x30, 0x70, 0x8e, 0xde, 0x9d, 0x86, 0xc8,
+	0x5a, 0x0c, 0xe5, 0x13, 0xfc, 0x04, 0xdc, 0x71, 0x46, 0xa1, 0x8f, 0xfb, 0xd8, 0x15, 0x07, 0x16,
+	0x2c, 0x68, 0xd6, 0xbc, 0x13, 0xae, 0x81, 0xb8, 0x12, 0xd5, 0x17, 0xca, 0xea, 0x65, 0x5d, 0x0a,
+	0x75, 0x90, 0x9f, 0x20, 0x3c, 0x18, 0x32, 0xaa, 0xe7, 0xca, 0xea, 0x96, 0x66, 0x25, 0x26, 0xec,
+	0xa4, 0xb4, 0x92, 0x17, 0x83, 0xdc, 0xbd, 0xb1, 0x4e, 0x24, 0x5d, 0x3f, 0x6b, 0x00, 0xf0, 0x4e,
+	0x2c, 0xe4, 0x92, 0xc8, 0x83, 0x1f, 0x83, 0xbc, 0xe8, 0x1d, 0x7b, 0x31, 0x5b, 0x26, 0x38, 0x3f,
+	0x2d, 0xe5, 0xf8, 0x1f, 0x9a, 0x75, 0x2b, 0xc7, 0x43, 0x4d, 0x0f, 0x7e, 0x05, 0x40, 0x84, 0x28,
+	0x8a, 0x4e, 0x10, 0xb5, 0x1d, 0x3d, 0x2b, 0xa7, 0x26, 0x6b, 0xf0, 0x7b, 0x7c, 0xc9, 0xd1, 0x1e,
+	0xc1, 0x81, 0xa9, 0xf1, 0xa9, 0x59, 0x4b, 0x49, 0x4a, 0x6d, 0x2e, 0xbf, 0xa7, 0xab, 0xef, 0x98,
+	0x6f, 0x42, 0x1b, 0xac, 0x30, 0xc2, 0x1c, 0x3f, 0x96, 0x36, 0xd5, 0xb5, 0x77, 0xd6, 0x76, 0x33,
+	0x60, 0x29, 0x5a, 0x9a, 0x01, 0xb3, 0x96, 0x05, 0xa2, 0x50, 0x35, 0x9d, 0x57, 0xc0, 0xc2, 0x8d,
+	0x14, 0x90, 0x7b, 0x93, 0x02, 0x7e, 0x50, 0xc0, 0x6a, 0x3c, 0x5b, 0xe4, 0xd9, 0x49, 0x5f, 0x7a,
+	0xbe, 0xac, 0xbe, 0x9d, 0x88, 0x16, 0xef, 0xf0, 0xaf, 0xd3, 0xd2, 0xdd, 0x2b, 0xb9, 0x9f, 0x91,
+	0x11, 0x66, 0x68, 0x14, 0xb2, 0xe9, 0xf3, 0xb3, 0xd2, 0xd6, 0x35, 0x08, 0xe0, 0x78, 0xd4, 0x2a,
+	0x24, 0x40, 0x96, 0xc4, 0x49, 0x6b, 0x70, 0x71, 0x4e, 0x83, 0x9b, 0xff, 0x28, 0x60, 0x59, 0xd0,
+	0x23, 0x95, 0xd2, 0x07, 0x4b, 0x1e, 0x0a, 0x09, 0xc5, 0x8c, 0x44, 0x42, 0x2b, 0x2b, 0xe6, 0xc1,
+	0xdf, 0xa7, 0xa5, 0xed, 0x6b, 0x14, 0xaf, 0xb9, 0x6e, 0xcd, 0xf3, 0x22, 0x44, 0xe9, 0xeb, 0x17,
+	0xdb, 0xef, 0xcb, 0x76, 0xa5, 0xc7, 0x9c, 0x32, 0x44, 0xad, 0x19, 0x74, 0x5a, 0x91, 0xd9, 0xff,
+	0x55, 0xa4, 0x0d, 0x56, 0x62, 0x2d, 0xd8, 0x64, 0x12, 0x20, 0x4f, 0x57, 0x6f, 0x43, 0x11, 0x31,
+	0x62, 0x8b, 0x03, 0x6e, 0xfe, 0x96, 0x05, 0xab, 0xbc, 0x66, 0x9b, 0xaf, 0x9d, 0x4e, 0xe0, 0x84,
+	0x74, 0x48, 0xd8, 0xf5, 0x6e, 0xcb, 0x1a, 0xc8, 0x0d, 0x05, 0x87, 0xe2, 0xfc, 0xaa, 0x25, 0x2d,
+	0xb8, 0x0b, 0x34, 0xfe, 0xe6, 0x91, 0xfa, 0xdf, 0xb8, 0xb2, 0xf5, 0xba, 0xc9, 0x6b, 0x29, 0x5e,
+	0x7b, 0x4f, 0xf9, 0xda, 0x13, 0x19, 0xb0, 0x0b, 0x72, 0x62, 0xfd, 0x71, 0xe5, 0xab, 0x37, 0xde,
+	0xea, 0x12, 0x0b, 0x62, 0xb0, 0xea, 0x8e, 0x47, 0x63, 0xdf, 0x61, 0xf8, 0x04, 0xd9, 0xb2, 0xc0,
+	0xc2, 0x2d, 0x14, 0x28, 0xcc, 0x60, 0x05, 0x7d, 0x74, 0xf3, 0x01, 0x28, 0x5c, 0x92, 0x29, 0x77,
+	0x38, 0x2c, 0x82, 0x85, 0x3e, 0x8e, 0x28, 0x13, 0x4c, 0x6a, 0x56, 0x6c, 0x40, 0x08, 0xb4, 0x00,
+	0x3d, 0x89, 0xa9, 0xd3, 0x2c, 0xf1, 0x7c, 0xef, 0x3b, 0xb0, 0x98, 0xdc, 0x3c, 0xb8, 0x0e, 0x3e,
+	0x68, 0xb7, 0x5a, 0x0f, 0xed, 0xee, 0xa3, 0x76, 0xc3, 0x3e, 0x3a, 0xec, 0xb4, 0x1b, 0x7b, 0xcd,
+	0xfd, 0x66, 0xa3, 0x5e, 0xc8, 0x40, 0x03, 0x6c, 0xcc, 0x42, 0x7b, 0xad, 0xc3, 0x4e, 0xb7, 0x76,
+	0xd8, 0xb5, 0xdb, 0x56, 0xab, 0x7e, 0xb4, 0xd7, 0x2d, 0x28, 0x50, 0x07, 0xc5, 0x59, 0xbc, 0xd3,
+	0xad, 0x99, 0x0f, 0x1b, 0x9d, 0xe3, 0x5a, 0xbb, 0x90, 0x85, 0x6b, 0x00, 0xce, 0x22, 0xc7, 0x8d,
+	0xe6, 0xd7, 0x07, 0xdd, 0x46, 0xbd, 0xa0, 0x6e, 0x68, 0xdf, 0xff, 0x64, 0x64, 0xcc, 0xfa, 0xcb,
+	0x73, 0x43, 0x79, 0x75, 0x6e, 0x28, 0x7f, 0x9e, 0x1b, 0xca, 0xd3, 0x0b, 0x23, 0xf3, 0xea, 0xc2,
+	0xc8, 0xfc, 0x7e, 0x61, 0x64, 0xbe, 0xb9, 0x97, 0xa2, 0x27, 0x44, 0x91, 0x4b, 0x28, 0xa6, 0xdb,
+	0xbe, 0xd3, 0xa3, 0x55, 0xf1, 0x51, 0xf4, 0x24, 0xfe, 0x2c, 0x12, 0x34, 0xf5, 0x72, 0x62, 0xce,
+	0x9f, 0xff, 0x3b, 0x00, 0xfe, 0xdf, 0xae, 0x4c, 0x30, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -317,6 +616,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PriceHistoryWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceHistoryWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProtocolFeeRecipient) > 0 {
+		i -= len(m.ProtocolFeeRecipient)
+		copy(dAtA[i:], m.ProtocolFeeRecipient)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.ProtocolFeeRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -364,6 +688,55 @@ func (m *AllowedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapFee != nil {
+		{
+			size := m.SwapFee.Size()
+			i -= size
+			if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Weights) > 0 {
+		dAtA3 := make([]byte, len(m.Weights)*10)
+		var j2 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSwap(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintSwap(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TokenB) > 0 {
 		i -= len(m.TokenB)
 		copy(dAtA[i:], m.TokenB)
@@ -401,6 +774,48 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Weights) > 0 {
+		dAtA5 := make([]byte, len(m.Weights)*10)
+		var j4 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintSwap(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.WeightedReserves) > 0 {
+		for iNdEx := len(m.WeightedReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -488,6 +903,110 @@ func (m *ShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolPriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativePrices) > 0 {
+		for iNdEx := len(m.CumulativePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CumulativePrices[iNdEx].Size()
+				i -= size
+				if _, err := m.CumulativePrices[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Prices[iNdEx].Size()
+				i -= size
+				if _, err := m.Prices[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintSwap(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPriceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Next != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Next))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.First != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.First))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwap(v)
 	base := offset
@@ -513,6 +1032,14 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SwapFee.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = len(m.ProtocolFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceHistoryWindow)
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -530,6 +1057,29 @@ func (m *AllowedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovSwap(uint64(e))
+		}
+		n += 1 + sovSwap(uint64(l)) + l
+	}
+	if m.SwapFee != nil {
+		l = m.SwapFee.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
 	return n
 }
 
@@ -549,11 +1099,30 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovSwap(uint64(l))
 	l = m.TotalShares.Size()
 	n += 1 + l + sovSwap(uint64(l))
-	return n
-}
-
-func (m *ShareRecord) Size() (n int) {
-	if m == nil {
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	if len(m.WeightedReserves) > 0 {
+		for _, e := range m.WeightedReserves {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovSwap(uint64(e))
+		}
+		n += 1 + sovSwap(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ShareRecord) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -571,6 +1140,51 @@ func (m *ShareRecord) Size() (n int) {
 	return n
 }
 
+func (m *PoolPriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSwap(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSwap(uint64(l))
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.CumulativePrices) > 0 {
+		for _, e := range m.CumulativePrices {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolPriceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.First != 0 {
+		n += 1 + sovSwap(uint64(m.First))
+	}
+	if m.Next != 0 {
+		n += 1 + sovSwap(uint64(m.Next))
+	}
+	return n
+}
+
 func sovSwap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -674,59 +1288,43 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwap
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowedPool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwap
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -754,13 +1352,13 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			m.ProtocolFeeRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -770,23 +1368,24 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PriceHistoryWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -809,7 +1408,7 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolRecord) Unmarshal(dAtA []byte) error {
+func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,15 +1431,15 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -868,13 +1467,13 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolID = string(dAtA[iNdEx:postIndex])
+			m.TokenA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -884,30 +1483,67 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -917,28 +1553,103 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSwap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSwap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSwap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSwap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSwap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,7 +1677,9 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFee = &v
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -991,7 +1704,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,17 +1727,17 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1034,31 +1747,29 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
-			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1068,29 +1779,361 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolID = string(dAtA[iNdEx:postIndex])
+			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedReserves = append(m.WeightedReserves, types.Coin{})
+			if err := m.WeightedReserves[len(m.WeightedReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSwap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSwap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSwap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSwap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSwap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1141,6 +2184,300 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolPriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Prices = append(m.Prices, v)
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CumulativePrices = append(m.CumulativePrices, v)
+			if err := m.CumulativePrices[len(m.CumulativePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolPriceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPriceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.First |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwap(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -370,6 +370,356 @@ func (m *MsgSwapForExactTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapForExactTokensResponse proto.InternalMessageInfo
 
+// MsgSwapExactForTokensMultiHop represents a message for trading exact coinA
+// for coinB through a route of pools
+type MsgSwapExactForTokensMultiHop struct {
+	// represents the address swaping the tokens
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	// exact_token_a represents the exact amount to swap for token_b
+	ExactTokenA types.Coin `protobuf:"bytes,2,opt,name=exact_token_a,json=exactTokenA,proto3" json:"exact_token_a"`
+	// token_b represents the desired token_b to swap for
+	TokenB types.Coin `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b"`
+	// pools represents the ordered pool ids to trade through, the route with the
+	// largest output is used if empty
+	Pools []string `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+	// slippage represents the maximum change in token_b allowed
+	Slippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage"`
+	// deadline represents the unix timestamp to complete the swap by
+	Deadline int64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *MsgSwapExactForTokensMultiHop) Reset()         { *m = MsgSwapExactForTokensMultiHop{} }
+func (m *MsgSwapExactForTokensMultiHop) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactForTokensMultiHop) ProtoMessage()    {}
+func (*MsgSwapExactForTokensMultiHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{8}
+}
+func (m *MsgSwapExactForTokensMultiHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactForTokensMultiHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactForTokensMultiHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactForTokensMultiHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactForTokensMultiHop.Merge(m, src)
+}
+func (m *MsgSwapExactForTokensMultiHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactForTokensMultiHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactForTokensMultiHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactForTokensMultiHop proto.InternalMessageInfo
+
+// MsgSwapExactForTokensMultiHopResponse defines the
+// Msg/SwapExactForTokensMultiHop response type.
+type MsgSwapExactForTokensMultiHopResponse struct {
+}
+
+func (m *MsgSwapExactForTokensMultiHopResponse) Reset()         { *m = MsgSwapExactForTokensMultiHopResponse{} }
+func (m *MsgSwapExactForTokensMultiHopResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactForTokensMultiHopResponse) ProtoMessage()    {}
+func (*MsgSwapExactForTokensMultiHopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{9}
+}
+func (m *MsgSwapExactForTokensMultiHopResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactForTokensMultiHopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactForTokensMultiHopResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactForTokensMultiHopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactForTokensMultiHopResponse.Merge(m, src)
+}
+func (m *MsgSwapExactForTokensMultiHopResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactForTokensMultiHopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactForTokensMultiHopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactForTokensMultiHopResponse proto.InternalMessageInfo
+
+// MsgSwapForExactTokensMultiHop represents a message for trading coinA for an
+// exact coinB through a route of pools
+type MsgSwapForExactTokensMultiHop struct {
+	// represents the address swaping the tokens
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	// token_a represents the desired token_a to swap for
+	TokenA types.Coin `protobuf:"bytes,2,opt,name=token_a,json=tokenA,proto3" json:"token_a"`
+	// exact_token_b represents the exact token b amount to swap for token a
+	ExactTokenB types.Coin `protobuf:"bytes,3,opt,name=exact_token_b,json=exactTokenB,proto3" json:"exact_token_b"`
+	// pools represents the ordered pool ids to trade through, the route with the
+	// smallest input is used if empty
+	Pools []string `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+	// slippage represents the maximum change in token_a allowed
+	Slippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage"`
+	// deadline represents the unix timestamp to complete the swap by
+	Deadline int64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *MsgSwapForExactTokensMultiHop) Reset()         { *m = MsgSwapForExactTokensMultiHop{} }
+func (m *MsgSwapForExactTokensMultiHop) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapForExactTokensMultiHop) ProtoMessage()    {}
+func (*MsgSwapForExactTokensMultiHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{10}
+}
+func (m *MsgSwapForExactTokensMultiHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapForExactTokensMultiHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapForExactTokensMultiHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapForExactTokensMultiHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapForExactTokensMultiHop.Merge(m, src)
+}
+func (m *MsgSwapForExactTokensMultiHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapForExactTokensMultiHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapForExactTokensMultiHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapForExactTokensMultiHop proto.InternalMessageInfo
+
+// MsgSwapForExactTokensMultiHopResponse defines the
+// Msg/SwapForExactTokensMultiHop response type.
+type MsgSwapForExactTokensMultiHopResponse struct {
+}
+
+func (m *MsgSwapForExactTokensMultiHopResponse) Reset()         { *m = MsgSwapForExactTokensMultiHopResponse{} }
+func (m *MsgSwapForExactTokensMultiHopResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapForExactTokensMultiHopResponse) ProtoMessage()    {}
+func (*MsgSwapForExactTokensMultiHopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{11}
+}
+func (m *MsgSwapForExactTokensMultiHopResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapForExactTokensMultiHopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapForExactTokensMultiHopResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapForExactTokensMultiHopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapForExactTokensMultiHopResponse.Merge(m, src)
+}
+func (m *MsgSwapForExactTokensMultiHopResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapForExactTokensMultiHopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapForExactTokensMultiHopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapForExactTokensMultiHopResponse proto.InternalMessageInfo
+
+// MsgDepositToPool represents a message for depositing liquidity into a pool by
+// id. Depositing a single token into a weighted pool is a single-sided deposit.
+type MsgDepositToPool struct {
+	// depositor represents the address to deposit funds from
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// pool_id represents the pool to deposit into
+	PoolID string `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// tokens represents the desired tokens to deposit
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens"`
+	// min_shares represents the minimum shares to receive for the deposit
+	MinShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_shares,json=minShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_shares"`
+	// deadline represents the unix timestamp to complete the deposit by
+	Deadline int64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *MsgDepositToPool) Reset()         { *m = MsgDepositToPool{} }
+func (m *MsgDepositToPool) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToPool) ProtoMessage()    {}
+func (*MsgDepositToPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{12}
+}
+func (m *MsgDepositToPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositToPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToPool.Merge(m, src)
+}
+func (m *MsgDepositToPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositToPool proto.InternalMessageInfo
+
+// MsgDepositToPoolResponse defines the Msg/DepositToPool response type.
+type MsgDepositToPoolResponse struct {
+}
+
+func (m *MsgDepositToPoolResponse) Reset()         { *m = MsgDepositToPoolResponse{} }
+func (m *MsgDepositToPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToPoolResponse) ProtoMessage()    {}
+func (*MsgDepositToPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{13}
+}
+func (m *MsgDepositToPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositToPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToPoolResponse.Merge(m, src)
+}
+func (m *MsgDepositToPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositToPoolResponse proto.InternalMessageInfo
+
+// MsgWithdrawFromPool represents a message for withdrawing liquidity from a
+// pool by id. Setting denom_out withdraws a single token from a weighted pool.
+type MsgWithdrawFromPool struct {
+	// from represents the address we are withdrawing for
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// pool_id represents the pool to withdraw from
+	PoolID string `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// shares represents the amount of shares to withdraw
+	Shares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares"`
+	// min_tokens represents the minimum tokens to withdraw
+	MinTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_tokens,json=minTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_tokens"`
+	// denom_out represents the single token to withdraw, or all pool tokens if empty
+	DenomOut string `protobuf:"bytes,5,opt,name=denom_out,json=denomOut,proto3" json:"denom_out,omitempty"`
+	// deadline represents the unix timestamp to complete the withdraw by
+	Deadline int64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *MsgWithdrawFromPool) Reset()         { *m = MsgWithdrawFromPool{} }
+func (m *MsgWithdrawFromPool) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromPool) ProtoMessage()    {}
+func (*MsgWithdrawFromPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{14}
+}
+func (m *MsgWithdrawFromPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFromPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromPool.Merge(m, src)
+}
+func (m *MsgWithdrawFromPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromPool proto.InternalMessageInfo
+
+// MsgWithdrawFromPoolResponse defines the Msg/WithdrawFromPool response type.
+type MsgWithdrawFromPoolResponse struct {
+}
+
+func (m *MsgWithdrawFromPoolResponse) Reset()         { *m = MsgWithdrawFromPoolResponse{} }
+func (m *MsgWithdrawFromPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromPoolResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ab1e8ec96a37b40, []int{15}
+}
+func (m *MsgWithdrawFromPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFromPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromPoolResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "fury.swap.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "fury.swap.v1beta1.MsgDepositResponse")
@@ -379,51 +729,77 @@ func init() {
 	proto.RegisterType((*MsgSwapExactForTokensResponse)(nil), "fury.swap.v1beta1.MsgSwapExactForTokensResponse")
 	proto.RegisterType((*MsgSwapForExactTokens)(nil), "fury.swap.v1beta1.MsgSwapForExactTokens")
 	proto.RegisterType((*MsgSwapForExactTokensResponse)(nil), "fury.swap.v1beta1.MsgSwapForExactTokensResponse")
+	proto.RegisterType((*MsgSwapExactForTokensMultiHop)(nil), "fury.swap.v1beta1.MsgSwapExactForTokensMultiHop")
+	proto.RegisterType((*MsgSwapExactForTokensMultiHopResponse)(nil), "fury.swap.v1beta1.MsgSwapExactForTokensMultiHopResponse")
+	proto.RegisterType((*MsgSwapForExactTokensMultiHop)(nil), "fury.swap.v1beta1.MsgSwapForExactTokensMultiHop")
+	proto.RegisterType((*MsgSwapForExactTokensMultiHopResponse)(nil), "fury.swap.v1beta1.MsgSwapForExactTokensMultiHopResponse")
+	proto.RegisterType((*MsgDepositToPool)(nil), "fury.swap.v1beta1.MsgDepositToPool")
+	proto.RegisterType((*MsgDepositToPoolResponse)(nil), "fury.swap.v1beta1.MsgDepositToPoolResponse")
+	proto.RegisterType((*MsgWithdrawFromPool)(nil), "fury.swap.v1beta1.MsgWithdrawFromPool")
+	proto.RegisterType((*MsgWithdrawFromPoolResponse)(nil), "fury.swap.v1beta1.MsgWithdrawFromPoolResponse")
 }
 
 func init() { proto.RegisterFile("fury/swap/v1beta1/tx.proto", fileDescriptor_4ab1e8ec96a37b40) }
 
 var fileDescriptor_4ab1e8ec96a37b40 = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcd, 0x6a, 0x13, 0x51,
-	0x14, 0xc7, 0x33, 0x49, 0x6c, 0x9b, 0x1b, 0x5c, 0x78, 0x6d, 0x61, 0x3a, 0xd0, 0x49, 0x29, 0x58,
-	0x82, 0x98, 0x19, 0x5b, 0x41, 0x44, 0x04, 0xe9, 0x34, 0x2d, 0xb8, 0x08, 0xc2, 0xb4, 0xa0, 0xb8,
-	0x09, 0xf3, 0x71, 0x3b, 0x19, 0x9a, 0xcc, 0x1d, 0xef, 0xb9, 0x31, 0xe9, 0x1b, 0xb8, 0x12, 0x1f,
-	0xc1, 0x9d, 0x2f, 0x90, 0x87, 0x28, 0xae, 0x4a, 0x57, 0xe2, 0xa2, 0x48, 0xf2, 0x22, 0x32, 0x9f,
-	0xf9, 0xe8, 0x10, 0x93, 0xae, 0x74, 0x95, 0xb9, 0xf3, 0x3f, 0xff, 0x73, 0xef, 0xfc, 0xce, 0xc9,
-	0xb9, 0x48, 0x3a, 0xeb, 0xb2, 0x0b, 0x15, 0x7a, 0x86, 0xaf, 0x7e, 0xda, 0x33, 0x09, 0x37, 0xf6,
-	0x54, 0xde, 0x57, 0x7c, 0x46, 0x39, 0xc5, 0x0f, 0x02, 0x4d, 0x09, 0x34, 0x25, 0xd6, 0x24, 0xd9,
-	0xa2, 0xd0, 0xa1, 0xa0, 0x9a, 0x06, 0x90, 0xd4, 0x60, 0x51, 0xd7, 0x8b, 0x2c, 0xd2, 0x66, 0xa4,
-	0x37, 0xc3, 0x95, 0x1a, 0x2d, 0x62, 0x69, 0xdd, 0xa1, 0x0e, 0x8d, 0xde, 0x07, 0x4f, 0xd1, 0xdb,
-	0x9d, 0x41, 0x1e, 0xa1, 0x06, 0x38, 0x75, 0xe2, 0x53, 0x70, 0x39, 0x7e, 0x8e, 0x4a, 0x76, 0xf4,
-	0x48, 0x99, 0x28, 0x6c, 0x0b, 0xd5, 0x92, 0x26, 0x5e, 0x0f, 0x6a, 0xeb, 0x71, 0xa6, 0x03, 0xdb,
-	0x66, 0x04, 0xe0, 0x84, 0x33, 0xd7, 0x73, 0xf4, 0x71, 0x28, 0x7e, 0x81, 0x56, 0x39, 0x3d, 0x27,
-	0x5e, 0xd3, 0x10, 0xf3, 0xdb, 0x42, 0xb5, 0xbc, 0xbf, 0xa9, 0xc4, 0x96, 0xe0, 0xa4, 0xc9, 0xf1,
-	0x95, 0x43, 0xea, 0x7a, 0x5a, 0xf1, 0xf2, 0xa6, 0x92, 0xd3, 0x57, 0xc2, 0xf8, 0x83, 0xb1, 0xd3,
-	0x14, 0x0b, 0xcb, 0x38, 0x35, 0xfc, 0x1e, 0xad, 0x41, 0xdb, 0xf5, 0x7d, 0xc3, 0x21, 0x62, 0x31,
-	0x3c, 0xea, 0xab, 0x40, 0xff, 0x75, 0x53, 0xd9, 0x75, 0x5c, 0xde, 0xea, 0x9a, 0x8a, 0x45, 0x3b,
-	0x31, 0x83, 0xf8, 0xa7, 0x06, 0xf6, 0xb9, 0xca, 0x2f, 0x7c, 0x02, 0x4a, 0x9d, 0x58, 0xd7, 0x83,
-	0x1a, 0x8a, 0xf7, 0xaa, 0x13, 0x4b, 0x4f, 0xb3, 0x61, 0x09, 0xad, 0xd9, 0xc4, 0xb0, 0xdb, 0xae,
-	0x47, 0xc4, 0x7b, 0xdb, 0x42, 0xb5, 0xa0, 0xa7, 0xeb, 0x97, 0xc5, 0xcf, 0xdf, 0x2a, 0xb9, 0x9d,
-	0x75, 0x84, 0xc7, 0xd4, 0x74, 0x02, 0x3e, 0xf5, 0x80, 0xec, 0x7c, 0xcf, 0xa3, 0x72, 0x03, 0x9c,
-	0x77, 0x2e, 0x6f, 0xd9, 0xcc, 0xe8, 0xe1, 0x27, 0xa8, 0x78, 0xc6, 0x68, 0xe7, 0xaf, 0x20, 0xc3,
-	0x28, 0x7c, 0x8c, 0x56, 0xa0, 0x65, 0x30, 0x02, 0x21, 0xc2, 0x92, 0xa6, 0x2c, 0xf1, 0x35, 0x6f,
-	0x3c, 0xae, 0xc7, 0x6e, 0xfc, 0x1a, 0x95, 0x3b, 0xae, 0xd7, 0x4c, 0xea, 0xb1, 0x20, 0xd5, 0x52,
-	0xc7, 0xf5, 0x4e, 0xa3, 0x92, 0x4c, 0x25, 0x30, 0x43, 0xb6, 0xcb, 0x24, 0xd0, 0x16, 0xe0, 0xb7,
-	0x81, 0x1e, 0x4e, 0x80, 0x4a, 0x01, 0xfe, 0xc8, 0xa3, 0x8d, 0x06, 0x38, 0x27, 0x3d, 0xc3, 0x3f,
-	0xea, 0x1b, 0x16, 0x3f, 0xa6, 0x2c, 0x4c, 0x09, 0x41, 0x63, 0x32, 0xf2, 0xb1, 0x4b, 0x80, 0x93,
-	0x05, 0x1a, 0x33, 0x0d, 0xc5, 0x87, 0xe8, 0x3e, 0x09, 0x32, 0x35, 0x97, 0x6c, 0xcf, 0x72, 0xe8,
-	0x3a, 0xfd, 0x9f, 0x7b, 0xb4, 0x82, 0xb6, 0x32, 0x59, 0x66, 0xd1, 0x3e, 0xa6, 0xec, 0x28, 0xfd,
-	0xe0, 0xbb, 0xd3, 0xbe, 0xfb, 0x18, 0x98, 0xa9, 0xd3, 0xc2, 0xa0, 0x27, 0xea, 0xf4, 0xaf, 0xd0,
-	0x9e, 0x66, 0x99, 0xd0, 0xde, 0xff, 0x52, 0x40, 0x85, 0x06, 0x38, 0xf8, 0x2d, 0x5a, 0x4d, 0xa6,
-	0xed, 0x96, 0x72, 0x6b, 0xc2, 0x2b, 0xe3, 0xb1, 0x22, 0x3d, 0x9a, 0x2b, 0x27, 0x89, 0xb1, 0x8e,
-	0xd6, 0xd2, 0x89, 0x23, 0x67, 0x5b, 0x12, 0x5d, 0xda, 0x9d, 0xaf, 0xa7, 0x39, 0x7d, 0x84, 0x33,
-	0xfe, 0x84, 0xd5, 0x6c, 0xf7, 0xed, 0x48, 0xe9, 0xe9, 0xa2, 0x91, 0xb3, 0x3b, 0xce, 0x34, 0xe2,
-	0x9c, 0x1d, 0xa7, 0x23, 0xe7, 0xed, 0x98, 0x5d, 0x10, 0xad, 0x7e, 0x39, 0x94, 0x85, 0xab, 0xa1,
-	0x2c, 0xfc, 0x1e, 0xca, 0xc2, 0xd7, 0x91, 0x9c, 0xbb, 0x1a, 0xc9, 0xb9, 0x9f, 0x23, 0x39, 0xf7,
-	0xe1, 0xf1, 0x44, 0xb7, 0xf8, 0x84, 0x59, 0x14, 0x5c, 0xa8, 0xb5, 0x0d, 0x13, 0xd4, 0xf0, 0xb6,
-	0xee, 0x47, 0xf7, 0x75, 0xd8, 0x35, 0xe6, 0x4a, 0x78, 0x8f, 0x3e, 0xfb, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x2f, 0x60, 0x64, 0x07, 0xc9, 0x07, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4d, 0x6b, 0xe3, 0x46,
+	0x18, 0xc7, 0x2d, 0x5b, 0x71, 0xe2, 0xc7, 0x2c, 0x6c, 0xa7, 0x5e, 0xd0, 0x6a, 0x89, 0x6c, 0xb2,
+	0xec, 0xd6, 0xb4, 0xb5, 0x9c, 0xdd, 0x42, 0x59, 0x4a, 0xa1, 0xac, 0xd7, 0x6b, 0x9a, 0x83, 0x49,
+	0x51, 0x02, 0x2d, 0xbd, 0x18, 0x59, 0x9a, 0x28, 0x4a, 0x2c, 0x8d, 0xaa, 0x91, 0x9b, 0xe4, 0x1b,
+	0xe4, 0xd8, 0x8f, 0xd0, 0x5b, 0xa1, 0xe7, 0x7c, 0x85, 0x42, 0xe8, 0x29, 0xe4, 0x54, 0x5a, 0x48,
+	0x8b, 0x73, 0xe9, 0xbd, 0x5f, 0xa0, 0x8c, 0xde, 0x1c, 0xdb, 0x8a, 0x22, 0x3b, 0x2d, 0x9b, 0x9c,
+	0x6c, 0xf9, 0x79, 0x99, 0x99, 0xdf, 0xff, 0xf1, 0x3c, 0x8f, 0x40, 0xdc, 0x19, 0xba, 0x47, 0x4d,
+	0x7a, 0xa0, 0x3a, 0xcd, 0xef, 0x5f, 0xf4, 0xb1, 0xa7, 0xbe, 0x68, 0x7a, 0x87, 0xb2, 0xe3, 0x12,
+	0x8f, 0xa0, 0xf7, 0x98, 0x4d, 0x66, 0x36, 0x39, 0xb4, 0x89, 0x92, 0x46, 0xa8, 0x45, 0x68, 0xb3,
+	0xaf, 0x52, 0x1c, 0x07, 0x68, 0xc4, 0xb4, 0x83, 0x10, 0xf1, 0x71, 0x60, 0xef, 0xf9, 0x4f, 0xcd,
+	0xe0, 0x21, 0x34, 0x55, 0x0c, 0x62, 0x90, 0xe0, 0x77, 0xf6, 0x2d, 0xf8, 0x75, 0xed, 0x24, 0x0f,
+	0xd0, 0xa5, 0x46, 0x1b, 0x3b, 0x84, 0x9a, 0x1e, 0xfa, 0x14, 0x4a, 0x7a, 0xf0, 0x95, 0xb8, 0x02,
+	0x57, 0xe3, 0xea, 0xa5, 0x96, 0x70, 0x7e, 0xd2, 0xa8, 0x84, 0x99, 0x5e, 0xeb, 0xba, 0x8b, 0x29,
+	0xdd, 0xf2, 0x5c, 0xd3, 0x36, 0x94, 0xb1, 0x2b, 0x7a, 0x05, 0xcb, 0x1e, 0xd9, 0xc7, 0x76, 0x4f,
+	0x15, 0xf2, 0x35, 0xae, 0x5e, 0x7e, 0xf9, 0x58, 0x0e, 0x43, 0xd8, 0x4e, 0xa3, 0xed, 0xcb, 0x6f,
+	0x88, 0x69, 0xb7, 0xf8, 0xd3, 0x8b, 0x6a, 0x4e, 0x29, 0xfa, 0xfe, 0xaf, 0xc7, 0x91, 0x7d, 0xa1,
+	0x30, 0x4f, 0x64, 0x0b, 0x7d, 0x03, 0x2b, 0x74, 0x60, 0x3a, 0x8e, 0x6a, 0x60, 0x81, 0xf7, 0xb7,
+	0xfa, 0x39, 0xb3, 0xff, 0x7e, 0x51, 0x7d, 0x6e, 0x98, 0xde, 0xee, 0xb0, 0x2f, 0x6b, 0xc4, 0x0a,
+	0x19, 0x84, 0x1f, 0x0d, 0xaa, 0xef, 0x37, 0xbd, 0x23, 0x07, 0x53, 0xb9, 0x8d, 0xb5, 0xf3, 0x93,
+	0x06, 0x84, 0x6b, 0xb5, 0xb1, 0xa6, 0xc4, 0xd9, 0x90, 0x08, 0x2b, 0x3a, 0x56, 0xf5, 0x81, 0x69,
+	0x63, 0x61, 0xa9, 0xc6, 0xd5, 0x0b, 0x4a, 0xfc, 0xfc, 0x19, 0x7f, 0xfc, 0x63, 0x35, 0xb7, 0x56,
+	0x01, 0x34, 0xa6, 0xa6, 0x60, 0xea, 0x10, 0x9b, 0xe2, 0xb5, 0x9f, 0xf2, 0x50, 0xee, 0x52, 0xe3,
+	0x6b, 0xd3, 0xdb, 0xd5, 0x5d, 0xf5, 0x00, 0x7d, 0x0c, 0xfc, 0x8e, 0x4b, 0xac, 0x1b, 0x41, 0xfa,
+	0x5e, 0xa8, 0x03, 0x45, 0xba, 0xab, 0xba, 0x98, 0xfa, 0x08, 0x4b, 0x2d, 0x79, 0x8e, 0xd3, 0x6c,
+	0xd8, 0x9e, 0x12, 0x46, 0xa3, 0x2f, 0xa0, 0x6c, 0x99, 0x76, 0x2f, 0xd2, 0x23, 0x23, 0xd5, 0x92,
+	0x65, 0xda, 0xdb, 0x81, 0x24, 0x13, 0x09, 0xfa, 0x02, 0x3f, 0x67, 0x82, 0x56, 0x06, 0x7e, 0x8f,
+	0xe0, 0xfd, 0x2b, 0xa0, 0x62, 0x80, 0xbf, 0xe6, 0xe1, 0x51, 0x97, 0x1a, 0x5b, 0x07, 0xaa, 0xf3,
+	0xf6, 0x50, 0xd5, 0xbc, 0x0e, 0x71, 0xfd, 0x94, 0x94, 0x15, 0xa6, 0x8b, 0xbf, 0x1b, 0x62, 0xea,
+	0xe1, 0x0c, 0x85, 0x19, 0xbb, 0xa2, 0x37, 0xf0, 0x00, 0xb3, 0x4c, 0xbd, 0x39, 0xcb, 0xb3, 0xec,
+	0x47, 0x6d, 0xdf, 0xe7, 0x1a, 0xad, 0xc2, 0x6a, 0x22, 0xcb, 0x24, 0xda, 0x1d, 0xe2, 0xbe, 0x8d,
+	0x0f, 0xbc, 0x38, 0xed, 0xc5, 0xaf, 0x81, 0x29, 0x9d, 0x32, 0x83, 0xbe, 0xa2, 0xd3, 0x5d, 0xa1,
+	0x3d, 0xc9, 0x32, 0xa6, 0xfd, 0x77, 0xfe, 0x1a, 0x3d, 0xba, 0xc3, 0x81, 0x67, 0x7e, 0x49, 0x9c,
+	0xfb, 0x5a, 0xe3, 0x15, 0x58, 0x72, 0x08, 0x19, 0x50, 0x81, 0xaf, 0x15, 0xea, 0x25, 0x25, 0x78,
+	0x98, 0xd0, 0x62, 0xe9, 0x7f, 0xd3, 0xa2, 0x98, 0xa8, 0xc5, 0x07, 0xf0, 0x2c, 0x95, 0x74, 0x92,
+	0x26, 0x93, 0xaa, 0xdd, 0x5a, 0x93, 0x77, 0xfc, 0x4f, 0xb8, 0xcb, 0x9a, 0x24, 0x93, 0x8e, 0x35,
+	0xf9, 0x25, 0x0f, 0x0f, 0xc7, 0xbd, 0x75, 0x9b, 0x7c, 0x45, 0xc8, 0x60, 0xe1, 0xb9, 0xe4, 0x29,
+	0x2c, 0xb3, 0xa3, 0xf7, 0x4c, 0x3d, 0x6c, 0xaa, 0x30, 0xba, 0xa8, 0x16, 0x59, 0xca, 0x8d, 0xb6,
+	0x52, 0x64, 0xa6, 0x0d, 0x1d, 0x69, 0x10, 0xb0, 0xa7, 0x42, 0xa1, 0x56, 0x48, 0x47, 0xbd, 0xce,
+	0x78, 0xfd, 0xfc, 0x67, 0xb5, 0x9e, 0x81, 0x17, 0x0b, 0xa0, 0xa1, 0xac, 0x14, 0x75, 0x01, 0x58,
+	0x53, 0x0d, 0x3b, 0x3c, 0xbf, 0x50, 0x87, 0x67, 0x2d, 0x76, 0x2b, 0x68, 0xf2, 0x37, 0x5f, 0x48,
+	0x22, 0x08, 0xd3, 0x18, 0x63, 0xc6, 0x7f, 0xe4, 0x27, 0xfa, 0x6f, 0xc7, 0x25, 0x96, 0x8f, 0x79,
+	0xbe, 0x81, 0x25, 0x13, 0xdc, 0xf1, 0x54, 0x53, 0xb8, 0xd5, 0x54, 0xb3, 0x17, 0xf0, 0x0b, 0x85,
+	0xe2, 0xff, 0x7b, 0xa1, 0xe2, 0xf9, 0x85, 0xa2, 0x27, 0xac, 0xda, 0x6c, 0x62, 0xf5, 0xc8, 0xd0,
+	0x0b, 0xfe, 0x28, 0x8c, 0xae, 0x4d, 0xac, 0xcd, 0xa1, 0x97, 0xa1, 0xd4, 0x57, 0xe1, 0x49, 0x02,
+	0xdc, 0x08, 0xfe, 0xcb, 0x7f, 0x8a, 0x50, 0xe8, 0x52, 0x03, 0x6d, 0xc2, 0x72, 0x34, 0x76, 0xaf,
+	0xca, 0x33, 0xa3, 0xbe, 0x3c, 0x16, 0x4f, 0x7c, 0x96, 0x6a, 0x8e, 0x12, 0x23, 0x05, 0x56, 0xe2,
+	0xd1, 0x53, 0x4a, 0x0e, 0x89, 0xec, 0xe2, 0xf3, 0x74, 0x7b, 0x9c, 0xd3, 0x01, 0x94, 0x30, 0x8d,
+	0xd5, 0x93, 0xa3, 0x67, 0x3d, 0xc5, 0xf5, 0xac, 0x9e, 0xd3, 0x2b, 0x4e, 0x4d, 0x24, 0x29, 0x2b,
+	0x4e, 0x7a, 0x8a, 0xeb, 0x59, 0x3d, 0xe3, 0x15, 0x8f, 0x39, 0x10, 0x53, 0xda, 0x72, 0xe6, 0x23,
+	0x44, 0x11, 0xe2, 0xab, 0x79, 0x23, 0x66, 0xb6, 0x72, 0x4d, 0x37, 0xca, 0x7c, 0xb6, 0x2c, 0x5b,
+	0x49, 0xbf, 0x87, 0x91, 0x0a, 0x0f, 0x26, 0xef, 0xe0, 0xa7, 0xa9, 0x55, 0x18, 0x38, 0x89, 0x1f,
+	0x65, 0x70, 0x8a, 0x97, 0xd8, 0x83, 0x87, 0x33, 0x57, 0xd0, 0x0d, 0x85, 0x19, 0xf9, 0x89, 0x72,
+	0x36, 0xbf, 0x68, 0xad, 0x56, 0xfb, 0x74, 0x24, 0x71, 0x67, 0x23, 0x89, 0xfb, 0x6b, 0x24, 0x71,
+	0x3f, 0x5c, 0x4a, 0xb9, 0xb3, 0x4b, 0x29, 0xf7, 0xdb, 0xa5, 0x94, 0xfb, 0xf6, 0xc3, 0x2b, 0x57,
+	0x84, 0x83, 0x5d, 0x8d, 0x50, 0x93, 0x36, 0x06, 0x6a, 0x9f, 0x36, 0xfd, 0x77, 0xf3, 0xc3, 0xe0,
+	0xed, 0xdc, 0xbf, 0x2a, 0xfa, 0x45, 0xff, 0xad, 0xf9, 0x93, 0x7f, 0x07, 0x00, 0x44, 0x06, 0xef,
+	0xe2, 0xb7, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -446,6 +822,18 @@ type MsgClient interface {
 	SwapExactForTokens(ctx context.Context, in *MsgSwapExactForTokens, opts ...grpc.CallOption) (*MsgSwapExactForTokensResponse, error)
 	// SwapForExactTokens represents a message for trading coinA for an exact coinB
 	SwapForExactTokens(ctx context.Context, in *MsgSwapForExactTokens, opts ...grpc.CallOption) (*MsgSwapForExactTokensResponse, error)
+	// SwapExactForTokensMultiHop represents a message for trading exact coinA for
+	// coinB through a route of pools
+	SwapExactForTokensMultiHop(ctx context.Context, in *MsgSwapExactForTokensMultiHop, opts ...grpc.CallOption) (*MsgSwapExactForTokensMultiHopResponse, error)
+	// SwapForExactTokensMultiHop represents a message for trading coinA for an
+	// exact coinB through a route of pools
+	SwapForExactTokensMultiHop(ctx context.Context, in *MsgSwapForExactTokensMultiHop, opts ...grpc.CallOption) (*MsgSwapForExactTokensMultiHopResponse, error)
+	// DepositToPool defines a method for depositing liquidity into a pool by id,
+	// including single-sided deposits into weighted pools
+	DepositToPool(ctx context.Context, in *MsgDepositToPool, opts ...grpc.CallOption) (*MsgDepositToPoolResponse, error)
+	// WithdrawFromPool defines a method for withdrawing liquidity from a pool by
+	// id, including single-sided withdraws from weighted pools
+	WithdrawFromPool(ctx context.Context, in *MsgWithdrawFromPool, opts ...grpc.CallOption) (*MsgWithdrawFromPoolResponse, error)
 }
 
 type msgClient struct {
@@ -492,6 +880,42 @@ func (c *msgClient) SwapForExactTokens(ctx context.Context, in *MsgSwapForExactT
 	return out, nil
 }
 
+func (c *msgClient) SwapExactForTokensMultiHop(ctx context.Context, in *MsgSwapExactForTokensMultiHop, opts ...grpc.CallOption) (*MsgSwapExactForTokensMultiHopResponse, error) {
+	out := new(MsgSwapExactForTokensMultiHopResponse)
+	err := c.cc.Invoke(ctx, "/fury.swap.v1beta1.Msg/SwapExactForTokensMultiHop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapForExactTokensMultiHop(ctx context.Context, in *MsgSwapForExactTokensMultiHop, opts ...grpc.CallOption) (*MsgSwapForExactTokensMultiHopResponse, error) {
+	out := new(MsgSwapForExactTokensMultiHopResponse)
+	err := c.cc.Invoke(ctx, "/fury.swap.v1beta1.Msg/SwapForExactTokensMultiHop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositToPool(ctx context.Context, in *MsgDepositToPool, opts ...grpc.CallOption) (*MsgDepositToPoolResponse, error) {
+	out := new(MsgDepositToPoolResponse)
+	err := c.cc.Invoke(ctx, "/fury.swap.v1beta1.Msg/DepositToPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromPool(ctx context.Context, in *MsgWithdrawFromPool, opts ...grpc.CallOption) (*MsgWithdrawFromPoolResponse, error) {
+	out := new(MsgWithdrawFromPoolResponse)
+	err := c.cc.Invoke(ctx, "/fury.swap.v1beta1.Msg/WithdrawFromPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing liquidity into a pool
@@ -502,6 +926,18 @@ type MsgServer interface {
 	SwapExactForTokens(context.Context, *MsgSwapExactForTokens) (*MsgSwapExactForTokensResponse, error)
 	// SwapForExactTokens represents a message for trading coinA for an exact coinB
 	SwapForExactTokens(context.Context, *MsgSwapForExactTokens) (*MsgSwapForExactTokensResponse, error)
+	// SwapExactForTokensMultiHop represents a message for trading exact coinA for
+	// coinB through a route of pools
+	SwapExactForTokensMultiHop(context.Context, *MsgSwapExactForTokensMultiHop) (*MsgSwapExactForTokensMultiHopResponse, error)
+	// SwapForExactTokensMultiHop represents a message for trading coinA for an
+	// exact coinB through a route of pools
+	SwapForExactTokensMultiHop(context.Context, *MsgSwapForExactTokensMultiHop) (*MsgSwapForExactTokensMultiHopResponse, error)
+	// DepositToPool defines a method for depositing liquidity into a pool by id,
+	// including single-sided deposits into weighted pools
+	DepositToPool(context.Context, *MsgDepositToPool) (*MsgDepositToPoolResponse, error)
+	// WithdrawFromPool defines a method for withdrawing liquidity from a pool by
+	// id, including single-sided withdraws from weighted pools
+	WithdrawFromPool(context.Context, *MsgWithdrawFromPool) (*MsgWithdrawFromPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -520,6 +956,18 @@ func (*UnimplementedMsgServer) SwapExactForTokens(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SwapForExactTokens(ctx context.Context, req *MsgSwapForExactTokens) (*MsgSwapForExactTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapForExactTokens not implemented")
 }
+func (*UnimplementedMsgServer) SwapExactForTokensMultiHop(ctx context.Context, req *MsgSwapExactForTokensMultiHop) (*MsgSwapExactForTokensMultiHopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactForTokensMultiHop not implemented")
+}
+func (*UnimplementedMsgServer) SwapForExactTokensMultiHop(ctx context.Context, req *MsgSwapForExactTokensMultiHop) (*MsgSwapForExactTokensMultiHopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapForExactTokensMultiHop not implemented")
+}
+func (*UnimplementedMsgServer) DepositToPool(ctx context.Context, req *MsgDepositToPool) (*MsgDepositToPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToPool not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromPool(ctx context.Context, req *MsgWithdrawFromPool) (*MsgWithdrawFromPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -597,43 +1045,131 @@ func _Msg_SwapForExactTokens_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fury.swap.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "SwapExactForTokens",
-			Handler:    _Msg_SwapExactForTokens_Handler,
-		},
-		{
-			MethodName: "SwapForExactTokens",
-			Handler:    _Msg_SwapForExactTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fury/swap/v1beta1/tx.proto",
-}
-
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SwapExactForTokensMultiHop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactForTokensMultiHop)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactForTokensMultiHop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.swap.v1beta1.Msg/SwapExactForTokensMultiHop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactForTokensMultiHop(ctx, req.(*MsgSwapExactForTokensMultiHop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapForExactTokensMultiHop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapForExactTokensMultiHop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapForExactTokensMultiHop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.swap.v1beta1.Msg/SwapForExactTokensMultiHop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapForExactTokensMultiHop(ctx, req.(*MsgSwapForExactTokensMultiHop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.swap.v1beta1.Msg/DepositToPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToPool(ctx, req.(*MsgDepositToPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.swap.v1beta1.Msg/WithdrawFromPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromPool(ctx, req.(*MsgWithdrawFromPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fury.swap.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "SwapExactForTokens",
+			Handler:    _Msg_SwapExactForTokens_Handler,
+		},
+		{
+			MethodName: "SwapForExactTokens",
+			Handler:    _Msg_SwapForExactTokens_Handler,
+		},
+		{
+			MethodName: "SwapExactForTokensMultiHop",
+			Handler:    _Msg_SwapExactForTokensMultiHop_Handler,
+		},
+		{
+			MethodName: "SwapForExactTokensMultiHop",
+			Handler:    _Msg_SwapForExactTokensMultiHop_Handler,
+		},
+		{
+			MethodName: "DepositToPool",
+			Handler:    _Msg_DepositToPool_Handler,
+		},
+		{
+			MethodName: "WithdrawFromPool",
+			Handler:    _Msg_WithdrawFromPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fury/swap/v1beta1/tx.proto",
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -974,148 +1510,1683 @@ func (m *MsgSwapForExactTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSwapExactForTokensMultiHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSwapExactForTokensMultiHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactForTokensMultiHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TokenA.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenB.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Slippage.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if m.Deadline != 0 {
-		n += 1 + sovTx(uint64(m.Deadline))
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.TokenB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinTokenA.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinTokenB.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Deadline != 0 {
-		n += 1 + sovTx(uint64(m.Deadline))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ExactTokenA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSwapExactForTokensMultiHopResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactForTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ExactTokenA.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenB.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Slippage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Deadline != 0 {
-		n += 1 + sovTx(uint64(m.Deadline))
-	}
-	return n
+func (m *MsgSwapExactForTokensMultiHopResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactForTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSwapExactForTokensMultiHopResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapForExactTokens) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSwapForExactTokensMultiHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapForExactTokensMultiHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapForExactTokensMultiHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Requester)
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ExactTokenB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapForExactTokensMultiHopResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapForExactTokensMultiHopResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapForExactTokensMultiHopResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinShares.Size()
+		i -= size
+		if _, err := m.MinShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DenomOut) > 0 {
+		i -= len(m.DenomOut)
+		copy(dAtA[i:], m.DenomOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DenomOut)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MinTokens) > 0 {
+		for iNdEx := len(m.MinTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.TokenA.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = m.ExactTokenB.Size()
+	l = m.TokenB.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = m.Slippage.Size()
 	n += 1 + l + sovTx(uint64(l))
 	if m.Deadline != 0 {
 		n += 1 + sovTx(uint64(m.Deadline))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinTokenA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinTokenB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapExactForTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExactTokenA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgSwapExactForTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapForExactTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExactTokenB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgSwapForExactTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapExactForTokensMultiHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExactTokenA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Slippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgSwapExactForTokensMultiHopResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapForExactTokensMultiHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExactTokenB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Slippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgSwapForExactTokensMultiHopResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositToPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinShares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgDepositToPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.MinTokens) > 0 {
+		for _, e := range m.MinTokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DenomOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokenA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokenB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactForTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactForTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExactTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactForTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactForTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactForTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapForExactTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapForExactTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExactTokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapForExactTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapForExactTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapForExactTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSwapForExactTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactForTokensMultiHop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1138,15 +3209,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactForTokensMultiHop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactForTokensMultiHop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1174,11 +3245,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenA", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1205,7 +3276,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExactTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1243,6 +3314,38 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
 			}
@@ -1276,7 +3379,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
@@ -1316,7 +3419,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactForTokensMultiHopResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,10 +3442,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactForTokensMultiHopResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactForTokensMultiHopResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1366,7 +3469,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapForExactTokensMultiHop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1389,15 +3492,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapForExactTokensMultiHop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapForExactTokensMultiHop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1425,13 +3528,13 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1441,29 +3544,28 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1490,15 +3592,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExactTokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1508,26 +3610,59 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinTokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
@@ -1567,7 +3702,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapForExactTokensMultiHopResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1590,10 +3725,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapForExactTokensMultiHopResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapForExactTokensMultiHopResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1617,7 +3752,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositToPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1640,15 +3775,15 @@ func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactForTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositToPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactForTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositToPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1676,13 +3811,13 @@ func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1692,28 +3827,27 @@ func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExactTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1740,13 +3874,14 @@ func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1774,7 +3909,7 @@ func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1818,7 +3953,7 @@ func (m *MsgSwapExactForTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactForTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositToPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,10 +3976,10 @@ func (m *MsgSwapExactForTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactForTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositToPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactForTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositToPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1868,7 +4003,7 @@ func (m *MsgSwapExactForTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,15 +4026,15 @@ func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapForExactTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapForExactTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1927,13 +4062,13 @@ func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1943,28 +4078,61 @@ func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1991,13 +4159,14 @@ func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExactTokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinTokens = append(m.MinTokens, types.Coin{})
+			if err := m.MinTokens[len(m.MinTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2025,11 +4194,9 @@ func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DenomOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
@@ -2069,7 +4236,7 @@ func (m *MsgSwapForExactTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapForExactTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2092,10 +4259,10 @@ func (m *MsgSwapForExactTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapForExactTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapForExactTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
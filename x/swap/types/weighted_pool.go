@@ -0,0 +1,530 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxPoolAssets is the largest number of assets a weighted pool can hold
+	MaxPoolAssets = 8
+	// MaxTotalWeight is the largest sum of the weights of a weighted pool
+	MaxTotalWeight uint64 = 100
+)
+
+// maxPoolAmount is the largest reserve or swap amount a weighted pool calculation can return, which is the
+// bit length of the largest sdkmath.Int
+var maxPoolAmount = new(big.Int).Lsh(big.NewInt(1), sdkmath.MaxBitLen)
+
+// WeightedPool implements a denominated weighted constant mean liquidity pool of two or more assets.
+//
+// Trades keep the weighted product of the reserves prod(x_i ^ w_i) from decreasing.  The price of each
+// asset is proportional to its weight divided by its reserves, so an 80/20 pool holds 80% of its value
+// in the first asset, and a pool of three equally weighted assets holds a third of its value in each.
+//
+// Deposits and withdraws of every asset in proportion to the reserves create and remove shares the same
+// as a constant product pool.  A single asset can also be deposited or withdrawn, which is equal to a
+// proportional deposit or withdraw and a swap of the other assets, so the swap fee is charged on the
+// implicitly swapped portion of the single asset.
+//
+// All calculations compare the weighted product of the reserves exactly, using weights divided by their
+// greatest common divisor, and are rounded in favor of the pool.
+type WeightedPool struct {
+	denoms   []string
+	reserves []sdkmath.Int
+	weights  []uint64
+	// exponents are the weights divided by their greatest common divisor
+	exponents   []uint64
+	totalShares sdkmath.Int
+}
+
+// NewWeightedPool creates a new weighted pool from sorted reserve coins and the weights of each reserve
+func NewWeightedPool(reserves sdk.Coins, weights []uint64) (*WeightedPool, error) {
+	pool, err := newWeightedPool(reserves, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.totalShares = pool.calculateInitialShares()
+
+	return pool, nil
+}
+
+// NewWeightedPoolWithExistingShares creates a weighted pool from sorted reserve coins, the weights of each
+// reserve and existing shares
+func NewWeightedPoolWithExistingShares(reserves sdk.Coins, weights []uint64, totalShares sdkmath.Int) (*WeightedPool, error) {
+	if totalShares.IsNil() || !totalShares.IsPositive() {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "total shares must be greater than zero")
+	}
+
+	pool, err := newWeightedPool(reserves, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.totalShares = totalShares
+
+	return pool, nil
+}
+
+func newWeightedPool(reserves sdk.Coins, weights []uint64) (*WeightedPool, error) {
+	if !reserves.IsValid() {
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "reserves must be sorted and greater than zero: %s", reserves)
+	}
+
+	denoms := make([]string, len(reserves))
+	amounts := make([]sdkmath.Int, len(reserves))
+	for i, coin := range reserves {
+		denoms[i] = coin.Denom
+		amounts[i] = coin.Amount
+	}
+
+	if err := validateWeightedTokens(denoms, weights); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	return &WeightedPool{
+		denoms:    denoms,
+		reserves:  amounts,
+		weights:   append([]uint64{}, weights...),
+		exponents: reduceWeights(weights),
+	}, nil
+}
+
+// Reserves returns the reserves held in the pool
+func (p *WeightedPool) Reserves() sdk.Coins {
+	coins := sdk.NewCoins()
+	for i, denom := range p.denoms {
+		coins = coins.Add(sdk.NewCoin(denom, p.reserves[i]))
+	}
+
+	return coins
+}
+
+// Weights returns the weight of each reserve, in the order of the reserve denoms
+func (p *WeightedPool) Weights() []uint64 {
+	return append([]uint64{}, p.weights...)
+}
+
+// TotalShares returns the total shares for the pool
+func (p *WeightedPool) TotalShares() sdkmath.Int {
+	return p.totalShares
+}
+
+// IsEmpty returns true if all reserves are zero
+func (p *WeightedPool) IsEmpty() bool {
+	for _, reserves := range p.reserves {
+		if !reserves.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddLiquidity adds liquidity of every asset in proportion to the reserves, returning the deposited coins
+// and the shares created.  The deposit never exceeds the provided coins, and is empty with zero shares
+// when the provided coins are too small to create a share.
+func (p *WeightedPool) AddLiquidity(deposit sdk.Coins) (sdk.Coins, sdkmath.Int) {
+	// the shares are limited by the asset with the smallest deposit ratio, deposit_i * S / R_i
+	var shares *big.Int
+	for i, denom := range p.denoms {
+		var result big.Int
+		result.Mul(deposit.AmountOf(denom).BigInt(), p.totalShares.BigInt()).Quo(&result, p.reserves[i].BigInt())
+		if shares == nil || result.Cmp(shares) < 0 {
+			shares = &result
+		}
+	}
+
+	if shares.Sign() <= 0 {
+		return sdk.NewCoins(), sdk.ZeroInt()
+	}
+
+	// each deposit is ceiled so the shares are never worth more than the deposit
+	depositAmount := sdk.NewCoins()
+	for i, denom := range p.denoms {
+		amount := ceilQuo(new(big.Int).Mul(shares, p.reserves[i].BigInt()), p.totalShares.BigInt())
+		p.reserves[i] = p.reserves[i].Add(sdkmath.NewIntFromBigInt(amount))
+		depositAmount = depositAmount.Add(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+	}
+
+	sharesInt := sdkmath.NewIntFromBigInt(shares)
+	p.totalShares = p.totalShares.Add(sharesInt)
+
+	return depositAmount, sharesInt
+}
+
+// AddSingleAssetLiquidity adds liquidity of a single asset, returning the shares created and the portion of
+// the deposit that is used to pay the fee.
+//
+// The fee is charged on the portion of the deposit that is implicitly swapped for the other assets,
+// deposit * (1 - w_i / sum(w)) * fee, and is ceiled.  The shares created are truncated so the weighted
+// product of the reserves per share never decreases.
+func (p *WeightedPool) AddSingleAssetLiquidity(deposit sdk.Coin, fee sdk.Dec) (sdkmath.Int, sdk.Coin) {
+	i := p.mustIndexOf(deposit.Denom)
+	if !deposit.Amount.IsPositive() {
+		panic("invalid value: deposit must be positive")
+	}
+	assertWeightedFeeIsValid(fee)
+
+	feeValue := p.impliedSwapFee(i, deposit.Amount, fee)
+	depositAfterFee := deposit.Amount.Sub(feeValue)
+
+	// the shares s are the largest where (S + s)^E * R_i^e_i <= S^E * (R_i + deposit)^e_i
+	totalExponent := p.totalExponent()
+	totalShares := p.totalShares.BigInt()
+	reserves := p.reserves[i].BigInt()
+	newReserves := new(big.Int).Add(reserves, depositAfterFee.BigInt())
+
+	var limit big.Int
+	limit.Mul(pow(totalShares, totalExponent), pow(newReserves, p.exponents[i]))
+	reservesPow := pow(reserves, p.exponents[i])
+
+	// since e_i <= E, the shares are never more than S * deposit / R_i
+	var maxShares big.Int
+	maxShares.Mul(totalShares, depositAfterFee.BigInt()).Quo(&maxShares, reserves)
+
+	shares := searchLargest(big.NewInt(0), &maxShares, func(s *big.Int) bool {
+		var value big.Int
+		value.Add(totalShares, s)
+		value.Mul(pow(&value, totalExponent), reservesPow)
+		return value.Cmp(&limit) <= 0
+	})
+
+	sharesInt := sdkmath.NewIntFromBigInt(shares)
+	p.reserves[i] = p.reserves[i].Add(deposit.Amount)
+	p.totalShares = p.totalShares.Add(sharesInt)
+
+	return sharesInt, sdk.NewCoin(deposit.Denom, feeValue)
+}
+
+// RemoveLiquidity removes liquidity of every asset in proportion to the reserves and panics if the shares
+// provided are greater than the total shares of the pool or the shares are not positive.
+func (p *WeightedPool) RemoveLiquidity(shares sdkmath.Int) sdk.Coins {
+	withdrawAmount := p.ShareValue(shares)
+
+	for i, denom := range p.denoms {
+		p.reserves[i] = p.reserves[i].Sub(withdrawAmount.AmountOf(denom))
+	}
+	p.totalShares = p.totalShares.Sub(shares)
+
+	return withdrawAmount
+}
+
+// RemoveSingleAssetLiquidity removes liquidity of a single asset, returning the withdrawn coin and the
+// portion of the withdraw that is used to pay the fee.  Panics if the shares are not positive or are not
+// less than the total shares of the pool, since the last shares must be withdrawn proportionally.
+//
+// The withdraw before fees is truncated so the weighted product of the reserves per share never decreases.
+// The fee is charged on the portion of the withdraw that is implicitly swapped from the other assets,
+// withdraw * (1 - w_i / sum(w)) * fee, and is ceiled.
+func (p *WeightedPool) RemoveSingleAssetLiquidity(shares sdkmath.Int, denomOut string, fee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	i := p.mustIndexOf(denomOut)
+	if !shares.IsPositive() {
+		panic("invalid value: shares must be positive")
+	}
+	if shares.GTE(p.totalShares) {
+		panic(fmt.Sprintf("out of bounds: single asset withdraw of shares %s >= total shares %s", shares, p.totalShares))
+	}
+	assertWeightedFeeIsValid(fee)
+
+	// the withdraw a is the largest where S^E * (R_i - a)^e_i >= (S - s)^E * R_i^e_i
+	totalExponent := p.totalExponent()
+	reserves := p.reserves[i].BigInt()
+	totalSharesPow := pow(p.totalShares.BigInt(), totalExponent)
+
+	var limit big.Int
+	limit.Mul(pow(p.totalShares.Sub(shares).BigInt(), totalExponent), pow(reserves, p.exponents[i]))
+
+	maxWithdraw := new(big.Int).Sub(reserves, big.NewInt(1))
+	withdraw := searchLargest(big.NewInt(0), maxWithdraw, func(a *big.Int) bool {
+		var value big.Int
+		value.Sub(reserves, a)
+		value.Mul(pow(&value, p.exponents[i]), totalSharesPow)
+		return value.Cmp(&limit) >= 0
+	})
+
+	withdrawInt := sdkmath.NewIntFromBigInt(withdraw)
+	feeValue := sdkmath.MinInt(p.impliedSwapFee(i, withdrawInt, fee), withdrawInt)
+	withdrawAfterFee := withdrawInt.Sub(feeValue)
+
+	p.reserves[i] = p.reserves[i].Sub(withdrawAfterFee)
+	p.totalShares = p.totalShares.Sub(shares)
+
+	return sdk.NewCoin(denomOut, withdrawAfterFee), sdk.NewCoin(denomOut, feeValue)
+}
+
+// ShareValue returns the value of the provided shares and panics if the shares are greater than the
+// total shares of the pool or if the shares are not positive.
+func (p *WeightedPool) ShareValue(shares sdkmath.Int) sdk.Coins {
+	if !shares.IsPositive() {
+		panic("invalid value: shares must be positive")
+	}
+	if shares.GT(p.totalShares) {
+		panic(fmt.Sprintf("out of bounds: shares %s > total shares %s", shares, p.totalShares))
+	}
+
+	value := sdk.NewCoins()
+	for i, denom := range p.denoms {
+		var result big.Int
+		result.Mul(p.reserves[i].BigInt(), shares.BigInt()).Quo(&result, p.totalShares.BigInt())
+		value = value.Add(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(&result)))
+	}
+
+	return value
+}
+
+// SwapWithExactInputTo trades an exact input coin for denomOut.  Returns the positive output coin that is
+// removed from the pool and the portion of the input coin that is used for the fee.  Panics if either
+// denom does not match the pool reserves.
+//
+// The fee is ceiled the same as a constant product pool, and the output is truncated so the weighted
+// product of the reserves never decreases.
+func (p *WeightedPool) SwapWithExactInputTo(swapInput sdk.Coin, denomOut string, fee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	in, out := p.mustIndexesOf(swapInput.Denom, denomOut)
+	if !swapInput.Amount.IsPositive() {
+		panic("invalid value: swap input must be positive")
+	}
+	assertWeightedFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(swapInput.Amount).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+	feeValue := swapInput.Amount.Sub(inAfterFee)
+
+	invariant := p.pairInvariant(in, p.reserves[in].BigInt(), out, p.reserves[out].BigInt())
+	newInReserves := p.reserves[in].Add(inAfterFee).BigInt()
+	outReserves := p.reserves[out].BigInt()
+
+	maxOutput := new(big.Int).Sub(outReserves, big.NewInt(1))
+	output := searchLargest(big.NewInt(0), maxOutput, func(o *big.Int) bool {
+		newOutReserves := new(big.Int).Sub(outReserves, o)
+		return p.pairInvariant(in, newInReserves, out, newOutReserves).Cmp(invariant) >= 0
+	})
+	outputInt := sdkmath.NewIntFromBigInt(output)
+
+	p.assertInvariantAndUpdateReserves(in, p.reserves[in].Add(swapInput.Amount), feeValue, out, p.reserves[out].Sub(outputInt))
+
+	return sdk.NewCoin(denomOut, outputInt), sdk.NewCoin(swapInput.Denom, feeValue)
+}
+
+// SwapWithExactOutputFrom trades denomIn for an exact output coin.  Returns the positive input coin that
+// is added to the pool and the portion of that input that is used to pay the fee.  Panics if either denom
+// does not match the pool reserves, or the output is not less than the reserves.
+//
+// The input is ceiled so the weighted product of the reserves never decreases, and the fee is ceiled the
+// same as a constant product pool.
+func (p *WeightedPool) SwapWithExactOutputFrom(denomIn string, swapOutput sdk.Coin, fee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	in, out := p.mustIndexesOf(denomIn, swapOutput.Denom)
+	if !swapOutput.Amount.IsPositive() {
+		panic("invalid value: swap output must be positive")
+	}
+	if swapOutput.Amount.GTE(p.reserves[out]) {
+		panic("invalid value: swap output must be less than reserves")
+	}
+	assertWeightedFeeIsValid(fee)
+
+	invariant := p.pairInvariant(in, p.reserves[in].BigInt(), out, p.reserves[out].BigInt())
+	inReserves := p.reserves[in].BigInt()
+	newOutReserves := p.reserves[out].Sub(swapOutput.Amount).BigInt()
+	pred := func(x *big.Int) bool {
+		newInReserves := new(big.Int).Add(inReserves, x)
+		return p.pairInvariant(in, newInReserves, out, newOutReserves).Cmp(invariant) >= 0
+	}
+
+	// double the upper bound until it is large enough, panicking before the input overflows
+	maxInput := new(big.Int).Set(inReserves)
+	for !pred(maxInput) {
+		if maxInput.Cmp(maxPoolAmount) > 0 {
+			panic("overflow: swap input is larger than the max pool amount")
+		}
+		maxInput.Lsh(maxInput, 1)
+	}
+	inWithoutFee := sdkmath.NewIntFromBigInt(searchSmallest(big.NewInt(1), maxInput, pred))
+
+	swapInput := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := swapInput.Sub(inWithoutFee)
+
+	p.assertInvariantAndUpdateReserves(in, p.reserves[in].Add(swapInput), feeValue, out, p.reserves[out].Sub(swapOutput.Amount))
+
+	return sdk.NewCoin(denomIn, swapInput), sdk.NewCoin(denomIn, feeValue)
+}
+
+// SpotPrice returns the marginal price of the base denom in units of the quote denom, which is the ratio of
+// the quote reserves to the base reserves scaled by their weights, (x_q / w_q) / (x_b / w_b).  The price does
+// not include the swap fee.  Panics if the denoms are equal or do not match the pool reserves.
+func (p *WeightedPool) SpotPrice(base, quote string) sdk.Dec {
+	b, q := p.mustIndexesOf(base, quote)
+
+	var num, den big.Int
+	num.Mul(p.reserves[q].BigInt(), new(big.Int).SetUint64(p.exponents[b]))
+	den.Mul(p.reserves[b].BigInt(), new(big.Int).SetUint64(p.exponents[q]))
+
+	return priceFromRatio(&num, &den)
+}
+
+// calculateInitialShares calculates initial shares as the weighted geometric mean of the reserves,
+// prod(R_i ^ e_i) ^ (1 / E)
+func (p *WeightedPool) calculateInitialShares() sdkmath.Int {
+	product := big.NewInt(1)
+	for i, reserves := range p.reserves {
+		product.Mul(product, pow(reserves.BigInt(), p.exponents[i]))
+	}
+
+	return sdkmath.NewIntFromBigInt(nthRoot(product, p.totalExponent()))
+}
+
+// impliedSwapFee returns the fee on the portion of a single asset deposit or withdraw that is implicitly
+// swapped for the other assets of the pool, amount * (1 - e_i / E) * fee, ceiled
+func (p *WeightedPool) impliedSwapFee(i int, amount sdkmath.Int, fee sdk.Dec) sdkmath.Int {
+	totalExponent := p.totalExponent()
+	swappedPortion := sdk.NewDec(int64(totalExponent - p.exponents[i])).QuoInt64(int64(totalExponent))
+
+	return sdk.NewDecFromInt(amount).Mul(swappedPortion).Mul(fee).Ceil().TruncateInt()
+}
+
+// pairInvariant returns the part of the weighted product of the reserves that changes in a swap between
+// two assets, x^e_i * y^e_j
+func (p *WeightedPool) pairInvariant(i int, x *big.Int, j int, y *big.Int) *big.Int {
+	return new(big.Int).Mul(pow(x, p.exponents[i]), pow(y, p.exponents[j]))
+}
+
+// assertInvariantAndUpdateReserves asserts the weighted product of the swapped reserves does not decrease,
+// subtracting the fee from the input first, then updates the pool reserves.  Panics if the invariant is
+// violated, which is a bug.
+func (p *WeightedPool) assertInvariantAndUpdateReserves(in int, newInReserves, feeIn sdkmath.Int, out int, newOutReserves sdkmath.Int) {
+	invariant := p.pairInvariant(in, p.reserves[in].BigInt(), out, p.reserves[out].BigInt())
+	newInvariant := p.pairInvariant(in, newInReserves.Sub(feeIn).BigInt(), out, newOutReserves.BigInt())
+
+	if invariant.Cmp(newInvariant) == 1 {
+		panic(fmt.Sprintf("invalid state: invariant %s decreased to %s", invariant, newInvariant))
+	}
+
+	p.reserves[in] = newInReserves
+	p.reserves[out] = newOutReserves
+}
+
+// totalExponent returns the sum of the reduced weights
+func (p *WeightedPool) totalExponent() uint64 {
+	var total uint64
+	for _, exponent := range p.exponents {
+		total += exponent
+	}
+
+	return total
+}
+
+// mustIndexOf returns the index of a reserve denom and panics if the denom does not match the pool reserves
+func (p *WeightedPool) mustIndexOf(denom string) int {
+	for i, d := range p.denoms {
+		if d == denom {
+			return i
+		}
+	}
+
+	panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", denom))
+}
+
+// mustIndexesOf returns the indexes of two different reserve denoms and panics if they are equal or do
+// not match the pool reserves
+func (p *WeightedPool) mustIndexesOf(denomA, denomB string) (int, int) {
+	if denomA == denomB {
+		panic(fmt.Sprintf("invalid denomination: can not swap denom '%s' for itself", denomA))
+	}
+
+	return p.mustIndexOf(denomA), p.mustIndexOf(denomB)
+}
+
+// assertWeightedFeeIsValid panics if the fee is not between 0 and 1
+func assertWeightedFeeIsValid(fee sdk.Dec) {
+	if fee.IsNegative() || fee.GTE(sdk.OneDec()) {
+		panic("invalid value: fee must be between 0 and 1")
+	}
+}
+
+// reduceWeights returns weights divided by their greatest common divisor
+func reduceWeights(weights []uint64) []uint64 {
+	var divisor uint64
+	for _, weight := range weights {
+		a, b := divisor, weight
+		for b != 0 {
+			a, b = b, a%b
+		}
+		divisor = a
+	}
+
+	exponents := make([]uint64, len(weights))
+	for i, weight := range weights {
+		exponents[i] = weight / divisor
+	}
+
+	return exponents
+}
+
+// pow returns x^e
+func pow(x *big.Int, e uint64) *big.Int {
+	return new(big.Int).Exp(x, new(big.Int).SetUint64(e), nil)
+}
+
+// ceilQuo returns x / y, ceiled
+func ceilQuo(x, y *big.Int) *big.Int {
+	var quo, rem big.Int
+	quo.QuoRem(x, y, &rem)
+	if rem.Sign() != 0 {
+		quo.Add(&quo, big.NewInt(1))
+	}
+
+	return &quo
+}
+
+// nthRoot returns the largest integer r where r^n <= x, built one bit at a time
+func nthRoot(x *big.Int, n uint64) *big.Int {
+	root := new(big.Int)
+	for bit := x.BitLen()/int(n) + 1; bit >= 0; bit-- {
+		candidate := new(big.Int).SetBit(root, bit, 1)
+		if pow(candidate, n).Cmp(x) <= 0 {
+			root = candidate
+		}
+	}
+
+	return root
+}
+
+// searchLargest returns the largest value in [lo, hi] where pred is true, for a pred that is true at lo and
+// stays true up to some value, then stays false
+func searchLargest(lo, hi *big.Int, pred func(*big.Int) bool) *big.Int {
+	lo, hi = new(big.Int).Set(lo), new(big.Int).Set(hi)
+	one := big.NewInt(1)
+	for lo.Cmp(hi) < 0 {
+		// mid = ceil((lo + hi) / 2), so mid > lo and the search always narrows
+		mid := new(big.Int).Add(lo, hi)
+		mid.Add(mid, one).Rsh(mid, 1)
+		if pred(mid) {
+			lo = mid
+		} else {
+			hi = mid.Sub(mid, one)
+		}
+	}
+
+	return lo
+}
+
+// searchSmallest returns the smallest value in [lo, hi] where pred is true, for a pred that is true at hi
+// and stays true down to some value, then stays false
+func searchSmallest(lo, hi *big.Int, pred func(*big.Int) bool) *big.Int {
+	lo, hi = new(big.Int).Set(lo), new(big.Int).Set(hi)
+	one := big.NewInt(1)
+	for lo.Cmp(hi) < 0 {
+		mid := new(big.Int).Add(lo, hi)
+		mid.Rsh(mid, 1)
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid.Add(mid, one)
+		}
+	}
+
+	return hi
+}
@@ -0,0 +1,247 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/percosis-labs/fury/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		reserves    sdk.Coins
+		weights     []uint64
+		expectedErr string
+	}{
+		{"single asset", sdk.NewCoins(ufury(1e6)), []uint64{100}, "weighted pool must have between 2 and 8 tokens, received 1: invalid pool"},
+		{"missing weight", sdk.NewCoins(ufury(1e6), usdf(1e6)), []uint64{80}, "weighted pool must have one weight per token, received 2 tokens and 1 weights: invalid pool"},
+		{"zero weight", sdk.NewCoins(ufury(1e6), usdf(1e6)), []uint64{80, 0}, "weight of token 'usdf' must be positive: invalid pool"},
+		{"total weight too large", sdk.NewCoins(ufury(1e6), usdf(1e6)), []uint64{80, 21}, "total weight must not exceed 100: invalid pool"},
+		{"equal two asset weights", sdk.NewCoins(ufury(1e6), usdf(1e6)), []uint64{50, 50}, "two token pool with equal weights must use POOL_TYPE_CONSTANT_PRODUCT: invalid pool"},
+		{"zero reserves", sdk.Coins{ufury(0), usdf(1e6)}, []uint64{80, 20}, "reserves must be sorted and greater than zero: 0ufury,1000000usdf: invalid pool"},
+		{"unsorted reserves", sdk.Coins{usdf(1e6), ufury(1e6)}, []uint64{80, 20}, "reserves must be sorted and greater than zero: 1000000usdf,1000000ufury: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool, err := types.NewWeightedPool(tc.reserves, tc.weights)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+
+			pool, err = types.NewWeightedPoolWithExistingShares(tc.reserves, tc.weights, i(1e6))
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+
+	pool, err := types.NewWeightedPoolWithExistingShares(sdk.NewCoins(ufury(1e6), usdf(1e6)), []uint64{80, 20}, i(0))
+	require.EqualError(t, err, "total shares must be greater than zero: invalid pool")
+	assert.Nil(t, pool)
+}
+
+func TestWeightedPool_InitialShares(t *testing.T) {
+	testCases := []struct {
+		reserves       sdk.Coins
+		weights        []uint64
+		expectedShares sdkmath.Int
+	}{
+		{sdk.NewCoins(ufury(800e6), usdf(200e6)), []uint64{80, 20}, i(606286626)},
+		{sdk.NewCoins(ufury(800e6), usdf(200e6)), []uint64{4, 1}, i(606286626)},
+		{sdk.NewCoins(jinx(100e6), ufury(100e6), usdf(400e6)), []uint64{1, 1, 1}, i(158740105)},
+		{sdk.NewCoins(jinx(100e6), ufury(100e6), usdf(400e6)), []uint64{30, 30, 30}, i(158740105)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reserves=%s weights=%v", tc.reserves, tc.weights), func(t *testing.T) {
+			pool, err := types.NewWeightedPool(tc.reserves, tc.weights)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.reserves, pool.Reserves())
+			assert.Equal(t, tc.weights, pool.Weights())
+			assert.Equal(t, tc.expectedShares, pool.TotalShares())
+			assert.False(t, pool.IsEmpty())
+		})
+	}
+}
+
+func TestWeightedPool_Swap(t *testing.T) {
+	reserves8020 := sdk.NewCoins(ufury(800e6), usdf(200e6))
+	reserves3 := sdk.NewCoins(jinx(100e6), ufury(100e6), usdf(400e6))
+
+	testCases := []struct {
+		name           string
+		reserves       sdk.Coins
+		weights        []uint64
+		exactInput     bool
+		amount         sdk.Coin
+		denom          string
+		expectedAmount sdk.Coin
+		expectedFee    sdk.Coin
+	}{
+		{"80/20 exact input of heavy asset", reserves8020, []uint64{80, 20}, true, ufury(10e6), "usdf", usdf(9666949), ufury(30000)},
+		{"80/20 exact input of light asset", reserves8020, []uint64{80, 20}, true, usdf(10e6), "ufury", ufury(9670534), usdf(30000)},
+		{"80/20 exact output of light asset", reserves8020, []uint64{80, 20}, false, usdf(10e6), "ufury", ufury(10355784), ufury(31068)},
+		{"80/20 exact output of heavy asset", reserves8020, []uint64{80, 20}, false, ufury(10e6), "usdf", usdf(10351542), usdf(31055)},
+		{"three asset exact input", reserves3, []uint64{1, 1, 1}, true, jinx(1e6), "usdf", usdf(3948632), jinx(3000)},
+		{"three asset exact output", reserves3, []uint64{1, 1, 1}, false, usdf(4e6), "ufury", ufury(1013142), ufury(3040)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool, err := types.NewWeightedPool(tc.reserves, tc.weights)
+			require.NoError(t, err)
+
+			var input, output, feePaid sdk.Coin
+			if tc.exactInput {
+				input = tc.amount
+				output, feePaid = pool.SwapWithExactInputTo(input, tc.denom, d("0.003"))
+				assert.Equal(t, tc.expectedAmount, output)
+			} else {
+				output = tc.amount
+				input, feePaid = pool.SwapWithExactOutputFrom(tc.denom, output, d("0.003"))
+				assert.Equal(t, tc.expectedAmount, input)
+			}
+			assert.Equal(t, tc.expectedFee, feePaid)
+
+			expectedReserves := tc.reserves.Add(input).Sub(output)
+			assert.Equal(t, expectedReserves, pool.Reserves())
+			assert.Equal(t, tc.reserves, pool.Reserves().Sub(input).Add(output))
+		})
+	}
+}
+
+func TestWeightedPool_Liquidity_Proportional(t *testing.T) {
+	reserves := sdk.NewCoins(jinx(100e6), ufury(100e6), usdf(400e6))
+	pool, err := types.NewWeightedPool(reserves, []uint64{1, 1, 1})
+	require.NoError(t, err)
+	totalShares := pool.TotalShares()
+
+	// the deposit is limited by the smallest ratio of the reserves, and is ceiled
+	deposit, shares := pool.AddLiquidity(sdk.NewCoins(jinx(10e6), ufury(20e6), usdf(40e6)))
+	assert.Equal(t, i(15874010), shares)
+	assert.Equal(t, sdk.NewCoins(jinx(10e6), ufury(10e6), usdf(39999999)), deposit)
+	assert.Equal(t, reserves.Add(deposit...), pool.Reserves())
+	assert.Equal(t, totalShares.Add(shares), pool.TotalShares())
+
+	// a deposit missing an asset creates no shares
+	deposit, shares = pool.AddLiquidity(sdk.NewCoins(jinx(10e6), ufury(10e6)))
+	assert.Equal(t, sdk.NewCoins(), deposit)
+	assert.Equal(t, "0", shares.String())
+
+	// the withdraw is truncated
+	withdraw := pool.RemoveLiquidity(i(15874010))
+	assert.Equal(t, sdk.NewCoins(jinx(9999999), ufury(9999999), usdf(39999998)), withdraw)
+	assert.Equal(t, totalShares, pool.TotalShares())
+
+	withdraw = pool.RemoveLiquidity(pool.TotalShares())
+	assert.Equal(t, reserves.Add(jinx(1), ufury(1), usdf(1)), withdraw)
+	assert.True(t, pool.IsEmpty())
+}
+
+func TestWeightedPool_Liquidity_SingleAsset(t *testing.T) {
+	testCases := []struct {
+		name           string
+		denom          string
+		expectedShares sdkmath.Int
+		expectedFee    sdkmath.Int
+	}{
+		// the fee is charged on the 80% of a light asset deposit that is implicitly swapped
+		{"light asset", "usdf", i(5931129), i(24000)},
+		// the fee is charged on the 20% of a heavy asset deposit that is implicitly swapped
+		{"heavy asset", "ufury", i(6051696), i(6000)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reserves := sdk.NewCoins(ufury(800e6), usdf(200e6))
+			pool, err := types.NewWeightedPool(reserves, []uint64{80, 20})
+			require.NoError(t, err)
+			totalShares := pool.TotalShares()
+
+			deposit := sdk.NewCoin(tc.denom, i(10e6))
+			shares, fee := pool.AddSingleAssetLiquidity(deposit, d("0.003"))
+			assert.Equal(t, tc.expectedShares, shares)
+			assert.Equal(t, sdk.NewCoin(tc.denom, tc.expectedFee), fee)
+			assert.Equal(t, reserves.Add(deposit), pool.Reserves())
+			assert.Equal(t, totalShares.Add(shares), pool.TotalShares())
+
+			// withdrawing the same shares returns less than the deposit, since fees are paid twice
+			withdraw, withdrawFee := pool.RemoveSingleAssetLiquidity(shares, tc.denom, d("0.003"))
+			assert.True(t, withdraw.IsLT(deposit.Sub(fee)), "expected withdraw %s < deposit %s", withdraw, deposit.Sub(fee))
+			assert.True(t, withdrawFee.IsPositive())
+			assert.Equal(t, totalShares, pool.TotalShares())
+			assert.Equal(t, reserves.Add(deposit).Sub(withdraw), pool.Reserves())
+		})
+	}
+
+	reserves := sdk.NewCoins(ufury(800e6), usdf(200e6))
+	pool, err := types.NewWeightedPool(reserves, []uint64{80, 20})
+	require.NoError(t, err)
+
+	withdraw, fee := pool.RemoveSingleAssetLiquidity(i(1e6), "usdf", d("0.003"))
+	assert.Equal(t, usdf(1640006), withdraw)
+	assert.Equal(t, usdf(3946), fee)
+
+	withdraw, fee = pool.RemoveSingleAssetLiquidity(i(1e6), "ufury", d("0"))
+	assert.True(t, withdraw.IsPositive())
+	assert.Equal(t, "0", fee.Amount.String())
+}
+
+func TestWeightedPool_Panics(t *testing.T) {
+	newPool := func() *types.WeightedPool {
+		pool, err := types.NewWeightedPool(sdk.NewCoins(ufury(800e6), usdf(200e6)), []uint64{80, 20})
+		require.NoError(t, err)
+		return pool
+	}
+
+	assert.PanicsWithValue(t, "invalid denomination: denom 'jinx' does not match pool reserves", func() {
+		newPool().SwapWithExactInputTo(jinx(1e6), "usdf", d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid denomination: can not swap denom 'usdf' for itself", func() {
+		newPool().SwapWithExactInputTo(usdf(1e6), "usdf", d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: swap input must be positive", func() {
+		newPool().SwapWithExactInputTo(usdf(0), "ufury", d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: fee must be between 0 and 1", func() {
+		newPool().SwapWithExactInputTo(usdf(1e6), "ufury", d("1"))
+	})
+	assert.PanicsWithValue(t, "invalid value: swap output must be less than reserves", func() {
+		newPool().SwapWithExactOutputFrom("ufury", usdf(200e6), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: deposit must be positive", func() {
+		newPool().AddSingleAssetLiquidity(usdf(0), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "out of bounds: single asset withdraw of shares 606286626 >= total shares 606286626", func() {
+		newPool().RemoveSingleAssetLiquidity(i(606286626), "usdf", d("0.003"))
+	})
+	assert.PanicsWithValue(t, "out of bounds: shares 606286627 > total shares 606286626", func() {
+		newPool().RemoveLiquidity(i(606286627))
+	})
+	assert.PanicsWithValue(t, "invalid value: shares must be positive", func() {
+		newPool().ShareValue(i(0))
+	})
+}
+
+func TestWeightedPool_SpotPrice(t *testing.T) {
+	pool8020, err := types.NewWeightedPool(sdk.NewCoins(ufury(800e6), usdf(200e6)), []uint64{80, 20})
+	require.NoError(t, err)
+	// the 80/20 weights balance the 4:1 reserves, so both denoms are priced at 1
+	assert.Equal(t, sdk.OneDec(), pool8020.SpotPrice("ufury", "usdf"))
+	assert.Equal(t, sdk.OneDec(), pool8020.SpotPrice("usdf", "ufury"))
+
+	pool3, err := types.NewWeightedPool(sdk.NewCoins(jinx(100e6), ufury(100e6), usdf(400e6)), []uint64{1, 1, 1})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(4), pool3.SpotPrice("jinx", "usdf"))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.25"), pool3.SpotPrice("usdf", "ufury"))
+	assert.Equal(t, sdk.OneDec(), pool3.SpotPrice("ufury", "jinx"))
+
+	assert.Panics(t, func() { pool3.SpotPrice("jinx", "jinx") }, "expected panic for equal denoms")
+	assert.Panics(t, func() { pool3.SpotPrice("bnb", "jinx") }, "expected panic for denom not in pool")
+}